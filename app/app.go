@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -33,6 +34,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	poolsingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/pools/ingester"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 
@@ -89,6 +92,7 @@ import (
 	v20 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v20"
 	v21 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v21"
 	v22 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v22"
+	v23 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v23"
 	v3 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v3"
 	v4 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v4"
 	v5 "github.com/osmosis-labs/osmosis/v22/app/upgrades/v5"
@@ -136,7 +140,7 @@ var (
 
 	_ runtime.AppI = (*OsmosisApp)(nil)
 
-	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade, v17.Upgrade, v18.Upgrade, v19.Upgrade, v20.Upgrade, v21.Upgrade, v22.Upgrade}
+	Upgrades = []upgrades.Upgrade{v4.Upgrade, v5.Upgrade, v7.Upgrade, v9.Upgrade, v11.Upgrade, v12.Upgrade, v13.Upgrade, v14.Upgrade, v15.Upgrade, v16.Upgrade, v17.Upgrade, v18.Upgrade, v19.Upgrade, v20.Upgrade, v21.Upgrade, v22.Upgrade, v23.Upgrade}
 	Forks    = []upgrades.Fork{v3.Fork, v6.Fork, v8.Fork, v10.Fork}
 )
 
@@ -253,7 +257,8 @@ func NewOsmosisApp(
 	)
 
 	// Initialize the ingest manager for propagating data to external sinks.
-	app.IngestManager = ingest.NewIngestManager()
+	// The blocks that fail to be ingested are recorded in the dead-letter log to be replayed.
+	app.IngestManager = ingest.NewIngestManager(ingest.NewDeadLetterLog(filepath.Join(dataDir, ingest.DeadLetterLogDirName)))
 
 	sqsConfig := sqs.NewConfigFromOptions(appOpts)
 
@@ -266,15 +271,40 @@ func NewOsmosisApp(
 			ProtorevKeeper:     app.ProtoRevKeeper,
 			PoolManagerKeeper:  app.PoolManagerKeeper,
 			ConcentratedKeeper: app.ConcentratedLiquidityKeeper,
+			TxFeesKeeper:       app.TxFeesKeeper,
 		}
 
-		sqsIngester, err := sqsConfig.Initialize(appCodec, sqsKeepers)
+		// The pool tracker is populated by the pool hooks set up in SetupHooks
+		// and by the events of every delivered transaction.
+		app.SQSPoolTracker = poolsingester.NewPoolTracker()
+
+		sqsIngester, err := sqsConfig.Initialize(appCodec, sqsKeepers, app.SQSPoolTracker, homePath)
 		if err != nil {
 			panic(err)
 		}
 
 		// Set the sidecar query server ingester to the ingest manager.
-		app.IngestManager.RegisterIngester(sqsIngester)
+		if err := app.IngestManager.RegisterIngester(sqsIngester, sqsConfig.QueueConfig()); err != nil {
+			panic(err)
+		}
+	}
+
+	tradesConfig := trades.NewConfigFromOptions(appOpts)
+
+	// Initialize the trades ingester if it is enabled.
+	if tradesConfig.IsEnabled {
+		// The event tracker is populated with the swap events of every block
+		// in BeginBlocker and DeliverTx.
+		app.TradesEventTracker = trades.NewBlockEventTracker(trades.SwapEventTypes...)
+
+		tradesIngester, err := tradesConfig.Initialize(app.TradesEventTracker, app.PoolManagerKeeper, homePath)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := app.IngestManager.RegisterIngester(tradesIngester, tradesConfig.QueueConfig()); err != nil {
+			panic(err)
+		}
 	}
 
 	// TODO: There is a bug here, where we register the govRouter routes in InitNormalKeepers and then
@@ -418,15 +448,66 @@ func (app *OsmosisApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block.
 func (app *OsmosisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.TradesEventTracker != nil {
+		app.TradesEventTracker.StartBlock(ctx.BlockHeight())
+	}
+
 	BeginBlockForks(ctx, app)
-	return app.mm.BeginBlock(ctx, req)
+	res := app.mm.BeginBlock(ctx, req)
+
+	// Track the pools updated and the trades executed at the beginning of the block.
+	if app.SQSPoolTracker != nil {
+		app.SQSPoolTracker.TrackEvents(res.Events)
+	}
+	if app.TradesEventTracker != nil {
+		app.TradesEventTracker.TrackBlockEvents(res.Events)
+	}
+
+	return res
+}
+
+// DeliverTx delivers the transaction and tracks the pools it updated
+// if the sidecar query server is enabled and the trades it executed
+// if the trades ingester is enabled.
+func (app *OsmosisApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	if app.SQSPoolTracker != nil {
+		app.SQSPoolTracker.TrackEvents(res.Events)
+	}
+	if app.TradesEventTracker != nil {
+		app.TradesEventTracker.TrackTx(req.Tx, res)
+	}
+
+	return res
 }
 
 // EndBlocker application updates every end block.
 func (app *OsmosisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.mm.EndBlock(ctx, req)
+
+	// Track the pools updated at the end of the block, such as by governance proposals,
+	// before the block is ingested.
+	if app.SQSPoolTracker != nil {
+		app.SQSPoolTracker.TrackEvents(res.Events)
+	}
+
 	// Process the block and ingest data into various sinks.
+	// The txfees end blocker has already updated the EIP-1559 base fee for the next block,
+	// so the ingesters read the base fee that was in effect for this block from the base fee history.
 	app.IngestManager.ProcessBlock(ctx)
-	return app.mm.EndBlock(ctx, req)
+
+	// Stream the twap records updated in this block to the subscribers of the twap query server.
+	if err := app.TwapKeeper.PublishRecordUpdates(res.Events); err != nil {
+		ctx.Logger().Error("failed to publish the twap record updates", "height", ctx.BlockHeight(), "err", err)
+	}
+
+	return res
+}
+
+// Close waits for the queued block updates to be ingested and closes the app.
+func (app *OsmosisApp) Close() error {
+	return errors.Join(app.IngestManager.Close(), app.BaseApp.Close())
 }
 
 // InitChainer application update at chain initialization.
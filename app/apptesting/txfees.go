@@ -13,6 +13,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v22/x/txfees/keeper"
+	mempool1559 "github.com/osmosis-labs/osmosis/v22/x/txfees/keeper/mempool-1559"
 	"github.com/osmosis-labs/osmosis/v22/x/txfees/types"
 )
 
@@ -77,7 +78,7 @@ func (s *KeeperTestHelper) SetupTxFeeAnteHandlerAndChargeFee(clientCtx client.Co
 
 	tx := s.BuildTx(txBuilder, msgs, sigV2, "", txFee, gasLimit)
 
-	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, mempoolFeeOpts)
+	mfd := keeper.NewMempoolFeeDecorator(*s.App.TxFeesKeeper, mempoolFeeOpts, mempool1559.DefaultParams())
 	dfd := keeper.NewDeductFeeDecorator(*s.App.TxFeesKeeper, *s.App.AccountKeeper, s.App.BankKeeper, nil)
 	antehandlerMFD := sdk.ChainAnteDecorators(mfd, dfd)
 	_, err = antehandlerMFD(s.Ctx, tx, isSimulate)
@@ -67,6 +67,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
 	"github.com/osmosis-labs/osmosis/v22/ingest"
+	sqsdomain "github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	tradesdomain "github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
 
 	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
@@ -164,6 +166,12 @@ type AppKeepers struct {
 	CosmwasmPoolKeeper           *cosmwasmpool.Keeper
 
 	IngestManager ingest.IngestManager
+	// SQSPoolTracker tracks the pools updated within a block for the sidecar query server ingester.
+	// It is nil if the sidecar query server is disabled.
+	SQSPoolTracker sqsdomain.BlockPoolUpdateTracker
+	// TradesEventTracker tracks the swap events emitted within a block for the trades ingester.
+	// It is nil if the trades ingester is disabled.
+	TradesEventTracker tradesdomain.BlockEventTracker
 
 	// IBC modules
 	// transfer module
@@ -379,6 +387,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
 
 	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
 
@@ -391,6 +400,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.EpochsKeeper,
 		appKeepers.PoolManagerKeeper,
 		appKeepers.ConcentratedLiquidityKeeper,
+		appKeepers.DistrKeeper,
 	)
 	appKeepers.ProtoRevKeeper = &protorevKeeper
 	appKeepers.PoolManagerKeeper.SetProtorevKeeper(appKeepers.ProtoRevKeeper)
@@ -422,6 +432,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.ConcentratedLiquidityKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 	appKeepers.GAMMKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
+	appKeepers.ProtoRevKeeper.SetIncentivesKeeper(appKeepers.IncentivesKeeper)
 
 	mintKeeper := mintkeeper.NewKeeper(
 		appKeepers.keys[minttypes.StoreKey],
@@ -450,6 +461,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.IncentivesKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.GAMMKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
+	appKeepers.ProtoRevKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appKeepers.keys[tokenfactorytypes.StoreKey],
@@ -736,21 +748,31 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		),
 	)
 
+	gammHooks := []gammtypes.GammHooks{
+		// insert gamm hooks receivers here
+		appKeepers.PoolIncentivesKeeper.Hooks(),
+		appKeepers.TwapKeeper.GammHooks(),
+		appKeepers.ProtoRevKeeper.Hooks(),
+	}
+
+	concentratedLiquidityListeners := []concentratedliquiditytypes.ConcentratedLiquidityListener{
+		appKeepers.TwapKeeper.ConcentratedLiquidityListener(),
+		appKeepers.PoolIncentivesKeeper.Hooks(),
+		appKeepers.ProtoRevKeeper.Hooks(),
+	}
+
+	// The sidecar query server ingester only writes the pools updated within a block.
+	if appKeepers.SQSPoolTracker != nil {
+		gammHooks = append(gammHooks, appKeepers.SQSPoolTracker.GammHooks())
+		concentratedLiquidityListeners = append(concentratedLiquidityListeners, appKeepers.SQSPoolTracker.ConcentratedLiquidityListener())
+	}
+
 	appKeepers.GAMMKeeper.SetHooks(
-		gammtypes.NewMultiGammHooks(
-			// insert gamm hooks receivers here
-			appKeepers.PoolIncentivesKeeper.Hooks(),
-			appKeepers.TwapKeeper.GammHooks(),
-			appKeepers.ProtoRevKeeper.Hooks(),
-		),
+		gammtypes.NewMultiGammHooks(gammHooks...),
 	)
 
 	appKeepers.ConcentratedLiquidityKeeper.SetListeners(
-		concentratedliquiditytypes.NewConcentratedLiquidityListeners(
-			appKeepers.TwapKeeper.ConcentratedLiquidityListener(),
-			appKeepers.PoolIncentivesKeeper.Hooks(),
-			appKeepers.ProtoRevKeeper.Hooks(),
-		),
+		concentratedliquiditytypes.NewConcentratedLiquidityListeners(concentratedLiquidityListeners...),
 	)
 
 	appKeepers.LockupKeeper.SetHooks(
@@ -782,6 +804,8 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.PoolManagerKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
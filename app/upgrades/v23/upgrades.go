@@ -0,0 +1,52 @@
+package v23
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/osmosis-labs/osmosis/v22/app/keepers"
+	"github.com/osmosis-labs/osmosis/v22/app/upgrades"
+	protorevtypes "github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	bpm upgrades.BaseAppParamManager,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// Run migrations before applying any other state changes.
+		// NOTE: DO NOT PUT ANY STATE CHANGES BEFORE RunMigrations().
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// Set the new twap param to an empty slice, so that every pool keeps
+		// using the global record history keep period.
+		keepers.TwapKeeper.SetParam(ctx, twaptypes.KeyPoolRecordHistoryKeepPeriods, []twaptypes.PoolRecordHistoryKeepPeriod{})
+
+		// Set the new protorev param to an empty slice, so that the profits are
+		// still held in the module account until governance sets the recipients.
+		keepers.ProtoRevKeeper.SetParam(ctx, protorevtypes.ParamStoreKeyProfitShareRecipients, []protorevtypes.ProfitShareRecipient{})
+
+		// Backfill the squared log price accumulator of the existing twap records,
+		// so that realized volatility can be queried over their time range.
+		err = keepers.TwapKeeper.MigrateLogPriceSquaredAccumulators(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store the liquidity of the pools with twap records, which weighs them in the
+		// aggregated twaps until they change.
+		err = keepers.TwapKeeper.StoreAllPoolLiquidities(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return migrations, nil
+	}
+}
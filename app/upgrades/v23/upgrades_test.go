@@ -0,0 +1,96 @@
+package v23_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+)
+
+const (
+	v23UpgradeHeight = int64(10)
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	s.Setup()
+
+	// Create twap records over a few blocks with swaps.
+	poolId := s.PrepareBalancerPool()
+	for i := 0; i < 3; i++ {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+		s.RunBasicSwap(poolId)
+		s.App.TwapKeeper.EndBlock(s.Ctx)
+	}
+
+	expectedHistoricalRecords, err := s.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	expectedMostRecentRecords, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	// Remove the squared log price accumulators, as in records stored before they were tracked.
+	for _, record := range expectedHistoricalRecords {
+		s.Require().NotNil(record.LogPriceSquaredAccumulator)
+		record.LogPriceSquaredAccumulator = nil
+		s.App.TwapKeeper.StoreHistoricalTWAP(s.Ctx, record)
+	}
+	for _, record := range expectedMostRecentRecords {
+		record.LogPriceSquaredAccumulator = nil
+		s.App.TwapKeeper.StoreNewRecord(s.Ctx, record)
+	}
+	s.assertLogPriceSquaredAccumulatorsTracked(poolId, false)
+
+	dummyUpgrade(s)
+	s.Require().NotPanics(func() {
+		s.App.BeginBlocker(s.Ctx, abci.RequestBeginBlock{})
+	})
+
+	// Check that the accumulators have been replayed to the values tracked before their removal.
+	s.assertLogPriceSquaredAccumulatorsTracked(poolId, true)
+	historicalRecords, err := s.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHistoricalRecords, historicalRecords)
+	mostRecentRecords, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedMostRecentRecords, mostRecentRecords)
+
+	// Check that no pool overrides the twap record history keep period.
+	s.Require().Empty(s.App.TwapKeeper.GetParams(s.Ctx).PoolRecordHistoryKeepPeriods)
+
+	// Check that no profits are shared with any recipient.
+	s.Require().Empty(s.App.ProtoRevKeeper.GetParams(s.Ctx).ProfitShareRecipients)
+}
+
+func (s *UpgradeTestSuite) assertLogPriceSquaredAccumulatorsTracked(poolId uint64, tracked bool) {
+	historicalRecords, err := s.App.TwapKeeper.GetAllHistoricalTimeIndexedTWAPs(s.Ctx)
+	s.Require().NoError(err)
+	mostRecentRecords, err := s.App.TwapKeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	for _, record := range append(historicalRecords, mostRecentRecords...) {
+		s.Require().Equal(tracked, record.LogPriceSquaredAccumulator != nil, "record %v", record)
+	}
+}
+
+func dummyUpgrade(s *UpgradeTestSuite) {
+	s.Ctx = s.Ctx.WithBlockHeight(v23UpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: "v23", Height: v23UpgradeHeight}
+	err := s.App.UpgradeKeeper.ScheduleUpgrade(s.Ctx, plan)
+	s.Require().NoError(err)
+	_, exists := s.App.UpgradeKeeper.GetUpgradePlan(s.Ctx)
+	s.Require().True(exists)
+
+	s.Ctx = s.Ctx.WithBlockHeight(v23UpgradeHeight)
+}
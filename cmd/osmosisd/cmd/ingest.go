@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	cometbftdb "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v22/app"
+	"github.com/osmosis-labs/osmosis/v22/ingest"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades"
+	mempool1559 "github.com/osmosis-labs/osmosis/v22/x/txfees/keeper/mempool-1559"
+)
+
+const (
+	flagReplayFrom = "from"
+	flagReplayTo   = "to"
+)
+
+// IngestCmd returns the commands for managing the ingestion of block data into external sinks.
+func IngestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ingest",
+		Short: "Manage the ingestion of block data into external sinks",
+	}
+
+	cmd.AddCommand(
+		IngestReplayCmd(),
+		IngestDeadLettersCmd(),
+	)
+
+	return cmd
+}
+
+// IngestReplayCmd returns the command that re-runs the registered ingesters against historical state versions.
+func IngestReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-ingest a range of blocks from historical state into the configured sinks",
+		Long: `Re-ingest a range of blocks from historical state into the configured sinks.
+The ingesters enabled in app.toml are re-run against the state versions of every block in the range.
+The state versions and the blocks must not be pruned. The node must be stopped before running the command.
+The trades ingester cannot replay blocks since the swap events are not part of the state.
+The sidecar query server sink only keeps the latest state, so the blocks below its latest height are rejected.
+The base fee of a block is read from the EIP-1559 base fee history, which only keeps the latest blocks.
+The dead letters of the successfully replayed blocks are removed from the dead-letter log.
+Example:
+	osmosisd ingest replay --from 100 --to 200
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			from, err := cmd.Flags().GetInt64(flagReplayFrom)
+			if err != nil {
+				return err
+			}
+
+			to, err := cmd.Flags().GetInt64(flagReplayTo)
+			if err != nil {
+				return err
+			}
+
+			if from <= 0 || to < from {
+				return fmt.Errorf("invalid block range [%d, %d], expected 0 < from <= to", from, to)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			if !sqs.NewConfigFromOptions(serverCtx.Viper).IsEnabled && !trades.NewConfigFromOptions(serverCtx.Viper).IsEnabled {
+				return errors.New("no ingesters are enabled in app.toml")
+			}
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := cometbftdb.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+
+			app, ok := newApp(serverCtx.Logger, db, nil, serverCtx.Viper).(*osmosis.OsmosisApp)
+			if !ok {
+				return fmt.Errorf("expected app of type %T", &osmosis.OsmosisApp{})
+			}
+			defer func() {
+				err = errors.Join(err, app.Close())
+			}()
+
+			// The block times are not part of the state so they are read from the block store.
+			blockStoreDB, err := cometbftdb.NewDB("blockstore", cometbftdb.BackendType(serverCtx.Config.DBBackend), serverCtx.Config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+
+			// The base fees are not part of the state so they are read from the base fee history
+			// in the EIP-1559 backup file that the node wrote when it executed the blocks.
+			mempool1559.CurEipState.BackupFilePath = filepath.Join(dataDir, mempool1559.BackupFilename)
+			mempool1559.CurEipState.LoadBackup()
+
+			// A block that fails to be replayed by an ingester does not prevent
+			// the other ingesters and blocks from being replayed.
+			failedBlocks := 0
+			for height := from; height <= to; height++ {
+				cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+				if err != nil {
+					return fmt.Errorf("failed to load state at height %d: %w", height, err)
+				}
+
+				blockTime, err := getBlockTime(blockStore, height)
+				if err != nil {
+					return err
+				}
+
+				ctx := sdk.NewContext(cms, tmproto.Header{Height: height, Time: blockTime, ChainID: app.ChainID()}, false, serverCtx.Logger)
+				if err := app.IngestManager.ReplayBlock(ctx); err != nil {
+					fmt.Fprintf(cmd.ErrOrStderr(), "failed to replay block %d: %v\n", height, err)
+					failedBlocks++
+					continue
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "replayed block %d\n", height)
+			}
+
+			if failedBlocks > 0 {
+				return fmt.Errorf("failed to replay %d of %d blocks", failedBlocks, to-from+1)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayFrom, 0, "First height to replay")
+	cmd.Flags().Int64(flagReplayTo, 0, "Last height to replay")
+	_ = cmd.MarkFlagRequired(flagReplayFrom)
+	_ = cmd.MarkFlagRequired(flagReplayTo)
+
+	return cmd
+}
+
+// IngestDeadLettersCmd returns the command that lists the blocks that failed to be ingested.
+func IngestDeadLettersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead-letters",
+		Short: "List the blocks that failed to be ingested",
+		Long: `List the blocks that failed to be ingested as JSON lines.
+The blocks can be re-ingested with the replay command. The node must be stopped before running the command.
+Example:
+	osmosisd ingest dead-letters
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			deadLetterLog := ingest.NewDeadLetterLog(filepath.Join(serverCtx.Config.RootDir, "data", ingest.DeadLetterLogDirName))
+			defer deadLetterLog.Close()
+
+			deadLetters, err := deadLetterLog.GetAll()
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for _, deadLetter := range deadLetters {
+				if err := encoder.Encode(deadLetter); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v22/app/params"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades"
 
 	tmcfg "github.com/cometbft/cometbft/config"
 	tmcli "github.com/cometbft/cometbft/libs/cli"
@@ -505,6 +506,8 @@ func initAppConfig() (string, interface{}) {
 
 		SidecarQueryServerConfig sqs.Config `mapstructure:"osmosis-sqs"`
 
+		TradesIngesterConfig trades.Config `mapstructure:"osmosis-trades"`
+
 		Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 	}
 
@@ -523,7 +526,9 @@ func initAppConfig() (string, interface{}) {
 
 	sqsConfig := sqs.DefaultConfig
 
-	OsmosisAppCfg := CustomAppConfig{Config: *srvCfg, OsmosisMempoolConfig: memCfg, SidecarQueryServerConfig: sqsConfig, Wasm: wasmtypes.DefaultWasmConfig()}
+	tradesConfig := trades.DefaultConfig
+
+	OsmosisAppCfg := CustomAppConfig{Config: *srvCfg, OsmosisMempoolConfig: memCfg, SidecarQueryServerConfig: sqsConfig, TradesIngesterConfig: tradesConfig, Wasm: wasmtypes.DefaultWasmConfig()}
 
 	OsmosisAppTemplate := serverconfig.DefaultConfigTemplate + `
 ###############################################################################
@@ -546,6 +551,29 @@ min-gas-price-for-high-gas-tx = ".0025"
 # This parameter enables EIP-1559 like fee market logic in the mempool
 adaptive-fee-enabled = "true"
 
+# The following parameters tune the EIP-1559 like fee market logic in the mempool.
+# They are node local, validated on startup and reported by the txfees eip-params query.
+# The base fee is reset to the default every eip1559-reset-interval blocks
+# and always kept between the min and max base fees, denominated in uosmo per gas.
+eip1559-default-base-fee = "0.0060"
+eip1559-min-base-fee = "0.0025"
+eip1559-max-base-fee = "5"
+eip1559-reset-interval = "6000"
+
+# The maximum relative change of the base fee per block, must be between 0 and 1.
+eip1559-max-block-change-rate = "0.1"
+
+# The gas wanted per block that the base fee targets. If set to "0", it is the
+# eip1559-target-block-space-percent share of the block max gas.
+eip1559-target-gas = "0"
+eip1559-target-block-space-percent = "0.625"
+
+# When rechecking transactions, the base fee is divided by the high recheck factor if it is
+# above the threshold and by the low recheck factor otherwise.
+eip1559-recheck-fee-low-base-fee = "3"
+eip1559-recheck-fee-high-base-fee = "2.3"
+eip1559-recheck-fee-base-fee-threshold = "0.01"
+
 ###############################################################################
 ###              Osmosis Sidecar Query Server Configuration                 ###
 ###############################################################################
@@ -555,10 +583,96 @@ adaptive-fee-enabled = "true"
 # SQS service is disabled by default.
 is-enabled = "false"
 
+# The storage backend that the ingested data is written into.
+# One of "redis", "file" (embedded LevelDB snapshot store) or "memory" (for tests).
+sink-type = "{{ .SidecarQueryServerConfig.SinkType }}"
+
 # The hostname and address of the sidecar query server storage.
+# Only used by the "redis" sink.
 db-host = "{{ .SidecarQueryServerConfig.StorageHost }}"
 db-port = "{{ .SidecarQueryServerConfig.StoragePort }}"
 
+# The directory of the "file" sink database.
+# If empty, defaults to data/sqs under the node home directory.
+file-sink-dir = "{{ .SidecarQueryServerConfig.FileSinkDir }}"
+
+# The maximum number of block updates waiting to be written into the sink.
+# Block updates are written asynchronously so that slow writes do not slow down the block commit.
+ingest-queue-size = "{{ .SidecarQueryServerConfig.IngestQueueSize }}"
+
+# What happens when a block update is queued while the queue is full.
+# One of "block" (wait for capacity in the block path), "drop" (drop the new block update)
+# or "coalesce" (merge the queued block updates into the latest one, keeping the latest write of every pool).
+# All pools are ingested at the next block after a block update is dropped.
+ingest-queue-overflow-policy = "{{ .SidecarQueryServerConfig.IngestQueueOverflowPolicy }}"
+
+# The number of times a failed sink write is retried with exponential backoff.
+# Blocks that still fail to be written are recorded in the dead-letter log under data/ingest
+# and can be replayed with "osmosisd ingest replay".
+ingest-max-flush-retries = "{{ .SidecarQueryServerConfig.IngestMaxFlushRetries }}"
+
+# The delay before the first retry of a failed sink write. The delay doubles with every retry.
+ingest-flush-retry-backoff = "{{ .SidecarQueryServerConfig.IngestFlushRetryBackoff }}"
+
+# The path to the asset list in the chain registry format that token precisions are read from.
+# If empty, the asset list bundled with the binary is used.
+# Denoms missing from the asset list fall back to the x/bank denom metadata.
+asset-list-path = "{{ .SidecarQueryServerConfig.AssetListPath }}"
+
+# The interval at which the token precisions are reloaded.
+asset-list-refresh-interval = "{{ .SidecarQueryServerConfig.AssetListRefreshInterval }}"
+
+# The interval in blocks at which all pools are ingested.
+# At other blocks, only the pools updated within the block are ingested.
+# All pools are always ingested at startup. Zero disables the periodic full resync.
+pool-full-resync-height-interval = "{{ .SidecarQueryServerConfig.PoolFullResyncHeightInterval }}"
+
+# The interval in blocks at which the candidate routes are computed for the denom pairs with a taker fee
+# affected by the pools updated since the last computation, and written into the sink. All routes are
+# computed at startup so that the sidecar query server can serve them immediately after a restart.
+# Zero disables the candidate routes.
+# Note that the routes are computed in the block path.
+route-update-height-interval = "{{ .SidecarQueryServerConfig.RouteUpdateHeightInterval }}"
+
+# The maximum number of pools in a candidate route.
+route-max-hops = "{{ .SidecarQueryServerConfig.RouteMaxHops }}"
+
+# The maximum number of candidate routes for a denom pair in one direction.
+route-max-routes = "{{ .SidecarQueryServerConfig.RouteMaxRoutes }}"
+
+###############################################################################
+###                   Osmosis Trades Ingester Configuration                 ###
+###############################################################################
+
+[osmosis-trades]
+
+# The trades ingester writes the swaps executed within every block as normalized trade records.
+# It is disabled by default.
+is-enabled = "false"
+
+# The storage backend that the trades are written into.
+# Only "jsonl" (one JSON lines file per UTC day) is supported.
+sink-type = "{{ .TradesIngesterConfig.SinkType }}"
+
+# The directory that the trades are written into.
+# If empty, defaults to data/trades under the node home directory.
+output-dir = "{{ .TradesIngesterConfig.OutputDir }}"
+
+# The maximum number of block updates waiting to be written into the sink.
+ingest-queue-size = "{{ .TradesIngesterConfig.IngestQueueSize }}"
+
+# What happens when a block update is queued while the queue is full.
+# One of "block" (wait for capacity in the block path), "drop" (drop the new block update)
+# or "coalesce" (drop the queued block updates and keep the latest one).
+# The trades of dropped block updates are lost since they cannot be replayed.
+ingest-queue-overflow-policy = "{{ .TradesIngesterConfig.IngestQueueOverflowPolicy }}"
+
+# The number of times a failed sink write is retried with exponential backoff.
+ingest-max-flush-retries = "{{ .TradesIngesterConfig.IngestMaxFlushRetries }}"
+
+# The delay before the first retry of a failed sink write. The delay doubles with every retry.
+ingest-flush-retry-backoff = "{{ .TradesIngesterConfig.IngestFlushRetryBackoff }}"
+
 ###############################################################################
 ###              		       Wasm Configuration    					    ###
 ###############################################################################
@@ -601,6 +715,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		PrintEnvironmentCmd(),
 		PrintAllEnvironmentCmd(),
 		UpdateAssetListCmd(osmosis.DefaultNodeHome, osmosis.ModuleBasics),
+		IngestCmd(),
+		TwapCmd(),
 	)
 
 	server.AddCommands(rootCmd, osmosis.DefaultNodeHome, newApp, createOsmosisAppAndExport, addModuleInitFlags)
@@ -739,6 +855,14 @@ func newApp(logger log.Logger, db cometbftdb.DB, traceStore io.Writer, appOpts s
 		chainID = appGenesis.ChainID
 	}
 
+	// The sidecar query server reads token precisions from the bundled asset list
+	// unless an asset list path is configured.
+	if chainID == testnetId {
+		sqs.BundledAssetList, _ = assetFS.ReadFile("osmo-test-5-assetlist.json")
+	} else {
+		sqs.BundledAssetList, _ = assetFS.ReadFile("osmosis-1-assetlist.json")
+	}
+
 	var wasmOpts []wasmkeeper.Option
 	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
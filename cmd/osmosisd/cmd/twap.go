@@ -0,0 +1,327 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	cometbftdb "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmstore "github.com/cometbft/cometbft/store"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	osmosis "github.com/osmosis-labs/osmosis/v22/app"
+	"github.com/osmosis-labs/osmosis/v22/x/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+const (
+	flagTwapExportPool   = "pool"
+	flagTwapExportFrom   = "from"
+	flagTwapExportTo     = "to"
+	flagTwapExportAt     = "at"
+	flagTwapExportFormat = "format"
+	flagTwapExportOutput = "output"
+
+	twapExportFormatCSV  = "csv"
+	twapExportFormatJSON = "json"
+)
+
+// twapExportCSVHeader is the header of the csv written by the twap export command.
+var twapExportCSVHeader = []string{
+	"pool_id",
+	"asset0_denom",
+	"asset1_denom",
+	"height",
+	"time",
+	"p0_last_spot_price",
+	"p1_last_spot_price",
+	"p0_arithmetic_twap_accumulator",
+	"p1_arithmetic_twap_accumulator",
+	"geometric_twap_accumulator",
+	"log_price_squared_accumulator",
+	"last_error_time",
+}
+
+// TwapCmd returns the commands for inspecting the twap records stored by the node.
+func TwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap",
+		Short: "Inspect the twap records stored by the node",
+	}
+
+	cmd.AddCommand(
+		TwapExportCmd(),
+	)
+
+	return cmd
+}
+
+// TwapExportCmd returns the command that exports the twap records eligible for pruning to csv or json.
+func TwapExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the twap records eligible for pruning to csv or json",
+		Long: `Export the twap records eligible for pruning to csv or json, so that they can be audited after being deleted.
+The records are those that the next pruning would delete, as of the time of the last block, or of the --at time if given.
+The pruning honors the per pool record history keep periods of the twap params.
+The records can be filtered by pool and by time range, bounds included. Times are unix timestamps or in the sortable time format.
+The json format writes a record per line. Only the twap and params stores are loaded, the rest of the app is not started.
+The node must be stopped before running the command.
+Example:
+	osmosisd twap export --pool 1 --from 1700000000 --to 1700086400 --format csv --output twap-records.csv
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			poolId, err := cmd.Flags().GetUint64(flagTwapExportPool)
+			if err != nil {
+				return err
+			}
+
+			from, err := parseTwapExportTimeFlag(cmd, flagTwapExportFrom)
+			if err != nil {
+				return err
+			}
+
+			to, err := parseTwapExportTimeFlag(cmd, flagTwapExportTo)
+			if err != nil {
+				return err
+			}
+
+			if !from.IsZero() && !to.IsZero() && to.Before(from) {
+				return fmt.Errorf("invalid time range [%s, %s], expected from <= to", from, to)
+			}
+
+			at, err := parseTwapExportTimeFlag(cmd, flagTwapExportAt)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(flagTwapExportFormat)
+			if err != nil {
+				return err
+			}
+
+			if format != twapExportFormatCSV && format != twapExportFormatJSON {
+				return fmt.Errorf("invalid format %s, expected %s or %s", format, twapExportFormatCSV, twapExportFormatJSON)
+			}
+
+			outputPath, err := cmd.Flags().GetString(flagTwapExportOutput)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := cometbftdb.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+
+			defer func() {
+				err = errors.Join(err, db.Close())
+			}()
+
+			encodingConfig := osmosis.GetEncodingConfig()
+			twapKeeper, cms, err := loadTwapKeeper(db, serverCtx.Logger, encodingConfig.Marshaler, encodingConfig.Amino)
+			if err != nil {
+				return err
+			}
+
+			height := cms.LastCommitID().Version
+			if at.IsZero() {
+				at, err = loadBlockTime(serverCtx.Config.DBDir(), cometbftdb.BackendType(serverCtx.Config.DBBackend), height)
+				if err != nil {
+					return err
+				}
+			}
+
+			output := cmd.OutOrStdout()
+			if outputPath != "" {
+				file, createErr := os.Create(outputPath)
+				if createErr != nil {
+					return createErr
+				}
+				defer func() {
+					err = errors.Join(err, file.Close())
+				}()
+				output = file
+			}
+
+			writer := newTwapRecordWriter(output, format, encodingConfig.Marshaler)
+			if err := writer.writeHeader(); err != nil {
+				return err
+			}
+
+			ctx := sdk.NewContext(cms.CacheMultiStore(), tmproto.Header{Height: height, Time: at}, false, serverCtx.Logger)
+
+			exported := 0
+			err = twapKeeper.IteratePruneEligibleRecords(ctx, func(record twaptypes.TwapRecord) error {
+				if poolId != 0 && record.PoolId != poolId {
+					return nil
+				}
+				if (!from.IsZero() && record.Time.Before(from)) || (!to.IsZero() && record.Time.After(to)) {
+					return nil
+				}
+
+				exported++
+				return writer.write(record)
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := writer.flush(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "exported %d twap records eligible for pruning as of %s\n", exported, at.UTC().Format(time.RFC3339))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagTwapExportPool, 0, "Pool id to export the records of, all pools if not set")
+	cmd.Flags().String(flagTwapExportFrom, "", "Earliest record time to export, unbounded if not set")
+	cmd.Flags().String(flagTwapExportTo, "", "Latest record time to export, unbounded if not set")
+	cmd.Flags().String(flagTwapExportAt, "", "Time at which the records are eligible for pruning, the time of the last block if not set")
+	cmd.Flags().String(flagTwapExportFormat, twapExportFormatCSV, "Output format, csv or json")
+	cmd.Flags().String(flagTwapExportOutput, "", "File to write the records to, stdout if not set")
+
+	return cmd
+}
+
+// loadTwapKeeper loads the latest version of the twap and params stores of the given application db, and returns
+// a twap keeper reading them along with the store. The rest of the app, including the ingesters, is not started.
+func loadTwapKeeper(db cometbftdb.DB, logger log.Logger, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino) (*twap.Keeper, storetypes.CommitMultiStore, error) {
+	keys := sdk.NewKVStoreKeys(twaptypes.StoreKey, paramstypes.StoreKey)
+	tkeys := sdk.NewTransientStoreKeys(twaptypes.TransientStoreKey, paramstypes.TStoreKey)
+
+	cms := rootmulti.NewStore(db, logger)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	for _, key := range tkeys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	if err := cms.LoadLatestVersion(); err != nil {
+		return nil, nil, err
+	}
+
+	paramSpace := paramstypes.NewSubspace(cdc, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey], twaptypes.ModuleName)
+	return twap.NewKeeper(keys[twaptypes.StoreKey], tkeys[twaptypes.TransientStoreKey], paramSpace, nil), cms, nil
+}
+
+// parseTwapExportTimeFlag returns the time of the flag, or the zero time if the flag is not set.
+func parseTwapExportTimeFlag(cmd *cobra.Command, flag string) (time.Time, error) {
+	arg, err := cmd.Flags().GetString(flag)
+	if err != nil || arg == "" {
+		return time.Time{}, err
+	}
+	return osmocli.ParseUnixTime(arg, flag)
+}
+
+// loadBlockTime returns the time of the block at the given height from the block store.
+func loadBlockTime(dbDir string, backend cometbftdb.BackendType, height int64) (time.Time, error) {
+	db, err := cometbftdb.NewDB("blockstore", backend, dbDir)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer db.Close()
+
+	return getBlockTime(tmstore.NewBlockStore(db), height)
+}
+
+// getBlockTime returns the time of the block at the given height from the given block store.
+func getBlockTime(blockStore *tmstore.BlockStore, height int64) (time.Time, error) {
+	blockMeta := blockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return time.Time{}, fmt.Errorf("block %d not found in the block store", height)
+	}
+	return blockMeta.Header.Time, nil
+}
+
+// twapRecordWriter writes twap records either as csv rows or as json lines.
+type twapRecordWriter struct {
+	format    string
+	csvWriter *csv.Writer
+	bufWriter *bufio.Writer
+	cdc       codec.JSONCodec
+}
+
+func newTwapRecordWriter(output io.Writer, format string, cdc codec.JSONCodec) *twapRecordWriter {
+	writer := &twapRecordWriter{format: format, cdc: cdc}
+	if format == twapExportFormatCSV {
+		writer.csvWriter = csv.NewWriter(output)
+	} else {
+		writer.bufWriter = bufio.NewWriter(output)
+	}
+	return writer
+}
+
+func (w *twapRecordWriter) writeHeader() error {
+	if w.format != twapExportFormatCSV {
+		return nil
+	}
+	return w.csvWriter.Write(twapExportCSVHeader)
+}
+
+func (w *twapRecordWriter) write(record twaptypes.TwapRecord) error {
+	if w.format == twapExportFormatCSV {
+		logPriceSquaredAccumulator := ""
+		if record.LogPriceSquaredAccumulator != nil {
+			logPriceSquaredAccumulator = record.LogPriceSquaredAccumulator.String()
+		}
+		lastErrorTime := ""
+		if !record.LastErrorTime.IsZero() {
+			lastErrorTime = record.LastErrorTime.UTC().Format(time.RFC3339Nano)
+		}
+
+		return w.csvWriter.Write([]string{
+			strconv.FormatUint(record.PoolId, 10),
+			record.Asset0Denom,
+			record.Asset1Denom,
+			strconv.FormatInt(record.Height, 10),
+			record.Time.UTC().Format(time.RFC3339Nano),
+			record.P0LastSpotPrice.String(),
+			record.P1LastSpotPrice.String(),
+			record.P0ArithmeticTwapAccumulator.String(),
+			record.P1ArithmeticTwapAccumulator.String(),
+			record.GeometricTwapAccumulator.String(),
+			logPriceSquaredAccumulator,
+			lastErrorTime,
+		})
+	}
+
+	bz, err := w.cdc.MarshalJSON(&record)
+	if err != nil {
+		return err
+	}
+	if _, err := w.bufWriter.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (w *twapRecordWriter) flush() error {
+	if w.format == twapExportFormatCSV {
+		w.csvWriter.Flush()
+		return w.csvWriter.Error()
+	}
+	return w.bufWriter.Flush()
+}
@@ -0,0 +1,176 @@
+package ingest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DeadLetterLogDirName is the name of the dead-letter log directory under the node data directory.
+	DeadLetterLogDirName = "ingest"
+
+	deadLetterDBName = "dead_letters"
+
+	// deadLetterKeySeparator separates the ingester name from the height in the dead-letter keys.
+	deadLetterKeySeparator = "/"
+)
+
+// DeadLetter is a record of a block that an ingester failed to ingest.
+type DeadLetter struct {
+	Ingester string    `json:"ingester"`
+	Height   int64     `json:"height"`
+	Error    string    `json:"error"`
+	Time     time.Time `json:"time"`
+}
+
+// DeadLetterLog is a persistent log of the blocks that ingesters failed to ingest.
+// The recorded blocks can be re-ingested with the replay command.
+type DeadLetterLog interface {
+	// Record records the dead letter. A dead letter with the same ingester and height is overwritten.
+	Record(deadLetter DeadLetter) error
+	// Remove removes the dead letter of the given ingester at the given height if it exists.
+	Remove(ingesterName string, height int64) error
+	// GetAll returns all dead letters sorted by ingester name and height.
+	GetAll() ([]DeadLetter, error)
+	// Close closes the log.
+	Close() error
+}
+
+// deadLetterLog is a DeadLetterLog backed by a LevelDB database.
+// The database is only created on the first write so that nodes
+// without failures do not have the dead-letter log on disk.
+type deadLetterLog struct {
+	mu  sync.Mutex
+	dir string
+	db  dbm.DB
+}
+
+var _ DeadLetterLog = &deadLetterLog{}
+
+// NewDeadLetterLog returns a dead-letter log that is stored in the given directory.
+func NewDeadLetterLog(dir string) DeadLetterLog {
+	return &deadLetterLog{
+		dir: dir,
+	}
+}
+
+// Record implements DeadLetterLog.
+func (l *deadLetterLog) Record(deadLetter DeadLetter) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.open(); err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return l.db.SetSync(formatDeadLetterKey(deadLetter.Ingester, deadLetter.Height), bz)
+}
+
+// Remove implements DeadLetterLog.
+func (l *deadLetterLog) Remove(ingesterName string, height int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if exists, err := l.openIfExists(); err != nil || !exists {
+		return err
+	}
+
+	return l.db.DeleteSync(formatDeadLetterKey(ingesterName, height))
+}
+
+// GetAll implements DeadLetterLog.
+func (l *deadLetterLog) GetAll() ([]DeadLetter, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	deadLetters := []DeadLetter{}
+	if exists, err := l.openIfExists(); err != nil || !exists {
+		return deadLetters, err
+	}
+
+	iterator, err := l.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(iterator.Value(), &deadLetter); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, iterator.Error()
+}
+
+// Close implements DeadLetterLog.
+func (l *deadLetterLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.db == nil {
+		return nil
+	}
+
+	err := l.db.Close()
+	l.db = nil
+	return err
+}
+
+// open opens the database, creating it if it does not exist.
+// Must be called with the lock held.
+func (l *deadLetterLog) open() error {
+	if l.db != nil {
+		return nil
+	}
+
+	db, err := dbm.NewDB(deadLetterDBName, dbm.GoLevelDBBackend, l.dir)
+	if err != nil {
+		return err
+	}
+
+	l.db = db
+	return nil
+}
+
+// openIfExists opens the database if it exists on disk.
+// Returns false if the database does not exist.
+// Must be called with the lock held.
+func (l *deadLetterLog) openIfExists() (bool, error) {
+	if l.db != nil {
+		return true, nil
+	}
+
+	if _, err := os.Stat(l.dbPath()); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, l.open()
+}
+
+// dbPath returns the path of the database directory.
+func (l *deadLetterLog) dbPath() string {
+	return filepath.Join(l.dir, deadLetterDBName+".db")
+}
+
+// formatDeadLetterKey returns the key of the dead letter of the given ingester at the given height.
+// The height is big endian encoded so that the dead letters of an ingester are iterated in order of heights.
+func formatDeadLetterKey(ingesterName string, height int64) []byte {
+	return append([]byte(ingesterName+deadLetterKeySeparator), sdk.Uint64ToBigEndian(uint64(height))...)
+}
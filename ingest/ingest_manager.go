@@ -1,64 +1,251 @@
 package ingest
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // IngestManager is an interface that defines the methods for the ingest manager.
 // Ingest manager handles the processing of blocks and ingesting data into various sinks
 // that are defined by the Ingester interface.
+// Each registered ingester snapshots the block data in the block path. The snapshot is then
+// handed to a bounded queue that is flushed into the sink by a goroutine dedicated to the ingester.
+// As a result, slow sink writes do not slow down the block commit.
+// Failed flushes are retried with exponential backoff. Blocks that still fail to be ingested
+// are recorded in the dead-letter log so that they can be replayed from historical state.
 type IngestManager interface {
-	// RegisterIngester registers an ingester.
-	RegisterIngester(ingester Ingester)
-	// ProcessBlock processes the block and ingests data into various sinks.
+	// RegisterIngester registers an ingester and starts its worker goroutine.
+	// The queue config defines the capacity of the ingester queue and what happens when it is full.
+	// Returns error if the queue config is invalid.
+	RegisterIngester(ingester Ingester, queueConfig QueueConfig) error
+	// ProcessBlock processes the block and queues the block updates to be ingested into various sinks.
 	// Must never panic. If panic occurs, it is silently logged and ignored.
 	// If the ingester returns an error, it is silently logged and ignored.
 	ProcessBlock(ctx sdk.Context)
+	// ReplayBlock synchronously processes the block and flushes the block updates of all ingesters,
+	// bypassing the queues. It is meant for re-ingesting historical blocks and must not be called
+	// concurrently with ProcessBlock. The dead letters of the block are removed for the ingesters that succeed.
+	// Returns error if any ingester fails.
+	ReplayBlock(ctx sdk.Context) error
+	// Close stops accepting new blocks, waits for all queued block updates to be flushed
+	// and closes the dead-letter log.
+	// Returns error if the dead-letter log fails to close.
+	Close() error
 }
 
 // Ingester is an interface that defines the methods for the ingester.
 // Ingester ingests data into a sink.
 type Ingester interface {
-	// ProcessBlock processes the block and ingests data into a sink.
-	// Returns error if the ingester fails to ingest data.
-	ProcessBlock(ctx sdk.Context) error
+	// ProcessBlock processes the block and returns the snapshot of the data to ingest into a sink.
+	// It is called in the block path and must read all the state that it needs from ctx.
+	// The returned block update is flushed asynchronously. Nil block updates are skipped.
+	// Returns error if the ingester fails to process the block.
+	ProcessBlock(ctx sdk.Context) (BlockUpdate, error)
 
 	GetName() string
 }
 
+// BlockUpdate is the snapshot of the data that an ingester writes into a sink for a block.
+type BlockUpdate interface {
+	// Flush writes the block update into the sink.
+	// It is called outside of the block path by the worker goroutine of the ingester.
+	// It is called again if it fails so it must keep the data to write on failure.
+	// Returns error if the sink write fails.
+	Flush(ctx context.Context) error
+}
+
+// MergeableBlockUpdate is an optional interface for block updates that can absorb the block update
+// of a later block, so that queued block updates are merged rather than dropped when the queue is full.
+type MergeableBlockUpdate interface {
+	BlockUpdate
+	// Merge adds the data of the given later block update to the block update, with the later data
+	// taking precedence. It is called in the block path while the block update is queued.
+	// Returns false if the later block update cannot be merged, in which case the block update is unchanged.
+	Merge(later BlockUpdate) bool
+}
+
+// SkippedUpdateHandler is an optional interface for ingesters whose block updates
+// depend on the previous block updates being ingested.
+type SkippedUpdateHandler interface {
+	// OnUpdateSkipped is called when the block update at the given height is dropped
+	// due to a full queue or fails to be flushed.
+	// It may be called concurrently with ProcessBlock.
+	OnUpdateSkipped(height int64)
+}
+
+// FullStateRequester is an optional interface for ingesters whose block updates
+// only contain the data updated within the block, as tracked in the block path.
+type FullStateRequester interface {
+	// RequestFullState requests the full state to be processed at the next ProcessBlock call.
+	// It is called before replaying a historical block since the data updated within
+	// the block is not available.
+	RequestFullState()
+}
+
+// ReplayValidator is an optional interface for ingesters whose sinks cannot take every historical block,
+// e.g. because they only keep the latest state.
+type ReplayValidator interface {
+	// ValidateReplay returns error if the block cannot be replayed into the sink of the ingester.
+	// It is called before replaying a historical block.
+	ValidateReplay(ctx sdk.Context) error
+}
+
 // ingesterImpl is an implementation of IngesterManager.
 type ingestManagerImpl struct {
-	ingesters []Ingester
+	mu sync.Mutex
+
+	workers       []*ingesterWorker
+	deadLetterLog DeadLetterLog
+	closed        bool
 }
 
 var _ IngestManager = &ingestManagerImpl{}
 
 // NewIngestManager creates a new IngestManager.
-func NewIngestManager() IngestManager {
+// The blocks that fail to be ingested are recorded in the given dead-letter log unless it is nil.
+func NewIngestManager(deadLetterLog DeadLetterLog) IngestManager {
 	return &ingestManagerImpl{
-		ingesters: []Ingester{},
+		workers:       []*ingesterWorker{},
+		deadLetterLog: deadLetterLog,
 	}
 }
 
 // RegisterIngester implements IngestManager.
-func (im *ingestManagerImpl) RegisterIngester(ingester Ingester) {
-	im.ingesters = append(im.ingesters, ingester)
+func (im *ingestManagerImpl) RegisterIngester(ingester Ingester, queueConfig QueueConfig) error {
+	if err := queueConfig.Validate(); err != nil {
+		return err
+	}
+
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	worker := newIngesterWorker(ingester, queueConfig, im.deadLetterLog)
+	go worker.run()
+
+	im.workers = append(im.workers, worker)
+	return nil
 }
 
 // ProcessBlock implements IngestManager.
 func (im *ingestManagerImpl) ProcessBlock(ctx sdk.Context) {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if im.closed {
+		return
+	}
+
+	// Ingesters must be set in the app. If not, we do nothing.
+	for _, worker := range im.workers {
+		im.processBlock(ctx, worker)
+	}
+}
+
+// processBlock snapshots the block data of the worker ingester and queues it.
+// A panic in one ingester does not prevent the other ingesters from processing the block.
+func (im *ingestManagerImpl) processBlock(ctx sdk.Context, worker *ingesterWorker) {
+	ingesterName := worker.ingester.GetName()
+
 	defer func() {
 		if r := recover(); r != nil {
 			// Panics are silently logged and ignored.
-			ctx.Logger().Error("panic while processing block during ingest", "err", r)
+			ctx.Logger().Error("panic while processing block during ingest", "err", r, "ingester", ingesterName)
+			incrErrorCounter(ingesterName)
+			recordDeadLetter(ctx.Logger(), im.deadLetterLog, ingesterName, ctx.BlockHeight(), fmt.Errorf("panic while processing block: %v", r))
 		}
 	}()
 
-	// Ingesters must be set in the app. If not, we do nothing.
-	for _, ingester := range im.ingesters {
-		if err := ingester.ProcessBlock(ctx); err != nil {
-			// The error is silently logged and ignored.
-			ctx.Logger().Error("error processing block during ingest", "err", err, "ingester", ingester.GetName())
+	blockUpdate, err := worker.ingester.ProcessBlock(ctx)
+	if err != nil {
+		// The error is silently logged and ignored.
+		ctx.Logger().Error("error processing block during ingest", "err", err, "ingester", ingesterName)
+		incrErrorCounter(ingesterName)
+		recordDeadLetter(ctx.Logger(), im.deadLetterLog, ingesterName, ctx.BlockHeight(), err)
+		return
+	}
+
+	if blockUpdate == nil {
+		return
+	}
+
+	worker.enqueue(queuedBlockUpdate{
+		height:      ctx.BlockHeight(),
+		logger:      ctx.Logger(),
+		blockUpdate: blockUpdate,
+	})
+}
+
+// ReplayBlock implements IngestManager.
+func (im *ingestManagerImpl) ReplayBlock(ctx sdk.Context) error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	var errs []error
+	for _, worker := range im.workers {
+		ingesterName := worker.ingester.GetName()
+
+		if err := replayBlock(ctx, worker.ingester); err != nil {
+			errs = append(errs, fmt.Errorf("ingester %s failed to replay block %d: %w", ingesterName, ctx.BlockHeight(), err))
+			continue
 		}
+
+		if im.deadLetterLog != nil {
+			if err := im.deadLetterLog.Remove(ingesterName, ctx.BlockHeight()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// replayBlock processes the full state of the block and flushes the block update.
+// Panics are converted into errors.
+func replayBlock(ctx sdk.Context, ingester Ingester) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while replaying block: %v", r)
+		}
+	}()
+
+	if validator, ok := ingester.(ReplayValidator); ok {
+		if err := validator.ValidateReplay(ctx); err != nil {
+			return err
+		}
+	}
+
+	if requester, ok := ingester.(FullStateRequester); ok {
+		requester.RequestFullState()
+	}
+
+	blockUpdate, err := ingester.ProcessBlock(ctx)
+	if err != nil || blockUpdate == nil {
+		return err
+	}
+
+	return blockUpdate.Flush(ctx.Context())
+}
+
+// Close implements IngestManager.
+func (im *ingestManagerImpl) Close() error {
+	im.mu.Lock()
+	defer im.mu.Unlock()
+
+	if im.closed {
+		return nil
+	}
+	im.closed = true
+
+	for _, worker := range im.workers {
+		worker.close()
+	}
+
+	if im.deadLetterLog != nil {
+		return im.deadLetterLog.Close()
 	}
+	return nil
 }
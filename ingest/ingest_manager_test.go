@@ -0,0 +1,408 @@
+package ingest_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v22/ingest"
+)
+
+var (
+	errFlush  = errors.New("flush error")
+	errReplay = errors.New("replay error")
+
+	// testQueueConfig retries flushes without a noticeable delay.
+	testQueueConfig = ingest.QueueConfig{
+		Size:              10,
+		OverflowPolicy:    ingest.OverflowPolicyBlock,
+		MaxFlushRetries:   2,
+		FlushRetryBackoff: time.Millisecond,
+	}
+)
+
+// mockIngester is an ingester that records the flushed and skipped heights.
+// If gated, every flush waits until the gate is released.
+type mockIngester struct {
+	name string
+
+	// mergeable makes the ingester return block updates that implement ingest.MergeableBlockUpdate.
+	mergeable bool
+	// flushDelay is the duration of every flush, simulating a slow sink.
+	flushDelay time.Duration
+
+	processErr   error
+	processPanic bool
+	// flushFailures is the number of flushes that fail before the flushes succeed.
+	// Negative means that all flushes fail.
+	flushFailures int
+	// minReplayHeight is the lowest height that can be replayed.
+	minReplayHeight int64
+
+	gate              chan struct{}
+	flushStarted      chan int64
+	mu                sync.Mutex
+	flushed           []int64
+	skippedHeight     []int64
+	fullStateRequests int
+}
+
+// mockBlockUpdate flushes the heights of the block updates merged into it in order.
+type mockBlockUpdate struct {
+	ingester *mockIngester
+	heights  []int64
+}
+
+// mergeableMockBlockUpdate is a mockBlockUpdate that implements ingest.MergeableBlockUpdate.
+type mergeableMockBlockUpdate struct {
+	*mockBlockUpdate
+}
+
+var (
+	_ ingest.Ingester             = &mockIngester{}
+	_ ingest.SkippedUpdateHandler = &mockIngester{}
+	_ ingest.FullStateRequester   = &mockIngester{}
+	_ ingest.ReplayValidator      = &mockIngester{}
+	_ ingest.BlockUpdate          = &mockBlockUpdate{}
+	_ ingest.MergeableBlockUpdate = mergeableMockBlockUpdate{}
+)
+
+func newMockIngester(name string, gated bool) *mockIngester {
+	ingester := &mockIngester{
+		name:         name,
+		flushStarted: make(chan int64, 100),
+	}
+	if gated {
+		ingester.gate = make(chan struct{})
+	}
+	return ingester
+}
+
+func (m *mockIngester) ProcessBlock(ctx sdk.Context) (ingest.BlockUpdate, error) {
+	if m.processPanic {
+		panic("process panic")
+	}
+	if m.processErr != nil {
+		return nil, m.processErr
+	}
+	blockUpdate := &mockBlockUpdate{ingester: m, heights: []int64{ctx.BlockHeight()}}
+	if m.mergeable {
+		return mergeableMockBlockUpdate{blockUpdate}, nil
+	}
+	return blockUpdate, nil
+}
+
+func (m *mockIngester) GetName() string {
+	return m.name
+}
+
+func (m *mockIngester) OnUpdateSkipped(height int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.skippedHeight = append(m.skippedHeight, height)
+}
+
+func (m *mockIngester) RequestFullState() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fullStateRequests++
+}
+
+func (m *mockIngester) ValidateReplay(ctx sdk.Context) error {
+	if ctx.BlockHeight() < m.minReplayHeight {
+		return errReplay
+	}
+	return nil
+}
+
+func (m *mockIngester) getFlushed() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.flushed
+}
+
+func (m *mockIngester) getSkipped() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.skippedHeight
+}
+
+func (m *mockIngester) getFullStateRequests() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fullStateRequests
+}
+
+func (u *mockBlockUpdate) Flush(ctx context.Context) error {
+	u.ingester.flushStarted <- u.heights[len(u.heights)-1]
+	if u.ingester.gate != nil {
+		<-u.ingester.gate
+	}
+	time.Sleep(u.ingester.flushDelay)
+
+	u.ingester.mu.Lock()
+	defer u.ingester.mu.Unlock()
+
+	if u.ingester.flushFailures != 0 {
+		u.ingester.flushFailures--
+		return errFlush
+	}
+
+	u.ingester.flushed = append(u.ingester.flushed, u.heights...)
+	return nil
+}
+
+func (u mergeableMockBlockUpdate) Merge(later ingest.BlockUpdate) bool {
+	laterUpdate, ok := later.(mergeableMockBlockUpdate)
+	if !ok {
+		return false
+	}
+	u.heights = append(u.heights, laterUpdate.heights...)
+	return true
+}
+
+func newBlockCtx(height int64) sdk.Context {
+	return sdk.Context{}.WithLogger(log.NewNopLogger()).WithBlockHeight(height)
+}
+
+// Tests that when the worker is busy flushing the first block update and the queue
+// is full, the overflow policy is applied to the following block updates.
+func TestIngestManager_OverflowPolicy(t *testing.T) {
+	tests := map[string]struct {
+		overflowPolicy ingest.OverflowPolicy
+		mergeable      bool
+
+		expectedFlushed []int64
+		expectedSkipped []int64
+	}{
+		"drop: the new block update is dropped": {
+			overflowPolicy: ingest.OverflowPolicyDrop,
+
+			expectedFlushed: []int64{1, 2},
+			expectedSkipped: []int64{3, 4},
+		},
+		"coalesce: the queued block updates are dropped in favor of the latest": {
+			overflowPolicy: ingest.OverflowPolicyCoalesce,
+
+			expectedFlushed: []int64{1, 4},
+			expectedSkipped: []int64{2, 3},
+		},
+		"coalesce: the queued block updates are merged into the latest if mergeable": {
+			overflowPolicy: ingest.OverflowPolicyCoalesce,
+			mergeable:      true,
+
+			expectedFlushed: []int64{1, 2, 3, 4},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ingester := newMockIngester("mock", true)
+			ingester.mergeable = tc.mergeable
+
+			im := ingest.NewIngestManager(nil)
+			err := im.RegisterIngester(ingester, ingest.QueueConfig{Size: 1, OverflowPolicy: tc.overflowPolicy})
+			require.NoError(t, err)
+
+			// Wait for the worker to start flushing the first block update so that
+			// the following block updates are queued.
+			im.ProcessBlock(newBlockCtx(1))
+			require.Equal(t, int64(1), <-ingester.flushStarted)
+
+			for height := int64(2); height <= 4; height++ {
+				im.ProcessBlock(newBlockCtx(height))
+			}
+
+			close(ingester.gate)
+			require.NoError(t, im.Close())
+
+			require.Equal(t, tc.expectedFlushed, ingester.getFlushed())
+			require.Equal(t, tc.expectedSkipped, ingester.getSkipped())
+
+			// Blocks are ignored after close.
+			im.ProcessBlock(newBlockCtx(5))
+			require.Equal(t, tc.expectedFlushed, ingester.getFlushed())
+		})
+	}
+}
+
+// Tests that with the coalesce policy, a sink that is slower than the blocks does not cause
+// mergeable block updates to be skipped, so no full state is requested and every block is ingested in order.
+func TestIngestManager_CoalesceSlowSink(t *testing.T) {
+	const blocks = 50
+
+	ingester := newMockIngester("mock", false)
+	ingester.mergeable = true
+	ingester.flushDelay = 5 * time.Millisecond
+
+	im := ingest.NewIngestManager(nil)
+	err := im.RegisterIngester(ingester, ingest.QueueConfig{Size: 2, OverflowPolicy: ingest.OverflowPolicyCoalesce})
+	require.NoError(t, err)
+
+	expectedFlushed := make([]int64, 0, blocks)
+	for height := int64(1); height <= blocks; height++ {
+		im.ProcessBlock(newBlockCtx(height))
+		expectedFlushed = append(expectedFlushed, height)
+	}
+
+	require.NoError(t, im.Close())
+
+	require.Equal(t, expectedFlushed, ingester.getFlushed())
+	require.Empty(t, ingester.getSkipped())
+	require.Zero(t, ingester.getFullStateRequests())
+
+	// Fewer flushes than blocks show that the block updates were merged.
+	require.Less(t, len(ingester.flushStarted), blocks)
+}
+
+// Tests that with the block policy, all block updates are flushed in order.
+func TestIngestManager_BlockPolicy(t *testing.T) {
+	ingester := newMockIngester("mock", false)
+
+	im := ingest.NewIngestManager(nil)
+	err := im.RegisterIngester(ingester, ingest.QueueConfig{Size: 1, OverflowPolicy: ingest.OverflowPolicyBlock})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 5; height++ {
+		im.ProcessBlock(newBlockCtx(height))
+	}
+
+	require.NoError(t, im.Close())
+
+	require.Equal(t, []int64{1, 2, 3, 4, 5}, ingester.getFlushed())
+	require.Empty(t, ingester.getSkipped())
+}
+
+// Tests that errors and panics of one ingester are ignored and
+// do not prevent other ingesters from ingesting the block.
+func TestIngestManager_Errors(t *testing.T) {
+	var (
+		processErrIngester   = newMockIngester("process-error", false)
+		processPanicIngester = newMockIngester("process-panic", false)
+		flushErrIngester     = newMockIngester("flush-error", false)
+		validIngester        = newMockIngester("valid", false)
+	)
+	processErrIngester.processErr = errors.New("process error")
+	processPanicIngester.processPanic = true
+	flushErrIngester.flushFailures = -1
+
+	deadLetterLog := ingest.NewDeadLetterLog(t.TempDir())
+	defer deadLetterLog.Close()
+
+	im := ingest.NewIngestManager(deadLetterLog)
+	for _, ingester := range []*mockIngester{processErrIngester, processPanicIngester, flushErrIngester, validIngester} {
+		err := im.RegisterIngester(ingester, testQueueConfig)
+		require.NoError(t, err)
+	}
+
+	im.ProcessBlock(newBlockCtx(1))
+	require.NoError(t, im.Close())
+
+	require.Empty(t, processErrIngester.getFlushed())
+	require.Empty(t, processPanicIngester.getFlushed())
+
+	// Failed flushes are retried and then skipped.
+	require.Empty(t, flushErrIngester.getFlushed())
+	require.Equal(t, []int64{1}, flushErrIngester.getSkipped())
+
+	require.Equal(t, []int64{1}, validIngester.getFlushed())
+
+	// The blocks of the failed ingesters are recorded in the dead-letter log.
+	deadLetters, err := deadLetterLog.GetAll()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 3)
+	require.Equal(t, []string{flushErrIngester.name, processErrIngester.name, processPanicIngester.name},
+		[]string{deadLetters[0].Ingester, deadLetters[1].Ingester, deadLetters[2].Ingester})
+	for _, deadLetter := range deadLetters {
+		require.Equal(t, int64(1), deadLetter.Height)
+		require.NotEmpty(t, deadLetter.Error)
+	}
+}
+
+// Tests that transient flush failures are retried until the flush succeeds.
+func TestIngestManager_FlushRetry(t *testing.T) {
+	ingester := newMockIngester("mock", false)
+	ingester.flushFailures = testQueueConfig.MaxFlushRetries
+
+	deadLetterLog := ingest.NewDeadLetterLog(t.TempDir())
+	defer deadLetterLog.Close()
+
+	im := ingest.NewIngestManager(deadLetterLog)
+	err := im.RegisterIngester(ingester, testQueueConfig)
+	require.NoError(t, err)
+
+	im.ProcessBlock(newBlockCtx(1))
+	require.NoError(t, im.Close())
+
+	require.Equal(t, []int64{1}, ingester.getFlushed())
+	require.Empty(t, ingester.getSkipped())
+
+	deadLetters, err := deadLetterLog.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+// Tests that replaying a block requests the full state, flushes the block update synchronously
+// and removes the dead letters of the ingesters that succeed.
+// Ingesters that cannot take the block are not replayed.
+func TestIngestManager_ReplayBlock(t *testing.T) {
+	var (
+		validIngester    = newMockIngester("valid", false)
+		failedIngester   = newMockIngester("failed", false)
+		rejectedIngester = newMockIngester("rejected", false)
+	)
+	failedIngester.flushFailures = -1
+	rejectedIngester.minReplayHeight = 3
+
+	ingesters := []*mockIngester{validIngester, failedIngester, rejectedIngester}
+
+	deadLetterLog := ingest.NewDeadLetterLog(t.TempDir())
+	defer deadLetterLog.Close()
+	for _, ingester := range ingesters {
+		err := deadLetterLog.Record(ingest.DeadLetter{Ingester: ingester.name, Height: 2, Error: "error"})
+		require.NoError(t, err)
+	}
+
+	im := ingest.NewIngestManager(deadLetterLog)
+	for _, ingester := range ingesters {
+		err := im.RegisterIngester(ingester, testQueueConfig)
+		require.NoError(t, err)
+	}
+
+	err := im.ReplayBlock(newBlockCtx(2))
+	require.ErrorIs(t, err, errFlush)
+	require.ErrorIs(t, err, errReplay)
+
+	require.Equal(t, []int64{2}, validIngester.getFlushed())
+	require.Equal(t, 1, validIngester.fullStateRequests)
+	require.Equal(t, 1, failedIngester.fullStateRequests)
+	require.Zero(t, rejectedIngester.fullStateRequests)
+	require.Empty(t, rejectedIngester.getFlushed())
+
+	deadLetters, err := deadLetterLog.GetAll()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, failedIngester.name, deadLetters[0].Ingester)
+	require.Equal(t, rejectedIngester.name, deadLetters[1].Ingester)
+
+	require.NoError(t, im.Close())
+}
+
+func TestQueueConfig_Validate(t *testing.T) {
+	require.NoError(t, ingest.DefaultQueueConfig.Validate())
+
+	err := ingest.QueueConfig{Size: 0, OverflowPolicy: ingest.OverflowPolicyDrop}.Validate()
+	require.Error(t, err)
+
+	err = ingest.QueueConfig{Size: 1, OverflowPolicy: "unknown"}.Validate()
+	require.Error(t, err)
+
+	err = ingest.NewIngestManager(nil).RegisterIngester(newMockIngester("mock", false), ingest.QueueConfig{})
+	require.Error(t, err)
+}
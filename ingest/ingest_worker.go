@@ -0,0 +1,293 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// OverflowPolicy defines what happens when a block update is queued while the ingester queue is full.
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock blocks the block path until the queue has capacity.
+	// No block update is lost but slow sink writes slow down the block commit.
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDrop drops the new block update.
+	OverflowPolicyDrop OverflowPolicy = "drop"
+	// OverflowPolicyCoalesce merges all queued block updates into the new one and queues the result
+	// so that the ingester catches up to the latest height. The queued block updates that do not
+	// implement MergeableBlockUpdate are dropped instead.
+	OverflowPolicyCoalesce OverflowPolicy = "coalesce"
+)
+
+const (
+	metricsKeyIngest         = "ingest"
+	metricsKeyLagBlocks      = "lag_blocks"
+	metricsKeyErrors         = "errors"
+	metricsKeySkippedUpdates = "skipped_updates"
+	metricsKeyMergedUpdates  = "merged_updates"
+	metricsKeyFlushRetries   = "flush_retries"
+	metricsKeyDeadLetters    = "dead_letters"
+	metricsLabelIngester     = "ingester"
+
+	// maxFlushRetryBackoff caps the exponential backoff between flush retries.
+	maxFlushRetryBackoff = time.Minute
+)
+
+// QueueConfig defines the config of an ingester queue.
+type QueueConfig struct {
+	// Size is the maximum number of block updates waiting to be flushed.
+	Size int
+	// OverflowPolicy defines what happens when a block update is queued while the queue is full.
+	OverflowPolicy OverflowPolicy
+	// MaxFlushRetries is the number of times a failed flush is retried before
+	// the block is recorded in the dead-letter log.
+	MaxFlushRetries int
+	// FlushRetryBackoff is the delay before the first flush retry.
+	// The delay doubles with every retry.
+	FlushRetryBackoff time.Duration
+}
+
+// DefaultQueueConfig is the default ingester queue config.
+var DefaultQueueConfig = QueueConfig{
+	Size:              10,
+	OverflowPolicy:    OverflowPolicyCoalesce,
+	MaxFlushRetries:   3,
+	FlushRetryBackoff: 100 * time.Millisecond,
+}
+
+// Validate returns error if the queue config is invalid.
+func (c QueueConfig) Validate() error {
+	if c.Size <= 0 {
+		return fmt.Errorf("ingest queue size must be positive, got %d", c.Size)
+	}
+
+	if c.MaxFlushRetries < 0 {
+		return fmt.Errorf("ingest max flush retries must not be negative, got %d", c.MaxFlushRetries)
+	}
+
+	if c.FlushRetryBackoff < 0 {
+		return fmt.Errorf("ingest flush retry backoff must not be negative, got %s", c.FlushRetryBackoff)
+	}
+
+	switch c.OverflowPolicy {
+	case OverflowPolicyBlock, OverflowPolicyDrop, OverflowPolicyCoalesce:
+		return nil
+	default:
+		return fmt.Errorf("unknown ingest queue overflow policy %q, expected one of %q, %q or %q", c.OverflowPolicy, OverflowPolicyBlock, OverflowPolicyDrop, OverflowPolicyCoalesce)
+	}
+}
+
+// queuedBlockUpdate is a block update waiting to be flushed.
+type queuedBlockUpdate struct {
+	height      int64
+	logger      log.Logger
+	blockUpdate BlockUpdate
+}
+
+// ingesterWorker flushes the block updates of an ingester in the order of heights.
+// Block updates are queued from the block path and flushed by a dedicated goroutine.
+type ingesterWorker struct {
+	ingester          Ingester
+	overflowPolicy    OverflowPolicy
+	maxFlushRetries   int
+	flushRetryBackoff time.Duration
+	deadLetterLog     DeadLetterLog
+
+	queue chan queuedBlockUpdate
+	done  chan struct{}
+
+	// latestQueuedHeight is the height of the latest block update queued.
+	latestQueuedHeight atomic.Int64
+	// latestFlushedHeight is the height of the latest block update flushed successfully.
+	latestFlushedHeight atomic.Int64
+}
+
+// newIngesterWorker creates a new worker for the given ingester.
+// Blocks that fail to be flushed are recorded in the dead-letter log unless it is nil.
+// The caller must start the worker by calling run in a separate goroutine.
+func newIngesterWorker(ingester Ingester, queueConfig QueueConfig, deadLetterLog DeadLetterLog) *ingesterWorker {
+	return &ingesterWorker{
+		ingester:          ingester,
+		overflowPolicy:    queueConfig.OverflowPolicy,
+		maxFlushRetries:   queueConfig.MaxFlushRetries,
+		flushRetryBackoff: queueConfig.FlushRetryBackoff,
+		deadLetterLog:     deadLetterLog,
+		queue:             make(chan queuedBlockUpdate, queueConfig.Size),
+		done:              make(chan struct{}),
+	}
+}
+
+// enqueue queues the block update according to the overflow policy.
+// Must only be called from the block path.
+func (w *ingesterWorker) enqueue(update queuedBlockUpdate) {
+	w.latestQueuedHeight.Store(update.height)
+	defer w.reportLag()
+
+	switch w.overflowPolicy {
+	case OverflowPolicyBlock:
+		w.queue <- update
+	case OverflowPolicyDrop:
+		select {
+		case w.queue <- update:
+		default:
+			w.skip(update, "queue is full")
+		}
+	case OverflowPolicyCoalesce:
+		select {
+		case w.queue <- update:
+			return
+		default:
+		}
+
+		// The block path is the only producer so the queue has capacity once drained.
+		// The queued block updates are merged in the order of heights so that the later data wins.
+		var coalesced *queuedBlockUpdate
+		for drained := false; !drained; {
+			select {
+			case queued := <-w.queue:
+				coalesced = w.coalesce(coalesced, queued)
+			default:
+				drained = true
+			}
+		}
+
+		w.queue <- *w.coalesce(coalesced, update)
+	}
+}
+
+// coalesce merges the later block update into the earlier one and returns the result at the later height.
+// If the earlier block update is not mergeable or fails to merge, it is skipped and the later one is returned.
+func (w *ingesterWorker) coalesce(earlier *queuedBlockUpdate, later queuedBlockUpdate) *queuedBlockUpdate {
+	if earlier == nil {
+		return &later
+	}
+
+	if mergeable, ok := earlier.blockUpdate.(MergeableBlockUpdate); ok && mergeable.Merge(later.blockUpdate) {
+		telemetry.IncrCounterWithLabels([]string{metricsKeyIngest, metricsKeyMergedUpdates}, 1, ingesterLabels(w.ingester.GetName()))
+		return &queuedBlockUpdate{
+			height:      later.height,
+			logger:      later.logger,
+			blockUpdate: mergeable,
+		}
+	}
+
+	w.skip(*earlier, "coalesced into a later block update")
+	return &later
+}
+
+// run flushes the queued block updates until the queue is closed.
+func (w *ingesterWorker) run() {
+	defer close(w.done)
+
+	for update := range w.queue {
+		w.flush(update)
+	}
+}
+
+// flush flushes the block update into the sink.
+// Failed flushes are retried with exponential backoff. Once the retries are exhausted,
+// the block is recorded in the dead-letter log and the block update is skipped.
+func (w *ingesterWorker) flush(update queuedBlockUpdate) {
+	ingesterName := w.ingester.GetName()
+	backoff := w.flushRetryBackoff
+
+	for retry := 0; ; retry++ {
+		err := tryFlush(update.blockUpdate)
+		if err == nil {
+			w.latestFlushedHeight.Store(update.height)
+			w.reportLag()
+			return
+		}
+
+		update.logger.Error("error flushing block update during ingest", "err", err, "ingester", ingesterName, "height", update.height, "retry", retry)
+		incrErrorCounter(ingesterName)
+
+		if retry >= w.maxFlushRetries {
+			recordDeadLetter(update.logger, w.deadLetterLog, ingesterName, update.height, err)
+			w.skip(update, "flush failed")
+			return
+		}
+
+		telemetry.IncrCounterWithLabels([]string{metricsKeyIngest, metricsKeyFlushRetries}, 1, ingesterLabels(ingesterName))
+		time.Sleep(backoff)
+		backoff = min(2*backoff, maxFlushRetryBackoff)
+	}
+}
+
+// tryFlush flushes the block update and converts panics into errors.
+func tryFlush(blockUpdate BlockUpdate) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while flushing block update: %v", r)
+		}
+	}()
+
+	return blockUpdate.Flush(context.Background())
+}
+
+// skip records that the block update is not ingested and notifies the ingester
+// if it implements SkippedUpdateHandler.
+func (w *ingesterWorker) skip(update queuedBlockUpdate, reason string) {
+	ingesterName := w.ingester.GetName()
+
+	update.logger.Info("skipping block update during ingest", "reason", reason, "ingester", ingesterName, "height", update.height)
+	telemetry.IncrCounterWithLabels([]string{metricsKeyIngest, metricsKeySkippedUpdates}, 1, ingesterLabels(ingesterName))
+
+	if handler, ok := w.ingester.(SkippedUpdateHandler); ok {
+		handler.OnUpdateSkipped(update.height)
+	}
+}
+
+// reportLag reports the number of blocks between the latest queued and the latest flushed block updates.
+func (w *ingesterWorker) reportLag() {
+	lag := w.latestQueuedHeight.Load() - w.latestFlushedHeight.Load()
+	if lag < 0 {
+		lag = 0
+	}
+	telemetry.SetGaugeWithLabels([]string{metricsKeyIngest, metricsKeyLagBlocks}, float32(lag), ingesterLabels(w.ingester.GetName()))
+}
+
+// close closes the queue and waits for the queued block updates to be flushed.
+// Must only be called once, after the last enqueue.
+func (w *ingesterWorker) close() {
+	close(w.queue)
+	<-w.done
+}
+
+// recordDeadLetter records the block that the ingester failed to ingest in the dead-letter log.
+// Errors are logged and ignored. Does nothing if the dead-letter log is nil.
+func recordDeadLetter(logger log.Logger, deadLetterLog DeadLetterLog, ingesterName string, height int64, ingestErr error) {
+	if deadLetterLog == nil {
+		return
+	}
+
+	err := deadLetterLog.Record(DeadLetter{
+		Ingester: ingesterName,
+		Height:   height,
+		Error:    ingestErr.Error(),
+		Time:     time.Now().UTC(),
+	})
+	if err != nil {
+		logger.Error("error recording dead letter during ingest", "err", err, "ingester", ingesterName, "height", height)
+		return
+	}
+
+	telemetry.IncrCounterWithLabels([]string{metricsKeyIngest, metricsKeyDeadLetters}, 1, ingesterLabels(ingesterName))
+}
+
+// incrErrorCounter increments the error counter of the given ingester.
+func incrErrorCounter(ingesterName string) {
+	telemetry.IncrCounterWithLabels([]string{metricsKeyIngest, metricsKeyErrors}, 1, ingesterLabels(ingesterName))
+}
+
+// ingesterLabels returns the telemetry labels of the given ingester.
+func ingesterLabels(ingesterName string) []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel(metricsLabelIngester, ingesterName)}
+}
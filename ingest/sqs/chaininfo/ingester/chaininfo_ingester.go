@@ -1,44 +1,103 @@
 package chaininfoingester
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"fmt"
 
-	"github.com/osmosis-labs/sqs/sqsdomain/repository"
-	chaininforedisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/chaininfo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
 )
 
 // chainInfoIngester is an ingester for blockchain information.
 // It implements ingest.Ingester.
-// It reads the latest blockchain height and writes it to the chainInfo repository.
+// It reads the latest blockchain height, the block time, the fee market state
+// and the fee tokens and writes them to the chainInfo repository.
 type chainInfoIngester struct {
-	chainInfoRepo     chaininforedisrepo.ChainInfoRepository
-	repositoryManager repository.TxManager
+	chainInfoRepo     domain.ChainInfoRepository
+	repositoryManager domain.TxManager
+	txFeesKeeper      domain.TxFeesKeeper
+	feeMarket         domain.FeeMarket
 }
 
 // New returns a new chain information ingester.
-func New(chainInfoRepo chaininforedisrepo.ChainInfoRepository, repositoryManager repository.TxManager) domain.AtomicIngester {
+// feeMarket must be the EIP-1559 state that is updated by the txfees module.
+func New(chainInfoRepo domain.ChainInfoRepository, repositoryManager domain.TxManager, txFeesKeeper domain.TxFeesKeeper, feeMarket domain.FeeMarket) domain.AtomicIngester {
 	return &chainInfoIngester{
 		chainInfoRepo:     chainInfoRepo,
 		repositoryManager: repositoryManager,
+		txFeesKeeper:      txFeesKeeper,
+		feeMarket:         feeMarket,
 	}
 }
 
 // ProcessBlock implements ingest.Ingester.
-// It reads the latest blockchain height and stores it in Redis.
-func (ci *chainInfoIngester) ProcessBlock(ctx sdk.Context, tx repository.Tx) error {
+// It reads the latest blockchain height and the chain information and stores them in the sink.
+// The base fee and the gas wanted are read from the base fee history for the height of the block
+// so that they are the ones that were in effect for the block, also when replaying historical blocks.
+func (ci *chainInfoIngester) ProcessBlock(ctx sdk.Context, tx domain.Tx) error {
 	height := ctx.BlockHeight()
 
 	ctx.Logger().Info("ingesting latest blockchain height", "height", height)
 
-	err := ci.chainInfoRepo.StoreLatestHeight(sdk.WrapSDKContext(ctx), tx, uint64(height))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	err := ci.chainInfoRepo.StoreLatestHeight(goCtx, tx, uint64(height))
 	if err != nil {
 		ctx.Logger().Error("failed to ingest latest blockchain height", "error", err)
 		return err
 	}
 
+	chainInfo, err := ci.getChainInfo(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to read chain info", "error", err)
+		return err
+	}
+
+	if err := ci.chainInfoRepo.StoreChainInfo(goCtx, tx, chainInfo); err != nil {
+		ctx.Logger().Error("failed to ingest chain info", "error", err)
+		return err
+	}
+
 	return nil
 }
 
+// getChainInfo returns the chain information of the current block.
+// Fee tokens whose spot price cannot be computed are logged and skipped
+// so that a single broken fee token pool does not prevent the ingestion.
+func (ci *chainInfoIngester) getChainInfo(ctx sdk.Context) (domain.ChainInfo, error) {
+	baseDenom, err := ci.txFeesKeeper.GetBaseDenom(ctx)
+	if err != nil {
+		return domain.ChainInfo{}, err
+	}
+
+	feeTokens := []domain.FeeToken{}
+	for _, feeToken := range ci.txFeesKeeper.GetFeeTokens(ctx) {
+		spotPrice, err := ci.txFeesKeeper.CalcFeeSpotPrice(ctx, feeToken.Denom)
+		if err != nil {
+			ctx.Logger().Error("failed to compute fee token spot price", "denom", feeToken.Denom, "pool_id", feeToken.PoolID, "error", err)
+			continue
+		}
+
+		feeTokens = append(feeTokens, domain.FeeToken{
+			Denom:     feeToken.Denom,
+			PoolID:    feeToken.PoolID,
+			SpotPrice: spotPrice,
+		})
+	}
+
+	feeMarketEntry, found := ci.feeMarket.GetBaseFeeHistoryEntry(ctx.BlockHeight())
+	if !found {
+		return domain.ChainInfo{}, fmt.Errorf("fee market state of block %d not found in the base fee history", ctx.BlockHeight())
+	}
+
+	return domain.ChainInfo{
+		Height:         uint64(ctx.BlockHeight()),
+		BlockTime:      ctx.BlockTime().UTC(),
+		BaseFee:        feeMarketEntry.BaseFee,
+		TotalGasWanted: feeMarketEntry.GasWanted,
+		FeeBaseDenom:   baseDenom,
+		FeeTokens:      feeTokens,
+	}, nil
+}
+
 var _ domain.AtomicIngester = &chainInfoIngester{}
@@ -0,0 +1,134 @@
+package chaininfoingester_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+	chaininfoingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/chaininfo/ingester"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	memorysink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/memory"
+	mempool1559 "github.com/osmosis-labs/osmosis/v22/x/txfees/keeper/mempool-1559"
+	txfeestypes "github.com/osmosis-labs/osmosis/v22/x/txfees/types"
+)
+
+type ChainInfoIngesterTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+// feeMarketMock is a fee market with a fixed state for a single block.
+type feeMarketMock struct {
+	height         int64
+	baseFee        osmomath.Dec
+	totalGasWanted int64
+}
+
+var _ domain.FeeMarket = feeMarketMock{}
+
+func (m feeMarketMock) GetBaseFeeHistoryEntry(height int64) (mempool1559.BaseFeeHistoryEntry, bool) {
+	if height != m.height {
+		return mempool1559.BaseFeeHistoryEntry{}, false
+	}
+	return mempool1559.BaseFeeHistoryEntry{Height: height, BaseFee: m.baseFee, GasWanted: m.totalGasWanted}, true
+}
+
+func TestChainInfoIngesterTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainInfoIngesterTestSuite))
+}
+
+// Tests that the latest height, block time, fee market state and fee tokens
+// are written into the same transaction and only persisted on Exec.
+func (s *ChainInfoIngesterTestSuite) TestProcessBlock() {
+	s.Setup()
+
+	var (
+		blockTime = time.Unix(1_700_000_000, 0).UTC()
+		feeMarket = feeMarketMock{
+			height:         10,
+			baseFee:        osmomath.MustNewDecFromStr("0.0025"),
+			totalGasWanted: 1_000_000,
+		}
+	)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+
+	baseDenom, err := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	// 1 uion = 2 base denom
+	uionPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, 2_000_000), sdk.NewInt64Coin("uion", 1_000_000))
+	err = s.App.TxFeesKeeper.SetFeeTokens(s.Ctx, []txfeestypes.FeeToken{{Denom: "uion", PoolID: uionPoolID}})
+	s.Require().NoError(err)
+
+	expectedSpotPrice, err := s.App.TxFeesKeeper.CalcFeeSpotPrice(s.Ctx, "uion")
+	s.Require().NoError(err)
+
+	sink := memorysink.New()
+	ingester := chaininfoingester.New(sink, sink, s.App.TxFeesKeeper, feeMarket)
+
+	tx := sink.StartTx()
+	err = ingester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+
+	// Nothing is written before Exec.
+	latestHeight, err := sink.GetLatestHeight(sdk.WrapSDKContext(s.Ctx))
+	s.Require().NoError(err)
+	s.Require().Zero(latestHeight)
+	s.Require().Equal(domain.ChainInfo{}, sink.GetChainInfo())
+
+	s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+
+	latestHeight, err = sink.GetLatestHeight(sdk.WrapSDKContext(s.Ctx))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), latestHeight)
+	s.Require().Equal(domain.ChainInfo{
+		Height:         10,
+		BlockTime:      blockTime,
+		BaseFee:        feeMarket.baseFee,
+		TotalGasWanted: feeMarket.totalGasWanted,
+		FeeBaseDenom:   baseDenom,
+		FeeTokens: []domain.FeeToken{
+			{Denom: "uion", PoolID: uionPoolID, SpotPrice: expectedSpotPrice},
+		},
+	}, sink.GetChainInfo())
+
+	// The fee market state of the block must be in the base fee history.
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.Require().Error(ingester.ProcessBlock(s.Ctx, sink.StartTx()))
+}
+
+// Tests that the chain info ingested after the txfees end blocker publishes the base fee
+// that was in effect for the block rather than the base fee updated for the next block.
+func (s *ChainInfoIngesterTestSuite) TestProcessBlockAfterBaseFeeUpdate() {
+	s.Setup()
+
+	originalEipState := mempool1559.CurEipState.Clone()
+	defer func() {
+		mempool1559.CurEipState = originalEipState
+	}()
+	mempool1559.CurEipState.CurBaseFee = mempool1559.DefaultBaseFee.Clone()
+	mempool1559.CurEipState.BaseFeeHistory = mempool1559.NewBaseFeeHistory(mempool1559.BaseFeeHistorySize)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+
+	// The block does not use any gas so the end blocker lowers the base fee for the next block.
+	mempool1559.BeginBlockCode(s.Ctx)
+	blockBaseFee := mempool1559.CurEipState.GetCurBaseFee()
+	mempool1559.EndBlockCode(s.Ctx)
+	s.Require().True(mempool1559.CurEipState.GetCurBaseFee().LT(blockBaseFee))
+
+	sink := memorysink.New()
+	ingester := chaininfoingester.New(sink, sink, s.App.TxFeesKeeper, &mempool1559.CurEipState)
+
+	tx := sink.StartTx()
+	s.Require().NoError(ingester.ProcessBlock(s.Ctx, tx))
+	s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+
+	chainInfo := sink.GetChainInfo()
+	s.Require().Equal(blockBaseFee, chainInfo.BaseFee)
+	s.Require().Zero(chainInfo.TotalGasWanted)
+}
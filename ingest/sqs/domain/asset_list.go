@@ -1,28 +1,95 @@
 package domain
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"os"
 )
 
 type AssetListGetter interface {
 	GetDenomPrecisions(ctx context.Context) (map[string]int, error)
 }
 
+// AssetListGetterImpl fetches the asset list from the chain registry over the network.
 type AssetListGetterImpl struct{}
 
-var _ AssetListGetter = &AssetListGetterImpl{}
+// fileAssetListGetter reads the asset list from a file.
+type fileAssetListGetter struct {
+	path string
+}
+
+// bundledAssetListGetter parses the asset list bundled with the binary.
+type bundledAssetListGetter struct {
+	assetListBz []byte
+}
+
+var (
+	_ AssetListGetter = &AssetListGetterImpl{}
+	_ AssetListGetter = &fileAssetListGetter{}
+	_ AssetListGetter = &bundledAssetListGetter{}
+)
+
+var errEmptyBundledAssetList = errors.New("bundled asset list is empty")
 
 func NewAssetListGetter() AssetListGetter {
 	return &AssetListGetterImpl{}
 }
 
+// NewFileAssetListGetter returns an asset list getter that reads the asset list in
+// the chain registry format from the file at the given path.
+func NewFileAssetListGetter(path string) AssetListGetter {
+	return &fileAssetListGetter{
+		path: path,
+	}
+}
+
+// NewBundledAssetListGetter returns an asset list getter that parses the given asset list
+// in the chain registry format. It is meant for the asset list bundled with the binary.
+func NewBundledAssetListGetter(assetListBz []byte) AssetListGetter {
+	return &bundledAssetListGetter{
+		assetListBz: assetListBz,
+	}
+}
+
 // GetDenomPrecisions implements AssetListGetter.
 func (*AssetListGetterImpl) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
 	return GetDenomPrecisions(ctx)
 }
 
+// GetDenomPrecisions implements AssetListGetter.
+func (g *fileAssetListGetter) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	file, err := os.Open(g.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	tokensByDenomMap, err := parseTokens(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDenomPrecisionsFromTokens(tokensByDenomMap), nil
+}
+
+// GetDenomPrecisions implements AssetListGetter.
+func (g *bundledAssetListGetter) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	if len(g.assetListBz) == 0 {
+		return nil, errEmptyBundledAssetList
+	}
+
+	tokensByDenomMap, err := parseTokens(bytes.NewReader(g.assetListBz))
+	if err != nil {
+		return nil, err
+	}
+
+	return getDenomPrecisionsFromTokens(tokensByDenomMap), nil
+}
+
 // Token represents the token's domain model
 type Token struct {
 	// ChainDenom is the denom used in the chain state.
@@ -65,12 +132,16 @@ func GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
 		return nil, err
 	}
 
+	return getDenomPrecisionsFromTokens(tokensByDenomMap), nil
+}
+
+// getDenomPrecisionsFromTokens returns a map of precisions by chain denom.
+func getDenomPrecisionsFromTokens(tokensByDenomMap map[string]Token) map[string]int {
 	denomPrecisions := make(map[string]int, len(tokensByDenomMap))
 	for _, token := range tokensByDenomMap {
 		denomPrecisions[token.ChainDenom] = token.Precision
 	}
-
-	return denomPrecisions, nil
+	return denomPrecisions
 }
 
 // getTokensFromChainRegistry fetches the tokens from the chain registry.
@@ -83,9 +154,15 @@ func getTokensFromChainRegistry(chainRegistryAssetsFileURL string) (map[string]T
 	}
 	defer response.Body.Close()
 
+	return parseTokens(response.Body)
+}
+
+// parseTokens parses the asset list in the chain registry format.
+// It returns a map of tokens by chain denom.
+func parseTokens(reader io.Reader) (map[string]Token, error) {
 	// Decode the JSON data
 	var assetList AssetList
-	err = json.NewDecoder(response.Body).Decode(&assetList)
+	err := json.NewDecoder(reader).Decode(&assetList)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankMetadataAssetListGetter reads the denom precisions from the x/bank denom metadata.
+type bankMetadataAssetListGetter struct {
+	bankKeeper BankKeeper
+}
+
+var _ AssetListGetter = &bankMetadataAssetListGetter{}
+
+// NewBankMetadataAssetListGetter returns an asset list getter that reads the denom precisions
+// from the on-chain x/bank denom metadata.
+// The context given to GetDenomPrecisions must wrap an sdk.Context.
+func NewBankMetadataAssetListGetter(bankKeeper BankKeeper) AssetListGetter {
+	return &bankMetadataAssetListGetter{
+		bankKeeper: bankKeeper,
+	}
+}
+
+// GetDenomPrecisions implements AssetListGetter.
+// Denoms without a positive display exponent are skipped.
+func (g *bankMetadataAssetListGetter) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denomPrecisions := make(map[string]int)
+	g.bankKeeper.IterateAllDenomMetaData(sdkCtx, func(metadata banktypes.Metadata) bool {
+		if precision := getPrecisionFromMetadata(metadata); precision > 0 {
+			denomPrecisions[metadata.Base] = precision
+		}
+		return false
+	})
+
+	return denomPrecisions, nil
+}
+
+// getPrecisionFromMetadata returns the exponent of the display denom unit.
+// If the display denom unit is not found, the largest exponent is returned.
+func getPrecisionFromMetadata(metadata banktypes.Metadata) int {
+	maxExponent := uint32(0)
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			return int(denomUnit.Exponent)
+		}
+
+		if denomUnit.Exponent > maxExponent {
+			maxExponent = denomUnit.Exponent
+		}
+	}
+	return int(maxExponent)
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// cachedAssetListGetter caches the denom precisions of the underlying asset list getter
+// and refreshes them once the refresh interval elapses.
+type cachedAssetListGetter struct {
+	source          AssetListGetter
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu              sync.Mutex
+	denomPrecisions map[string]int
+	lastRefreshTime time.Time
+}
+
+// fallbackAssetListGetter merges the denom precisions of the primary asset list getter
+// with the denom precisions of the fallback getters.
+type fallbackAssetListGetter struct {
+	primary   AssetListGetter
+	fallbacks []AssetListGetter
+}
+
+var (
+	_ AssetListGetter = &cachedAssetListGetter{}
+	_ AssetListGetter = &fallbackAssetListGetter{}
+)
+
+// NewCachedAssetListGetter returns an asset list getter that caches the denom precisions
+// of the given source for the refresh interval.
+// If the refresh fails, the stale denom precisions are returned until the next successful refresh.
+func NewCachedAssetListGetter(source AssetListGetter, refreshInterval time.Duration) AssetListGetter {
+	return newCachedAssetListGetter(source, refreshInterval, time.Now)
+}
+
+func newCachedAssetListGetter(source AssetListGetter, refreshInterval time.Duration, now func() time.Time) *cachedAssetListGetter {
+	return &cachedAssetListGetter{
+		source:          source,
+		refreshInterval: refreshInterval,
+		now:             now,
+	}
+}
+
+// NewFallbackAssetListGetter returns an asset list getter that returns the denom precisions
+// of the primary getter. The denoms missing from the primary getter are filled in from the
+// fallback getters in order. If the primary getter fails, only the fallback getters are used.
+func NewFallbackAssetListGetter(primary AssetListGetter, fallbacks ...AssetListGetter) AssetListGetter {
+	return &fallbackAssetListGetter{
+		primary:   primary,
+		fallbacks: fallbacks,
+	}
+}
+
+// GetDenomPrecisions implements AssetListGetter.
+// The returned map is shared between calls and must not be mutated.
+func (g *cachedAssetListGetter) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	if g.denomPrecisions != nil && now.Sub(g.lastRefreshTime) < g.refreshInterval {
+		return g.denomPrecisions, nil
+	}
+
+	denomPrecisions, err := g.source.GetDenomPrecisions(ctx)
+	if err != nil {
+		if g.denomPrecisions != nil {
+			// Serve the stale denom precisions. The refresh is retried at the next call.
+			return g.denomPrecisions, nil
+		}
+		return nil, err
+	}
+
+	g.denomPrecisions = denomPrecisions
+	g.lastRefreshTime = now
+	return denomPrecisions, nil
+}
+
+// GetDenomPrecisions implements AssetListGetter.
+// Returns error if all getters fail.
+func (g *fallbackAssetListGetter) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	primaryDenomPrecisions, primaryErr := g.primary.GetDenomPrecisions(ctx)
+
+	denomPrecisions := make(map[string]int, len(primaryDenomPrecisions))
+	for denom, precision := range primaryDenomPrecisions {
+		denomPrecisions[denom] = precision
+	}
+
+	lastErr := primaryErr
+	hasSucceeded := primaryErr == nil
+	for _, fallback := range g.fallbacks {
+		fallbackDenomPrecisions, err := fallback.GetDenomPrecisions(ctx)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		hasSucceeded = true
+
+		for denom, precision := range fallbackDenomPrecisions {
+			if _, ok := denomPrecisions[denom]; !ok {
+				denomPrecisions[denom] = precision
+			}
+		}
+	}
+
+	if !hasSucceeded {
+		return nil, lastErr
+	}
+
+	return denomPrecisions, nil
+}
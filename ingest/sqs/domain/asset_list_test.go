@@ -1,8 +1,15 @@
 package domain_test
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
@@ -50,3 +57,157 @@ func (s *AssetListTestSuite) TestParseExponents() {
 	s.Require().Equal(defaultCosmosExponent, ibcxToken.Precision)
 	s.Require().Equal(ibcxMainnetDenom, ibcxToken.ChainDenom)
 }
+
+// assetListGetterMock returns the configured denom precisions or error and counts the calls.
+type assetListGetterMock struct {
+	denomPrecisions map[string]int
+	err             error
+	calls           int
+}
+
+func (m *assetListGetterMock) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
+	m.calls++
+	return m.denomPrecisions, m.err
+}
+
+// Tests that the bundled and file asset lists are parsed into token precisions.
+func (s *AssetListTestSuite) TestBundledAndFileAssetList() {
+	const (
+		assetListPath         = "../../../cmd/osmosisd/cmd/osmosis-1-assetlist.json"
+		atomMainnetDenom      = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+		defaultCosmosExponent = 6
+	)
+
+	assetListBz, err := os.ReadFile(assetListPath)
+	s.Require().NoError(err)
+
+	for name, getter := range map[string]domain.AssetListGetter{
+		"bundled": domain.NewBundledAssetListGetter(assetListBz),
+		"file":    domain.NewFileAssetListGetter(assetListPath),
+	} {
+		s.Run(name, func() {
+			denomPrecisions, err := getter.GetDenomPrecisions(context.Background())
+			s.Require().NoError(err)
+
+			s.Require().Equal(defaultCosmosExponent, denomPrecisions["uosmo"])
+			s.Require().Equal(defaultCosmosExponent, denomPrecisions[atomMainnetDenom])
+		})
+	}
+
+	_, err = domain.NewBundledAssetListGetter(nil).GetDenomPrecisions(context.Background())
+	s.Require().Error(err)
+
+	_, err = domain.NewFileAssetListGetter(filepath.Join(s.T().TempDir(), "missing.json")).GetDenomPrecisions(context.Background())
+	s.Require().Error(err)
+}
+
+// Tests that the denom precisions are cached for the refresh interval
+// and that stale denom precisions are served if the refresh fails.
+func (s *AssetListTestSuite) TestCachedAssetListGetter() {
+	const refreshInterval = time.Minute
+
+	var (
+		now    = time.Unix(0, 0)
+		source = &assetListGetterMock{denomPrecisions: map[string]int{"uosmo": 6}}
+		getter = domain.NewCachedAssetListGetterWithClock(source, refreshInterval, func() time.Time { return now })
+	)
+
+	// Initial load.
+	denomPrecisions, err := getter.GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 6}, denomPrecisions)
+	s.Require().Equal(1, source.calls)
+
+	// Cached within the refresh interval.
+	now = now.Add(refreshInterval - time.Second)
+	source.denomPrecisions = map[string]int{"uosmo": 6, "uion": 6}
+	denomPrecisions, err = getter.GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 6}, denomPrecisions)
+	s.Require().Equal(1, source.calls)
+
+	// Refreshed after the refresh interval.
+	now = now.Add(time.Second)
+	denomPrecisions, err = getter.GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 6, "uion": 6}, denomPrecisions)
+	s.Require().Equal(2, source.calls)
+
+	// Stale denom precisions are served if the refresh fails and the refresh is retried at the next call.
+	now = now.Add(refreshInterval)
+	source.err = errors.New("source error")
+	denomPrecisions, err = getter.GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 6, "uion": 6}, denomPrecisions)
+
+	_, err = getter.GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(4, source.calls)
+
+	// Error if the initial load fails.
+	_, err = domain.NewCachedAssetListGetter(source, refreshInterval).GetDenomPrecisions(context.Background())
+	s.Require().Error(err)
+}
+
+// Tests that the primary denom precisions take precedence over the fallbacks
+// and that the fallbacks are used if the primary getter fails.
+func (s *AssetListTestSuite) TestFallbackAssetListGetter() {
+	var (
+		primary  = &assetListGetterMock{denomPrecisions: map[string]int{"uosmo": 6}}
+		fallback = &assetListGetterMock{denomPrecisions: map[string]int{"uosmo": 8, "uion": 6}}
+		failing  = &assetListGetterMock{err: errors.New("failing")}
+	)
+
+	denomPrecisions, err := domain.NewFallbackAssetListGetter(primary, failing, fallback).GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 6, "uion": 6}, denomPrecisions)
+
+	// The primary denom precisions are not mutated.
+	s.Require().Equal(map[string]int{"uosmo": 6}, primary.denomPrecisions)
+
+	denomPrecisions, err = domain.NewFallbackAssetListGetter(failing, fallback).GetDenomPrecisions(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int{"uosmo": 8, "uion": 6}, denomPrecisions)
+
+	_, err = domain.NewFallbackAssetListGetter(failing, failing).GetDenomPrecisions(context.Background())
+	s.Require().Error(err)
+}
+
+// Tests that the denom precisions are read from the x/bank denom metadata.
+func (s *AssetListTestSuite) TestBankMetadataAssetListGetter() {
+	s.Setup()
+
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, banktypes.Metadata{
+		Base:    "ufoo",
+		Display: "foo",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ufoo", Exponent: 0},
+			{Denom: "mfoo", Exponent: 3},
+			{Denom: "foo", Exponent: 6},
+		},
+	})
+	// No display denom unit, the largest exponent is used.
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, banktypes.Metadata{
+		Base:    "ubar",
+		Display: "unknown",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ubar", Exponent: 0},
+			{Denom: "bar", Exponent: 8},
+		},
+	})
+	// No positive exponent, skipped.
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, banktypes.Metadata{
+		Base:    "baz",
+		Display: "baz",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "baz", Exponent: 0},
+		},
+	})
+
+	denomPrecisions, err := domain.NewBankMetadataAssetListGetter(s.App.BankKeeper).GetDenomPrecisions(sdk.WrapSDKContext(s.Ctx))
+	s.Require().NoError(err)
+
+	s.Require().Equal(6, denomPrecisions["ufoo"])
+	s.Require().Equal(8, denomPrecisions["ubar"])
+	s.Require().NotContains(denomPrecisions, "baz")
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	mempool1559 "github.com/osmosis-labs/osmosis/v22/x/txfees/keeper/mempool-1559"
+)
+
+// ChainInfo is the information about the latest ingested block
+// that the sidecar query server and front-ends need to price transaction fees.
+type ChainInfo struct {
+	// Height is the height of the block.
+	Height uint64 `json:"height"`
+	// BlockTime is the timestamp of the block header.
+	BlockTime time.Time `json:"block_time"`
+	// BaseFee is the EIP-1559 base fee in the fee base denom that was in effect for the block.
+	BaseFee osmomath.Dec `json:"base_fee"`
+	// TotalGasWanted is the sum of the gas wanted by the transactions of the block.
+	TotalGasWanted int64 `json:"total_gas_wanted"`
+	// FeeBaseDenom is the denom that the base fee and the fee token spot prices are denominated in.
+	FeeBaseDenom string `json:"fee_base_denom"`
+	// FeeTokens are the tokens other than the fee base denom that are accepted as transaction fees.
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// FeeToken is a token accepted as transaction fees with its spot price.
+type FeeToken struct {
+	Denom  string `json:"denom"`
+	PoolID uint64 `json:"pool_id"`
+	// SpotPrice is the price of one unit of the fee token in the fee base denom
+	// in the pool that the fee token is converted with.
+	SpotPrice osmomath.BigDec `json:"spot_price"`
+}
+
+// FeeMarket is an interface for reading the EIP-1559 fee market state.
+type FeeMarket interface {
+	// GetBaseFeeHistoryEntry returns the base fee that was in effect for the block at the given height
+	// and the gas wanted by its transactions.
+	// Returns false if the block is not in the base fee history.
+	GetBaseFeeHistoryEntry(height int64) (mempool1559.BaseFeeHistoryEntry, bool)
+}
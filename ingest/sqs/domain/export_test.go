@@ -1,5 +1,11 @@
 package domain
 
+import "time"
+
 func GetTokensFromChainRegistry(chainRegistryAssetsFileURL string) (map[string]Token, error) {
 	return getTokensFromChainRegistry(chainRegistryAssetsFileURL)
 }
+
+func NewCachedAssetListGetterWithClock(source AssetListGetter, refreshInterval time.Duration, now func() time.Time) AssetListGetter {
+	return newCachedAssetListGetter(source, refreshInterval, now)
+}
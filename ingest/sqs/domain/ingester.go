@@ -2,8 +2,6 @@ package domain
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	"github.com/osmosis-labs/sqs/sqsdomain/repository"
 )
 
 // AtomicIngester is an interface that defines the methods for the atomic ingester.
@@ -13,5 +11,14 @@ type AtomicIngester interface {
 	// ProcessBlock processes the block by writing data into a transaction.
 	// Returns error if fails to process.
 	// It does not flush data to sink. The caller must call Exec on the transaction
-	ProcessBlock(ctx sdk.Context, tx repository.Tx) error
+	ProcessBlock(ctx sdk.Context, tx Tx) error
+}
+
+// FullResyncRequester is an interface for atomic ingesters that only process
+// the data updated within a block.
+type FullResyncRequester interface {
+	// RequestFullResync requests the full state to be processed at the next block.
+	// It is called when the data processed at a previous block was not flushed to sink.
+	// Must be safe for concurrent use with ProcessBlock.
+	RequestFullResync()
 }
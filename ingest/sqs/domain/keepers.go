@@ -2,11 +2,13 @@ package domain
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 
 	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v22/x/txfees/types"
 )
 
 // Chain keepers required for sqs ingest.
@@ -17,6 +19,7 @@ type SQSIngestKeepers struct {
 	ProtorevKeeper     ProtorevKeeper
 	PoolManagerKeeper  PoolManagerKeeper
 	ConcentratedKeeper ConcentratedKeeper
+	TxFeesKeeper       TxFeesKeeper
 }
 
 // PoolKeeper is an interface for getting pools from a keeper.
@@ -29,9 +32,10 @@ type CosmWasmPoolKeeper interface {
 	GetPoolsWithWasmKeeper(ctx sdk.Context) ([]poolmanagertypes.PoolI, error)
 }
 
-// BankKeeper is an interface for getting bank balances.
+// BankKeeper is an interface for getting bank balances and denom metadata.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(banktypes.Metadata) bool)
 }
 
 // ProtorevKeeper is an interface for getting the pool for a denom pair.
@@ -64,6 +68,11 @@ type PoolManagerKeeper interface {
 
 	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
 
+	GetPool(
+		ctx sdk.Context,
+		poolId uint64,
+	) (poolmanagertypes.PoolI, error)
+
 	MultihopEstimateInGivenExactAmountOut(
 		ctx sdk.Context,
 		route []poolmanagertypes.SwapAmountOutRoute,
@@ -75,4 +84,12 @@ type PoolManagerKeeper interface {
 type ConcentratedKeeper interface {
 	PoolKeeper
 	GetTickLiquidityForFullRange(ctx sdk.Context, poolId uint64) ([]queryproto.LiquidityDepthWithRange, int64, error)
+	GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (effectiveSpreadFactor osmomath.Dec, volatility osmomath.Dec, err error)
+}
+
+// TxFeesKeeper is an interface for getting the tokens accepted as transaction fees.
+type TxFeesKeeper interface {
+	GetBaseDenom(ctx sdk.Context) (denom string, err error)
+	GetFeeTokens(ctx sdk.Context) (feetokens []txfeestypes.FeeToken)
+	CalcFeeSpotPrice(ctx sdk.Context, inputDenom string) (osmomath.BigDec, error)
 }
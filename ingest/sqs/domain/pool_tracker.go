@@ -0,0 +1,46 @@
+package domain
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	concentratedtypes "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+)
+
+// BlockPoolUpdateTracker is an interface for tracking the pools that were updated within a block.
+// It is populated by the pool module hooks and by the events emitted during block execution.
+// The pools ingester drains it at the end of every block and writes only the updated pools.
+type BlockPoolUpdateTracker interface {
+	// TrackPoolID tracks the pool with the given ID as updated.
+	TrackPoolID(poolID uint64)
+	// TrackContractAddress tracks the contract with the given address as updated.
+	// CosmWasm pools do not have hooks so we detect their updates by contract address.
+	TrackContractAddress(address string)
+	// TrackDenomPair tracks the denom pair as having its taker fee updated.
+	TrackDenomPair(denom0, denom1 string)
+	// TrackParamsUpdate tracks a parameter change from a passed governance proposal.
+	// Since parameters might affect any pool, the full pool state is processed at the end of the block.
+	TrackParamsUpdate()
+	// TrackEvents tracks all pools, contracts and taker fee denom pairs referenced by the given events.
+	TrackEvents(events []abci.Event)
+
+	// GetPoolIDs returns the IDs of the pools tracked since the last reset.
+	GetPoolIDs() map[uint64]struct{}
+	// GetContractAddresses returns the contract addresses tracked since the last reset.
+	GetContractAddresses() map[string]struct{}
+	// GetDenomPairs returns the taker fee denom pairs tracked since the last reset.
+	GetDenomPairs() map[sqsdomain.DenomPair]struct{}
+	// GetParamsUpdated returns true if a parameter change was tracked since the last reset.
+	GetParamsUpdated() bool
+
+	// Reset clears all tracked data. Must be called after every block is processed.
+	Reset()
+
+	// GammHooks returns the gamm hooks that track CFMM pool updates.
+	GammHooks() gammtypes.GammHooks
+	// ConcentratedLiquidityListener returns the concentrated liquidity listener that tracks
+	// concentrated pool updates.
+	ConcentratedLiquidityListener() concentratedtypes.ConcentratedLiquidityListener
+}
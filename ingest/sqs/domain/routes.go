@@ -0,0 +1,15 @@
+package domain
+
+// CandidateRouteConfig defines how the pools ingester computes the candidate routes
+// that the sidecar query server can serve before computing its own.
+type CandidateRouteConfig struct {
+	// UpdateHeightInterval is the interval in blocks at which the candidate routes are recomputed
+	// for the denom pairs with a taker fee affected by the pools updated since the last computation.
+	// The routes of all denom pairs are computed at the first block after startup.
+	// Zero disables the candidate routes.
+	UpdateHeightInterval uint64
+	// MaxHops is the maximum number of pools in a candidate route.
+	MaxHops int
+	// MaxRoutes is the maximum number of candidate routes for a denom pair in one direction.
+	MaxRoutes int
+}
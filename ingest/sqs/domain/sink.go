@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// SinkType is the type of the storage backend that the ingested data is written into.
+type SinkType string
+
+const (
+	// RedisSinkType writes the ingested data into Redis that is read by the sidecar query server.
+	RedisSinkType SinkType = "redis"
+	// FileSinkType writes the ingested data into an embedded LevelDB snapshot store.
+	FileSinkType SinkType = "file"
+	// MemorySinkType keeps the ingested data in memory. Useful for tests.
+	MemorySinkType SinkType = "memory"
+)
+
+// Tx is an atomic transaction over a sink.
+// Writes are buffered in the transaction and flushed to the sink on Exec.
+type Tx interface {
+	// Exec flushes all writes in the transaction to the sink atomically.
+	// On failure, the writes are kept so that Exec can be retried.
+	Exec(ctx context.Context) error
+}
+
+// MergeableTx is an optional interface for transactions that can absorb the writes of a later transaction.
+type MergeableTx interface {
+	Tx
+	// Merge adds the writes of the given later transaction to the transaction.
+	// Writes of the same pool, denom pair or chain information are kept from the later transaction.
+	// Returns false if the later transaction cannot be merged, in which case the transaction is unchanged.
+	Merge(later Tx) bool
+}
+
+// TxManager is an interface for starting atomic transactions over a sink.
+type TxManager interface {
+	// StartTx starts a new atomic transaction.
+	StartTx() Tx
+}
+
+// PoolsRepository is an interface for writing pools into a sink.
+type PoolsRepository interface {
+	// StorePools writes the given pools into the transaction.
+	// Pools that already exist in the sink are overwritten.
+	StorePools(ctx context.Context, tx Tx, pools []sqsdomain.PoolI) error
+}
+
+// RouterRepository is an interface for writing routing data into a sink.
+type RouterRepository interface {
+	// SetTakerFee writes the taker fee for the given denom pair into the transaction.
+	SetTakerFee(ctx context.Context, tx Tx, denom0, denom1 string, takerFee osmomath.Dec) error
+	// SetRoutes writes the candidate routes from the token in denom to the token out denom into the transaction.
+	// The routes of the denom pair in that direction that already exist in the sink are overwritten.
+	SetRoutes(ctx context.Context, tx Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error
+}
+
+// ChainInfoRepository is an interface for reading and writing chain information in a sink.
+type ChainInfoRepository interface {
+	// StoreLatestHeight writes the latest chain height into the transaction.
+	StoreLatestHeight(ctx context.Context, tx Tx, height uint64) error
+	// GetLatestHeight returns the latest chain height in the sink.
+	// Returns zero if no height was stored yet.
+	GetLatestHeight(ctx context.Context) (uint64, error)
+	// StoreChainInfo writes the information about the latest block into the transaction.
+	// The chain information that already exists in the sink is overwritten.
+	StoreChainInfo(ctx context.Context, tx Tx, chainInfo ChainInfo) error
+}
+
+// Sink is a storage backend that the sidecar query server ingesters write into.
+type Sink interface {
+	TxManager
+	PoolsRepository
+	RouterRepository
+	ChainInfoRepository
+
+	// Close releases the resources held by the sink.
+	Close() error
+}
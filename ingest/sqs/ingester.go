@@ -1,8 +1,10 @@
 package sqs
 
 import (
+	"context"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/osmosis-labs/sqs/sqsdomain/repository"
 
 	"github.com/osmosis-labs/osmosis/v22/ingest"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
@@ -10,46 +12,111 @@ import (
 
 const sqsIngesterName = "sidecar-query-server"
 
-var _ ingest.Ingester = &sqsIngester{}
+var (
+	_ ingest.Ingester             = &sqsIngester{}
+	_ ingest.SkippedUpdateHandler = &sqsIngester{}
+	_ ingest.FullStateRequester   = &sqsIngester{}
+	_ ingest.ReplayValidator      = &sqsIngester{}
+	_ ingest.MergeableBlockUpdate = &txBlockUpdate{}
+)
 
 // sqsIngester is a sidecar query server (SQS) implementation of Ingester.
 // It encapsulates all individual SQS ingesters.
 type sqsIngester struct {
-	txManager         repository.TxManager
+	txManager         domain.TxManager
+	chainInfoRepo     domain.ChainInfoRepository
 	poolsIngester     domain.AtomicIngester
 	chainInfoIngester domain.AtomicIngester
 }
 
 // NewSidecarQueryServerIngester creates a new sidecar query server ingester.
-// poolsRepository is the storage for pools.
-// gammKeeper is the keeper for Gamm pools.
-func NewSidecarQueryServerIngester(poolsIngester, chainInfoIngester domain.AtomicIngester, txManager repository.TxManager) ingest.Ingester {
+// poolsIngester and chainInfoIngester write into the transactions started by txManager.
+// chainInfoRepo is read for the latest ingested height when replaying blocks.
+func NewSidecarQueryServerIngester(poolsIngester, chainInfoIngester domain.AtomicIngester, txManager domain.TxManager, chainInfoRepo domain.ChainInfoRepository) ingest.Ingester {
 	return &sqsIngester{
 		txManager:         txManager,
+		chainInfoRepo:     chainInfoRepo,
 		chainInfoIngester: chainInfoIngester,
 		poolsIngester:     poolsIngester,
 	}
 }
 
+// txBlockUpdate is a block update that flushes the writes buffered in an atomic transaction.
+type txBlockUpdate struct {
+	tx domain.Tx
+}
+
 // ProcessBlock implements ingest.Ingester.
-func (i *sqsIngester) ProcessBlock(ctx sdk.Context) error {
+// The writes of all ingesters are buffered in a single atomic transaction
+// that is flushed into the sink asynchronously.
+func (i *sqsIngester) ProcessBlock(ctx sdk.Context) (ingest.BlockUpdate, error) {
 	// Start atomic transaction
 	tx := i.txManager.StartTx()
 
-	goCtx := sdk.WrapSDKContext(ctx)
-
 	// Process block by reading and writing data and ingesting data into sinks
 	if err := i.poolsIngester.ProcessBlock(ctx, tx); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Process block by reading and writing data and ingesting data into sinks
 	if err := i.chainInfoIngester.ProcessBlock(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return &txBlockUpdate{tx: tx}, nil
+}
+
+// OnUpdateSkipped implements ingest.SkippedUpdateHandler.
+// The pools updated at the skipped height are never written so the pools ingester
+// must process the full pool state at the next block.
+func (i *sqsIngester) OnUpdateSkipped(height int64) {
+	i.RequestFullState()
+}
+
+// RequestFullState implements ingest.FullStateRequester.
+func (i *sqsIngester) RequestFullState() {
+	if resyncRequester, ok := i.poolsIngester.(domain.FullResyncRequester); ok {
+		resyncRequester.RequestFullResync()
+	}
+}
+
+// ValidateReplay implements ingest.ReplayValidator.
+// The sink only keeps the latest state, so replaying a block below the latest ingested height
+// would overwrite the pools, the latest height and the chain information with older data.
+func (i *sqsIngester) ValidateReplay(ctx sdk.Context) error {
+	latestHeight, err := i.chainInfoRepo.GetLatestHeight(sdk.WrapSDKContext(ctx))
+	if err != nil {
 		return err
 	}
 
-	// Flush all writes atomically
-	return tx.Exec(goCtx)
+	if uint64(ctx.BlockHeight()) < latestHeight {
+		return fmt.Errorf("cannot replay block %d below the latest ingested height %d", ctx.BlockHeight(), latestHeight)
+	}
+
+	return nil
+}
+
+// Flush implements ingest.BlockUpdate.
+// It flushes all writes atomically.
+func (u *txBlockUpdate) Flush(ctx context.Context) error {
+	return u.tx.Exec(ctx)
+}
+
+// Merge implements ingest.MergeableBlockUpdate.
+// The block updates can be merged if the transactions of the sink can be merged. As a result,
+// the pools updated at a block that is merged into a later block update do not need a full resync.
+func (u *txBlockUpdate) Merge(later ingest.BlockUpdate) bool {
+	laterUpdate, ok := later.(*txBlockUpdate)
+	if !ok {
+		return false
+	}
+
+	tx, ok := u.tx.(domain.MergeableTx)
+	if !ok {
+		return false
+	}
+
+	return tx.Merge(laterUpdate.tx)
 }
 
 // GetName implements ingest.Ingester.
@@ -0,0 +1,138 @@
+package poolsingester
+
+import (
+	"sort"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+)
+
+const (
+	// maxCandidateRouteSearchExpansions bounds the number of partial routes explored
+	// when searching the candidate routes of a denom pair so that densely connected
+	// denoms do not stall the block path.
+	maxCandidateRouteSearchExpansions = 10_000
+	// maxCandidateRouteSearchQueueSize bounds the number of partial routes waiting to be explored
+	// so that the memory used by the search does not grow with the number of pools per denom.
+	// Since the search is breadth-first, the partial routes that are dropped are the longest ones.
+	maxCandidateRouteSearchQueueSize = 1_000
+)
+
+// routeDirection is a denom pair in the direction of the swap.
+type routeDirection struct {
+	tokenInDenom  string
+	tokenOutDenom string
+}
+
+// candidateRouteFinder finds the candidate routes between denoms over a fixed set of pools.
+// Pools with a higher TVL are explored first so that the most liquid routes are preferred.
+type candidateRouteFinder struct {
+	// poolsByDenom maps every denom to the pools that contain it, sorted by TVL in descending order.
+	poolsByDenom map[string][]sqsdomain.PoolI
+	maxHops      int
+	maxRoutes    int
+}
+
+// candidateRouteSearchState is a partial route explored by the search.
+type candidateRouteSearchState struct {
+	pools []sqsdomain.CandidatePool
+	// denom is the token out denom of the last pool in the route.
+	denom string
+}
+
+// newCandidateRouteFinder returns a new candidate route finder over the given pools.
+func newCandidateRouteFinder(pools []sqsdomain.PoolI, maxHops, maxRoutes int) *candidateRouteFinder {
+	sortedPools := make([]sqsdomain.PoolI, len(pools))
+	copy(sortedPools, pools)
+
+	// Sort by TVL in descending order, breaking ties by ID for deterministic routes.
+	sort.SliceStable(sortedPools, func(i, j int) bool {
+		tvlI, tvlJ := sortedPools[i].GetTotalValueLockedUOSMO(), sortedPools[j].GetTotalValueLockedUOSMO()
+		if !tvlI.Equal(tvlJ) {
+			return tvlI.GT(tvlJ)
+		}
+		return sortedPools[i].GetId() < sortedPools[j].GetId()
+	})
+
+	poolsByDenom := map[string][]sqsdomain.PoolI{}
+	for _, pool := range sortedPools {
+		for _, denom := range pool.GetPoolDenoms() {
+			poolsByDenom[denom] = append(poolsByDenom[denom], pool)
+		}
+	}
+
+	return &candidateRouteFinder{
+		poolsByDenom: poolsByDenom,
+		maxHops:      maxHops,
+		maxRoutes:    maxRoutes,
+	}
+}
+
+// getCandidateRoutes returns up to maxRoutes routes from the token in denom to the token out denom
+// with at most maxHops pools each. Routes never go through the same pool or denom twice.
+// Shorter routes are found first since the search is breadth-first.
+func (f *candidateRouteFinder) getCandidateRoutes(tokenInDenom, tokenOutDenom string) sqsdomain.CandidateRoutes {
+	candidateRoutes := sqsdomain.CandidateRoutes{
+		Routes:        []sqsdomain.CandidateRoute{},
+		UniquePoolIDs: map[uint64]struct{}{},
+	}
+
+	queue := []candidateRouteSearchState{{denom: tokenInDenom}}
+	for expansions := 0; len(queue) > 0 && expansions < maxCandidateRouteSearchExpansions; expansions++ {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, pool := range f.poolsByDenom[state.denom] {
+			if state.containsPool(pool.GetId()) {
+				continue
+			}
+
+			for _, denom := range pool.GetPoolDenoms() {
+				if denom == state.denom || denom == tokenInDenom || state.containsDenom(denom) {
+					continue
+				}
+
+				routePools := make([]sqsdomain.CandidatePool, len(state.pools), len(state.pools)+1)
+				copy(routePools, state.pools)
+				routePools = append(routePools, sqsdomain.CandidatePool{ID: pool.GetId(), TokenOutDenom: denom})
+
+				if denom == tokenOutDenom {
+					candidateRoutes.Routes = append(candidateRoutes.Routes, sqsdomain.CandidateRoute{Pools: routePools})
+					for _, routePool := range routePools {
+						candidateRoutes.UniquePoolIDs[routePool.ID] = struct{}{}
+					}
+
+					if len(candidateRoutes.Routes) >= f.maxRoutes {
+						return candidateRoutes
+					}
+					continue
+				}
+
+				if len(routePools) < f.maxHops && len(queue) < maxCandidateRouteSearchQueueSize {
+					queue = append(queue, candidateRouteSearchState{pools: routePools, denom: denom})
+				}
+			}
+		}
+	}
+
+	return candidateRoutes
+}
+
+// containsPool returns true if the route goes through the pool with the given ID.
+func (s candidateRouteSearchState) containsPool(poolID uint64) bool {
+	for _, pool := range s.pools {
+		if pool.ID == poolID {
+			return true
+		}
+	}
+	return false
+}
+
+// containsDenom returns true if the route swaps into the given denom.
+func (s candidateRouteSearchState) containsDenom(denom string) bool {
+	for _, pool := range s.pools {
+		if pool.TokenOutDenom == denom {
+			return true
+		}
+	}
+	return false
+}
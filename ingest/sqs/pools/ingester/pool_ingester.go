@@ -4,12 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync/atomic"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/sqs/sqsdomain"
-	"github.com/osmosis-labs/sqs/sqsdomain/repository"
-	poolsredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/pools"
-	routerredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/router"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
@@ -21,7 +19,9 @@ import (
 
 // poolIngester is an ingester for pools.
 // It implements ingest.Ingester.
-// It reads all pools from the state and writes them to the pools repository.
+// It reads pools from the state and writes them to the pools repository.
+// At startup and at a configurable height interval, all pools are written.
+// At other blocks, only the pools tracked as updated within the block are written.
 // As part of that, it instruments each pool with chain native balances and
 // OSMO based TVL.
 // NOTE:
@@ -31,9 +31,9 @@ import (
 // - If error in TVL calculation, TVL is set to the value that could be computed and the pool struct
 // has a flag to indicate that there was an error in TVL calculation.
 type poolIngester struct {
-	poolsRepository    poolsredisrepo.PoolsRepository
-	routerRepository   routerredisrepo.RouterRepository
-	repositoryManager  repository.TxManager
+	poolsRepository    domain.PoolsRepository
+	routerRepository   domain.RouterRepository
+	repositoryManager  domain.TxManager
 	gammKeeper         domain.PoolKeeper
 	concentratedKeeper domain.ConcentratedKeeper
 	cosmWasmKeeper     domain.CosmWasmPoolKeeper
@@ -41,6 +41,33 @@ type poolIngester struct {
 	protorevKeeper     domain.ProtorevKeeper
 	poolManagerKeeper  domain.PoolManagerKeeper
 	assetListGetter    domain.AssetListGetter
+
+	// poolTracker tracks the pools updated within a block.
+	// If nil, the full pool state is processed at every block.
+	poolTracker domain.BlockPoolUpdateTracker
+	// fullResyncHeightInterval is the interval in blocks at which the full pool state is
+	// reprocessed regardless of the tracked updates. Zero disables the periodic full resync.
+	fullResyncHeightInterval uint64
+	// routeConfig defines how the candidate routes are computed.
+	routeConfig domain.CandidateRouteConfig
+	// hasProcessedFullState is true if the full pool state was successfully processed since startup
+	// or since the last failure. Until then, the full pool state is processed at every block.
+	// It is reset by RequestFullResync which is called concurrently from the ingest worker.
+	hasProcessedFullState atomic.Bool
+	// hasUpdatedRoutes is true if the candidate routes were computed since startup or since the last failure.
+	// Until then, the candidate routes are computed at every block if enabled.
+	// It is reset by RequestFullResync which is called concurrently from the ingest worker.
+	hasUpdatedRoutes atomic.Bool
+	// updatedRoutePoolIDs are the IDs of the pools tracked as updated since the candidate routes were last computed.
+	updatedRoutePoolIDs map[uint64]struct{}
+	// routePoolIDs are the IDs of all pools when the candidate routes were last computed.
+	// They are used to detect the pools created since then.
+	routePoolIDs map[uint64]struct{}
+	// candidateRoutePoolIDs are the IDs of the pools on the candidate routes last computed in every direction.
+	candidateRoutePoolIDs map[routeDirection]map[uint64]struct{}
+	// cosmWasmPoolIDByAddress maps CosmWasm pool contract addresses to pool IDs.
+	// CosmWasm pools do not have hooks so their updates are tracked by contract address.
+	cosmWasmPoolIDByAddress map[string]uint64
 }
 
 // denomRoutingInfo encapsulates the routing information for a pool.
@@ -60,10 +87,12 @@ const (
 	spotPricePrecisionErrorFmtStr = "error calculating spot price from route overwrites due to precision for denom %s"
 	multiHopSpotPriceErrorFmtStr  = "error calculating spot price via multihop swap, %s"
 
-	// placeholder value to disable route updates at the end of every block.
-	// nolint: unused
+	// placeholder value to disable the candidate route updates.
 	routeIngestDisablePlaceholder = 0
 
+	// placeholder value to disable the periodic full resync of the pool state.
+	fullResyncDisablePlaceholder = 0
+
 	// https://app.osmosis.zone/pool/1263
 	usdcPool    = 1263
 	usdcDenom   = "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4"
@@ -109,7 +138,13 @@ var stablesOverwrite map[string]struct{} = map[string]struct{}{
 }
 
 // NewPoolIngester returns a new pool ingester.
-func NewPoolIngester(poolsRepository poolsredisrepo.PoolsRepository, routerRepository routerredisrepo.RouterRepository, repositoryManager repository.TxManager, assetListGetter domain.AssetListGetter, keepers domain.SQSIngestKeepers) domain.AtomicIngester {
+// poolTracker is the tracker of the pools updated within a block. If nil, the full pool state
+// is processed at every block.
+// fullResyncHeightInterval is the interval in blocks at which the full pool state is processed
+// even if the pool tracker is set. Zero means that the full pool state is only processed at startup.
+// routeConfig defines how the candidate routes are computed. The full pool state is processed
+// at the blocks at which the candidate routes are computed.
+func NewPoolIngester(poolsRepository domain.PoolsRepository, routerRepository domain.RouterRepository, repositoryManager domain.TxManager, assetListGetter domain.AssetListGetter, poolTracker domain.BlockPoolUpdateTracker, fullResyncHeightInterval uint64, routeConfig domain.CandidateRouteConfig, keepers domain.SQSIngestKeepers) domain.AtomicIngester {
 	return &poolIngester{
 		poolsRepository:    poolsRepository,
 		routerRepository:   routerRepository,
@@ -121,21 +156,102 @@ func NewPoolIngester(poolsRepository poolsredisrepo.PoolsRepository, routerRepos
 		protorevKeeper:     keepers.ProtorevKeeper,
 		poolManagerKeeper:  keepers.PoolManagerKeeper,
 		assetListGetter:    assetListGetter,
+
+		poolTracker:              poolTracker,
+		fullResyncHeightInterval: fullResyncHeightInterval,
+		routeConfig:              routeConfig,
+		updatedRoutePoolIDs:      map[uint64]struct{}{},
+		cosmWasmPoolIDByAddress:  map[string]uint64{},
 	}
 }
 
 // ProcessBlock implements ingest.Ingester.
-func (pi *poolIngester) ProcessBlock(ctx sdk.Context, tx repository.Tx) error {
-	return pi.processPoolState(ctx, tx)
+// It processes the full pool state at startup, every fullResyncHeightInterval blocks
+// and after any failure. Otherwise, it only processes the pools tracked as updated within the block.
+// If enabled, the candidate routes are computed from the full pool state at startup,
+// every route update height interval and after any failure. Between these, only the routes
+// affected by the pools updated since the last computation are recomputed.
+func (pi *poolIngester) ProcessBlock(ctx sdk.Context, tx domain.Tx) (err error) {
+	if pi.poolTracker != nil {
+		// Tracked updates must not leak into the next block.
+		defer pi.poolTracker.Reset()
+	}
+
+	defer func() {
+		// On failure, the updates tracked in this block are lost.
+		// As a result, we must fall back to processing the full pool state at the next block,
+		// and to computing all candidate routes since the updated pools are no longer known.
+		if err != nil {
+			pi.hasProcessedFullState.Store(false)
+			pi.hasUpdatedRoutes.Store(false)
+		}
+	}()
+
+	if pi.poolTracker != nil && pi.routeConfig.UpdateHeightInterval != routeIngestDisablePlaceholder {
+		for _, poolID := range pi.getUpdatedPoolIDs() {
+			pi.updatedRoutePoolIDs[poolID] = struct{}{}
+		}
+	}
+
+	shouldUpdateRoutes := pi.shouldUpdateRoutes(ctx)
+	if shouldUpdateRoutes || pi.shouldProcessFullState(ctx) {
+		if err := pi.processPoolState(ctx, tx, shouldUpdateRoutes); err != nil {
+			return err
+		}
+
+		pi.hasProcessedFullState.Store(true)
+		if shouldUpdateRoutes {
+			pi.hasUpdatedRoutes.Store(true)
+		}
+		return nil
+	}
+
+	return pi.processUpdatedPoolState(ctx, tx)
+}
+
+// RequestFullResync implements domain.FullResyncRequester.
+// The full pool state is processed at the next block.
+// Since the failed block update might have contained the candidate routes,
+// they are also recomputed at the next block if enabled.
+func (pi *poolIngester) RequestFullResync() {
+	pi.hasProcessedFullState.Store(false)
+	pi.hasUpdatedRoutes.Store(false)
 }
 
-var _ domain.AtomicIngester = &poolIngester{}
+// shouldProcessFullState returns true if the full pool state must be processed at the current block.
+// That is the case if there is no pool tracker, if the full pool state has not been processed since startup
+// or since the last failure, if parameters were changed by governance within the block,
+// or if the current height is a multiple of the full resync height interval.
+func (pi *poolIngester) shouldProcessFullState(ctx sdk.Context) bool {
+	if pi.poolTracker == nil || !pi.hasProcessedFullState.Load() || pi.poolTracker.GetParamsUpdated() {
+		return true
+	}
+
+	return pi.fullResyncHeightInterval > fullResyncDisablePlaceholder && uint64(ctx.BlockHeight())%pi.fullResyncHeightInterval == 0
+}
 
-// processPoolState processes the pool state. an
-func (pi *poolIngester) processPoolState(ctx sdk.Context, tx repository.Tx) error {
+// shouldUpdateRoutes returns true if the candidate routes must be computed at the current block.
+// That is the case if the candidate routes are enabled and either they have not been computed since startup
+// or since the last failure, or the current height is a multiple of the route update height interval.
+func (pi *poolIngester) shouldUpdateRoutes(ctx sdk.Context) bool {
+	if pi.routeConfig.UpdateHeightInterval == routeIngestDisablePlaceholder {
+		return false
+	}
+
+	return !pi.hasUpdatedRoutes.Load() || uint64(ctx.BlockHeight())%pi.routeConfig.UpdateHeightInterval == 0
+}
+
+var (
+	_ domain.AtomicIngester      = &poolIngester{}
+	_ domain.FullResyncRequester = &poolIngester{}
+)
+
+// processPoolState processes the full pool state by writing all pools and the taker fees of their denom pairs
+// into the transaction.
+// If updateRoutes is true, the candidate routes are computed from the processed pools.
+func (pi *poolIngester) processPoolState(ctx sdk.Context, tx domain.Tx, updateRoutes bool) error {
 	goCtx := sdk.WrapSDKContext(ctx)
 
-	// TODO: can be cached
 	tokenPrecisionMap, err := pi.assetListGetter.GetDenomPrecisions(goCtx)
 	if err != nil {
 		return err
@@ -193,6 +309,9 @@ func (pi *poolIngester) processPoolState(ctx sdk.Context, tx repository.Tx) erro
 	}
 
 	for _, pool := range cosmWasmPools {
+		// Track the contract address so that updates to this pool can be detected from events.
+		pi.cosmWasmPoolIDByAddress[pool.GetAddress().String()] = pool.GetId()
+
 		// Parse cosmwasm pool to the standard SQS types.
 		pool, err := pi.convertPool(ctx, pool, denomToRoutablePoolIDMap, denomPairToTakerFeeMap, tokenPrecisionMap)
 		if err != nil {
@@ -202,7 +321,7 @@ func (pi *poolIngester) processPoolState(ctx sdk.Context, tx repository.Tx) erro
 		allPoolsParsed = append(allPoolsParsed, pool)
 	}
 
-	ctx.Logger().Info("ingesting pools to Redis", "height", ctx.BlockHeight(), "num_cfmm", len(cfmmPools), "num_concentrated", len(concentratedPools), "num_cosmwasm", len(cosmWasmPools))
+	ctx.Logger().Info("ingesting pools to sink", "height", ctx.BlockHeight(), "num_cfmm", len(cfmmPools), "num_concentrated", len(concentratedPools), "num_cosmwasm", len(cosmWasmPools))
 
 	err = pi.poolsRepository.StorePools(goCtx, tx, allPoolsParsed)
 	if err != nil {
@@ -215,73 +334,198 @@ func (pi *poolIngester) processPoolState(ctx sdk.Context, tx repository.Tx) erro
 		return err
 	}
 
-	// TODO: decide later if we need this
-	// // Update routes every RouteUpdateHeightInterval blocks unless RouteUpdateHeightInterval is 0.
-	// if pi.routerConfig.RouteUpdateHeightInterval > routeIngestDisablePlaceholder && ctx.BlockHeight()%int64(pi.routerConfig.RouteUpdateHeightInterval) == 0 {
-	// 	allPools := make([]domain.PoolI, 0, len(allPoolsParsed))
+	if updateRoutes {
+		return pi.updateRoutes(ctx, tx, allPoolsParsed, denomPairToTakerFeeMap)
+	}
 
-	// 	pi.logger.Debug("getting routes for pools", zap.Int64("height", ctx.BlockHeight()))
+	return nil
+}
 
-	// 	pi.updateRoutes(sdk.WrapSDKContext(ctx), tx, allPools, denomPairToTakerFeeMap)
-	// }
+// processUpdatedPoolState processes only the pools tracked as updated within the block
+// together with the taker fees of their denom pairs and of any tracked denom pairs.
+func (pi *poolIngester) processUpdatedPoolState(ctx sdk.Context, tx domain.Tx) error {
+	updatedPoolIDs := pi.getUpdatedPoolIDs()
+	updatedDenomPairs := pi.poolTracker.GetDenomPairs()
 
-	return nil
+	if len(updatedPoolIDs) == 0 && len(updatedDenomPairs) == 0 {
+		return nil
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	tokenPrecisionMap, err := pi.assetListGetter.GetDenomPrecisions(goCtx)
+	if err != nil {
+		return err
+	}
+
+	// Create a map from denom to routable pool ID.
+	denomToRoutablePoolIDMap := make(map[string]denomRoutingInfo)
+
+	denomPairToTakerFeeMap := make(map[sqsdomain.DenomPair]osmomath.Dec, 0)
+
+	updatedPoolsParsed := make([]sqsdomain.PoolI, 0, len(updatedPoolIDs))
+	for _, poolID := range updatedPoolIDs {
+		pool, err := pi.poolManagerKeeper.GetPool(ctx, poolID)
+		if err != nil {
+			return err
+		}
+
+		// Track the contract address of the newly created CosmWasm pools.
+		if pool.GetType() == poolmanagertypes.CosmWasm {
+			pi.cosmWasmPoolIDByAddress[pool.GetAddress().String()] = poolID
+		}
+
+		// Parse pool to the standard SQS types.
+		poolParsed, err := pi.convertPool(ctx, pool, denomToRoutablePoolIDMap, denomPairToTakerFeeMap, tokenPrecisionMap)
+		if err != nil {
+			return err
+		}
+
+		updatedPoolsParsed = append(updatedPoolsParsed, poolParsed)
+	}
+
+	// Retrieve taker fees for the denom pairs that were updated directly.
+	for denomPair := range updatedDenomPairs {
+		err := retrieveTakerFeeToMapIfNotExists(ctx, []string{denomPair.Denom0, denomPair.Denom1}, denomPairToTakerFeeMap, pi.poolManagerKeeper)
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("ingesting updated pools to sink", "height", ctx.BlockHeight(), "num_pools", len(updatedPoolsParsed), "num_taker_fees", len(denomPairToTakerFeeMap))
+
+	if len(updatedPoolsParsed) > 0 {
+		if err := pi.poolsRepository.StorePools(goCtx, tx, updatedPoolsParsed); err != nil {
+			return err
+		}
+	}
+
+	return pi.persistTakerFees(ctx, tx, denomPairToTakerFeeMap)
+}
+
+// getUpdatedPoolIDs returns the sorted IDs of the pools tracked as updated within the block.
+// CosmWasm pools are included if their contract address was tracked.
+// Contract addresses that do not belong to known CosmWasm pools are ignored.
+func (pi *poolIngester) getUpdatedPoolIDs() []uint64 {
+	updatedPoolIDsMap := make(map[uint64]struct{}, len(pi.poolTracker.GetPoolIDs()))
+	for poolID := range pi.poolTracker.GetPoolIDs() {
+		updatedPoolIDsMap[poolID] = struct{}{}
+	}
+
+	for address := range pi.poolTracker.GetContractAddresses() {
+		if poolID, ok := pi.cosmWasmPoolIDByAddress[address]; ok {
+			updatedPoolIDsMap[poolID] = struct{}{}
+		}
+	}
+
+	updatedPoolIDs := make([]uint64, 0, len(updatedPoolIDsMap))
+	for poolID := range updatedPoolIDsMap {
+		updatedPoolIDs = append(updatedPoolIDs, poolID)
+	}
+
+	// Sort for deterministic order of writes.
+	sort.Slice(updatedPoolIDs, func(i, j int) bool {
+		return updatedPoolIDs[i] < updatedPoolIDs[j]
+	})
+
+	return updatedPoolIDs
 }
 
-// TODO: decide later if we need this.
-// // updateRoutes updates the routes for all denom pairs in the taker fee map. The taker fee map value is unused.
-// // It returns a channel that is closed when all routes are updated.
-// // TODO: test
-// func (pi *poolIngester) updateRoutes(ctx context.Context, tx mvc.Tx, pools []domain.PoolI, denomPairToTakerFeeMap map[domain.DenomPair]osmomath.Dec) chan domain.DenomPair {
-// 	// Initialize a channel that will be closed when all routes are updated.
-// 	completionChan := make(chan domain.DenomPair, len(denomPairToTakerFeeMap))
-
-// 	defer func() {
-// 		// Close completion channel before returning.
-// 		close(completionChan)
-// 	}()
-
-// 	for denomPair := range denomPairToTakerFeeMap {
-// 		denomPair := denomPair
-// 		// router
-// 		router := routerusecase.NewRouter([]uint64{}, pi.routerConfig.MaxPoolsPerRoute, pi.routerConfig.MaxRoutes, pi.routerConfig.MaxSplitRoutes, pi.routerConfig.MaxSplitIterations, pi.routerConfig.MinOSMOLiquidity, pi.logger)
-// 		router = routerusecase.WithSortedPools(router, pools)
-
-// 		go func(denomPair domain.DenomPair) {
-// 			// TODO: abstract this better
-
-// 			candidateRoutes, err := router.GetCandidateRoutes(denomPair.Denom0, denomPair.Denom1)
-// 			if err != nil {
-// 				pi.logger.Error("error getting routes", zap.Error(err))
-// 				return
-// 			}
-
-// 			err = pi.routerRepository.SetRoutesTx(ctx, tx, denomPair.Denom0, denomPair.Denom1, candidateRoutes)
-// 			if err != nil {
-// 				pi.logger.Error("error setting routes", zap.Error(err))
-// 				return
-// 			}
-
-// 			// In the other direction. This can be optimized later.
-
-// 			candidateRoutes, err = router.GetCandidateRoutes(denomPair.Denom1, denomPair.Denom0)
-// 			if err != nil {
-// 				pi.logger.Error("error getting routes", zap.Error(err))
-// 				return
-// 			}
-
-// 			err = pi.routerRepository.SetRoutesTx(ctx, tx, denomPair.Denom1, denomPair.Denom0, candidateRoutes)
-// 			if err != nil {
-// 				pi.logger.Error("error setting routes", zap.Error(err))
-// 				return
-// 			}
-
-// 			completionChan <- denomPair
-// 		}(denomPair)
-// 	}
-
-// 	return completionChan
-// }
+// updateRoutes computes the candidate routes in both directions for the denom pairs in the taker fee map
+// over the given pools and writes them into the router repository. The taker fee map value is unused.
+//
+// To keep the block path short, the routes of a direction are only recomputed if they were not computed
+// before, if one of its denoms is in a pool updated since the last computation, or if one of its previous
+// routes goes through such a pool. All routes are recomputed if the updated pools are not known, i.e.
+// without a pool tracker, at startup and after a failure, or if pools were created since the last computation.
+func (pi *poolIngester) updateRoutes(ctx sdk.Context, tx domain.Tx, pools []sqsdomain.PoolI, denomPairToTakerFeeMap sqsdomain.TakerFeeMap) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	recomputeAll := pi.poolTracker == nil || !pi.hasUpdatedRoutes.Load()
+
+	poolIDs := make(map[uint64]struct{}, len(pools))
+	updatedDenoms := map[string]struct{}{}
+	for _, pool := range pools {
+		poolIDs[pool.GetId()] = struct{}{}
+
+		if _, ok := pi.routePoolIDs[pool.GetId()]; !ok {
+			recomputeAll = true
+		}
+
+		if _, ok := pi.updatedRoutePoolIDs[pool.GetId()]; ok {
+			for _, denom := range pool.GetPoolDenoms() {
+				updatedDenoms[denom] = struct{}{}
+			}
+		}
+	}
+
+	// isAffected returns true if the routes of the direction must be recomputed.
+	isAffected := func(direction routeDirection) bool {
+		previousPoolIDs, ok := pi.candidateRoutePoolIDs[direction]
+		if recomputeAll || !ok {
+			return true
+		}
+
+		for _, denom := range []string{direction.tokenInDenom, direction.tokenOutDenom} {
+			if _, ok := updatedDenoms[denom]; ok {
+				return true
+			}
+		}
+
+		for poolID := range previousPoolIDs {
+			if _, ok := pi.updatedRoutePoolIDs[poolID]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	denomPairs := make([]sqsdomain.DenomPair, 0, len(denomPairToTakerFeeMap))
+	for denomPair := range denomPairToTakerFeeMap {
+		denomPairs = append(denomPairs, denomPair)
+	}
+
+	// Sort for deterministic order of writes.
+	sort.Slice(denomPairs, func(i, j int) bool {
+		if denomPairs[i].Denom0 != denomPairs[j].Denom0 {
+			return denomPairs[i].Denom0 < denomPairs[j].Denom0
+		}
+		return denomPairs[i].Denom1 < denomPairs[j].Denom1
+	})
+
+	routeFinder := newCandidateRouteFinder(pools, pi.routeConfig.MaxHops, pi.routeConfig.MaxRoutes)
+
+	candidateRoutePoolIDs := make(map[routeDirection]map[uint64]struct{}, 2*len(denomPairs))
+	numDirections, numRoutes := 0, 0
+	for _, denomPair := range denomPairs {
+		for _, direction := range []routeDirection{
+			{tokenInDenom: denomPair.Denom0, tokenOutDenom: denomPair.Denom1},
+			{tokenInDenom: denomPair.Denom1, tokenOutDenom: denomPair.Denom0},
+		} {
+			if !isAffected(direction) {
+				candidateRoutePoolIDs[direction] = pi.candidateRoutePoolIDs[direction]
+				continue
+			}
+
+			candidateRoutes := routeFinder.getCandidateRoutes(direction.tokenInDenom, direction.tokenOutDenom)
+			if err := pi.routerRepository.SetRoutes(goCtx, tx, direction.tokenInDenom, direction.tokenOutDenom, candidateRoutes); err != nil {
+				return err
+			}
+
+			candidateRoutePoolIDs[direction] = candidateRoutes.UniquePoolIDs
+			numDirections++
+			numRoutes += len(candidateRoutes.Routes)
+		}
+	}
+
+	pi.routePoolIDs = poolIDs
+	pi.candidateRoutePoolIDs = candidateRoutePoolIDs
+	pi.updatedRoutePoolIDs = map[uint64]struct{}{}
+
+	ctx.Logger().Info("ingesting candidate routes to sink", "height", ctx.BlockHeight(), "num_denom_pairs", len(denomPairs), "num_recomputed_directions", numDirections, "num_routes", numRoutes)
+
+	return nil
+}
 
 // convertPool converts a pool to the standard SQS pool type.
 // It instruments the pool with chain native balances and OSMO based TVL.
@@ -312,6 +556,16 @@ func (pi *poolIngester) convertPool(
 
 	spreadFactor := pool.GetSpreadFactor(ctx)
 
+	// Concentrated pools in dynamic spread factor mode charge an effective spread factor
+	// that differs from the one they were created with.
+	if pool.GetType() == poolmanagertypes.Concentrated {
+		var err error
+		spreadFactor, _, err = pi.concentratedKeeper.GetEffectiveSpreadFactor(ctx, pool.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Note that this must follow the call to GetPoolDenoms() and GetSpreadFactor.
 	// Otherwise, the CosmWasmPool model panics.
 	pool = pool.AsSerializablePool()
@@ -491,7 +745,7 @@ func (pi *poolIngester) convertPool(
 }
 
 // persistTakerFees persists all taker fees to the router repository.
-func (pi *poolIngester) persistTakerFees(ctx sdk.Context, tx repository.Tx, takerFeeMap sqsdomain.TakerFeeMap) error {
+func (pi *poolIngester) persistTakerFees(ctx sdk.Context, tx domain.Tx, takerFeeMap sqsdomain.TakerFeeMap) error {
 	for denomPair, takerFee := range takerFeeMap {
 		err := pi.routerRepository.SetTakerFee(sdk.WrapSDKContext(ctx), tx, denomPair.Denom0, denomPair.Denom1, takerFee)
 		if err != nil {
@@ -1,6 +1,7 @@
 package poolsingester_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -8,8 +9,6 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/sqs/sqsdomain"
-	sqsdomainmocks "github.com/osmosis-labs/sqs/sqsdomain/mocks"
-	"github.com/osmosis-labs/sqs/sqsdomain/repository"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
@@ -17,6 +16,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain/mocks"
 	poolsingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/pools/ingester"
+	memorysink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/memory"
 	clqueryproto "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
 	cltypes "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
@@ -468,16 +468,10 @@ func (s *IngesterTestSuite) TestConvertPool_NoPrecisionInMap() {
 func (s *IngesterTestSuite) TestProcessBlock() {
 	s.Setup()
 	var (
-		redisRepoMock   = &sqsdomainmocks.RedisPoolsRepositoryMock{}
-		redisRouterMock = &sqsdomainmocks.RedisRouterRepositoryMock{
-			TakerFees: sqsdomain.TakerFeeMap{},
-		}
+		sink                = memorysink.New()
 		assetListGetterMock = &mocks.AssetListGetterMock{}
 
-		// Note: this is a dummy tx that is not initialized correctly.
-		// We do note expect it to be called or used by the system under test
-		// due to using the mock repository.
-		redisTx = repository.NewRedisTx(nil)
+		tx = sink.StartTx()
 	)
 
 	// Set the default taker fee
@@ -499,31 +493,30 @@ func (s *IngesterTestSuite) TestProcessBlock() {
 		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
 	}
 
-	poolIngester := poolsingester.NewPoolIngester(redisRepoMock, redisRouterMock, nil, assetListGetterMock, sqsKeepers)
-
-	err := poolIngester.ProcessBlock(s.Ctx, redisTx)
-	s.Require().NoError(err)
+	poolIngester := poolsingester.NewPoolIngester(sink, sink, sink, assetListGetterMock, nil, 0, domain.CandidateRouteConfig{}, sqsKeepers)
 
-	allPools, err := redisRepoMock.GetAllPools(sdk.WrapSDKContext(s.Ctx))
+	err := poolIngester.ProcessBlock(s.Ctx, tx)
 	s.Require().NoError(err)
 
-	s.Require().Len(allPools, 2+2+1)
+	// Nothing is visible in the sink until the transaction is executed.
+	s.Require().Empty(sink.GetAllPools())
 
-	// Order of pooks is by order of writes:
-	// 1. CFMM
-	// 2. Concentrated
-	// 3. Cosmwasm
+	err = tx.Exec(sdk.WrapSDKContext(s.Ctx))
+	s.Require().NoError(err)
 
-	s.Require().Equal(poolsData.BalancerPoolID, allPools[0].GetId())
-	s.Require().Equal(poolsData.StableSwapPoolID, allPools[1].GetId())
+	allPools := sink.GetAllPools()
 
-	s.Require().Equal(poolsData.ConcentratedPoolID, allPools[2].GetId())
-	s.Require().Equal(customTakerFeeConcentratedPool.GetId(), allPools[3].GetId())
+	s.Require().Len(allPools, 2+2+1)
 
-	s.Require().Equal(poolsData.CosmWasmPoolID, allPools[4].GetId())
+	// Pools are returned by the sink in the order of IDs.
+	s.Require().Equal(poolsData.ConcentratedPoolID, allPools[0].GetId())
+	s.Require().Equal(poolsData.BalancerPoolID, allPools[1].GetId())
+	s.Require().Equal(poolsData.StableSwapPoolID, allPools[2].GetId())
+	s.Require().Equal(poolsData.CosmWasmPoolID, allPools[3].GetId())
+	s.Require().Equal(customTakerFeeConcentratedPool.GetId(), allPools[4].GetId())
 
 	// Validate taker fee for the custom pool
-	actualTakerFee, err := redisRouterMock.GetTakerFee(sdk.WrapSDKContext(s.Ctx), customTakerFeeConcentratedPool.GetToken0(), customTakerFeeConcentratedPool.GetToken1())
+	actualTakerFee, err := sink.GetTakerFee(customTakerFeeConcentratedPool.GetToken0(), customTakerFeeConcentratedPool.GetToken1())
 	s.Require().NoError(err)
 	// Custom taker fee
 	s.Require().Equal(defaultCustomTakerFee, actualTakerFee)
@@ -531,12 +524,302 @@ func (s *IngesterTestSuite) TestProcessBlock() {
 	// Validate taker fee for one of the default taker fee pools
 	defaultConcentratedPool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, poolsData.ConcentratedPoolID)
 	s.Require().NoError(err)
-	actualTakerFee, err = redisRouterMock.GetTakerFee(sdk.WrapSDKContext(s.Ctx), defaultConcentratedPool.GetToken0(), defaultConcentratedPool.GetToken1())
+	actualTakerFee, err = sink.GetTakerFee(defaultConcentratedPool.GetToken0(), defaultConcentratedPool.GetToken1())
 	s.Require().NoError(err)
 	// Poolmanager params taker fee
 	s.Require().Equal(defaultPoolManagerTakerFee, actualTakerFee)
 }
 
+// sinkRecorderMock is an in-memory sink that records
+// the pools written by the last StorePools call and
+// the directions of all SetRoutes calls.
+type sinkRecorderMock struct {
+	*memorysink.MemorySink
+
+	lastStoredPools []sqsdomain.PoolI
+	setRoutes       [][2]string
+}
+
+// StorePools implements domain.PoolsRepository.
+func (m *sinkRecorderMock) StorePools(ctx context.Context, tx domain.Tx, pools []sqsdomain.PoolI) error {
+	m.lastStoredPools = pools
+	return m.MemorySink.StorePools(ctx, tx, pools)
+}
+
+// SetRoutes implements domain.RouterRepository.
+func (m *sinkRecorderMock) SetRoutes(ctx context.Context, tx domain.Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error {
+	m.setRoutes = append(m.setRoutes, [2]string{tokenInDenom, tokenOutDenom})
+	return m.MemorySink.SetRoutes(ctx, tx, tokenInDenom, tokenOutDenom, routes)
+}
+
+// This test validates that only the tracked pools are processed after the initial full state
+// is processed. It checks that:
+// - the full pool state is processed at the first block
+// - only the tracked pools, including CosmWasm pools tracked by contract address, are processed at the next block
+// - the tracked taker fee denom pairs are persisted
+// - the tracker is reset after every block
+// - nothing is written if there are no tracked updates
+// - the full pool state is processed after a governance parameter change
+// - the full pool state is processed at the full resync height interval
+func (s *IngesterTestSuite) TestProcessBlock_UpdatedPools() {
+	s.Setup()
+	const fullResyncHeightInterval = 10
+
+	var (
+		sink = &sinkRecorderMock{
+			MemorySink: memorysink.New(),
+		}
+		assetListGetterMock = &mocks.AssetListGetterMock{}
+		poolTracker         = poolsingester.NewPoolTracker()
+
+		tx = sink.StartTx()
+	)
+
+	s.setDefaultPoolManagerTakerFee()
+
+	// Create one pool of each type.
+	poolsData := s.PrepareAllSupportedPools()
+
+	cosmWasmPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolsData.CosmWasmPoolID)
+	s.Require().NoError(err)
+
+	sqsKeepers := domain.SQSIngestKeepers{
+		GammKeeper:         s.App.GAMMKeeper,
+		ConcentratedKeeper: s.App.ConcentratedLiquidityKeeper,
+		BankKeeper:         s.App.BankKeeper,
+		ProtorevKeeper:     s.App.ProtoRevKeeper,
+		PoolManagerKeeper:  s.App.PoolManagerKeeper,
+		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
+	}
+
+	poolIngester := poolsingester.NewPoolIngester(sink, sink, sink, assetListGetterMock, poolTracker, fullResyncHeightInterval, domain.CandidateRouteConfig{}, sqsKeepers)
+
+	// Startup: the full state is processed even though nothing is tracked.
+	s.Ctx = s.Ctx.WithBlockHeight(fullResyncHeightInterval + 1)
+	err = poolIngester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+	s.Require().Len(sink.lastStoredPools, 4)
+
+	// Track a CFMM pool by ID, a CosmWasm pool by contract address and a custom taker fee denom pair.
+	const customDenom0, customDenom1 = "customdenomb", "customdenoma"
+	s.App.PoolManagerKeeper.SetDenomPairTakerFee(s.Ctx, customDenom0, customDenom1, defaultCustomTakerFee)
+
+	poolTracker.TrackPoolID(poolsData.BalancerPoolID)
+	poolTracker.TrackContractAddress(cosmWasmPool.GetAddress().String())
+	poolTracker.TrackDenomPair(customDenom0, customDenom1)
+	// Unknown contract addresses are ignored.
+	poolTracker.TrackContractAddress(s.TestAccs[0].String())
+
+	s.Ctx = s.Ctx.WithBlockHeight(fullResyncHeightInterval + 2)
+	err = poolIngester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+
+	s.Require().Len(sink.lastStoredPools, 2)
+	s.Require().Equal(poolsData.BalancerPoolID, sink.lastStoredPools[0].GetId())
+	s.Require().Equal(poolsData.CosmWasmPoolID, sink.lastStoredPools[1].GetId())
+
+	err = tx.Exec(sdk.WrapSDKContext(s.Ctx))
+	s.Require().NoError(err)
+
+	actualTakerFee, err := sink.GetTakerFee(customDenom1, customDenom0)
+	s.Require().NoError(err)
+	s.Require().Equal(defaultCustomTakerFee, actualTakerFee)
+
+	// The tracker is reset after the block.
+	s.Require().Empty(poolTracker.GetPoolIDs())
+	s.Require().Empty(poolTracker.GetContractAddresses())
+	s.Require().Empty(poolTracker.GetDenomPairs())
+
+	// Nothing is tracked so nothing is written.
+	sink.lastStoredPools = nil
+	s.Ctx = s.Ctx.WithBlockHeight(fullResyncHeightInterval + 3)
+	err = poolIngester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+	s.Require().Nil(sink.lastStoredPools)
+
+	// A parameter change might affect any pool, so the full state is processed.
+	poolTracker.TrackParamsUpdate()
+	s.Ctx = s.Ctx.WithBlockHeight(fullResyncHeightInterval + 4)
+	err = poolIngester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+	s.Require().Len(sink.lastStoredPools, 4)
+	s.Require().False(poolTracker.GetParamsUpdated())
+
+	// At the full resync height interval, the full state is processed.
+	s.Ctx = s.Ctx.WithBlockHeight(2 * fullResyncHeightInterval)
+	err = poolIngester.ProcessBlock(s.Ctx, tx)
+	s.Require().NoError(err)
+	s.Require().Len(sink.lastStoredPools, 4)
+}
+
+// Tests that the candidate routes are computed for all denom pairs in both directions at startup
+// and at the route update height interval, limited by the max hops and max routes.
+func (s *IngesterTestSuite) TestProcessBlock_CandidateRoutes() {
+	const routeUpdateHeightInterval = 10
+
+	tests := map[string]struct {
+		routeConfig domain.CandidateRouteConfig
+
+		expectedNumRoutes int
+	}{
+		"direct and two hop routes": {
+			routeConfig: domain.CandidateRouteConfig{UpdateHeightInterval: routeUpdateHeightInterval, MaxHops: 2, MaxRoutes: 10},
+
+			expectedNumRoutes: 2,
+		},
+		"max hops excludes the two hop route": {
+			routeConfig: domain.CandidateRouteConfig{UpdateHeightInterval: routeUpdateHeightInterval, MaxHops: 1, MaxRoutes: 10},
+
+			expectedNumRoutes: 1,
+		},
+		"max routes excludes the two hop route": {
+			routeConfig: domain.CandidateRouteConfig{UpdateHeightInterval: routeUpdateHeightInterval, MaxHops: 2, MaxRoutes: 1},
+
+			expectedNumRoutes: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			var (
+				sink = &sinkRecorderMock{
+					MemorySink: memorysink.New(),
+				}
+				assetListGetterMock = &mocks.AssetListGetterMock{}
+				poolTracker         = poolsingester.NewPoolTracker()
+			)
+
+			s.setDefaultPoolManagerTakerFee()
+
+			// UOSMO -> USDT directly or through USDC.
+			directPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultAmount), sdk.NewCoin(USDT, defaultAmount))
+			uosmoUSDCPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultAmount), sdk.NewCoin(USDC, defaultAmount))
+			usdcUSDTPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(USDC, defaultAmount), sdk.NewCoin(USDT, defaultAmount))
+
+			sqsKeepers := domain.SQSIngestKeepers{
+				GammKeeper:         s.App.GAMMKeeper,
+				ConcentratedKeeper: s.App.ConcentratedLiquidityKeeper,
+				BankKeeper:         s.App.BankKeeper,
+				ProtorevKeeper:     s.App.ProtoRevKeeper,
+				PoolManagerKeeper:  s.App.PoolManagerKeeper,
+				CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
+			}
+
+			poolIngester := poolsingester.NewPoolIngester(sink, sink, sink, assetListGetterMock, poolTracker, 0, tc.routeConfig, sqsKeepers)
+
+			// Startup: the routes are computed.
+			s.Ctx = s.Ctx.WithBlockHeight(routeUpdateHeightInterval + 1)
+			tx := sink.StartTx()
+			s.Require().NoError(poolIngester.ProcessBlock(s.Ctx, tx))
+			s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+
+			routes, ok := sink.GetRoutes(UOSMO, USDT)
+			s.Require().True(ok)
+			s.Require().Len(routes.Routes, tc.expectedNumRoutes)
+
+			// The direct route is found first.
+			s.Require().Equal([]sqsdomain.CandidatePool{{ID: directPoolID, TokenOutDenom: USDT}}, routes.Routes[0].Pools)
+			if tc.expectedNumRoutes > 1 {
+				s.Require().Equal([]sqsdomain.CandidatePool{
+					{ID: uosmoUSDCPoolID, TokenOutDenom: USDC},
+					{ID: usdcUSDTPoolID, TokenOutDenom: USDT},
+				}, routes.Routes[1].Pools)
+			}
+
+			// The routes are computed in the other direction.
+			routes, ok = sink.GetRoutes(USDT, UOSMO)
+			s.Require().True(ok)
+			s.Require().Equal([]sqsdomain.CandidatePool{{ID: directPoolID, TokenOutDenom: UOSMO}}, routes.Routes[0].Pools)
+
+			// Outside of the interval, the routes are not recomputed.
+			newPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultAmount), sdk.NewCoin(USDW, defaultAmount))
+
+			s.Ctx = s.Ctx.WithBlockHeight(routeUpdateHeightInterval + 2)
+			tx = sink.StartTx()
+			s.Require().NoError(poolIngester.ProcessBlock(s.Ctx, tx))
+			s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+
+			_, ok = sink.GetRoutes(UOSMO, USDW)
+			s.Require().False(ok)
+
+			// At the route update height interval, the routes are recomputed including the new pool.
+			s.Ctx = s.Ctx.WithBlockHeight(2 * routeUpdateHeightInterval)
+			tx = sink.StartTx()
+			s.Require().NoError(poolIngester.ProcessBlock(s.Ctx, tx))
+			s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+
+			routes, ok = sink.GetRoutes(UOSMO, USDW)
+			s.Require().True(ok)
+			s.Require().Equal([]sqsdomain.CandidatePool{{ID: newPoolID, TokenOutDenom: USDW}}, routes.Routes[0].Pools)
+		})
+	}
+}
+
+// Tests that at the route update height interval, only the routes of the directions affected
+// by the pools updated since the last computation are recomputed, and that all routes are
+// recomputed after a failure.
+func (s *IngesterTestSuite) TestProcessBlock_CandidateRoutes_UpdatedPools() {
+	s.Setup()
+	const routeUpdateHeightInterval = 10
+
+	var (
+		sink = &sinkRecorderMock{
+			MemorySink: memorysink.New(),
+		}
+		assetListGetterMock = &mocks.AssetListGetterMock{}
+		poolTracker         = poolsingester.NewPoolTracker()
+		routeConfig         = domain.CandidateRouteConfig{UpdateHeightInterval: routeUpdateHeightInterval, MaxHops: 2, MaxRoutes: 10}
+	)
+
+	s.setDefaultPoolManagerTakerFee()
+
+	uosmoUSDTPoolID := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(UOSMO, defaultAmount), sdk.NewCoin(USDT, defaultAmount))
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(USDC, defaultAmount), sdk.NewCoin(USDW, defaultAmount))
+
+	sqsKeepers := domain.SQSIngestKeepers{
+		GammKeeper:         s.App.GAMMKeeper,
+		ConcentratedKeeper: s.App.ConcentratedLiquidityKeeper,
+		BankKeeper:         s.App.BankKeeper,
+		ProtorevKeeper:     s.App.ProtoRevKeeper,
+		PoolManagerKeeper:  s.App.PoolManagerKeeper,
+		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
+	}
+
+	poolIngester := poolsingester.NewPoolIngester(sink, sink, sink, assetListGetterMock, poolTracker, 0, routeConfig, sqsKeepers)
+
+	processBlock := func(height int64) {
+		s.Ctx = s.Ctx.WithBlockHeight(height)
+		sink.setRoutes = nil
+		tx := sink.StartTx()
+		s.Require().NoError(poolIngester.ProcessBlock(s.Ctx, tx))
+		s.Require().NoError(tx.Exec(sdk.WrapSDKContext(s.Ctx)))
+	}
+
+	// Startup: the routes of all directions are computed.
+	processBlock(routeUpdateHeightInterval + 1)
+	s.Require().Len(sink.setRoutes, 4)
+
+	// The UOSMO/USDT pool is updated outside of the interval.
+	poolTracker.TrackPoolID(uosmoUSDTPoolID)
+	processBlock(routeUpdateHeightInterval + 2)
+	s.Require().Empty(sink.setRoutes)
+
+	// At the interval, only the routes of the UOSMO/USDT directions are recomputed.
+	processBlock(2 * routeUpdateHeightInterval)
+	s.Require().ElementsMatch([][2]string{{UOSMO, USDT}, {USDT, UOSMO}}, sink.setRoutes)
+
+	// Without updated pools, no routes are recomputed.
+	processBlock(3 * routeUpdateHeightInterval)
+	s.Require().Empty(sink.setRoutes)
+
+	// After a failure, the routes of all directions are recomputed at the next block.
+	poolIngester.(domain.FullResyncRequester).RequestFullResync()
+	processBlock(3*routeUpdateHeightInterval + 1)
+	s.Require().Len(sink.setRoutes, 4)
+}
+
 // validatePoolConversion validates that the pool conversion is correct.
 // It asserts that
 // - the pool ID of the actual pool is equal to the expected pool ID.
@@ -580,7 +863,7 @@ func (s *IngesterTestSuite) initializePoolIngester() *poolsingester.PoolIngester
 		CosmWasmPoolKeeper: s.App.CosmwasmPoolKeeper,
 	}
 
-	atomicIngester := poolsingester.NewPoolIngester(nil, nil, nil, nil, sqsKeepers)
+	atomicIngester := poolsingester.NewPoolIngester(nil, nil, nil, nil, nil, 0, domain.CandidateRouteConfig{}, sqsKeepers)
 	poolIngester, ok := atomicIngester.(*poolsingester.PoolIngester)
 	s.Require().True(ok)
 	return poolIngester
@@ -0,0 +1,250 @@
+package poolsingester
+
+import (
+	"strconv"
+	"sync"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	concentratedtypes "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// poolTracker tracks the pools updated within a block.
+// It implements domain.BlockPoolUpdateTracker.
+// Updates are collected from the gamm hooks, the concentrated liquidity listeners
+// and the events emitted during block execution.
+// Note that updates from CheckTx are ignored. Updates from failed transactions
+// might still be tracked, which only results in redundant writes.
+type poolTracker struct {
+	mu sync.Mutex
+
+	poolIDs            map[uint64]struct{}
+	contractAddresses  map[string]struct{}
+	takerFeeDenomPairs map[sqsdomain.DenomPair]struct{}
+	paramsUpdated      bool
+}
+
+var (
+	_ domain.BlockPoolUpdateTracker                   = &poolTracker{}
+	_ gammtypes.GammHooks                             = &gammHook{}
+	_ concentratedtypes.ConcentratedLiquidityListener = &concentratedLiquidityListener{}
+)
+
+// poolEventTypes are the event types that reference an updated pool with
+// the pool ID attribute.
+var poolEventTypes = map[string]struct{}{
+	poolmanagertypes.TypeEvtPoolCreated:       {},
+	gammtypes.TypeEvtTokenSwapped:             {},
+	gammtypes.TypeEvtPoolJoined:               {},
+	gammtypes.TypeEvtPoolExited:               {},
+	concentratedtypes.TypeEvtCreatePosition:   {},
+	concentratedtypes.TypeEvtWithdrawPosition: {},
+}
+
+// NewPoolTracker returns a new pool tracker.
+func NewPoolTracker() domain.BlockPoolUpdateTracker {
+	return &poolTracker{
+		poolIDs:            map[uint64]struct{}{},
+		contractAddresses:  map[string]struct{}{},
+		takerFeeDenomPairs: map[sqsdomain.DenomPair]struct{}{},
+	}
+}
+
+// TrackPoolID implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) TrackPoolID(poolID uint64) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.poolIDs[poolID] = struct{}{}
+}
+
+// TrackContractAddress implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) TrackContractAddress(address string) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.contractAddresses[address] = struct{}{}
+}
+
+// TrackDenomPair implements domain.BlockPoolUpdateTracker.
+// The denoms are sorted lexicographically to match the taker fee map keys.
+func (pt *poolTracker) TrackDenomPair(denom0, denom1 string) {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.takerFeeDenomPairs[sqsdomain.DenomPair{Denom0: denom0, Denom1: denom1}] = struct{}{}
+}
+
+// TrackParamsUpdate implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) TrackParamsUpdate() {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.paramsUpdated = true
+}
+
+// TrackEvents implements domain.BlockPoolUpdateTracker.
+// It tracks:
+// - pool IDs from pool creation, swap and liquidity events
+// - contract addresses from CosmWasm execute events
+// - denom pairs from taker fee update events
+// - parameter changes from the governance proposals that passed, which are
+// only emitted by the gov end blocker
+func (pt *poolTracker) TrackEvents(events []abci.Event) {
+	for _, event := range events {
+		switch {
+		case event.Type == wasmtypes.EventTypeExecute:
+			if address, ok := getEventAttribute(event, wasmtypes.AttributeKeyContractAddr); ok {
+				pt.TrackContractAddress(address)
+			}
+		case event.Type == poolmanagertypes.TypeMsgSetDenomPairTakerFee:
+			denom0, ok0 := getEventAttribute(event, poolmanagertypes.AttributeKeyDenom0)
+			denom1, ok1 := getEventAttribute(event, poolmanagertypes.AttributeKeyDenom1)
+			if ok0 && ok1 {
+				pt.TrackDenomPair(denom0, denom1)
+			}
+		case event.Type == govtypes.EventTypeActiveProposal:
+			// A passed proposal might change parameters that affect the pools
+			// such as the taker fees or the pool spread factors.
+			if result, ok := getEventAttribute(event, govtypes.AttributeKeyProposalResult); ok && result == govtypes.AttributeValueProposalPassed {
+				pt.TrackParamsUpdate()
+			}
+		default:
+			if _, ok := poolEventTypes[event.Type]; !ok {
+				continue
+			}
+
+			poolIDStr, ok := getEventAttribute(event, poolmanagertypes.AttributeKeyPoolId)
+			if !ok {
+				continue
+			}
+
+			poolID, err := strconv.ParseUint(poolIDStr, 10, 64)
+			if err != nil {
+				continue
+			}
+
+			pt.TrackPoolID(poolID)
+		}
+	}
+}
+
+// GetPoolIDs implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) GetPoolIDs() map[uint64]struct{} {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	return pt.poolIDs
+}
+
+// GetContractAddresses implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) GetContractAddresses() map[string]struct{} {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	return pt.contractAddresses
+}
+
+// GetDenomPairs implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) GetDenomPairs() map[sqsdomain.DenomPair]struct{} {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	return pt.takerFeeDenomPairs
+}
+
+// GetParamsUpdated implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) GetParamsUpdated() bool {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	return pt.paramsUpdated
+}
+
+// Reset implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) Reset() {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.poolIDs = map[uint64]struct{}{}
+	pt.contractAddresses = map[string]struct{}{}
+	pt.takerFeeDenomPairs = map[sqsdomain.DenomPair]struct{}{}
+	pt.paramsUpdated = false
+}
+
+// trackPoolIDFromHook tracks the pool ID unless the hook is triggered in CheckTx.
+func (pt *poolTracker) trackPoolIDFromHook(ctx sdk.Context, poolID uint64) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	pt.TrackPoolID(poolID)
+}
+
+// getEventAttribute returns the value of the first attribute with the given key.
+// Returns false if the attribute is not present.
+func getEventAttribute(event abci.Event, key string) (string, bool) {
+	for _, attribute := range event.Attributes {
+		if attribute.Key == key {
+			return attribute.Value, true
+		}
+	}
+	return "", false
+}
+
+type gammHook struct {
+	pt *poolTracker
+}
+
+// GammHooks implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) GammHooks() gammtypes.GammHooks {
+	return &gammHook{pt}
+}
+
+// AfterCFMMPoolCreated is called after CreatePool run on a CFMM pool from x/gamm.
+func (h *gammHook) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	h.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+// AfterJoinPool is called after JoinPool, JoinSwapExternAmountIn, and JoinSwapShareAmountOut
+func (h *gammHook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
+	h.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+// AfterExitPool is called after ExitPool, ExitSwapShareAmountIn, and ExitSwapExternAmountOut
+func (h *gammHook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins) {
+	h.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+// AfterCFMMSwap is called after SwapExactAmountIn and SwapExactAmountOut in x/gamm.
+func (h *gammHook) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	h.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+type concentratedLiquidityListener struct {
+	pt *poolTracker
+}
+
+// ConcentratedLiquidityListener implements domain.BlockPoolUpdateTracker.
+func (pt *poolTracker) ConcentratedLiquidityListener() concentratedtypes.ConcentratedLiquidityListener {
+	return &concentratedLiquidityListener{pt}
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.pt.trackPoolIDFromHook(ctx, poolId)
+}
+
+func (l *concentratedLiquidityListener) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	l.pt.trackPoolIDFromHook(ctx, poolId)
+}
@@ -0,0 +1,158 @@
+package poolsingester_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolsingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/pools/ingester"
+	cltypes "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// Tests that the pool tracker tracks pool IDs, contract addresses, taker fee
+// denom pairs and parameter changes from the events emitted within a block.
+func (s *IngesterTestSuite) TestPoolTracker_TrackEvents() {
+	const contractAddress = "osmo1contract"
+
+	newEvent := func(eventType string, attributes ...abci.EventAttribute) abci.Event {
+		return abci.Event{Type: eventType, Attributes: attributes}
+	}
+
+	poolIDAttribute := func(poolID string) abci.EventAttribute {
+		return abci.EventAttribute{Key: poolmanagertypes.AttributeKeyPoolId, Value: poolID}
+	}
+
+	tests := map[string]struct {
+		events []abci.Event
+
+		expectedPoolIDs           map[uint64]struct{}
+		expectedContractAddresses map[string]struct{}
+		expectedDenomPairs        map[sqsdomain.DenomPair]struct{}
+		expectedParamsUpdated     bool
+	}{
+		"pool events": {
+			events: []abci.Event{
+				newEvent(poolmanagertypes.TypeEvtPoolCreated, poolIDAttribute("1")),
+				newEvent(gammtypes.TypeEvtTokenSwapped, poolIDAttribute("2")),
+				newEvent(gammtypes.TypeEvtPoolJoined, poolIDAttribute("3")),
+				newEvent(gammtypes.TypeEvtPoolExited, poolIDAttribute("3")),
+				newEvent(cltypes.TypeEvtCreatePosition, poolIDAttribute("4")),
+				newEvent(cltypes.TypeEvtWithdrawPosition, poolIDAttribute("5")),
+			},
+
+			expectedPoolIDs: map[uint64]struct{}{1: {}, 2: {}, 3: {}, 4: {}, 5: {}},
+		},
+		"unrelated events and malformed pool IDs are ignored": {
+			events: []abci.Event{
+				newEvent(sdk.EventTypeMessage, poolIDAttribute("1")),
+				newEvent(gammtypes.TypeEvtTokenSwapped, poolIDAttribute("invalid")),
+				newEvent(gammtypes.TypeEvtTokenSwapped),
+			},
+		},
+		"contract execution": {
+			events: []abci.Event{
+				newEvent(wasmtypes.EventTypeExecute, abci.EventAttribute{Key: wasmtypes.AttributeKeyContractAddr, Value: contractAddress}),
+			},
+
+			expectedContractAddresses: map[string]struct{}{contractAddress: {}},
+		},
+		"taker fee update with unsorted denoms": {
+			events: []abci.Event{
+				newEvent(poolmanagertypes.TypeMsgSetDenomPairTakerFee,
+					abci.EventAttribute{Key: poolmanagertypes.AttributeKeyDenom0, Value: USDT},
+					abci.EventAttribute{Key: poolmanagertypes.AttributeKeyDenom1, Value: USDC},
+				),
+			},
+
+			expectedDenomPairs: map[sqsdomain.DenomPair]struct{}{{Denom0: USDC, Denom1: USDT}: {}},
+		},
+		"passed governance proposal": {
+			events: []abci.Event{
+				newEvent(govtypes.EventTypeActiveProposal,
+					abci.EventAttribute{Key: govtypes.AttributeKeyProposalID, Value: "1"},
+					abci.EventAttribute{Key: govtypes.AttributeKeyProposalResult, Value: govtypes.AttributeValueProposalPassed},
+				),
+			},
+
+			expectedParamsUpdated: true,
+		},
+		"rejected governance proposal is ignored": {
+			events: []abci.Event{
+				newEvent(govtypes.EventTypeActiveProposal,
+					abci.EventAttribute{Key: govtypes.AttributeKeyProposalID, Value: "1"},
+					abci.EventAttribute{Key: govtypes.AttributeKeyProposalResult, Value: govtypes.AttributeValueProposalRejected},
+				),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			poolTracker := poolsingester.NewPoolTracker()
+
+			poolTracker.TrackEvents(tc.events)
+
+			s.Require().Equal(len(tc.expectedPoolIDs), len(poolTracker.GetPoolIDs()))
+			for poolID := range tc.expectedPoolIDs {
+				s.Require().Contains(poolTracker.GetPoolIDs(), poolID)
+			}
+
+			s.Require().Equal(len(tc.expectedContractAddresses), len(poolTracker.GetContractAddresses()))
+			for address := range tc.expectedContractAddresses {
+				s.Require().Contains(poolTracker.GetContractAddresses(), address)
+			}
+
+			s.Require().Equal(len(tc.expectedDenomPairs), len(poolTracker.GetDenomPairs()))
+			for denomPair := range tc.expectedDenomPairs {
+				s.Require().Contains(poolTracker.GetDenomPairs(), denomPair)
+			}
+
+			s.Require().Equal(tc.expectedParamsUpdated, poolTracker.GetParamsUpdated())
+
+			// Reset clears everything.
+			poolTracker.Reset()
+			s.Require().Empty(poolTracker.GetPoolIDs())
+			s.Require().Empty(poolTracker.GetContractAddresses())
+			s.Require().Empty(poolTracker.GetDenomPairs())
+			s.Require().False(poolTracker.GetParamsUpdated())
+		})
+	}
+}
+
+// Tests that the pool tracker hooks track pool IDs in DeliverTx
+// and ignore updates in CheckTx.
+func (s *IngesterTestSuite) TestPoolTracker_Hooks() {
+	s.Setup()
+
+	poolTracker := poolsingester.NewPoolTracker()
+	gammHooks := poolTracker.GammHooks()
+	clListener := poolTracker.ConcentratedLiquidityListener()
+
+	sender := s.TestAccs[0]
+
+	// CheckTx updates are ignored.
+	checkTxCtx := s.Ctx.WithIsCheckTx(true)
+	gammHooks.AfterCFMMSwap(checkTxCtx, sender, 1, sdk.Coins{}, sdk.Coins{})
+	clListener.AfterConcentratedPoolSwap(checkTxCtx, sender, 2, sdk.Coins{}, sdk.Coins{})
+	s.Require().Empty(poolTracker.GetPoolIDs())
+
+	gammHooks.AfterCFMMPoolCreated(s.Ctx, sender, 1)
+	gammHooks.AfterJoinPool(s.Ctx, sender, 2, sdk.Coins{}, osmomath.ZeroInt())
+	gammHooks.AfterExitPool(s.Ctx, sender, 3, osmomath.ZeroInt(), sdk.Coins{})
+	gammHooks.AfterCFMMSwap(s.Ctx, sender, 4, sdk.Coins{}, sdk.Coins{})
+	clListener.AfterConcentratedPoolCreated(s.Ctx, sender, 5)
+	clListener.AfterInitialPoolPositionCreated(s.Ctx, sender, 6)
+	clListener.AfterLastPoolPositionRemoved(s.Ctx, sender, 7)
+	clListener.AfterConcentratedPoolSwap(s.Ctx, sender, 8, sdk.Coins{}, sdk.Coins{})
+
+	s.Require().Len(poolTracker.GetPoolIDs(), 8)
+	for poolID := uint64(1); poolID <= 8; poolID++ {
+		s.Require().Contains(poolTracker.GetPoolIDs(), poolID)
+	}
+}
@@ -0,0 +1,239 @@
+package bufferedsink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+)
+
+// bufferedSink is a sink that buffers the writes of a transaction keyed by what they write,
+// so that the transactions of consecutive blocks can be merged with the latest write of every key winning.
+// On Exec, the buffered writes are written into a transaction of the underlying sink.
+// It implements domain.Sink.
+type bufferedSink struct {
+	domain.Sink
+}
+
+// routesKey is the key of the candidate routes from the token in denom to the token out denom.
+type routesKey struct {
+	tokenInDenom  string
+	tokenOutDenom string
+}
+
+// bufferedTx buffers the writes keyed by pool ID, denom pair or routes direction until Exec is called.
+// The writes are kept on failure so that Exec can be retried.
+// It implements domain.MergeableTx.
+type bufferedTx struct {
+	sink *bufferedSink
+
+	pools        map[uint64]sqsdomain.PoolI
+	takerFees    map[sqsdomain.DenomPair]osmomath.Dec
+	routes       map[routesKey]sqsdomain.CandidateRoutes
+	latestHeight *uint64
+	chainInfo    *domain.ChainInfo
+}
+
+var (
+	_ domain.Sink        = &bufferedSink{}
+	_ domain.MergeableTx = &bufferedTx{}
+)
+
+// New wraps the given sink into a sink whose transactions can be merged.
+func New(sink domain.Sink) domain.Sink {
+	return &bufferedSink{
+		Sink: sink,
+	}
+}
+
+// StartTx implements domain.Sink.
+func (s *bufferedSink) StartTx() domain.Tx {
+	return &bufferedTx{
+		sink:      s,
+		pools:     map[uint64]sqsdomain.PoolI{},
+		takerFees: map[sqsdomain.DenomPair]osmomath.Dec{},
+		routes:    map[routesKey]sqsdomain.CandidateRoutes{},
+	}
+}
+
+// StorePools implements domain.Sink.
+func (s *bufferedSink) StorePools(ctx context.Context, tx domain.Tx, pools []sqsdomain.PoolI) error {
+	bTx, err := s.asBufferedTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		bTx.pools[pool.GetId()] = pool
+	}
+
+	return nil
+}
+
+// SetTakerFee implements domain.Sink.
+func (s *bufferedSink) SetTakerFee(ctx context.Context, tx domain.Tx, denom0, denom1 string, takerFee osmomath.Dec) error {
+	bTx, err := s.asBufferedTx(tx)
+	if err != nil {
+		return err
+	}
+
+	bTx.takerFees[newDenomPair(denom0, denom1)] = takerFee
+	return nil
+}
+
+// SetRoutes implements domain.Sink.
+func (s *bufferedSink) SetRoutes(ctx context.Context, tx domain.Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error {
+	bTx, err := s.asBufferedTx(tx)
+	if err != nil {
+		return err
+	}
+
+	bTx.routes[routesKey{tokenInDenom: tokenInDenom, tokenOutDenom: tokenOutDenom}] = routes
+	return nil
+}
+
+// StoreLatestHeight implements domain.Sink.
+func (s *bufferedSink) StoreLatestHeight(ctx context.Context, tx domain.Tx, height uint64) error {
+	bTx, err := s.asBufferedTx(tx)
+	if err != nil {
+		return err
+	}
+
+	bTx.latestHeight = &height
+	return nil
+}
+
+// StoreChainInfo implements domain.Sink.
+func (s *bufferedSink) StoreChainInfo(ctx context.Context, tx domain.Tx, chainInfo domain.ChainInfo) error {
+	bTx, err := s.asBufferedTx(tx)
+	if err != nil {
+		return err
+	}
+
+	bTx.chainInfo = &chainInfo
+	return nil
+}
+
+// Merge implements domain.MergeableTx.
+func (t *bufferedTx) Merge(later domain.Tx) bool {
+	laterTx, ok := later.(*bufferedTx)
+	if !ok || laterTx.sink != t.sink {
+		return false
+	}
+
+	for poolID, pool := range laterTx.pools {
+		t.pools[poolID] = pool
+	}
+	for denomPair, takerFee := range laterTx.takerFees {
+		t.takerFees[denomPair] = takerFee
+	}
+	for key, routes := range laterTx.routes {
+		t.routes[key] = routes
+	}
+	if laterTx.latestHeight != nil {
+		t.latestHeight = laterTx.latestHeight
+	}
+	if laterTx.chainInfo != nil {
+		t.chainInfo = laterTx.chainInfo
+	}
+
+	return true
+}
+
+// Exec implements domain.Tx.
+// The buffered writes are written in a deterministic order into a new transaction of the underlying sink
+// so that a failed Exec can be retried.
+func (t *bufferedTx) Exec(ctx context.Context) error {
+	sink := t.sink.Sink
+	tx := sink.StartTx()
+
+	if len(t.pools) > 0 {
+		pools := make([]sqsdomain.PoolI, 0, len(t.pools))
+		for _, pool := range t.pools {
+			pools = append(pools, pool)
+		}
+		sort.Slice(pools, func(i, j int) bool {
+			return pools[i].GetId() < pools[j].GetId()
+		})
+
+		if err := sink.StorePools(ctx, tx, pools); err != nil {
+			return err
+		}
+	}
+
+	denomPairs := make([]sqsdomain.DenomPair, 0, len(t.takerFees))
+	for denomPair := range t.takerFees {
+		denomPairs = append(denomPairs, denomPair)
+	}
+	sort.Slice(denomPairs, func(i, j int) bool {
+		if denomPairs[i].Denom0 != denomPairs[j].Denom0 {
+			return denomPairs[i].Denom0 < denomPairs[j].Denom0
+		}
+		return denomPairs[i].Denom1 < denomPairs[j].Denom1
+	})
+	for _, denomPair := range denomPairs {
+		if err := sink.SetTakerFee(ctx, tx, denomPair.Denom0, denomPair.Denom1, t.takerFees[denomPair]); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]routesKey, 0, len(t.routes))
+	for key := range t.routes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].tokenInDenom != keys[j].tokenInDenom {
+			return keys[i].tokenInDenom < keys[j].tokenInDenom
+		}
+		return keys[i].tokenOutDenom < keys[j].tokenOutDenom
+	})
+	for _, key := range keys {
+		if err := sink.SetRoutes(ctx, tx, key.tokenInDenom, key.tokenOutDenom, t.routes[key]); err != nil {
+			return err
+		}
+	}
+
+	if t.latestHeight != nil {
+		if err := sink.StoreLatestHeight(ctx, tx, *t.latestHeight); err != nil {
+			return err
+		}
+	}
+
+	if t.chainInfo != nil {
+		if err := sink.StoreChainInfo(ctx, tx, *t.chainInfo); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Exec(ctx); err != nil {
+		return err
+	}
+
+	t.pools = map[uint64]sqsdomain.PoolI{}
+	t.takerFees = map[sqsdomain.DenomPair]osmomath.Dec{}
+	t.routes = map[routesKey]sqsdomain.CandidateRoutes{}
+	t.latestHeight, t.chainInfo = nil, nil
+	return nil
+}
+
+// asBufferedTx returns the given transaction as a transaction of this sink.
+// Returns error if the transaction was not started by this sink.
+func (s *bufferedSink) asBufferedTx(tx domain.Tx) (*bufferedTx, error) {
+	bTx, ok := tx.(*bufferedTx)
+	if !ok || bTx.sink != s {
+		return nil, fmt.Errorf("transaction %T was not started by this buffered sink", tx)
+	}
+	return bTx, nil
+}
+
+// newDenomPair returns a denom pair with the denoms sorted lexicographically.
+func newDenomPair(denom0, denom1 string) sqsdomain.DenomPair {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return sqsdomain.DenomPair{Denom0: denom0, Denom1: denom1}
+}
@@ -0,0 +1,107 @@
+package bufferedsink_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	bufferedsink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/buffered"
+	memorysink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/memory"
+)
+
+type BufferedSinkTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestBufferedSinkTestSuite(t *testing.T) {
+	suite.Run(t, new(BufferedSinkTestSuite))
+}
+
+// Tests that merging the transaction of a later block keeps the latest write of every pool, denom pair
+// and chain information, that nothing is written until Exec and that transactions of other sinks are not merged.
+func (s *BufferedSinkTestSuite) TestMerge() {
+	s.Setup()
+
+	var (
+		goCtx      = sdk.WrapSDKContext(s.Ctx)
+		memorySink = memorysink.New()
+		sink       = bufferedsink.New(memorySink)
+	)
+
+	poolIDs := []uint64{s.PrepareBalancerPool(), s.PrepareBalancerPool()}
+	newPool := func(poolID uint64, tvl int64) sqsdomain.PoolI {
+		pool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolID)
+		s.Require().NoError(err)
+		return &sqsdomain.PoolWrapper{
+			ChainModel: pool,
+			SQSModel: sqsdomain.SQSPool{
+				TotalValueLockedUSDC: osmomath.NewInt(tvl),
+				Balances:             sdk.NewCoins(),
+				SpreadFactor:         pool.GetSpreadFactor(s.Ctx),
+			},
+		}
+	}
+
+	routes := sqsdomain.CandidateRoutes{
+		Routes: []sqsdomain.CandidateRoute{
+			{Pools: []sqsdomain.CandidatePool{{ID: poolIDs[0], TokenOutDenom: "uatom"}}},
+		},
+		UniquePoolIDs: map[uint64]struct{}{poolIDs[0]: {}},
+	}
+
+	// The transaction of the earlier block writes both pools, a taker fee, the height and the chain information.
+	tx := sink.StartTx()
+	s.Require().NoError(sink.StorePools(goCtx, tx, []sqsdomain.PoolI{newPool(poolIDs[0], 100), newPool(poolIDs[1], 100)}))
+	s.Require().NoError(sink.SetTakerFee(goCtx, tx, "uosmo", "uatom", osmomath.MustNewDecFromStr("0.001")))
+	s.Require().NoError(sink.StoreLatestHeight(goCtx, tx, 1))
+	s.Require().NoError(sink.StoreChainInfo(goCtx, tx, domain.ChainInfo{Height: 1}))
+
+	// The transaction of the later block overwrites the first pool and the taker fee, and writes routes and a new height.
+	laterTx := sink.StartTx()
+	s.Require().NoError(sink.StorePools(goCtx, laterTx, []sqsdomain.PoolI{newPool(poolIDs[0], 200)}))
+	s.Require().NoError(sink.SetTakerFee(goCtx, laterTx, "uatom", "uosmo", osmomath.MustNewDecFromStr("0.002")))
+	s.Require().NoError(sink.SetRoutes(goCtx, laterTx, "uosmo", "uatom", routes))
+	s.Require().NoError(sink.StoreLatestHeight(goCtx, laterTx, 2))
+
+	mergeableTx, ok := tx.(domain.MergeableTx)
+	s.Require().True(ok)
+	s.Require().True(mergeableTx.Merge(laterTx))
+
+	// Transactions of other sinks cannot be merged.
+	s.Require().False(mergeableTx.Merge(memorySink.StartTx()))
+	s.Require().False(mergeableTx.Merge(bufferedsink.New(memorySink).StartTx()))
+
+	// Nothing is written before Exec.
+	s.Require().Empty(memorySink.GetAllPools())
+
+	s.Require().NoError(tx.Exec(goCtx))
+
+	pools := memorySink.GetAllPools()
+	s.Require().Len(pools, 2)
+	s.Require().Equal(osmomath.NewInt(200), pools[0].GetSQSPoolModel().TotalValueLockedUSDC)
+	s.Require().Equal(osmomath.NewInt(100), pools[1].GetSQSPoolModel().TotalValueLockedUSDC)
+
+	takerFee, err := memorySink.GetTakerFee("uosmo", "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.002"), takerFee)
+
+	actualRoutes, ok := memorySink.GetRoutes("uosmo", "uatom")
+	s.Require().True(ok)
+	s.Require().Equal(routes, actualRoutes)
+
+	latestHeight, err := memorySink.GetLatestHeight(goCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), latestHeight)
+
+	// The chain information is kept from the earlier transaction since the later one did not write it.
+	s.Require().Equal(uint64(1), memorySink.GetChainInfo().Height)
+
+	// Writing into a transaction of another sink fails.
+	s.Require().Error(sink.StorePools(goCtx, memorySink.StartTx(), pools))
+}
@@ -0,0 +1,320 @@
+package filesink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+const (
+	dbName = "sqs"
+
+	// denomPairSeparator separates the denoms in the taker fee and route keys.
+	// It cannot be part of a valid denom.
+	denomPairSeparator = "|"
+)
+
+var (
+	poolsPrefix         = []byte("pools/")
+	takerFeesPrefix     = []byte("taker_fees/")
+	routesPrefix        = []byte("routes/")
+	latestHeightKey     = []byte("latest_height")
+	chainInfoKey        = []byte("chain_info")
+	errTakerFeeNotFound = fmt.Errorf("taker fee not found")
+)
+
+// FileSink is a sink that writes the ingested data into an embedded LevelDB snapshot store.
+// It allows running the ingester without an external storage such as Redis.
+// It implements domain.Sink.
+type FileSink struct {
+	db       dbm.DB
+	appCodec codec.Codec
+}
+
+// fileTx buffers writes until Exec is called. On Exec, all writes are
+// flushed to the database in a single batch.
+// It implements domain.Tx.
+type fileTx struct {
+	sink   *FileSink
+	keys   [][]byte
+	values [][]byte
+}
+
+// poolRecord is the stored representation of a pool.
+type poolRecord struct {
+	// ChainModel is the chain pool model serialized with the app codec.
+	ChainModel json.RawMessage      `json:"chain_model"`
+	SQSModel   sqsdomain.SQSPool    `json:"sqs_model"`
+	TickModel  *sqsdomain.TickModel `json:"tick_model,omitempty"`
+}
+
+var (
+	_ domain.Sink = &FileSink{}
+	_ domain.Tx   = &fileTx{}
+)
+
+// New creates a new file sink that stores the data in a LevelDB database in the given directory.
+// The directory is created if it does not exist.
+func New(appCodec codec.Codec, dir string) (*FileSink, error) {
+	db, err := dbm.NewDB(dbName, dbm.GoLevelDBBackend, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{
+		db:       db,
+		appCodec: appCodec,
+	}, nil
+}
+
+// StartTx implements domain.Sink.
+func (s *FileSink) StartTx() domain.Tx {
+	return &fileTx{
+		sink: s,
+	}
+}
+
+// StorePools implements domain.Sink.
+func (s *FileSink) StorePools(ctx context.Context, tx domain.Tx, pools []sqsdomain.PoolI) error {
+	fTx, err := s.asFileTx(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		poolWrapper, ok := pool.(*sqsdomain.PoolWrapper)
+		if !ok {
+			return fmt.Errorf("expected pool of type %T, got %T", &sqsdomain.PoolWrapper{}, pool)
+		}
+
+		chainModelBz, err := s.appCodec.MarshalInterfaceJSON(poolWrapper.ChainModel)
+		if err != nil {
+			return err
+		}
+
+		recordBz, err := json.Marshal(poolRecord{
+			ChainModel: chainModelBz,
+			SQSModel:   poolWrapper.SQSModel,
+			TickModel:  poolWrapper.TickModel,
+		})
+		if err != nil {
+			return err
+		}
+
+		fTx.set(formatPoolKey(pool.GetId()), recordBz)
+	}
+
+	return nil
+}
+
+// SetTakerFee implements domain.Sink.
+func (s *FileSink) SetTakerFee(ctx context.Context, tx domain.Tx, denom0, denom1 string, takerFee osmomath.Dec) error {
+	fTx, err := s.asFileTx(tx)
+	if err != nil {
+		return err
+	}
+
+	fTx.set(formatTakerFeeKey(denom0, denom1), []byte(takerFee.String()))
+	return nil
+}
+
+// SetRoutes implements domain.Sink.
+func (s *FileSink) SetRoutes(ctx context.Context, tx domain.Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error {
+	fTx, err := s.asFileTx(tx)
+	if err != nil {
+		return err
+	}
+
+	routesBz, err := json.Marshal(routes)
+	if err != nil {
+		return err
+	}
+
+	fTx.set(formatRoutesKey(tokenInDenom, tokenOutDenom), routesBz)
+	return nil
+}
+
+// StoreLatestHeight implements domain.Sink.
+func (s *FileSink) StoreLatestHeight(ctx context.Context, tx domain.Tx, height uint64) error {
+	fTx, err := s.asFileTx(tx)
+	if err != nil {
+		return err
+	}
+
+	fTx.set(latestHeightKey, []byte(strconv.FormatUint(height, 10)))
+	return nil
+}
+
+// StoreChainInfo implements domain.Sink.
+func (s *FileSink) StoreChainInfo(ctx context.Context, tx domain.Tx, chainInfo domain.ChainInfo) error {
+	fTx, err := s.asFileTx(tx)
+	if err != nil {
+		return err
+	}
+
+	chainInfoBz, err := json.Marshal(chainInfo)
+	if err != nil {
+		return err
+	}
+
+	fTx.set(chainInfoKey, chainInfoBz)
+	return nil
+}
+
+// Close implements domain.Sink.
+func (s *FileSink) Close() error {
+	return s.db.Close()
+}
+
+// GetAllPools returns all pools in the snapshot sorted by ID.
+func (s *FileSink) GetAllPools() ([]sqsdomain.PoolI, error) {
+	iterator, err := s.db.Iterator(poolsPrefix, sdk.PrefixEndBytes(poolsPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	pools := []sqsdomain.PoolI{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record poolRecord
+		if err := json.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+
+		var chainModel poolmanagertypes.PoolI
+		if err := s.appCodec.UnmarshalInterfaceJSON(record.ChainModel, &chainModel); err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, &sqsdomain.PoolWrapper{
+			ChainModel: chainModel,
+			SQSModel:   record.SQSModel,
+			TickModel:  record.TickModel,
+		})
+	}
+
+	return pools, iterator.Error()
+}
+
+// GetTakerFee returns the taker fee for the given denom pair from the snapshot.
+// Returns error if the taker fee is not in the snapshot.
+func (s *FileSink) GetTakerFee(denom0, denom1 string) (osmomath.Dec, error) {
+	bz, err := s.db.Get(formatTakerFeeKey(denom0, denom1))
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	if bz == nil {
+		return osmomath.Dec{}, fmt.Errorf("%w for denom pair (%s, %s)", errTakerFeeNotFound, denom0, denom1)
+	}
+
+	return osmomath.NewDecFromStr(string(bz))
+}
+
+// GetRoutes returns the candidate routes from the token in denom to the token out denom from the snapshot.
+// Returns empty routes if no routes were stored for the denom pair in that direction.
+func (s *FileSink) GetRoutes(tokenInDenom, tokenOutDenom string) (sqsdomain.CandidateRoutes, error) {
+	bz, err := s.db.Get(formatRoutesKey(tokenInDenom, tokenOutDenom))
+	if err != nil || bz == nil {
+		return sqsdomain.CandidateRoutes{}, err
+	}
+
+	var routes sqsdomain.CandidateRoutes
+	if err := json.Unmarshal(bz, &routes); err != nil {
+		return sqsdomain.CandidateRoutes{}, err
+	}
+
+	return routes, nil
+}
+
+// GetLatestHeight implements domain.Sink.
+func (s *FileSink) GetLatestHeight(ctx context.Context) (uint64, error) {
+	bz, err := s.db.Get(latestHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(bz), 10, 64)
+}
+
+// GetChainInfo returns the information about the latest block from the snapshot.
+// Returns false if no chain information was stored yet.
+func (s *FileSink) GetChainInfo() (domain.ChainInfo, bool, error) {
+	bz, err := s.db.Get(chainInfoKey)
+	if err != nil || bz == nil {
+		return domain.ChainInfo{}, false, err
+	}
+
+	var chainInfo domain.ChainInfo
+	if err := json.Unmarshal(bz, &chainInfo); err != nil {
+		return domain.ChainInfo{}, false, err
+	}
+
+	return chainInfo, true, nil
+}
+
+// Exec implements domain.Tx.
+func (t *fileTx) Exec(ctx context.Context) error {
+	batch := t.sink.db.NewBatch()
+	defer batch.Close()
+
+	for i, key := range t.keys {
+		if err := batch.Set(key, t.values[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	t.keys, t.values = nil, nil
+	return nil
+}
+
+// set buffers the write of the given key and value.
+func (t *fileTx) set(key, value []byte) {
+	t.keys = append(t.keys, key)
+	t.values = append(t.values, value)
+}
+
+// asFileTx returns the given transaction as a transaction of this sink.
+// Returns error if the transaction was not started by this sink.
+func (s *FileSink) asFileTx(tx domain.Tx) (*fileTx, error) {
+	fTx, ok := tx.(*fileTx)
+	if !ok || fTx.sink != s {
+		return nil, fmt.Errorf("transaction %T was not started by this file sink", tx)
+	}
+	return fTx, nil
+}
+
+// formatPoolKey returns the key of the pool with the given ID.
+// The ID is big endian encoded so that the pools are iterated in order of IDs.
+func formatPoolKey(poolID uint64) []byte {
+	return append(append([]byte{}, poolsPrefix...), sdk.Uint64ToBigEndian(poolID)...)
+}
+
+// formatTakerFeeKey returns the key of the taker fee for the given denom pair.
+// The denoms are sorted lexicographically so that the key does not depend on their order.
+func formatTakerFeeKey(denom0, denom1 string) []byte {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return []byte(string(takerFeesPrefix) + denom0 + denomPairSeparator + denom1)
+}
+
+// formatRoutesKey returns the key of the candidate routes from the token in denom to the token out denom.
+// Unlike taker fees, routes are directional so the denoms are not sorted.
+func formatRoutesKey(tokenInDenom, tokenOutDenom string) []byte {
+	return []byte(string(routesPrefix) + tokenInDenom + denomPairSeparator + tokenOutDenom)
+}
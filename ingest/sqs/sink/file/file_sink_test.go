@@ -0,0 +1,153 @@
+package filesink_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+	filesink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/file"
+	memorysink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/memory"
+)
+
+type FileSinkTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestFileSinkTestSuite(t *testing.T) {
+	suite.Run(t, new(FileSinkTestSuite))
+}
+
+// Tests that the pools, taker fees, latest height and chain info are persisted on Exec,
+// survive reopening the sink and that transactions of other sinks are rejected.
+func (s *FileSinkTestSuite) TestStoreAndReopen() {
+	s.Setup()
+
+	var (
+		dir            = s.T().TempDir()
+		goCtx          = sdk.WrapSDKContext(s.Ctx)
+		takerFee       = osmomath.MustNewDecFromStr("0.002")
+		expectedHeight = uint64(10)
+	)
+
+	balancerPoolID := s.PrepareBalancerPool()
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+
+	concentratedPool := s.PrepareConcentratedPool()
+
+	pools := []sqsdomain.PoolI{
+		// Stored out of order to validate that pools are returned sorted by ID.
+		&sqsdomain.PoolWrapper{
+			ChainModel: concentratedPool,
+			SQSModel: sqsdomain.SQSPool{
+				TotalValueLockedUSDC: osmomath.NewInt(200),
+				Balances:             sdk.NewCoins(),
+				SpreadFactor:         concentratedPool.GetSpreadFactor(s.Ctx),
+			},
+			TickModel: &sqsdomain.TickModel{},
+		},
+		&sqsdomain.PoolWrapper{
+			ChainModel: balancerPool,
+			SQSModel: sqsdomain.SQSPool{
+				TotalValueLockedUSDC: osmomath.NewInt(100),
+				Balances:             sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(1))),
+				SpreadFactor:         balancerPool.GetSpreadFactor(s.Ctx),
+			},
+		},
+	}
+
+	routes := sqsdomain.CandidateRoutes{
+		Routes: []sqsdomain.CandidateRoute{
+			{Pools: []sqsdomain.CandidatePool{{ID: balancerPoolID, TokenOutDenom: "uatom"}}},
+		},
+		UniquePoolIDs: map[uint64]struct{}{balancerPoolID: {}},
+	}
+
+	chainInfo := domain.ChainInfo{
+		Height:         expectedHeight,
+		BlockTime:      time.Unix(1_700_000_000, 0).UTC(),
+		BaseFee:        osmomath.MustNewDecFromStr("0.0025"),
+		TotalGasWanted: 1_000_000,
+		FeeBaseDenom:   "uosmo",
+		FeeTokens: []domain.FeeToken{
+			{Denom: "uatom", PoolID: balancerPoolID, SpotPrice: osmomath.MustNewBigDecFromStr("1.5")},
+		},
+	}
+
+	sink, err := filesink.New(s.App.AppCodec(), dir)
+	s.Require().NoError(err)
+
+	_, found, err := sink.GetChainInfo()
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	tx := sink.StartTx()
+	s.Require().NoError(sink.StorePools(goCtx, tx, pools))
+	s.Require().NoError(sink.SetTakerFee(goCtx, tx, "uosmo", "uatom", takerFee))
+	s.Require().NoError(sink.StoreLatestHeight(goCtx, tx, expectedHeight))
+	s.Require().NoError(sink.SetRoutes(goCtx, tx, "uosmo", "uatom", routes))
+	s.Require().NoError(sink.StoreChainInfo(goCtx, tx, chainInfo))
+
+	// Nothing is persisted before Exec.
+	actualPools, err := sink.GetAllPools()
+	s.Require().NoError(err)
+	s.Require().Empty(actualPools)
+
+	s.Require().NoError(tx.Exec(goCtx))
+
+	// Transactions of other sinks are rejected.
+	err = sink.StoreLatestHeight(goCtx, memorysink.New().StartTx(), expectedHeight)
+	s.Require().Error(err)
+
+	// Reopen the sink to validate that the data is persisted.
+	s.Require().NoError(sink.Close())
+	sink, err = filesink.New(s.App.AppCodec(), dir)
+	s.Require().NoError(err)
+	defer sink.Close()
+
+	actualPools, err = sink.GetAllPools()
+	s.Require().NoError(err)
+	s.Require().Len(actualPools, 2)
+
+	s.Require().Equal(balancerPoolID, actualPools[0].GetId())
+	s.Require().Equal(pools[1].GetTotalValueLockedUOSMO(), actualPools[0].GetTotalValueLockedUOSMO())
+	s.Require().Equal(pools[1].GetPoolDenoms(), actualPools[0].GetPoolDenoms())
+
+	s.Require().Equal(concentratedPool.GetId(), actualPools[1].GetId())
+	s.Require().Equal(pools[0].GetTotalValueLockedUOSMO(), actualPools[1].GetTotalValueLockedUOSMO())
+	_, err = actualPools[1].GetTickModel()
+	s.Require().NoError(err)
+
+	// Denom order does not matter.
+	actualTakerFee, err := sink.GetTakerFee("uatom", "uosmo")
+	s.Require().NoError(err)
+	s.Require().Equal(takerFee, actualTakerFee)
+
+	_, err = sink.GetTakerFee("uatom", "uion")
+	s.Require().Error(err)
+
+	actualHeight, err := sink.GetLatestHeight(goCtx)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedHeight, actualHeight)
+
+	actualChainInfo, found, err := sink.GetChainInfo()
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(chainInfo, actualChainInfo)
+
+	actualRoutes, err := sink.GetRoutes("uosmo", "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(routes, actualRoutes)
+
+	// Routes are directional.
+	actualRoutes, err = sink.GetRoutes("uatom", "uosmo")
+	s.Require().NoError(err)
+	s.Require().Empty(actualRoutes.Routes)
+}
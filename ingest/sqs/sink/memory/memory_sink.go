@@ -0,0 +1,223 @@
+package memorysink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+)
+
+// MemorySink is a sink that keeps the ingested data in memory.
+// It is meant for tests and for running the ingester without any external storage.
+// It implements domain.Sink.
+type MemorySink struct {
+	mu sync.RWMutex
+
+	pools        map[uint64]sqsdomain.PoolI
+	takerFees    map[sqsdomain.DenomPair]osmomath.Dec
+	routes       map[routesKey]sqsdomain.CandidateRoutes
+	latestHeight uint64
+	chainInfo    domain.ChainInfo
+}
+
+// routesKey is the key of the candidate routes from the token in denom to the token out denom.
+type routesKey struct {
+	tokenInDenom  string
+	tokenOutDenom string
+}
+
+// memoryTx buffers writes until Exec is called.
+// It implements domain.Tx.
+type memoryTx struct {
+	sink   *MemorySink
+	writes []func()
+}
+
+var (
+	_ domain.Sink = &MemorySink{}
+	_ domain.Tx   = &memoryTx{}
+)
+
+// New creates a new empty in-memory sink.
+func New() *MemorySink {
+	return &MemorySink{
+		pools:     map[uint64]sqsdomain.PoolI{},
+		takerFees: map[sqsdomain.DenomPair]osmomath.Dec{},
+		routes:    map[routesKey]sqsdomain.CandidateRoutes{},
+	}
+}
+
+// StartTx implements domain.Sink.
+func (s *MemorySink) StartTx() domain.Tx {
+	return &memoryTx{
+		sink: s,
+	}
+}
+
+// StorePools implements domain.Sink.
+func (s *MemorySink) StorePools(ctx context.Context, tx domain.Tx, pools []sqsdomain.PoolI) error {
+	memTx, err := s.asMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	memTx.writes = append(memTx.writes, func() {
+		for _, pool := range pools {
+			s.pools[pool.GetId()] = pool
+		}
+	})
+
+	return nil
+}
+
+// SetTakerFee implements domain.Sink.
+func (s *MemorySink) SetTakerFee(ctx context.Context, tx domain.Tx, denom0, denom1 string, takerFee osmomath.Dec) error {
+	memTx, err := s.asMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	memTx.writes = append(memTx.writes, func() {
+		s.takerFees[newDenomPair(denom0, denom1)] = takerFee
+	})
+
+	return nil
+}
+
+// SetRoutes implements domain.Sink.
+func (s *MemorySink) SetRoutes(ctx context.Context, tx domain.Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error {
+	memTx, err := s.asMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	memTx.writes = append(memTx.writes, func() {
+		s.routes[routesKey{tokenInDenom: tokenInDenom, tokenOutDenom: tokenOutDenom}] = routes
+	})
+
+	return nil
+}
+
+// StoreLatestHeight implements domain.Sink.
+func (s *MemorySink) StoreLatestHeight(ctx context.Context, tx domain.Tx, height uint64) error {
+	memTx, err := s.asMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	memTx.writes = append(memTx.writes, func() {
+		s.latestHeight = height
+	})
+
+	return nil
+}
+
+// StoreChainInfo implements domain.Sink.
+func (s *MemorySink) StoreChainInfo(ctx context.Context, tx domain.Tx, chainInfo domain.ChainInfo) error {
+	memTx, err := s.asMemoryTx(tx)
+	if err != nil {
+		return err
+	}
+
+	memTx.writes = append(memTx.writes, func() {
+		s.chainInfo = chainInfo
+	})
+
+	return nil
+}
+
+// Close implements domain.Sink.
+func (s *MemorySink) Close() error {
+	return nil
+}
+
+// GetAllPools returns all pools in the sink sorted by ID.
+func (s *MemorySink) GetAllPools() []sqsdomain.PoolI {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pools := make([]sqsdomain.PoolI, 0, len(s.pools))
+	for _, pool := range s.pools {
+		pools = append(pools, pool)
+	}
+
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].GetId() < pools[j].GetId()
+	})
+
+	return pools
+}
+
+// GetTakerFee returns the taker fee for the given denom pair.
+// Returns error if the taker fee is not in the sink.
+func (s *MemorySink) GetTakerFee(denom0, denom1 string) (osmomath.Dec, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	takerFee, ok := s.takerFees[newDenomPair(denom0, denom1)]
+	if !ok {
+		return osmomath.Dec{}, fmt.Errorf("taker fee for denom pair (%s, %s) not found", denom0, denom1)
+	}
+
+	return takerFee, nil
+}
+
+// GetRoutes returns the candidate routes from the token in denom to the token out denom.
+// Returns false if no routes were stored for the denom pair in that direction.
+func (s *MemorySink) GetRoutes(tokenInDenom, tokenOutDenom string) (sqsdomain.CandidateRoutes, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	routes, ok := s.routes[routesKey{tokenInDenom: tokenInDenom, tokenOutDenom: tokenOutDenom}]
+	return routes, ok
+}
+
+// GetLatestHeight implements domain.Sink.
+func (s *MemorySink) GetLatestHeight(ctx context.Context) (uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latestHeight, nil
+}
+
+// GetChainInfo returns the information about the latest block in the sink.
+func (s *MemorySink) GetChainInfo() domain.ChainInfo {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.chainInfo
+}
+
+// Exec implements domain.Tx.
+func (t *memoryTx) Exec(ctx context.Context) error {
+	t.sink.mu.Lock()
+	defer t.sink.mu.Unlock()
+
+	for _, write := range t.writes {
+		write()
+	}
+	t.writes = nil
+
+	return nil
+}
+
+// asMemoryTx returns the given transaction as a transaction of this sink.
+// Returns error if the transaction was not started by this sink.
+func (s *MemorySink) asMemoryTx(tx domain.Tx) (*memoryTx, error) {
+	memTx, ok := tx.(*memoryTx)
+	if !ok || memTx.sink != s {
+		return nil, fmt.Errorf("transaction %T was not started by this in-memory sink", tx)
+	}
+	return memTx, nil
+}
+
+// newDenomPair returns a denom pair with the denoms sorted lexicographically.
+func newDenomPair(denom0, denom1 string) sqsdomain.DenomPair {
+	if denom0 > denom1 {
+		denom0, denom1 = denom1, denom0
+	}
+	return sqsdomain.DenomPair{Denom0: denom0, Denom1: denom1}
+}
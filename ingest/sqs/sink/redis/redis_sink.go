@@ -0,0 +1,183 @@
+package redissink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/sqs/sqsdomain"
+	"github.com/osmosis-labs/sqs/sqsdomain/repository"
+	redisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis"
+	chaininforedisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/chaininfo"
+	poolsredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/pools"
+	routerredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/router"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
+)
+
+const (
+	noRoutesCacheExpiry = 0
+
+	// chainInfoKey is the key of the information about the latest block.
+	// The sidecar query server repositories do not support it yet so it is written directly.
+	chainInfoKey = "chain_info"
+)
+
+// redisSink is a sink that writes the ingested data into Redis
+// using the sidecar query server repositories.
+// It implements domain.Sink.
+type redisSink struct {
+	redisClient *redis.Client
+
+	txManager           repository.TxManager
+	poolsRepository     poolsredisrepo.PoolsRepository
+	routerRepository    routerredisrepo.RouterRepository
+	chainInfoRepository chaininforedisrepo.ChainInfoRepository
+}
+
+// redisTx buffers writes to the sidecar query server repositories until Exec is called.
+// On Exec, the writes are applied to a new repository transaction so that a failed Exec can be retried.
+// It implements domain.Tx.
+type redisTx struct {
+	txManager repository.TxManager
+	writes    []func(tx repository.Tx) error
+}
+
+var (
+	_ domain.Sink = &redisSink{}
+	_ domain.Tx   = &redisTx{}
+)
+
+// New creates a new Redis sink connected to the given address.
+// Returns error if Redis is not reachable.
+func New(appCodec codec.Codec, address string) (domain.Sink, error) {
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     address,
+		Password: "", // no password set
+		DB:       0,  // use default DB
+	})
+
+	// Ensure that Redis is up.
+	if _, err := redisClient.Ping(context.Background()).Result(); err != nil {
+		return nil, err
+	}
+
+	txManager := redisrepo.NewTxManager(redisClient)
+
+	return &redisSink{
+		redisClient: redisClient,
+
+		txManager:           txManager,
+		poolsRepository:     poolsredisrepo.New(appCodec, txManager),
+		routerRepository:    routerredisrepo.New(txManager, noRoutesCacheExpiry),
+		chainInfoRepository: chaininforedisrepo.New(txManager),
+	}, nil
+}
+
+// StartTx implements domain.Sink.
+func (s *redisSink) StartTx() domain.Tx {
+	return &redisTx{
+		txManager: s.txManager,
+	}
+}
+
+// StorePools implements domain.Sink.
+func (s *redisSink) StorePools(ctx context.Context, tx domain.Tx, pools []sqsdomain.PoolI) error {
+	return addWrite(tx, func(repositoryTx repository.Tx) error {
+		return s.poolsRepository.StorePools(ctx, repositoryTx, pools)
+	})
+}
+
+// SetTakerFee implements domain.Sink.
+func (s *redisSink) SetTakerFee(ctx context.Context, tx domain.Tx, denom0, denom1 string, takerFee osmomath.Dec) error {
+	return addWrite(tx, func(repositoryTx repository.Tx) error {
+		return s.routerRepository.SetTakerFee(ctx, repositoryTx, denom0, denom1, takerFee)
+	})
+}
+
+// SetRoutes implements domain.Sink.
+func (s *redisSink) SetRoutes(ctx context.Context, tx domain.Tx, tokenInDenom, tokenOutDenom string, routes sqsdomain.CandidateRoutes) error {
+	return addWrite(tx, func(repositoryTx repository.Tx) error {
+		return s.routerRepository.SetRoutesTx(ctx, repositoryTx, tokenInDenom, tokenOutDenom, routes)
+	})
+}
+
+// StoreLatestHeight implements domain.Sink.
+func (s *redisSink) StoreLatestHeight(ctx context.Context, tx domain.Tx, height uint64) error {
+	return addWrite(tx, func(repositoryTx repository.Tx) error {
+		return s.chainInfoRepository.StoreLatestHeight(ctx, repositoryTx, height)
+	})
+}
+
+// GetLatestHeight implements domain.Sink.
+func (s *redisSink) GetLatestHeight(ctx context.Context) (uint64, error) {
+	height, err := s.chainInfoRepository.GetLatestHeight(ctx)
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// StoreChainInfo implements domain.Sink.
+// The chain information is written as JSON into the pipeline of the repository transaction
+// so that it is flushed atomically with the other writes.
+func (s *redisSink) StoreChainInfo(ctx context.Context, tx domain.Tx, chainInfo domain.ChainInfo) error {
+	chainInfoBz, err := json.Marshal(chainInfo)
+	if err != nil {
+		return err
+	}
+
+	return addWrite(tx, func(repositoryTx repository.Tx) error {
+		redisTx, ok := repositoryTx.(*redisrepo.RedisTx)
+		if !ok {
+			return fmt.Errorf("expected repository transaction of type %T, got %T", &redisrepo.RedisTx{}, repositoryTx)
+		}
+
+		pipeliner, err := redisTx.GetPipeline()
+		if err != nil {
+			return err
+		}
+
+		return pipeliner.Set(ctx, chainInfoKey, chainInfoBz, 0).Err()
+	})
+}
+
+// Close implements domain.Sink.
+func (s *redisSink) Close() error {
+	return s.redisClient.Close()
+}
+
+// Exec implements domain.Tx.
+// The writes are kept on failure so that Exec can be retried.
+func (t *redisTx) Exec(ctx context.Context) error {
+	repositoryTx := t.txManager.StartTx()
+
+	for _, write := range t.writes {
+		if err := write(repositoryTx); err != nil {
+			return err
+		}
+	}
+
+	if err := repositoryTx.Exec(ctx); err != nil {
+		return err
+	}
+
+	t.writes = nil
+	return nil
+}
+
+// addWrite buffers the write in the given transaction.
+// Returns error if the transaction was not started by the Redis sink.
+func addWrite(tx domain.Tx, write func(repositoryTx repository.Tx) error) error {
+	wrappedTx, ok := tx.(*redisTx)
+	if !ok {
+		return fmt.Errorf("expected transaction of type %T, got %T", &redisTx{}, tx)
+	}
+	wrappedTx.writes = append(wrappedTx.writes, write)
+	return nil
+}
@@ -1,23 +1,24 @@
 package sqs
 
 import (
-	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/redis/go-redis/v9"
-
-	redisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis"
-	chaininforedisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/chaininfo"
-	poolsredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/pools"
-	routerredisrepo "github.com/osmosis-labs/sqs/sqsdomain/repository/redis/router"
+	"github.com/spf13/cast"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v22/ingest"
 	chaininfoingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/chaininfo/ingester"
 	"github.com/osmosis-labs/osmosis/v22/ingest/sqs/domain"
 	poolsingester "github.com/osmosis-labs/osmosis/v22/ingest/sqs/pools/ingester"
+	bufferedsink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/buffered"
+	filesink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/file"
+	memorysink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/memory"
+	redissink "github.com/osmosis-labs/osmosis/v22/ingest/sqs/sink/redis"
+	mempool1559 "github.com/osmosis-labs/osmosis/v22/x/txfees/keeper/mempool-1559"
 )
 
 // Config defines the config for the sidecar query server.
@@ -25,15 +26,83 @@ type Config struct {
 	// IsEnabled defines if the sidecar query server is enabled.
 	IsEnabled bool `mapstructure:"enabled"`
 
+	// SinkType defines the storage backend that the ingested data is written into.
+	// One of "redis", "file" or "memory".
+	SinkType domain.SinkType `mapstructure:"sink-type"`
+
 	// Storage defines the storage host and port.
+	// Only used by the Redis sink.
 	StorageHost string `mapstructure:"db-host"`
 	StoragePort string `mapstructure:"db-port"`
+
+	// FileSinkDir defines the directory of the file sink database.
+	// If empty, defaults to the sqs directory under the node data directory.
+	// Only used by the file sink.
+	FileSinkDir string `mapstructure:"file-sink-dir"`
+
+	// PoolFullResyncHeightInterval defines the interval in blocks at which all pools are ingested.
+	// At other blocks, only the pools updated within the block are ingested.
+	// All pools are also ingested at startup. Zero disables the periodic full resync.
+	PoolFullResyncHeightInterval uint64 `mapstructure:"pool-full-resync-height-interval"`
+
+	// RouteUpdateHeightInterval defines the interval in blocks at which the candidate routes
+	// are computed for the denom pairs with a taker fee affected by the updated pools. All routes
+	// are computed at startup so that the sidecar query server can serve them immediately.
+	// Zero disables the candidate routes.
+	RouteUpdateHeightInterval uint64 `mapstructure:"route-update-height-interval"`
+
+	// RouteMaxHops defines the maximum number of pools in a candidate route.
+	RouteMaxHops int `mapstructure:"route-max-hops"`
+
+	// RouteMaxRoutes defines the maximum number of candidate routes for a denom pair in one direction.
+	RouteMaxRoutes int `mapstructure:"route-max-routes"`
+
+	// IngestQueueSize defines the maximum number of block updates waiting to be written into the sink.
+	IngestQueueSize int `mapstructure:"ingest-queue-size"`
+
+	// IngestQueueOverflowPolicy defines what happens when a block update is queued while the queue is full.
+	// One of "block", "drop" or "coalesce".
+	IngestQueueOverflowPolicy ingest.OverflowPolicy `mapstructure:"ingest-queue-overflow-policy"`
+
+	// IngestMaxFlushRetries defines the number of times a failed sink write is retried before
+	// the block is recorded in the dead-letter log.
+	IngestMaxFlushRetries int `mapstructure:"ingest-max-flush-retries"`
+
+	// IngestFlushRetryBackoff defines the delay before the first retry of a failed sink write.
+	// The delay doubles with every retry.
+	IngestFlushRetryBackoff time.Duration `mapstructure:"ingest-flush-retry-backoff"`
+
+	// AssetListPath defines the path to the asset list in the chain registry format
+	// that token precisions are read from. If empty, the asset list bundled with the binary is used.
+	// Denoms missing from the asset list fall back to the x/bank denom metadata.
+	AssetListPath string `mapstructure:"asset-list-path"`
+
+	// AssetListRefreshInterval defines the interval at which the token precisions are reloaded.
+	AssetListRefreshInterval time.Duration `mapstructure:"asset-list-refresh-interval"`
 }
 
+// BundledAssetList is the asset list bundled with the binary for the chain that the node runs.
+// It is set by the osmosisd command and used if no asset list path is configured.
+var BundledAssetList []byte
+
 const (
 	groupOptName = "osmosis-sqs"
 
-	noRoutesCacheExpiry = 0
+	sinkTypeOptName                     = "sink-type"
+	fileSinkDirOptName                  = "file-sink-dir"
+	poolFullResyncHeightIntervalOptName = "pool-full-resync-height-interval"
+	routeUpdateHeightIntervalOptName    = "route-update-height-interval"
+	routeMaxHopsOptName                 = "route-max-hops"
+	routeMaxRoutesOptName               = "route-max-routes"
+	ingestQueueSizeOptName              = "ingest-queue-size"
+	ingestQueueOverflowPolicyOptName    = "ingest-queue-overflow-policy"
+	ingestMaxFlushRetriesOptName        = "ingest-max-flush-retries"
+	ingestFlushRetryBackoffOptName      = "ingest-flush-retry-backoff"
+	assetListPathOptName                = "asset-list-path"
+	assetListRefreshIntervalOptName     = "asset-list-refresh-interval"
+
+	// defaultFileSinkDirName is the name of the file sink directory under the node data directory.
+	defaultFileSinkDirName = "sqs"
 )
 
 // DefaultConfig defines the default config for the sidecar query server.
@@ -41,11 +110,33 @@ var DefaultConfig = Config{
 
 	IsEnabled: false,
 
+	SinkType: domain.RedisSinkType,
+
 	StorageHost: "localhost",
 	StoragePort: "6379",
+
+	FileSinkDir: "",
+
+	PoolFullResyncHeightInterval: 100,
+
+	RouteUpdateHeightInterval: 0,
+	RouteMaxHops:              4,
+	RouteMaxRoutes:            20,
+
+	IngestQueueSize:           ingest.DefaultQueueConfig.Size,
+	IngestQueueOverflowPolicy: ingest.DefaultQueueConfig.OverflowPolicy,
+
+	IngestMaxFlushRetries:   ingest.DefaultQueueConfig.MaxFlushRetries,
+	IngestFlushRetryBackoff: ingest.DefaultQueueConfig.FlushRetryBackoff,
+
+	AssetListPath: "",
+
+	AssetListRefreshInterval: time.Hour,
 }
 
 // NewConfigFromOptions returns a new sidecar query server config from the given options.
+// Options introduced after the initial release fall back to the defaults if missing
+// so that nodes with existing configs keep working.
 func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 	isEnabled := osmoutils.ParseBool(opts, groupOptName, "is-enabled", false)
 
@@ -55,44 +146,164 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 		}
 	}
 
-	return Config{
+	config := Config{
 		IsEnabled: isEnabled,
 
-		StorageHost: osmoutils.ParseString(opts, groupOptName, "db-host"),
-		StoragePort: osmoutils.ParseString(opts, groupOptName, "db-port"),
+		SinkType: domain.SinkType(parseStringWithDefault(opts, sinkTypeOptName, string(DefaultConfig.SinkType))),
+
+		FileSinkDir: parseStringWithDefault(opts, fileSinkDirOptName, DefaultConfig.FileSinkDir),
+
+		PoolFullResyncHeightInterval: DefaultConfig.PoolFullResyncHeightInterval,
+
+		RouteUpdateHeightInterval: DefaultConfig.RouteUpdateHeightInterval,
+
+		RouteMaxHops: DefaultConfig.RouteMaxHops,
+
+		RouteMaxRoutes: DefaultConfig.RouteMaxRoutes,
+
+		IngestQueueSize: DefaultConfig.IngestQueueSize,
+
+		IngestQueueOverflowPolicy: ingest.OverflowPolicy(parseStringWithDefault(opts, ingestQueueOverflowPolicyOptName, string(DefaultConfig.IngestQueueOverflowPolicy))),
+
+		AssetListPath: parseStringWithDefault(opts, assetListPathOptName, DefaultConfig.AssetListPath),
+
+		AssetListRefreshInterval: DefaultConfig.AssetListRefreshInterval,
+
+		IngestMaxFlushRetries: DefaultConfig.IngestMaxFlushRetries,
+
+		IngestFlushRetryBackoff: DefaultConfig.IngestFlushRetryBackoff,
+	}
+
+	if opts.Get(groupOptName+"."+poolFullResyncHeightIntervalOptName) != nil {
+		config.PoolFullResyncHeightInterval = uint64(osmoutils.ParseInt(opts, groupOptName, poolFullResyncHeightIntervalOptName))
 	}
+
+	if opts.Get(groupOptName+"."+routeUpdateHeightIntervalOptName) != nil {
+		config.RouteUpdateHeightInterval = uint64(osmoutils.ParseInt(opts, groupOptName, routeUpdateHeightIntervalOptName))
+	}
+
+	if opts.Get(groupOptName+"."+routeMaxHopsOptName) != nil {
+		config.RouteMaxHops = osmoutils.ParseInt(opts, groupOptName, routeMaxHopsOptName)
+	}
+
+	if opts.Get(groupOptName+"."+routeMaxRoutesOptName) != nil {
+		config.RouteMaxRoutes = osmoutils.ParseInt(opts, groupOptName, routeMaxRoutesOptName)
+	}
+
+	if opts.Get(groupOptName+"."+ingestQueueSizeOptName) != nil {
+		config.IngestQueueSize = osmoutils.ParseInt(opts, groupOptName, ingestQueueSizeOptName)
+	}
+
+	if opts.Get(groupOptName+"."+ingestMaxFlushRetriesOptName) != nil {
+		config.IngestMaxFlushRetries = osmoutils.ParseInt(opts, groupOptName, ingestMaxFlushRetriesOptName)
+	}
+
+	if flushRetryBackoff := opts.Get(groupOptName + "." + ingestFlushRetryBackoffOptName); flushRetryBackoff != nil {
+		config.IngestFlushRetryBackoff = cast.ToDuration(flushRetryBackoff)
+	}
+
+	if refreshInterval := opts.Get(groupOptName + "." + assetListRefreshIntervalOptName); refreshInterval != nil {
+		config.AssetListRefreshInterval = cast.ToDuration(refreshInterval)
+	}
+
+	// Storage host and port are only required by the Redis sink.
+	if config.SinkType == domain.RedisSinkType {
+		config.StorageHost = osmoutils.ParseString(opts, groupOptName, "db-host")
+		config.StoragePort = osmoutils.ParseString(opts, groupOptName, "db-port")
+	}
+
+	return config
 }
 
 // Initialize initializes the sidecar query server and returns the ingester.
-func (c Config) Initialize(appCodec codec.Codec, keepers domain.SQSIngestKeepers) (ingest.Ingester, error) {
-	// Create redis client and ensure that it is up.
-	redisAddress := fmt.Sprintf("%s:%s", c.StorageHost, c.StoragePort)
-	redisClient := redis.NewClient(&redis.Options{
-		Addr:     redisAddress,
-		Password: "", // no password set
-		DB:       0,  // use default DB
-	})
-	redisStatus := redisClient.Ping(context.Background())
-	_, err := redisStatus.Result()
+// poolTracker must be populated with the pools updated within a block by the caller.
+// homePath is the node home directory. It is used for the default file sink directory.
+func (c Config) Initialize(appCodec codec.Codec, keepers domain.SQSIngestKeepers, poolTracker domain.BlockPoolUpdateTracker, homePath string) (ingest.Ingester, error) {
+	if c.RouteUpdateHeightInterval > 0 && (c.RouteMaxHops <= 0 || c.RouteMaxRoutes <= 0) {
+		return nil, fmt.Errorf("route max hops and max routes must be positive when candidate routes are enabled, got %d and %d", c.RouteMaxHops, c.RouteMaxRoutes)
+	}
+
+	sink, err := c.newSink(appCodec, homePath)
 	if err != nil {
 		return nil, err
 	}
 
-	txManager := redisrepo.NewTxManager(redisClient)
-
-	redisPoolsRepository := poolsredisrepo.New(appCodec, txManager)
+	// The writes of a block are buffered by key so that the block updates queued behind a slow sink
+	// are merged rather than dropped.
+	sink = bufferedsink.New(sink)
 
-	redisRouterRepository := routerredisrepo.New(txManager, noRoutesCacheExpiry)
+	// Token precisions are read from the configured or bundled asset list
+	// with the x/bank denom metadata as a fallback.
+	assetListGetter := domain.NewCachedAssetListGetter(
+		domain.NewFallbackAssetListGetter(c.newAssetListSource(), domain.NewBankMetadataAssetListGetter(keepers.BankKeeper)),
+		c.AssetListRefreshInterval,
+	)
 
 	// Create pools ingester
-	poolsIngester := poolsingester.NewPoolIngester(redisPoolsRepository, redisRouterRepository, txManager, domain.NewAssetListGetter(), keepers)
+	poolsIngester := poolsingester.NewPoolIngester(sink, sink, sink, assetListGetter, poolTracker, c.PoolFullResyncHeightInterval, c.candidateRouteConfig(), keepers)
 
 	// Create chain info ingester
-	chainInfoRepository := chaininforedisrepo.New(txManager)
-	chainInfoingester := chaininfoingester.New(chainInfoRepository, txManager)
+	// The base fee and the gas wanted are read from the EIP-1559 state of the txfees module.
+	chainInfoingester := chaininfoingester.New(sink, sink, keepers.TxFeesKeeper, &mempool1559.CurEipState)
 
 	// Create sqs ingester that encapsulates all ingesters.
-	sqsIngester := NewSidecarQueryServerIngester(poolsIngester, chainInfoingester, txManager)
+	sqsIngester := NewSidecarQueryServerIngester(poolsIngester, chainInfoingester, sink, sink)
 
 	return sqsIngester, nil
 }
+
+// QueueConfig returns the config of the queue of block updates waiting to be written into the sink.
+func (c Config) QueueConfig() ingest.QueueConfig {
+	return ingest.QueueConfig{
+		Size:              c.IngestQueueSize,
+		OverflowPolicy:    c.IngestQueueOverflowPolicy,
+		MaxFlushRetries:   c.IngestMaxFlushRetries,
+		FlushRetryBackoff: c.IngestFlushRetryBackoff,
+	}
+}
+
+// candidateRouteConfig returns the config of the candidate routes computed by the pools ingester.
+func (c Config) candidateRouteConfig() domain.CandidateRouteConfig {
+	return domain.CandidateRouteConfig{
+		UpdateHeightInterval: c.RouteUpdateHeightInterval,
+		MaxHops:              c.RouteMaxHops,
+		MaxRoutes:            c.RouteMaxRoutes,
+	}
+}
+
+// newAssetListSource returns the getter of the configured asset list.
+// If no asset list path is configured, the bundled asset list is used.
+func (c Config) newAssetListSource() domain.AssetListGetter {
+	if c.AssetListPath != "" {
+		return domain.NewFileAssetListGetter(c.AssetListPath)
+	}
+	return domain.NewBundledAssetListGetter(BundledAssetList)
+}
+
+// newSink creates the sink of the configured type.
+// Returns error if the sink type is unknown or if the sink fails to initialize.
+func (c Config) newSink(appCodec codec.Codec, homePath string) (domain.Sink, error) {
+	switch c.SinkType {
+	case domain.RedisSinkType:
+		return redissink.New(appCodec, fmt.Sprintf("%s:%s", c.StorageHost, c.StoragePort))
+	case domain.FileSinkType:
+		fileSinkDir := c.FileSinkDir
+		if fileSinkDir == "" {
+			fileSinkDir = filepath.Join(homePath, "data", defaultFileSinkDirName)
+		}
+		return filesink.New(appCodec, fileSinkDir)
+	case domain.MemorySinkType:
+		return memorysink.New(), nil
+	default:
+		return nil, fmt.Errorf("unknown sidecar query server sink type %q, expected one of %q, %q or %q", c.SinkType, domain.RedisSinkType, domain.FileSinkType, domain.MemorySinkType)
+	}
+}
+
+// parseStringWithDefault parses a string option from the sidecar query server group.
+// Returns the default value if the option is missing.
+func parseStringWithDefault(opts servertypes.AppOptions, optName, defaultValue string) string {
+	if opts.Get(groupOptName+"."+optName) == nil {
+		return defaultValue
+	}
+	return osmoutils.ParseString(opts, groupOptName, optName)
+}
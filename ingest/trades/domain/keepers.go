@@ -0,0 +1,16 @@
+package domain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// PoolManagerKeeper is an interface for getting pools and taker fees from the pool manager keeper.
+type PoolManagerKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolI, error)
+	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
+	GetParams(ctx sdk.Context) poolmanagertypes.Params
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// SinkType is the type of the storage backend that the trades are written into.
+type SinkType string
+
+const (
+	// JSONLSinkType writes the trades into daily JSON lines files.
+	JSONLSinkType SinkType = "jsonl"
+)
+
+// Trade is a normalized record of a swap against a single pool.
+// A multi-hop swap results in one trade per hop.
+// Height and Index uniquely identify a trade. Since a block might be re-executed
+// after a crash, consumers should deduplicate the trades by height and index.
+type Trade struct {
+	Height    int64     `json:"height"`
+	BlockTime time.Time `json:"block_time"`
+	// Index is the position of the trade within the block.
+	Index int `json:"index"`
+	// TxHash is the hex encoded hash of the transaction that the trade is executed in.
+	// Empty for the trades executed outside of transactions.
+	TxHash string `json:"tx_hash"`
+	Sender string `json:"sender"`
+
+	PoolID   uint64 `json:"pool_id"`
+	PoolType string `json:"pool_type"`
+
+	// TokenInAmount is the amount swapped into the pool, after the taker fee is charged.
+	TokenInDenom   string       `json:"token_in_denom"`
+	TokenInAmount  osmomath.Int `json:"token_in_amount"`
+	TokenOutDenom  string       `json:"token_out_denom"`
+	TokenOutAmount osmomath.Int `json:"token_out_amount"`
+
+	// SpreadFactor is the spread factor charged by the swap, as recorded in the swap event.
+	// SpreadFeeAmount is computed from it and charged in the token in denom.
+	SpreadFactor    osmomath.Dec `json:"spread_factor"`
+	SpreadFeeAmount osmomath.Int `json:"spread_fee_amount"`
+	// TakerFee is the taker fee of the denom pair at the end of the block.
+	// TakerFeeAmount is estimated from it and charged in the token in denom.
+	TakerFee       osmomath.Dec `json:"taker_fee"`
+	TakerFeeAmount osmomath.Int `json:"taker_fee_amount"`
+}
+
+// Sink is a storage backend that the trades are written into.
+type Sink interface {
+	// WriteTrades writes the trades of the block at the given height.
+	// A failed write must not leave partially written trades so that it can be retried.
+	// Returns error if the write fails.
+	WriteTrades(ctx context.Context, height int64, trades []Trade) error
+	// Close closes the sink.
+	Close() error
+}
+
+// TxEvents are the events emitted by a transaction.
+type TxEvents struct {
+	// TxHash is empty for the events emitted outside of transactions.
+	TxHash string
+	Events []abci.Event
+}
+
+// BlockEventTracker is an interface for tracking the events emitted within a block.
+// The events are not available from the context at the end of the block so they are
+// tracked as the block is executed.
+type BlockEventTracker interface {
+	// StartBlock clears the tracked events and starts tracking the events of the block at the given height.
+	// Must be called at the beginning of every block.
+	StartBlock(height int64)
+	// TrackBlockEvents tracks the events emitted outside of transactions.
+	TrackBlockEvents(events []abci.Event)
+	// TrackTx tracks the events emitted by the delivered transaction.
+	// The events of failed transactions are ignored.
+	TrackTx(txBytes []byte, res abci.ResponseDeliverTx)
+	// GetBlockEvents returns the height of the tracked block and its events in the order of execution.
+	GetBlockEvents() (int64, []TxEvents)
+}
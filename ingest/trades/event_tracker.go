@@ -0,0 +1,90 @@
+package trades
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+)
+
+// blockEventTracker tracks the events of the given types emitted within a block.
+// It implements domain.BlockEventTracker.
+type blockEventTracker struct {
+	mu sync.Mutex
+
+	eventTypes map[string]struct{}
+
+	height   int64
+	txEvents []domain.TxEvents
+}
+
+var _ domain.BlockEventTracker = &blockEventTracker{}
+
+// NewBlockEventTracker returns a tracker of the events of the given types.
+// Events of other types are ignored.
+func NewBlockEventTracker(eventTypes ...string) domain.BlockEventTracker {
+	eventTypesMap := make(map[string]struct{}, len(eventTypes))
+	for _, eventType := range eventTypes {
+		eventTypesMap[eventType] = struct{}{}
+	}
+
+	return &blockEventTracker{
+		eventTypes: eventTypesMap,
+		txEvents:   []domain.TxEvents{},
+	}
+}
+
+// StartBlock implements domain.BlockEventTracker.
+func (t *blockEventTracker) StartBlock(height int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.height = height
+	t.txEvents = []domain.TxEvents{}
+}
+
+// TrackBlockEvents implements domain.BlockEventTracker.
+func (t *blockEventTracker) TrackBlockEvents(events []abci.Event) {
+	t.track("", events)
+}
+
+// TrackTx implements domain.BlockEventTracker.
+func (t *blockEventTracker) TrackTx(txBytes []byte, res abci.ResponseDeliverTx) {
+	if !res.IsOK() {
+		return
+	}
+
+	t.track(strings.ToUpper(hex.EncodeToString(tmhash.Sum(txBytes))), res.Events)
+}
+
+// GetBlockEvents implements domain.BlockEventTracker.
+func (t *blockEventTracker) GetBlockEvents() (int64, []domain.TxEvents) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.height, t.txEvents
+}
+
+// track tracks the events of the tracked types.
+// Nothing is tracked if none of the events are of the tracked types.
+func (t *blockEventTracker) track(txHash string, events []abci.Event) {
+	trackedEvents := []abci.Event{}
+	for _, event := range events {
+		if _, ok := t.eventTypes[event.Type]; ok {
+			trackedEvents = append(trackedEvents, event)
+		}
+	}
+
+	if len(trackedEvents) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.txEvents = append(t.txEvents, domain.TxEvents{TxHash: txHash, Events: trackedEvents})
+}
@@ -0,0 +1,209 @@
+package trades
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/ingest"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+const tradesIngesterName = "trades"
+
+// SwapEventTypes are the types of the events that the trades are read from.
+// The tracker passed to the trades ingester must track them.
+var SwapEventTypes = []string{gammtypes.TypeEvtTokenSwapped}
+
+var (
+	_ ingest.Ingester    = &tradesIngester{}
+	_ ingest.BlockUpdate = &tradesBlockUpdate{}
+)
+
+// tradesIngester is an implementation of ingest.Ingester that writes
+// the trades executed within a block into a sink.
+// The trades are read from the swap events tracked during the execution of the block.
+type tradesIngester struct {
+	sink              domain.Sink
+	eventTracker      domain.BlockEventTracker
+	poolManagerKeeper domain.PoolManagerKeeper
+}
+
+// NewTradesIngester creates a new trades ingester.
+// eventTracker must track the SwapEventTypes of every block.
+func NewTradesIngester(sink domain.Sink, eventTracker domain.BlockEventTracker, poolManagerKeeper domain.PoolManagerKeeper) ingest.Ingester {
+	return &tradesIngester{
+		sink:              sink,
+		eventTracker:      eventTracker,
+		poolManagerKeeper: poolManagerKeeper,
+	}
+}
+
+// tradesBlockUpdate is a block update that writes the trades of a block into the sink.
+type tradesBlockUpdate struct {
+	sink   domain.Sink
+	height int64
+	trades []domain.Trade
+}
+
+// ProcessBlock implements ingest.Ingester.
+// Returns error if the events of the block were not tracked, e.g. when replaying
+// a historical block, since the events cannot be recovered from the state.
+// Blocks without trades are skipped.
+func (i *tradesIngester) ProcessBlock(ctx sdk.Context) (ingest.BlockUpdate, error) {
+	height, txEvents := i.eventTracker.GetBlockEvents()
+	if height != ctx.BlockHeight() {
+		return nil, fmt.Errorf("events of block %d are not tracked, tracked block is %d", ctx.BlockHeight(), height)
+	}
+
+	trades, err := i.parseTrades(ctx, txEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(trades) == 0 {
+		return nil, nil
+	}
+
+	return &tradesBlockUpdate{
+		sink:   i.sink,
+		height: height,
+		trades: trades,
+	}, nil
+}
+
+// parseTrades parses the trades from the swap events of the block.
+// The spread fee is computed from the spread factor charged by the swap, as recorded in the swap event.
+// The taker fee is estimated from the denom pair parameters at the end of the block.
+func (i *tradesIngester) parseTrades(ctx sdk.Context, txEvents []domain.TxEvents) ([]domain.Trade, error) {
+	reducedTakerFeeWhitelist := i.poolManagerKeeper.GetParams(ctx).TakerFeeParams.ReducedFeeWhitelist
+
+	pools := map[uint64]poolmanagertypes.PoolI{}
+
+	trades := []domain.Trade{}
+	for _, tx := range txEvents {
+		for _, event := range tx.Events {
+			if event.Type != gammtypes.TypeEvtTokenSwapped {
+				continue
+			}
+
+			trade, err := parseSwapEvent(event)
+			if err != nil {
+				return nil, err
+			}
+
+			pool, ok := pools[trade.PoolID]
+			if !ok {
+				pool, err = i.poolManagerKeeper.GetPool(ctx, trade.PoolID)
+				if err != nil {
+					return nil, err
+				}
+				pools[trade.PoolID] = pool
+			}
+
+			trade.Height = ctx.BlockHeight()
+			trade.BlockTime = ctx.BlockTime().UTC()
+			trade.Index = len(trades)
+			trade.TxHash = tx.TxHash
+			trade.PoolType = pool.GetType().String()
+
+			// The swap events emitted before the spread factor was recorded fall back to the static spread factor of the pool.
+			if trade.SpreadFactor.IsNil() {
+				trade.SpreadFactor = pool.GetSpreadFactor(ctx)
+			}
+			trade.SpreadFeeAmount = trade.TokenInAmount.ToLegacyDec().MulTruncate(trade.SpreadFactor).TruncateInt()
+
+			trade.TakerFee = osmomath.ZeroDec()
+			trade.TakerFeeAmount = osmomath.ZeroInt()
+			if !osmoutils.Contains(reducedTakerFeeWhitelist, trade.Sender) {
+				trade.TakerFee, err = i.poolManagerKeeper.GetTradingPairTakerFee(ctx, trade.TokenInDenom, trade.TokenOutDenom)
+				if err != nil {
+					return nil, err
+				}
+
+				// The swap event amount is after the taker fee is charged.
+				_, takerFeeCoin := poolmanager.CalcTakerFeeExactOut(sdk.NewCoin(trade.TokenInDenom, trade.TokenInAmount), trade.TakerFee)
+				trade.TakerFeeAmount = takerFeeCoin.Amount
+			}
+
+			trades = append(trades, trade)
+		}
+	}
+
+	return trades, nil
+}
+
+// parseSwapEvent parses the sender, pool, tokens and spread factor of the swap event.
+// The spread factor is left nil if the event does not record it.
+// Returns error if the event is malformed.
+func parseSwapEvent(event abci.Event) (domain.Trade, error) {
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+
+	poolID, err := strconv.ParseUint(attributes[gammtypes.AttributeKeyPoolId], 10, 64)
+	if err != nil {
+		return domain.Trade{}, fmt.Errorf("invalid pool ID in swap event: %w", err)
+	}
+
+	tokenIn, err := parseSingleCoin(attributes[gammtypes.AttributeKeyTokensIn])
+	if err != nil {
+		return domain.Trade{}, fmt.Errorf("invalid tokens in of swap event in pool %d: %w", poolID, err)
+	}
+
+	tokenOut, err := parseSingleCoin(attributes[gammtypes.AttributeKeyTokensOut])
+	if err != nil {
+		return domain.Trade{}, fmt.Errorf("invalid tokens out of swap event in pool %d: %w", poolID, err)
+	}
+
+	var spreadFactor osmomath.Dec
+	if spreadFactorStr, ok := attributes[gammtypes.AttributeKeySpreadFactor]; ok {
+		spreadFactor, err = osmomath.NewDecFromStr(spreadFactorStr)
+		if err != nil {
+			return domain.Trade{}, fmt.Errorf("invalid spread factor of swap event in pool %d: %w", poolID, err)
+		}
+	}
+
+	return domain.Trade{
+		Sender:         attributes[sdk.AttributeKeySender],
+		PoolID:         poolID,
+		TokenInDenom:   tokenIn.Denom,
+		TokenInAmount:  tokenIn.Amount,
+		TokenOutDenom:  tokenOut.Denom,
+		TokenOutAmount: tokenOut.Amount,
+		SpreadFactor:   spreadFactor,
+	}, nil
+}
+
+// parseSingleCoin parses the coins string that must contain exactly one coin.
+func parseSingleCoin(coinsStr string) (sdk.Coin, error) {
+	coins, err := sdk.ParseCoinsNormalized(coinsStr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("expected exactly one coin, got %q", coinsStr)
+	}
+
+	return coins[0], nil
+}
+
+// Flush implements ingest.BlockUpdate.
+func (u *tradesBlockUpdate) Flush(ctx context.Context) error {
+	return u.sink.WriteTrades(ctx, u.height, u.trades)
+}
+
+// GetName implements ingest.Ingester.
+func (*tradesIngester) GetName() string {
+	return tradesIngesterName
+}
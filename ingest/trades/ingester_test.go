@@ -0,0 +1,238 @@
+package trades_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+	"github.com/osmosis-labs/osmosis/v22/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+type TradesIngesterTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+const (
+	UOSMO = "uosmo"
+	USDC  = "usdc"
+)
+
+var (
+	defaultAmount       = osmomath.NewInt(1_000_000_000)
+	defaultSpreadFactor = osmomath.NewDecWithPrec(1, 2)
+	defaultTakerFee     = osmomath.NewDecWithPrec(5, 2)
+)
+
+func TestTradesIngesterTestSuite(t *testing.T) {
+	suite.Run(t, new(TradesIngesterTestSuite))
+}
+
+// sinkRecorderMock is a sink that records the written trades.
+type sinkRecorderMock struct {
+	trades []domain.Trade
+}
+
+var _ domain.Sink = &sinkRecorderMock{}
+
+func (s *sinkRecorderMock) WriteTrades(ctx context.Context, height int64, trades []domain.Trade) error {
+	s.trades = append(s.trades, trades...)
+	return nil
+}
+
+func (s *sinkRecorderMock) Close() error {
+	return nil
+}
+
+// Tests that the trades are parsed from the swap events of successful transactions
+// with the pool type, the spread factor charged by the swaps and the estimated taker fee, and written into the sink on flush.
+func (s *TradesIngesterTestSuite) TestProcessBlock() {
+	s.Setup()
+
+	poolmanagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
+	poolmanagerParams.TakerFeeParams.DefaultTakerFee = defaultTakerFee
+	poolmanagerParams.TakerFeeParams.ReducedFeeWhitelist = []string{s.TestAccs[1].String()}
+	s.App.PoolManagerKeeper.SetParams(s.Ctx, poolmanagerParams)
+
+	poolID := s.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Token: sdk.NewCoin(UOSMO, defaultAmount), Weight: osmomath.NewInt(1)},
+		{Token: sdk.NewCoin(USDC, defaultAmount), Weight: osmomath.NewInt(1)},
+	}, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: osmomath.ZeroDec(),
+	})
+
+	tracker := trades.NewBlockEventTracker(trades.SwapEventTypes...)
+	tracker.StartBlock(s.Ctx.BlockHeight())
+
+	tokenIn := sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000))
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolID, TokenOutDenom: USDC}}
+
+	// The first account pays the taker fee.
+	txBytes := []byte("tx")
+	tokenOutAmount := s.swap(s.TestAccs[0], route, tokenIn)
+	tracker.TrackTx(txBytes, abci.ResponseDeliverTx{Events: s.Ctx.EventManager().ABCIEvents()})
+
+	// The events of failed transactions are ignored.
+	s.swap(s.TestAccs[0], route, tokenIn)
+	tracker.TrackTx([]byte("failed"), abci.ResponseDeliverTx{Code: 1, Events: s.Ctx.EventManager().ABCIEvents()})
+
+	// The second account is whitelisted from the taker fee and swaps outside of a transaction.
+	whitelistedTokenOutAmount := s.swap(s.TestAccs[1], route, tokenIn)
+	tracker.TrackBlockEvents(s.Ctx.EventManager().ABCIEvents())
+
+	sink := &sinkRecorderMock{}
+	ingester := trades.NewTradesIngester(sink, tracker, s.App.PoolManagerKeeper)
+
+	blockUpdate, err := ingester.ProcessBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().NotNil(blockUpdate)
+
+	// Nothing is written until the block update is flushed.
+	s.Require().Empty(sink.trades)
+	s.Require().NoError(blockUpdate.Flush(context.Background()))
+
+	// 5% of the token in is charged as taker fee from the first account.
+	tokenInAfterTakerFee := osmomath.NewInt(950_000)
+
+	expectedTrades := []domain.Trade{
+		{
+			Height:          s.Ctx.BlockHeight(),
+			BlockTime:       s.Ctx.BlockTime().UTC(),
+			Index:           0,
+			TxHash:          strings.ToUpper(hex.EncodeToString(tmhash.Sum(txBytes))),
+			Sender:          s.TestAccs[0].String(),
+			PoolID:          poolID,
+			PoolType:        poolmanagertypes.Balancer.String(),
+			TokenInDenom:    UOSMO,
+			TokenInAmount:   tokenInAfterTakerFee,
+			TokenOutDenom:   USDC,
+			TokenOutAmount:  tokenOutAmount,
+			SpreadFactor:    defaultSpreadFactor,
+			SpreadFeeAmount: osmomath.NewInt(9_500),
+			TakerFee:        defaultTakerFee,
+			TakerFeeAmount:  osmomath.NewInt(50_000),
+		},
+		{
+			Height:          s.Ctx.BlockHeight(),
+			BlockTime:       s.Ctx.BlockTime().UTC(),
+			Index:           1,
+			TxHash:          "",
+			Sender:          s.TestAccs[1].String(),
+			PoolID:          poolID,
+			PoolType:        poolmanagertypes.Balancer.String(),
+			TokenInDenom:    UOSMO,
+			TokenInAmount:   tokenIn.Amount,
+			TokenOutDenom:   USDC,
+			TokenOutAmount:  whitelistedTokenOutAmount,
+			SpreadFactor:    defaultSpreadFactor,
+			SpreadFeeAmount: osmomath.NewInt(10_000),
+			TakerFee:        osmomath.ZeroDec(),
+			TakerFeeAmount:  osmomath.ZeroInt(),
+		},
+	}
+
+	s.Require().Equal(expectedTrades, sink.trades)
+}
+
+// Tests that the spread fee is computed from the spread factor recorded in the swap event,
+// and from the spread factor of the pool for the swap events that do not record it.
+func (s *TradesIngesterTestSuite) TestProcessBlock_EventSpreadFactor() {
+	s.Setup()
+
+	poolID := s.PrepareCustomBalancerPool([]balancer.PoolAsset{
+		{Token: sdk.NewCoin(UOSMO, defaultAmount), Weight: osmomath.NewInt(1)},
+		{Token: sdk.NewCoin(USDC, defaultAmount), Weight: osmomath.NewInt(1)},
+	}, balancer.PoolParams{
+		SwapFee: defaultSpreadFactor,
+		ExitFee: osmomath.ZeroDec(),
+	})
+
+	newSwapEvent := func(attributes ...abci.EventAttribute) abci.Event {
+		return abci.Event{
+			Type: gammtypes.TypeEvtTokenSwapped,
+			Attributes: append([]abci.EventAttribute{
+				{Key: sdk.AttributeKeySender, Value: s.TestAccs[0].String()},
+				{Key: gammtypes.AttributeKeyPoolId, Value: strconv.FormatUint(poolID, 10)},
+				{Key: gammtypes.AttributeKeyTokensIn, Value: sdk.NewCoin(UOSMO, osmomath.NewInt(1_000_000)).String()},
+				{Key: gammtypes.AttributeKeyTokensOut, Value: sdk.NewCoin(USDC, osmomath.NewInt(900_000)).String()},
+			}, attributes...),
+		}
+	}
+
+	// The spread factor charged by the swap differs from the spread factor of the pool at the end of the block.
+	eventSpreadFactor := osmomath.NewDecWithPrec(3, 3)
+
+	tracker := trades.NewBlockEventTracker(trades.SwapEventTypes...)
+	tracker.StartBlock(s.Ctx.BlockHeight())
+	tracker.TrackTx([]byte("tx"), abci.ResponseDeliverTx{Events: []abci.Event{
+		newSwapEvent(abci.EventAttribute{Key: gammtypes.AttributeKeySpreadFactor, Value: eventSpreadFactor.String()}),
+		newSwapEvent(),
+	}})
+
+	sink := &sinkRecorderMock{}
+	ingester := trades.NewTradesIngester(sink, tracker, s.App.PoolManagerKeeper)
+
+	blockUpdate, err := ingester.ProcessBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(blockUpdate.Flush(context.Background()))
+
+	s.Require().Len(sink.trades, 2)
+	s.Require().Equal(eventSpreadFactor, sink.trades[0].SpreadFactor)
+	s.Require().Equal(osmomath.NewInt(3_000), sink.trades[0].SpreadFeeAmount)
+	s.Require().Equal(defaultSpreadFactor, sink.trades[1].SpreadFactor)
+	s.Require().Equal(osmomath.NewInt(10_000), sink.trades[1].SpreadFeeAmount)
+
+	// The swap events with a malformed spread factor fail the block.
+	tracker.StartBlock(s.Ctx.BlockHeight())
+	tracker.TrackTx([]byte("tx"), abci.ResponseDeliverTx{Events: []abci.Event{
+		newSwapEvent(abci.EventAttribute{Key: gammtypes.AttributeKeySpreadFactor, Value: "invalid"}),
+	}})
+
+	_, err = ingester.ProcessBlock(s.Ctx)
+	s.Require().Error(err)
+}
+
+// Tests that blocks without trades are skipped and that blocks
+// whose events were not tracked fail to be processed.
+func (s *TradesIngesterTestSuite) TestProcessBlock_NoTrades() {
+	s.Setup()
+
+	tracker := trades.NewBlockEventTracker(trades.SwapEventTypes...)
+	ingester := trades.NewTradesIngester(&sinkRecorderMock{}, tracker, s.App.PoolManagerKeeper)
+
+	// The events of the block are not tracked.
+	_, err := ingester.ProcessBlock(s.Ctx)
+	s.Require().Error(err)
+
+	tracker.StartBlock(s.Ctx.BlockHeight())
+	tracker.TrackTx([]byte("tx"), abci.ResponseDeliverTx{Events: []abci.Event{{Type: "transfer"}}})
+
+	blockUpdate, err := ingester.ProcessBlock(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Nil(blockUpdate)
+}
+
+// swap swaps the token in through the route with a fresh event manager
+// and returns the token out amount.
+func (s *TradesIngesterTestSuite) swap(sender sdk.AccAddress, route []poolmanagertypes.SwapAmountInRoute, tokenIn sdk.Coin) osmomath.Int {
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+	tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, sender, route, tokenIn, osmomath.OneInt())
+	s.Require().NoError(err)
+
+	return tokenOutAmount
+}
@@ -0,0 +1,140 @@
+package jsonlsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+)
+
+const (
+	// fileNameDateFormat is the format of the date in the file names.
+	fileNameDateFormat = "2006-01-02"
+
+	filePerm = 0o644
+	dirPerm  = 0o755
+)
+
+// jsonlSink is an implementation of domain.Sink that appends the trades
+// as JSON lines to one file per UTC day of the block time.
+type jsonlSink struct {
+	mu sync.Mutex
+
+	dir string
+
+	// file is the file of the latest written day. Nil until the first write.
+	file     *os.File
+	fileName string
+}
+
+var _ domain.Sink = &jsonlSink{}
+
+// New creates a new JSON lines sink that writes the files into the given directory.
+// The directory is created if it does not exist.
+func New(dir string) (domain.Sink, error) {
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return nil, err
+	}
+
+	return &jsonlSink{
+		dir: dir,
+	}, nil
+}
+
+// WriteTrades implements domain.Sink.
+// All trades of the block are written into the file of the day of the block time in a single write.
+// If the write fails, the file is truncated to its previous size.
+func (s *jsonlSink) WriteTrades(ctx context.Context, height int64, trades []domain.Trade) error {
+	if len(trades) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, trade := range trades {
+		if err := encoder.Encode(trade); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.openFile(FileName(trades[0]))
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		return s.rollback(file, info.Size(), fmt.Errorf("failed to write trades of block %d: %w", height, err))
+	}
+
+	if err := file.Sync(); err != nil {
+		return s.rollback(file, info.Size(), fmt.Errorf("failed to sync trades of block %d: %w", height, err))
+	}
+
+	return nil
+}
+
+// Close implements domain.Sink.
+func (s *jsonlSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+	s.fileName = ""
+	return err
+}
+
+// FileName returns the name of the file that the trade is written into.
+func FileName(trade domain.Trade) string {
+	return fmt.Sprintf("trades-%s.jsonl", trade.BlockTime.UTC().Format(fileNameDateFormat))
+}
+
+// openFile returns the file with the given name, closing the previously written file
+// if it is different. Must be called with the lock held.
+func (s *jsonlSink) openFile(fileName string) (*os.File, error) {
+	if s.file != nil && s.fileName == fileName {
+		return s.file, nil
+	}
+
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return nil, err
+		}
+		s.file = nil
+	}
+
+	file, err := os.OpenFile(filepath.Join(s.dir, fileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	s.file = file
+	s.fileName = fileName
+	return file, nil
+}
+
+// rollback truncates the file to the given size so that the failed write can be retried
+// without duplicating trades. Returns the write error joined with the truncation error if any.
+func (s *jsonlSink) rollback(file *os.File, size int64, writeErr error) error {
+	if err := file.Truncate(size); err != nil {
+		return fmt.Errorf("%w, failed to truncate %s: %v", writeErr, s.fileName, err)
+	}
+	return writeErr
+}
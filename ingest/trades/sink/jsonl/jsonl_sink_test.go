@@ -0,0 +1,99 @@
+package jsonlsink_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+	jsonlsink "github.com/osmosis-labs/osmosis/v22/ingest/trades/sink/jsonl"
+)
+
+// Tests that the trades are appended to the file of the day of the block time
+// and can be read back, including after reopening the sink.
+func TestWriteTrades(t *testing.T) {
+	var (
+		dir     = t.TempDir()
+		day     = time.Date(2024, 1, 2, 23, 59, 0, 0, time.UTC)
+		nextDay = day.Add(time.Minute)
+	)
+
+	newTrade := func(height int64, index int, blockTime time.Time) domain.Trade {
+		return domain.Trade{
+			Height:          height,
+			BlockTime:       blockTime,
+			Index:           index,
+			TxHash:          "ABCD",
+			Sender:          "osmo1sender",
+			PoolID:          1,
+			PoolType:        "Balancer",
+			TokenInDenom:    "uosmo",
+			TokenInAmount:   osmomath.NewInt(100),
+			TokenOutDenom:   "usdc",
+			TokenOutAmount:  osmomath.NewInt(50),
+			SpreadFactor:    osmomath.NewDecWithPrec(1, 2),
+			SpreadFeeAmount: osmomath.NewInt(1),
+			TakerFee:        osmomath.NewDecWithPrec(1, 3),
+			TakerFeeAmount:  osmomath.ZeroInt(),
+		}
+	}
+
+	sink, err := jsonlsink.New(dir)
+	require.NoError(t, err)
+
+	firstBlock := []domain.Trade{newTrade(1, 0, day), newTrade(1, 1, day)}
+	require.NoError(t, sink.WriteTrades(context.Background(), 1, firstBlock))
+
+	// Blocks without trades are ignored.
+	require.NoError(t, sink.WriteTrades(context.Background(), 2, nil))
+
+	secondBlock := []domain.Trade{newTrade(3, 0, nextDay)}
+	require.NoError(t, sink.WriteTrades(context.Background(), 3, secondBlock))
+	require.NoError(t, sink.Close())
+
+	// Writes after reopening are appended.
+	sink, err = jsonlsink.New(dir)
+	require.NoError(t, err)
+
+	thirdBlock := []domain.Trade{newTrade(4, 0, nextDay)}
+	require.NoError(t, sink.WriteTrades(context.Background(), 4, thirdBlock))
+	require.NoError(t, sink.Close())
+
+	require.Equal(t, "trades-2024-01-02.jsonl", jsonlsink.FileName(firstBlock[0]))
+	require.Equal(t, "trades-2024-01-03.jsonl", jsonlsink.FileName(secondBlock[0]))
+
+	requireTrades(t, filepath.Join(dir, jsonlsink.FileName(firstBlock[0])), firstBlock)
+	requireTrades(t, filepath.Join(dir, jsonlsink.FileName(secondBlock[0])), append(secondBlock, thirdBlock...))
+}
+
+// requireTrades requires the JSON lines file at the given path to contain the expected trades.
+func requireTrades(t *testing.T, path string, expectedTrades []domain.Trade) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	actualTrades := []domain.Trade{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var trade domain.Trade
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &trade))
+		actualTrades = append(actualTrades, trade)
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, actualTrades, len(expectedTrades))
+	for i, expectedTrade := range expectedTrades {
+		require.Equal(t, expectedTrade.Height, actualTrades[i].Height)
+		require.Equal(t, expectedTrade.Index, actualTrades[i].Index)
+		require.True(t, expectedTrade.BlockTime.Equal(actualTrades[i].BlockTime))
+		require.Equal(t, expectedTrade.TokenInAmount.String(), actualTrades[i].TokenInAmount.String())
+		require.Equal(t, expectedTrade.SpreadFactor.String(), actualTrades[i].SpreadFactor.String())
+	}
+}
@@ -0,0 +1,152 @@
+package trades
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/ingest"
+	"github.com/osmosis-labs/osmosis/v22/ingest/trades/domain"
+	jsonlsink "github.com/osmosis-labs/osmosis/v22/ingest/trades/sink/jsonl"
+)
+
+// Config defines the config for the trades ingester.
+type Config struct {
+	// IsEnabled defines if the trades ingester is enabled.
+	IsEnabled bool `mapstructure:"enabled"`
+
+	// SinkType defines the storage backend that the trades are written into.
+	// Only "jsonl" is supported.
+	SinkType domain.SinkType `mapstructure:"sink-type"`
+
+	// OutputDir defines the directory that the trades are written into.
+	// If empty, defaults to the trades directory under the node data directory.
+	OutputDir string `mapstructure:"output-dir"`
+
+	// IngestQueueSize defines the maximum number of block updates waiting to be written into the sink.
+	IngestQueueSize int `mapstructure:"ingest-queue-size"`
+
+	// IngestQueueOverflowPolicy defines what happens when a block update is queued while the queue is full.
+	// One of "block", "drop" or "coalesce". The trades of dropped block updates are lost.
+	IngestQueueOverflowPolicy ingest.OverflowPolicy `mapstructure:"ingest-queue-overflow-policy"`
+
+	// IngestMaxFlushRetries defines the number of times a failed sink write is retried before
+	// the block is recorded in the dead-letter log.
+	IngestMaxFlushRetries int `mapstructure:"ingest-max-flush-retries"`
+
+	// IngestFlushRetryBackoff defines the delay before the first retry of a failed sink write.
+	// The delay doubles with every retry.
+	IngestFlushRetryBackoff time.Duration `mapstructure:"ingest-flush-retry-backoff"`
+}
+
+const (
+	groupOptName = "osmosis-trades"
+
+	sinkTypeOptName                  = "sink-type"
+	outputDirOptName                 = "output-dir"
+	ingestQueueSizeOptName           = "ingest-queue-size"
+	ingestQueueOverflowPolicyOptName = "ingest-queue-overflow-policy"
+	ingestMaxFlushRetriesOptName     = "ingest-max-flush-retries"
+	ingestFlushRetryBackoffOptName   = "ingest-flush-retry-backoff"
+
+	// defaultOutputDirName is the name of the output directory under the node data directory.
+	defaultOutputDirName = "trades"
+)
+
+// DefaultConfig defines the default config for the trades ingester.
+// Since the trades cannot be recovered once the block is committed,
+// the block commit waits for the queue to have capacity by default.
+var DefaultConfig = Config{
+	IsEnabled: false,
+
+	SinkType: domain.JSONLSinkType,
+
+	OutputDir: "",
+
+	IngestQueueSize:           ingest.DefaultQueueConfig.Size,
+	IngestQueueOverflowPolicy: ingest.OverflowPolicyBlock,
+
+	IngestMaxFlushRetries:   ingest.DefaultQueueConfig.MaxFlushRetries,
+	IngestFlushRetryBackoff: ingest.DefaultQueueConfig.FlushRetryBackoff,
+}
+
+// NewConfigFromOptions returns a new trades ingester config from the given options.
+// Missing options fall back to the defaults.
+func NewConfigFromOptions(opts servertypes.AppOptions) Config {
+	if !osmoutils.ParseBool(opts, groupOptName, "is-enabled", false) {
+		return Config{
+			IsEnabled: false,
+		}
+	}
+
+	config := DefaultConfig
+	config.IsEnabled = true
+
+	config.SinkType = domain.SinkType(parseStringWithDefault(opts, sinkTypeOptName, string(DefaultConfig.SinkType)))
+	config.OutputDir = parseStringWithDefault(opts, outputDirOptName, DefaultConfig.OutputDir)
+	config.IngestQueueOverflowPolicy = ingest.OverflowPolicy(parseStringWithDefault(opts, ingestQueueOverflowPolicyOptName, string(DefaultConfig.IngestQueueOverflowPolicy)))
+
+	if opts.Get(groupOptName+"."+ingestQueueSizeOptName) != nil {
+		config.IngestQueueSize = osmoutils.ParseInt(opts, groupOptName, ingestQueueSizeOptName)
+	}
+
+	if opts.Get(groupOptName+"."+ingestMaxFlushRetriesOptName) != nil {
+		config.IngestMaxFlushRetries = osmoutils.ParseInt(opts, groupOptName, ingestMaxFlushRetriesOptName)
+	}
+
+	if flushRetryBackoff := opts.Get(groupOptName + "." + ingestFlushRetryBackoffOptName); flushRetryBackoff != nil {
+		config.IngestFlushRetryBackoff = cast.ToDuration(flushRetryBackoff)
+	}
+
+	return config
+}
+
+// Initialize initializes the trades sink and returns the trades ingester.
+// eventTracker must track the SwapEventTypes of every block.
+// homePath is the node home directory. It is used for the default output directory.
+func (c Config) Initialize(eventTracker domain.BlockEventTracker, poolManagerKeeper domain.PoolManagerKeeper, homePath string) (ingest.Ingester, error) {
+	sink, err := c.newSink(homePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTradesIngester(sink, eventTracker, poolManagerKeeper), nil
+}
+
+// QueueConfig returns the config of the queue of block updates waiting to be written into the sink.
+func (c Config) QueueConfig() ingest.QueueConfig {
+	return ingest.QueueConfig{
+		Size:              c.IngestQueueSize,
+		OverflowPolicy:    c.IngestQueueOverflowPolicy,
+		MaxFlushRetries:   c.IngestMaxFlushRetries,
+		FlushRetryBackoff: c.IngestFlushRetryBackoff,
+	}
+}
+
+// newSink creates the sink of the configured type.
+// Returns error if the sink type is unknown or if the sink fails to initialize.
+func (c Config) newSink(homePath string) (domain.Sink, error) {
+	switch c.SinkType {
+	case domain.JSONLSinkType:
+		outputDir := c.OutputDir
+		if outputDir == "" {
+			outputDir = filepath.Join(homePath, "data", defaultOutputDirName)
+		}
+		return jsonlsink.New(outputDir)
+	default:
+		return nil, fmt.Errorf("unknown trades sink type %q, expected %q", c.SinkType, domain.JSONLSinkType)
+	}
+}
+
+// parseStringWithDefault parses a string option from the trades ingester group.
+// Returns the default value if the option is missing.
+func parseStringWithDefault(opts servertypes.AppOptions, optName, defaultValue string) string {
+	if opts.Get(groupOptName+"."+optName) == nil {
+		return defaultValue
+	}
+	return osmoutils.ParseString(opts, groupOptName, optName)
+}
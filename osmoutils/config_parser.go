@@ -12,13 +12,13 @@ import (
 
 // ParseBool parses a boolean value from a server type option.
 func ParseBool(opts servertypes.AppOptions, groupOptName, optName string, defaultValue bool) bool {
-	fullOptName := "osmosis-sqs." + optName
+	fullOptName := groupOptName + "." + optName
 	valueInterface := opts.Get(fullOptName)
 	value := defaultValue
 	if valueInterface != nil {
 		valueStr, ok := valueInterface.(string)
 		if !ok {
-			panic("invalidly configured osmosis-sqs." + optName)
+			panic("invalidly configured " + fullOptName)
 		}
 		valueStr = strings.TrimSpace(valueStr)
 		v, err := strconv.ParseBool(valueStr)
@@ -36,7 +36,7 @@ func ParseBool(opts servertypes.AppOptions, groupOptName, optName string, defaul
 func ParseInt(opts servertypes.AppOptions, groupOptName, optName string) int {
 	valueInterface := opts.Get(groupOptName + "." + optName)
 	if valueInterface == nil {
-		panic("missing config for osmosis-sqs." + optName)
+		panic("missing config for " + groupOptName + "." + optName)
 	}
 	value := cast.ToInt(valueInterface)
 	return value
@@ -48,7 +48,7 @@ func ParseUint64Slice(opts servertypes.AppOptions, groupOptName, optName string)
 
 	valueUint64Slice, err := ParseStringToUint64Slice(stringSlice)
 	if err != nil {
-		panic(fmt.Sprintf("invalidly configured osmosis-sqs.%s, err= %v", optName, err))
+		panic(fmt.Sprintf("invalidly configured %s.%s, err= %v", groupOptName, optName, err))
 	}
 
 	return valueUint64Slice
@@ -88,7 +88,7 @@ func ParseStringToUint64Slice(input string) ([]uint64, error) {
 func ParseString(opts servertypes.AppOptions, groupOptName, optName string) string {
 	valueInterface := opts.Get(groupOptName + "." + optName)
 	if valueInterface == nil {
-		panic("missing config for osmosis-sqs." + optName)
+		panic("missing config for " + groupOptName + "." + optName)
 	}
 	value := cast.ToString(valueInterface)
 	return value
@@ -167,6 +167,10 @@ func init() {
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/ArithmeticTwapToNow", &twapquerytypes.ArithmeticTwapToNowResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwap", &twapquerytypes.GeometricTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/GeometricTwapToNow", &twapquerytypes.GeometricTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicTwap", &twapquerytypes.HarmonicTwapResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/HarmonicTwapToNow", &twapquerytypes.HarmonicTwapToNowResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/RealizedVolatility", &twapquerytypes.RealizedVolatilityResponse{})
+	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/AggregatedTwap", &twapquerytypes.AggregatedTwapResponse{})
 	setWhitelistedQuery("/osmosis.twap.v1beta1.Query/Params", &twapquerytypes.ParamsResponse{})
 
 	// downtime-detector
@@ -8,6 +8,8 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagDynamicSpreadFactorConfigs = "dynamic-spread-factor-configs"
+	FlagDisabledPoolIds            = "disabled-pool-ids"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
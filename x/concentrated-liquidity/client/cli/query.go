@@ -23,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLimitOrderById)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetUserLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetEffectiveSpreadFactor)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -52,6 +55,28 @@ func GetPositionById() (*osmocli.QueryDescriptor, *queryproto.PositionByIdReques
 		&queryproto.PositionByIdRequest{}
 }
 
+func GetLimitOrderById() (*osmocli.QueryDescriptor, *queryproto.LimitOrderByIdRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "limit-order-by-id",
+			Short: "Query limit order by ID",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} limit-order-by-id 7`,
+		},
+		&queryproto.LimitOrderByIdRequest{}
+}
+
+func GetUserLimitOrders() (*osmocli.QueryDescriptor, *queryproto.UserLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+			Use:   "user-limit-orders",
+			Short: "Query user's limit orders",
+			Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-limit-orders osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+			Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+			CustomFlagOverrides: poolIdFlagOverride,
+		},
+		&queryproto.UserLimitOrdersRequest{}
+}
+
 func GetCmdPools() (*osmocli.QueryDescriptor, *queryproto.PoolsRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "pools",
@@ -146,3 +171,12 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetEffectiveSpreadFactor() (*osmocli.QueryDescriptor, *queryproto.EffectiveSpreadFactorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "effective-spread-factor",
+		Short: "Query the spread factor currently charged by the swaps of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} effective-spread-factor 1`,
+	}, &queryproto.EffectiveSpreadFactorRequest{}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -16,6 +17,7 @@ import (
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
@@ -31,6 +33,11 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewCancelLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewSetManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewUnsetManagedPositionCmd)
 	return txCmd
 }
 
@@ -103,6 +110,48 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order",
+		Short:   "place a limit order selling the given token at the price of the given tick",
+		Long:    "the tick index is rounded down to the pool tick spacing. Orders selling token0 must be placed above the current tick and orders selling token1 at or below it",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 \"[-69000]\" 10000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order",
+		Short:   "claim the proceeds of a filled limit order",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
+
+func NewCancelLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgCancelLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "cancel-limit-order",
+		Short:   "cancel an unfilled limit order, refunding the tokens backing it",
+		Example: "osmosisd tx concentratedliquidity cancel-limit-order 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgCancelLimitOrder{}
+}
+
+func NewSetManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgSetManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-managed-position",
+		Short:   "put a position in managed mode, compounding its rewards into it at the end of every day epoch",
+		Long:    "the rebalance width is the width in ticks of the range that the position is re-centred on around the current tick when it is out of range. It must be a multiple of the pool tick spacing, zero disabling re-centring",
+		Example: "osmosisd tx concentratedliquidity set-managed-position 1 1000 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgSetManagedPosition{}
+}
+
+func NewUnsetManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgUnsetManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "unset-managed-position",
+		Short:   "take a position out of managed mode",
+		Example: "osmosisd tx concentratedliquidity unset-managed-position 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgUnsetManagedPosition{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,6 +239,55 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSetDynamicSpreadFactorProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dynamic-spread-factor-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a set dynamic spread factor proposal",
+		Long: strings.TrimSpace(`Submit a set dynamic spread factor proposal.
+
+Passing in FlagDynamicSpreadFactorConfigs separated by commas would be parsed automatically to dynamic spread factor configs.
+Ex) --dynamic-spread-factor-configs=1,0.001,0.01,0.5,1h,5,0.0005,0.005,1,30m ->
+[poolId 1, minSpreadFactor 0.1%, maxSpreadFactor 1%, volatilityMultiplier 0.5, volatilityWindow 1h]
+[poolId 5, minSpreadFactor 0.05%, maxSpreadFactor 0.5%, volatilityMultiplier 1, volatilityWindow 30m]
+
+Passing in FlagDisabledPoolIds separated by commas takes the pools out of dynamic spread factor mode.
+Ex) --disabled-pool-ids=2,3
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSetDynamicSpreadFactorArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagDynamicSpreadFactorConfigs, "", "The dynamic spread factor configs array")
+	cmd.Flags().String(FlagDisabledPoolIds, "", "The ids of the pools to take out of dynamic spread factor mode")
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -317,3 +415,98 @@ func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 
 	return finalPoolRecords, nil
 }
+
+func parseSetDynamicSpreadFactorArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	configs, err := parseDynamicSpreadFactorConfigs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledPoolIdsStr, err := cmd.Flags().GetString(FlagDisabledPoolIds)
+	if err != nil {
+		return nil, err
+	}
+	disabledPoolIds := []uint64{}
+	if disabledPoolIdsStr != "" {
+		disabledPoolIds, err = osmoutils.ParseUint64SliceFromString(disabledPoolIdsStr, ",")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	content := &types.SetDynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Configs:         configs,
+		DisabledPoolIds: disabledPoolIds,
+	}
+	return content, nil
+}
+
+func parseDynamicSpreadFactorConfigs(cmd *cobra.Command) ([]types.DynamicSpreadFactorConfig, error) {
+	configsStr, err := cmd.Flags().GetString(FlagDynamicSpreadFactorConfigs)
+	if err != nil {
+		return nil, err
+	}
+	if configsStr == "" {
+		return []types.DynamicSpreadFactorConfig{}, nil
+	}
+
+	configFields := strings.Split(configsStr, ",")
+
+	if len(configFields)%5 != 0 {
+		return nil, fmt.Errorf("dynamicSpreadFactorConfigs must be a list of poolId, minSpreadFactor, maxSpreadFactor, volatilityMultiplier, and volatilityWindow")
+	}
+
+	configs := []types.DynamicSpreadFactorConfig{}
+	i := 0
+	for i < len(configFields) {
+		poolId, err := strconv.ParseUint(configFields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		minSpreadFactor, err := osmomath.NewDecFromStr(configFields[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		maxSpreadFactor, err := osmomath.NewDecFromStr(configFields[i+2])
+		if err != nil {
+			return nil, err
+		}
+
+		volatilityMultiplier, err := osmomath.NewDecFromStr(configFields[i+3])
+		if err != nil {
+			return nil, err
+		}
+
+		volatilityWindow, err := time.ParseDuration(configFields[i+4])
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, types.DynamicSpreadFactorConfig{
+			PoolId:               poolId,
+			MinSpreadFactor:      minSpreadFactor,
+			MaxSpreadFactor:      maxSpreadFactor,
+			VolatilityMultiplier: volatilityMultiplier,
+			VolatilityWindow:     volatilityWindow,
+		})
+
+		// increase counter by the next 5
+		i = i + 5
+	}
+
+	return configs, nil
+}
@@ -77,6 +77,13 @@ func (q Querier) PositionById(ctx sdk.Context, req clquery.PositionByIdRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	var managedPosition *model.ManagedPosition
+	if mp, isManaged, err := q.Keeper.GetManagedPosition(ctx, position.PositionId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if isManaged {
+		managedPosition = &mp
+	}
+
 	return &clquery.PositionByIdResponse{
 		Position: model.FullPositionBreakdown{
 			Position:               position,
@@ -85,6 +92,7 @@ func (q Querier) PositionById(ctx sdk.Context, req clquery.PositionByIdRequest)
 			ClaimableSpreadRewards: claimableSpreadRewards,
 			ClaimableIncentives:    claimableIncentives,
 			ForfeitedIncentives:    forfeitedIncentives,
+			ManagedPosition:        managedPosition,
 		},
 	}, nil
 }
@@ -318,3 +326,61 @@ func (q Querier) NumNextInitializedTicks(ctx sdk.Context, req clquery.NumNextIni
 
 	return &clquery.NumNextInitializedTicksResponse{LiquidityDepths: liquidityDepths, CurrentLiquidity: pool.GetLiquidity(), CurrentTick: pool.GetCurrentTick()}, nil
 }
+
+// LimitOrderById returns the limit order with the specified id.
+func (q Querier) LimitOrderById(ctx sdk.Context, req clquery.LimitOrderByIdRequest) (*clquery.LimitOrderByIdResponse, error) {
+	limitOrder, err := q.Keeper.GetLimitOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &clquery.LimitOrderByIdResponse{LimitOrder: limitOrder}, nil
+}
+
+// UserLimitOrders returns the limit orders of the specified address, filled or not.
+// If a pool id is specified, only the limit orders in that pool are returned.
+func (q Querier) UserLimitOrders(ctx sdk.Context, req clquery.UserLimitOrdersRequest) (*clquery.UserLimitOrdersResponse, error) {
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limitOrders, pageRes, err := q.Keeper.GetUserLimitOrders(ctx, sdkAddr, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.UserLimitOrdersResponse{
+		LimitOrders: limitOrders,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// EffectiveSpreadFactor returns the spread factor currently charged by the swaps of the given pool,
+// along with the pool's dynamic spread factor config if it is in dynamic spread factor mode.
+func (q Querier) EffectiveSpreadFactor(ctx sdk.Context, req clquery.EffectiveSpreadFactorRequest) (*clquery.EffectiveSpreadFactorResponse, error) {
+	pool, err := q.Keeper.GetConcentratedPoolById(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	effectiveSpreadFactor, volatility, err := q.Keeper.GetEffectiveSpreadFactor(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	config, found, err := q.Keeper.GetDynamicSpreadFactorConfig(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &clquery.EffectiveSpreadFactorResponse{
+		SpreadFactor:          pool.GetSpreadFactor(ctx),
+		EffectiveSpreadFactor: effectiveSpreadFactor,
+		Volatility:            volatility,
+	}
+	if found {
+		res.DynamicSpreadFactorConfig = &config
+	}
+	return res, nil
+}
@@ -1631,6 +1631,307 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
+// =============================== LimitOrderById
+type LimitOrderByIdRequest struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+}
+
+func (m *LimitOrderByIdRequest) Reset()         { *m = LimitOrderByIdRequest{} }
+func (m *LimitOrderByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderByIdRequest) ProtoMessage()    {}
+func (*LimitOrderByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
+}
+func (m *LimitOrderByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderByIdRequest.Merge(m, src)
+}
+func (m *LimitOrderByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderByIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderByIdRequest proto.InternalMessageInfo
+
+func (m *LimitOrderByIdRequest) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+type LimitOrderByIdResponse struct {
+	LimitOrder model.LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+}
+
+func (m *LimitOrderByIdResponse) Reset()         { *m = LimitOrderByIdResponse{} }
+func (m *LimitOrderByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderByIdResponse) ProtoMessage()    {}
+func (*LimitOrderByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
+}
+func (m *LimitOrderByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderByIdResponse.Merge(m, src)
+}
+func (m *LimitOrderByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderByIdResponse proto.InternalMessageInfo
+
+func (m *LimitOrderByIdResponse) GetLimitOrder() model.LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return model.LimitOrder{}
+}
+
+// =============================== UserLimitOrders
+type UserLimitOrdersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId     uint64             `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserLimitOrdersRequest) Reset()         { *m = UserLimitOrdersRequest{} }
+func (m *UserLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersRequest) ProtoMessage()    {}
+func (*UserLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *UserLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersRequest.Merge(m, src)
+}
+func (m *UserLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *UserLimitOrdersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UserLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *UserLimitOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type UserLimitOrdersResponse struct {
+	LimitOrders []model.LimitOrder  `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *UserLimitOrdersResponse) Reset()         { *m = UserLimitOrdersResponse{} }
+func (m *UserLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*UserLimitOrdersResponse) ProtoMessage()    {}
+func (*UserLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *UserLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLimitOrdersResponse.Merge(m, src)
+}
+func (m *UserLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLimitOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *UserLimitOrdersResponse) GetLimitOrders() []model.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *UserLimitOrdersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== EffectiveSpreadFactor
+type EffectiveSpreadFactorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *EffectiveSpreadFactorRequest) Reset()         { *m = EffectiveSpreadFactorRequest{} }
+func (m *EffectiveSpreadFactorRequest) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorRequest) ProtoMessage()    {}
+func (*EffectiveSpreadFactorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorRequest proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type EffectiveSpreadFactorResponse struct {
+	// spread_factor is the spread factor the pool was created with.
+	SpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=spread_factor,json=spreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spread_factor" yaml:"spread_factor"`
+	// effective_spread_factor is the spread factor currently charged by swaps.
+	EffectiveSpreadFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=effective_spread_factor,json=effectiveSpreadFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_spread_factor" yaml:"effective_spread_factor"`
+	// volatility is the relative deviation of the pool spot price from its
+	// geometric TWAP that the effective spread factor is derived from. It is zero
+	// if the pool is not in dynamic spread factor mode.
+	Volatility cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=volatility,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"volatility" yaml:"volatility"`
+	// dynamic_spread_factor_config is the dynamic spread factor configuration of
+	// the pool, if any.
+	DynamicSpreadFactorConfig *types1.DynamicSpreadFactorConfig `protobuf:"bytes,4,opt,name=dynamic_spread_factor_config,json=dynamicSpreadFactorConfig,proto3" json:"dynamic_spread_factor_config,omitempty"`
+}
+
+func (m *EffectiveSpreadFactorResponse) Reset()         { *m = EffectiveSpreadFactorResponse{} }
+func (m *EffectiveSpreadFactorResponse) String() string { return proto.CompactTextString(m) }
+func (*EffectiveSpreadFactorResponse) ProtoMessage()    {}
+func (*EffectiveSpreadFactorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EffectiveSpreadFactorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.Merge(m, src)
+}
+func (m *EffectiveSpreadFactorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EffectiveSpreadFactorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectiveSpreadFactorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectiveSpreadFactorResponse proto.InternalMessageInfo
+
+func (m *EffectiveSpreadFactorResponse) GetDynamicSpreadFactorConfig() *types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
 	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
@@ -1664,6 +1965,12 @@ func init() {
 	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
 	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
 	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*LimitOrderByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderByIdRequest")
+	proto.RegisterType((*LimitOrderByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderByIdResponse")
+	proto.RegisterType((*UserLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersRequest")
+	proto.RegisterType((*UserLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserLimitOrdersResponse")
+	proto.RegisterType((*EffectiveSpreadFactorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorRequest")
+	proto.RegisterType((*EffectiveSpreadFactorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.EffectiveSpreadFactorResponse")
 }
 
 func init() {
@@ -1671,153 +1978,175 @@ func init() {
 }
 
 var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x4e, 0x39, 0xbf, 0xf3, 0xe2, 0xfc, 0x95, 0x1d, 0xdb, 0x99, 0x24, 0x33, 0xd9, 0x82, 0xb0,
-	0x16, 0x49, 0x66, 0x88, 0x93, 0x10, 0xf2, 0xb7, 0x59, 0x8f, 0xbd, 0x8e, 0xac, 0x75, 0xbc, 0x4e,
-	0x27, 0x06, 0xc4, 0x81, 0xde, 0x9e, 0xee, 0xf2, 0xb8, 0x35, 0x3d, 0x5d, 0xe3, 0xee, 0xea, 0x24,
-	0x66, 0x89, 0xb4, 0xda, 0x3d, 0x22, 0xc1, 0x22, 0xae, 0x08, 0x09, 0x71, 0x41, 0x2b, 0x8e, 0x5c,
-	0xe0, 0x82, 0xe0, 0x80, 0x22, 0x0e, 0xab, 0x95, 0x10, 0x12, 0xda, 0xc3, 0x2c, 0x24, 0x1c, 0x90,
-	0x16, 0x90, 0x30, 0x17, 0x8e, 0xa8, 0xab, 0xab, 0x7a, 0x7a, 0xc6, 0x3d, 0x76, 0xcf, 0xd8, 0x9c,
-	0xf6, 0x34, 0x53, 0xfd, 0xea, 0xbd, 0xf7, 0xbd, 0x9f, 0x7a, 0x5d, 0xef, 0xcd, 0xc0, 0x65, 0xe6,
-	0x37, 0x98, 0x6f, 0xfb, 0x65, 0x93, 0xb9, 0x26, 0x75, 0xb9, 0x67, 0x70, 0x6a, 0x39, 0xf6, 0x5a,
-	0x60, 0x5b, 0x36, 0x5f, 0x2f, 0x3f, 0xbe, 0x5c, 0xa5, 0xdc, 0xb8, 0x5c, 0x5e, 0x0b, 0xa8, 0xb7,
-	0x5e, 0x6a, 0x7a, 0x8c, 0x33, 0x7c, 0x5e, 0xb2, 0x94, 0x52, 0x59, 0x4a, 0x92, 0x25, 0x3f, 0x5a,
-	0x63, 0x35, 0x26, 0x38, 0xca, 0xe1, 0xb7, 0x88, 0x39, 0xff, 0xe5, 0xad, 0xf5, 0x35, 0x0d, 0xcf,
-	0x68, 0xf8, 0x72, 0xef, 0xd5, 0x6c, 0xd8, 0xb8, 0x6d, 0xd6, 0xe7, 0xdd, 0x15, 0xa5, 0xa1, 0x60,
-	0x0a, 0xb6, 0x72, 0xd5, 0xf0, 0x69, 0xbc, 0xc7, 0x64, 0xb6, 0xab, 0x10, 0x24, 0xe9, 0xc2, 0xae,
-	0x78, 0x57, 0xd3, 0xa8, 0xd9, 0xae, 0xc1, 0x6d, 0xa6, 0xf6, 0x9e, 0xa9, 0x31, 0x56, 0x73, 0x68,
-	0xd9, 0x68, 0xda, 0x65, 0xc3, 0x75, 0x19, 0x17, 0x44, 0x85, 0xef, 0x94, 0xa4, 0x8a, 0x55, 0x35,
-	0x58, 0x29, 0x1b, 0xee, 0xba, 0x22, 0x45, 0x4a, 0xf4, 0xc8, 0xfe, 0x68, 0x21, 0x49, 0xc5, 0x6e,
-	0x2e, 0x6e, 0x37, 0xa8, 0xcf, 0x8d, 0x46, 0x53, 0x19, 0xd0, 0xbd, 0xc1, 0x0a, 0xbc, 0x24, 0xa8,
-	0x8c, 0x6e, 0x69, 0x32, 0xdf, 0x4e, 0x70, 0xdd, 0xce, 0xc6, 0x65, 0x0b, 0xa2, 0xfd, 0x98, 0xea,
-	0x1e, 0x35, 0x99, 0x67, 0x45, 0xdc, 0xe4, 0x57, 0x08, 0x46, 0x97, 0x7d, 0xea, 0x2d, 0x49, 0xa1,
-	0xbe, 0x46, 0xd7, 0x02, 0xea, 0x73, 0x7c, 0x11, 0x0e, 0x1a, 0x96, 0xe5, 0x51, 0xdf, 0x9f, 0x40,
-	0xe7, 0xd0, 0x64, 0xae, 0x82, 0x37, 0x5a, 0xc5, 0xa3, 0xeb, 0x46, 0xc3, 0xb9, 0x49, 0x24, 0x81,
-	0x68, 0x6a, 0x0b, 0xbe, 0x00, 0x07, 0x9b, 0x8c, 0x39, 0xba, 0x6d, 0x4d, 0x0c, 0x9d, 0x43, 0x93,
-	0xfb, 0x92, 0xbb, 0x25, 0x81, 0x68, 0x07, 0xc2, 0x6f, 0xf3, 0x16, 0x9e, 0x03, 0x68, 0x07, 0x64,
-	0x62, 0xef, 0x39, 0x34, 0x79, 0x78, 0xea, 0x4b, 0x25, 0xe9, 0xcb, 0x30, 0x7a, 0xa5, 0x28, 0x2b,
-	0x25, 0xf4, 0xd2, 0x92, 0x51, 0xa3, 0x12, 0x96, 0x96, 0xe0, 0x24, 0xbf, 0x43, 0x70, 0xb2, 0x0b,
-	0xbb, 0xdf, 0x64, 0xae, 0x4f, 0xf1, 0xdb, 0x90, 0x53, 0x5e, 0x0a, 0xe1, 0xef, 0x9d, 0x3c, 0x3c,
-	0x75, 0xbb, 0x94, 0x29, 0xbb, 0x4b, 0x73, 0x81, 0xe3, 0x28, 0x81, 0x15, 0x8f, 0x1a, 0x75, 0x8b,
-	0x3d, 0x71, 0x2b, 0xfb, 0x9e, 0xb7, 0x8a, 0x7b, 0xb4, 0xb6, 0x50, 0x7c, 0xaf, 0xc3, 0x86, 0x21,
-	0x61, 0xc3, 0xab, 0xdb, 0xda, 0x10, 0xc1, 0xeb, 0x30, 0x62, 0x11, 0x46, 0x62, 0x75, 0xeb, 0xf3,
-	0x96, 0x72, 0xff, 0x75, 0x38, 0xac, 0x94, 0x85, 0x4e, 0x45, 0xc2, 0xa9, 0x63, 0x1b, 0xad, 0x22,
-	0x56, 0x4e, 0x8d, 0x89, 0x44, 0x03, 0xb5, 0x9a, 0xb7, 0xc8, 0x63, 0x18, 0xed, 0x94, 0x27, 0x5d,
-	0xf2, 0x6d, 0x38, 0xa4, 0x76, 0x09, 0x69, 0xbb, 0xe3, 0x91, 0x58, 0x26, 0xf9, 0x3a, 0x0c, 0x2f,
-	0x31, 0xe6, 0xc4, 0xf9, 0x33, 0x97, 0xe2, 0xa0, 0x41, 0x82, 0xfc, 0x03, 0x04, 0x47, 0xa4, 0x60,
-	0x69, 0xc9, 0x35, 0xd8, 0x1f, 0x26, 0x92, 0x0a, 0xec, 0x68, 0x29, 0x3a, 0x56, 0x25, 0x75, 0xac,
-	0x4a, 0xd3, 0xee, 0x7a, 0x25, 0xf7, 0x87, 0x5f, 0x5e, 0xda, 0x1f, 0xf2, 0xcd, 0x6b, 0xd1, 0xee,
-	0xdd, 0x8b, 0xd8, 0x31, 0x38, 0xb2, 0x24, 0xaa, 0x99, 0x84, 0x4b, 0x96, 0xe1, 0xa8, 0x7a, 0x20,
-	0x21, 0xce, 0xc0, 0x81, 0xa8, 0xe0, 0x49, 0x57, 0x9f, 0xdf, 0xc6, 0xd5, 0x11, 0xbb, 0xf4, 0xa9,
-	0x64, 0x25, 0x1f, 0x22, 0x38, 0xfe, 0xc8, 0x36, 0xeb, 0x0b, 0x6a, 0xdb, 0x22, 0xe5, 0xf8, 0x6d,
-	0x38, 0x12, 0xb3, 0xe9, 0x2e, 0xe5, 0xf2, 0x70, 0xde, 0x0a, 0x39, 0x3f, 0x69, 0x15, 0x4f, 0x47,
-	0xf6, 0xf8, 0x56, 0xbd, 0x64, 0xb3, 0x72, 0xc3, 0xe0, 0xab, 0xa5, 0x05, 0x5a, 0x33, 0xcc, 0xf5,
-	0x59, 0x6a, 0x6e, 0xb4, 0x8a, 0xa3, 0x51, 0xf2, 0x74, 0x48, 0x20, 0xda, 0xb0, 0x93, 0xd4, 0x70,
-	0x15, 0x20, 0x2c, 0xbc, 0xba, 0xed, 0x5a, 0xf4, 0xa9, 0xf0, 0xd3, 0xde, 0xca, 0xc9, 0x8d, 0x56,
-	0xf1, 0x44, 0xc4, 0xdb, 0xa6, 0x11, 0x2d, 0x17, 0x55, 0xe8, 0xf0, 0xfb, 0x3f, 0x11, 0x8c, 0xc7,
-	0x40, 0x67, 0x69, 0x93, 0xaf, 0x7e, 0xc3, 0xe6, 0xab, 0x9a, 0xe1, 0xd6, 0x28, 0x5e, 0x81, 0xe3,
-	0x6d, 0x8d, 0x46, 0x83, 0x05, 0xee, 0xae, 0xc0, 0x3e, 0x16, 0xaf, 0xa7, 0x85, 0xcc, 0x10, 0xb9,
-	0xc3, 0x9e, 0x50, 0x4f, 0x0f, 0x61, 0x6d, 0x46, 0xde, 0xa6, 0x11, 0x2d, 0x27, 0x16, 0xa1, 0x77,
-	0x43, 0xae, 0xa0, 0xd9, 0x54, 0x5c, 0x7b, 0xbb, 0xb9, 0xda, 0x34, 0xa2, 0xe5, 0xc4, 0x22, 0xe4,
-	0x22, 0x9f, 0x0e, 0x41, 0x21, 0x19, 0x98, 0x79, 0x77, 0xd6, 0xf6, 0xa8, 0x19, 0x26, 0x88, 0x3a,
-	0x01, 0x89, 0x9a, 0x88, 0xb6, 0xad, 0x89, 0x25, 0x38, 0xc4, 0x59, 0x9d, 0xba, 0xba, 0x1d, 0xe5,
-	0x66, 0xae, 0x32, 0xb2, 0xd1, 0x2a, 0x1e, 0x93, 0x3e, 0x97, 0x14, 0xa2, 0x1d, 0x14, 0x5f, 0xe7,
-	0xdd, 0x10, 0xb5, 0xcf, 0x0d, 0x8f, 0xf7, 0x40, 0xdd, 0xa6, 0x11, 0x2d, 0x27, 0x16, 0xc2, 0xd6,
-	0x1b, 0x30, 0x1c, 0xf8, 0x54, 0x37, 0x03, 0x69, 0xed, 0xbe, 0x73, 0x68, 0xf2, 0x50, 0x65, 0x7c,
-	0xa3, 0x55, 0x1c, 0x91, 0xd6, 0x26, 0xa8, 0x44, 0x83, 0xc0, 0xa7, 0x33, 0x41, 0xec, 0xa6, 0x2a,
-	0x0b, 0x5c, 0x2b, 0x62, 0xdc, 0xdf, 0xad, 0xb0, 0x4d, 0x23, 0x5a, 0x4e, 0x2c, 0x92, 0x0a, 0x5d,
-	0xa6, 0x8b, 0x67, 0x13, 0x07, 0xd2, 0x14, 0x2a, 0x6a, 0xa4, 0x70, 0x91, 0x55, 0xc4, 0xe2, 0xa7,
-	0x7b, 0xa1, 0xd8, 0xd3, 0xc3, 0xf2, 0x9c, 0xad, 0x26, 0x33, 0xcb, 0x0a, 0xb3, 0x4e, 0x55, 0x85,
-	0xeb, 0x19, 0x8b, 0x5b, 0xf7, 0x01, 0x93, 0x67, 0xb0, 0x9d, 0x5b, 0x22, 0x97, 0x7d, 0xfc, 0x0a,
-	0x0c, 0x9b, 0x81, 0xe7, 0x51, 0x97, 0x27, 0xb2, 0x4b, 0x3b, 0x2c, 0x9f, 0x09, 0x5b, 0x1d, 0x38,
-	0xa1, 0xb6, 0xc4, 0xdc, 0x22, 0x32, 0xb9, 0xca, 0xdd, 0x6c, 0x79, 0x3e, 0x11, 0xf9, 0x64, 0x93,
-	0x14, 0xa2, 0x1d, 0x97, 0xcf, 0x62, 0xa8, 0xf8, 0x3d, 0x04, 0x58, 0x6d, 0xf4, 0xd7, 0x3c, 0xae,
-	0x37, 0x3d, 0xdb, 0xa4, 0x22, 0xa2, 0xb9, 0xca, 0x23, 0xa9, 0xaf, 0x5c, 0xb3, 0xf9, 0x6a, 0x50,
-	0x2d, 0x99, 0xac, 0x51, 0x96, 0xfe, 0xb8, 0xe4, 0x18, 0x55, 0x5f, 0x2d, 0xc4, 0xa7, 0x80, 0x51,
-	0xb1, 0x6b, 0x11, 0x86, 0x53, 0x9d, 0x18, 0xda, 0xa2, 0xdb, 0x20, 0x1e, 0xae, 0x79, 0x7c, 0x49,
-	0x3c, 0x7a, 0x13, 0xce, 0xc4, 0x88, 0x96, 0xa2, 0x93, 0x21, 0x8e, 0xfc, 0x20, 0x47, 0x80, 0xfc,
-	0x06, 0xc1, 0xd9, 0x1e, 0xd2, 0x64, 0xb8, 0xab, 0x90, 0x6b, 0x7b, 0x36, 0x8a, 0xf3, 0x6b, 0x19,
-	0xe3, 0xdc, 0xa3, 0x36, 0xa9, 0x17, 0x7b, 0xcc, 0x80, 0x6f, 0xc2, 0x70, 0x35, 0x30, 0xeb, 0x94,
-	0x77, 0x14, 0xc0, 0x44, 0xc6, 0x26, 0xa9, 0x44, 0x3b, 0x1c, 0x2d, 0xa3, 0x22, 0xf8, 0x4d, 0x38,
-	0x3b, 0xe3, 0x18, 0x76, 0xc3, 0xa8, 0x3a, 0xf4, 0x61, 0xd3, 0xa3, 0x86, 0xa5, 0xd1, 0x27, 0x86,
-	0x67, 0xf9, 0x3b, 0x7e, 0xab, 0xff, 0x04, 0x41, 0xa1, 0x97, 0x68, 0xe9, 0x9c, 0xef, 0xc2, 0x84,
-	0xa9, 0x76, 0xe8, 0xbe, 0xd8, 0xa2, 0x7b, 0xd1, 0x1e, 0xe9, 0xab, 0x53, 0x1d, 0x6f, 0x3b, 0xe5,
-	0x99, 0x19, 0x66, 0xbb, 0x95, 0x57, 0x43, 0x37, 0x6c, 0xb4, 0x8a, 0x45, 0x19, 0xfd, 0x1e, 0x82,
-	0x88, 0x36, 0x66, 0xa6, 0xa2, 0x20, 0xcb, 0x90, 0x8f, 0xf1, 0xcd, 0xab, 0xab, 0xe6, 0xce, 0xed,
-	0x7e, 0x7f, 0x08, 0x4e, 0xa7, 0xca, 0x95, 0x46, 0xaf, 0xc1, 0x68, 0x1b, 0x6b, 0x7c, 0xc5, 0xcd,
-	0x60, 0xf0, 0x17, 0xa4, 0xc1, 0xa7, 0xbb, 0x0d, 0x6e, 0x0b, 0x21, 0xda, 0x88, 0xb9, 0x59, 0x75,
-	0xa8, 0x72, 0x85, 0x79, 0x2b, 0xd4, 0xe6, 0xd4, 0x4a, 0xaa, 0x1c, 0xea, 0x53, 0x65, 0x9a, 0x10,
-	0xa2, 0x8d, 0xc4, 0x8f, 0xdb, 0x2a, 0xc9, 0x02, 0x9c, 0x0d, 0xaf, 0x32, 0xd3, 0xa6, 0x19, 0x34,
-	0x02, 0xc7, 0xe0, 0xcc, 0xeb, 0xca, 0xab, 0xbe, 0xce, 0xd9, 0x6f, 0x87, 0xa0, 0xd0, 0x4b, 0x9c,
-	0x74, 0xeb, 0x07, 0x08, 0x4e, 0x77, 0x44, 0x5e, 0xaf, 0x79, 0xec, 0x09, 0x5f, 0xd5, 0x6b, 0x0e,
-	0xab, 0x1a, 0x8e, 0x74, 0xef, 0x99, 0x54, 0x5b, 0x67, 0xa9, 0x29, 0xcc, 0xbd, 0x12, 0x9a, 0xfb,
-	0xe1, 0xa7, 0xc5, 0x0b, 0x89, 0x1a, 0x24, 0x3b, 0xb4, 0xe8, 0xe3, 0x92, 0x6f, 0xd5, 0xcb, 0x7c,
-	0xbd, 0x49, 0x7d, 0xc5, 0xe3, 0x6b, 0x13, 0x7e, 0x22, 0xab, 0xee, 0x09, 0x9d, 0xf7, 0x84, 0x4a,
-	0xfc, 0x3d, 0x04, 0xa3, 0x41, 0x33, 0x6c, 0xa9, 0xba, 0xb0, 0x44, 0x7e, 0xbf, 0x9a, 0xb1, 0x0e,
-	0x2c, 0x0b, 0x11, 0x8f, 0x3c, 0xc3, 0xac, 0x53, 0xaf, 0x3b, 0x24, 0x69, 0xf2, 0x89, 0x86, 0xa3,
-	0xc7, 0x49, 0x34, 0xe4, 0x7d, 0x04, 0x85, 0xb0, 0x3e, 0x25, 0x7c, 0x28, 0x65, 0x0e, 0x14, 0x93,
-	0x01, 0x2f, 0x5d, 0x9f, 0x0d, 0x41, 0xb1, 0x27, 0x0a, 0x19, 0xca, 0xe7, 0x08, 0x6e, 0xa4, 0x86,
-	0x92, 0x35, 0xc5, 0x39, 0xa3, 0xba, 0xa5, 0x5e, 0xab, 0x3a, 0x5b, 0xd1, 0x1d, 0xc3, 0xe7, 0x3a,
-	0xf7, 0x8c, 0xc7, 0xd4, 0xf3, 0xff, 0x9f, 0x81, 0x9e, 0xda, 0x1c, 0xe8, 0xb7, 0x24, 0xa0, 0xf8,
-	0x35, 0xff, 0xd6, 0xca, 0x82, 0xe1, 0xf3, 0x47, 0x0a, 0x0c, 0x7e, 0x06, 0xc7, 0x64, 0x84, 0xb8,
-	0xb4, 0x72, 0x47, 0xc1, 0x2f, 0xc8, 0xe0, 0x8f, 0x75, 0x04, 0x5f, 0x89, 0x26, 0xda, 0xd1, 0x20,
-	0xb9, 0xdd, 0x27, 0xdf, 0x47, 0x30, 0x1e, 0x1f, 0x4a, 0x4d, 0x34, 0xd1, 0x83, 0x05, 0x7b, 0xb7,
-	0x5a, 0xa3, 0x8f, 0x10, 0x4c, 0x6c, 0x06, 0x24, 0xe3, 0x6e, 0xc3, 0x89, 0xee, 0x96, 0x5f, 0x95,
-	0xc5, 0xaf, 0x66, 0x74, 0x57, 0x97, 0x6c, 0xf9, 0xae, 0x3c, 0x6e, 0x77, 0xa9, 0xdc, 0xbd, 0xce,
-	0xea, 0x5d, 0x04, 0x17, 0x66, 0xe6, 0xee, 0xdf, 0x17, 0x7d, 0x9b, 0xb5, 0x60, 0xbb, 0xf5, 0x39,
-	0x8f, 0x35, 0x66, 0x12, 0x20, 0x23, 0x8a, 0xf2, 0xfa, 0x03, 0x18, 0x4d, 0x5a, 0xa0, 0x77, 0x86,
-	0xa0, 0x98, 0x28, 0xef, 0x29, 0xbb, 0x88, 0x86, 0xcd, 0x4d, 0x92, 0x89, 0x0d, 0x17, 0xb3, 0x21,
-	0x90, 0x6e, 0xbe, 0x01, 0xc3, 0xe6, 0x4a, 0xa3, 0xd1, 0xa5, 0x3a, 0x71, 0x5d, 0x48, 0x52, 0x89,
-	0x06, 0xe1, 0x52, 0xaa, 0xba, 0x0f, 0x67, 0x97, 0x7d, 0xea, 0x2d, 0xbb, 0x55, 0xe6, 0x5a, 0xb6,
-	0x5b, 0xdb, 0xd9, 0x08, 0x86, 0xfc, 0x0c, 0x41, 0xa1, 0x97, 0x3c, 0x09, 0xf6, 0x5d, 0x04, 0xf9,
-	0x78, 0x84, 0xa1, 0x3f, 0xb1, 0xf9, 0xaa, 0xde, 0xa4, 0x9e, 0xcd, 0x2c, 0xdd, 0x61, 0x66, 0x5d,
-	0x66, 0xc7, 0x9d, 0x8c, 0xd9, 0xa1, 0xc4, 0x87, 0x77, 0xa9, 0x25, 0x21, 0x65, 0x81, 0x99, 0x75,
-	0x99, 0x24, 0xe3, 0xb1, 0x9a, 0x4e, 0x32, 0xc9, 0xc3, 0xc4, 0x3d, 0xca, 0x1f, 0x31, 0x6e, 0x38,
-	0xf1, 0x95, 0x4c, 0xf5, 0xd1, 0x3f, 0x44, 0x70, 0x2a, 0x85, 0x28, 0xc1, 0x73, 0x38, 0xc6, 0x43,
-	0x8a, 0xde, 0x7d, 0x05, 0xdc, 0xe2, 0x95, 0xfb, 0x15, 0x59, 0x9a, 0x26, 0x33, 0x94, 0xa6, 0xa8,
-	0x2e, 0x1d, 0xe5, 0x1d, 0xda, 0xc9, 0x06, 0x82, 0xc2, 0x62, 0xd0, 0x58, 0xa4, 0x4f, 0xf9, 0xbc,
-	0x6b, 0x73, 0xdb, 0x70, 0xec, 0xef, 0x50, 0xd1, 0xdb, 0x0c, 0x76, 0xf6, 0xef, 0xc2, 0x51, 0xd5,
-	0xcd, 0xe9, 0x16, 0x75, 0x59, 0x43, 0x76, 0x7b, 0xa7, 0x36, 0x5a, 0xc5, 0x93, 0x9d, 0xdd, 0x5e,
-	0x44, 0x27, 0xda, 0xb0, 0xec, 0xf9, 0x66, 0xc3, 0x25, 0xae, 0x42, 0xde, 0x0d, 0x1a, 0xba, 0x4b,
-	0x9f, 0x86, 0x77, 0xd0, 0x18, 0x91, 0xe8, 0x4a, 0x7c, 0xd1, 0x6e, 0xec, 0xab, 0x9c, 0xdf, 0x68,
-	0x15, 0x5f, 0x89, 0x84, 0xf5, 0xde, 0x4b, 0xb4, 0x71, 0x37, 0xdd, 0x30, 0xf2, 0xe3, 0x21, 0x28,
-	0xf6, 0x34, 0xfa, 0x73, 0xdf, 0x7a, 0x4d, 0xfd, 0x3b, 0x0f, 0xfb, 0x1f, 0x84, 0x15, 0x0d, 0xff,
-	0x1c, 0x81, 0x18, 0x32, 0xf9, 0xf8, 0x4a, 0xe6, 0x53, 0xd3, 0x9e, 0x91, 0xe5, 0xaf, 0xf6, 0xc7,
-	0x14, 0x79, 0x9e, 0x5c, 0x7d, 0xef, 0x8f, 0x7f, 0xfb, 0xd1, 0x50, 0x09, 0x5f, 0x2c, 0x67, 0x9d,
-	0x17, 0x87, 0x00, 0x7f, 0x81, 0xe0, 0x40, 0x34, 0x66, 0xc2, 0x99, 0xd5, 0x26, 0xa7, 0x5c, 0xf9,
-	0x6b, 0x7d, 0x72, 0x49, 0xb4, 0xd7, 0x04, 0xda, 0x32, 0xbe, 0x94, 0x15, 0x6d, 0x84, 0xf1, 0x23,
-	0x04, 0x47, 0x3a, 0x66, 0xbb, 0xf8, 0x56, 0xd6, 0x97, 0x7c, 0xca, 0x34, 0x3b, 0x7f, 0x7b, 0x30,
-	0x66, 0x69, 0x43, 0x45, 0xd8, 0x70, 0x1b, 0xdf, 0x2c, 0xf7, 0x37, 0xa1, 0xf7, 0xcb, 0xef, 0xc8,
-	0xea, 0xfc, 0x0c, 0x7f, 0x86, 0xe0, 0x64, 0x6a, 0x77, 0x8b, 0x67, 0xfa, 0x6d, 0x61, 0x53, 0x3a,
-	0xed, 0xfc, 0xec, 0xce, 0x84, 0x48, 0x43, 0xef, 0x09, 0x43, 0xa7, 0xf1, 0xdd, 0x8c, 0x86, 0xb6,
-	0x2b, 0x80, 0x1a, 0x92, 0xe9, 0x9e, 0xb0, 0xe9, 0x3f, 0xc9, 0x71, 0x60, 0xe7, 0xf0, 0x06, 0xbf,
-	0xd1, 0x2f, 0xd4, 0xd4, 0xf1, 0x5a, 0x7e, 0x6e, 0xa7, 0x62, 0xa4, 0xcd, 0xf3, 0xc2, 0xe6, 0x19,
-	0x3c, 0xdd, 0xb7, 0xcd, 0xae, 0x18, 0x03, 0xb4, 0xef, 0xcf, 0xf8, 0x5f, 0x08, 0xc6, 0xd2, 0xbb,
-	0x74, 0x9c, 0x35, 0x3e, 0x5b, 0xce, 0x0f, 0xf2, 0x6f, 0xec, 0x50, 0xca, 0x80, 0x61, 0xee, 0x35,
-	0x0e, 0xc0, 0x7f, 0x45, 0x30, 0x92, 0xd2, 0x9e, 0xe3, 0xe9, 0x7e, 0x71, 0x6e, 0x1a, 0x19, 0xe4,
-	0x2b, 0x3b, 0x11, 0x21, 0xed, 0x9c, 0x11, 0x76, 0xde, 0xc1, 0xb7, 0xfa, 0xb6, 0xb3, 0xdd, 0x92,
-	0xe3, 0xdf, 0x23, 0x18, 0x4e, 0xfe, 0xa2, 0x82, 0x6f, 0xf6, 0x79, 0x41, 0x4a, 0xfc, 0xac, 0x93,
-	0xbf, 0x35, 0x10, 0xaf, 0x34, 0xe7, 0x8e, 0x30, 0xe7, 0x3a, 0xbe, 0xd6, 0x67, 0x19, 0xd2, 0xab,
-	0xeb, 0xba, 0x6d, 0xe1, 0xbf, 0x23, 0x18, 0x4b, 0xef, 0xfb, 0x33, 0x67, 0xe7, 0x96, 0x53, 0x88,
-	0xcc, 0xd9, 0xb9, 0xf5, 0xf0, 0x81, 0x4c, 0x0b, 0x33, 0x6f, 0xe1, 0x1b, 0x7d, 0xbc, 0xdf, 0x74,
-	0x23, 0x94, 0x17, 0xe7, 0xe5, 0x9f, 0x10, 0x1c, 0xef, 0xee, 0x8c, 0xf0, 0x6b, 0x83, 0xb5, 0x3d,
-	0xb1, 0x79, 0x77, 0x07, 0xe6, 0x97, 0x86, 0xbd, 0x2e, 0x0c, 0xbb, 0x89, 0xbf, 0x56, 0x1e, 0xec,
-	0x27, 0x5b, 0x1f, 0xff, 0x03, 0xc1, 0x78, 0x8f, 0x86, 0x3f, 0x73, 0x59, 0xdd, 0x7a, 0x6c, 0x91,
-	0xb9, 0xac, 0x6e, 0x33, 0x77, 0xe8, 0xfb, 0x9d, 0x29, 0x5e, 0x1e, 0x51, 0x14, 0x55, 0x0b, 0x8e,
-	0x7f, 0x3d, 0x04, 0x5f, 0xcc, 0xd2, 0x8d, 0x61, 0x2d, 0x6b, 0xb1, 0xc8, 0xde, 0x5c, 0xe6, 0x1f,
-	0xee, 0xaa, 0x4c, 0xe9, 0x15, 0x5b, 0x78, 0xc5, 0xc4, 0x46, 0xd6, 0x8a, 0x94, 0xe8, 0x1e, 0x75,
-	0xc7, 0x76, 0xeb, 0xfa, 0x8a, 0xc7, 0x1a, 0x7a, 0x92, 0xa9, 0xfc, 0x4e, 0x5a, 0x77, 0xfb, 0x0c,
-	0xff, 0x17, 0xc1, 0x58, 0x7a, 0x3f, 0x98, 0xf9, 0xb8, 0x6f, 0xd9, 0x9e, 0x66, 0x3e, 0xee, 0x5b,
-	0x37, 0xa5, 0xe4, 0x81, 0x70, 0xc9, 0x9b, 0x78, 0x3e, 0xa3, 0x4b, 0x02, 0x9f, 0x7a, 0x7a, 0xa0,
-	0xe4, 0xe9, 0x69, 0x77, 0xad, 0x4f, 0x10, 0x9c, 0xd8, 0xd4, 0x48, 0xe2, 0xac, 0xe7, 0xb7, 0x57,
-	0x7f, 0x9a, 0x7f, 0x7d, 0x70, 0x01, 0x03, 0x1e, 0x8a, 0x1a, 0xe5, 0x7a, 0x57, 0xd3, 0x2b, 0xae,
-	0x56, 0x3d, 0x9a, 0xb3, 0xcc, 0x35, 0x60, 0xeb, 0x8e, 0x36, 0x73, 0x0d, 0xd8, 0xa6, 0x47, 0xec,
-	0xfb, 0x6a, 0xd5, 0xbb, 0x59, 0xad, 0xac, 0x3e, 0x7f, 0x51, 0x40, 0x1f, 0xbf, 0x28, 0xa0, 0xbf,
-	0xbc, 0x28, 0xa0, 0x0f, 0x5e, 0x16, 0xf6, 0x7c, 0xfc, 0xb2, 0xb0, 0xe7, 0xcf, 0x2f, 0x0b, 0x7b,
-	0xbe, 0xb5, 0xb8, 0xdd, 0x6f, 0x5c, 0x8f, 0xa7, 0xa6, 0xca, 0x4f, 0x3b, 0x34, 0x5f, 0x6a, 0xab,
-	0x36, 0x1d, 0x9b, 0xba, 0x3c, 0xfa, 0xbb, 0x50, 0xf4, 0x07, 0x82, 0x03, 0xe2, 0xe3, 0xca, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x93, 0x88, 0x7a, 0x41, 0x25, 0x00, 0x00,
+	// 2674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x6c, 0x1c, 0x49,
+	0xf9, 0x4f, 0xdb, 0x79, 0xcd, 0x67, 0xc7, 0x4e, 0xca, 0xef, 0x49, 0x32, 0x93, 0xad, 0xff, 0x3f,
+	0xac, 0x45, 0x92, 0x19, 0xe2, 0x24, 0x64, 0xf3, 0x70, 0x12, 0x8f, 0x5f, 0x0c, 0x71, 0x1c, 0xa7,
+	0x93, 0x40, 0xb4, 0x07, 0x7a, 0x7b, 0xba, 0x6b, 0xc6, 0xa5, 0xe9, 0xe9, 0x1e, 0xf7, 0xc3, 0x89,
+	0x09, 0x91, 0x96, 0xdd, 0x23, 0x12, 0x2c, 0x82, 0x23, 0x42, 0x42, 0x5c, 0xd0, 0x8a, 0x23, 0x17,
+	0x40, 0x02, 0xc1, 0x01, 0x45, 0x1c, 0x96, 0x95, 0x10, 0x02, 0xed, 0x61, 0x16, 0x12, 0x0e, 0x48,
+	0x0b, 0x1c, 0x0c, 0x07, 0x8e, 0xa8, 0xab, 0xab, 0x1f, 0x33, 0xee, 0x71, 0x7a, 0x66, 0xcc, 0x01,
+	0x71, 0xf2, 0x74, 0x7f, 0xf5, 0x3d, 0x7e, 0xdf, 0x57, 0xf5, 0x55, 0xd5, 0xaf, 0x0d, 0xe7, 0x0d,
+	0xab, 0x66, 0x58, 0xd4, 0xca, 0x2b, 0x86, 0xae, 0x10, 0xdd, 0x36, 0x65, 0x9b, 0xa8, 0x1a, 0xdd,
+	0x70, 0xa8, 0x4a, 0xed, 0xad, 0xfc, 0xe6, 0xf9, 0x12, 0xb1, 0xe5, 0xf3, 0xf9, 0x0d, 0x87, 0x98,
+	0x5b, 0xb9, 0xba, 0x69, 0xd8, 0x06, 0x3a, 0xcd, 0x55, 0x72, 0xb1, 0x2a, 0x39, 0xae, 0x92, 0x1e,
+	0xad, 0x18, 0x15, 0x83, 0x69, 0xe4, 0xdd, 0x5f, 0x9e, 0x72, 0xfa, 0xd3, 0xbb, 0xfb, 0xab, 0xcb,
+	0xa6, 0x5c, 0xb3, 0xf8, 0xd8, 0x8b, 0xc9, 0x62, 0xb3, 0xa9, 0x52, 0x2d, 0xea, 0x65, 0xdf, 0x43,
+	0x46, 0x61, 0x6a, 0xf9, 0x92, 0x6c, 0x91, 0x60, 0x8c, 0x62, 0x50, 0xdd, 0x8f, 0x20, 0x2a, 0x67,
+	0xb8, 0x82, 0x51, 0x75, 0xb9, 0x42, 0x75, 0xd9, 0xa6, 0x86, 0x3f, 0xf6, 0x44, 0xc5, 0x30, 0x2a,
+	0x1a, 0xc9, 0xcb, 0x75, 0x9a, 0x97, 0x75, 0xdd, 0xb0, 0x99, 0xd0, 0x8f, 0x6f, 0x8a, 0x4b, 0xd9,
+	0x53, 0xc9, 0x29, 0xe7, 0x65, 0x7d, 0xcb, 0x17, 0x79, 0x4e, 0x24, 0x0f, 0xbf, 0xf7, 0xc0, 0x45,
+	0xd9, 0x56, 0x2d, 0x9b, 0xd6, 0x88, 0x65, 0xcb, 0xb5, 0xba, 0x0f, 0xa0, 0x75, 0x80, 0xea, 0x98,
+	0xd1, 0xa0, 0x12, 0xa6, 0xa5, 0x6e, 0x58, 0x34, 0xa2, 0x75, 0x3d, 0x99, 0x16, 0x65, 0x42, 0xba,
+	0x49, 0x24, 0x93, 0x28, 0x86, 0xa9, 0x72, 0xed, 0xcb, 0xc9, 0xb4, 0x35, 0x5a, 0xa3, 0xb6, 0x64,
+	0x98, 0x2a, 0x31, 0xb9, 0xe2, 0x5c, 0x32, 0x45, 0x75, 0x4b, 0x97, 0x6b, 0x54, 0x91, 0xac, 0xba,
+	0x49, 0x64, 0x55, 0x2a, 0xcb, 0x8a, 0x6d, 0x70, 0x13, 0xf8, 0xc7, 0x02, 0x8c, 0x3e, 0xb4, 0x88,
+	0xb9, 0xc6, 0x01, 0x59, 0x22, 0xd9, 0x70, 0x88, 0x65, 0xa3, 0xb3, 0x70, 0x48, 0x56, 0x55, 0x93,
+	0x58, 0xd6, 0xa4, 0x70, 0x4a, 0x98, 0x4e, 0x15, 0xd0, 0x76, 0x23, 0x3b, 0xb4, 0x25, 0xd7, 0xb4,
+	0xab, 0x98, 0x0b, 0xb0, 0xe8, 0x0f, 0x41, 0x67, 0xe0, 0x50, 0xdd, 0x30, 0x34, 0x89, 0xaa, 0x93,
+	0x7d, 0xa7, 0x84, 0xe9, 0xfd, 0xd1, 0xd1, 0x5c, 0x80, 0xc5, 0x83, 0xee, 0xaf, 0xa2, 0x8a, 0x96,
+	0x00, 0xc2, 0xc9, 0x30, 0xd9, 0x7f, 0x4a, 0x98, 0x1e, 0x98, 0xf9, 0x54, 0x8e, 0xd7, 0xd1, 0x9d,
+	0x39, 0x39, 0x6f, 0x45, 0xf0, 0xf8, 0x73, 0x6b, 0x72, 0x85, 0xf0, 0xb0, 0xc4, 0x88, 0x26, 0xfe,
+	0xa5, 0x00, 0x63, 0x2d, 0xb1, 0x5b, 0x75, 0x43, 0xb7, 0x08, 0x7a, 0x0b, 0x52, 0x7e, 0x85, 0xdc,
+	0xf0, 0xfb, 0xa7, 0x07, 0x66, 0xae, 0xe7, 0x12, 0xad, 0xac, 0xdc, 0x92, 0xa3, 0x69, 0xbe, 0xc1,
+	0x82, 0x49, 0xe4, 0xaa, 0x6a, 0x3c, 0xd6, 0x0b, 0xfb, 0x9f, 0x37, 0xb2, 0xfb, 0xc4, 0xd0, 0x28,
+	0x5a, 0x6e, 0xc2, 0xd0, 0xc7, 0x30, 0xbc, 0xfe, 0x4a, 0x0c, 0x5e, 0x78, 0x4d, 0x20, 0x56, 0x61,
+	0x24, 0x70, 0xb7, 0x55, 0x54, 0xfd, 0xf4, 0x5f, 0x86, 0x01, 0xdf, 0x99, 0x9b, 0x54, 0x81, 0x25,
+	0x75, 0x7c, 0xbb, 0x91, 0x45, 0x7e, 0x52, 0x03, 0x21, 0x16, 0xc1, 0x7f, 0x2a, 0xaa, 0x78, 0x13,
+	0x46, 0x9b, 0xed, 0xf1, 0x94, 0x7c, 0x09, 0x0e, 0xfb, 0xa3, 0x98, 0xb5, 0xbd, 0xc9, 0x48, 0x60,
+	0x13, 0x7f, 0x01, 0x06, 0xd7, 0x0c, 0x43, 0x0b, 0xe6, 0xcf, 0x52, 0x4c, 0x82, 0xba, 0x29, 0xf2,
+	0x37, 0x04, 0x38, 0xc2, 0x0d, 0x73, 0x24, 0x97, 0xe0, 0x80, 0x3b, 0x91, 0xfc, 0xc2, 0x8e, 0xe6,
+	0xbc, 0x25, 0x9d, 0xf3, 0x97, 0x74, 0x6e, 0x4e, 0xdf, 0x2a, 0xa4, 0x7e, 0xfd, 0xa3, 0x73, 0x07,
+	0x5c, 0xbd, 0xa2, 0xe8, 0x8d, 0xde, 0xbb, 0x8a, 0x0d, 0xc3, 0x91, 0x35, 0xd6, 0x49, 0x79, 0xb8,
+	0xf8, 0x21, 0x0c, 0xf9, 0x2f, 0x78, 0x88, 0xf3, 0x70, 0xd0, 0x6b, 0xb6, 0x3c, 0xd5, 0xa7, 0x5f,
+	0x91, 0x6a, 0x4f, 0x9d, 0xe7, 0x94, 0xab, 0xe2, 0xf7, 0x05, 0x38, 0xfa, 0x80, 0x2a, 0xd5, 0x15,
+	0x7f, 0xd8, 0x2a, 0xb1, 0xd1, 0x5b, 0x70, 0x24, 0x50, 0x93, 0x74, 0x62, 0xf3, 0xc5, 0x79, 0xcd,
+	0xd5, 0xfc, 0xa8, 0x91, 0x3d, 0xee, 0xe1, 0xb1, 0xd4, 0x6a, 0x8e, 0x1a, 0xf9, 0x9a, 0x6c, 0xaf,
+	0xe7, 0x56, 0x48, 0x45, 0x56, 0xb6, 0x16, 0x88, 0xb2, 0xdd, 0xc8, 0x8e, 0x7a, 0x93, 0xa7, 0xc9,
+	0x02, 0x16, 0x07, 0xb5, 0xa8, 0x87, 0x8b, 0x00, 0x6e, 0xd3, 0x97, 0xa8, 0xae, 0x92, 0x27, 0x2c,
+	0x4f, 0xfd, 0x85, 0xb1, 0xed, 0x46, 0xf6, 0x98, 0xa7, 0x1b, 0xca, 0xb0, 0x98, 0xf2, 0x76, 0x07,
+	0xf7, 0xf7, 0xdf, 0x04, 0x98, 0x08, 0x02, 0x5d, 0x20, 0x75, 0x7b, 0xfd, 0x8b, 0xd4, 0x5e, 0x17,
+	0x65, 0xbd, 0x42, 0x50, 0x19, 0x8e, 0x86, 0x1e, 0xe5, 0x9a, 0xe1, 0xe8, 0x7b, 0x12, 0xf6, 0x70,
+	0xf0, 0x3c, 0xc7, 0x6c, 0xba, 0x91, 0x6b, 0xc6, 0x63, 0x62, 0x4a, 0x6e, 0x58, 0x3b, 0x23, 0x0f,
+	0x65, 0x58, 0x4c, 0xb1, 0x07, 0x37, 0xbb, 0xae, 0x96, 0x53, 0xaf, 0xfb, 0x5a, 0xfd, 0xad, 0x5a,
+	0xa1, 0x0c, 0x8b, 0x29, 0xf6, 0xe0, 0x6a, 0xe1, 0x8f, 0xfb, 0x20, 0x13, 0x2d, 0x4c, 0x51, 0x5f,
+	0xa0, 0x26, 0x51, 0xdc, 0x09, 0xe2, 0xaf, 0x80, 0x48, 0x4f, 0x14, 0x5e, 0xd9, 0x13, 0x73, 0x70,
+	0xd8, 0x36, 0xaa, 0x44, 0x97, 0xa8, 0x37, 0x37, 0x53, 0x85, 0x91, 0xed, 0x46, 0x76, 0x98, 0xe7,
+	0x9c, 0x4b, 0xb0, 0x78, 0x88, 0xfd, 0x2c, 0xea, 0x6e, 0xd4, 0x96, 0x2d, 0x9b, 0x76, 0x9b, 0xa8,
+	0x43, 0x19, 0x16, 0x53, 0xec, 0x81, 0x61, 0xbd, 0x02, 0x83, 0x8e, 0x45, 0x24, 0xc5, 0xe1, 0x68,
+	0xf7, 0x9f, 0x12, 0xa6, 0x0f, 0x17, 0x26, 0xb6, 0x1b, 0xd9, 0x11, 0x8e, 0x36, 0x22, 0xc5, 0x22,
+	0x38, 0x16, 0x99, 0x77, 0x82, 0x34, 0x95, 0x0c, 0x47, 0x57, 0x3d, 0xc5, 0x03, 0xad, 0x0e, 0x43,
+	0x19, 0x16, 0x53, 0xec, 0x21, 0xea, 0x50, 0x37, 0x24, 0xf6, 0x6e, 0xf2, 0x60, 0x9c, 0x43, 0x5f,
+	0xea, 0x39, 0x5c, 0x35, 0x0a, 0xec, 0xe1, 0x7b, 0xfd, 0x90, 0x6d, 0x9b, 0x61, 0xbe, 0xce, 0xd6,
+	0xa3, 0x33, 0x4b, 0x75, 0x67, 0x9d, 0xdf, 0x15, 0x2e, 0x27, 0x6c, 0x6e, 0xad, 0x0b, 0x8c, 0xaf,
+	0xc1, 0x61, 0xad, 0x69, 0x2e, 0x5b, 0xe8, 0x35, 0x18, 0x54, 0x1c, 0xd3, 0x24, 0xba, 0x1d, 0x99,
+	0x5d, 0xe2, 0x00, 0x7f, 0xc7, 0xb0, 0x6a, 0x70, 0xcc, 0x1f, 0x12, 0x68, 0xb3, 0xca, 0xa4, 0x0a,
+	0x37, 0x93, 0xcd, 0xf3, 0x49, 0x2f, 0x27, 0x3b, 0xac, 0x60, 0xf1, 0x28, 0x7f, 0x17, 0x84, 0x8a,
+	0xde, 0x11, 0x00, 0xf9, 0x03, 0xad, 0x0d, 0xd3, 0x96, 0xea, 0x26, 0x55, 0x08, 0xab, 0x68, 0xaa,
+	0xf0, 0x80, 0xfb, 0xcb, 0x57, 0xa8, 0xbd, 0xee, 0x94, 0x72, 0x8a, 0x51, 0xcb, 0xf3, 0x7c, 0x9c,
+	0xd3, 0xe4, 0x92, 0xe5, 0x3f, 0xb0, 0xbf, 0x2c, 0x8c, 0x02, 0xad, 0x78, 0x31, 0x4c, 0x35, 0xc7,
+	0x10, 0x9a, 0x0e, 0x83, 0xb8, 0xbf, 0x61, 0xda, 0x6b, 0xec, 0xd5, 0x6d, 0x38, 0x11, 0x44, 0xb4,
+	0xe6, 0xad, 0x0c, 0xb6, 0xe4, 0xbb, 0x59, 0x02, 0xf8, 0xe7, 0x02, 0x9c, 0x6c, 0x63, 0x8d, 0x97,
+	0xbb, 0x04, 0xa9, 0x30, 0xb3, 0x5e, 0x9d, 0x6f, 0x24, 0xac, 0x73, 0x9b, 0xde, 0xe4, 0x6f, 0xec,
+	0x81, 0x02, 0xba, 0x0a, 0x83, 0x25, 0x47, 0xa9, 0x12, 0xbb, 0xa9, 0x01, 0x46, 0x66, 0x6c, 0x54,
+	0x8a, 0xc5, 0x01, 0xef, 0xd1, 0x6b, 0x82, 0x8f, 0xe0, 0xe4, 0xbc, 0x26, 0xd3, 0x9a, 0x5c, 0xd2,
+	0xc8, 0x7d, 0x76, 0xd8, 0x12, 0xc9, 0x63, 0xd9, 0x54, 0xad, 0x9e, 0x77, 0xf5, 0xef, 0x0a, 0x90,
+	0x69, 0x67, 0x9a, 0x27, 0xe7, 0x2b, 0x30, 0xa9, 0xf8, 0x23, 0xfc, 0xa3, 0x9e, 0xe9, 0x8d, 0xe1,
+	0xb9, 0x9a, 0x6a, 0xda, 0xed, 0xfc, 0xcc, 0xcc, 0x1b, 0x54, 0x2f, 0xbc, 0xee, 0xa6, 0x61, 0xbb,
+	0x91, 0xcd, 0xf2, 0xea, 0xb7, 0x31, 0x84, 0xc5, 0x71, 0x25, 0x36, 0x0a, 0xfc, 0x10, 0xd2, 0x41,
+	0x7c, 0x45, 0xff, 0x98, 0xdb, 0x3b, 0xee, 0x77, 0xfb, 0xe0, 0x78, 0xac, 0x5d, 0x0e, 0x7a, 0x03,
+	0x46, 0xc3, 0x58, 0x83, 0xe3, 0x75, 0x02, 0xc0, 0xff, 0xc7, 0x01, 0x1f, 0x6f, 0x05, 0x1c, 0x1a,
+	0xc1, 0xe2, 0x88, 0xb2, 0xd3, 0xb5, 0xeb, 0xb2, 0x6c, 0x98, 0x65, 0x42, 0x6d, 0xa2, 0x46, 0x5d,
+	0xf6, 0x75, 0xe8, 0x32, 0xce, 0x08, 0x16, 0x47, 0x82, 0xd7, 0xa1, 0x4b, 0xbc, 0x02, 0x27, 0xdd,
+	0xa3, 0xcc, 0x9c, 0xa2, 0x38, 0x35, 0x47, 0x93, 0x6d, 0xc3, 0x6c, 0x99, 0x57, 0x1d, 0xad, 0xb3,
+	0x5f, 0xf4, 0x41, 0xa6, 0x9d, 0x39, 0x9e, 0xd6, 0xf7, 0x04, 0x38, 0xde, 0x54, 0x79, 0xa9, 0x62,
+	0x1a, 0x8f, 0xed, 0x75, 0xa9, 0xa2, 0x19, 0x25, 0x59, 0xe3, 0xe9, 0x3d, 0x11, 0x8b, 0x75, 0x81,
+	0x28, 0x0c, 0xee, 0x05, 0x17, 0xee, 0xfb, 0x1f, 0x67, 0xcf, 0x44, 0x7a, 0x90, 0x37, 0x9e, 0xff,
+	0x39, 0x67, 0xa9, 0xd5, 0xbc, 0xbd, 0x55, 0x27, 0x96, 0xaf, 0x63, 0x89, 0x93, 0x56, 0x64, 0x56,
+	0x2d, 0x33, 0x9f, 0xcb, 0xcc, 0x25, 0xfa, 0x9a, 0x00, 0xa3, 0x4e, 0xdd, 0xa6, 0x35, 0xd2, 0x12,
+	0x8b, 0x97, 0xf7, 0x8b, 0x09, 0xfb, 0xc0, 0x43, 0x66, 0xe2, 0x81, 0x29, 0x2b, 0x55, 0x62, 0xb6,
+	0x96, 0x24, 0xce, 0x3e, 0x16, 0x91, 0xf7, 0x3a, 0x1a, 0x0d, 0x7e, 0x57, 0x80, 0x8c, 0xdb, 0x9f,
+	0x22, 0x39, 0xe4, 0x36, 0xbb, 0xaa, 0x49, 0x97, 0x87, 0xae, 0x4f, 0xfa, 0x20, 0xdb, 0x36, 0x0a,
+	0x5e, 0xca, 0xe7, 0x02, 0x5c, 0x89, 0x2d, 0xa5, 0x51, 0x67, 0xeb, 0x8c, 0x48, 0xaa, 0xbf, 0xad,
+	0x4a, 0x46, 0x59, 0xd2, 0x64, 0xcb, 0x96, 0x6c, 0x53, 0xde, 0x24, 0xa6, 0xf5, 0x9f, 0x2c, 0xf4,
+	0xcc, 0xce, 0x42, 0xdf, 0xe5, 0x01, 0x05, 0xdb, 0xfc, 0xdd, 0xf2, 0x8a, 0x6c, 0xd9, 0x0f, 0xfc,
+	0x60, 0xd0, 0x33, 0x18, 0xe6, 0x15, 0xb2, 0x39, 0xca, 0x9e, 0x8a, 0x9f, 0xe1, 0xc5, 0x1f, 0x6f,
+	0x2a, 0xbe, 0x6f, 0x1a, 0x8b, 0x43, 0x4e, 0x74, 0xb8, 0x85, 0xbf, 0x2e, 0xc0, 0x44, 0xb0, 0x28,
+	0x45, 0x76, 0x81, 0xef, 0xae, 0xd8, 0x7b, 0x75, 0x35, 0xfa, 0x40, 0x80, 0xc9, 0x9d, 0x01, 0xf1,
+	0xba, 0x53, 0x38, 0xd6, 0x4a, 0x37, 0xf8, 0x6d, 0xf1, 0xb3, 0x09, 0xd3, 0xd5, 0x62, 0x9b, 0xef,
+	0x95, 0x47, 0x69, 0x8b, 0xcb, 0xbd, 0xbb, 0x59, 0xbd, 0x2d, 0xc0, 0x99, 0xf9, 0xa5, 0x3b, 0x77,
+	0xd8, 0xbd, 0x4d, 0x5d, 0xa1, 0x7a, 0x75, 0xc9, 0x34, 0x6a, 0xf3, 0x91, 0x20, 0x3d, 0x89, 0x9f,
+	0xf5, 0x7b, 0x30, 0x1a, 0x45, 0x20, 0x35, 0x97, 0x20, 0x1b, 0x69, 0xef, 0x31, 0xa3, 0xb0, 0x88,
+	0x94, 0x1d, 0x96, 0x31, 0x85, 0xb3, 0xc9, 0x22, 0xe0, 0x69, 0xbe, 0x02, 0x83, 0x4a, 0xb9, 0x56,
+	0x6b, 0x71, 0x1d, 0x39, 0x2e, 0x44, 0xa5, 0x58, 0x04, 0xf7, 0x91, 0xbb, 0xba, 0x03, 0x27, 0x5d,
+	0xf6, 0xe2, 0xa1, 0x5e, 0x32, 0x74, 0x95, 0xea, 0x95, 0xde, 0x28, 0x18, 0xfc, 0x7d, 0x01, 0x32,
+	0xed, 0xec, 0xf1, 0x60, 0xdf, 0x16, 0x20, 0x1d, 0x50, 0x18, 0xd2, 0x63, 0x6a, 0xaf, 0x4b, 0x75,
+	0x62, 0x52, 0x43, 0x95, 0x34, 0x43, 0xa9, 0xf2, 0xd9, 0x31, 0x9b, 0x70, 0x76, 0xf8, 0xe6, 0xdd,
+	0xb3, 0xd4, 0x1a, 0xb3, 0xb2, 0x62, 0x28, 0x55, 0x3e, 0x49, 0x26, 0x02, 0x37, 0xcd, 0x62, 0x9c,
+	0x86, 0xc9, 0x65, 0x62, 0x3f, 0x30, 0x6c, 0x59, 0x0b, 0x8e, 0x64, 0xfe, 0x3d, 0xfa, 0x9b, 0x02,
+	0x4c, 0xc5, 0x08, 0x79, 0xf0, 0x36, 0x0c, 0xdb, 0xae, 0x44, 0x6a, 0x3d, 0x02, 0xee, 0xb2, 0xe5,
+	0x7e, 0x86, 0xb7, 0xa6, 0xe9, 0x04, 0xad, 0xc9, 0xeb, 0x4b, 0x43, 0x76, 0x93, 0x77, 0xbc, 0x2d,
+	0x40, 0x66, 0xd5, 0xa9, 0xad, 0x92, 0x27, 0x76, 0x51, 0xa7, 0x36, 0x95, 0x35, 0xfa, 0x65, 0xc2,
+	0xee, 0x36, 0xdd, 0xad, 0xfd, 0x9b, 0x30, 0xe4, 0xdf, 0xe6, 0x24, 0x95, 0xe8, 0x46, 0x8d, 0xdf,
+	0xf6, 0xa6, 0xb6, 0x1b, 0xd9, 0xb1, 0xe6, 0xdb, 0x9e, 0x27, 0xc7, 0xe2, 0x20, 0xbf, 0xf3, 0x2d,
+	0xb8, 0x8f, 0xa8, 0x04, 0x69, 0xdd, 0xa9, 0x49, 0x3a, 0x79, 0xe2, 0x9e, 0x41, 0x83, 0x88, 0xd8,
+	0xad, 0xc4, 0x62, 0xd7, 0x8d, 0xfd, 0x85, 0xd3, 0xdb, 0x8d, 0xec, 0x6b, 0x9e, 0xb1, 0xf6, 0x63,
+	0xb1, 0x38, 0xa1, 0xc7, 0x03, 0xc3, 0xdf, 0xe9, 0x83, 0x6c, 0x5b, 0xd0, 0xff, 0xf3, 0x57, 0x2f,
+	0xbc, 0x0c, 0x63, 0x2b, 0xb4, 0x46, 0xed, 0xbb, 0x2e, 0x15, 0x1b, 0x25, 0xed, 0x72, 0x70, 0x98,
+	0xd1, 0xb3, 0xe1, 0x54, 0x88, 0x5c, 0xe2, 0x7d, 0x09, 0x16, 0x0f, 0xb1, 0x9f, 0x45, 0x15, 0x9b,
+	0x30, 0xde, 0x6a, 0x88, 0x67, 0xf7, 0x11, 0x0c, 0x44, 0xe8, 0x5e, 0xce, 0x22, 0x9d, 0x4f, 0x7c,
+	0xd7, 0x09, 0x6c, 0x7a, 0x29, 0x05, 0x2d, 0x78, 0x83, 0x7f, 0x2a, 0xc0, 0xb8, 0xdb, 0x26, 0xc2,
+	0x41, 0xff, 0x4d, 0x94, 0xef, 0xcf, 0x04, 0x98, 0xd8, 0x11, 0x3d, 0xcf, 0xd9, 0x9b, 0x30, 0x18,
+	0xc9, 0x99, 0x3f, 0x1b, 0xbb, 0x4e, 0xda, 0x40, 0x98, 0xb4, 0x3d, 0xdc, 0xe2, 0x6e, 0xc3, 0x89,
+	0xc5, 0x72, 0x99, 0x28, 0xee, 0xfe, 0xe9, 0xdd, 0xa0, 0x96, 0x18, 0x1d, 0xdf, 0xd5, 0x49, 0xbe,
+	0xd1, 0x0f, 0x27, 0xdb, 0x58, 0x0b, 0x88, 0xf0, 0x23, 0x4d, 0xac, 0x7f, 0x57, 0xbc, 0x5b, 0x93,
+	0x05, 0x2c, 0x0e, 0x5a, 0x11, 0x4f, 0xe8, 0x19, 0x4c, 0x10, 0x3f, 0x84, 0xe6, 0x2f, 0x0c, 0xbc,
+	0xb3, 0x2d, 0x26, 0xf3, 0x95, 0xf1, 0x7c, 0xb5, 0xb1, 0x85, 0xc5, 0x31, 0x12, 0x07, 0x14, 0x3d,
+	0x02, 0xd8, 0x34, 0x34, 0xd9, 0xa6, 0x5a, 0xb8, 0xe4, 0xdf, 0x48, 0xe6, 0x91, 0x9f, 0xad, 0x43,
+	0x75, 0x2c, 0x46, 0x6c, 0xa1, 0xaf, 0x0a, 0x70, 0x22, 0xf6, 0xcb, 0x89, 0xa4, 0x18, 0x7a, 0x99,
+	0x56, 0x18, 0xd5, 0x32, 0x30, 0x73, 0x2b, 0xe1, 0xfc, 0x5a, 0xf0, 0x4c, 0x45, 0x83, 0x9f, 0x67,
+	0x76, 0xc4, 0x29, 0xb5, 0x9d, 0x68, 0xe6, 0xdb, 0xa7, 0xe0, 0xc0, 0x3d, 0x77, 0x62, 0xa1, 0x1f,
+	0x08, 0xc0, 0xe8, 0x6c, 0x0b, 0x5d, 0x48, 0xbc, 0x3f, 0x87, 0x6c, 0x7c, 0xfa, 0x62, 0x67, 0x4a,
+	0xde, 0xec, 0xc1, 0x17, 0xdf, 0xf9, 0xed, 0x9f, 0xbf, 0xd5, 0x97, 0x43, 0x67, 0xf3, 0x49, 0xbf,
+	0x8a, 0xb9, 0x01, 0xfe, 0x50, 0x80, 0x83, 0x1e, 0xa1, 0x8d, 0x12, 0xbb, 0x8d, 0xf2, 0xe9, 0xe9,
+	0x4b, 0x1d, 0x6a, 0xf1, 0x68, 0x2f, 0xb1, 0x68, 0xf3, 0xe8, 0x5c, 0xd2, 0x68, 0xbd, 0x18, 0x3f,
+	0x10, 0xe0, 0x48, 0xd3, 0x57, 0x24, 0x74, 0x2d, 0xe9, 0x75, 0x22, 0xe6, 0xbb, 0x59, 0xfa, 0x7a,
+	0x77, 0xca, 0x1c, 0x43, 0x81, 0x61, 0xb8, 0x8e, 0xae, 0xe6, 0x3b, 0xfb, 0x0e, 0x69, 0xe5, 0x9f,
+	0xf2, 0xbe, 0xfc, 0x0c, 0x7d, 0x22, 0xb8, 0xfb, 0x53, 0x0c, 0x8f, 0x86, 0xe6, 0x3b, 0x25, 0xcb,
+	0x62, 0x38, 0xbd, 0xf4, 0x42, 0x6f, 0x46, 0x38, 0xd0, 0x65, 0x06, 0x74, 0x0e, 0xdd, 0xcc, 0x27,
+	0xfd, 0xf8, 0xc9, 0xdf, 0x48, 0x3e, 0x1d, 0x2f, 0x99, 0x0c, 0xd3, 0x3f, 0xa2, 0x1f, 0x1e, 0x9a,
+	0x69, 0x62, 0xb4, 0xd8, 0x69, 0xa8, 0xb1, 0x44, 0x7e, 0x7a, 0xa9, 0x57, 0x33, 0x1c, 0x73, 0x91,
+	0x61, 0x9e, 0x47, 0x73, 0x1d, 0x63, 0xd6, 0x19, 0xe1, 0x18, 0xde, 0xd4, 0xd1, 0xdf, 0x05, 0x18,
+	0x8f, 0xe7, 0x03, 0x51, 0xd2, 0xfa, 0xec, 0xca, 0x54, 0xa6, 0x17, 0x7b, 0xb4, 0xd2, 0x65, 0x99,
+	0xdb, 0x11, 0x8f, 0xe8, 0x4f, 0x02, 0x8c, 0xc4, 0x10, 0x81, 0x68, 0xae, 0xd3, 0x38, 0x77, 0x90,
+	0x93, 0xe9, 0x42, 0x2f, 0x26, 0x38, 0xce, 0x79, 0x86, 0x73, 0x16, 0x5d, 0xeb, 0x18, 0x67, 0x48,
+	0xfe, 0xa1, 0x5f, 0x09, 0xee, 0x37, 0xd4, 0xf0, 0xdb, 0x2d, 0xba, 0xda, 0xe1, 0x55, 0x2c, 0x72,
+	0x16, 0x4d, 0x5f, 0xeb, 0x4a, 0x97, 0xc3, 0x99, 0x65, 0x70, 0x2e, 0xa3, 0x4b, 0x1d, 0xb6, 0x21,
+	0xa9, 0xb4, 0x25, 0x51, 0x15, 0xfd, 0x45, 0x80, 0xf1, 0x78, 0x86, 0x31, 0xf1, 0xec, 0xdc, 0x95,
+	0xef, 0x4c, 0x2f, 0xf6, 0x68, 0x85, 0xc3, 0x9c, 0x63, 0x30, 0xaf, 0xa1, 0x2b, 0x1d, 0xec, 0x6f,
+	0x92, 0xec, 0xda, 0x0b, 0xe6, 0xe5, 0xef, 0x04, 0x38, 0xda, 0xca, 0xc1, 0xa0, 0x1b, 0xdd, 0x11,
+	0x2c, 0x01, 0xbc, 0x9b, 0x5d, 0xeb, 0x73, 0x60, 0xb7, 0x18, 0xb0, 0xab, 0xe8, 0x8d, 0x7c, 0x77,
+	0xff, 0x98, 0x62, 0xa1, 0xbf, 0x0a, 0x30, 0xd1, 0x86, 0x5a, 0x4c, 0xdc, 0x56, 0x77, 0x27, 0x48,
+	0xd3, 0x4b, 0xbd, 0x9a, 0xe9, 0x72, 0xcf, 0x64, 0x9b, 0x87, 0x57, 0x45, 0x9f, 0xec, 0x43, 0x3f,
+	0xe9, 0x83, 0xff, 0x4f, 0xc2, 0xfb, 0x20, 0x31, 0x69, 0xb3, 0x48, 0x4e, 0x63, 0xa5, 0xef, 0xef,
+	0xa9, 0x4d, 0x9e, 0x15, 0xca, 0xb2, 0xa2, 0x20, 0x39, 0x69, 0x47, 0x8a, 0xf0, 0x54, 0x92, 0x46,
+	0xf5, 0xaa, 0x54, 0x36, 0x8d, 0x9a, 0x14, 0x55, 0xca, 0x3f, 0x8d, 0xe3, 0xd1, 0x9e, 0xa1, 0x7f,
+	0xf1, 0x2b, 0xe5, 0x4e, 0xe6, 0x29, 0xf1, 0x72, 0xdf, 0x95, 0x08, 0x4b, 0x2f, 0xf6, 0x68, 0x85,
+	0xa7, 0xe4, 0x1e, 0x4b, 0xc9, 0x6d, 0x54, 0x4c, 0x98, 0x12, 0xc7, 0x22, 0xa6, 0xe4, 0xf8, 0xf6,
+	0xa4, 0xb8, 0xb3, 0xd6, 0x47, 0x02, 0x1c, 0xdb, 0x41, 0x59, 0xa1, 0xa4, 0xeb, 0xb7, 0x1d, 0x13,
+	0x96, 0xbe, 0xd5, 0xbd, 0x81, 0x2e, 0x17, 0x45, 0x85, 0xd8, 0x52, 0x0b, 0xbd, 0xc6, 0x8e, 0x56,
+	0x6d, 0x68, 0xa0, 0xc4, 0x3d, 0x60, 0x77, 0xee, 0x2c, 0xbd, 0xd4, 0xab, 0x99, 0x2e, 0x8f, 0x56,
+	0xed, 0x69, 0x31, 0xf4, 0x1b, 0x01, 0x86, 0x9a, 0x59, 0x19, 0x74, 0xbd, 0x73, 0x0e, 0x21, 0xb2,
+	0x13, 0xcf, 0x76, 0xa9, 0xdd, 0x65, 0x2f, 0x8f, 0x70, 0x20, 0x7c, 0x3b, 0xfe, 0xbd, 0x00, 0xc3,
+	0x2d, 0xa4, 0x09, 0x9a, 0xed, 0x60, 0x49, 0xed, 0xa4, 0x8a, 0xd2, 0x37, 0xba, 0x55, 0xe7, 0xa0,
+	0x16, 0x19, 0xa8, 0x9b, 0x68, 0xb6, 0x73, 0x50, 0xd1, 0xe5, 0xf7, 0x4f, 0x01, 0xc6, 0x62, 0x09,
+	0x90, 0xc4, 0x57, 0x9d, 0xdd, 0xc8, 0x98, 0xf4, 0x42, 0x6f, 0x46, 0x38, 0xd6, 0x35, 0x86, 0xf5,
+	0xf3, 0xe8, 0x73, 0x09, 0xb1, 0xb6, 0xa1, 0x40, 0xf2, 0x4f, 0xfd, 0x86, 0x5b, 0x58, 0x7f, 0xfe,
+	0x22, 0x23, 0x7c, 0xf8, 0x22, 0x23, 0xfc, 0xf1, 0x45, 0x46, 0x78, 0xef, 0x65, 0x66, 0xdf, 0x87,
+	0x2f, 0x33, 0xfb, 0xfe, 0xf0, 0x32, 0xb3, 0xef, 0xcd, 0xd5, 0x57, 0xfd, 0xc3, 0xc7, 0xe6, 0xcc,
+	0x4c, 0xfe, 0x49, 0x53, 0x00, 0xe7, 0xc2, 0x08, 0x14, 0x8d, 0x12, 0xdd, 0xf6, 0xfe, 0x6f, 0xd7,
+	0xfb, 0x6f, 0xba, 0x83, 0xec, 0xcf, 0x85, 0x7f, 0x0f, 0x00, 0x11, 0x23, 0x22, 0x9d, 0xca, 0x2c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1872,6 +2201,15 @@ type QueryClient interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// LimitOrderById returns the limit order with the given id.
+	LimitOrderById(ctx context.Context, in *LimitOrderByIdRequest, opts ...grpc.CallOption) (*LimitOrderByIdResponse, error)
+	// UserLimitOrders returns the limit orders of the given address, optionally
+	// filtered by pool id.
+	UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// swaps of the given pool, which differs from the pool's spread factor if the
+	// pool is in dynamic spread factor mode.
+	EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error)
 }
 
 type queryClient struct {
@@ -2017,6 +2355,33 @@ func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextIn
 	return out, nil
 }
 
+func (c *queryClient) LimitOrderById(ctx context.Context, in *LimitOrderByIdRequest, opts ...grpc.CallOption) (*LimitOrderByIdResponse, error) {
+	out := new(LimitOrderByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserLimitOrders(ctx context.Context, in *UserLimitOrdersRequest, opts ...grpc.CallOption) (*UserLimitOrdersResponse, error) {
+	out := new(UserLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveSpreadFactor(ctx context.Context, in *EffectiveSpreadFactorRequest, opts ...grpc.CallOption) (*EffectiveSpreadFactorResponse, error) {
+	out := new(EffectiveSpreadFactorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Pools returns all concentrated liquidity pools
@@ -2059,6 +2424,15 @@ type QueryServer interface {
 	// NumNextInitializedTicks returns the provided number of next initialized
 	// ticks in the direction of swapping the token in denom.
 	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// LimitOrderById returns the limit order with the given id.
+	LimitOrderById(context.Context, *LimitOrderByIdRequest) (*LimitOrderByIdResponse, error)
+	// UserLimitOrders returns the limit orders of the given address, optionally
+	// filtered by pool id.
+	UserLimitOrders(context.Context, *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error)
+	// EffectiveSpreadFactor returns the spread factor currently charged by the
+	// swaps of the given pool, which differs from the pool's spread factor if the
+	// pool is in dynamic spread factor mode.
+	EffectiveSpreadFactor(context.Context, *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2110,6 +2484,15 @@ func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *Get
 func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
 }
+func (*UnimplementedQueryServer) LimitOrderById(ctx context.Context, req *LimitOrderByIdRequest) (*LimitOrderByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderById not implemented")
+}
+func (*UnimplementedQueryServer) UserLimitOrders(ctx context.Context, req *UserLimitOrdersRequest) (*UserLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveSpreadFactor(ctx context.Context, req *EffectiveSpreadFactorRequest) (*EffectiveSpreadFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSpreadFactor not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2385,6 +2768,60 @@ func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LimitOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LimitOrderById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderById(ctx, req.(*LimitOrderByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserLimitOrders(ctx, req.(*UserLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveSpreadFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EffectiveSpreadFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/EffectiveSpreadFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSpreadFactor(ctx, req.(*EffectiveSpreadFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2449,6 +2886,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumNextInitializedTicks",
 			Handler:    _Query_NumNextInitializedTicks_Handler,
 		},
+		{
+			MethodName: "LimitOrderById",
+			Handler:    _Query_LimitOrderById_Handler,
+		},
+		{
+			MethodName: "UserLimitOrders",
+			Handler:    _Query_UserLimitOrders_Handler,
+		},
+		{
+			MethodName: "EffectiveSpreadFactor",
+			Handler:    _Query_EffectiveSpreadFactor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
@@ -3700,17 +4149,267 @@ func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *LimitOrderByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrderByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EffectiveSpreadFactorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EffectiveSpreadFactorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSpreadFactorConfig != nil {
+		{
+			size, err := m.DynamicSpreadFactorConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EffectiveSpreadFactor.Size()
+		i -= size
+		if _, err := m.EffectiveSpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SpreadFactor.Size()
+		i -= size
+		if _, err := m.SpreadFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *UserPositionsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4215,22 +4914,115 @@ func (m *NumNextInitializedTicksResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *LimitOrderByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LimitOrderByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *UserLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *EffectiveSpreadFactorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectiveSpreadFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicSpreadFactorConfig != nil {
+		l = m.DynamicSpreadFactorConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -7369,6 +8161,672 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrderByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrderByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLimitOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, model.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveSpreadFactorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EffectiveSpreadFactorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EffectiveSpreadFactorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveSpreadFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveSpreadFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSpreadFactorConfig == nil {
+				m.DynamicSpreadFactorConfig = &types1.DynamicSpreadFactorConfig{}
+			}
+			if err := m.DynamicSpreadFactorConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,108 @@
+package concentrated_liquidity
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+// Pools in dynamic spread factor mode charge their spread factor increased by the configured volatility multiplier
+// times the volatility of their price, clamped to the configured min and max spread factors.
+//
+// The volatility is the relative deviation of the spot price of the pool from its geometric TWAP over the configured
+// window. The TWAP is read from x/twap, so it reflects the price moves over the whole window and a single block can
+// only move it by as much as its share of the window.
+
+// SetDynamicSpreadFactorConfig puts the pool of the given config in dynamic spread factor mode, or updates its config
+// if it already is.
+// Returns error if the config is invalid or if the pool does not exist.
+func (k Keeper) SetDynamicSpreadFactorConfig(ctx sdk.Context, config types.DynamicSpreadFactorConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if _, err := k.getPoolById(ctx, config.PoolId); err != nil {
+		return err
+	}
+
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorConfig(config.PoolId), &config)
+	return nil
+}
+
+// RemoveDynamicSpreadFactorConfig takes the given pool out of dynamic spread factor mode, so that it charges its spread factor again.
+// Returns error if the pool does not exist.
+func (k Keeper) RemoveDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64) error {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyDynamicSpreadFactorConfig(poolId))
+	return nil
+}
+
+// GetDynamicSpreadFactorConfig returns the dynamic spread factor config of the given pool and whether the pool is in dynamic spread factor mode.
+func (k Keeper) GetDynamicSpreadFactorConfig(ctx sdk.Context, poolId uint64) (types.DynamicSpreadFactorConfig, bool, error) {
+	config := types.DynamicSpreadFactorConfig{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyDynamicSpreadFactorConfig(poolId), &config)
+	if err != nil {
+		return types.DynamicSpreadFactorConfig{}, false, err
+	}
+	return config, found, nil
+}
+
+// GetAllDynamicSpreadFactorConfigs returns the dynamic spread factor configs of all the pools in dynamic spread factor mode.
+func (k Keeper) GetAllDynamicSpreadFactorConfigs(ctx sdk.Context) ([]types.DynamicSpreadFactorConfig, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.DynamicSpreadFactorConfigPrefix, func(bz []byte) (types.DynamicSpreadFactorConfig, error) {
+		var config types.DynamicSpreadFactorConfig
+		err := config.Unmarshal(bz)
+		return config, err
+	})
+}
+
+// GetEffectiveSpreadFactor returns the spread factor currently charged by the swaps of the given pool,
+// along with the volatility it is derived from.
+// The pool's spread factor and a zero volatility are returned if the pool is not in dynamic spread factor mode.
+// Since the pool's GetSpreadFactor returns the spread factor the pool was created with, the consumers that quote
+// or record the spread factor charged by swaps must use this method instead.
+func (k Keeper) GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (effectiveSpreadFactor osmomath.Dec, volatility osmomath.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	return k.getEffectiveSpreadFactor(ctx, pool, pool.GetSpreadFactor(ctx))
+}
+
+// getEffectiveSpreadFactor returns the spread factor charged by the swaps of the given pool given its spread factor,
+// along with the volatility it is derived from.
+// The spread factor and a zero volatility are returned as is if the pool is not in dynamic spread factor mode.
+func (k Keeper) getEffectiveSpreadFactor(ctx sdk.Context, pool types.ConcentratedPoolExtension, spreadFactor osmomath.Dec) (effectiveSpreadFactor osmomath.Dec, volatility osmomath.Dec, err error) {
+	config, found, err := k.GetDynamicSpreadFactorConfig(ctx, pool.GetId())
+	if err != nil {
+		return osmomath.Dec{}, osmomath.Dec{}, err
+	}
+	if !found {
+		return spreadFactor, osmomath.ZeroDec(), nil
+	}
+
+	volatility = k.getTwapDeviation(ctx, pool, config.VolatilityWindow)
+	effectiveSpreadFactor = config.ClampSpreadFactor(spreadFactor.Add(config.VolatilityMultiplier.Mul(volatility)))
+	return effectiveSpreadFactor, volatility, nil
+}
+
+// getTwapDeviation returns |spot price / geometric TWAP - 1| of the given pool, where the TWAP is taken over the given
+// window up to the current block.
+// Returns zero if the TWAP cannot be computed, e.g. because the pool is younger than the window,
+// so that the pool still charges its spread factor clamped to the configured range.
+func (k Keeper) getTwapDeviation(ctx sdk.Context, pool types.ConcentratedPoolExtension, window time.Duration) osmomath.Dec {
+	twapPrice, err := k.twapKeeper.GetGeometricTwapToNow(ctx, pool.GetId(), pool.GetToken0(), pool.GetToken1(), ctx.BlockTime().Add(-window))
+	if err != nil || !twapPrice.IsPositive() {
+		return osmomath.ZeroDec()
+	}
+
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	spotPrice := sqrtPrice.Mul(sqrtPrice).Dec()
+	return spotPrice.Sub(twapPrice).Abs().Quo(twapPrice)
+}
@@ -0,0 +1,145 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+// TestDynamicSpreadFactor tests that the swaps of a pool in dynamic spread factor mode charge the pool's spread factor
+// increased by the deviation of its spot price from its geometric TWAP, clamped to the configured range, and that the
+// deviation fades out as the TWAP catches up with the spot price.
+func (s *KeeperTestSuite) TestDynamicSpreadFactor() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	querier := client.Querier{Keeper: *clKeeper}
+
+	spreadFactor := osmomath.MustNewDecFromStr("0.0005")
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, spreadFactor)
+	s.SetupDefaultPosition(pool.GetId())
+
+	config := types.DynamicSpreadFactorConfig{
+		PoolId:               pool.GetId(),
+		MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+		VolatilityMultiplier: osmomath.OneDec(),
+		VolatilityWindow:     time.Hour,
+	}
+
+	swap := func(tokenIn sdk.Coin, tokenOutDenom string) {
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+	}
+	currentSqrtPrice := func() osmomath.BigDec {
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		return pool.GetCurrentSqrtPrice()
+	}
+	// twapDeviation returns the deviation of the spot price of the pool from its geometric TWAP over the window.
+	twapDeviation := func() osmomath.Dec {
+		twapPrice, err := s.App.TwapKeeper.GetGeometricTwapToNow(s.Ctx, pool.GetId(), ETH, USDC, s.Ctx.BlockTime().Add(-config.VolatilityWindow))
+		s.Require().NoError(err)
+		sqrtPrice := currentSqrtPrice()
+		return sqrtPrice.Mul(sqrtPrice).Dec().Sub(twapPrice).Abs().Quo(twapPrice)
+	}
+	// advanceTwap records the current price of the pool in its TWAP records and moves the block time forward.
+	advanceTwap := func(duration time.Duration) {
+		s.App.TwapKeeper.EndBlock(s.Ctx)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(duration))
+	}
+	requireEffectiveSpreadFactor := func(expectedSpreadFactor, expectedVolatility osmomath.Dec) {
+		res, err := querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+		s.Require().NoError(err)
+		s.Require().Equal(spreadFactor.String(), res.SpreadFactor.String())
+		s.Require().Equal(expectedSpreadFactor.String(), res.EffectiveSpreadFactor.String())
+		s.Require().Equal(expectedVolatility.String(), res.Volatility.String())
+
+		effectiveSpreadFactor, volatility, err := clKeeper.GetEffectiveSpreadFactor(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(expectedSpreadFactor.String(), effectiveSpreadFactor.String())
+		s.Require().Equal(expectedVolatility.String(), volatility.String())
+	}
+
+	// Not in dynamic spread factor mode: the pool's spread factor is charged.
+	requireEffectiveSpreadFactor(spreadFactor, osmomath.ZeroDec())
+	res, err := querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Nil(res.DynamicSpreadFactorConfig)
+
+	// Unknown pools can't be configured nor queried.
+	invalidPoolConfig := config
+	invalidPoolConfig.PoolId = pool.GetId() + 1
+	s.Require().Error(clKeeper.SetDynamicSpreadFactorConfig(s.Ctx, invalidPoolConfig))
+	_, _, err = clKeeper.GetEffectiveSpreadFactor(s.Ctx, invalidPoolConfig.PoolId)
+	s.Require().Error(err)
+
+	err = clKeeper.HandleSetDynamicSpreadFactorProposal(s.Ctx, &types.SetDynamicSpreadFactorProposal{Configs: []types.DynamicSpreadFactorConfig{config}})
+	s.Require().NoError(err)
+
+	// The pool is younger than the window, so it has no TWAP yet: the pool's spread factor is clamped to the min.
+	requireEffectiveSpreadFactor(config.MinSpreadFactor, osmomath.ZeroDec())
+	res, err = querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().True(config.Equal(res.DynamicSpreadFactorConfig))
+
+	// The price has not moved over the window: the pool's spread factor is still clamped to the min.
+	advanceTwap(config.VolatilityWindow + time.Second)
+	res, err = querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(config.MinSpreadFactor.String(), res.EffectiveSpreadFactor.String())
+	s.Require().True(res.Volatility.LT(osmomath.NewDecWithPrec(1, 9)))
+
+	// The price moves up by a bit less than 0.2% away from the TWAP.
+	sqrtPriceBefore := currentSqrtPrice()
+	swap(sdk.NewCoin(USDC, osmomath.NewInt(100_000_000)), ETH)
+	sqrtPriceRatio := currentSqrtPrice().Quo(sqrtPriceBefore)
+	volatility := twapDeviation()
+	osmoassert.Equal(s.T(), multiplicativeTolerance, sqrtPriceRatio.Mul(sqrtPriceRatio).Sub(osmomath.OneBigDec()).Dec(), volatility)
+	s.Require().True(volatility.GT(osmomath.MustNewDecFromStr("0.001")))
+	expectedSpreadFactor := spreadFactor.Add(volatility)
+	requireEffectiveSpreadFactor(expectedSpreadFactor, volatility)
+
+	// The next swap, moving the price back down, is charged the effective spread factor.
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(10_000))
+	spreadRewardsBefore := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), ETH)
+	swap(tokenIn, USDC)
+	spreadRewardsCharged := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetSpreadRewardsAddress(), ETH).Sub(spreadRewardsBefore)
+	osmoassert.Equal(s.T(), oneAdditiveTolerance, tokenIn.Amount.ToLegacyDec().Mul(expectedSpreadFactor).Ceil().TruncateInt(), spreadRewardsCharged.Amount)
+
+	// Half a window later, the TWAP has moved towards the new price, so the volatility is lower.
+	volatilityAfterSwaps := twapDeviation()
+	s.Require().True(volatilityAfterSwaps.LT(volatility))
+	advanceTwap(config.VolatilityWindow / 2)
+	halfWindowVolatility := twapDeviation()
+	s.Require().True(halfWindowVolatility.IsPositive())
+	s.Require().True(halfWindowVolatility.LT(volatilityAfterSwaps))
+	requireEffectiveSpreadFactor(config.ClampSpreadFactor(spreadFactor.Add(halfWindowVolatility)), halfWindowVolatility)
+
+	// A window later, the TWAP has caught up with the price: the pool's spread factor is clamped to the min again.
+	advanceTwap(config.VolatilityWindow)
+	res, err = querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(config.MinSpreadFactor.String(), res.EffectiveSpreadFactor.String())
+	s.Require().True(res.Volatility.LT(osmomath.NewDecWithPrec(1, 9)))
+
+	// Large moves are capped at the max.
+	swap(sdk.NewCoin(USDC, osmomath.NewInt(3_000_000_000)), ETH)
+	res, err = querier.EffectiveSpreadFactor(s.Ctx, queryproto.EffectiveSpreadFactorRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().True(res.Volatility.GT(config.MaxSpreadFactor))
+	s.Require().Equal(config.MaxSpreadFactor.String(), res.EffectiveSpreadFactor.String())
+
+	// Out of dynamic spread factor mode, the pool's spread factor is charged again.
+	err = clKeeper.HandleSetDynamicSpreadFactorProposal(s.Ctx, &types.SetDynamicSpreadFactorProposal{DisabledPoolIds: []uint64{pool.GetId()}})
+	s.Require().NoError(err)
+	requireEffectiveSpreadFactor(spreadFactor, osmomath.ZeroDec())
+}
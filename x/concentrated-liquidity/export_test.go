@@ -322,7 +322,8 @@ func (k Keeper) GetLargestSupportedUptimeDuration(ctx sdk.Context) time.Duration
 func (k Keeper) SetupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension,
 	spreadFactor osmomath.Dec, tokenInDenom string,
 	priceLimit osmomath.BigDec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit osmomath.BigDec, err error) {
-	return k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	strategy, sqrtPriceLimit, _, err = k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
+	return strategy, sqrtPriceLimit, err
 }
 
 func MoveRewardsToNewPositionAndDeleteOldAcc(ctx sdk.Context, accum *accum.AccumulatorObject, oldPositionName, newPositionName string, growthOutside sdk.DecCoins) error {
@@ -344,3 +345,7 @@ func (k Keeper) CallPoolActionListener(ctx sdk.Context, msgBz []byte, poolId uin
 func (k Keeper) GetPoolHookContract(ctx sdk.Context, poolId uint64, actionPrefix string) string {
 	return k.getPoolHookContract(ctx, poolId, actionPrefix)
 }
+
+func (k Keeper) SwapToPositionRatio(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, liquidity osmomath.Dec, amount0, amount1 osmomath.Int, twapPrice osmomath.Dec) (osmomath.Int, osmomath.Int, error) {
+	return k.swapToPositionRatio(ctx, owner, pool, lowerTick, upperTick, liquidity, amount0, amount1, twapPrice)
+}
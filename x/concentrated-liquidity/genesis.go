@@ -101,6 +101,58 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set limit orders, whose liquidity is already part of the ticks and whose tokens are already part of the pool balances.
+	if genState.NextLimitOrderId != 0 {
+		k.SetNextLimitOrderId(ctx, genState.NextLimitOrderId)
+	}
+	for _, limitOrderWrapper := range genState.LimitOrderData {
+		limitOrder := limitOrderWrapper.LimitOrder
+		if _, ok := seenPoolIds[limitOrder.PoolId]; !ok {
+			panic(fmt.Sprintf("found limit order with pool id (%d) but there is no pool with such id that exists", limitOrder.PoolId))
+		}
+
+		pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		k.setLimitOrder(ctx, pool, limitOrder)
+
+		// set the limit order's spread reward and uptime accumulator positions
+		positionName := types.KeyLimitOrderAccumulatorPosition(limitOrder.OrderId)
+		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		spreadRewardRecord := limitOrderWrapper.SpreadRewardAccumRecord
+		k.initOrUpdateAccumPosition(ctx, spreadRewardAccumObject, spreadRewardRecord.AccumValuePerShare, positionName, spreadRewardRecord.NumShares, spreadRewardRecord.UnclaimedRewardsTotal, spreadRewardRecord.Options)
+
+		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		for uptimeIndex, uptimeRecord := range limitOrderWrapper.UptimeAccumRecords {
+			k.initOrUpdateAccumPosition(ctx, uptimeAccumulators[uptimeIndex], uptimeRecord.AccumValuePerShare, positionName, uptimeRecord.NumShares, uptimeRecord.UnclaimedRewardsTotal, uptimeRecord.Options)
+		}
+	}
+
+	// set managed positions
+	for _, managedPosition := range genState.ManagedPositions {
+		if _, err := k.GetPosition(ctx, managedPosition.PositionId); err != nil {
+			panic(fmt.Sprintf("found managed position with id (%d) but there is no position with such id that exists", managedPosition.PositionId))
+		}
+		k.setManagedPosition(ctx, managedPosition)
+	}
+
+	// set dynamic spread factor configs
+	for _, config := range genState.DynamicSpreadFactorConfigs {
+		if _, ok := seenPoolIds[config.PoolId]; !ok {
+			panic(fmt.Sprintf("found dynamic spread factor config with pool id (%d) but there is no pool with such id that exists", config.PoolId))
+		}
+		if err := k.SetDynamicSpreadFactorConfig(ctx, config); err != nil {
+			panic(err)
+		}
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 }
@@ -230,12 +282,64 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	limitOrderData := make([]genesis.LimitOrderData, 0, len(limitOrders))
+	for _, limitOrder := range limitOrders {
+		// Retrieve the spread reward and uptime accumulator state for the limit order
+		positionName := types.KeyLimitOrderAccumulatorPosition(limitOrder.OrderId)
+		spreadRewardAccumObject, err := k.GetSpreadRewardAccumulator(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		spreadRewardAccumRecord, err := spreadRewardAccumObject.GetPosition(positionName)
+		if err != nil {
+			panic(err)
+		}
+
+		uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, limitOrder.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		uptimeAccumRecords := make([]accum.Record, len(uptimeAccumulators))
+		for uptimeIndex := range uptimeAccumulators {
+			uptimeAccumRecords[uptimeIndex], err = uptimeAccumulators[uptimeIndex].GetPosition(positionName)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		limitOrderData = append(limitOrderData, genesis.LimitOrderData{
+			LimitOrder:              limitOrder,
+			SpreadRewardAccumRecord: spreadRewardAccumRecord,
+			UptimeAccumRecords:      uptimeAccumRecords,
+		})
+	}
+
+	dynamicSpreadFactorConfigs, err := k.GetAllDynamicSpreadFactorConfigs(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	managedPositions, err := k.GetAllManagedPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
 		Params:                k.GetParams(ctx),
 		PoolData:              poolData,
 		PositionData:          positionData,
 		NextPositionId:        k.GetNextPositionId(ctx),
 		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		LimitOrderData:        limitOrderData,
+		NextLimitOrderId:      k.GetNextLimitOrderId(ctx),
+
+		DynamicSpreadFactorConfigs: dynamicSpreadFactorConfigs,
+		ManagedPositions:           managedPositions,
 	}
 }
 
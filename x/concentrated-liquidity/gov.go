@@ -30,6 +30,22 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSetDynamicSpreadFactorProposal handles a set dynamic spread factor proposal by putting the pools of
+// the configs in dynamic spread factor mode and taking the disabled pools out of it.
+func (k Keeper) HandleSetDynamicSpreadFactorProposal(ctx sdk.Context, p *types.SetDynamicSpreadFactorProposal) error {
+	for _, config := range p.Configs {
+		if err := k.SetDynamicSpreadFactorConfig(ctx, config); err != nil {
+			return err
+		}
+	}
+	for _, poolId := range p.DisabledPoolIds {
+		if err := k.RemoveDynamicSpreadFactorConfig(ctx, poolId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
@@ -37,6 +53,8 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.SetDynamicSpreadFactorProposal:
+			return k.HandleSetDynamicSpreadFactorProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
@@ -0,0 +1,668 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"strconv"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+// Limit orders are resting orders to sell one of the pool tokens at the price of a single tick spacing range.
+//
+// An order is backed by liquidity in the range [tickIndex, tickIndex + tickSpacing) that is added to the
+// ticks like the liquidity of a position, but that is owned by no position. The range must be entirely
+// on the token in side of the current price, so that the escrowed tokens are only made of token in.
+// As swaps move the price into the range, the order's liquidity is converted into the other token
+// like any other liquidity. Once a swap crosses the whole range, i.e. the upper tick of an order selling
+// token0 or the lower tick of an order selling token1, the order is filled: its liquidity is removed
+// from the ticks so that later swaps cannot convert it back, and its proceeds can be claimed.
+//
+// Like a position, an order has its own positions in the spread reward and uptime accumulators, so that
+// the spread rewards and incentives accrued to its liquidity while it is in range do not dilute those of
+// the positions. The positions are emptied once the order is filled or cancelled, and their rewards are
+// sent to the owner with the proceeds of the order when it is claimed, or with its underlying tokens when
+// it is cancelled.
+
+// PlaceLimitOrder escrows tokenIn from the owner in the range starting at tickIndex of the given pool.
+// Returns the id of the order and the amount of tokens escrowed, which can be rounded down from tokenIn.
+// Returns error if:
+// - the pool does not exist or has no position yet
+// - tokenIn is not one of the pool tokens or is not positive
+// - the tick index is not a valid lower tick for the pool's tick spacing
+// - the range is not entirely on the token in side of the current price
+// - the amount is too small to be translated into the minimum order liquidity
+// - the tick filling the order already has the maximum number of unfilled orders
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (uint64, sdk.Coin, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	if !k.PoolHasPosition(ctx, pool) {
+		return 0, sdk.Coin{}, types.ErrLimitOrderPoolNotInitialized
+	}
+
+	if tokenIn.Denom != pool.GetToken0() && tokenIn.Denom != pool.GetToken1() {
+		return 0, sdk.Coin{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return 0, sdk.Coin{}, types.NotPositiveRequireAmountError{Amount: tokenIn.Amount.String()}
+	}
+
+	lowerTick := tickIndex
+	upperTick := tickIndex + int64(pool.GetTickSpacing())
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	// If multiple ticks can represent the same spot price, ensure we are using the largest of those ticks.
+	lowerTick, upperTick, err = roundTickToCanonicalPriceTick(lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, pool.GetTickSpacing())
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	// An order selling token0 must be above the current price and an order selling token1 below it,
+	// so that it is made only of the token it sells and is filled when the price crosses it.
+	isTokenZero := tokenIn.Denom == pool.GetToken0()
+	currentTick := pool.GetCurrentTick()
+	var liquidity osmomath.Dec
+	fillTick := lowerTick
+	if isTokenZero {
+		fillTick = upperTick
+		if currentTick >= lowerTick {
+			return 0, sdk.Coin{}, types.LimitOrderWrongSideOfPriceError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick, IsTokenZero: true}
+		}
+		liquidity = math.Liquidity0(tokenIn.Amount, sqrtPriceLowerTick, sqrtPriceUpperTick)
+	} else {
+		if currentTick < upperTick {
+			return 0, sdk.Coin{}, types.LimitOrderWrongSideOfPriceError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick, CurrentTick: currentTick}
+		}
+		liquidity = math.Liquidity1(tokenIn.Amount, sqrtPriceLowerTick, sqrtPriceUpperTick)
+	}
+	if liquidity.LT(types.MinLimitOrderLiquidity) {
+		return 0, sdk.Coin{}, types.LimitOrderLiquidityTooLowError{Liquidity: liquidity, MinLiquidity: types.MinLimitOrderLiquidity}
+	}
+
+	// Each order filled by crossing a tick is filled individually by the swaps crossing it.
+	if k.countLimitOrdersAtTick(ctx, poolId, fillTick, types.MaxLimitOrdersPerTick) >= types.MaxLimitOrdersPerTick {
+		return 0, sdk.Coin{}, types.LimitOrderTickFullError{PoolId: poolId, FillTick: fillTick, MaxLimitOrders: types.MaxLimitOrdersPerTick}
+	}
+
+	// Sync the uptime accumulators so that past incentives are not distributed with the new liquidity.
+	if err := k.UpdatePoolUptimeAccumulatorsToNow(ctx, poolId); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	if err := k.updateLimitOrderTicks(ctx, poolId, currentTick, lowerTick, upperTick, liquidity); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	// Refetch the pool since updating the accumulators and the ticks may mutate it.
+	pool, err = k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	amount0, amount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidity)
+	if err != nil {
+		return 0, sdk.Coin{}, err
+	}
+	escrowed := sdk.NewCoin(tokenIn.Denom, amount0.TruncateInt())
+	if !isTokenZero {
+		escrowed = sdk.NewCoin(tokenIn.Denom, amount1.TruncateInt())
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), sdk.NewCoins(escrowed)); err != nil {
+		return 0, sdk.Coin{}, types.InsufficientUserBalanceError{Err: err}
+	}
+
+	order := model.LimitOrder{
+		OrderId:   k.getNextLimitOrderIdAndIncrement(ctx),
+		Owner:     owner.String(),
+		PoolId:    poolId,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Liquidity: liquidity,
+		TokenIn:   escrowed,
+		Filled:    false,
+		TokenOut:  sdk.NewCoin(otherPoolDenom(pool, tokenIn.Denom), osmomath.ZeroInt()),
+		PlaceTime: ctx.BlockTime(),
+	}
+
+	if err := k.initOrUpdateLimitOrderAccumulators(ctx, order, liquidity); err != nil {
+		return 0, sdk.Coin{}, err
+	}
+
+	k.setLimitOrder(ctx, pool, order)
+
+	k.RecordTotalLiquidityIncrease(ctx, sdk.NewCoins(escrowed))
+
+	emitLimitOrderEvent(ctx, types.TypeEvtPlaceLimitOrder, order, escrowed)
+
+	return order.OrderId, escrowed, nil
+}
+
+// ClaimLimitOrder sends the proceeds of the filled limit order with the given id to its owner, along with the
+// spread rewards and incentives accrued to the order, and deletes the order.
+// Returns error if the order does not exist, is not owned by owner or is not filled.
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (sdk.Coin, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if order.Owner != owner.String() {
+		return sdk.Coin{}, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+	if !order.Filled {
+		return sdk.Coin{}, types.LimitOrderNotFilledError{OrderId: orderId}
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if order.TokenOut.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, sdk.NewCoins(order.TokenOut)); err != nil {
+			return sdk.Coin{}, types.InsufficientPoolBalanceError{Err: err}
+		}
+		k.RecordTotalLiquidityDecrease(ctx, sdk.NewCoins(order.TokenOut))
+	}
+
+	if err := k.collectLimitOrderRewards(ctx, pool, order, owner); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.deleteLimitOrder(ctx, pool, order)
+
+	emitLimitOrderEvent(ctx, types.TypeEvtClaimLimitOrder, order, order.TokenOut)
+
+	return order.TokenOut, nil
+}
+
+// CancelLimitOrder removes the unfilled limit order with the given id from the ticks, sends its
+// underlying tokens to its owner along with the spread rewards and incentives accrued to the order,
+// and deletes the order. If the order was partially filled, i.e. the current price is within its range,
+// the underlying tokens include the proceeds of the partial fill.
+// Returns error if the order does not exist, is not owned by owner or is already filled.
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, owner sdk.AccAddress, orderId uint64) (osmomath.Int, osmomath.Int, error) {
+	order, err := k.GetLimitOrder(ctx, orderId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if order.Owner != owner.String() {
+		return osmomath.Int{}, osmomath.Int{}, types.NotLimitOrderOwnerError{OrderId: orderId, Address: owner.String()}
+	}
+	if order.Filled {
+		return osmomath.Int{}, osmomath.Int{}, types.LimitOrderAlreadyFilledError{OrderId: orderId}
+	}
+
+	return k.cancelLimitOrder(ctx, order)
+}
+
+// cancelLimitOrder removes the given unfilled order's liquidity from the ticks and from the pool if it is in range,
+// sends its underlying tokens and its rewards to its owner and deletes the order.
+func (k Keeper) cancelLimitOrder(ctx sdk.Context, order model.LimitOrder) (osmomath.Int, osmomath.Int, error) {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// Sync the uptime accumulators before the pool liquidity may change.
+	if err := k.UpdatePoolUptimeAccumulatorsToNow(ctx, order.PoolId); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// The accumulator positions are emptied before the ticks may be deleted, as they rely on the ticks' growth outside.
+	liquidityDelta := order.Liquidity.Neg()
+	if err := k.initOrUpdateLimitOrderAccumulators(ctx, order, liquidityDelta); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if err := k.updateLimitOrderTicks(ctx, order.PoolId, pool.GetCurrentTick(), order.LowerTick, order.UpperTick, liquidityDelta); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// Refetch the pool since updating the accumulators and the ticks may mutate it.
+	pool, err = k.getPoolById(ctx, order.PoolId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	actualAmount0, actualAmount1, err := pool.CalcActualAmounts(ctx, order.LowerTick, order.UpperTick, liquidityDelta)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	// The amounts are negative since liquidity is removed, and rounded down in favor of the pool.
+	amount0, amount1 := actualAmount0.Abs().TruncateInt(), actualAmount1.Abs().TruncateInt()
+
+	pool.UpdateLiquidityIfActivePosition(ctx, order.LowerTick, order.UpperTick, liquidityDelta)
+	if err := k.setPool(ctx, pool); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	if err := k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), amount0, amount1, pool.GetAddress(), owner); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	tokensRemoved := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	k.RecordTotalLiquidityDecrease(ctx, tokensRemoved)
+
+	if err := k.collectLimitOrderRewards(ctx, pool, order, owner); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	k.deleteLimitOrder(ctx, pool, order)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelLimitOrder,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		),
+	})
+
+	return amount0, amount1, nil
+}
+
+// cancelAllLimitOrdersForPool cancels every unfilled limit order of the given pool, sending their underlying tokens to their owners.
+// It is called when the last position of a pool is withdrawn, since the pool's price is reset at that point.
+func (k Keeper) cancelAllLimitOrdersForPool(ctx sdk.Context, poolId uint64) error {
+	orderIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyLimitOrderPoolPrefix(poolId), parseLimitOrderId)
+	if err != nil {
+		return err
+	}
+
+	for _, orderId := range orderIds {
+		order, err := k.GetLimitOrder(ctx, orderId)
+		if err != nil {
+			return err
+		}
+		if _, _, err := k.cancelLimitOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillLimitOrdersAtCrossedTicks fills the unfilled limit orders of the given pool that are filled by crossing
+// the given ticks in the given direction. It is called once the swap that crossed the ticks is applied to the pool,
+// so that the spread rewards and incentives accrued to the orders during the swap are accounted for when emptying
+// their accumulator positions. The swap being monotonic, the ticks are not crossed back by the same swap.
+func (k Keeper) fillLimitOrdersAtCrossedTicks(ctx sdk.Context, poolId uint64, crossedTicks []int64, zeroForOne bool) error {
+	if len(crossedTicks) == 0 {
+		return nil
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	for _, tickIndex := range crossedTicks {
+		if err := k.fillLimitOrdersAtTick(ctx, pool, tickIndex, zeroForOne, pool.GetCurrentTick()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillLimitOrdersAtTick fills the unfilled limit orders of the given pool that are filled by crossing the given tick
+// in the given direction, i.e. the orders selling token0 whose upper tick is crossed while the price increases and
+// the orders selling token1 whose lower tick is crossed while the price decreases.
+// Their accumulator positions are emptied, their liquidity is removed from the ticks and their proceeds are recorded
+// so that they can be claimed. Since the current tick is past the range of these orders, the pool liquidity is left
+// unchanged.
+func (k Keeper) fillLimitOrdersAtTick(ctx sdk.Context, pool types.ConcentratedPoolExtension, tickIndex int64, zeroForOne bool, currentTick int64) error {
+	poolId := pool.GetId()
+	orderIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyLimitOrderTickPrefix(poolId, tickIndex), parseLimitOrderId)
+	if err != nil {
+		return err
+	}
+
+	for _, orderId := range orderIds {
+		order, err := k.GetLimitOrder(ctx, orderId)
+		if err != nil {
+			return err
+		}
+
+		// Orders selling token1 are filled while the price decreases, orders selling token0 while it increases.
+		sellsToken1 := order.TokenIn.Denom == pool.GetToken1()
+		if sellsToken1 != zeroForOne {
+			continue
+		}
+
+		sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(order.LowerTick, order.UpperTick)
+		if err != nil {
+			return err
+		}
+
+		// The proceeds are the whole range converted into the other token, rounded down in favor of the pool.
+		liquidity := osmomath.BigDecFromDec(order.Liquidity)
+		var proceeds osmomath.Int
+		if sellsToken1 {
+			proceeds = math.CalcAmount0Delta(liquidity, sqrtPriceLowerTick, sqrtPriceUpperTick, false).Dec().TruncateInt()
+		} else {
+			proceeds = math.CalcAmount1Delta(liquidity, sqrtPriceLowerTick, sqrtPriceUpperTick, false).Dec().TruncateInt()
+		}
+
+		if err := k.initOrUpdateLimitOrderAccumulators(ctx, order, order.Liquidity.Neg()); err != nil {
+			return err
+		}
+
+		if err := k.updateLimitOrderTicks(ctx, poolId, currentTick, order.LowerTick, order.UpperTick, order.Liquidity.Neg()); err != nil {
+			return err
+		}
+
+		// Filled orders are no longer indexed by their fill tick.
+		ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrderTick(poolId, tickIndex, orderId))
+		order.Filled = true
+		order.TokenOut = sdk.NewCoin(order.TokenOut.Denom, proceeds)
+		k.setLimitOrder(ctx, pool, order)
+
+		emitLimitOrderEvent(ctx, types.TypeEvtFillLimitOrder, order, order.TokenOut)
+	}
+
+	return nil
+}
+
+// countLimitOrdersAtTick returns the number of unfilled limit orders of the given pool that are filled by crossing
+// the given tick, counting up to limit.
+func (k Keeper) countLimitOrdersAtTick(ctx sdk.Context, poolId uint64, fillTick int64, limit int) int {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyLimitOrderTickPrefix(poolId, fillTick))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid() && count < limit; iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// initOrUpdateLimitOrderAccumulators adds liquidityDelta to the spread reward and uptime accumulator positions of the given order,
+// creating them when the order is placed. The ticks of the order must be initialized.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) initOrUpdateLimitOrderAccumulators(ctx sdk.Context, order model.LimitOrder, liquidityDelta osmomath.Dec) error {
+	positionName := types.KeyLimitOrderAccumulatorPosition(order.OrderId)
+	if err := k.initOrUpdateUptimeAccumulatorPositions(ctx, order.PoolId, order.Liquidity, order.LowerTick, order.UpperTick, liquidityDelta, order.OrderId, positionName); err != nil {
+		return err
+	}
+	return k.initOrUpdateSpreadRewardAccumulatorPosition(ctx, order.PoolId, order.LowerTick, order.UpperTick, order.OrderId, positionName, liquidityDelta)
+}
+
+// collectLimitOrderRewards sends the spread rewards and incentives accrued to the given order to its owner and deletes
+// the order's accumulator positions. The positions must have been emptied when the order was filled or cancelled, so
+// that all their rewards are unclaimed rewards and do not depend on the growth outside of the order's range, whose ticks
+// may have been deleted since.
+// Like for positions, the incentives of the uptimes longer than the age of the order are forfeited to the community pool.
+func (k Keeper) collectLimitOrderRewards(ctx sdk.Context, pool types.ConcentratedPoolExtension, order model.LimitOrder, owner sdk.AccAddress) error {
+	positionName := types.KeyLimitOrderAccumulatorPosition(order.OrderId)
+
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, order.PoolId)
+	if err != nil {
+		return err
+	}
+	spreadRewards, forfeitedDust, err := updateAccumAndClaimRewards(spreadRewardAccumulator, positionName, sdk.DecCoins{})
+	if err != nil {
+		return err
+	}
+
+	// Add the forfeited dust back to the global accumulator, as done when claiming the spread rewards of positions.
+	if !forfeitedDust.IsZero() {
+		spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, order.PoolId)
+		if err != nil {
+			return err
+		}
+
+		totalSharesRemaining := spreadRewardAccumulator.GetTotalShares()
+		if !totalSharesRemaining.IsZero() {
+			spreadRewardAccumulator.AddToAccumulator(forfeitedDust.QuoDecTruncate(totalSharesRemaining))
+		}
+	}
+
+	orderAge := ctx.BlockTime().Sub(order.PlaceTime)
+	if orderAge < 0 {
+		return types.NegativeDurationError{Duration: orderAge}
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, order.PoolId)
+	if err != nil {
+		return err
+	}
+
+	incentives, forfeitedIncentives := sdk.Coins{}, sdk.Coins{}
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		incentivesForUptime, _, err := updateAccumAndClaimRewards(uptimeAccum, positionName, sdk.DecCoins{})
+		if err != nil {
+			return err
+		}
+
+		if orderAge < types.SupportedUptimes[uptimeIndex] {
+			forfeitedIncentives = forfeitedIncentives.Add(incentivesForUptime...)
+		} else {
+			incentives = incentives.Add(incentivesForUptime...)
+		}
+	}
+
+	if !spreadRewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetSpreadRewardsAddress(), owner, spreadRewards); err != nil {
+			return err
+		}
+	}
+	if !incentives.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), owner, incentives); err != nil {
+			return err
+		}
+	}
+	if !forfeitedIncentives.IsZero() {
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, forfeitedIncentives, pool.GetIncentivesAddress()); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCollectLimitOrderRewards,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeySpreadRewards, spreadRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyIncentives, incentives.String()),
+			sdk.NewAttribute(types.AttributeKeyForfeitedTokens, forfeitedIncentives.String()),
+		),
+	})
+
+	return nil
+}
+
+// updateLimitOrderTicks adds liquidityDelta to the lower and upper ticks of a limit order, deleting the ticks that become empty.
+// WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
+func (k Keeper) updateLimitOrderTicks(ctx sdk.Context, poolId uint64, currentTick, lowerTick, upperTick int64, liquidityDelta osmomath.Dec) error {
+	lowerTickIsEmpty, err := k.initOrUpdateTick(ctx, poolId, currentTick, lowerTick, liquidityDelta, false)
+	if err != nil {
+		return err
+	}
+	upperTickIsEmpty, err := k.initOrUpdateTick(ctx, poolId, currentTick, upperTick, liquidityDelta, true)
+	if err != nil {
+		return err
+	}
+
+	if lowerTickIsEmpty {
+		k.RemoveTickInfo(ctx, poolId, lowerTick)
+	}
+	if upperTickIsEmpty {
+		k.RemoveTickInfo(ctx, poolId, upperTick)
+	}
+	return nil
+}
+
+// GetLimitOrder returns the limit order with the given id.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderId uint64) (model.LimitOrder, error) {
+	order := model.LimitOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyLimitOrder(orderId), &order)
+	if err != nil {
+		return model.LimitOrder{}, err
+	}
+	if !found {
+		return model.LimitOrder{}, types.LimitOrderNotFoundError{OrderId: orderId}
+	}
+	return order, nil
+}
+
+// GetAllLimitOrders returns all the limit orders, sorted by id.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]model.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, parseLimitOrder)
+}
+
+// GetUserLimitOrders returns the limit orders of the given address, only those in the given pool if poolId is non-zero.
+func (k Keeper) GetUserLimitOrders(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]model.LimitOrder, *query.PageResponse, error) {
+	ownerPrefix := types.KeyLimitOrderOwnerPrefix(owner)
+	if poolId != 0 {
+		ownerPrefix = types.KeyLimitOrderOwnerPoolPrefix(owner, poolId)
+	}
+	ordersStore := prefix.NewStore(ctx.KVStore(k.storeKey), ownerPrefix)
+
+	orders := []model.LimitOrder{}
+	pageRes, err := query.Paginate(ordersStore, pagination, func(_, value []byte) error {
+		orderId, err := parseLimitOrderId(value)
+		if err != nil {
+			return err
+		}
+
+		order, err := k.GetLimitOrder(ctx, orderId)
+		if err != nil {
+			return err
+		}
+
+		orders = append(orders, order)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, pageRes, nil
+}
+
+// setLimitOrder writes the given limit order of the given pool and its indexes to state.
+// Only unfilled orders are indexed by the tick that fills them.
+func (k Keeper) setLimitOrder(ctx sdk.Context, pool types.ConcentratedPoolExtension, order model.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+	orderIdBz := sdk.Uint64ToBigEndian(order.OrderId)
+
+	osmoutils.MustSet(store, types.KeyLimitOrder(order.OrderId), &order)
+	store.Set(types.KeyLimitOrderOwner(owner, order.PoolId, order.OrderId), orderIdBz)
+	if !order.Filled {
+		store.Set(types.KeyLimitOrderTick(order.PoolId, limitOrderFillTick(pool, order), order.OrderId), orderIdBz)
+	}
+}
+
+// deleteLimitOrder deletes the given limit order of the given pool and its indexes from state.
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, pool types.ConcentratedPoolExtension, order model.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	owner := sdk.MustAccAddressFromBech32(order.Owner)
+
+	store.Delete(types.KeyLimitOrder(order.OrderId))
+	store.Delete(types.KeyLimitOrderOwner(owner, order.PoolId, order.OrderId))
+	store.Delete(types.KeyLimitOrderTick(order.PoolId, limitOrderFillTick(pool, order), order.OrderId))
+}
+
+// GetNextLimitOrderId returns the next limit order id, starting at 1.
+func (k Keeper) GetNextLimitOrderId(ctx sdk.Context) uint64 {
+	nextLimitOrderId := gogotypes.UInt64Value{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyNextGlobalLimitOrderId, &nextLimitOrderId)
+	if err != nil {
+		panic(err)
+	}
+	if !found || nextLimitOrderId.Value == 0 {
+		return 1
+	}
+	return nextLimitOrderId.Value
+}
+
+// SetNextLimitOrderId sets the next limit order id.
+func (k Keeper) SetNextLimitOrderId(ctx sdk.Context, orderId uint64) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyNextGlobalLimitOrderId, &gogotypes.UInt64Value{Value: orderId})
+}
+
+func (k Keeper) getNextLimitOrderIdAndIncrement(ctx sdk.Context) uint64 {
+	nextLimitOrderId := k.GetNextLimitOrderId(ctx)
+	k.SetNextLimitOrderId(ctx, nextLimitOrderId+1)
+	return nextLimitOrderId
+}
+
+// limitOrderFillTick returns the tick that fills the given order of the given pool when crossed:
+// the upper tick for orders selling token0 and the lower tick for orders selling token1.
+func limitOrderFillTick(pool types.ConcentratedPoolExtension, order model.LimitOrder) int64 {
+	if order.TokenIn.Denom == pool.GetToken0() {
+		return order.UpperTick
+	}
+	return order.LowerTick
+}
+
+// otherPoolDenom returns the pool token that is not denom.
+func otherPoolDenom(pool types.ConcentratedPoolExtension, denom string) string {
+	if denom == pool.GetToken0() {
+		return pool.GetToken1()
+	}
+	return pool.GetToken0()
+}
+
+func emitLimitOrderEvent(ctx sdk.Context, eventType string, order model.LimitOrder, amount sdk.Coin) {
+	attributeKey := types.AttributeKeyTokenOut
+	if eventType == types.TypeEvtPlaceLimitOrder {
+		attributeKey = types.AttributeKeyTokenIn
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, order.Owner),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(order.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOrderId, strconv.FormatUint(order.OrderId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(order.LowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(order.UpperTick, 10)),
+			sdk.NewAttribute(attributeKey, amount.String()),
+		),
+	})
+}
+
+func parseLimitOrder(bz []byte) (model.LimitOrder, error) {
+	order := model.LimitOrder{}
+	err := order.Unmarshal(bz)
+	return order, err
+}
+
+func parseLimitOrderId(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, errors.New("invalid limit order id encoding")
+	}
+	return sdk.BigEndianToUint64(bz), nil
+}
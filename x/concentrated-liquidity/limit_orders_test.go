@@ -0,0 +1,458 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+// The default position puts the current tick at 31000000, i.e. a price of 5000 with an increment of 0.001 per tick.
+const (
+	// sells ETH in the [5000.1, 5000.2] price range.
+	token0LimitOrderTick = DefaultCurrTick + 100
+	// sells USDC in the [4999.8, 4999.9] price range.
+	token1LimitOrderTick = DefaultCurrTick - 200
+)
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		tokenIn        sdk.Coin
+		tickIndex      int64
+		noPosition     bool
+		expectedLower  int64
+		expectedUpper  int64
+		expectedErr    error
+		expectedErrAny bool
+	}{
+		"token0 order above the current tick": {
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+			tickIndex:     token0LimitOrderTick,
+			expectedLower: token0LimitOrderTick,
+			expectedUpper: token0LimitOrderTick + int64(DefaultTickSpacing),
+		},
+		"token1 order whose upper tick is the current tick": {
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(500_000_000)),
+			tickIndex:     DefaultCurrTick - int64(DefaultTickSpacing),
+			expectedLower: DefaultCurrTick - int64(DefaultTickSpacing),
+			expectedUpper: DefaultCurrTick,
+		},
+		"error: token0 order including the current tick": {
+			tokenIn:   sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+			tickIndex: DefaultCurrTick,
+			expectedErr: types.LimitOrderWrongSideOfPriceError{
+				PoolId: 1, LowerTick: DefaultCurrTick, UpperTick: DefaultCurrTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick, IsTokenZero: true,
+			},
+		},
+		"error: token1 order including the current tick": {
+			tokenIn:   sdk.NewCoin(USDC, osmomath.NewInt(500_000_000)),
+			tickIndex: DefaultCurrTick,
+			expectedErr: types.LimitOrderWrongSideOfPriceError{
+				PoolId: 1, LowerTick: DefaultCurrTick, UpperTick: DefaultCurrTick + int64(DefaultTickSpacing), CurrentTick: DefaultCurrTick,
+			},
+		},
+		"error: denom not in pool": {
+			tokenIn:     sdk.NewCoin("uosmo", osmomath.NewInt(100_000)),
+			tickIndex:   token0LimitOrderTick,
+			expectedErr: types.TokenInDenomNotInPoolError{TokenInDenom: "uosmo"},
+		},
+		"error: tick not a multiple of the tick spacing": {
+			tokenIn:        sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+			tickIndex:      token0LimitOrderTick + 1,
+			expectedErrAny: true,
+		},
+		"error: pool without positions": {
+			tokenIn:     sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+			tickIndex:   token0LimitOrderTick,
+			noPosition:  true,
+			expectedErr: types.ErrLimitOrderPoolNotInitialized,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			if !tc.noPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+			poolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			orderId, escrowed, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			if tc.expectedErrAny {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// At most one unit is left to the owner by rounding.
+			s.Require().Equal(tc.tokenIn.Denom, escrowed.Denom)
+			s.Require().True(escrowed.Amount.LTE(tc.tokenIn.Amount))
+			s.Require().True(escrowed.Amount.GTE(tc.tokenIn.Amount.SubRaw(1)))
+			s.Require().Equal(tc.tokenIn.Sub(escrowed), s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom))
+			s.Require().Equal(poolBalanceBefore.Add(escrowed), s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+
+			order, err := clKeeper.GetLimitOrder(s.Ctx, orderId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), order.Owner)
+			s.Require().Equal(tc.expectedLower, order.LowerTick)
+			s.Require().Equal(tc.expectedUpper, order.UpperTick)
+			s.Require().Equal(escrowed, order.TokenIn)
+			s.Require().False(order.Filled)
+			s.Require().True(order.TokenOut.IsZero())
+
+			// The order's liquidity is added to its ticks, but not to the pool since it is out of range.
+			lowerTickInfo, err := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), order.LowerTick)
+			s.Require().NoError(err)
+			s.Require().Equal(order.Liquidity, lowerTickInfo.LiquidityNet)
+			poolAfter, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(pool.GetLiquidity(), poolAfter.GetLiquidity())
+
+			s.Require().Equal(uint64(2), clKeeper.GetNextLimitOrderId(s.Ctx))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtPlaceLimitOrder, 1)
+
+			// The order is returned by the user query, filtered by pool id or not.
+			querier := client.Querier{Keeper: *clKeeper}
+			for _, poolId := range []uint64{0, pool.GetId()} {
+				res, err := querier.UserLimitOrders(s.Ctx, queryproto.UserLimitOrdersRequest{Address: owner.String(), PoolId: poolId})
+				s.Require().NoError(err)
+				s.Require().Equal([]model.LimitOrder{order}, res.LimitOrders)
+			}
+			res, err := querier.UserLimitOrders(s.Ctx, queryproto.UserLimitOrdersRequest{Address: owner.String(), PoolId: pool.GetId() + 1})
+			s.Require().NoError(err)
+			s.Require().Empty(res.LimitOrders)
+		})
+	}
+}
+
+// TestPlaceLimitOrderLimits tests that dust orders are rejected and that the number of unfilled orders filled
+// by crossing the same tick is capped.
+func (s *KeeperTestSuite) TestPlaceLimitOrderLimits() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(100_000))
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, tokenIn.Amount.MulRaw(types.MaxLimitOrdersPerTick+2))))
+
+	// The liquidity of a single unit is below the minimum.
+	_, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, sdk.NewCoin(ETH, osmomath.OneInt()))
+	s.Require().ErrorAs(err, &types.LimitOrderLiquidityTooLowError{})
+
+	orderIds := []uint64{}
+	for i := 0; i < types.MaxLimitOrdersPerTick; i++ {
+		orderId, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+		s.Require().NoError(err)
+		orderIds = append(orderIds, orderId)
+	}
+
+	// The orders are filled by crossing their upper tick, which is full.
+	fillTick := token0LimitOrderTick + int64(DefaultTickSpacing)
+	_, _, err = clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+	s.Require().ErrorIs(err, types.LimitOrderTickFullError{PoolId: pool.GetId(), FillTick: fillTick, MaxLimitOrders: types.MaxLimitOrdersPerTick})
+
+	// An order in the next range is filled by crossing another tick, so it can still be placed.
+	_, _, err = clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick+int64(DefaultTickSpacing), tokenIn)
+	s.Require().NoError(err)
+
+	// Cancelling an order frees room in the tick.
+	_, _, err = clKeeper.CancelLimitOrder(s.Ctx, owner, orderIds[0])
+	s.Require().NoError(err)
+	_, _, err = clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+	s.Require().NoError(err)
+}
+
+// TestLimitOrderFillAndClaim tests that a swap crossing the whole range of an order fills it at the price of
+// its range, and that only the owner can claim the proceeds of a filled order.
+func (s *KeeperTestSuite) TestLimitOrderFillAndClaim() {
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tickIndex     int64
+		swapIn        sdk.Coin
+		expectedPrice osmomath.Dec
+	}{
+		"token0 order filled by a swap increasing the price": {
+			tokenIn:   sdk.NewCoin(ETH, osmomath.NewInt(100_000)),
+			tickIndex: token0LimitOrderTick,
+			swapIn:    sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000)),
+			// geometric mean of 5000.1 and 5000.2.
+			expectedPrice: osmomath.MustNewDecFromStr("5000.15"),
+		},
+		"token1 order filled by a swap decreasing the price": {
+			tokenIn:   sdk.NewCoin(USDC, osmomath.NewInt(500_000_000)),
+			tickIndex: token1LimitOrderTick,
+			swapIn:    sdk.NewCoin(ETH, osmomath.NewInt(200_000)),
+			// geometric mean of 4999.8 and 4999.9.
+			expectedPrice: osmomath.MustNewDecFromStr("4999.85"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+			orderId, escrowed, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, tc.tickIndex, tc.tokenIn)
+			s.Require().NoError(err)
+
+			// Claiming before the fill fails.
+			_, err = clKeeper.ClaimLimitOrder(s.Ctx, owner, orderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFilledError{OrderId: orderId})
+
+			swapper := s.TestAccs[2]
+			s.FundAcc(swapper, sdk.NewCoins(tc.swapIn))
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			tokenOutDenom := ETH
+			if tc.swapIn.Denom == ETH {
+				tokenOutDenom = USDC
+			}
+			_, err = clKeeper.SwapExactAmountIn(s.Ctx, swapper, pool, tc.swapIn, tokenOutDenom, osmomath.OneInt(), DefaultZeroSpreadFactor)
+			s.Require().NoError(err)
+
+			order, err := clKeeper.GetLimitOrder(s.Ctx, orderId)
+			s.Require().NoError(err)
+			s.Require().True(order.Filled)
+			s.Require().Equal(tokenOutDenom, order.TokenOut.Denom)
+			expectedTokenOut := escrowed.Amount.ToLegacyDec().Mul(tc.expectedPrice).TruncateInt()
+			if escrowed.Denom == USDC {
+				expectedTokenOut = escrowed.Amount.ToLegacyDec().Quo(tc.expectedPrice).TruncateInt()
+			}
+			osmoassert.Equal(s.T(), multiplicativeTolerance, expectedTokenOut, order.TokenOut.Amount)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtFillLimitOrder, 1)
+
+			// The order's liquidity is removed from its ticks, so that it is not converted back.
+			for _, tickIndex := range []int64{order.LowerTick, order.UpperTick} {
+				tickInfo, err := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), tickIndex)
+				s.Require().NoError(err)
+				s.Require().True(tickInfo.LiquidityGross.IsZero())
+			}
+
+			// A filled order can't be cancelled.
+			_, _, err = clKeeper.CancelLimitOrder(s.Ctx, owner, orderId)
+			s.Require().ErrorIs(err, types.LimitOrderAlreadyFilledError{OrderId: orderId})
+
+			// Only the owner can claim.
+			_, err = clKeeper.ClaimLimitOrder(s.Ctx, swapper, orderId)
+			s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{OrderId: orderId, Address: swapper.String()})
+
+			tokenOut, err := clKeeper.ClaimLimitOrder(s.Ctx, owner, orderId)
+			s.Require().NoError(err)
+			s.Require().Equal(order.TokenOut, tokenOut)
+			s.Require().Equal(tokenOut, s.App.BankKeeper.GetBalance(s.Ctx, owner, tokenOutDenom))
+
+			// The order is deleted once claimed.
+			_, err = clKeeper.GetLimitOrder(s.Ctx, orderId)
+			s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId})
+			_, err = clKeeper.ClaimLimitOrder(s.Ctx, owner, orderId)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelLimitOrder() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(100_000))
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	orderId, escrowed, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+	s.Require().NoError(err)
+	order, err := clKeeper.GetLimitOrder(s.Ctx, orderId)
+	s.Require().NoError(err)
+
+	// Only the owner can cancel.
+	_, _, err = clKeeper.CancelLimitOrder(s.Ctx, s.TestAccs[2], orderId)
+	s.Require().ErrorIs(err, types.NotLimitOrderOwnerError{OrderId: orderId, Address: s.TestAccs[2].String()})
+
+	amount0, amount1, err := clKeeper.CancelLimitOrder(s.Ctx, owner, orderId)
+	s.Require().NoError(err)
+
+	// The escrowed tokens are refunded, rounded down in favor of the pool.
+	s.Require().True(amount1.IsZero())
+	s.Require().True(amount0.LTE(escrowed.Amount))
+	s.Require().True(amount0.GTE(escrowed.Amount.SubRaw(1)))
+	s.Require().Equal(tokenIn.Amount.Sub(escrowed.Amount).Add(amount0), s.App.BankKeeper.GetBalance(s.Ctx, owner, ETH).Amount)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCancelLimitOrder, 1)
+
+	// The order and its ticks are deleted.
+	_, err = clKeeper.GetLimitOrder(s.Ctx, orderId)
+	s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId})
+	tickInfo, err := clKeeper.GetTickInfo(s.Ctx, pool.GetId(), order.LowerTick)
+	s.Require().NoError(err)
+	s.Require().True(tickInfo.LiquidityGross.IsZero())
+}
+
+// TestLimitOrderRewards tests that a limit order sitting in range accrues spread rewards and incentives like a position
+// with the same range and liquidity: the claimable rewards of an LP are the same whether the liquidity next to it is
+// an order or a position, and the order's owner receives the rewards the position would have when cancelling it.
+func (s *KeeperTestSuite) TestLimitOrderRewards() {
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(100_000))
+	incentiveCoin := sdk.NewCoin("uosmo", osmomath.NewInt(1_000_000_000))
+
+	// Returns the claimable spread rewards and incentives of the LP and the rewards of the order or position.
+	setupAndAccrueRewards := func(asLimitOrder bool) (lpSpreadRewards, lpIncentives, spreadRewards, incentives sdk.Coins) {
+		s.SetupTest()
+		clKeeper := s.App.ConcentratedLiquidityKeeper
+		pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultSpreadFactor)
+		_, lpPositionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(incentiveCoin))
+		_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], incentiveCoin, osmomath.NewDec(1000), s.Ctx.BlockTime(), types.DefaultAuthorizedUptimes[0])
+		s.Require().NoError(err)
+
+		owner := s.TestAccs[1]
+		s.FundAcc(owner, sdk.NewCoins(tokenIn))
+		var orderId, positionId uint64
+		if asLimitOrder {
+			orderId, _, err = clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+			s.Require().NoError(err)
+		} else {
+			positionData, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), token0LimitOrderTick, token0LimitOrderTick+int64(DefaultTickSpacing))
+			s.Require().NoError(err)
+			positionId = positionData.ID
+		}
+
+		// Move the price to the middle of the range, and let the incentives accrue while it stays there.
+		priceLimit, err := math.TickToPrice(token0LimitOrderTick + int64(DefaultTickSpacing)/2)
+		s.Require().NoError(err)
+		swapper := s.TestAccs[2]
+		swapIn := sdk.NewCoin(USDC, osmomath.NewInt(10_000_000_000))
+		s.FundAcc(swapper, sdk.NewCoins(swapIn))
+		pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, swapper, pool, swapIn, ETH, DefaultSpreadFactor, priceLimit)
+		s.Require().NoError(err)
+		pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(token0LimitOrderTick+int64(DefaultTickSpacing)/2, pool.GetCurrentTick())
+		s.AddBlockTime(time.Hour)
+
+		lpSpreadRewards, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, lpPositionId)
+		s.Require().NoError(err)
+		lpIncentives, _, err = clKeeper.GetClaimableIncentives(s.Ctx, lpPositionId)
+		s.Require().NoError(err)
+
+		if !asLimitOrder {
+			spreadRewards, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			incentives, _, err = clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+			s.Require().NoError(err)
+			return lpSpreadRewards, lpIncentives, spreadRewards, incentives
+		}
+
+		// The rewards are sent along with the underlying tokens of the order when cancelling it.
+		balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+		amount0, amount1, err := clKeeper.CancelLimitOrder(s.Ctx, owner, orderId)
+		s.Require().NoError(err)
+		s.AssertEventEmitted(s.Ctx, types.TypeEvtCollectLimitOrderRewards, 1)
+		underlying := sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+		rewards := s.App.BankKeeper.GetAllBalances(s.Ctx, owner).Sub(balancesBefore...).Sub(underlying...)
+		incentives = sdk.NewCoins(sdk.NewCoin(incentiveCoin.Denom, rewards.AmountOf(incentiveCoin.Denom)))
+		spreadRewards = rewards.Sub(incentives...)
+		return lpSpreadRewards, lpIncentives, spreadRewards, incentives
+	}
+
+	lpSpreadRewards, lpIncentives, orderSpreadRewards, orderIncentives := setupAndAccrueRewards(true)
+	expectedLpSpreadRewards, expectedLpIncentives, positionSpreadRewards, positionIncentives := setupAndAccrueRewards(false)
+
+	s.Require().False(lpSpreadRewards.IsZero())
+	s.Require().False(lpIncentives.IsZero())
+	s.Require().Equal(expectedLpSpreadRewards, lpSpreadRewards)
+	s.Require().Equal(expectedLpIncentives, lpIncentives)
+
+	s.Require().False(orderSpreadRewards.IsZero())
+	s.Require().False(orderIncentives.IsZero())
+	s.Require().Equal(positionSpreadRewards, orderSpreadRewards)
+	s.Require().Equal(positionIncentives, orderIncentives)
+}
+
+// TestLimitOrderGenesis tests that the accumulator positions of the limit orders are exported and imported along with them.
+func (s *KeeperTestSuite) TestLimitOrderGenesis() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(100_000))
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	orderId, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokenIn)
+	s.Require().NoError(err)
+	order, err := clKeeper.GetLimitOrder(s.Ctx, orderId)
+	s.Require().NoError(err)
+
+	genState := clKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genState.LimitOrderData, 1)
+	s.Require().Equal(order, genState.LimitOrderData[0].LimitOrder)
+	s.Require().Equal(order.Liquidity, genState.LimitOrderData[0].SpreadRewardAccumRecord.NumShares)
+	s.Require().Len(genState.LimitOrderData[0].UptimeAccumRecords, len(types.SupportedUptimes))
+	for _, uptimeAccumRecord := range genState.LimitOrderData[0].UptimeAccumRecords {
+		s.Require().Equal(order.Liquidity, uptimeAccumRecord.NumShares)
+	}
+
+	s.SetupTest()
+	clKeeper = s.App.ConcentratedLiquidityKeeper
+	clKeeper.InitGenesis(s.Ctx, *genState)
+	s.Require().Equal(genState.LimitOrderData, clKeeper.ExportGenesis(s.Ctx).LimitOrderData)
+}
+
+// TestWithdrawLastPositionCancelsLimitOrders tests that withdrawing the last position of a pool
+// cancels the unfilled limit orders of the pool and refunds their owners.
+func (s *KeeperTestSuite) TestWithdrawLastPositionCancelsLimitOrders() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	_, positionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	owner := s.TestAccs[1]
+	tokensIn := sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(100_000)), sdk.NewCoin(USDC, osmomath.NewInt(500_000_000)))
+	s.FundAcc(owner, tokensIn)
+	token0OrderId, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token0LimitOrderTick, tokensIn[0])
+	s.Require().NoError(err)
+	token1OrderId, _, err := clKeeper.PlaceLimitOrder(s.Ctx, pool.GetId(), owner, token1LimitOrderTick, tokensIn[1])
+	s.Require().NoError(err)
+
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, s.TestAccs[0], positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	for _, orderId := range []uint64{token0OrderId, token1OrderId} {
+		_, err = clKeeper.GetLimitOrder(s.Ctx, orderId)
+		s.Require().ErrorIs(err, types.LimitOrderNotFoundError{OrderId: orderId})
+	}
+
+	// The owner is refunded, rounded down in favor of the pool.
+	for _, tokenIn := range tokensIn {
+		balance := s.App.BankKeeper.GetBalance(s.Ctx, owner, tokenIn.Denom)
+		s.Require().True(balance.Amount.GTE(tokenIn.Amount.SubRaw(2)))
+	}
+}
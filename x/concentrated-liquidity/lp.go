@@ -313,6 +313,12 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		}
 
 		if !anyPositionsRemainingInPool {
+			// The unfilled limit orders are refunded to their owners since their side of
+			// the price is undefined once the pool is uninitialized.
+			if err := k.cancelAllLimitOrdersForPool(ctx, pool.GetId()); err != nil {
+				return osmomath.Int{}, osmomath.Int{}, err
+			}
+
 			// Reset the current tick and current square root price to initial values of zero since there is no
 			// liquidity left.
 			if err := k.uninitializePool(ctx, pool.GetId()); err != nil {
@@ -411,6 +417,12 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, types.PositionSuperfluidStakedError{PositionId: position.PositionId}
 	}
 
+	// The managed mode of the position is deleted along with it, and moved to the new position.
+	managedPosition, isManaged, err := k.GetManagedPosition(ctx, positionId)
+	if err != nil {
+		return 0, osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	// Withdraw full position.
 	amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
 	if err != nil {
@@ -448,6 +460,11 @@ func (k Keeper) addToPosition(ctx sdk.Context, owner sdk.AccAddress, positionId
 		return 0, osmomath.Int{}, osmomath.Int{}, err
 	}
 
+	if isManaged {
+		managedPosition.PositionId = newPositionData.ID
+		k.setManagedPosition(ctx, managedPosition)
+	}
+
 	// Emit an event indicating that a position was added to.
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
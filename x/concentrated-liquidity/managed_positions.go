@@ -0,0 +1,407 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// Managed positions have their rewards compounded into them at the end of every ManagedPositionEpochIdentifier epoch,
+// at most MaxManagedPositionsPerEpoch of them per epoch, taken in turn.
+//
+// The swaps and re-centrings are checked against the ManagedPositionTwapWindow TWAP of the pool, so that they
+// can't be made at a price manipulated within the block. Positions whose pool has no such TWAP are not compounded.
+//
+// The spread rewards and incentives of a managed position are claimed to its owner. The claimed pool tokens are
+// swapped against the pool through the poolmanager so that they are in the ratio of the tokens backing the position,
+// and added to it in place. The claimed incentives in other denoms are left to the owner.
+//
+// Unlike MsgAddToPosition, which replaces the position with a new one, compounding keeps the position and its join
+// time, so that managed positions keep earning the incentives of the uptimes longer than the epoch. The liquidity
+// added this way only comes from the rewards of the position itself, so it can't be used to claim uptime it did not earn.
+//
+// If a rebalance width is set and the position is out of range, the position is instead withdrawn in full, and its
+// tokens are added to the claimed pool tokens to create a position of the rebalance width centred around the current
+// tick. The managed mode is moved to the new position, whose join time starts over.
+// Positions that are withdrawn in full or transferred are taken out of managed mode.
+
+// ManagedPositionEpochIdentifier is the epoch at the end of which the rewards of managed positions are compounded.
+const ManagedPositionEpochIdentifier = "day"
+
+// SetManagedPosition puts the given position in managed mode, or updates its rebalance width if it already is.
+// Returns error if:
+// - the position does not exist or is not owned by the owner
+// - the position has an active underlying lock
+// - the rebalance width is not a multiple of the pool tick spacing
+func (k Keeper) SetManagedPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, rebalanceWidth uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	// Positions with an underlying lock can't be replaced by a new position.
+	positionHasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if positionHasActiveUnderlyingLock {
+		return types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	if rebalanceWidth%pool.GetTickSpacing() != 0 {
+		return types.InvalidRebalanceWidthError{PositionId: positionId, RebalanceWidth: rebalanceWidth, TickSpacing: pool.GetTickSpacing()}
+	}
+
+	k.setManagedPosition(ctx, model.ManagedPosition{PositionId: positionId, RebalanceWidth: rebalanceWidth})
+	return nil
+}
+
+// UnsetManagedPosition takes the given position out of managed mode.
+// Returns error if the position does not exist, is not owned by the owner or is not managed.
+func (k Keeper) UnsetManagedPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
+	if owner.String() != position.Address {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyManagedPosition(positionId)
+	if !store.Has(key) {
+		return types.ManagedPositionNotFoundError{PositionId: positionId}
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetManagedPosition returns the managed mode of the given position and whether the position is managed.
+func (k Keeper) GetManagedPosition(ctx sdk.Context, positionId uint64) (model.ManagedPosition, bool, error) {
+	managedPosition := model.ManagedPosition{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyManagedPosition(positionId), &managedPosition)
+	if err != nil {
+		return model.ManagedPosition{}, false, err
+	}
+	return managedPosition, found, nil
+}
+
+// GetAllManagedPositions returns the managed mode of all the managed positions.
+func (k Keeper) GetAllManagedPositions(ctx sdk.Context) ([]model.ManagedPosition, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.ManagedPositionPrefix, func(bz []byte) (model.ManagedPosition, error) {
+		var managedPosition model.ManagedPosition
+		err := managedPosition.Unmarshal(bz)
+		return managedPosition, err
+	})
+}
+
+func (k Keeper) setManagedPosition(ctx sdk.Context, managedPosition model.ManagedPosition) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyManagedPosition(managedPosition.PositionId), &managedPosition)
+}
+
+// CompoundManagedPositions compounds the rewards of at most MaxManagedPositionsPerEpoch managed positions, starting
+// from the position id of the stored cursor, so that the work of an epoch is bounded. The cursor is moved past the
+// compounded positions, or reset once the last managed position is reached. Since re-centring replaces a position
+// with one of a greater id, the re-centred positions are queued after the ones not yet compounded.
+// The positions that fail to be compounded, for instance because their rewards are too small to be translated
+// into liquidity, are left untouched until they are reached again.
+func (k Keeper) CompoundManagedPositions(ctx sdk.Context) {
+	managedPositions, err := k.getManagedPositionsFromCursor(ctx, types.MaxManagedPositionsPerEpoch)
+	if err != nil {
+		ctx.Logger().Error("failed to get managed positions", "error", err)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(managedPositions) < types.MaxManagedPositionsPerEpoch {
+		store.Delete(types.KeyManagedPositionsCompoundCursor)
+	} else {
+		store.Set(types.KeyManagedPositionsCompoundCursor, sdk.Uint64ToBigEndian(managedPositions[len(managedPositions)-1].PositionId+1))
+	}
+
+	for _, managedPosition := range managedPositions {
+		managedPosition := managedPosition
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, err := k.compoundManagedPosition(cacheCtx, managedPosition)
+			return err
+		})
+	}
+}
+
+// getManagedPositionsFromCursor returns at most limit managed positions, in order of position id, starting from
+// the position id of the stored cursor.
+func (k Keeper) getManagedPositionsFromCursor(ctx sdk.Context, limit int) ([]model.ManagedPosition, error) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := uint64(0)
+	if bz := store.Get(types.KeyManagedPositionsCompoundCursor); bz != nil {
+		cursor = sdk.BigEndianToUint64(bz)
+	}
+
+	iterator := store.Iterator(types.KeyManagedPosition(cursor), sdk.PrefixEndBytes(types.ManagedPositionPrefix))
+	defer iterator.Close()
+
+	managedPositions := []model.ManagedPosition{}
+	for ; iterator.Valid() && len(managedPositions) < limit; iterator.Next() {
+		var managedPosition model.ManagedPosition
+		if err := managedPosition.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		managedPositions = append(managedPositions, managedPosition)
+	}
+	return managedPositions, nil
+}
+
+// compoundManagedPosition compounds the rewards of the given managed position into it, re-centring it first if it is
+// out of range and has a rebalance width. Returns the id of the position, which only changes if it is re-centred.
+func (k Keeper) compoundManagedPosition(ctx sdk.Context, managedPosition model.ManagedPosition) (uint64, error) {
+	position, err := k.GetPosition(ctx, managedPosition.PositionId)
+	if err != nil {
+		return 0, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return 0, err
+	}
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, err
+	}
+
+	spreadRewards, err := k.collectSpreadRewards(ctx, owner, position.PositionId)
+	if err != nil {
+		return 0, err
+	}
+	incentives, _, err := k.collectIncentives(ctx, owner, position.PositionId)
+	if err != nil {
+		return 0, err
+	}
+	rewards := spreadRewards.Add(incentives...)
+	amount0, amount1 := rewards.AmountOf(pool.GetToken0()), rewards.AmountOf(pool.GetToken1())
+
+	rebalance := managedPosition.RebalanceWidth != 0 && !pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick)
+	if !rebalance && amount0.IsZero() && amount1.IsZero() {
+		return position.PositionId, nil
+	}
+
+	twapPrice, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, pool.GetId(), pool.GetToken0(), pool.GetToken1(), ctx.BlockTime().Add(-types.ManagedPositionTwapWindow))
+	if err != nil {
+		return 0, err
+	}
+	if !twapPrice.IsPositive() {
+		return 0, types.NonPositiveTwapPriceError{PoolId: pool.GetId(), TwapPrice: twapPrice}
+	}
+
+	// The position is not re-centred around a current tick that may have been moved away from the TWAP by a
+	// manipulation of the pool price.
+	if rebalance && !isPriceNearTwap(pool.GetCurrentSqrtPrice(), twapPrice) {
+		rebalance = false
+		if amount0.IsZero() && amount1.IsZero() {
+			return position.PositionId, nil
+		}
+	}
+
+	lowerTick, upperTick := position.LowerTick, position.UpperTick
+	if rebalance {
+		lowerTick, upperTick, err = centredRange(pool.GetCurrentTick(), managedPosition.RebalanceWidth, pool.GetTickSpacing())
+		if err != nil {
+			return 0, err
+		}
+
+		amount0Withdrawn, amount1Withdrawn, err := k.WithdrawPosition(ctx, owner, position.PositionId, position.Liquidity)
+		if err != nil {
+			return 0, err
+		}
+		amount0, amount1 = amount0.Add(amount0Withdrawn), amount1.Add(amount1Withdrawn)
+
+		// The pool is uninitialized once its last position is withdrawn.
+		anyPositionsRemainingInPool, err := k.HasAnyPositionForPool(ctx, position.PoolId)
+		if err != nil {
+			return 0, err
+		}
+		if !anyPositionsRemainingInPool {
+			return 0, types.AddToLastPositionInPoolError{PoolId: position.PoolId, PositionId: position.PositionId}
+		}
+		pool, err = k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	amount0, amount1, err = k.swapToPositionRatio(ctx, owner, pool, lowerTick, upperTick, position.Liquidity, amount0, amount1, twapPrice)
+	if err != nil {
+		return 0, err
+	}
+
+	var newPositionId uint64
+	if rebalance {
+		tokensProvided := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+		positionData, err := k.CreatePosition(ctx, position.PoolId, owner, tokensProvided, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+		if err != nil {
+			return 0, err
+		}
+		newPositionId = positionData.ID
+		amount0, amount1 = positionData.Amount0, positionData.Amount1
+
+		// The new position replaces the withdrawn one, which was taken out of managed mode.
+		managedPosition.PositionId = newPositionId
+		k.setManagedPosition(ctx, managedPosition)
+	} else {
+		newPositionId = position.PositionId
+		amount0, amount1, err = k.addToPositionInPlace(ctx, owner, pool, position, amount0, amount1)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCompoundManagedPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPositionId, 10)),
+			sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(lowerTick, 10)),
+			sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(upperTick, 10)),
+			sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+			sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		),
+	})
+
+	return newPositionId, nil
+}
+
+// addToPositionInPlace adds the liquidity of the given amounts of the pool tokens to the given position, keeping its id
+// and join time. Returns the amounts actually added.
+// Returns error if the amounts are too small to be translated into liquidity.
+func (k Keeper) addToPositionInPlace(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, position model.Position, amount0, amount1 osmomath.Int) (osmomath.Int, osmomath.Int, error) {
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0, amount1)
+	if liquidityDelta.IsZero() {
+		return osmomath.Int{}, osmomath.Int{}, types.ErrZeroLiquidity
+	}
+
+	updateData, err := k.UpdatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, position.PositionId)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0, updateData.Amount1, owner, pool.GetAddress())
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	tokensAdded := sdk.Coins{}
+	if updateData.Amount0.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken0(), updateData.Amount0))
+	}
+	if updateData.Amount1.IsPositive() {
+		tokensAdded = tokensAdded.Add(sdk.NewCoin(pool.GetToken1(), updateData.Amount1))
+	}
+	k.RecordTotalLiquidityIncrease(ctx, tokensAdded)
+
+	return updateData.Amount0, updateData.Amount1, nil
+}
+
+// swapToPositionRatio swaps the excess of one of the given amounts of the pool tokens for the other through the
+// poolmanager, so that their value is split as the value of the tokens backing the given liquidity in the given range.
+// The swap must get at least the value of the excess at the given TWAP price, less ManagedPositionMaxTwapDeviation.
+// Returns the resulting amounts of the pool tokens.
+func (k Keeper) swapToPositionRatio(ctx sdk.Context, owner sdk.AccAddress, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, liquidity osmomath.Dec, amount0, amount1 osmomath.Int, twapPrice osmomath.Dec) (osmomath.Int, osmomath.Int, error) {
+	positionAmount0, positionAmount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidity)
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	// All the values are in terms of token1.
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	price := sqrtPrice.Mul(sqrtPrice)
+	positionValue0 := osmomath.BigDecFromDec(positionAmount0).Mul(price)
+	positionValue := positionValue0.Add(osmomath.BigDecFromDec(positionAmount1))
+	if !positionValue.IsPositive() {
+		return amount0, amount1, nil
+	}
+	value := osmomath.BigDecFromSDKInt(amount0).Mul(price).Add(osmomath.BigDecFromSDKInt(amount1))
+	desiredValue0 := value.Mul(positionValue0).Quo(positionValue)
+
+	var tokenIn sdk.Coin
+	var tokenOutDenom string
+	var twapTokenOut osmomath.Dec
+	if amount0Desired := desiredValue0.Quo(price).Dec().TruncateInt(); amount0.GT(amount0Desired) {
+		tokenIn, tokenOutDenom = sdk.NewCoin(pool.GetToken0(), amount0.Sub(amount0Desired)), pool.GetToken1()
+		twapTokenOut = tokenIn.Amount.ToLegacyDec().Mul(twapPrice)
+	} else if amount1Desired := value.Sub(desiredValue0).Dec().TruncateInt(); amount1.GT(amount1Desired) {
+		tokenIn, tokenOutDenom = sdk.NewCoin(pool.GetToken1(), amount1.Sub(amount1Desired)), pool.GetToken0()
+		twapTokenOut = tokenIn.Amount.ToLegacyDec().Quo(twapPrice)
+	} else {
+		return amount0, amount1, nil
+	}
+	tokenOutMinAmount := osmomath.MaxInt(twapTokenOut.Mul(osmomath.OneDec().Sub(types.ManagedPositionMaxTwapDeviation)).TruncateInt(), osmomath.OneInt())
+
+	// The amounts are added as is if the swap fails because the excess is too small to be swapped,
+	// or the pool price is too far from the TWAP. Any other failure fails the compounding.
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: pool.GetId(), TokenOutDenom: tokenOutDenom}}
+	cacheCtx, write := ctx.CacheContext()
+	tokenOutAmount, err := k.poolmanagerKeeper.RouteExactAmountIn(cacheCtx, owner, route, tokenIn, tokenOutMinAmount)
+	if isSkippableCompoundSwapError(err) {
+		return amount0, amount1, nil
+	}
+	if err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	write()
+
+	if tokenIn.Denom == pool.GetToken0() {
+		return amount0.Sub(tokenIn.Amount), amount1.Add(tokenOutAmount), nil
+	}
+	return amount0.Add(tokenOutAmount), amount1.Sub(tokenIn.Amount), nil
+}
+
+// isSkippableCompoundSwapError returns whether the given error of the swap to the position ratio is an expected one,
+// after which the amounts are added as is:
+// - the swap gets less than the min amount out derived from the TWAP
+// - the amount in is too small to get any amount out, or to move the price
+func isSkippableCompoundSwapError(err error) bool {
+	return errors.As(err, &types.AmountLessThanMinError{}) ||
+		errors.As(err, &types.InvalidAmountCalculatedError{}) ||
+		errors.As(err, &types.SwapNoProgressError{})
+}
+
+// centredRange returns the range of the given width, rounded up to the tick spacing, that contains the current tick
+// with as many tick spacings below as above it.
+func centredRange(currentTick int64, width uint64, tickSpacing uint64) (int64, int64, error) {
+	numSpacings := (width + tickSpacing - 1) / tickSpacing
+	currentSpacingTick, err := math.RoundDownTickToSpacing(currentTick, int64(tickSpacing))
+	if err != nil {
+		return 0, 0, err
+	}
+	lowerTick := currentSpacingTick - int64(numSpacings/2*tickSpacing)
+	upperTick := lowerTick + int64(numSpacings*tickSpacing)
+	return lowerTick, upperTick, nil
+}
+
+// isPriceNearTwap returns whether the price of the given current sqrt price deviates from the given positive TWAP
+// price by at most ManagedPositionMaxTwapDeviation.
+func isPriceNearTwap(currentSqrtPrice osmomath.BigDec, twapPrice osmomath.Dec) bool {
+	currentPrice := currentSqrtPrice.Mul(currentSqrtPrice).Dec()
+	deviation := currentPrice.Sub(twapPrice).Abs().Quo(twapPrice)
+	return deviation.LTE(types.ManagedPositionMaxTwapDeviation)
+}
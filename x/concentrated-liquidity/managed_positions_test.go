@@ -0,0 +1,392 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestSetManagedPosition() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	querier := client.Querier{Keeper: *clKeeper}
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	owner := s.TestAccs[1]
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	// Only the owner can put the position in managed mode.
+	err := clKeeper.SetManagedPosition(s.Ctx, s.TestAccs[2], positionId, 0)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: positionId, Address: s.TestAccs[2].String()})
+
+	// The rebalance width must be a multiple of the tick spacing.
+	err = clKeeper.SetManagedPosition(s.Ctx, owner, positionId, DefaultTickSpacing+1)
+	s.Require().ErrorIs(err, types.InvalidRebalanceWidthError{PositionId: positionId, RebalanceWidth: DefaultTickSpacing + 1, TickSpacing: DefaultTickSpacing})
+
+	err = clKeeper.SetManagedPosition(s.Ctx, owner, positionId, 10*DefaultTickSpacing)
+	s.Require().NoError(err)
+
+	expectedManagedPosition := model.ManagedPosition{PositionId: positionId, RebalanceWidth: 10 * DefaultTickSpacing}
+	managedPosition, isManaged, err := clKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(isManaged)
+	s.Require().Equal(expectedManagedPosition, managedPosition)
+
+	res, err := querier.PositionById(s.Ctx, queryproto.PositionByIdRequest{PositionId: positionId})
+	s.Require().NoError(err)
+	s.Require().Equal(&expectedManagedPosition, res.Position.ManagedPosition)
+
+	// The managed mode moves to the position replacing it when added to.
+	s.FundAcc(owner, DefaultCoins)
+	newPositionId, _, _, err := clKeeper.AddToPosition(s.Ctx, owner, positionId, DefaultAmt0, DefaultAmt1, osmomath.ZeroInt(), osmomath.ZeroInt())
+	s.Require().NoError(err)
+	_, isManaged, err = clKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(isManaged)
+	managedPosition, isManaged, err = clKeeper.GetManagedPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	s.Require().True(isManaged)
+	s.Require().Equal(model.ManagedPosition{PositionId: newPositionId, RebalanceWidth: 10 * DefaultTickSpacing}, managedPosition)
+
+	// Only the owner can take the position out of managed mode.
+	err = clKeeper.UnsetManagedPosition(s.Ctx, s.TestAccs[2], newPositionId)
+	s.Require().ErrorIs(err, types.NotPositionOwnerError{PositionId: newPositionId, Address: s.TestAccs[2].String()})
+
+	err = clKeeper.UnsetManagedPosition(s.Ctx, owner, newPositionId)
+	s.Require().NoError(err)
+	res, err = querier.PositionById(s.Ctx, queryproto.PositionByIdRequest{PositionId: newPositionId})
+	s.Require().NoError(err)
+	s.Require().Nil(res.Position.ManagedPosition)
+
+	err = clKeeper.UnsetManagedPosition(s.Ctx, owner, newPositionId)
+	s.Require().ErrorIs(err, types.ManagedPositionNotFoundError{PositionId: newPositionId})
+
+	// Withdrawing the position in full takes it out of managed mode.
+	err = clKeeper.SetManagedPosition(s.Ctx, owner, newPositionId, 0)
+	s.Require().NoError(err)
+	position, err := clKeeper.GetPosition(s.Ctx, newPositionId)
+	s.Require().NoError(err)
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, newPositionId, position.Liquidity)
+	s.Require().NoError(err)
+	managedPositions, err := clKeeper.GetAllManagedPositions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(managedPositions)
+}
+
+func (s *KeeperTestSuite) TestCompoundManagedPositions() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner, rebalancedOwner := s.TestAccs[1], s.TestAccs[3]
+	inRangePositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	_, outOfRangePositionId := s.SetupPosition(pool.GetId(), rebalancedOwner, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))), DefaultExponentConsecutivePositionLowerTick, DefaultExponentConsecutivePositionUpperTick, false)
+	unmanagedPositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(clKeeper.SetManagedPosition(s.Ctx, owner, inRangePositionId, 0))
+	s.Require().NoError(clKeeper.SetManagedPosition(s.Ctx, rebalancedOwner, outOfRangePositionId, 10*DefaultTickSpacing))
+
+	// The positions are compounded against the TWAP of the pool.
+	s.advanceManagedPositionTwap()
+
+	// Nothing to compound without rewards, and the in range position is not rebalanced.
+	inRangePosition, err := clKeeper.GetPosition(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 1)
+	s.Require().NoError(err)
+	managedPosition, isManaged, err := clKeeper.GetManagedPosition(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	s.Require().True(isManaged)
+	s.Require().Equal(uint64(0), managedPosition.RebalanceWidth)
+
+	// The out of range position was re-centred around the current tick.
+	_, err = clKeeper.GetPosition(s.Ctx, outOfRangePositionId)
+	s.Require().Error(err)
+	managedPositions, err := clKeeper.GetAllManagedPositions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 2)
+	rebalancedPositionId := managedPositions[1].PositionId
+	s.Require().Greater(rebalancedPositionId, unmanagedPositionId)
+	rebalancedPosition, err := clKeeper.GetPosition(s.Ctx, rebalancedPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(rebalancedOwner.String(), rebalancedPosition.Address)
+	s.Require().Equal(10*int64(DefaultTickSpacing), rebalancedPosition.UpperTick-rebalancedPosition.LowerTick)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(pool.IsCurrentTickInRange(rebalancedPosition.LowerTick, rebalancedPosition.UpperTick))
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundManagedPosition, 1)
+
+	// Swaps in both directions accrue spread rewards to the positions.
+	for _, tokenIn := range []sdk.Coin{sdk.NewCoin(USDC, osmomath.NewInt(100_000_000)), sdk.NewCoin(ETH, osmomath.NewInt(20_000))} {
+		s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+		pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		tokenOutDenom := USDC
+		if tokenIn.Denom == USDC {
+			tokenOutDenom = ETH
+		}
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+	}
+	s.advanceManagedPositionTwap()
+
+	claimableSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableSpreadRewards.AmountOf(ETH).IsPositive())
+	s.Require().True(claimableSpreadRewards.AmountOf(USDC).IsPositive())
+	unmanagedSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, unmanagedPositionId)
+	s.Require().NoError(err)
+
+	balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 2)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundManagedPosition, 2)
+
+	// The rewards of the in range position were added to it in place, so that it keeps its join time.
+	managedPositions, err = clKeeper.GetAllManagedPositions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 2)
+	s.Require().Equal(inRangePositionId, managedPositions[0].PositionId)
+	compoundedPosition, err := clKeeper.GetPosition(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(inRangePosition.LowerTick, compoundedPosition.LowerTick)
+	s.Require().Equal(inRangePosition.UpperTick, compoundedPosition.UpperTick)
+	s.Require().Equal(inRangePosition.JoinTime, compoundedPosition.JoinTime)
+	s.Require().True(compoundedPosition.Liquidity.GT(inRangePosition.Liquidity))
+
+	// Only the spread rewards of the swap to the position ratio were accrued since.
+	compoundedSpreadRewards := claimableSpreadRewards
+	claimableSpreadRewards, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	s.requireSpreadRewardsLT(claimableSpreadRewards, compoundedSpreadRewards)
+
+	// The rewards were swapped to the position ratio, so that at most the dust of about a unit of ETH is left to the owner.
+	balanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	s.Require().True(balanceAfter.AmountOf(ETH).Sub(balanceBefore.AmountOf(ETH)).LTE(osmomath.NewInt(2)))
+	s.Require().True(balanceAfter.AmountOf(USDC).Sub(balanceBefore.AmountOf(USDC)).LTE(osmomath.NewInt(10_000)))
+
+	// The unmanaged position is untouched.
+	claimableSpreadRewards, err = clKeeper.GetClaimableSpreadRewards(s.Ctx, unmanagedPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(unmanagedSpreadRewards, claimableSpreadRewards)
+
+	// Other epochs don't compound.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtCompoundManagedPosition, 0)
+}
+
+func (s *KeeperTestSuite) TestCompoundManagedPositionsBatch() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	positionIds := make([]uint64, types.MaxManagedPositionsPerEpoch+5)
+	for i := range positionIds {
+		positionIds[i] = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+		s.Require().NoError(clKeeper.SetManagedPosition(s.Ctx, owner, positionIds[i], 0))
+	}
+
+	s.advanceManagedPositionTwap()
+
+	// Swaps in both directions accrue spread rewards to the positions.
+	for _, tokenIn := range []sdk.Coin{sdk.NewCoin(USDC, osmomath.NewInt(10_000_000_000)), sdk.NewCoin(ETH, osmomath.NewInt(2_000_000))} {
+		s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		tokenOutDenom := USDC
+		if tokenIn.Denom == USDC {
+			tokenOutDenom = ETH
+		}
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+	}
+	s.advanceManagedPositionTwap()
+
+	spreadRewardsBefore := make([]sdk.Coins, len(positionIds))
+	for i, positionId := range positionIds {
+		claimableSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().False(claimableSpreadRewards.IsZero())
+		spreadRewardsBefore[i] = claimableSpreadRewards
+	}
+	// requireCompounded requires that the rewards of the given positions were compounded, leaving them with only the
+	// spread rewards of the swaps to the position ratio.
+	requireCompounded := func(start, end int, compounded bool) {
+		for i := start; i < end; i++ {
+			claimableSpreadRewards, err := clKeeper.GetClaimableSpreadRewards(s.Ctx, positionIds[i])
+			s.Require().NoError(err)
+			if compounded {
+				s.requireSpreadRewardsLT(claimableSpreadRewards, spreadRewardsBefore[i])
+			} else {
+				s.Require().True(claimableSpreadRewards.IsAllGTE(spreadRewardsBefore[i]))
+			}
+		}
+	}
+
+	// The first epoch compounds the first MaxManagedPositionsPerEpoch positions and moves the cursor past them.
+	err := clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Uint64ToBigEndian(positionIds[types.MaxManagedPositionsPerEpoch-1]+1), store.Get(types.KeyManagedPositionsCompoundCursor))
+	requireCompounded(0, types.MaxManagedPositionsPerEpoch, true)
+	requireCompounded(types.MaxManagedPositionsPerEpoch, len(positionIds), false)
+
+	// The second epoch compounds the remaining positions and resets the cursor, as it reaches the last managed position.
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 2)
+	s.Require().NoError(err)
+	s.Require().Nil(store.Get(types.KeyManagedPositionsCompoundCursor))
+	requireCompounded(types.MaxManagedPositionsPerEpoch, len(positionIds), true)
+	managedPositions, err := clKeeper.GetAllManagedPositions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, len(positionIds))
+	for i, managedPosition := range managedPositions {
+		s.Require().Equal(positionIds[i], managedPosition.PositionId)
+	}
+}
+
+func (s *KeeperTestSuite) TestCompoundManagedPositionsTwap() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+
+	owner := s.TestAccs[1]
+	_, outOfRangePositionId := s.SetupPosition(pool.GetId(), owner, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(1_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))), DefaultExponentConsecutivePositionLowerTick, DefaultExponentConsecutivePositionUpperTick, false)
+	s.Require().NoError(clKeeper.SetManagedPosition(s.Ctx, owner, outOfRangePositionId, 10*DefaultTickSpacing))
+	s.advanceManagedPositionTwap()
+
+	// A swap moves the price of the pool from 5000 to about 4600, more than ManagedPositionMaxTwapDeviation away from the TWAP.
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(900_000))
+	s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+	pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[2], pool, tokenIn, USDC, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+
+	// The out of range position is not re-centred around the current tick.
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 1)
+	s.Require().NoError(err)
+	_, err = clKeeper.GetPosition(s.Ctx, outOfRangePositionId)
+	s.Require().NoError(err)
+	_, isManaged, err := clKeeper.GetManagedPosition(s.Ctx, outOfRangePositionId)
+	s.Require().NoError(err)
+	s.Require().True(isManaged)
+
+	// Once the TWAP has caught up with the price, the position is re-centred.
+	s.advanceManagedPositionTwap()
+	err = clKeeper.EpochHooks().AfterEpochEnd(s.Ctx, cl.ManagedPositionEpochIdentifier, 2)
+	s.Require().NoError(err)
+	_, err = clKeeper.GetPosition(s.Ctx, outOfRangePositionId)
+	s.Require().Error(err)
+	managedPositions, err := clKeeper.GetAllManagedPositions(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 1)
+	rebalancedPosition, err := clKeeper.GetPosition(s.Ctx, managedPositions[0].PositionId)
+	s.Require().NoError(err)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(pool.IsCurrentTickInRange(rebalancedPosition.LowerTick, rebalancedPosition.UpperTick))
+}
+
+func (s *KeeperTestSuite) TestSwapToPositionRatio() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.003"))
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+	position, err := clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	spotPrice := osmomath.NewDec(5000)
+
+	tests := map[string]struct {
+		amount0, amount1 osmomath.Int
+		fund             bool
+		twapPrice        osmomath.Dec
+		expectSwap       bool
+		expectErr        bool
+	}{
+		"excess of token0 is swapped": {
+			amount0:    osmomath.NewInt(10_000),
+			amount1:    osmomath.ZeroInt(),
+			fund:       true,
+			twapPrice:  spotPrice,
+			expectSwap: true,
+		},
+		"price too far from the TWAP: added as is": {
+			amount0:   osmomath.NewInt(10_000),
+			amount1:   osmomath.ZeroInt(),
+			fund:      true,
+			twapPrice: spotPrice.MulInt64(2),
+		},
+		"excess too small to get any amount out: added as is": {
+			amount0:   osmomath.ZeroInt(),
+			amount1:   osmomath.OneInt(),
+			fund:      true,
+			twapPrice: spotPrice,
+		},
+		"other swap failures fail": {
+			amount0:   osmomath.NewInt(10_000),
+			amount1:   osmomath.ZeroInt(),
+			twapPrice: spotPrice,
+			expectErr: true,
+		},
+	}
+	for name, tc := range tests {
+		s.Run(name, func() {
+			// The owner of the swapped amounts is only funded in the cases that expect it.
+			owner := s.TestAccs[2]
+			if tc.fund {
+				owner = s.TestAccs[1]
+				s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, tc.amount0), sdk.NewCoin(USDC, tc.amount1)))
+			}
+			ctx, _ := s.Ctx.CacheContext()
+
+			amount0, amount1, err := clKeeper.SwapToPositionRatio(ctx, owner, pool, position.LowerTick, position.UpperTick, position.Liquidity, tc.amount0, tc.amount1, tc.twapPrice)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			if tc.expectSwap {
+				s.Require().True(amount0.LT(tc.amount0))
+				s.Require().True(amount1.GT(tc.amount1))
+			} else {
+				s.Require().Equal(tc.amount0, amount0)
+				s.Require().Equal(tc.amount1, amount1)
+			}
+		})
+	}
+}
+
+// requireSpreadRewardsLT requires that the given spread rewards are lower than the given positive spread rewards
+// in every denom.
+func (s *KeeperTestSuite) requireSpreadRewardsLT(spreadRewards, spreadRewardsBefore sdk.Coins) {
+	for _, coin := range spreadRewardsBefore {
+		s.Require().True(spreadRewards.AmountOf(coin.Denom).LT(coin.Amount))
+	}
+}
+
+// advanceManagedPositionTwap records the current prices of the pools in their TWAP records, and moves the block time
+// forward so that the managed positions are compounded against these prices.
+func (s *KeeperTestSuite) advanceManagedPositionTwap() {
+	s.App.TwapKeeper.EndBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.ManagedPositionTwapWindow + time.Second))
+}
@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/concentratedliquidity/v1beta1/limit_order.proto
+
+// this is a legacy package that requires additional migration logic
+// in order to use the correct package. Decision made to use legacy package path
+// until clear steps for migration logic and the unknowns for state breaking are
+// investigated for changing proto package.
+
+package model
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LimitOrder is a resting order to sell token_in for the other pool token at
+// the price of a single tick spacing range. The order is backed by liquidity
+// in the range from lower_tick to upper_tick that is owned by no position.
+// An order selling token0 is filled when a swap crosses its upper tick and an
+// order selling token1 is filled when a swap crosses its lower tick. At that
+// point its liquidity is removed from the ticks so that it cannot be converted
+// back, and its token_out can be claimed by the owner.
+type LimitOrder struct {
+	OrderId   uint64                      `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner     string                      `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	PoolId    uint64                      `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64                       `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                       `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity" yaml:"liquidity"`
+	// token_in is the amount of tokens escrowed in the pool when the order was
+	// placed.
+	TokenIn types.Coin `protobuf:"bytes,7,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// filled is true once a swap crossed the order's range in full.
+	Filled bool `protobuf:"varint,8,opt,name=filled,proto3" json:"filled,omitempty" yaml:"filled"`
+	// token_out is the amount of tokens claimable by the owner once the order is
+	// filled. It is zero until then.
+	TokenOut  types.Coin `protobuf:"bytes,9,opt,name=token_out,json=tokenOut,proto3" json:"token_out" yaml:"token_out"`
+	PlaceTime time.Time  `protobuf:"bytes,10,opt,name=place_time,json=placeTime,proto3,stdtime" json:"place_time" yaml:"place_time"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86b1e750dd898c01, []int{0}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetFilled() bool {
+	if m != nil {
+		return m.Filled
+	}
+	return false
+}
+
+func (m *LimitOrder) GetTokenOut() types.Coin {
+	if m != nil {
+		return m.TokenOut
+	}
+	return types.Coin{}
+}
+
+func (m *LimitOrder) GetPlaceTime() time.Time {
+	if m != nil {
+		return m.PlaceTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*LimitOrder)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrder")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/limit_order.proto", fileDescriptor_86b1e750dd898c01)
+}
+
+var fileDescriptor_86b1e750dd898c01 = []byte{
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x9a, 0xc4, 0xcb, 0x57, 0x6a, 0x40, 0x98, 0x20, 0xec, 0xc8, 0x12, 0x28, 0x08,
+	0x75, 0xad, 0x06, 0xa4, 0x4a, 0x1c, 0x0d, 0x97, 0x48, 0x45, 0x45, 0x56, 0x91, 0x10, 0x42, 0x8a,
+	0xfc, 0xb1, 0x75, 0x57, 0xb1, 0x3d, 0xc6, 0x5e, 0xb7, 0xe4, 0x5f, 0xf4, 0x67, 0xf5, 0xd8, 0x23,
+	0xe2, 0x60, 0x50, 0xf2, 0x0f, 0x7c, 0xe4, 0x84, 0x76, 0xd7, 0x6e, 0x00, 0x21, 0xf5, 0x36, 0x3b,
+	0xf3, 0xde, 0x9b, 0xe7, 0xf1, 0x0c, 0xda, 0x87, 0x22, 0x81, 0x82, 0x16, 0x76, 0x00, 0x69, 0x40,
+	0x52, 0x96, 0x7b, 0x8c, 0x84, 0x31, 0xfd, 0x52, 0xd2, 0x90, 0xb2, 0xa5, 0x7d, 0xba, 0xe7, 0x13,
+	0xe6, 0xed, 0xd9, 0x31, 0x4d, 0x28, 0x9b, 0x43, 0x1e, 0x92, 0x1c, 0x67, 0x39, 0x30, 0xd0, 0x9e,
+	0x36, 0x44, 0xfc, 0x5f, 0x22, 0x6e, 0x88, 0x23, 0x33, 0x02, 0x88, 0x62, 0x62, 0x0b, 0x92, 0x5f,
+	0x1e, 0xdb, 0x8c, 0x26, 0xa4, 0x60, 0x5e, 0x92, 0x49, 0x9d, 0xd1, 0xfd, 0x08, 0x22, 0x10, 0xa1,
+	0xcd, 0xa3, 0x26, 0x6b, 0x04, 0x42, 0xde, 0xf6, 0xbd, 0x82, 0x5c, 0x99, 0x08, 0x80, 0xa6, 0xb2,
+	0x6e, 0xfd, 0xea, 0x22, 0x74, 0xc0, 0x3d, 0x1d, 0x72, 0x4b, 0x1a, 0x46, 0x03, 0xe1, 0x6d, 0x4e,
+	0x43, 0x5d, 0x19, 0x2b, 0x93, 0xae, 0x73, 0xaf, 0xae, 0xcc, 0xbb, 0x4b, 0x2f, 0x89, 0x5f, 0x5b,
+	0x6d, 0xc5, 0x72, 0xfb, 0x22, 0x9c, 0x85, 0xda, 0x33, 0xb4, 0x0d, 0x67, 0x29, 0xc9, 0xf5, 0x1b,
+	0x63, 0x65, 0xa2, 0x3a, 0xc3, 0xba, 0x32, 0x6f, 0x35, 0x60, 0x9e, 0xb6, 0x5c, 0x59, 0xd6, 0x5e,
+	0xa0, 0x7e, 0x06, 0x10, 0x73, 0xd9, 0x2d, 0x21, 0xab, 0xd5, 0x95, 0x79, 0x47, 0x22, 0x9b, 0x82,
+	0xe5, 0xf6, 0x78, 0x34, 0x0b, 0xb5, 0x57, 0x08, 0xc5, 0x70, 0x46, 0xf2, 0x39, 0xa3, 0xc1, 0x42,
+	0xef, 0x8e, 0x95, 0xc9, 0x96, 0xf3, 0xa0, 0xae, 0xcc, 0x1d, 0x89, 0xdf, 0xd4, 0x2c, 0x57, 0x15,
+	0x8f, 0x23, 0x1a, 0x2c, 0x38, 0xab, 0xcc, 0xb2, 0x96, 0xb5, 0xfd, 0x2f, 0x6b, 0x53, 0xb3, 0x5c,
+	0x55, 0x3c, 0x04, 0xeb, 0x03, 0x52, 0xaf, 0x66, 0xad, 0xf7, 0xc4, 0x47, 0xec, 0x5f, 0x54, 0x66,
+	0xe7, 0x7b, 0x65, 0x3e, 0x96, 0xa3, 0x2b, 0xc2, 0x05, 0xa6, 0x60, 0x27, 0x1e, 0x3b, 0xc1, 0x07,
+	0x24, 0xf2, 0x82, 0xe5, 0x5b, 0x12, 0xd4, 0x95, 0x39, 0x6c, 0xdc, 0xb4, 0x6c, 0x6e, 0xa6, 0x8d,
+	0xb5, 0x77, 0x68, 0xc0, 0x60, 0x41, 0xd2, 0x39, 0x4d, 0xf5, 0xfe, 0x58, 0x99, 0xdc, 0x9c, 0x3e,
+	0xc2, 0x52, 0x0e, 0xf3, 0x3f, 0xd1, 0xfe, 0x55, 0xfc, 0x06, 0x68, 0xea, 0x3c, 0xe4, 0x0d, 0x37,
+	0x63, 0x6e, 0x89, 0x96, 0xdb, 0x17, 0xe1, 0x2c, 0xd5, 0x9e, 0xa3, 0xde, 0x31, 0x8d, 0x63, 0x12,
+	0xea, 0x83, 0xb1, 0x32, 0x19, 0x38, 0x3b, 0x75, 0x65, 0xde, 0x96, 0x68, 0x99, 0xb7, 0xdc, 0x06,
+	0xa0, 0xbd, 0x47, 0xaa, 0x14, 0x80, 0x92, 0xe9, 0xea, 0x75, 0xad, 0xf5, 0xa6, 0xf5, 0xf0, 0xcf,
+	0xd6, 0x50, 0x32, 0xcb, 0x95, 0xfe, 0x0f, 0x4b, 0xa6, 0x7d, 0x44, 0x28, 0x8b, 0xbd, 0x80, 0xcc,
+	0xf9, 0xc6, 0xe9, 0x48, 0x48, 0x8e, 0xb0, 0x5c, 0x47, 0xdc, 0xae, 0x23, 0x3e, 0x6a, 0xd7, 0xd1,
+	0x79, 0xd2, 0x68, 0x36, 0x83, 0xdf, 0x70, 0xad, 0xf3, 0x1f, 0xa6, 0xe2, 0xaa, 0x22, 0xc1, 0xe1,
+	0xce, 0xe7, 0x8b, 0x95, 0xa1, 0x5c, 0xae, 0x0c, 0xe5, 0xe7, 0xca, 0x50, 0xce, 0xd7, 0x46, 0xe7,
+	0x72, 0x6d, 0x74, 0xbe, 0xad, 0x8d, 0xce, 0x27, 0x27, 0xa2, 0xec, 0xa4, 0xf4, 0x71, 0x00, 0x89,
+	0xdd, 0xdc, 0xc7, 0x6e, 0xec, 0xf9, 0x45, 0xfb, 0xb0, 0x4f, 0xa7, 0x53, 0xfb, 0xeb, 0x5f, 0xb7,
+	0xb6, 0xbb, 0x39, 0xb6, 0x04, 0x42, 0x12, 0xfb, 0x3d, 0xe1, 0xed, 0xe5, 0xef, 0x01, 0x00, 0x2c,
+	0xd0, 0xe1, 0x12, 0x9a, 0x03, 0x00, 0x00,
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PlaceTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlaceTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLimitOrder(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Filled {
+		i--
+		if m.Filled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLimitOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLimitOrder(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintLimitOrder(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLimitOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLimitOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.OrderId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLimitOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovLimitOrder(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovLimitOrder(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = m.TokenIn.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	if m.Filled {
+		n += 2
+	}
+	l = m.TokenOut.Size()
+	n += 1 + l + sovLimitOrder(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PlaceTime)
+	n += 1 + l + sovLimitOrder(uint64(l))
+	return n
+}
+
+func sovLimitOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLimitOrder(x uint64) (n int) {
+	return sovLimitOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PlaceTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLimitOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLimitOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLimitOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLimitOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLimitOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLimitOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLimitOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLimitOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLimitOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -124,6 +124,64 @@ func (m *Position) GetJoinTime() time.Time {
 	return time.Time{}
 }
 
+// ManagedPosition puts a position in managed mode. At the end of every day
+// epoch, the spread rewards and incentives of managed positions are swapped to
+// the ratio of the position and added back to it.
+type ManagedPosition struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	// rebalance_width is the width in ticks of the range that the position is
+	// re-centred on around the current tick when it is out of range. Zero
+	// disables re-centring.
+	RebalanceWidth uint64 `protobuf:"varint,2,opt,name=rebalance_width,json=rebalanceWidth,proto3" json:"rebalance_width,omitempty" yaml:"rebalance_width"`
+}
+
+func (m *ManagedPosition) Reset()         { *m = ManagedPosition{} }
+func (m *ManagedPosition) String() string { return proto.CompactTextString(m) }
+func (*ManagedPosition) ProtoMessage()    {}
+func (*ManagedPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1363e25aa5179fb1, []int{1}
+}
+func (m *ManagedPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManagedPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedPosition.Merge(m, src)
+}
+func (m *ManagedPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedPosition proto.InternalMessageInfo
+
+func (m *ManagedPosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *ManagedPosition) GetRebalanceWidth() uint64 {
+	if m != nil {
+		return m.RebalanceWidth
+	}
+	return 0
+}
+
 // FullPositionBreakdown returns:
 // - the position itself
 // - the amount the position translates in terms of asset0 and asset1
@@ -131,20 +189,22 @@ func (m *Position) GetJoinTime() time.Time {
 // - the amount of claimable incentives
 // - the amount of incentives that would be forfeited if the position was closed
 // now
+// - the managed mode of the position, if it is managed
 type FullPositionBreakdown struct {
-	Position               Position     `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
-	Asset0                 types.Coin   `protobuf:"bytes,2,opt,name=asset0,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"asset0"`
-	Asset1                 types.Coin   `protobuf:"bytes,3,opt,name=asset1,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"asset1"`
-	ClaimableSpreadRewards []types.Coin `protobuf:"bytes,4,rep,name=claimable_spread_rewards,json=claimableSpreadRewards,proto3" json:"claimable_spread_rewards" yaml:"claimable_spread_rewards"`
-	ClaimableIncentives    []types.Coin `protobuf:"bytes,5,rep,name=claimable_incentives,json=claimableIncentives,proto3" json:"claimable_incentives" yaml:"claimable_incentives"`
-	ForfeitedIncentives    []types.Coin `protobuf:"bytes,6,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
+	Position               Position         `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	Asset0                 types.Coin       `protobuf:"bytes,2,opt,name=asset0,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"asset0"`
+	Asset1                 types.Coin       `protobuf:"bytes,3,opt,name=asset1,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"asset1"`
+	ClaimableSpreadRewards []types.Coin     `protobuf:"bytes,4,rep,name=claimable_spread_rewards,json=claimableSpreadRewards,proto3" json:"claimable_spread_rewards" yaml:"claimable_spread_rewards"`
+	ClaimableIncentives    []types.Coin     `protobuf:"bytes,5,rep,name=claimable_incentives,json=claimableIncentives,proto3" json:"claimable_incentives" yaml:"claimable_incentives"`
+	ForfeitedIncentives    []types.Coin     `protobuf:"bytes,6,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
+	ManagedPosition        *ManagedPosition `protobuf:"bytes,7,opt,name=managed_position,json=managedPosition,proto3" json:"managed_position,omitempty" yaml:"managed_position"`
 }
 
 func (m *FullPositionBreakdown) Reset()         { *m = FullPositionBreakdown{} }
 func (m *FullPositionBreakdown) String() string { return proto.CompactTextString(m) }
 func (*FullPositionBreakdown) ProtoMessage()    {}
 func (*FullPositionBreakdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1363e25aa5179fb1, []int{1}
+	return fileDescriptor_1363e25aa5179fb1, []int{2}
 }
 func (m *FullPositionBreakdown) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,6 +275,13 @@ func (m *FullPositionBreakdown) GetForfeitedIncentives() []types.Coin {
 	return nil
 }
 
+func (m *FullPositionBreakdown) GetManagedPosition() *ManagedPosition {
+	if m != nil {
+		return m.ManagedPosition
+	}
+	return nil
+}
+
 type PositionWithPeriodLock struct {
 	Position Position          `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
 	Locks    types1.PeriodLock `protobuf:"bytes,2,opt,name=locks,proto3" json:"locks"`
@@ -224,7 +291,7 @@ func (m *PositionWithPeriodLock) Reset()         { *m = PositionWithPeriodLock{}
 func (m *PositionWithPeriodLock) String() string { return proto.CompactTextString(m) }
 func (*PositionWithPeriodLock) ProtoMessage()    {}
 func (*PositionWithPeriodLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1363e25aa5179fb1, []int{2}
+	return fileDescriptor_1363e25aa5179fb1, []int{3}
 }
 func (m *PositionWithPeriodLock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,6 +336,7 @@ func (m *PositionWithPeriodLock) GetLocks() types1.PeriodLock {
 
 func init() {
 	proto.RegisterType((*Position)(nil), "osmosis.concentratedliquidity.v1beta1.Position")
+	proto.RegisterType((*ManagedPosition)(nil), "osmosis.concentratedliquidity.v1beta1.ManagedPosition")
 	proto.RegisterType((*FullPositionBreakdown)(nil), "osmosis.concentratedliquidity.v1beta1.FullPositionBreakdown")
 	proto.RegisterType((*PositionWithPeriodLock)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithPeriodLock")
 }
@@ -278,52 +346,57 @@ func init() {
 }
 
 var fileDescriptor_1363e25aa5179fb1 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x18, 0x8d, 0xff, 0x5c, 0xda, 0x4e, 0xa4, 0x5f, 0xc8, 0x2d, 0x95, 0x9b, 0x42, 0x1c, 0x19, 0xa1,
-	0x46, 0x82, 0xda, 0x24, 0x20, 0x2a, 0xb1, 0x0c, 0x08, 0xa9, 0x52, 0x17, 0xc5, 0x14, 0x21, 0x21,
-	0xa4, 0x68, 0xec, 0x99, 0xa6, 0x43, 0xec, 0x8c, 0xeb, 0x99, 0xb4, 0x44, 0xe2, 0x09, 0x58, 0x75,
-	0xc5, 0x0b, 0xb0, 0xe3, 0x49, 0xba, 0xec, 0x12, 0xb1, 0x48, 0x51, 0xfb, 0x06, 0x7d, 0x02, 0x34,
-	0x17, 0xdb, 0xa1, 0x2a, 0xb7, 0x45, 0x57, 0xf6, 0x7c, 0xe7, 0x3b, 0xe7, 0xcc, 0xcc, 0xf9, 0x64,
-	0x83, 0x47, 0x94, 0xc5, 0x94, 0x11, 0xe6, 0x85, 0x74, 0x14, 0xe2, 0x11, 0x4f, 0x21, 0xc7, 0x28,
-	0x22, 0xfb, 0x63, 0x82, 0x08, 0x9f, 0x78, 0x07, 0x9d, 0x00, 0x73, 0xd8, 0xf1, 0x12, 0xca, 0x08,
-	0x27, 0x74, 0xe4, 0x26, 0x29, 0xe5, 0xd4, 0xbc, 0xab, 0x59, 0xee, 0x95, 0x2c, 0x57, 0xb3, 0x1a,
-	0x2b, 0xa1, 0xec, 0xeb, 0x4b, 0x92, 0xa7, 0x16, 0x4a, 0xa1, 0x61, 0x0f, 0x28, 0x1d, 0x44, 0xd8,
-	0x93, 0xab, 0x60, 0xbc, 0xeb, 0x71, 0x12, 0x63, 0xc6, 0x61, 0x9c, 0xe8, 0x86, 0xe6, 0xe5, 0x06,
-	0x34, 0x4e, 0x61, 0xb1, 0x85, 0xc6, 0xd2, 0x80, 0x0e, 0xa8, 0x12, 0x16, 0x6f, 0x19, 0x4b, 0x99,
-	0x78, 0x01, 0x64, 0x38, 0xdf, 0x7c, 0x48, 0x49, 0xc6, 0x5a, 0xc9, 0x8e, 0x1b, 0xd1, 0x70, 0x38,
-	0x4e, 0xe4, 0x43, 0x41, 0xce, 0xc7, 0x32, 0x98, 0xdf, 0xd6, 0xc7, 0x34, 0x37, 0x40, 0x3d, 0x3b,
-	0x72, 0x9f, 0x20, 0xcb, 0x68, 0x19, 0xed, 0x4a, 0x6f, 0xf9, 0x62, 0x6a, 0x9b, 0x13, 0x18, 0x47,
-	0x4f, 0x9c, 0x19, 0xd0, 0xf1, 0x41, 0xb6, 0xda, 0x44, 0xe6, 0x7d, 0x30, 0x07, 0x11, 0x4a, 0x31,
-	0x63, 0xd6, 0x7f, 0x2d, 0xa3, 0xbd, 0xd0, 0x33, 0x2f, 0xa6, 0xf6, 0xff, 0x8a, 0xa4, 0x01, 0xc7,
-	0xcf, 0x5a, 0xcc, 0x7b, 0x60, 0x2e, 0xa1, 0x34, 0x12, 0x16, 0x65, 0x69, 0x31, 0xd3, 0xad, 0x01,
-	0xc7, 0xaf, 0x89, 0xb7, 0x4d, 0x64, 0xde, 0x06, 0x20, 0xa2, 0x87, 0x38, 0xed, 0x73, 0x12, 0x0e,
-	0xad, 0x4a, 0xcb, 0x68, 0x97, 0xfd, 0x05, 0x59, 0xd9, 0x21, 0xe1, 0x50, 0xc0, 0xe3, 0x24, 0xc9,
-	0xe0, 0xaa, 0x82, 0x65, 0x45, 0xc2, 0xaf, 0xc0, 0xc2, 0x3b, 0x4a, 0x46, 0x7d, 0x71, 0xcf, 0x56,
-	0xad, 0x65, 0xb4, 0xeb, 0xdd, 0x86, 0xab, 0xee, 0xd8, 0xcd, 0xee, 0xd8, 0xdd, 0xc9, 0x42, 0xe8,
-	0xdd, 0x3a, 0x9e, 0xda, 0xa5, 0x8b, 0xa9, 0x7d, 0x43, 0x6d, 0x26, 0xa7, 0x3a, 0x47, 0xa7, 0xb6,
-	0xe1, 0xcf, 0x8b, 0xb5, 0x68, 0x16, 0xb2, 0x79, 0xee, 0xd6, 0x9c, 0x3c, 0xf1, 0x86, 0xa0, 0x7e,
-	0x9b, 0xda, 0xab, 0x2a, 0x0b, 0x86, 0x86, 0x2e, 0xa1, 0x5e, 0x0c, 0xf9, 0x9e, 0xbb, 0x85, 0x07,
-	0x30, 0x9c, 0x3c, 0xc3, 0x61, 0xa1, 0x9c, 0xb3, 0x1d, 0xbf, 0x50, 0x72, 0x3e, 0x55, 0xc1, 0xcd,
-	0xe7, 0xe3, 0x28, 0xca, 0x02, 0xe9, 0xa5, 0x18, 0x0e, 0x11, 0x3d, 0x1c, 0x99, 0x2f, 0xc0, 0x7c,
-	0x76, 0xdd, 0x32, 0x96, 0x7a, 0xd7, 0x73, 0xff, 0x6a, 0x1a, 0xdd, 0x5c, 0xab, 0x22, 0x36, 0xe8,
-	0xe7, 0x32, 0x66, 0x00, 0x6a, 0x90, 0x31, 0xcc, 0x1f, 0xc8, 0xc8, 0xea, 0xdd, 0x15, 0x57, 0x8f,
-	0xaa, 0x98, 0xa2, 0x9c, 0xfe, 0x94, 0x92, 0x51, 0xcf, 0x13, 0xd4, 0x2f, 0xa7, 0xf6, 0xda, 0x80,
-	0xf0, 0xbd, 0x71, 0xe0, 0x86, 0x34, 0xd6, 0x73, 0xad, 0x1f, 0xeb, 0x0c, 0x0d, 0x3d, 0x3e, 0x49,
-	0x30, 0x93, 0x04, 0x5f, 0x2b, 0xe7, 0x1e, 0x1d, 0x19, 0xf4, 0x75, 0x78, 0x74, 0xcc, 0x0f, 0xc0,
-	0x0a, 0x23, 0x48, 0x62, 0x18, 0x44, 0xb8, 0xcf, 0x92, 0x14, 0x43, 0xd4, 0x4f, 0xf1, 0x21, 0x4c,
-	0x11, 0xb3, 0x2a, 0xad, 0xf2, 0xef, 0x5d, 0xd7, 0x74, 0xe0, 0xb6, 0x8a, 0xe5, 0x57, 0x42, 0x8e,
-	0xbf, 0x9c, 0x43, 0x2f, 0x25, 0xe2, 0x2b, 0xc0, 0xdc, 0x07, 0x4b, 0x05, 0x89, 0xc8, 0x20, 0xc8,
-	0x01, 0x66, 0x56, 0xf5, 0x4f, 0xce, 0x77, 0xb4, 0xf3, 0xea, 0x65, 0xe7, 0x42, 0xc4, 0xf1, 0x17,
-	0xf3, 0xf2, 0x66, 0x5e, 0x15, 0x96, 0xbb, 0x34, 0xdd, 0xc5, 0x84, 0x63, 0x34, 0x6b, 0x59, 0xfb,
-	0x47, 0xcb, 0xab, 0x44, 0x1c, 0x7f, 0x31, 0x2f, 0x17, 0x96, 0xce, 0x67, 0x03, 0x2c, 0x67, 0x83,
-	0xf4, 0x9a, 0xf0, 0xbd, 0x6d, 0x9c, 0x12, 0x8a, 0xb6, 0x68, 0x38, 0xbc, 0x8e, 0xc9, 0x7c, 0x0c,
-	0xaa, 0xe2, 0x0b, 0xc5, 0xf4, 0x60, 0x36, 0x72, 0x3d, 0xf5, 0xf9, 0x72, 0x0b, 0x77, 0x4d, 0x55,
-	0xed, 0xbd, 0xb7, 0xc7, 0x67, 0x4d, 0xe3, 0xe4, 0xac, 0x69, 0x7c, 0x3f, 0x6b, 0x1a, 0x47, 0xe7,
-	0xcd, 0xd2, 0xc9, 0x79, 0xb3, 0xf4, 0xf5, 0xbc, 0x59, 0x7a, 0xd3, 0x9b, 0x19, 0x2a, 0x2d, 0xb6,
-	0x1e, 0xc1, 0x80, 0x65, 0x0b, 0xef, 0xa0, 0xdb, 0xf5, 0xde, 0xff, 0xf4, 0x37, 0x58, 0x2f, 0x7e,
-	0x07, 0x31, 0x45, 0x38, 0x0a, 0x6a, 0xf2, 0x7b, 0xf1, 0xf0, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa0, 0x7f, 0xfd, 0xc3, 0x3c, 0x06, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xeb, 0x44,
+	0x14, 0x8d, 0x49, 0x9a, 0xb4, 0x13, 0xa9, 0xad, 0xdc, 0x12, 0xdc, 0x14, 0xe2, 0xc8, 0x08, 0x35,
+	0x12, 0xd4, 0x26, 0x01, 0xb5, 0x12, 0x4b, 0x17, 0x21, 0x55, 0x2a, 0x52, 0x31, 0x45, 0x95, 0x10,
+	0x92, 0x35, 0xf6, 0x4c, 0x93, 0x21, 0xb6, 0xc7, 0xf5, 0x4c, 0x1a, 0x22, 0x21, 0x3e, 0x80, 0x0d,
+	0x5d, 0xf1, 0x03, 0xec, 0xf8, 0x92, 0x2e, 0xbb, 0x44, 0x2c, 0x52, 0xd4, 0xfe, 0x41, 0xbe, 0x00,
+	0x79, 0xc6, 0x76, 0xd2, 0xa8, 0xef, 0xbd, 0x3e, 0x3d, 0x75, 0x65, 0xcf, 0x3d, 0xf7, 0x9c, 0x73,
+	0x67, 0xee, 0x5c, 0x1b, 0x7c, 0x49, 0x59, 0x48, 0x19, 0x61, 0x96, 0x4f, 0x23, 0x1f, 0x47, 0x3c,
+	0x81, 0x1c, 0xa3, 0x80, 0x5c, 0x8e, 0x08, 0x22, 0x7c, 0x62, 0x5d, 0x75, 0x3d, 0xcc, 0x61, 0xd7,
+	0x8a, 0x29, 0x23, 0x9c, 0xd0, 0xc8, 0x8c, 0x13, 0xca, 0xa9, 0xfa, 0x49, 0xc6, 0x32, 0x9f, 0x64,
+	0x99, 0x19, 0xab, 0xb9, 0xe3, 0x8b, 0x3c, 0x57, 0x90, 0x2c, 0xb9, 0x90, 0x0a, 0x4d, 0xbd, 0x4f,
+	0x69, 0x3f, 0xc0, 0x96, 0x58, 0x79, 0xa3, 0x0b, 0x8b, 0x93, 0x10, 0x33, 0x0e, 0xc3, 0x38, 0x4b,
+	0x68, 0x2d, 0x27, 0xa0, 0x51, 0x02, 0xe7, 0x25, 0x34, 0xb7, 0xfb, 0xb4, 0x4f, 0xa5, 0x70, 0xfa,
+	0x96, 0xb3, 0xa4, 0x89, 0xe5, 0x41, 0x86, 0x8b, 0xe2, 0x7d, 0x4a, 0x72, 0xd6, 0x4e, 0xbe, 0xdd,
+	0x80, 0xfa, 0xc3, 0x51, 0x2c, 0x1e, 0x12, 0x32, 0x7e, 0x2f, 0x83, 0xd5, 0xd3, 0x6c, 0x9b, 0xea,
+	0x21, 0xa8, 0xe7, 0x5b, 0x76, 0x09, 0xd2, 0x94, 0xb6, 0xd2, 0xa9, 0xd8, 0x8d, 0xd9, 0x54, 0x57,
+	0x27, 0x30, 0x0c, 0xbe, 0x32, 0x16, 0x40, 0xc3, 0x01, 0xf9, 0xea, 0x18, 0xa9, 0x9f, 0x81, 0x1a,
+	0x44, 0x28, 0xc1, 0x8c, 0x69, 0xef, 0xb5, 0x95, 0xce, 0x9a, 0xad, 0xce, 0xa6, 0xfa, 0xba, 0x24,
+	0x65, 0x80, 0xe1, 0xe4, 0x29, 0xea, 0xa7, 0xa0, 0x16, 0x53, 0x1a, 0xa4, 0x16, 0x65, 0x61, 0xb1,
+	0x90, 0x9d, 0x01, 0x86, 0x53, 0x4d, 0xdf, 0x8e, 0x91, 0xfa, 0x11, 0x00, 0x01, 0x1d, 0xe3, 0xc4,
+	0xe5, 0xc4, 0x1f, 0x6a, 0x95, 0xb6, 0xd2, 0x29, 0x3b, 0x6b, 0x22, 0x72, 0x46, 0xfc, 0x61, 0x0a,
+	0x8f, 0xe2, 0x38, 0x87, 0x57, 0x24, 0x2c, 0x22, 0x02, 0xfe, 0x01, 0xac, 0xfd, 0x4c, 0x49, 0xe4,
+	0xa6, 0xe7, 0xac, 0x55, 0xdb, 0x4a, 0xa7, 0xde, 0x6b, 0x9a, 0xf2, 0x8c, 0xcd, 0xfc, 0x8c, 0xcd,
+	0xb3, 0xbc, 0x09, 0xf6, 0x87, 0x37, 0x53, 0xbd, 0x34, 0x9b, 0xea, 0x9b, 0xb2, 0x98, 0x82, 0x6a,
+	0x5c, 0xdf, 0xe9, 0x8a, 0xb3, 0x9a, 0xae, 0xd3, 0xe4, 0x54, 0xb6, 0xe8, 0xbb, 0x56, 0x13, 0x3b,
+	0x3e, 0x4c, 0xa9, 0xff, 0x4e, 0xf5, 0x5d, 0xd9, 0x0b, 0x86, 0x86, 0x26, 0xa1, 0x56, 0x08, 0xf9,
+	0xc0, 0x3c, 0xc1, 0x7d, 0xe8, 0x4f, 0xbe, 0xc6, 0xfe, 0x5c, 0xb9, 0x60, 0x1b, 0xce, 0x5c, 0xc9,
+	0xf8, 0x43, 0x01, 0x1b, 0xdf, 0xc2, 0x08, 0xf6, 0x31, 0x7a, 0xf7, 0x9e, 0x1c, 0x81, 0x8d, 0x04,
+	0x7b, 0x30, 0x80, 0x91, 0x8f, 0xdd, 0x31, 0x41, 0x7c, 0x20, 0x7a, 0x53, 0xb1, 0x9b, 0xb3, 0xa9,
+	0xde, 0x90, 0xe4, 0xa5, 0x04, 0xc3, 0x59, 0x2f, 0x22, 0xe7, 0x22, 0xf0, 0x67, 0x15, 0xbc, 0xff,
+	0xcd, 0x28, 0x08, 0xf2, 0x72, 0xec, 0x04, 0xc3, 0x21, 0xa2, 0xe3, 0x48, 0xfd, 0x0e, 0xac, 0xe6,
+	0x66, 0xa2, 0xa8, 0x7a, 0xcf, 0x32, 0x9f, 0x35, 0x1f, 0x66, 0xa1, 0x55, 0x49, 0x8f, 0xcc, 0x29,
+	0x64, 0x54, 0x0f, 0x54, 0x21, 0x63, 0x98, 0x7f, 0x2e, 0x0a, 0xad, 0xf7, 0x76, 0xcc, 0x6c, 0x78,
+	0xd2, 0x7b, 0x5d, 0xd0, 0x8f, 0x28, 0x89, 0x6c, 0x2b, 0xa5, 0xfe, 0x7d, 0xa7, 0xef, 0xf5, 0x09,
+	0x1f, 0x8c, 0x3c, 0xd3, 0xa7, 0x61, 0x36, 0x69, 0xd9, 0x63, 0x9f, 0xa1, 0xa1, 0xc5, 0x27, 0x31,
+	0x66, 0x82, 0xe0, 0x64, 0xca, 0x85, 0x47, 0x57, 0x2b, 0xbf, 0x90, 0x47, 0x57, 0xfd, 0x15, 0x68,
+	0x7e, 0x00, 0x49, 0x08, 0xbd, 0x00, 0xbb, 0x2c, 0x4e, 0x30, 0x44, 0x6e, 0x82, 0xc7, 0x30, 0x41,
+	0x4c, 0xab, 0xb4, 0xcb, 0xaf, 0x77, 0xdd, 0xcb, 0xae, 0xa0, 0x2e, 0x3b, 0xf4, 0x2a, 0x21, 0xc3,
+	0x69, 0x14, 0xd0, 0xf7, 0x02, 0x71, 0x24, 0xa0, 0x5e, 0x82, 0xed, 0x39, 0x89, 0x88, 0x46, 0x90,
+	0x2b, 0xcc, 0xb4, 0x95, 0x37, 0x39, 0x7f, 0x9c, 0x39, 0xef, 0x2e, 0x3b, 0xcf, 0x45, 0x0c, 0x67,
+	0xab, 0x08, 0x1f, 0x17, 0xd1, 0xd4, 0xf2, 0x82, 0x26, 0x17, 0x98, 0x70, 0x8c, 0x16, 0x2d, 0xab,
+	0x6f, 0x69, 0xf9, 0x94, 0x88, 0xe1, 0x6c, 0x15, 0xe1, 0x05, 0xcb, 0xdf, 0xc0, 0x66, 0x28, 0x27,
+	0xc5, 0x2d, 0xae, 0x61, 0x4d, 0x74, 0xf4, 0xe0, 0x99, 0xd7, 0x70, 0x69, 0xd0, 0xec, 0xdd, 0xd9,
+	0x54, 0xff, 0x40, 0xd6, 0xb1, 0xac, 0x6c, 0x38, 0x1b, 0xe1, 0xe3, 0x6c, 0xe3, 0x2f, 0x05, 0x34,
+	0xf2, 0xc5, 0x39, 0xe1, 0x83, 0x53, 0x9c, 0x10, 0x8a, 0x4e, 0xa8, 0x3f, 0x7c, 0x89, 0xc9, 0x38,
+	0x00, 0x2b, 0xe9, 0x37, 0x9b, 0x65, 0x83, 0xd1, 0x2c, 0xf4, 0xe4, 0x07, 0xdd, 0x9c, 0xbb, 0x67,
+	0x54, 0x99, 0x6e, 0xff, 0x74, 0x73, 0xdf, 0x52, 0x6e, 0xef, 0x5b, 0xca, 0x7f, 0xf7, 0x2d, 0xe5,
+	0xfa, 0xa1, 0x55, 0xba, 0x7d, 0x68, 0x95, 0xfe, 0x79, 0x68, 0x95, 0x7e, 0xb4, 0x17, 0x2e, 0x75,
+	0x26, 0xb6, 0x1f, 0x40, 0x8f, 0xe5, 0x0b, 0xeb, 0xaa, 0xd7, 0xb3, 0x7e, 0x79, 0xf4, 0x7f, 0xdc,
+	0x9f, 0xff, 0x20, 0x43, 0x8a, 0x70, 0xe0, 0x55, 0xc5, 0x17, 0xf4, 0x8b, 0xff, 0x07, 0x00, 0xc8,
+	0xcc, 0x25, 0x8f, 0x4e, 0x07, 0x00, 0x00,
 }
 
 func (m *Position) Marshal() (dAtA []byte, err error) {
@@ -394,6 +467,39 @@ func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManagedPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RebalanceWidth != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.RebalanceWidth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintPosition(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FullPositionBreakdown) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +520,18 @@ func (m *FullPositionBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ManagedPosition != nil {
+		{
+			size, err := m.ManagedPosition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPosition(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.ForfeitedIncentives) > 0 {
 		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -572,6 +690,21 @@ func (m *Position) Size() (n int) {
 	return n
 }
 
+func (m *ManagedPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovPosition(uint64(m.PositionId))
+	}
+	if m.RebalanceWidth != 0 {
+		n += 1 + sovPosition(uint64(m.RebalanceWidth))
+	}
+	return n
+}
+
 func (m *FullPositionBreakdown) Size() (n int) {
 	if m == nil {
 		return 0
@@ -602,6 +735,10 @@ func (m *FullPositionBreakdown) Size() (n int) {
 			n += 1 + l + sovPosition(uint64(l))
 		}
 	}
+	if m.ManagedPosition != nil {
+		l = m.ManagedPosition.Size()
+		n += 1 + l + sovPosition(uint64(l))
+	}
 	return n
 }
 
@@ -849,6 +986,94 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ManagedPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPosition
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceWidth", wireType)
+			}
+			m.RebalanceWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceWidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPosition(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FullPositionBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1079,6 +1304,42 @@ func (m *FullPositionBreakdown) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPosition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPosition
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPosition
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPosition
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedPosition == nil {
+				m.ManagedPosition = &ManagedPosition{}
+			}
+			if err := m.ManagedPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPosition(dAtA[iNdEx:])
@@ -255,6 +255,13 @@ func (k Keeper) GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress,
 			return err
 		}
 
+		var managedPosition *model.ManagedPosition
+		if mp, isManaged, err := k.GetManagedPosition(ctx, position.PositionId); err != nil {
+			return err
+		} else if isManaged {
+			managedPosition = &mp
+		}
+
 		// Append the position and underlying assets to the positions slice
 		fullPositions = append(fullPositions, model.FullPositionBreakdown{
 			Position:               position,
@@ -263,6 +270,7 @@ func (k Keeper) GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress,
 			ClaimableSpreadRewards: claimableSpreadRewards,
 			ClaimableIncentives:    claimableIncentives,
 			ForfeitedIncentives:    forfeitedIncentives,
+			ManagedPosition:        managedPosition,
 		})
 
 		return nil
@@ -345,6 +353,7 @@ func (k Keeper) SetPosition(ctx sdk.Context,
 // - owner-pool-id-position-id to position id
 // - pool-id-position-id to position id
 // - position-id to underlying lock id if such mapping exists
+// - position-id to managed mode if the position is managed
 // Returns error if:
 // - the position with the given id does not exist.
 // - the owner-pool-id-position-id to position id mapping does not exist.
@@ -377,6 +386,9 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 	}
 	store.Delete(addressPoolIdPositionIdKey)
 
+	// Take the position out of managed mode (if it is managed)
+	store.Delete(types.KeyManagedPosition(positionId))
+
 	// Remove the position ID to underlying lock ID mapping (if it exists)
 	positionIdLockKey := types.KeyPositionIdForLock(positionId)
 	if store.Has(positionIdLockKey) {
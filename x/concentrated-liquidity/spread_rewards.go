@@ -45,15 +45,18 @@ func (k Keeper) GetSpreadRewardAccumulator(ctx sdk.Context, poolId uint64) (*acc
 // - fails to prepare the accumulator for update.
 // - fails to update the position's accumulator.
 func (k Keeper) initOrUpdatePositionSpreadRewardAccumulator(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, positionId uint64, liquidityDelta osmomath.Dec) error {
+	return k.initOrUpdateSpreadRewardAccumulatorPosition(ctx, poolId, lowerTick, upperTick, positionId, types.KeySpreadRewardPositionAccumulator(positionId), liquidityDelta)
+}
+
+// initOrUpdateSpreadRewardAccumulatorPosition is the implementation of initOrUpdatePositionSpreadRewardAccumulator
+// for the accumulator position with the given key, which belongs to the position or limit order with the given id.
+func (k Keeper) initOrUpdateSpreadRewardAccumulatorPosition(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, id uint64, positionKey string, liquidityDelta osmomath.Dec) error {
 	// Get the spread reward accumulator for the position's pool.
 	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
 	if err != nil {
 		return err
 	}
 
-	// Get the key for the position's accumulator in the spread reward accumulator.
-	positionKey := types.KeySpreadRewardPositionAccumulator(positionId)
-
 	hasPosition := spreadRewardAccumulator.HasPosition(positionKey)
 
 	spreadRewardGrowthOutside, err := k.getSpreadRewardGrowthOutside(ctx, poolId, lowerTick, upperTick)
@@ -66,7 +69,7 @@ func (k Keeper) initOrUpdatePositionSpreadRewardAccumulator(ctx sdk.Context, poo
 
 	if !hasPosition {
 		if !liquidityDelta.IsPositive() {
-			return types.NonPositiveLiquidityForNewPositionError{LiquidityDelta: liquidityDelta, PositionId: positionId}
+			return types.NonPositiveLiquidityForNewPositionError{LiquidityDelta: liquidityDelta, PositionId: id}
 		}
 
 		// Initialize the position with the spread reward growth inside the tick range
@@ -57,6 +57,11 @@ type SwapState struct {
 	// global spread reward growth
 	globalSpreadRewardGrowth osmomath.Dec
 
+	// Ticks crossed by the swap, in the order they are crossed.
+	// Initialized to empty.
+	// Updated each time a tick is crossed.
+	crossedTicks []int64
+
 	swapStrategy swapstrategy.SwapStrategy
 }
 
@@ -66,6 +71,13 @@ type SwapResult struct {
 	AmountIn      osmomath.Int
 	AmountOut     osmomath.Int
 	SpreadRewards osmomath.Dec
+	// SpreadFactor is the spread factor charged by the swap, which is
+	// the effective spread factor if the pool is in dynamic spread factor mode.
+	SpreadFactor osmomath.Dec
+
+	// crossedTicks are the ticks crossed by the swap, whose limit orders
+	// are filled once the swap is applied to the pool.
+	crossedTicks []int64
 }
 
 // swapNoProgressLimit is the maximum number of iterations that can be performed
@@ -90,9 +102,10 @@ func newSwapState(specifiedAmount osmomath.Int, p types.ConcentratedPoolExtensio
 }
 
 type SwapDetails struct {
-	Sender   sdk.AccAddress
-	TokenIn  sdk.Coin
-	TokenOut sdk.Coin
+	Sender       sdk.AccAddress
+	TokenIn      sdk.Coin
+	TokenOut     sdk.Coin
+	SpreadFactor osmomath.Dec
 }
 
 type PoolUpdates struct {
@@ -258,7 +271,12 @@ func (k Keeper) swapOutAmtGivenIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
+	// Fill the limit orders whose range was fully crossed by the swap.
+	if err := k.fillLimitOrdersAtCrossedTicks(ctx, pool.GetId(), swapResult.crossedTicks, getZeroForOne(tokenIn.Denom, pool.GetToken0())); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -290,7 +308,12 @@ func (k *Keeper) swapInAmtGivenOut(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut}, poolUpdates, swapResult.SpreadRewards); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, SwapDetails{sender, tokenIn, tokenOut, swapResult.SpreadFactor}, poolUpdates, swapResult.SpreadRewards); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
+	}
+
+	// Fill the limit orders whose range was fully crossed by the swap.
+	if err := k.fillLimitOrdersAtCrossedTicks(ctx, pool.GetId(), swapResult.crossedTicks, getZeroForOne(tokenIn.Denom, pool.GetToken0())); err != nil {
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
@@ -388,7 +411,7 @@ func (k Keeper) computeOutAmtGivenIn(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInMin.Denom, priceLimit)
+	swapStrategy, sqrtPriceLimit, effectiveSpreadFactor, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInMin.Denom, priceLimit)
 	if err != nil {
 		return SwapResult{}, PoolUpdates{}, err
 	}
@@ -496,6 +519,8 @@ func (k Keeper) computeOutAmtGivenIn(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		SpreadFactor:  effectiveSpreadFactor,
+		crossedTicks:  swapState.crossedTicks,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -516,7 +541,7 @@ func (k Keeper) computeInAmtGivenOut(
 		return SwapResult{}, PoolUpdates{}, err
 	}
 
-	swapStrategy, sqrtPriceLimit, err := k.setupSwapStrategy(p, spreadFactor, tokenInDenom, priceLimit)
+	swapStrategy, sqrtPriceLimit, effectiveSpreadFactor, err := k.setupSwapStrategy(ctx, p, spreadFactor, tokenInDenom, priceLimit)
 	if err != nil {
 		return SwapResult{}, PoolUpdates{}, err
 	}
@@ -618,6 +643,8 @@ func (k Keeper) computeInAmtGivenOut(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		SpreadFactor:  effectiveSpreadFactor,
+		crossedTicks:  swapState.crossedTicks,
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -662,6 +689,10 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	// Update the swapState's tick with the tick we retrieved liquidity from
 	swapState.tick = strategy.UpdateTickAfterCrossing(nextInitializedTick)
 
+	// Record the crossed tick so that the limit orders whose range was fully crossed are filled once the swap
+	// is applied. Their liquidity was just removed from the swap state's liquidity by crossing the tick.
+	swapState.crossedTicks = append(swapState.crossedTicks, nextInitializedTick)
+
 	return swapState, nil
 }
 
@@ -736,7 +767,7 @@ func (k Keeper) updatePoolForSwap(
 
 	// TODO: move this to poolmanager and remove from here.
 	// Also, remove from gamm.
-	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut})
+	events.EmitSwapEvent(ctx, swapDetails.Sender, pool.GetId(), sdk.Coins{swapDetails.TokenIn}, sdk.Coins{swapDetails.TokenOut}, swapDetails.SpreadFactor)
 
 	return err
 }
@@ -761,13 +792,22 @@ func checkDenomValidity(inDenom, outDenom, asset0, asset1 string) error {
 	return nil
 }
 
-func (k Keeper) setupSwapStrategy(p types.ConcentratedPoolExtension, spreadFactor osmomath.Dec, tokenInDenom string, priceLimit osmomath.BigDec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit osmomath.BigDec, err error) {
+// setupSwapStrategy returns the swap strategy for swapping tokenInDenom in the given pool up to priceLimit,
+// along with the spread factor it charges.
+// If the pool is in dynamic spread factor mode, the strategy charges the effective spread factor derived from
+// the given spread factor instead of the given spread factor.
+func (k Keeper) setupSwapStrategy(ctx sdk.Context, p types.ConcentratedPoolExtension, spreadFactor osmomath.Dec, tokenInDenom string, priceLimit osmomath.BigDec) (strategy swapstrategy.SwapStrategy, sqrtPriceLimit osmomath.BigDec, effectiveSpreadFactor osmomath.Dec, err error) {
+	spreadFactor, _, err = k.getEffectiveSpreadFactor(ctx, p, spreadFactor)
+	if err != nil {
+		return strategy, osmomath.BigDec{}, osmomath.Dec{}, err
+	}
+
 	zeroForOne := getZeroForOne(tokenInDenom, p.GetToken0())
 
 	// take provided price limit and turn this into a sqrt price limit since formulas use sqrtPrice
 	sqrtPriceLimit, err = swapstrategy.GetSqrtPriceLimit(priceLimit, zeroForOne)
 	if err != nil {
-		return strategy, osmomath.BigDec{}, types.SqrtRootCalculationError{SqrtPriceLimit: sqrtPriceLimit}
+		return strategy, osmomath.BigDec{}, osmomath.Dec{}, types.SqrtRootCalculationError{SqrtPriceLimit: sqrtPriceLimit}
 	}
 
 	// set the swap strategy
@@ -776,10 +816,10 @@ func (k Keeper) setupSwapStrategy(p types.ConcentratedPoolExtension, spreadFacto
 	// get current sqrt price from pool
 	curSqrtPrice := p.GetCurrentSqrtPrice()
 	if err := swapStrategy.ValidateSqrtPrice(sqrtPriceLimit, curSqrtPrice); err != nil {
-		return strategy, osmomath.BigDec{}, err
+		return strategy, osmomath.BigDec{}, osmomath.Dec{}, err
 	}
 
-	return swapStrategy, sqrtPriceLimit, nil
+	return swapStrategy, sqrtPriceLimit, spreadFactor, nil
 }
 
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
@@ -862,7 +902,7 @@ func (k Keeper) ComputeMaxInAmtGivenMaxTicksCrossed(
 	}
 
 	// Setup the swap strategy
-	swapStrategy, _, err := k.setupSwapStrategy(p, p.GetSpreadFactor(cacheCtx), tokenInDenom, osmomath.ZeroBigDec())
+	swapStrategy, _, _, err := k.setupSwapStrategy(cacheCtx, p, p.GetSpreadFactor(cacheCtx), tokenInDenom, osmomath.ZeroBigDec())
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
@@ -28,6 +28,13 @@ const (
 	BaseGasFeeForNewIncentive           = 10_000
 	BaseGasFeeForInitializingTick       = 10_000
 	BaseGasFeeForTransferPosition       = 10_000
+	// MaxLimitOrdersPerTick is the maximum number of unfilled limit orders of a pool that are
+	// filled by crossing the same tick. It bounds the work of the swaps crossing the tick,
+	// since each of these orders is filled individually.
+	MaxLimitOrdersPerTick = 10
+	// MaxManagedPositionsPerEpoch is the maximum number of managed positions compounded at the end
+	// of a managed position epoch. The other managed positions are compounded in the following epochs.
+	MaxManagedPositionsPerEpoch = 100
 )
 
 var (
@@ -56,6 +63,14 @@ var (
 		osmomath.MustNewDecFromStr("0.005"),  // 0.5%
 	}
 	DefaultBalancerSharesDiscount = osmomath.MustNewDecFromStr("0.05")
+	// MinLimitOrderLiquidity is the minimum liquidity of a limit order, so that dust orders
+	// cannot take the limited room of a tick.
+	MinLimitOrderLiquidity = osmomath.NewDec(1_000_000_000)
+	// ManagedPositionTwapWindow is the window of the TWAP against which the managed positions are compounded.
+	ManagedPositionTwapWindow = time.Hour
+	// ManagedPositionMaxTwapDeviation is the maximum deviation of the price obtained when swapping the rewards of a
+	// managed position, and of the current price when re-centring it, from the ManagedPositionTwapWindow TWAP.
+	ManagedPositionMaxTwapDeviation = osmomath.MustNewDecFromStr("0.05")
 	// By default, we only authorize one nanosecond (one block) uptime as an option
 	DefaultAuthorizedUptimes                = []time.Duration{time.Nanosecond}
 	DefaultUnrestrictedPoolCreatorWhitelist = []string{}
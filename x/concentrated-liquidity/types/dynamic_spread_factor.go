@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Validate returns an error if the dynamic spread factor config is invalid.
+// The min and max spread factors must be in the [0, 1) range with min <= max,
+// the volatility multiplier must not be negative and the volatility window must be positive.
+func (c DynamicSpreadFactorConfig) Validate() error {
+	if c.PoolId == 0 {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "pool id must be positive"}
+	}
+	if c.MinSpreadFactor.IsNil() || c.MinSpreadFactor.IsNegative() || c.MinSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidSpreadFactorError{ActualSpreadFactor: c.MinSpreadFactor}
+	}
+	if c.MaxSpreadFactor.IsNil() || c.MaxSpreadFactor.IsNegative() || c.MaxSpreadFactor.GTE(osmomath.OneDec()) {
+		return InvalidSpreadFactorError{ActualSpreadFactor: c.MaxSpreadFactor}
+	}
+	if c.MinSpreadFactor.GT(c.MaxSpreadFactor) {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "min spread factor must not be greater than max spread factor"}
+	}
+	if c.VolatilityMultiplier.IsNil() || c.VolatilityMultiplier.IsNegative() {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "volatility multiplier must not be negative"}
+	}
+	if c.VolatilityWindow <= 0 {
+		return InvalidDynamicSpreadFactorConfigError{PoolId: c.PoolId, Reason: "volatility window must be positive"}
+	}
+	return nil
+}
+
+// ClampSpreadFactor returns the given spread factor clamped to the [min, max] range of the config.
+func (c DynamicSpreadFactorConfig) ClampSpreadFactor(spreadFactor osmomath.Dec) osmomath.Dec {
+	if spreadFactor.LT(c.MinSpreadFactor) {
+		return c.MinSpreadFactor
+	}
+	if spreadFactor.GT(c.MaxSpreadFactor) {
+		return c.MaxSpreadFactor
+	}
+	return spreadFactor
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
+)
+
+func TestDynamicSpreadFactorConfigValidate(t *testing.T) {
+	validConfig := func() types.DynamicSpreadFactorConfig {
+		return types.DynamicSpreadFactorConfig{
+			PoolId:               1,
+			MinSpreadFactor:      osmomath.MustNewDecFromStr("0.001"),
+			MaxSpreadFactor:      osmomath.MustNewDecFromStr("0.01"),
+			VolatilityMultiplier: osmomath.MustNewDecFromStr("0.5"),
+			VolatilityWindow:     time.Hour,
+		}
+	}
+
+	tests := map[string]struct {
+		modify    func(*types.DynamicSpreadFactorConfig)
+		expectErr bool
+	}{
+		"valid": {
+			modify: func(c *types.DynamicSpreadFactorConfig) {},
+		},
+		"valid: min equals max": {
+			modify: func(c *types.DynamicSpreadFactorConfig) { c.MaxSpreadFactor = c.MinSpreadFactor },
+		},
+		"valid: zero multiplier": {
+			modify: func(c *types.DynamicSpreadFactorConfig) { c.VolatilityMultiplier = osmomath.ZeroDec() },
+		},
+		"zero pool id": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.PoolId = 0 },
+			expectErr: true,
+		},
+		"negative min spread factor": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.MinSpreadFactor = osmomath.MustNewDecFromStr("-0.001") },
+			expectErr: true,
+		},
+		"max spread factor of one": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.MaxSpreadFactor = osmomath.OneDec() },
+			expectErr: true,
+		},
+		"min greater than max": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.MinSpreadFactor = osmomath.MustNewDecFromStr("0.02") },
+			expectErr: true,
+		},
+		"nil multiplier": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.VolatilityMultiplier = osmomath.Dec{} },
+			expectErr: true,
+		},
+		"negative multiplier": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.VolatilityMultiplier = osmomath.MustNewDecFromStr("-1") },
+			expectErr: true,
+		},
+		"zero window": {
+			modify:    func(c *types.DynamicSpreadFactorConfig) { c.VolatilityWindow = 0 },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := validConfig()
+			tc.modify(&config)
+
+			err := config.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// A valid config is also a valid proposal, unless its pool is disabled at the same time.
+			proposal := types.NewSetDynamicSpreadFactorProposal("title", "description", []types.DynamicSpreadFactorConfig{config}, []uint64{2})
+			require.NoError(t, proposal.ValidateBasic())
+			proposal = types.NewSetDynamicSpreadFactorProposal("title", "description", []types.DynamicSpreadFactorConfig{config}, []uint64{config.PoolId})
+			require.Error(t, proposal.ValidateBasic())
+		})
+	}
+}
+
+func TestDynamicSpreadFactorConfigClampSpreadFactor(t *testing.T) {
+	config := types.DynamicSpreadFactorConfig{
+		MinSpreadFactor: osmomath.MustNewDecFromStr("0.001"),
+		MaxSpreadFactor: osmomath.MustNewDecFromStr("0.01"),
+	}
+
+	require.Equal(t, config.MinSpreadFactor, config.ClampSpreadFactor(osmomath.ZeroDec()))
+	require.Equal(t, osmomath.MustNewDecFromStr("0.005"), config.ClampSpreadFactor(osmomath.MustNewDecFromStr("0.005")))
+	require.Equal(t, config.MaxSpreadFactor, config.ClampSpreadFactor(osmomath.MustNewDecFromStr("0.5")))
+}
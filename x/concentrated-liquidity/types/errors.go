@@ -19,6 +19,7 @@ var (
 	ErrZeroLiquidity                      = errors.New("liquidity cannot be 0")
 	ErrNextTickInfoNil                    = errors.New("next tick info cannot be nil")
 	ErrPoolNil                            = errors.New("pool cannot be nil")
+	ErrLimitOrderPoolNotInitialized       = errors.New("limit orders can only be placed in pools with at least one position")
 )
 
 // x/concentrated-liquidity module sentinel errors.
@@ -930,3 +931,106 @@ type InvalidActionPrefixError struct {
 func (e InvalidActionPrefixError) Error() string {
 	return fmt.Sprintf("invalid action prefix (%s). Valid actions: %s", e.ActionPrefix, e.ValidActions)
 }
+
+type NotLimitOrderOwnerError struct {
+	OrderId uint64
+	Address string
+}
+
+func (e NotLimitOrderOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of limit order ID (%d)", e.Address, e.OrderId)
+}
+
+type LimitOrderNotFoundError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFoundError) Error() string {
+	return fmt.Sprintf("limit order with ID (%d) not found", e.OrderId)
+}
+
+type LimitOrderNotFilledError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderNotFilledError) Error() string {
+	return fmt.Sprintf("limit order with ID (%d) is not filled yet", e.OrderId)
+}
+
+type LimitOrderAlreadyFilledError struct {
+	OrderId uint64
+}
+
+func (e LimitOrderAlreadyFilledError) Error() string {
+	return fmt.Sprintf("limit order with ID (%d) is already filled, claim it instead", e.OrderId)
+}
+
+type LimitOrderWrongSideOfPriceError struct {
+	PoolId      uint64
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+	IsTokenZero bool
+}
+
+func (e LimitOrderWrongSideOfPriceError) Error() string {
+	if e.IsTokenZero {
+		return fmt.Sprintf("limit order selling token0 in pool %d must have its lower tick (%d) above the current tick (%d)", e.PoolId, e.LowerTick, e.CurrentTick)
+	}
+	return fmt.Sprintf("limit order selling token1 in pool %d must have its upper tick (%d) at or below the current tick (%d)", e.PoolId, e.UpperTick, e.CurrentTick)
+}
+
+type LimitOrderLiquidityTooLowError struct {
+	Liquidity    osmomath.Dec
+	MinLiquidity osmomath.Dec
+}
+
+func (e LimitOrderLiquidityTooLowError) Error() string {
+	return fmt.Sprintf("limit order liquidity (%s) is lower than the minimum (%s)", e.Liquidity, e.MinLiquidity)
+}
+
+type LimitOrderTickFullError struct {
+	PoolId         uint64
+	FillTick       int64
+	MaxLimitOrders int
+}
+
+func (e LimitOrderTickFullError) Error() string {
+	return fmt.Sprintf("pool %d already has the maximum number of unfilled limit orders (%d) filled by crossing tick %d", e.PoolId, e.MaxLimitOrders, e.FillTick)
+}
+
+type InvalidDynamicSpreadFactorConfigError struct {
+	PoolId uint64
+	Reason string
+}
+
+func (e InvalidDynamicSpreadFactorConfigError) Error() string {
+	return fmt.Sprintf("invalid dynamic spread factor config for pool (%d): %s", e.PoolId, e.Reason)
+}
+
+type InvalidRebalanceWidthError struct {
+	PositionId     uint64
+	RebalanceWidth uint64
+	TickSpacing    uint64
+}
+
+func (e InvalidRebalanceWidthError) Error() string {
+	return fmt.Sprintf("rebalance width (%d) of position (%d) must be a multiple of the pool tick spacing (%d)", e.RebalanceWidth, e.PositionId, e.TickSpacing)
+}
+
+type ManagedPositionNotFoundError struct {
+	PositionId uint64
+}
+
+func (e ManagedPositionNotFoundError) Error() string {
+	return fmt.Sprintf("position (%d) is not managed", e.PositionId)
+}
+
+type NonPositiveTwapPriceError struct {
+	PoolId    uint64
+	TwapPrice osmomath.Dec
+}
+
+func (e NonPositiveTwapPriceError) Error() string {
+	return fmt.Sprintf("TWAP price (%s) of pool (%d) must be positive", e.TwapPrice, e.PoolId)
+}
@@ -13,6 +13,12 @@ const (
 	TypeEvtMoveRewards               = "move_rewards"
 	TypeEvtCrossTick                 = "cross_tick"
 	TypeEvtTransferPositions         = "transfer_positions"
+	TypeEvtPlaceLimitOrder           = "place_limit_order"
+	TypeEvtFillLimitOrder            = "fill_limit_order"
+	TypeEvtClaimLimitOrder           = "claim_limit_order"
+	TypeEvtCancelLimitOrder          = "cancel_limit_order"
+	TypeEvtCollectLimitOrderRewards  = "collect_limit_order_rewards"
+	TypeEvtCompoundManagedPosition   = "compound_managed_position"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -48,4 +54,9 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyOrderId                                            = "order_id"
+	AttributeKeyTokenIn                                            = "token_in"
+	AttributeKeyTokenOut                                           = "token_out"
+	AttributeKeySpreadRewards                                      = "spread_rewards"
+	AttributeKeyIncentives                                         = "incentives"
 )
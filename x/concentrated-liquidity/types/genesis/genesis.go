@@ -11,6 +11,7 @@ func DefaultGenesis() *GenesisState {
 		Params:                types.DefaultParams(),
 		NextPositionId:        1,
 		NextIncentiveRecordId: 1,
+		NextLimitOrderId:      1,
 	}
 }
 
@@ -25,5 +26,10 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, config := range gs.DynamicSpreadFactorConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
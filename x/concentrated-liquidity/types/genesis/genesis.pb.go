@@ -243,22 +243,88 @@ func (m *PositionData) GetUptimeAccumRecords() []accum.Record {
 	return nil
 }
 
+// LimitOrderData represents a limit order along with the records of its
+// spread reward and uptime accumulator positions for genesis state.
+type LimitOrderData struct {
+	LimitOrder              model.LimitOrder `protobuf:"bytes,1,opt,name=limit_order,json=limitOrder,proto3" json:"limit_order"`
+	SpreadRewardAccumRecord accum.Record     `protobuf:"bytes,2,opt,name=spread_reward_accum_record,json=spreadRewardAccumRecord,proto3" json:"spread_reward_accum_record"`
+	UptimeAccumRecords      []accum.Record   `protobuf:"bytes,3,rep,name=uptime_accum_records,json=uptimeAccumRecords,proto3" json:"uptime_accum_records"`
+}
+
+func (m *LimitOrderData) Reset()         { *m = LimitOrderData{} }
+func (m *LimitOrderData) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderData) ProtoMessage()    {}
+func (*LimitOrderData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+}
+func (m *LimitOrderData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderData.Merge(m, src)
+}
+func (m *LimitOrderData) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderData proto.InternalMessageInfo
+
+func (m *LimitOrderData) GetLimitOrder() model.LimitOrder {
+	if m != nil {
+		return m.LimitOrder
+	}
+	return model.LimitOrder{}
+}
+
+func (m *LimitOrderData) GetSpreadRewardAccumRecord() accum.Record {
+	if m != nil {
+		return m.SpreadRewardAccumRecord
+	}
+	return accum.Record{}
+}
+
+func (m *LimitOrderData) GetUptimeAccumRecords() []accum.Record {
+	if m != nil {
+		return m.UptimeAccumRecords
+	}
+	return nil
+}
+
 // GenesisState defines the concentrated liquidity module's genesis state.
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pool data containing serialized pool struct and ticks.
-	PoolData              []PoolData     `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
-	PositionData          []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
-	NextPositionId        uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
-	NextIncentiveRecordId uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	PoolData                   []PoolData                         `protobuf:"bytes,2,rep,name=pool_data,json=poolData,proto3" json:"pool_data"`
+	PositionData               []PositionData                     `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
+	NextPositionId             uint64                             `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	NextIncentiveRecordId      uint64                             `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	LimitOrderData             []LimitOrderData                   `protobuf:"bytes,6,rep,name=limit_order_data,json=limitOrderData,proto3" json:"limit_order_data"`
+	NextLimitOrderId           uint64                             `protobuf:"varint,7,opt,name=next_limit_order_id,json=nextLimitOrderId,proto3" json:"next_limit_order_id,omitempty" yaml:"next_limit_order_id"`
+	DynamicSpreadFactorConfigs []types1.DynamicSpreadFactorConfig `protobuf:"bytes,8,rep,name=dynamic_spread_factor_configs,json=dynamicSpreadFactorConfigs,proto3" json:"dynamic_spread_factor_configs"`
+	ManagedPositions           []model.ManagedPosition            `protobuf:"bytes,9,rep,name=managed_positions,json=managedPositions,proto3" json:"managed_positions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{3}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,6 +388,34 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetLimitOrderData() []LimitOrderData {
+	if m != nil {
+		return m.LimitOrderData
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextLimitOrderId() uint64 {
+	if m != nil {
+		return m.NextLimitOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDynamicSpreadFactorConfigs() []types1.DynamicSpreadFactorConfig {
+	if m != nil {
+		return m.DynamicSpreadFactorConfigs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetManagedPositions() []model.ManagedPosition {
+	if m != nil {
+		return m.ManagedPositions
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -332,7 +426,7 @@ func (m *AccumObject) Reset()         { *m = AccumObject{} }
 func (m *AccumObject) String() string { return proto.CompactTextString(m) }
 func (*AccumObject) ProtoMessage()    {}
 func (*AccumObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cdf50d18c43a7c5, []int{4}
+	return fileDescriptor_4cdf50d18c43a7c5, []int{5}
 }
 func (m *AccumObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,6 +473,7 @@ func init() {
 	proto.RegisterType((*FullTick)(nil), "osmosis.concentratedliquidity.v1beta1.FullTick")
 	proto.RegisterType((*PoolData)(nil), "osmosis.concentratedliquidity.v1beta1.PoolData")
 	proto.RegisterType((*PositionData)(nil), "osmosis.concentratedliquidity.v1beta1.PositionData")
+	proto.RegisterType((*LimitOrderData)(nil), "osmosis.concentratedliquidity.v1beta1.LimitOrderData")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.concentratedliquidity.v1beta1.GenesisState")
 	proto.RegisterType((*AccumObject)(nil), "osmosis.concentratedliquidity.v1beta1.AccumObject")
 }
@@ -388,62 +483,73 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xee, 0x34, 0x69, 0x69, 0x9d, 0xec, 0xd2, 0xb5, 0xba, 0x74, 0xb6, 0x68, 0x93, 0xe0, 0x55,
-	0xa5, 0x02, 0xea, 0x8c, 0x36, 0x5d, 0x71, 0x40, 0x5c, 0x3a, 0xcb, 0x87, 0x02, 0x12, 0x54, 0x66,
-	0xb9, 0xf0, 0x15, 0x9c, 0xb1, 0x1b, 0xcc, 0xce, 0x8c, 0x87, 0xb1, 0x53, 0x9a, 0x2b, 0xbf, 0x00,
-	0x71, 0xe2, 0x47, 0x70, 0x44, 0xe2, 0xcc, 0x6d, 0x85, 0x38, 0xec, 0x91, 0x53, 0x84, 0xda, 0x7f,
-	0x90, 0x5f, 0x80, 0xc6, 0x1f, 0xc9, 0xa4, 0xb4, 0x90, 0x72, 0xb3, 0xf3, 0xbc, 0xcf, 0xf3, 0x3e,
-	0xf6, 0xfb, 0xbe, 0x9e, 0x80, 0x43, 0x21, 0x53, 0x21, 0xb9, 0x0c, 0x63, 0x91, 0xc5, 0x2c, 0x53,
-	0x05, 0x51, 0x8c, 0x26, 0xfc, 0xdb, 0x11, 0xa7, 0x5c, 0x8d, 0xc3, 0xd3, 0x87, 0x03, 0xa6, 0xc8,
-	0xc3, 0x70, 0xc8, 0x32, 0x26, 0xb9, 0x0c, 0xf2, 0x42, 0x28, 0x01, 0xf7, 0x2c, 0x29, 0xb8, 0x92,
-	0x14, 0x58, 0xd2, 0xee, 0xf6, 0x50, 0x0c, 0x85, 0x66, 0x84, 0xe5, 0xca, 0x90, 0x77, 0xef, 0xc5,
-	0x9a, 0xdd, 0x37, 0x80, 0xd9, 0x58, 0xa8, 0x65, 0x76, 0xe1, 0x80, 0x48, 0x36, 0x4b, 0x1d, 0x0b,
-	0x9e, 0x39, 0xea, 0x50, 0x88, 0x61, 0xc2, 0x42, 0xbd, 0x1b, 0x8c, 0x4e, 0x42, 0x92, 0x8d, 0x2d,
-	0xf4, 0x8a, 0x3b, 0x07, 0x89, 0xe3, 0x51, 0x3a, 0x23, 0xeb, 0x9d, 0x0d, 0x79, 0xed, 0xdf, 0x8f,
-	0x9a, 0x93, 0x82, 0xa4, 0xce, 0xc9, 0xa3, 0xe5, 0xae, 0x25, 0x17, 0x92, 0x2b, 0x2e, 0xb2, 0x9b,
-	0xb1, 0x14, 0x8f, 0x9f, 0xf6, 0xb2, 0x13, 0x77, 0x21, 0x6f, 0x2d, 0xc7, 0xe2, 0x1a, 0xe4, 0xa7,
-	0xac, 0x5f, 0xb0, 0x58, 0x14, 0xd4, 0xb0, 0xd1, 0x1f, 0x1e, 0xd8, 0x78, 0x77, 0x94, 0x24, 0x4f,
-	0x78, 0xfc, 0x14, 0xbe, 0x0e, 0x5e, 0xc8, 0x85, 0x48, 0xfa, 0x9c, 0xfa, 0x5e, 0xc7, 0xdb, 0xaf,
-	0x47, 0x70, 0x3a, 0x69, 0xdf, 0x1e, 0x93, 0x34, 0x79, 0x13, 0x59, 0x00, 0xe1, 0xf5, 0x72, 0xd5,
-	0xa3, 0xf0, 0x11, 0x00, 0xa5, 0x93, 0x3e, 0xcf, 0x28, 0x3b, 0xf3, 0x57, 0x3b, 0xde, 0x7e, 0x2d,
-	0xba, 0x3b, 0x9d, 0xb4, 0xef, 0x98, 0xf8, 0x39, 0x86, 0xf0, 0xa6, 0xb1, 0x4c, 0xd9, 0x19, 0xfc,
-	0x02, 0xd4, 0x79, 0x76, 0x22, 0xfc, 0x5a, 0xc7, 0xdb, 0x6f, 0x74, 0xc3, 0x60, 0xa9, 0x56, 0x08,
-	0x9e, 0xd8, 0x23, 0x47, 0xfe, 0xb3, 0x49, 0x7b, 0x65, 0x3a, 0x69, 0x6f, 0x2d, 0x24, 0x39, 0x11,
-	0x08, 0x6b, 0x59, 0xf4, 0x6b, 0x1d, 0x6c, 0x1c, 0x0b, 0x91, 0xbc, 0x4d, 0x14, 0x81, 0x87, 0xa0,
-	0x5e, 0x7a, 0xd5, 0x67, 0x69, 0x74, 0xb7, 0x03, 0x53, 0xfe, 0xc0, 0x95, 0x3f, 0x38, 0xca, 0xc6,
-	0xd1, 0xe6, 0xef, 0xbf, 0x1c, 0xac, 0x95, 0x8c, 0x1e, 0xd6, 0xc1, 0xf0, 0x33, 0xb0, 0x56, 0xaa,
-	0x4a, 0x7f, 0xb5, 0x53, 0xbb, 0x81, 0x43, 0x77, 0x87, 0xd1, 0xb6, 0x75, 0xd8, 0x9c, 0x3b, 0x94,
-	0x08, 0x1b, 0x4d, 0xf8, 0x93, 0x07, 0xee, 0xc9, 0xbc, 0x60, 0x84, 0xf6, 0x0b, 0xf6, 0x1d, 0x29,
-	0x68, 0x5f, 0x77, 0xd8, 0x28, 0x21, 0x4a, 0x14, 0xf6, 0x4e, 0xba, 0x4b, 0x66, 0x3c, 0x2a, 0x99,
-	0x1f, 0x0d, 0xbe, 0x61, 0xb1, 0x8a, 0xf6, 0x6d, 0xd2, 0x8e, 0x49, 0x7a, 0x6d, 0x0a, 0x84, 0x77,
-	0x0c, 0x86, 0x35, 0x74, 0x34, 0x47, 0xe0, 0x8f, 0x1e, 0xd8, 0x99, 0xf5, 0x88, 0xac, 0x92, 0xa4,
-	0x5f, 0xd7, 0x57, 0xf1, 0x7f, 0x8c, 0xed, 0x59, 0x63, 0xf7, 0x8d, 0xb1, 0xab, 0x13, 0x20, 0xfc,
-	0xd2, 0x1c, 0xa8, 0x78, 0x92, 0x90, 0x83, 0x3b, 0x97, 0xfb, 0x56, 0xfa, 0x6b, 0xda, 0xcd, 0x1b,
-	0x4b, 0xba, 0xe9, 0x39, 0x3e, 0xd6, 0xf4, 0xa8, 0x5e, 0x3a, 0xc2, 0x5b, 0x7c, 0xf1, 0x67, 0x89,
-	0x7e, 0x5b, 0x05, 0xcd, 0x63, 0x3b, 0x8f, 0xba, 0x7b, 0x3e, 0x00, 0x1b, 0x6e, 0x3e, 0x6d, 0x07,
-	0x2d, 0xdb, 0x0b, 0x4e, 0x06, 0xcf, 0x04, 0xca, 0xc9, 0x4a, 0x44, 0xd9, 0xab, 0x54, 0x4f, 0xca,
-	0xc2, 0x64, 0x59, 0x00, 0xe1, 0xf5, 0x72, 0xd5, 0xa3, 0xf0, 0x2b, 0xb0, 0x7b, 0x45, 0x05, 0xed,
-	0xf9, 0x6d, 0x97, 0xdc, 0x9f, 0x79, 0x31, 0x6f, 0x94, 0xcb, 0xbd, 0x70, 0xca, 0x7f, 0x16, 0xdb,
-	0xc0, 0xf0, 0x13, 0xb0, 0x3d, 0xca, 0x15, 0x4f, 0xd9, 0x82, 0xb4, 0x2b, 0xf4, 0x52, 0xda, 0xd0,
-	0x08, 0x54, 0x54, 0x25, 0xfa, 0xb9, 0x06, 0x9a, 0xef, 0x99, 0xa7, 0xfe, 0x63, 0x45, 0x14, 0x83,
-	0x8f, 0xc1, 0xba, 0x79, 0x17, 0xed, 0x0d, 0xee, 0xfd, 0xc7, 0x0d, 0x1e, 0xeb, 0x60, 0x9b, 0xc1,
-	0x52, 0x21, 0x06, 0x9b, 0xfa, 0xf1, 0xa1, 0x44, 0x91, 0x1b, 0x4e, 0xa5, 0x7b, 0x0a, 0xac, 0xe2,
-	0x46, 0xee, 0x9e, 0x86, 0x2f, 0xc1, 0x2d, 0x57, 0x1b, 0xa3, 0x5b, 0xd3, 0xba, 0x87, 0x37, 0xac,
-	0x70, 0x45, 0xbb, 0x99, 0x57, 0x9b, 0xe7, 0x1d, 0xb0, 0x95, 0xb1, 0x33, 0xd5, 0x9f, 0x25, 0xe1,
-	0xd4, 0xaf, 0xeb, 0xc2, 0xbf, 0x3c, 0x9d, 0xb4, 0x77, 0x4c, 0xe1, 0x2f, 0x47, 0x20, 0x7c, 0xbb,
-	0xfc, 0xc9, 0x89, 0xf7, 0x28, 0xfc, 0x1c, 0xf8, 0x3a, 0xe8, 0xf2, 0x10, 0x94, 0x72, 0x6b, 0x5a,
-	0xee, 0xc1, 0x74, 0xd2, 0x6e, 0x57, 0xe4, 0xae, 0x88, 0x44, 0xf8, 0x6e, 0x09, 0x5d, 0x1a, 0x84,
-	0x1e, 0x45, 0xdf, 0x7b, 0xa0, 0x51, 0x19, 0x56, 0xf8, 0x00, 0xd4, 0x33, 0x92, 0x32, 0x5d, 0xab,
-	0xcd, 0xe8, 0xc5, 0xe9, 0xa4, 0xdd, 0xb0, 0xca, 0x24, 0x65, 0x08, 0x6b, 0x10, 0x7e, 0x08, 0x6e,
-	0x99, 0x9e, 0x89, 0x45, 0xa6, 0x58, 0xa6, 0x74, 0x3f, 0x37, 0xba, 0xaf, 0x5e, 0xd3, 0x33, 0x95,
-	0x71, 0x7e, 0x6c, 0x08, 0xb8, 0xa9, 0x23, 0xec, 0x2e, 0xa2, 0xcf, 0xce, 0x5b, 0xde, 0xf3, 0xf3,
-	0x96, 0xf7, 0xd7, 0x79, 0xcb, 0xfb, 0xe1, 0xa2, 0xb5, 0xf2, 0xfc, 0xa2, 0xb5, 0xf2, 0xe7, 0x45,
-	0x6b, 0xe5, 0xd3, 0xf7, 0x87, 0x5c, 0x7d, 0x3d, 0x1a, 0x04, 0xb1, 0x48, 0x43, 0x2b, 0x7e, 0x90,
-	0x90, 0x81, 0x74, 0x9b, 0xf0, 0xb4, 0xdb, 0x0d, 0xcf, 0x16, 0x3e, 0x7b, 0x07, 0xf3, 0xef, 0x9e,
-	0x1a, 0xe7, 0x4c, 0xba, 0x3f, 0x1e, 0x83, 0x75, 0xfd, 0xe8, 0x1f, 0xfe, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x1c, 0x5b, 0x8d, 0x0c, 0xb0, 0x08, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xd3, 0x34, 0x9b, 0x4c, 0xb2, 0xa5, 0x3b, 0x74, 0x59, 0x6f, 0x50, 0x93, 0xe0, 0x55,
+	0xa5, 0x02, 0x6a, 0xac, 0xa6, 0x0b, 0x48, 0x88, 0x03, 0x4d, 0x97, 0x45, 0x01, 0x96, 0xad, 0xbc,
+	0x8b, 0x84, 0xf8, 0x67, 0x26, 0x9e, 0x49, 0x18, 0xd6, 0xf6, 0x04, 0xcf, 0xa4, 0x34, 0x57, 0x6e,
+	0xdc, 0x10, 0x27, 0xee, 0x7c, 0x05, 0x24, 0xce, 0xdc, 0x56, 0x88, 0xc3, 0x1e, 0xe1, 0x12, 0xa1,
+	0xf6, 0x1b, 0xe4, 0x13, 0x20, 0xcf, 0x8c, 0x13, 0x27, 0xa4, 0xac, 0x03, 0xe2, 0xe6, 0xf1, 0x7b,
+	0xbf, 0xdf, 0x7b, 0xef, 0x37, 0xef, 0x3d, 0x1b, 0x1c, 0x32, 0x1e, 0x30, 0x4e, 0xb9, 0xed, 0xb1,
+	0xd0, 0x23, 0xa1, 0x88, 0x90, 0x20, 0xd8, 0xa7, 0x5f, 0x0d, 0x29, 0xa6, 0x62, 0x64, 0x9f, 0x1e,
+	0x74, 0x89, 0x40, 0x07, 0x76, 0x9f, 0x84, 0x84, 0x53, 0xde, 0x1c, 0x44, 0x4c, 0x30, 0xb8, 0xab,
+	0x41, 0xcd, 0xa5, 0xa0, 0xa6, 0x06, 0x55, 0xb7, 0xfb, 0xac, 0xcf, 0x24, 0xc2, 0x8e, 0x9f, 0x14,
+	0xb8, 0x7a, 0xd3, 0x93, 0x68, 0x57, 0x19, 0xd4, 0x41, 0x9b, 0x6a, 0xea, 0x64, 0x77, 0x11, 0x27,
+	0xd3, 0xd0, 0x1e, 0xa3, 0x61, 0x02, 0xed, 0x33, 0xd6, 0xf7, 0x89, 0x2d, 0x4f, 0xdd, 0x61, 0xcf,
+	0x46, 0xe1, 0x48, 0x9b, 0x5e, 0x48, 0xea, 0x40, 0x9e, 0x37, 0x0c, 0xa6, 0x60, 0x79, 0xd2, 0x2e,
+	0x2f, 0xfd, 0x73, 0xa9, 0x03, 0x14, 0xa1, 0x20, 0xc9, 0xe4, 0x76, 0x36, 0x59, 0x06, 0x8c, 0x53,
+	0x41, 0x59, 0xb8, 0x1a, 0x4a, 0x50, 0xef, 0x51, 0x27, 0xec, 0x25, 0x82, 0xbc, 0x91, 0x0d, 0x45,
+	0xa5, 0x91, 0x9e, 0x12, 0x37, 0x22, 0x1e, 0x8b, 0xb0, 0x46, 0xbf, 0x96, 0x0d, 0xed, 0xd3, 0x80,
+	0x0a, 0x97, 0x45, 0x98, 0x44, 0x1a, 0x78, 0x94, 0x0d, 0x88, 0x47, 0x21, 0x0a, 0xa8, 0xe7, 0xf2,
+	0x41, 0x44, 0x10, 0x76, 0x7b, 0xc8, 0x13, 0x4c, 0x53, 0x58, 0xbf, 0x19, 0xa0, 0x78, 0x77, 0xe8,
+	0xfb, 0x0f, 0xa9, 0xf7, 0x08, 0xbe, 0x0c, 0xae, 0x0c, 0x18, 0xf3, 0x5d, 0x8a, 0x4d, 0xa3, 0x61,
+	0xec, 0xe5, 0xdb, 0x70, 0x32, 0xae, 0x6f, 0x8e, 0x50, 0xe0, 0xbf, 0x6e, 0x69, 0x83, 0xe5, 0x14,
+	0xe2, 0xa7, 0x0e, 0x86, 0xb7, 0x01, 0x88, 0x55, 0x70, 0x69, 0x88, 0xc9, 0x99, 0x99, 0x6b, 0x18,
+	0x7b, 0xeb, 0xed, 0xeb, 0x93, 0x71, 0xfd, 0x9a, 0xf2, 0x9f, 0xd9, 0x2c, 0xa7, 0xa4, 0xe4, 0xc2,
+	0xe4, 0x0c, 0x7e, 0x0a, 0xf2, 0x34, 0xec, 0x31, 0x73, 0xbd, 0x61, 0xec, 0x95, 0x5b, 0x76, 0x33,
+	0x53, 0x1b, 0x36, 0x1f, 0x6a, 0xb9, 0xdb, 0xe6, 0xe3, 0x71, 0x7d, 0x6d, 0x32, 0xae, 0x6f, 0xcd,
+	0x05, 0xe9, 0x31, 0xcb, 0x91, 0xb4, 0xd6, 0xcf, 0x79, 0x50, 0x3c, 0x61, 0xcc, 0xbf, 0x83, 0x04,
+	0x82, 0x87, 0x20, 0x1f, 0xe7, 0x2a, 0x6b, 0x29, 0xb7, 0xb6, 0x9b, 0xaa, 0xf5, 0x9a, 0x49, 0xeb,
+	0x35, 0x8f, 0xc2, 0x51, 0xbb, 0xf4, 0xeb, 0x4f, 0xfb, 0x1b, 0x31, 0xa2, 0xe3, 0x48, 0x67, 0xf8,
+	0x31, 0xd8, 0x88, 0x59, 0xb9, 0x99, 0x6b, 0xac, 0xaf, 0x90, 0x61, 0xa2, 0x61, 0x7b, 0x5b, 0x67,
+	0x58, 0x99, 0x65, 0xc8, 0x2d, 0x47, 0x71, 0xc2, 0x1f, 0x0c, 0x70, 0x53, 0xdf, 0x42, 0x44, 0xbe,
+	0x46, 0x11, 0x76, 0x65, 0x77, 0x0f, 0x7d, 0x24, 0x58, 0xa4, 0x35, 0x69, 0x65, 0x8c, 0x78, 0x14,
+	0x23, 0xef, 0x77, 0xbf, 0x24, 0x9e, 0x68, 0xef, 0xe9, 0xa0, 0x0d, 0x15, 0xf4, 0xd2, 0x10, 0x96,
+	0x73, 0x43, 0xd9, 0x1c, 0x69, 0x3a, 0x9a, 0x59, 0xe0, 0xf7, 0x06, 0xb8, 0x31, 0xed, 0x4f, 0x9e,
+	0x06, 0x71, 0x33, 0xdf, 0x58, 0xff, 0x97, 0x89, 0xed, 0xea, 0xc4, 0x76, 0x54, 0x62, 0xcb, 0x03,
+	0x58, 0xce, 0x73, 0x33, 0x43, 0x2a, 0x27, 0x0e, 0x29, 0xb8, 0xb6, 0x38, 0x33, 0xdc, 0xdc, 0x90,
+	0xd9, 0xbc, 0x9a, 0x31, 0x9b, 0x4e, 0x82, 0x77, 0x24, 0xbc, 0x9d, 0x8f, 0x33, 0x72, 0xb6, 0xe8,
+	0xfc, 0x6b, 0x6e, 0xfd, 0x92, 0x03, 0x95, 0x13, 0xbd, 0x0b, 0x64, 0xf7, 0xbc, 0x0b, 0x8a, 0xc9,
+	0x6e, 0xd0, 0x1d, 0x94, 0xb5, 0x17, 0x12, 0x1a, 0x67, 0x4a, 0x10, 0x4f, 0x96, 0xcf, 0xe2, 0x5e,
+	0xc5, 0x66, 0x6e, 0x71, 0xb2, 0xb4, 0xc1, 0x72, 0x0a, 0xf1, 0x53, 0x07, 0xc3, 0xcf, 0x41, 0x75,
+	0xc9, 0x0d, 0xea, 0xfa, 0x75, 0x97, 0xec, 0x4c, 0x73, 0x91, 0xc6, 0x69, 0xec, 0xb9, 0x2a, 0xff,
+	0x7e, 0xd9, 0xca, 0x0c, 0x3f, 0x00, 0xdb, 0xc3, 0x81, 0xa0, 0x01, 0x99, 0xa3, 0x4e, 0x2e, 0x3a,
+	0x13, 0x37, 0x54, 0x04, 0x29, 0x56, 0x6e, 0xfd, 0x98, 0x03, 0x9b, 0xef, 0xc5, 0x5b, 0xea, 0x7e,
+	0xbc, 0xa4, 0xa4, 0x8a, 0x1f, 0x82, 0x72, 0x6a, 0x6f, 0x69, 0x21, 0x0f, 0x32, 0x0a, 0x39, 0xe3,
+	0xd2, 0x41, 0x81, 0x3f, 0x7d, 0xf3, 0x14, 0x95, 0x72, 0xff, 0xa3, 0x4a, 0xeb, 0xff, 0x4d, 0xa5,
+	0x3f, 0x0a, 0xa0, 0xf2, 0xb6, 0xfa, 0x18, 0x3f, 0x10, 0x48, 0x10, 0x78, 0x0c, 0x0a, 0xea, 0xcb,
+	0xa5, 0xe5, 0xd9, 0x7d, 0x8a, 0x3c, 0x27, 0xd2, 0x59, 0x47, 0xd0, 0x50, 0xe8, 0x80, 0x92, 0x5c,
+	0xd1, 0x18, 0x09, 0xb4, 0xe2, 0xee, 0x4a, 0x16, 0xa6, 0x66, 0x2c, 0x0e, 0x92, 0x05, 0xfa, 0x19,
+	0xb8, 0x9a, 0x74, 0xb0, 0xe2, 0x55, 0x95, 0x1f, 0xae, 0x38, 0x07, 0x29, 0xee, 0xca, 0x20, 0x3d,
+	0x62, 0x6f, 0x81, 0xad, 0x90, 0x9c, 0x09, 0x77, 0x1a, 0x84, 0x62, 0x33, 0x2f, 0xc7, 0xe3, 0xf9,
+	0xc9, 0xb8, 0x7e, 0x43, 0x8d, 0xc7, 0xa2, 0x87, 0xe5, 0x6c, 0xc6, 0xaf, 0x12, 0xf2, 0x0e, 0x86,
+	0x9f, 0x00, 0x53, 0x3a, 0x2d, 0xae, 0x8a, 0x98, 0x6e, 0x43, 0xd2, 0xdd, 0x9a, 0x8c, 0xeb, 0xf5,
+	0x14, 0xdd, 0x12, 0x4f, 0xcb, 0xb9, 0x1e, 0x9b, 0x16, 0xd6, 0x45, 0x07, 0x43, 0x02, 0xb6, 0x52,
+	0x1d, 0xac, 0x74, 0x28, 0x48, 0x1d, 0x5e, 0x59, 0xb9, 0x8d, 0x53, 0x4a, 0x6c, 0xfa, 0xf3, 0x83,
+	0x72, 0x0f, 0x3c, 0x2b, 0x53, 0x4b, 0xc7, 0xa2, 0xd8, 0xbc, 0x22, 0xf3, 0xaf, 0x4d, 0xc6, 0xf5,
+	0x6a, 0x2a, 0xff, 0x79, 0x27, 0xcb, 0x91, 0x32, 0xce, 0xc2, 0x74, 0x30, 0xfc, 0xd6, 0x00, 0x3b,
+	0x4b, 0xbf, 0xfb, 0xae, 0xc7, 0xc2, 0x1e, 0xed, 0x73, 0xb3, 0x28, 0x6b, 0x78, 0x33, 0x63, 0x0d,
+	0x77, 0x14, 0xd7, 0x03, 0x49, 0x75, 0x57, 0x32, 0x1d, 0x4b, 0x22, 0x5d, 0x4e, 0x15, 0x5f, 0xe6,
+	0x20, 0xb7, 0x78, 0x80, 0x42, 0xd4, 0x27, 0x78, 0x7a, 0x8f, 0xdc, 0x2c, 0xad, 0xb4, 0xc5, 0xef,
+	0x29, 0x7c, 0x72, 0xe9, 0xc9, 0x16, 0x0f, 0xe6, 0x5f, 0x73, 0xeb, 0x1b, 0x03, 0x94, 0x53, 0xdf,
+	0x1f, 0x78, 0x0b, 0xe4, 0x43, 0x14, 0x10, 0x39, 0x58, 0xa5, 0xf6, 0x33, 0x93, 0x71, 0xbd, 0xac,
+	0x65, 0x44, 0x01, 0xb1, 0x1c, 0x69, 0x84, 0xef, 0x83, 0xab, 0x6a, 0xc0, 0x3d, 0x16, 0x0a, 0x12,
+	0x0a, 0xbd, 0x3c, 0x5e, 0xbc, 0x64, 0xc0, 0x53, 0x5f, 0xa8, 0x63, 0x05, 0x70, 0x2a, 0xd2, 0x43,
+	0x9f, 0xda, 0xf8, 0xf1, 0x79, 0xcd, 0x78, 0x72, 0x5e, 0x33, 0xfe, 0x3c, 0xaf, 0x19, 0xdf, 0x5d,
+	0xd4, 0xd6, 0x9e, 0x5c, 0xd4, 0xd6, 0x7e, 0xbf, 0xa8, 0xad, 0x7d, 0xf4, 0x4e, 0x9f, 0x8a, 0x2f,
+	0x86, 0xdd, 0xa6, 0xc7, 0x02, 0x5b, 0x93, 0xef, 0xfb, 0xa8, 0xcb, 0x93, 0x83, 0x7d, 0xda, 0x6a,
+	0xd9, 0x67, 0x73, 0xbf, 0x73, 0xfb, 0xb3, 0xff, 0x39, 0x31, 0x1a, 0x10, 0x9e, 0xfc, 0xc7, 0x77,
+	0x0b, 0xf2, 0x3f, 0xe6, 0xf0, 0xaf, 0x01, 0x00, 0x76, 0x4c, 0xeb, 0x24, 0xff, 0x0b, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -640,6 +746,63 @@ func (m *PositionData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitOrderData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeAccumRecords) > 0 {
+		for iNdEx := len(m.UptimeAccumRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeAccumRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.SpreadRewardAccumRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LimitOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -660,6 +823,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ManagedPositions) > 0 {
+		for iNdEx := len(m.ManagedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DynamicSpreadFactorConfigs) > 0 {
+		for iNdEx := len(m.DynamicSpreadFactorConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicSpreadFactorConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextLimitOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LimitOrderData) > 0 {
+		for iNdEx := len(m.LimitOrderData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextIncentiveRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
 		i--
@@ -838,6 +1048,25 @@ func (m *PositionData) Size() (n int) {
 	return n
 }
 
+func (m *LimitOrderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LimitOrder.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SpreadRewardAccumRecord.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UptimeAccumRecords) > 0 {
+		for _, e := range m.UptimeAccumRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -864,6 +1093,27 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.LimitOrderData) > 0 {
+		for _, e := range m.LimitOrderData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextLimitOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextLimitOrderId))
+	}
+	if len(m.DynamicSpreadFactorConfigs) > 0 {
+		for _, e := range m.DynamicSpreadFactorConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ManagedPositions) > 0 {
+		for _, e := range m.ManagedPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1404,6 +1654,156 @@ func (m *PositionData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrderData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardAccumRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpreadRewardAccumRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeAccumRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeAccumRecords = append(m.UptimeAccumRecords, accum.Record{})
+			if err := m.UptimeAccumRecords[len(m.UptimeAccumRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1572,6 +1972,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderData = append(m.LimitOrderData, LimitOrderData{})
+			if err := m.LimitOrderData[len(m.LimitOrderData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextLimitOrderId", wireType)
+			}
+			m.NextLimitOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextLimitOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSpreadFactorConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DynamicSpreadFactorConfigs = append(m.DynamicSpreadFactorConfigs, types1.DynamicSpreadFactorConfig{})
+			if err := m.DynamicSpreadFactorConfigs[len(m.DynamicSpreadFactorConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPositions = append(m.ManagedPositions, model.ManagedPosition{})
+			if err := m.ManagedPositions[len(m.ManagedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,16 +14,19 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSetDynamicSpreadFactor          = "SetDynamicSpreadFactor"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSetDynamicSpreadFactor)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SetDynamicSpreadFactorProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +158,76 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSetDynamicSpreadFactorProposal(title, description string, configs []DynamicSpreadFactorConfig, disabledPoolIds []uint64) govtypesv1.Content {
+	return &SetDynamicSpreadFactorProposal{
+		Title:           title,
+		Description:     description,
+		Configs:         configs,
+		DisabledPoolIds: disabledPoolIds,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SetDynamicSpreadFactorProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetDynamicSpreadFactorProposal) ProposalType() string {
+	return ProposalTypeSetDynamicSpreadFactor
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SetDynamicSpreadFactorProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Configs) == 0 && len(p.DisabledPoolIds) == 0 {
+		return fmt.Errorf("empty proposal records")
+	}
+
+	seenPoolIds := map[uint64]struct{}{}
+	for _, config := range p.Configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seenPoolIds[config.PoolId]; ok {
+			return fmt.Errorf("duplicate pool id (%d)", config.PoolId)
+		}
+		seenPoolIds[config.PoolId] = struct{}{}
+	}
+
+	for _, poolId := range p.DisabledPoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if _, ok := seenPoolIds[poolId]; ok {
+			return fmt.Errorf("duplicate pool id (%d)", poolId)
+		}
+		seenPoolIds[poolId] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the set dynamic spread factor proposal.
+func (p SetDynamicSpreadFactorProposal) String() string {
+	recordsStr := ""
+	for _, config := range p.Configs {
+		recordsStr = recordsStr + fmt.Sprintf("(PoolID: %d, MinSpreadFactor: %s, MaxSpreadFactor: %s, VolatilityMultiplier: %s, VolatilityWindow: %s) ", config.PoolId, config.MinSpreadFactor, config.MaxSpreadFactor, config.VolatilityMultiplier, config.VolatilityWindow)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Dynamic Spread Factor Proposal:
+Title:           %s
+Description:     %s
+Records:         %s
+DisabledPoolIds: %v
+`, p.Title, p.Description, recordsStr, p.DisabledPoolIds))
+	return b.String()
+}
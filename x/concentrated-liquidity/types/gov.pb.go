@@ -109,6 +109,50 @@ func (m *TickSpacingDecreaseProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TickSpacingDecreaseProposal proto.InternalMessageInfo
 
+// SetDynamicSpreadFactorProposal is a gov Content type for enabling, updating
+// or disabling the dynamic spread factor mode of pools. The pools of configs
+// are set in dynamic spread factor mode with the given config, and the pools of
+// disabled_pool_ids go back to charging their spread factor. The proposal will
+// fail if one of the pools does not exist.
+type SetDynamicSpreadFactorProposal struct {
+	Title           string                      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Configs         []DynamicSpreadFactorConfig `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs"`
+	DisabledPoolIds []uint64                    `protobuf:"varint,4,rep,packed,name=disabled_pool_ids,json=disabledPoolIds,proto3" json:"disabled_pool_ids,omitempty"`
+}
+
+func (m *SetDynamicSpreadFactorProposal) Reset()      { *m = SetDynamicSpreadFactorProposal{} }
+func (*SetDynamicSpreadFactorProposal) ProtoMessage() {}
+func (*SetDynamicSpreadFactorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{2}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDynamicSpreadFactorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.Merge(m, src)
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDynamicSpreadFactorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDynamicSpreadFactorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDynamicSpreadFactorProposal proto.InternalMessageInfo
+
 // PoolIdToTickSpacingRecord is a struct that contains a pool id to new tick
 // spacing pair.
 type PoolIdToTickSpacingRecord struct {
@@ -120,7 +164,7 @@ func (m *PoolIdToTickSpacingRecord) Reset()         { *m = PoolIdToTickSpacingRe
 func (m *PoolIdToTickSpacingRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolIdToTickSpacingRecord) ProtoMessage()    {}
 func (*PoolIdToTickSpacingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{2}
+	return fileDescriptor_a96adc35f4989ef7, []int{3}
 }
 func (m *PoolIdToTickSpacingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +218,7 @@ func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
 func (m *PoolRecord) String() string { return proto.CompactTextString(m) }
 func (*PoolRecord) ProtoMessage()    {}
 func (*PoolRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a96adc35f4989ef7, []int{3}
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
 }
 func (m *PoolRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +271,7 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
+	proto.RegisterType((*SetDynamicSpreadFactorProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SetDynamicSpreadFactorProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
 }
@@ -236,42 +281,46 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x5b, 0x37, 0xc0, 0x25, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84, 0x14,
-	0x86, 0xda, 0x38, 0x6c, 0x61, 0x41, 0x69, 0x85, 0x04, 0xea, 0x50, 0x99, 0x4e, 0x08, 0x29, 0x5c,
-	0xce, 0x87, 0x7b, 0x8a, 0xe3, 0xe7, 0xfa, 0xae, 0x29, 0xf9, 0x0f, 0x90, 0x60, 0x60, 0x64, 0xcc,
-	0x9f, 0xd3, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0xbf, 0x00, 0xe5, 0x2e, 0x3f, 0x9c,
-	0x42, 0x25, 0x10, 0x9b, 0xef, 0xee, 0xfb, 0xbe, 0xf7, 0x7d, 0xef, 0xf9, 0x21, 0x0f, 0x78, 0x0f,
-	0x38, 0xe3, 0x1e, 0x81, 0x84, 0xd0, 0x44, 0x64, 0x58, 0xd0, 0x30, 0x66, 0x67, 0xe7, 0x2c, 0x64,
-	0x62, 0xe0, 0xf5, 0xfd, 0x0e, 0x15, 0xd8, 0xf7, 0x22, 0xe8, 0xbb, 0x69, 0x06, 0x02, 0xcc, 0x87,
-	0x73, 0x82, 0xfb, 0x47, 0x82, 0x3b, 0x27, 0xec, 0x95, 0x23, 0x88, 0x40, 0x32, 0xbc, 0xd9, 0x97,
-	0x22, 0x3b, 0x13, 0x1d, 0xd5, 0x0f, 0x32, 0x8a, 0x05, 0x3d, 0xc8, 0xb1, 0x8f, 0x16, 0xec, 0x63,
-	0x80, 0x98, 0x1f, 0x67, 0x90, 0x02, 0xc7, 0xb1, 0x59, 0x46, 0x5b, 0x82, 0x89, 0x98, 0x56, 0xf4,
-	0x9a, 0x5e, 0xbf, 0x13, 0xa8, 0x83, 0x59, 0x43, 0xc5, 0x90, 0x72, 0x92, 0xb1, 0x54, 0x30, 0x48,
-	0x2a, 0x1b, 0xf2, 0x2d, 0x7f, 0x65, 0x9e, 0xa1, 0x52, 0x0a, 0x10, 0xb7, 0x33, 0x4a, 0x20, 0x0b,
-	0x79, 0x65, 0xb3, 0xb6, 0x59, 0x2f, 0x36, 0x7c, 0xf7, 0xaf, 0x8c, 0xbb, 0x33, 0x0f, 0x81, 0x64,
-	0xb6, 0xaa, 0x97, 0x23, 0x5b, 0x9b, 0x8e, 0xec, 0xfb, 0x03, 0xdc, 0x8b, 0x9b, 0x4e, 0x5e, 0xd4,
-	0x09, 0x8a, 0xe9, 0x12, 0xc8, 0x9b, 0xa5, 0x0f, 0x43, 0x5b, 0xfb, 0x32, 0xb4, 0xb5, 0x1f, 0x43,
-	0x5b, 0x77, 0x7e, 0xea, 0xa8, 0x7a, 0xc2, 0x48, 0xf7, 0x55, 0x8a, 0x09, 0x4b, 0xa2, 0x43, 0x4a,
-	0x32, 0x8a, 0x39, 0xfd, 0xef, 0x60, 0x1f, 0x75, 0x64, 0x4b, 0x13, 0x2c, 0x6c, 0x0b, 0x68, 0x0b,
-	0x46, 0xba, 0x6d, 0xae, 0x6a, 0x5c, 0x0b, 0xfb, 0xec, 0x1f, 0xc2, 0xbe, 0x08, 0x4f, 0x20, 0xe7,
-	0x76, 0x9e, 0xdd, 0x98, 0x65, 0x0f, 0xf6, 0xd2, 0x9b, 0x00, 0xd7, 0x33, 0x87, 0xe8, 0xc1, 0x8d,
-	0x62, 0xe6, 0x2e, 0xba, 0x35, 0xf7, 0x2d, 0x23, 0x1b, 0x41, 0x41, 0xe9, 0x9a, 0x75, 0xb4, 0x93,
-	0xd0, 0x8b, 0xb5, 0x24, 0x32, 0xb8, 0x11, 0xdc, 0x4d, 0xe8, 0x45, 0x4e, 0xa8, 0x69, 0xc8, 0x2a,
-	0x9f, 0x36, 0x10, 0x5a, 0x0d, 0xc8, 0x7c, 0x84, 0x0a, 0x21, 0x4d, 0xa0, 0xf7, 0x58, 0x75, 0xb2,
-	0x75, 0x6f, 0x3a, 0xb2, 0xb7, 0xd5, 0xb0, 0xd4, 0xbd, 0x13, 0xcc, 0x01, 0x4b, 0xa8, 0xaf, 0x1a,
-	0xfb, 0x1b, 0xd4, 0x5f, 0x40, 0x7d, 0xb3, 0x89, 0x4a, 0x6b, 0x86, 0x36, 0x67, 0x86, 0x5a, 0xbb,
-	0xab, 0x1f, 0x21, 0xff, 0xea, 0x04, 0x45, 0xb1, 0xb2, 0x69, 0xbe, 0x45, 0xdb, 0x3c, 0xcd, 0x28,
-	0x0e, 0xdb, 0xef, 0x30, 0x11, 0x90, 0x55, 0xb6, 0x64, 0xb5, 0xa7, 0xb3, 0x6e, 0x7e, 0x1b, 0xd9,
-	0x55, 0x22, 0xe7, 0xc2, 0xc3, 0xae, 0xcb, 0xc0, 0xeb, 0x61, 0x71, 0xea, 0x1e, 0xd1, 0x08, 0x93,
-	0xc1, 0x21, 0x25, 0xd3, 0x91, 0x5d, 0x56, 0xfa, 0x6b, 0x0a, 0x4e, 0x50, 0x52, 0xe7, 0xe7, 0xf2,
-	0xa8, 0x1a, 0xf1, 0xd2, 0xb8, 0x6d, 0xec, 0x6c, 0xb5, 0xde, 0x5c, 0x8e, 0x2d, 0xfd, 0x6a, 0x6c,
-	0xe9, 0xdf, 0xc7, 0x96, 0xfe, 0x79, 0x62, 0x69, 0x57, 0x13, 0x4b, 0xfb, 0x3a, 0xb1, 0xb4, 0xd7,
-	0xad, 0x88, 0x89, 0xd3, 0xf3, 0x8e, 0x4b, 0xa0, 0xb7, 0xd8, 0xf0, 0xfd, 0x18, 0x77, 0xf8, 0x72,
-	0xdd, 0xfb, 0x8d, 0x86, 0xf7, 0x7e, 0x6d, 0xe9, 0xf7, 0x57, 0x5b, 0x2f, 0x06, 0x29, 0xe5, 0x9d,
-	0x82, 0xdc, 0xd9, 0x27, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xee, 0xe8, 0x42, 0x23, 0x04,
-	0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0xb2, 0x05, 0x74, 0x76, 0x51, 0xa8, 0x24, 0xac, 0x90, 0xb4, 0x9b, 0x26, 0x26, 0xab,
+	0x09, 0xad, 0xc5, 0xdb, 0x7a, 0xd1, 0x85, 0x98, 0x68, 0x38, 0x90, 0xc2, 0xc9, 0x98, 0x94, 0xd9,
+	0x99, 0xa1, 0x4c, 0x68, 0x3b, 0xa5, 0x33, 0x80, 0xfb, 0x0f, 0x4c, 0xf4, 0xe0, 0xd1, 0x23, 0x3f,
+	0x87, 0x23, 0x47, 0xe3, 0x61, 0x63, 0xd8, 0x8b, 0x57, 0x89, 0x3f, 0xc0, 0xec, 0x4c, 0xcb, 0x76,
+	0xc9, 0x92, 0x40, 0xb8, 0x75, 0x66, 0xde, 0xfb, 0xde, 0xf7, 0x7d, 0xef, 0xf5, 0x01, 0x97, 0xf1,
+	0x98, 0x71, 0xca, 0x5d, 0xc4, 0x12, 0x44, 0x12, 0x91, 0x41, 0x41, 0x70, 0x44, 0x0f, 0x8f, 0x28,
+	0xa6, 0xa2, 0xe7, 0x1e, 0x7b, 0x5d, 0x22, 0xa0, 0xe7, 0x86, 0xec, 0xd8, 0x49, 0x33, 0x26, 0x98,
+	0xf1, 0x2c, 0x4f, 0x70, 0x26, 0x26, 0x38, 0x79, 0xc2, 0xf2, 0x62, 0xc8, 0x42, 0x26, 0x33, 0xdc,
+	0xe1, 0x97, 0x4a, 0x5e, 0x7e, 0x7b, 0xbb, 0x6a, 0xb8, 0x97, 0xc0, 0x98, 0xa2, 0x80, 0xa7, 0x19,
+	0x81, 0x38, 0xd8, 0x83, 0x48, 0xb0, 0x4c, 0x41, 0xd8, 0x03, 0x0d, 0xb4, 0xd6, 0x33, 0x02, 0x05,
+	0x59, 0x2f, 0x61, 0x6c, 0x16, 0x18, 0x5b, 0x8c, 0x45, 0x7c, 0x2b, 0x63, 0x29, 0xe3, 0x30, 0x32,
+	0x16, 0xc1, 0xb4, 0xa0, 0x22, 0x22, 0x0d, 0xad, 0xa9, 0xb5, 0x1e, 0xfa, 0xea, 0x60, 0x34, 0x41,
+	0x0d, 0x13, 0x8e, 0x32, 0x9a, 0x0a, 0xca, 0x92, 0xc6, 0x94, 0x7c, 0x2b, 0x5f, 0x19, 0x87, 0xa0,
+	0x9e, 0x32, 0x16, 0x05, 0x19, 0x41, 0x2c, 0xc3, 0xbc, 0x51, 0x6d, 0x56, 0x5b, 0xb5, 0x35, 0xcf,
+	0xb9, 0x95, 0x76, 0x67, 0xc8, 0xc1, 0x97, 0x99, 0x9d, 0x95, 0xb3, 0xbe, 0x55, 0xb9, 0xec, 0x5b,
+	0x4f, 0x7a, 0x30, 0x8e, 0xda, 0x76, 0x19, 0xd4, 0xf6, 0x6b, 0xe9, 0x55, 0x20, 0x6f, 0xd7, 0xbf,
+	0x9c, 0x5a, 0x95, 0x1f, 0xa7, 0x56, 0xe5, 0xcf, 0xa9, 0xa5, 0xd9, 0x7f, 0x35, 0xb0, 0xb2, 0x43,
+	0xd1, 0xc1, 0x76, 0x0a, 0x11, 0x4d, 0xc2, 0x0d, 0x82, 0x32, 0x02, 0x39, 0xb9, 0xb7, 0xb0, 0xaf,
+	0x1a, 0xb0, 0x24, 0x09, 0x8a, 0x03, 0xc1, 0x02, 0x41, 0xd1, 0x41, 0xc0, 0x55, 0x8d, 0x6b, 0x62,
+	0xdf, 0xdc, 0x41, 0xec, 0x7b, 0xbc, 0xc3, 0x4a, 0x6c, 0x73, 0xed, 0xfa, 0x50, 0xbb, 0xbf, 0x9c,
+	0xde, 0x14, 0x70, 0x5d, 0xf3, 0x3f, 0x0d, 0x98, 0xdb, 0x44, 0x6c, 0xa8, 0xe6, 0x6f, 0xcb, 0xde,
+	0xbf, 0x93, 0xad, 0xbf, 0xb7, 0xec, 0x5d, 0x30, 0x8b, 0x58, 0xb2, 0x47, 0xc3, 0xbb, 0xaa, 0x9b,
+	0x40, 0x66, 0x5d, 0x02, 0xe5, 0xea, 0x0a, 0x58, 0xe3, 0x05, 0x58, 0xc0, 0x94, 0xc3, 0x6e, 0x44,
+	0x70, 0x90, 0x1b, 0xcc, 0x1b, 0x7a, 0xb3, 0xda, 0xd2, 0xfd, 0xc7, 0xc5, 0x83, 0xb2, 0xea, 0xba,
+	0x6c, 0x0c, 0x9e, 0xde, 0xe8, 0xa1, 0xb1, 0x04, 0x66, 0x73, 0x34, 0x29, 0x59, 0xf7, 0x67, 0x94,
+	0x9d, 0x46, 0x0b, 0xcc, 0x27, 0xe4, 0x64, 0xac, 0x81, 0x52, 0xb8, 0xee, 0x3f, 0x4a, 0xc8, 0x49,
+	0x09, 0xa8, 0xad, 0xcb, 0x2a, 0xdf, 0xa6, 0x00, 0x18, 0xcd, 0xa5, 0xf1, 0x1c, 0xcc, 0x60, 0x92,
+	0xb0, 0xf8, 0xa5, 0x72, 0xb2, 0xb3, 0x70, 0xd9, 0xb7, 0xe6, 0xd4, 0x8c, 0xaa, 0x7b, 0xdb, 0xcf,
+	0x03, 0xae, 0x42, 0xbd, 0xc6, 0xd4, 0xc4, 0x50, 0xaf, 0x08, 0xf5, 0x8c, 0x36, 0xa8, 0x8f, 0x11,
+	0xaa, 0x0e, 0x09, 0x75, 0x96, 0x46, 0xf3, 0x5f, 0x7e, 0xb5, 0xfd, 0x9a, 0x18, 0xd1, 0x34, 0x76,
+	0xc1, 0xdc, 0xd8, 0xef, 0xde, 0x98, 0x96, 0xd5, 0x5e, 0x0f, 0x6d, 0xfe, 0xd5, 0xb7, 0x56, 0x90,
+	0x6c, 0x18, 0xc7, 0x07, 0x0e, 0x65, 0x6e, 0x0c, 0xc5, 0xbe, 0xb3, 0x49, 0x42, 0x88, 0x7a, 0x1b,
+	0x04, 0x5d, 0xf6, 0xad, 0x45, 0x85, 0x3f, 0x86, 0x60, 0xfb, 0x75, 0x5e, 0xea, 0x9b, 0x32, 0xe2,
+	0x83, 0xfe, 0x40, 0x9f, 0x9f, 0xee, 0x7c, 0x3a, 0xbb, 0x30, 0xb5, 0xf3, 0x0b, 0x53, 0xfb, 0x7d,
+	0x61, 0x6a, 0xdf, 0x07, 0x66, 0xe5, 0x7c, 0x60, 0x56, 0x7e, 0x0e, 0xcc, 0xca, 0xc7, 0x4e, 0x48,
+	0xc5, 0xfe, 0x51, 0xd7, 0x41, 0x2c, 0x2e, 0x76, 0xe3, 0x6a, 0x04, 0xbb, 0xbc, 0x38, 0xb8, 0xc7,
+	0x6b, 0x6b, 0xee, 0xe7, 0xb1, 0x05, 0xb6, 0x3a, 0xda, 0x60, 0xa2, 0x97, 0x12, 0xde, 0x9d, 0x91,
+	0xab, 0xea, 0xd5, 0xff, 0x01, 0x00, 0x89, 0x94, 0x8c, 0x73, 0x5d, 0x05, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -344,6 +393,49 @@ func (this *TickSpacingDecreaseProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetDynamicSpreadFactorProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDynamicSpreadFactorProposal)
+	if !ok {
+		that2, ok := that.(SetDynamicSpreadFactorProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Configs) != len(that1.Configs) {
+		return false
+	}
+	for i := range this.Configs {
+		if !this.Configs[i].Equal(&that1.Configs[i]) {
+			return false
+		}
+	}
+	if len(this.DisabledPoolIds) != len(that1.DisabledPoolIds) {
+		return false
+	}
+	for i := range this.DisabledPoolIds {
+		if this.DisabledPoolIds[i] != that1.DisabledPoolIds[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *PoolIdToTickSpacingRecord) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -506,6 +598,75 @@ func (m *TickSpacingDecreaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *SetDynamicSpreadFactorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDynamicSpreadFactorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisabledPoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.DisabledPoolIds)*10)
+		var j1 int
+		for _, num := range m.DisabledPoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PoolIdToTickSpacingRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -648,6 +809,36 @@ func (m *TickSpacingDecreaseProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetDynamicSpreadFactorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.DisabledPoolIds) > 0 {
+		l = 0
+		for _, e := range m.DisabledPoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *PoolIdToTickSpacingRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -987,6 +1178,230 @@ func (m *TickSpacingDecreaseProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetDynamicSpreadFactorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDynamicSpreadFactorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, DynamicSpreadFactorConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DisabledPoolIds) == 0 {
+					m.DisabledPoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DisabledPoolIds = append(m.DisabledPoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledPoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PoolIdToTickSpacingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
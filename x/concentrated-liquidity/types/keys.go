@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -50,6 +51,16 @@ var (
 	KeyTotalLiquidity     = []byte{0x13}
 	KeyContractHookPrefix = []byte{0x14}
 
+	LimitOrderPrefix          = []byte{0x15}
+	LimitOrderTickPrefix      = []byte{0x16}
+	LimitOrderOwnerPrefix     = []byte{0x17}
+	KeyNextGlobalLimitOrderId = []byte{0x18}
+
+	DynamicSpreadFactorConfigPrefix = []byte{0x19}
+
+	ManagedPositionPrefix             = []byte{0x1B}
+	KeyManagedPositionsCompoundCursor = []byte{0x1C}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -222,6 +233,82 @@ func KeyPool(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PoolPrefix, poolId))
 }
 
+// Limit Order Prefix Keys
+
+// KeyLimitOrder returns the key consisted of (LimitOrderPrefix | order id) and is used to store limit orders.
+func KeyLimitOrder(orderId uint64) []byte {
+	key := make([]byte, 0, len(LimitOrderPrefix)+uint64ByteSize)
+	key = append(key, LimitOrderPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(orderId)...)
+	return key
+}
+
+// KeyLimitOrderAccumulatorPosition returns the name of the limit order's position in the spread reward and uptime accumulators.
+func KeyLimitOrderAccumulatorPosition(orderId uint64) string {
+	return strings.Join([]string{string(LimitOrderPrefix), strconv.FormatUint(orderId, base10)}, KeySeparator)
+}
+
+// KeyLimitOrderTick returns the key consisted of (LimitOrderTickPrefix | pool id | fill tick index | order id).
+// It indexes the unfilled limit orders by the tick that fills them when crossed.
+func KeyLimitOrderTick(poolId uint64, fillTick int64, orderId uint64) []byte {
+	key := KeyLimitOrderTickPrefix(poolId, fillTick)
+	key = append(key, sdk.Uint64ToBigEndian(orderId)...)
+	return key
+}
+
+// KeyLimitOrderTickPrefix returns the prefix key used to create KeyLimitOrderTick, which only includes pool id + fill tick index.
+// This key can be used to iterate over the unfilled limit orders filled by crossing the given tick.
+func KeyLimitOrderTickPrefix(poolId uint64, fillTick int64) []byte {
+	key := make([]byte, 0, len(LimitOrderTickPrefix)+uint64ByteSize+9+uint64ByteSize)
+	key = append(key, LimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, TickIndexToBytes(fillTick)...)
+	return key
+}
+
+// KeyLimitOrderPoolPrefix returns the prefix key used to iterate over the unfilled limit orders of the given pool.
+func KeyLimitOrderPoolPrefix(poolId uint64) []byte {
+	key := make([]byte, 0, len(LimitOrderTickPrefix)+uint64ByteSize)
+	key = append(key, LimitOrderTickPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyLimitOrderOwner returns the key consisted of (LimitOrderOwnerPrefix | length prefixed owner | pool id | order id).
+func KeyLimitOrderOwner(owner sdk.AccAddress, poolId uint64, orderId uint64) []byte {
+	key := KeyLimitOrderOwnerPoolPrefix(owner, poolId)
+	key = append(key, sdk.Uint64ToBigEndian(orderId)...)
+	return key
+}
+
+// KeyLimitOrderOwnerPoolPrefix returns the prefix key used to iterate over the limit orders of the given owner in the given pool.
+func KeyLimitOrderOwnerPoolPrefix(owner sdk.AccAddress, poolId uint64) []byte {
+	key := KeyLimitOrderOwnerPrefix(owner)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	return key
+}
+
+// KeyLimitOrderOwnerPrefix returns the prefix key used to iterate over all the limit orders of the given owner.
+func KeyLimitOrderOwnerPrefix(owner sdk.AccAddress) []byte {
+	ownerBz := address.MustLengthPrefix(owner)
+	key := make([]byte, 0, len(LimitOrderOwnerPrefix)+len(ownerBz)+2*uint64ByteSize)
+	key = append(key, LimitOrderOwnerPrefix...)
+	key = append(key, ownerBz...)
+	return key
+}
+
+// KeyDynamicSpreadFactorConfig returns the key consisted of (DynamicSpreadFactorConfigPrefix | pool id)
+// and is used to store the dynamic spread factor config of a pool.
+func KeyDynamicSpreadFactorConfig(poolId uint64) []byte {
+	return append(DynamicSpreadFactorConfigPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyManagedPosition returns the key consisted of (ManagedPositionPrefix | position id)
+// and is used to store the managed mode of a position.
+func KeyManagedPosition(positionId uint64) []byte {
+	return append(ManagedPositionPrefix, sdk.Uint64ToBigEndian(positionId)...)
+}
+
 // Incentive Prefix Keys
 // KeyIncentiveRecord is the key used to store incentive records using the combination of
 // pool id + min uptime index + incentive record id.
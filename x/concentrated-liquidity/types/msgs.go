@@ -19,6 +19,11 @@ const (
 	TypeMsgCollectIncentives       = "collect-incentives"
 	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
 	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgPlaceLimitOrder         = "place-limit-order"
+	TypeMsgClaimLimitOrder         = "claim-limit-order"
+	TypeMsgCancelLimitOrder        = "cancel-limit-order"
+	TypeMsgSetManagedPosition      = "set-managed-position"
+	TypeMsgUnsetManagedPosition    = "unset-managed-position"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -267,3 +272,156 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return fmt.Errorf("Invalid pool id (%d)", msg.PoolId)
+	}
+
+	if err := msg.TokenIn.Validate(); err != nil {
+		return fmt.Errorf("Invalid token in (%s)", err)
+	}
+
+	if !msg.TokenIn.Amount.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid order id (%d)", msg.OrderId)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCancelLimitOrder{}
+
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+func (msg MsgCancelLimitOrder) Type() string  { return TypeMsgCancelLimitOrder }
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.OrderId == 0 {
+		return fmt.Errorf("Invalid order id (%d)", msg.OrderId)
+	}
+
+	return nil
+}
+
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetManagedPosition{}
+
+func (msg MsgSetManagedPosition) Route() string { return RouterKey }
+func (msg MsgSetManagedPosition) Type() string  { return TypeMsgSetManagedPosition }
+func (msg MsgSetManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Invalid position id (%d)", msg.PositionId)
+	}
+
+	return nil
+}
+
+func (msg MsgSetManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnsetManagedPosition{}
+
+func (msg MsgUnsetManagedPosition) Route() string { return RouterKey }
+func (msg MsgUnsetManagedPosition) Type() string  { return TypeMsgUnsetManagedPosition }
+func (msg MsgUnsetManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return fmt.Errorf("Invalid position id (%d)", msg.PositionId)
+	}
+
+	return nil
+}
+
+func (msg MsgUnsetManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnsetManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
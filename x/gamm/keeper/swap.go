@@ -74,7 +74,7 @@ func (k Keeper) SwapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin, spreadFactor); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -138,7 +138,7 @@ func (k Keeper) SwapExactAmountOut(
 		return osmomath.Int{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, spreadFactor)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -180,12 +180,14 @@ func (k Keeper) CalcInAmtGivenOut(
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
+// The swap event records the spread factor charged by the swap.
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool poolmanagertypes.PoolI,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	spreadFactor osmomath.Dec,
 ) error {
 	tokensIn := sdk.Coins{tokenIn}
 	tokensOut := sdk.Coins{tokenOut}
@@ -209,7 +211,7 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
-	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut)
+	events.EmitSwapEvent(ctx, sender, pool.GetId(), tokensIn, tokensOut, spreadFactor)
 	k.hooks.AfterCFMMSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
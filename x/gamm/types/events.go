@@ -11,6 +11,7 @@ const (
 	AttributeKeyPoolIdEntering = "pool_id_entering"
 	AttributeKeyPoolIdLeaving  = "pool_id_leaving"
 	AttributeKeySwapFee        = "swap_fee"
+	AttributeKeySpreadFactor   = "spread_factor"
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
 
@@ -27,6 +27,8 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to osmomath.Int.
+	FlagTokenOutMinAmount = "token-out-min-amount"
 )
 
 type createBalancerPoolInputs struct {
@@ -106,3 +108,10 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetTokenOutMinAmount() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTokenOutMinAmount, "0", "Minimum amount of token out for the whole amount in, scaled to the part of it swapped")
+	return fs
+}
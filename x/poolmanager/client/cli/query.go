@@ -30,9 +30,11 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdAllPools)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalVolumeForPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolVolumeHistory)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdOptimalRoute)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -192,6 +194,15 @@ func GetCmdTotalVolumeForPool() (*osmocli.QueryDescriptor, *queryproto.TotalVolu
 	}, &queryproto.TotalVolumeForPoolRequest{}
 }
 
+func GetCmdPoolVolumeHistory() (*osmocli.QueryDescriptor, *queryproto.PoolVolumeHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-volume-history",
+		Short: "Query the volume of a pool over the last 24 hours, 7 days and 30 days, optionally with its hourly volume buckets",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} pool-volume-history 1 false`,
+	}, &queryproto.PoolVolumeHistoryRequest{}
+}
+
 func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingPairTakerFeeRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "trading-pair-taker-fee",
@@ -212,3 +223,15 @@ func GetCmdEstimateTradeBasedOnPriceImpact() (
 		QueryFnName: "EstimateTradeBasedOnPriceImpact",
 	}, &queryproto.EstimateTradeBasedOnPriceImpactRequest{}
 }
+
+// GetCmdOptimalRoute returns the routes that yield the most token out for the given token in.
+func GetCmdOptimalRoute() (*osmocli.QueryDescriptor, *queryproto.OptimalRouteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "optimal-route",
+		Short: "Query the split routes that yield the most token out for the given token in, and the estimated token out amount",
+		Long: `{{.Short}}
+Zero max hops and max splits use the defaults of 3 hops and 3 splits.{{.ExampleHeader}}
+{{.CommandPrefix}} optimal-route 1000000uosmo uatom 3 2`,
+		QueryFnName: "OptimalRoute",
+	}, &queryproto.OptimalRouteRequest{}
+}
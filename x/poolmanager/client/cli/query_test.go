@@ -71,6 +71,21 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			},
 			&poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{},
 		},
+		{
+			"Query optimal route",
+			"/osmosis.poolmanager.v1beta1.Query/OptimalRoute",
+			&poolmanagerqueryproto.OptimalRouteRequest{
+				TokenIn:       "10bar",
+				TokenOutDenom: "baz",
+			},
+			&poolmanagerqueryproto.OptimalRouteResponse{},
+		},
+		{
+			"Query pool volume history",
+			"/osmosis.poolmanager.v1beta1.Query/PoolVolumeHistory",
+			&poolmanagerqueryproto.PoolVolumeHistoryRequest{PoolId: 1, IncludeBuckets: true},
+			&poolmanagerqueryproto.PoolVolumeHistoryResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -37,6 +37,8 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewSwapExactAmountOutCmd)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountIn)
 	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountOut)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithMaxPriceImpactCmd)
+	osmocli.AddTxCmd(txCmd, NewSplitRouteSwapExactAmountInWithMaxPriceImpact)
 	txCmd.AddCommand(NewSetDenomPairTakerFeeCmd())
 
 	txCmd.AddCommand(
@@ -162,6 +164,44 @@ func NewSplitRouteSwapExactAmountOut() (*osmocli.TxCliDesc, *types.MsgSplitRoute
 	}, &types.MsgSplitRouteSwapExactAmountOut{}
 }
 
+func NewSwapExactAmountInWithMaxPriceImpactCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInWithMaxPriceImpact) {
+	return &osmocli.TxCliDesc{
+		Use:     "swap-exact-amount-in-with-max-price-impact",
+		Short:   "swap at most the given amount in whose price deviates from the spot price by at most the max price impact",
+		Example: "osmosisd tx poolmanager swap-exact-amount-in-with-max-price-impact 2000000uosmo 0.01 --swap-route-pool-ids 5 --swap-route-denoms uion --token-out-min-amount 1900000 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo",
+		CustomFlagOverrides: map[string]string{
+			"TokenOutMinAmount": FlagTokenOutMinAmount,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetTokenOutMinAmount()},
+		},
+	}, &types.MsgSwapExactAmountInWithMaxPriceImpact{}
+}
+
+func NewSplitRouteSwapExactAmountInWithMaxPriceImpact() (*osmocli.TxCliDesc, *types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) {
+	return &osmocli.TxCliDesc{
+		Use:   "split-route-swap-exact-amount-in-with-max-price-impact",
+		Short: "split route swap, through each route, at most its amount in whose price deviates from the spot price by at most the max price impact",
+		Example: `osmosisd tx poolmanager split-route-swap-exact-amount-in-with-max-price-impact uosmo 0.01 --routes-file="./routes.json" --token-out-min-amount 1900 --from val --keyring-backend test -b=block --chain-id=localosmosis --fees 10000uosmo
+		- routes.json, in the format of split-route-swap-exact-amount-in
+		`,
+		CustomFlagOverrides: map[string]string{
+			"TokenOutMinAmount": FlagTokenOutMinAmount,
+		},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Routes": osmocli.FlagOnlyParser(NewMsgNewSplitRouteSwapExactAmountIn),
+		},
+		Flags: osmocli.FlagDesc{
+			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetTokenOutMinAmount()},
+		},
+	}, &types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact{}
+}
+
 func NewMsgNewSplitRouteSwapExactAmountOut(fs *flag.FlagSet) ([]types.SwapAmountOutSplitRoute, error) {
 	routesFile, _ := fs.GetString(FlagRoutesFile)
 	if routesFile == "" {
@@ -71,6 +71,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) PoolVolumeHistory(grpcCtx context.Context,
+	req *queryproto.PoolVolumeHistoryRequest,
+) (*queryproto.PoolVolumeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolVolumeHistory(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -91,6 +101,16 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) OptimalRoute(grpcCtx context.Context,
+	req *queryproto.OptimalRouteRequest,
+) (*queryproto.OptimalRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OptimalRoute(ctx, *req)
+}
+
 func (q Querier) NumPools(grpcCtx context.Context,
 	req *queryproto.NumPoolsRequest,
 ) (*queryproto.NumPoolsResponse, error) {
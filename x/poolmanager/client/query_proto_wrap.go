@@ -320,6 +320,42 @@ func (q Querier) TotalVolumeForPool(ctx sdk.Context, req queryproto.TotalVolumeF
 	}, nil
 }
 
+// PoolVolumeHistory returns the volume of the given pool over the volume windows,
+// and optionally the volume buckets that are kept.
+func (q Querier) PoolVolumeHistory(ctx sdk.Context, req queryproto.PoolVolumeHistoryRequest) (*queryproto.PoolVolumeHistoryResponse, error) {
+	if _, err := q.K.GetPool(ctx, req.PoolId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	windows := make([]queryproto.PoolVolumeWindow, 0, len(types.VolumeWindows))
+	for _, window := range types.VolumeWindows {
+		nativeVolume, osmoVolume, err := q.K.GetVolumeForWindow(ctx, req.PoolId, window)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		windows = append(windows, queryproto.PoolVolumeWindow{
+			Window:       window,
+			NativeVolume: nativeVolume,
+			OsmoVolume:   osmoVolume,
+		})
+	}
+
+	buckets := []types.VolumeBucket{}
+	if req.IncludeBuckets {
+		var err error
+		startTime := types.VolumeBucketStartTime(ctx.BlockTime()).Add(-types.VolumeBucketRetention)
+		buckets, err = q.K.GetVolumeBuckets(ctx, req.PoolId, startTime)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &queryproto.PoolVolumeHistoryResponse{
+		Windows: windows,
+		Buckets: buckets,
+	}, nil
+}
+
 // TradingPairTakerFee returns the taker fee for the given trading pair
 func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPairTakerFeeRequest) (*queryproto.TradingPairTakerFeeResponse, error) {
 	tradingPairTakerFee, err := q.K.GetTradingPairTakerFee(ctx, req.Denom_0, req.Denom_1)
@@ -332,6 +368,28 @@ func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPair
 	}, nil
 }
 
+// OptimalRoute returns the split routes that yield the most token out for the given token in
+// and their estimated token out amount.
+func (q Querier) OptimalRoute(ctx sdk.Context, req queryproto.OptimalRouteRequest) (*queryproto.OptimalRouteResponse, error) {
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token in")
+	}
+	if req.TokenOutDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token out denom")
+	}
+
+	routes, tokenOutAmount, err := q.K.OptimalRoute(ctx, tokenIn, req.TokenOutDenom, req.MaxHops, req.MaxSplits)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.OptimalRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+	}, nil
+}
+
 // EstimateTradeBasedOnPriceImpact returns the input and output amount of coins for a pool trade
 // based on external price and maximum price impact.
 func (q Querier) EstimateTradeBasedOnPriceImpact(
@@ -15,21 +15,25 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1300,6 +1304,170 @@ func (m *TotalVolumeForPoolResponse) GetVolume() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+// =============================== PoolVolumeHistory
+type PoolVolumeHistoryRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// include_buckets returns the volume buckets of the last 30 days along with
+	// the windows.
+	IncludeBuckets bool `protobuf:"varint,2,opt,name=include_buckets,json=includeBuckets,proto3" json:"include_buckets,omitempty" yaml:"include_buckets"`
+}
+
+func (m *PoolVolumeHistoryRequest) Reset()         { *m = PoolVolumeHistoryRequest{} }
+func (m *PoolVolumeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeHistoryRequest) ProtoMessage()    {}
+func (*PoolVolumeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{26}
+}
+func (m *PoolVolumeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeHistoryRequest.Merge(m, src)
+}
+func (m *PoolVolumeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeHistoryRequest proto.InternalMessageInfo
+
+func (m *PoolVolumeHistoryRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolumeHistoryRequest) GetIncludeBuckets() bool {
+	if m != nil {
+		return m.IncludeBuckets
+	}
+	return false
+}
+
+type PoolVolumeHistoryResponse struct {
+	// windows are the volumes over the last 24 hours, 7 days and 30 days.
+	Windows []PoolVolumeWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows" yaml:"windows"`
+	// buckets are sorted by start time.
+	Buckets []types.VolumeBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets" yaml:"buckets"`
+}
+
+func (m *PoolVolumeHistoryResponse) Reset()         { *m = PoolVolumeHistoryResponse{} }
+func (m *PoolVolumeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeHistoryResponse) ProtoMessage()    {}
+func (*PoolVolumeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{27}
+}
+func (m *PoolVolumeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeHistoryResponse.Merge(m, src)
+}
+func (m *PoolVolumeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeHistoryResponse proto.InternalMessageInfo
+
+func (m *PoolVolumeHistoryResponse) GetWindows() []PoolVolumeWindow {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+func (m *PoolVolumeHistoryResponse) GetBuckets() []types.VolumeBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// PoolVolumeWindow is the swap volume of a pool over the latest volume
+// buckets spanning window, the current one included.
+type PoolVolumeWindow struct {
+	Window       time.Duration                            `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	NativeVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=native_volume,json=nativeVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"native_volume" yaml:"native_volume"`
+	OsmoVolume   cosmossdk_io_math.Int                    `protobuf:"bytes,3,opt,name=osmo_volume,json=osmoVolume,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_volume" yaml:"osmo_volume"`
+}
+
+func (m *PoolVolumeWindow) Reset()         { *m = PoolVolumeWindow{} }
+func (m *PoolVolumeWindow) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeWindow) ProtoMessage()    {}
+func (*PoolVolumeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{28}
+}
+func (m *PoolVolumeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeWindow.Merge(m, src)
+}
+func (m *PoolVolumeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeWindow proto.InternalMessageInfo
+
+func (m *PoolVolumeWindow) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PoolVolumeWindow) GetNativeVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NativeVolume
+	}
+	return nil
+}
+
 // =============================== TradingPairTakerFee
 type TradingPairTakerFeeRequest struct {
 	Denom_0 string `protobuf:"bytes,1,opt,name=denom_0,json=denom0,proto3" json:"denom_0,omitempty" yaml:"denom_0"`
@@ -1310,7 +1478,7 @@ func (m *TradingPairTakerFeeRequest) Reset()         { *m = TradingPairTakerFeeR
 func (m *TradingPairTakerFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeRequest) ProtoMessage()    {}
 func (*TradingPairTakerFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{26}
+	return fileDescriptor_6256a4106f701b7d, []int{29}
 }
 func (m *TradingPairTakerFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1529,7 @@ func (m *TradingPairTakerFeeResponse) Reset()         { *m = TradingPairTakerFee
 func (m *TradingPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingPairTakerFeeResponse) ProtoMessage()    {}
 func (*TradingPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{27}
+	return fileDescriptor_6256a4106f701b7d, []int{30}
 }
 func (m *TradingPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,7 +1585,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactRequest) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{28}
+	return fileDescriptor_6256a4106f701b7d, []int{31}
 }
 func (m *EstimateTradeBasedOnPriceImpactRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1486,7 +1654,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Reset() {
 func (m *EstimateTradeBasedOnPriceImpactResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateTradeBasedOnPriceImpactResponse) ProtoMessage()    {}
 func (*EstimateTradeBasedOnPriceImpactResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6256a4106f701b7d, []int{29}
+	return fileDescriptor_6256a4106f701b7d, []int{32}
 }
 func (m *EstimateTradeBasedOnPriceImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1529,6 +1697,128 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) GetOutputCoin() types2.Coin {
 	return types2.Coin{}
 }
 
+// =============================== OptimalRoute
+type OptimalRouteRequest struct {
+	// token_in is the coin to swap, e.g. 1000000uosmo.
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools of a route. Zero defaults to 3,
+	// and it must not exceed 4.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_splits is the maximum number of routes the token in is split across.
+	// Zero defaults to 3, and it must not exceed 5.
+	MaxSplits uint64 `protobuf:"varint,4,opt,name=max_splits,json=maxSplits,proto3" json:"max_splits,omitempty" yaml:"max_splits"`
+}
+
+func (m *OptimalRouteRequest) Reset()         { *m = OptimalRouteRequest{} }
+func (m *OptimalRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*OptimalRouteRequest) ProtoMessage()    {}
+func (*OptimalRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{33}
+}
+func (m *OptimalRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptimalRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptimalRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptimalRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimalRouteRequest.Merge(m, src)
+}
+func (m *OptimalRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptimalRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimalRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptimalRouteRequest proto.InternalMessageInfo
+
+func (m *OptimalRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *OptimalRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *OptimalRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *OptimalRouteRequest) GetMaxSplits() uint64 {
+	if m != nil {
+		return m.MaxSplits
+	}
+	return 0
+}
+
+type OptimalRouteResponse struct {
+	// routes are sorted by their token in amount.
+	Routes []types.SwapAmountInSplitRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	// token_out_amount is the estimated token out amount of the routes, taker
+	// fees and spread factors included.
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *OptimalRouteResponse) Reset()         { *m = OptimalRouteResponse{} }
+func (m *OptimalRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*OptimalRouteResponse) ProtoMessage()    {}
+func (*OptimalRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{34}
+}
+func (m *OptimalRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptimalRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptimalRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptimalRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimalRouteResponse.Merge(m, src)
+}
+func (m *OptimalRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptimalRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimalRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptimalRouteResponse proto.InternalMessageInfo
+
+func (m *OptimalRouteResponse) GetRoutes() []types.SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
@@ -1556,10 +1846,15 @@ func init() {
 	proto.RegisterType((*TotalLiquidityResponse)(nil), "osmosis.poolmanager.v1beta1.TotalLiquidityResponse")
 	proto.RegisterType((*TotalVolumeForPoolRequest)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolRequest")
 	proto.RegisterType((*TotalVolumeForPoolResponse)(nil), "osmosis.poolmanager.v1beta1.TotalVolumeForPoolResponse")
+	proto.RegisterType((*PoolVolumeHistoryRequest)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeHistoryRequest")
+	proto.RegisterType((*PoolVolumeHistoryResponse)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeHistoryResponse")
+	proto.RegisterType((*PoolVolumeWindow)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeWindow")
 	proto.RegisterType((*TradingPairTakerFeeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeRequest")
 	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*OptimalRouteRequest)(nil), "osmosis.poolmanager.v1beta1.OptimalRouteRequest")
+	proto.RegisterType((*OptimalRouteResponse)(nil), "osmosis.poolmanager.v1beta1.OptimalRouteResponse")
 }
 
 func init() {
@@ -1567,135 +1862,159 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2040 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x73, 0x1b, 0x49,
-	0xf5, 0xcf, 0xc8, 0x3f, 0xd6, 0x7a, 0x8e, 0x6d, 0xa5, 0x13, 0x27, 0xb6, 0x92, 0xaf, 0xe5, 0xed,
-	0xec, 0x37, 0xeb, 0x8d, 0xa3, 0x51, 0x2c, 0x27, 0x24, 0x04, 0x76, 0x83, 0x64, 0x3b, 0x1b, 0x41,
-	0x20, 0xde, 0x89, 0xf7, 0x07, 0x0b, 0x61, 0x6a, 0x6c, 0x75, 0x94, 0x21, 0x9a, 0x19, 0x45, 0xd3,
-	0xe3, 0x58, 0x45, 0xed, 0x85, 0x2a, 0x6a, 0x39, 0x51, 0x0b, 0x1c, 0xf6, 0xc0, 0x81, 0xe2, 0xc0,
-	0x85, 0x1f, 0xc5, 0x85, 0x0b, 0x77, 0x0e, 0x29, 0xaa, 0xa0, 0x52, 0x05, 0x07, 0x8a, 0x83, 0xa0,
-	0x12, 0x0e, 0x54, 0x41, 0x71, 0x10, 0xff, 0x00, 0xd5, 0x3f, 0x66, 0x24, 0x8d, 0xa5, 0xd1, 0x48,
-	0xce, 0x81, 0x53, 0xe4, 0xee, 0xf7, 0x5e, 0x7f, 0x3e, 0xaf, 0xdf, 0xa7, 0xbb, 0xdf, 0x04, 0x5e,
-	0x77, 0x5c, 0xcb, 0x71, 0x4d, 0x37, 0x57, 0x73, 0x9c, 0xaa, 0x65, 0xd8, 0x46, 0x85, 0xd4, 0x73,
-	0xfb, 0x6b, 0xbb, 0x84, 0x1a, 0x6b, 0xb9, 0xc7, 0x1e, 0xa9, 0x37, 0xd4, 0x5a, 0xdd, 0xa1, 0x0e,
-	0x3a, 0x2b, 0x0d, 0xd5, 0x0e, 0x43, 0x55, 0x1a, 0xa6, 0x4f, 0x55, 0x9c, 0x8a, 0xc3, 0xed, 0x72,
-	0xec, 0x97, 0x70, 0x49, 0xbf, 0x11, 0x15, 0xbb, 0x42, 0x6c, 0xc2, 0xc3, 0x71, 0xd3, 0xd7, 0xa2,
-	0x4c, 0xe9, 0x81, 0xb4, 0xba, 0x14, 0x65, 0xe5, 0x3e, 0x31, 0x6a, 0x7a, 0xdd, 0xf1, 0x28, 0x91,
-	0xd6, 0x4b, 0x7b, 0xdc, 0x3c, 0xb7, 0x6b, 0xb8, 0x24, 0xb0, 0xda, 0x73, 0x4c, 0x5b, 0xce, 0x5f,
-	0xec, 0x9c, 0xe7, 0x54, 0x03, 0xab, 0x9a, 0x51, 0x31, 0x6d, 0x83, 0x9a, 0x8e, 0x6f, 0x7b, 0xae,
-	0xe2, 0x38, 0x95, 0x2a, 0xc9, 0x19, 0x35, 0x33, 0x67, 0xd8, 0xb6, 0x43, 0xf9, 0xa4, 0x8f, 0x7e,
-	0x51, 0xce, 0xf2, 0xbf, 0x76, 0xbd, 0x07, 0x39, 0xc3, 0x6e, 0xf8, 0x53, 0x62, 0x11, 0x5d, 0x24,
-	0x47, 0xfc, 0x21, 0xa7, 0x32, 0x61, 0x2f, 0x6a, 0x5a, 0xc4, 0xa5, 0x86, 0x55, 0x13, 0x06, 0x78,
-	0x0e, 0x66, 0xb6, 0x8d, 0xba, 0x61, 0xb9, 0x1a, 0x79, 0xec, 0x11, 0x97, 0xe2, 0x7b, 0x30, 0xeb,
-	0x0f, 0xb8, 0x35, 0xc7, 0x76, 0x09, 0x2a, 0xc0, 0x64, 0x8d, 0x8f, 0x2c, 0x28, 0xcb, 0xca, 0xca,
-	0x74, 0xfe, 0xbc, 0x1a, 0xb1, 0x4d, 0xaa, 0x70, 0x2e, 0x8e, 0x3f, 0x6d, 0x66, 0x8e, 0x69, 0xd2,
-	0x11, 0xff, 0x5b, 0x81, 0xe5, 0x2d, 0x97, 0x9a, 0x96, 0x41, 0xc9, 0xbd, 0x27, 0x46, 0x6d, 0xeb,
-	0xc0, 0xd8, 0xa3, 0x05, 0xcb, 0xf1, 0x6c, 0x5a, 0xb2, 0xe5, 0xca, 0x28, 0x0b, 0xaf, 0xb0, 0x80,
-	0xba, 0x59, 0x5e, 0x48, 0x2c, 0x2b, 0x2b, 0xe3, 0xc5, 0x53, 0xad, 0x66, 0x66, 0xb6, 0x61, 0x58,
-	0xd5, 0x1b, 0x58, 0x4e, 0xe0, 0x05, 0x45, 0x9b, 0x64, 0xbf, 0x4b, 0x65, 0xa4, 0xc2, 0x14, 0x75,
-	0x1e, 0x11, 0x5b, 0x37, 0xed, 0x85, 0xb1, 0x65, 0x65, 0x25, 0x59, 0x3c, 0xd9, 0x6a, 0x66, 0xe6,
-	0x84, 0xbd, 0x3f, 0x83, 0xb5, 0x57, 0xf8, 0xcf, 0x92, 0x8d, 0xee, 0xc3, 0x24, 0xdf, 0x39, 0x77,
-	0x61, 0x7c, 0x79, 0x6c, 0x65, 0x3a, 0xaf, 0x46, 0xd2, 0x60, 0x28, 0x03, 0x80, 0xcc, 0xad, 0x38,
-	0xcf, 0x18, 0xb5, 0x9a, 0x99, 0x19, 0xb1, 0x82, 0x88, 0x85, 0x35, 0x19, 0xf4, 0x8b, 0xe3, 0x53,
-	0x4a, 0x2a, 0xa1, 0x4d, 0xba, 0xc4, 0x2e, 0x93, 0x3a, 0xfe, 0x45, 0x02, 0xf2, 0x7d, 0x09, 0xbf,
-	0x6f, 0xd2, 0x87, 0xdb, 0x75, 0xd3, 0x32, 0xa9, 0xb9, 0x4f, 0x76, 0x1a, 0x35, 0xe2, 0xf6, 0x48,
-	0x81, 0x32, 0x64, 0x0a, 0x12, 0x31, 0x52, 0x70, 0x13, 0x66, 0x05, 0x5a, 0xdd, 0x5f, 0x65, 0x6c,
-	0x79, 0x6c, 0x65, 0xbc, 0xb8, 0xd8, 0x6a, 0x66, 0xe6, 0x3b, 0x69, 0xf9, 0xf3, 0x58, 0x3b, 0x2e,
-	0x06, 0xb6, 0xc5, 0x82, 0xef, 0xc1, 0x69, 0x69, 0x20, 0xa2, 0x3b, 0x1e, 0xd5, 0xcb, 0xc4, 0x76,
-	0x2c, 0x9e, 0xd3, 0x64, 0xf1, 0xd5, 0x56, 0x33, 0xf3, 0x7f, 0x5d, 0x81, 0x42, 0x76, 0x58, 0x3b,
-	0x29, 0x26, 0x76, 0xd8, 0xf8, 0x5d, 0x8f, 0x6e, 0xf2, 0xd1, 0xdf, 0x2b, 0x70, 0x31, 0x48, 0x97,
-	0x69, 0x57, 0xaa, 0x84, 0x2d, 0xd8, 0xb7, 0x52, 0x56, 0xc3, 0x69, 0x42, 0x87, 0xd3, 0x34, 0x72,
-	0x92, 0x8a, 0x30, 0x17, 0x26, 0x27, 0xca, 0x2b, 0xdd, 0x6a, 0x66, 0x4e, 0x77, 0xba, 0x75, 0xb0,
-	0x9a, 0xa1, 0x5d, 0x7c, 0x3e, 0x56, 0xe0, 0xd5, 0x88, 0x7a, 0x97, 0xc2, 0xda, 0x85, 0x54, 0x3b,
-	0x90, 0xc1, 0x67, 0x39, 0x9f, 0x64, 0xf1, 0x3a, 0xab, 0xb5, 0xbf, 0x34, 0x33, 0xf3, 0x42, 0xcc,
-	0x6e, 0xf9, 0x91, 0x6a, 0x3a, 0x39, 0xcb, 0xa0, 0x0f, 0xd5, 0x92, 0x4d, 0x5b, 0xcd, 0xcc, 0x99,
-	0x30, 0x0e, 0xe1, 0x8e, 0xb5, 0x59, 0x1f, 0x88, 0x58, 0x0d, 0xff, 0xa7, 0x3f, 0x92, 0xbb, 0x1e,
-	0x1d, 0x51, 0x7a, 0xdf, 0x08, 0xa4, 0x34, 0xc6, 0xa5, 0x94, 0x8b, 0x29, 0x25, 0xb6, 0x62, 0x0c,
-	0x2d, 0xa1, 0x35, 0x48, 0x06, 0xcc, 0x16, 0xc6, 0x79, 0x46, 0x18, 0xa0, 0x54, 0x88, 0x34, 0xd6,
-	0xa6, 0x7c, 0xb6, 0x21, 0xf9, 0xfd, 0x32, 0x01, 0xeb, 0xfd, 0x59, 0xbf, 0x34, 0xfd, 0x1d, 0xd6,
-	0x53, 0x62, 0x38, 0x3d, 0xdd, 0x83, 0xf9, 0x2e, 0x9d, 0x98, 0x76, 0x50, 0x71, 0x4c, 0x4e, 0xcb,
-	0xad, 0x66, 0xe6, 0x5c, 0x0f, 0x39, 0xf9, 0x66, 0x58, 0x43, 0x1d, 0x6a, 0x2a, 0xd9, 0xbc, 0xf8,
-	0x46, 0xc8, 0x1e, 0xfe, 0x83, 0x02, 0xab, 0x03, 0xf5, 0xd7, 0x51, 0x2f, 0x43, 0x09, 0xf0, 0x26,
-	0xcc, 0x86, 0xd8, 0x09, 0x19, 0x76, 0x64, 0x29, 0x4c, 0xeb, 0x38, 0xed, 0x4b, 0x68, 0x2c, 0x16,
-	0xa1, 0xef, 0x28, 0x80, 0xa3, 0xca, 0x5e, 0x2a, 0x50, 0xf7, 0xb5, 0x6e, 0xda, 0xdd, 0x02, 0xbc,
-	0x36, 0x48, 0x80, 0xa7, 0x43, 0xc0, 0x7d, 0xfd, 0xcd, 0x48, 0xe4, 0x52, 0x7e, 0x27, 0x60, 0xee,
-	0x2b, 0x9e, 0xc5, 0x92, 0x19, 0x5c, 0xb0, 0x5b, 0x90, 0x6a, 0x0f, 0x49, 0x1c, 0x6b, 0x90, 0xb4,
-	0x3d, 0x8b, 0x57, 0x89, 0xdb, 0x51, 0x79, 0x92, 0x61, 0x30, 0x85, 0xb5, 0x29, 0x5b, 0xba, 0xe2,
-	0x1b, 0x30, 0xcd, 0x7e, 0x8c, 0xb2, 0x23, 0x78, 0x03, 0x8e, 0x0b, 0x5f, 0xb9, 0xfc, 0x3a, 0x8c,
-	0xb3, 0x19, 0x79, 0xbf, 0x9f, 0x52, 0xc5, 0xa3, 0x41, 0xf5, 0x1f, 0x0d, 0x6a, 0xc1, 0x6e, 0x14,
-	0x93, 0xbf, 0xfb, 0x75, 0x76, 0x82, 0x97, 0xad, 0xc6, 0x8d, 0x19, 0xb5, 0x42, 0xb5, 0xda, 0x45,
-	0xad, 0x04, 0xa9, 0xf6, 0x90, 0x8c, 0x7d, 0x15, 0x26, 0x7c, 0x5a, 0x63, 0x71, 0x82, 0x0b, 0x6b,
-	0x5c, 0x80, 0x33, 0x77, 0x4c, 0x97, 0xf2, 0x58, 0xc5, 0x06, 0xaf, 0x03, 0x9f, 0xea, 0x05, 0x98,
-	0x10, 0x65, 0x24, 0xb6, 0x2a, 0xd5, 0x6a, 0x66, 0x8e, 0x0b, 0xa2, 0xb2, 0x7a, 0xc4, 0x34, 0x7e,
-	0x07, 0x16, 0x0e, 0x87, 0x38, 0x1a, 0xaa, 0x67, 0x0a, 0xa4, 0xee, 0xd5, 0x1c, 0xba, 0x5d, 0x37,
-	0xf7, 0xc8, 0x48, 0x62, 0xd8, 0x82, 0x14, 0x7b, 0x0b, 0xea, 0x86, 0xeb, 0x12, 0xda, 0x25, 0x87,
-	0xb3, 0xed, 0x63, 0x3d, 0x6c, 0x81, 0xb5, 0x59, 0x36, 0x54, 0x60, 0x23, 0x42, 0x12, 0xb7, 0xe1,
-	0xc4, 0x63, 0xcf, 0xa1, 0xdd, 0x71, 0x84, 0x34, 0xce, 0xb5, 0x9a, 0x99, 0x05, 0x11, 0xe7, 0x90,
-	0x09, 0xd6, 0xe6, 0xf8, 0x58, 0x3b, 0x12, 0x2e, 0xc1, 0x89, 0x0e, 0x46, 0x32, 0x3d, 0x57, 0x00,
-	0xdc, 0x9a, 0x43, 0xf5, 0x1a, 0x1b, 0x95, 0x79, 0x9e, 0x6f, 0x35, 0x33, 0x27, 0x44, 0xdc, 0xf6,
-	0x1c, 0xd6, 0x92, 0xae, 0xef, 0x8d, 0x6f, 0xc3, 0xe2, 0x8e, 0x43, 0x0d, 0x5e, 0x00, 0x77, 0xcc,
-	0xc7, 0x9e, 0x59, 0x36, 0x69, 0x63, 0xa4, 0x02, 0xfd, 0x91, 0x02, 0xe9, 0x5e, 0xa1, 0x24, 0xbc,
-	0x8f, 0x20, 0x59, 0xf5, 0x07, 0xe5, 0x0e, 0x2e, 0xaa, 0xf2, 0xdd, 0xcb, 0x12, 0x15, 0x5c, 0x3d,
-	0x1b, 0x8e, 0x69, 0x17, 0x37, 0xe5, 0x65, 0x23, 0xd5, 0x14, 0x78, 0xe2, 0x9f, 0xfd, 0x35, 0xb3,
-	0x52, 0x31, 0xe9, 0x43, 0x6f, 0x57, 0xdd, 0x73, 0x2c, 0xf9, 0x70, 0x96, 0xff, 0x64, 0xdd, 0xf2,
-	0xa3, 0x1c, 0x65, 0x77, 0x03, 0x0f, 0xe2, 0x6a, 0xed, 0x15, 0xf1, 0x19, 0x98, 0xe7, 0xe0, 0xc2,
-	0x1c, 0xf1, 0xa7, 0x0a, 0x9c, 0x0e, 0xcf, 0xfc, 0x6f, 0x40, 0xf6, 0xb7, 0xe6, 0x3d, 0xa7, 0xea,
-	0x59, 0xe4, 0x96, 0x53, 0x1f, 0xf9, 0xec, 0xf8, 0x81, 0xbf, 0x35, 0xa1, 0x50, 0x92, 0x27, 0x85,
-	0xc9, 0x7d, 0x3e, 0x31, 0x98, 0x64, 0xa1, 0xfb, 0x11, 0x20, 0xdc, 0x86, 0x63, 0x28, 0xd7, 0xc2,
-	0xfb, 0x90, 0xde, 0xa9, 0x1b, 0x65, 0xd3, 0xae, 0x6c, 0x1b, 0x66, 0x7d, 0xc7, 0x78, 0x44, 0xea,
-	0xb7, 0x48, 0xa7, 0x40, 0x79, 0xf5, 0xeb, 0x97, 0x65, 0x29, 0x77, 0xf0, 0x93, 0x13, 0x58, 0x9b,
-	0xe4, 0xbf, 0x2e, 0xb7, 0x8d, 0xd7, 0xa4, 0x2e, 0x0f, 0x19, 0xaf, 0xf9, 0xc6, 0x6b, 0xf8, 0x9b,
-	0x70, 0xb6, 0xe7, 0xba, 0x32, 0x19, 0x5f, 0x82, 0x24, 0x65, 0x63, 0xfa, 0x03, 0xe2, 0xab, 0x48,
-	0x95, 0x17, 0xcb, 0x85, 0x18, 0x1c, 0x37, 0xc9, 0x9e, 0x36, 0x45, 0x65, 0x50, 0xfc, 0xa7, 0x04,
-	0x5c, 0xf0, 0xaf, 0x34, 0xb6, 0x28, 0x29, 0x1a, 0x2e, 0x29, 0xdf, 0xb5, 0xb9, 0xf6, 0x4a, 0x56,
-	0xcd, 0xd8, 0x0b, 0xae, 0xe7, 0xcf, 0x43, 0xf2, 0x41, 0xdd, 0xb1, 0x74, 0xd6, 0x88, 0xca, 0x43,
-	0x3d, 0x62, 0x1f, 0x44, 0xab, 0x36, 0xc5, 0x3c, 0xd8, 0xdf, 0x08, 0xc3, 0x0c, 0x75, 0xb8, 0x6f,
-	0xe7, 0xf9, 0xa4, 0x4d, 0x53, 0x87, 0x4d, 0x8b, 0xf3, 0xe7, 0x4c, 0xbb, 0x64, 0xd8, 0xa9, 0x33,
-	0x1e, 0x9c, 0x6f, 0x1f, 0x40, 0xca, 0x32, 0x0e, 0xc4, 0xe1, 0xa0, 0x9b, 0x1c, 0x95, 0x7c, 0x83,
-	0x0c, 0xcb, 0x7c, 0xd6, 0x32, 0x0e, 0x3a, 0xb8, 0xa1, 0x77, 0x61, 0x96, 0x1c, 0x50, 0x52, 0xb7,
-	0x8d, 0xaa, 0x3c, 0x97, 0x26, 0x46, 0x8a, 0x3b, 0xe3, 0x47, 0x11, 0x87, 0xd6, 0xcf, 0x15, 0x78,
-	0x7d, 0x60, 0x5a, 0xe5, 0x7e, 0xbe, 0x05, 0x60, 0xda, 0x35, 0x8f, 0x0e, 0x95, 0xd8, 0x24, 0x77,
-	0xe1, 0x99, 0xfd, 0x02, 0x4c, 0x3b, 0x1e, 0x0d, 0x02, 0x24, 0xe2, 0x05, 0x00, 0xe1, 0xc3, 0x46,
-	0xf2, 0x1f, 0x9f, 0x83, 0x89, 0x77, 0x3c, 0x52, 0x6f, 0xa0, 0xef, 0x29, 0x30, 0x29, 0x7a, 0x6d,
-	0x74, 0x31, 0x46, 0x43, 0x2e, 0x4b, 0x23, 0xbd, 0x1a, 0xcb, 0x56, 0xf0, 0xc5, 0xab, 0xdf, 0xfe,
-	0xe3, 0xdf, 0x7f, 0x98, 0xf8, 0x7f, 0x74, 0x3e, 0x17, 0xf5, 0x51, 0x44, 0xa2, 0xf8, 0x87, 0x02,
-	0x8b, 0x7d, 0x7b, 0x1e, 0xf4, 0x66, 0xe4, 0xba, 0x83, 0xbe, 0x0d, 0xa4, 0xdf, 0x1a, 0xd5, 0x5d,
-	0x32, 0xb9, 0xc3, 0x99, 0xdc, 0x42, 0x9b, 0x91, 0x4c, 0xbe, 0x25, 0x6b, 0xfa, 0xa3, 0x1c, 0x91,
-	0x11, 0xc5, 0x17, 0x1f, 0xc2, 0x62, 0xca, 0x27, 0x9e, 0x6e, 0xda, 0xe8, 0x27, 0x89, 0x8e, 0xe7,
-	0xf2, 0xe0, 0xee, 0x1e, 0xdd, 0x1d, 0x0d, 0x7d, 0xdf, 0x3e, 0xe5, 0xc8, 0xe9, 0x30, 0x78, 0x3a,
-	0xbe, 0x86, 0xbe, 0xfa, 0x32, 0xd2, 0xa1, 0x3f, 0x31, 0xe9, 0x43, 0x26, 0x49, 0x01, 0x54, 0xe7,
-	0x52, 0x43, 0xdf, 0x4d, 0xc0, 0xf9, 0x18, 0x2d, 0x3d, 0x7a, 0x3b, 0x1e, 0x95, 0x81, 0x1f, 0x05,
-	0x8e, 0x9c, 0x93, 0x0f, 0x78, 0x4e, 0x34, 0xb4, 0x3d, 0x74, 0x4e, 0x38, 0x36, 0xd1, 0xe2, 0xf5,
-	0x2c, 0x97, 0x7f, 0x29, 0x90, 0xee, 0xdf, 0x8c, 0xa0, 0x91, 0x80, 0xb7, 0x9b, 0xb1, 0xf4, 0xcd,
-	0x91, 0xfd, 0x25, 0xf3, 0x2f, 0x73, 0xe6, 0x6f, 0xa3, 0xad, 0xa3, 0x57, 0x83, 0xe3, 0x51, 0xf4,
-	0xd3, 0x04, 0x5c, 0x1a, 0xa6, 0xf9, 0x46, 0xdb, 0x23, 0x12, 0xe8, 0xaf, 0x8f, 0x23, 0xa7, 0x64,
-	0x97, 0xa7, 0xe4, 0xeb, 0xe8, 0xc3, 0x97, 0x92, 0x92, 0xde, 0x0a, 0xf9, 0x24, 0x01, 0xaf, 0xc5,
-	0x69, 0xba, 0xd1, 0xed, 0xa3, 0x49, 0xe4, 0x65, 0x96, 0xca, 0x7d, 0x9e, 0x97, 0xf7, 0xd1, 0xbb,
-	0x43, 0xe6, 0x85, 0x65, 0x61, 0x80, 0x50, 0x58, 0xe9, 0x7c, 0xaa, 0xc0, 0x94, 0xdf, 0x1c, 0xa3,
-	0x4b, 0x91, 0x60, 0x43, 0x6d, 0x75, 0x3a, 0x1b, 0xd3, 0x5a, 0x12, 0x51, 0x39, 0x91, 0x15, 0x74,
-	0x21, 0x92, 0x48, 0xd0, 0x79, 0xa3, 0xef, 0x2b, 0x30, 0xce, 0x22, 0xa0, 0x95, 0xe8, 0x0b, 0xb4,
-	0xfd, 0xac, 0x4e, 0xbf, 0x11, 0xc3, 0x52, 0xa2, 0xb9, 0xc2, 0xd1, 0xa8, 0xe8, 0x52, 0x24, 0x1a,
-	0x8e, 0xa4, 0x9d, 0x5c, 0x9e, 0x2d, 0xbf, 0xdf, 0x1e, 0x90, 0xad, 0x50, 0xa7, 0x3e, 0x20, 0x5b,
-	0xe1, 0x26, 0x3e, 0x66, 0xb6, 0x8c, 0x6a, 0x35, 0x2b, 0xb2, 0xf5, 0x1b, 0x05, 0x52, 0xe1, 0xde,
-	0x1b, 0x5d, 0x89, 0x5c, 0xb3, 0x4f, 0xb7, 0x9f, 0xbe, 0x3a, 0xa4, 0x97, 0x44, 0x7c, 0x9d, 0x23,
-	0xce, 0xa3, 0xcb, 0x91, 0x88, 0xab, 0xa6, 0x4b, 0x05, 0xe4, 0xec, 0x6e, 0x23, 0xcb, 0x5f, 0xbb,
-	0xe8, 0xc7, 0x0a, 0x24, 0x83, 0x8e, 0x18, 0x45, 0x27, 0x2a, 0xfc, 0x2d, 0x20, 0xad, 0xc6, 0x35,
-	0x97, 0x30, 0xd7, 0x39, 0xcc, 0x2c, 0x5a, 0xed, 0x09, 0x33, 0xb4, 0xe1, 0x39, 0xfe, 0xec, 0x75,
-	0xd1, 0x33, 0x05, 0xd0, 0xe1, 0xee, 0x18, 0x7d, 0x26, 0x72, 0xed, 0xbe, 0x9d, 0x79, 0xfa, 0xda,
-	0xd0, 0x7e, 0x12, 0x7c, 0x89, 0x83, 0xdf, 0x40, 0x85, 0x61, 0xaa, 0x36, 0x47, 0x59, 0x40, 0x71,
-	0x08, 0x04, 0xfd, 0x29, 0xfa, 0x95, 0x02, 0xb3, 0xdd, 0x9d, 0x33, 0xca, 0x0f, 0x86, 0x75, 0x88,
-	0xca, 0xfa, 0x50, 0x3e, 0x43, 0x89, 0x4f, 0xc0, 0x6e, 0x23, 0x7e, 0xea, 0x6f, 0x42, 0x57, 0x1f,
-	0x1c, 0x67, 0x13, 0x7a, 0xf5, 0xe0, 0x71, 0x36, 0xa1, 0x67, 0xc3, 0x8d, 0x0b, 0x1c, 0xfd, 0xe7,
-	0xd0, 0x67, 0x47, 0xd8, 0x04, 0xd1, 0x3d, 0xa3, 0xdf, 0x2a, 0x70, 0xb2, 0x47, 0x1b, 0x8b, 0x06,
-	0x60, 0xea, 0xdb, 0x70, 0xa7, 0xaf, 0x0f, 0xef, 0x28, 0xd9, 0xdc, 0xe0, 0x6c, 0xae, 0xa0, 0x7c,
-	0xf4, 0x5e, 0x88, 0x08, 0x7a, 0xcd, 0x30, 0xeb, 0x3a, 0x6f, 0x90, 0x1f, 0x10, 0x82, 0xfe, 0xa9,
-	0x40, 0x66, 0x40, 0x27, 0x87, 0x36, 0x62, 0x5d, 0x80, 0xd1, 0xed, 0x75, 0x7a, 0xf3, 0x68, 0x41,
-	0x24, 0xd5, 0x37, 0x39, 0xd5, 0x6b, 0xe8, 0xea, 0xb0, 0x57, 0x29, 0xe5, 0x81, 0xef, 0x3f, 0x7d,
-	0xbe, 0xa4, 0x3c, 0x7b, 0xbe, 0xa4, 0xfc, 0xed, 0xf9, 0x92, 0xf2, 0xc9, 0x8b, 0xa5, 0x63, 0xcf,
-	0x5e, 0x2c, 0x1d, 0xfb, 0xf3, 0x8b, 0xa5, 0x63, 0x1f, 0x6e, 0x74, 0x34, 0xc2, 0x32, 0x74, 0xb6,
-	0x6a, 0xec, 0xba, 0xc1, 0x3a, 0xfb, 0xf9, 0x7c, 0xee, 0xa0, 0x6b, 0xb5, 0xbd, 0xaa, 0x49, 0x6c,
-	0x2a, 0xfe, 0x83, 0x5a, 0x7c, 0x02, 0x9d, 0xe4, 0xff, 0xac, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x8e, 0x0b, 0x2e, 0x52, 0xbc, 0x1f, 0x00, 0x00,
+	// 2420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1b, 0x69,
+	0xf9, 0xef, 0x38, 0x6e, 0x1a, 0x3f, 0xf9, 0x72, 0xdf, 0x36, 0xad, 0xe3, 0xf6, 0x1f, 0x67, 0xdf,
+	0xee, 0xbf, 0x9b, 0x36, 0xb5, 0xdd, 0x24, 0xed, 0xb6, 0x74, 0xe9, 0x96, 0x38, 0x69, 0x37, 0x81,
+	0x42, 0xb3, 0xd3, 0xec, 0x76, 0xd9, 0xa5, 0x8c, 0x26, 0xf6, 0x34, 0x19, 0xe2, 0xf9, 0xa8, 0xe7,
+	0x75, 0x9a, 0x08, 0xed, 0x05, 0x09, 0xb1, 0x07, 0x84, 0x16, 0x38, 0x2c, 0x12, 0x07, 0xc4, 0x01,
+	0x21, 0xf1, 0x21, 0x2e, 0x5c, 0x38, 0x22, 0x21, 0x51, 0x21, 0x81, 0x2a, 0xc1, 0x01, 0x38, 0x78,
+	0x51, 0xcb, 0x01, 0x09, 0x04, 0x92, 0x39, 0x72, 0x41, 0xef, 0xc7, 0x8c, 0xc7, 0x13, 0x7b, 0x3c,
+	0xe3, 0xe4, 0xc0, 0x29, 0xe3, 0xf7, 0x7d, 0x3e, 0x7e, 0xbf, 0xe7, 0x7d, 0x9e, 0xf7, 0xe3, 0x51,
+	0xe0, 0x15, 0xcb, 0x31, 0x2c, 0x47, 0x77, 0x8a, 0xb6, 0x65, 0x55, 0x0d, 0xd5, 0x54, 0x37, 0xb5,
+	0x5a, 0x71, 0x67, 0x6e, 0x43, 0x23, 0xea, 0x5c, 0xf1, 0x71, 0x5d, 0xab, 0xed, 0x15, 0xec, 0x9a,
+	0x45, 0x2c, 0x74, 0x46, 0x08, 0x16, 0x7c, 0x82, 0x05, 0x21, 0x98, 0x3d, 0xb9, 0x69, 0x6d, 0x5a,
+	0x4c, 0xae, 0x48, 0xbf, 0xb8, 0x4a, 0xf6, 0x42, 0x98, 0xed, 0x4d, 0xcd, 0xd4, 0x98, 0x39, 0x26,
+	0xfa, 0x72, 0x98, 0x28, 0xd9, 0x15, 0x52, 0x97, 0xc2, 0xa4, 0x9c, 0x27, 0xaa, 0xad, 0xd4, 0xac,
+	0x3a, 0xd1, 0x84, 0xf4, 0xe5, 0x50, 0x9b, 0x35, 0xb5, 0xbc, 0xad, 0x55, 0x94, 0x1d, 0xab, 0x5a,
+	0x37, 0x5c, 0x8d, 0xa9, 0x32, 0x53, 0x29, 0x6e, 0xa8, 0x8e, 0xe6, 0x49, 0x96, 0x2d, 0xdd, 0x14,
+	0xf3, 0x17, 0xfd, 0xf3, 0x2c, 0x38, 0x9e, 0x94, 0xad, 0x6e, 0xea, 0xa6, 0x4a, 0x74, 0xcb, 0x95,
+	0x3d, 0xbb, 0x69, 0x59, 0x9b, 0x55, 0xad, 0xa8, 0xda, 0x7a, 0x51, 0x35, 0x4d, 0x8b, 0xb0, 0x49,
+	0x97, 0xef, 0xa4, 0x98, 0x65, 0xbf, 0x36, 0xea, 0x8f, 0x8a, 0xaa, 0xb9, 0xe7, 0x4e, 0x71, 0x27,
+	0x0a, 0x0f, 0x27, 0xff, 0x21, 0xa6, 0x72, 0x41, 0x2d, 0xa2, 0x1b, 0x9a, 0x43, 0x54, 0xc3, 0x76,
+	0x09, 0x04, 0x05, 0x2a, 0xf5, 0x9a, 0x0f, 0x14, 0x1e, 0x87, 0xd1, 0x35, 0xb5, 0xa6, 0x1a, 0x8e,
+	0xac, 0x3d, 0xae, 0x6b, 0x0e, 0xc1, 0xf7, 0x61, 0xcc, 0x1d, 0x70, 0x6c, 0xcb, 0x74, 0x34, 0xb4,
+	0x08, 0x83, 0x36, 0x1b, 0xc9, 0x48, 0xd3, 0xd2, 0xcc, 0xf0, 0xfc, 0xb9, 0x42, 0xc8, 0xc2, 0x17,
+	0xb8, 0x72, 0x29, 0xf9, 0xb4, 0x91, 0x3b, 0x22, 0x0b, 0x45, 0xfc, 0x4f, 0x09, 0xa6, 0x6f, 0x3b,
+	0x44, 0x37, 0x54, 0xa2, 0xdd, 0x7f, 0xa2, 0xda, 0xb7, 0x77, 0xd5, 0x32, 0x59, 0x34, 0xac, 0xba,
+	0x49, 0x56, 0x4d, 0xe1, 0x19, 0xe5, 0xe1, 0x18, 0x35, 0xa8, 0xe8, 0x95, 0x4c, 0x62, 0x5a, 0x9a,
+	0x49, 0x96, 0x4e, 0x36, 0x1b, 0xb9, 0xb1, 0x3d, 0xd5, 0xa8, 0xde, 0xc0, 0x62, 0x02, 0x67, 0x24,
+	0x79, 0x90, 0x7e, 0xaf, 0x56, 0x50, 0x01, 0x86, 0x88, 0xb5, 0xad, 0x99, 0x8a, 0x6e, 0x66, 0x06,
+	0xa6, 0xa5, 0x99, 0x54, 0xe9, 0x44, 0xb3, 0x91, 0x1b, 0xe7, 0xf2, 0xee, 0x0c, 0x96, 0x8f, 0xb1,
+	0xcf, 0x55, 0x13, 0x3d, 0x84, 0x41, 0x96, 0x0b, 0x4e, 0x26, 0x39, 0x3d, 0x30, 0x33, 0x3c, 0x5f,
+	0x08, 0xa5, 0x41, 0x51, 0x7a, 0x00, 0xa9, 0x5a, 0x69, 0x82, 0x32, 0x6a, 0x36, 0x72, 0xa3, 0xdc,
+	0x03, 0xb7, 0x85, 0x65, 0x61, 0xf4, 0xd3, 0xc9, 0x21, 0x29, 0x9d, 0x90, 0x07, 0x1d, 0xcd, 0xac,
+	0x68, 0x35, 0xfc, 0x93, 0x04, 0xcc, 0x77, 0x25, 0xfc, 0x40, 0x27, 0x5b, 0x6b, 0x35, 0xdd, 0xd0,
+	0x89, 0xbe, 0xa3, 0xad, 0xef, 0xd9, 0x9a, 0xd3, 0x21, 0x04, 0x52, 0xcc, 0x10, 0x24, 0x22, 0x84,
+	0xe0, 0x16, 0x8c, 0x71, 0xb4, 0x8a, 0xeb, 0x65, 0x60, 0x7a, 0x60, 0x26, 0x59, 0x9a, 0x6c, 0x36,
+	0x72, 0x13, 0x7e, 0x5a, 0xee, 0x3c, 0x96, 0x47, 0xf8, 0xc0, 0x1a, 0x77, 0xf8, 0x36, 0x9c, 0x12,
+	0x02, 0xdc, 0xba, 0x55, 0x27, 0x4a, 0x45, 0x33, 0x2d, 0x83, 0xc5, 0x34, 0x55, 0x7a, 0xa9, 0xd9,
+	0xc8, 0xfd, 0x5f, 0x9b, 0xa1, 0x80, 0x1c, 0x96, 0x4f, 0xf0, 0x89, 0x75, 0x3a, 0x7e, 0xaf, 0x4e,
+	0x96, 0xd9, 0xe8, 0x6f, 0x25, 0xb8, 0xe8, 0x85, 0x4b, 0x37, 0x37, 0xab, 0x1a, 0x75, 0xd8, 0x35,
+	0x53, 0x66, 0x83, 0x61, 0x42, 0xfb, 0xc3, 0xd4, 0x77, 0x90, 0x4a, 0x30, 0x1e, 0x24, 0xc7, 0xd3,
+	0x2b, 0xdb, 0x6c, 0xe4, 0x4e, 0xf9, 0xd5, 0x7c, 0xac, 0x46, 0x49, 0x1b, 0x9f, 0xaf, 0x49, 0xf0,
+	0x52, 0x48, 0xbe, 0x8b, 0xc2, 0xda, 0x80, 0x74, 0xcb, 0x90, 0xca, 0x66, 0x19, 0x9f, 0x54, 0xe9,
+	0x3a, 0xcd, 0xb5, 0x3f, 0x37, 0x72, 0x13, 0xbc, 0xd8, 0x9d, 0xca, 0x76, 0x41, 0xb7, 0x8a, 0x86,
+	0x4a, 0xb6, 0x0a, 0xab, 0x26, 0x69, 0x36, 0x72, 0xa7, 0x83, 0x38, 0xb8, 0x3a, 0x96, 0xc7, 0x5c,
+	0x20, 0xdc, 0x1b, 0xfe, 0x77, 0x77, 0x24, 0xf7, 0xea, 0xa4, 0xcf, 0xd2, 0xfb, 0xa2, 0x57, 0x4a,
+	0x03, 0xac, 0x94, 0x8a, 0x11, 0x4b, 0x89, 0x7a, 0x8c, 0x50, 0x4b, 0x68, 0x0e, 0x52, 0x1e, 0xb3,
+	0x4c, 0x92, 0x45, 0x84, 0x02, 0x4a, 0x07, 0x48, 0x63, 0x79, 0xc8, 0x65, 0x1b, 0x28, 0xbf, 0x9f,
+	0x26, 0x60, 0xa1, 0x3b, 0xeb, 0x43, 0xab, 0xbf, 0xfd, 0xf5, 0x94, 0x88, 0x57, 0x4f, 0xf7, 0x61,
+	0xa2, 0xad, 0x4e, 0x74, 0xd3, 0xcb, 0x38, 0x5a, 0x4e, 0xd3, 0xcd, 0x46, 0xee, 0x6c, 0x87, 0x72,
+	0x72, 0xc5, 0xb0, 0x8c, 0x7c, 0xd5, 0xb4, 0x6a, 0xb2, 0xe4, 0xeb, 0x23, 0x7a, 0xf8, 0x77, 0x12,
+	0xcc, 0xf6, 0xac, 0x3f, 0x5f, 0xbe, 0xc4, 0x2a, 0xc0, 0x5b, 0x30, 0x16, 0x60, 0xc7, 0xcb, 0xd0,
+	0x17, 0xa5, 0x20, 0xad, 0x11, 0xd2, 0x95, 0xd0, 0x40, 0x24, 0x42, 0x5f, 0x95, 0x00, 0x87, 0xa5,
+	0xbd, 0xa8, 0x40, 0xc5, 0xad, 0x75, 0xdd, 0x6c, 0x2f, 0xc0, 0x6b, 0xbd, 0x0a, 0xf0, 0x54, 0x00,
+	0xb8, 0x5b, 0x7f, 0xa3, 0x02, 0xb9, 0x28, 0xbf, 0xe3, 0x30, 0xfe, 0xb9, 0xba, 0x41, 0x83, 0xe9,
+	0x1d, 0xb0, 0xb7, 0x21, 0xdd, 0x1a, 0x12, 0x38, 0xe6, 0x20, 0x65, 0xd6, 0x0d, 0x96, 0x25, 0x8e,
+	0x2f, 0xf3, 0x04, 0x43, 0x6f, 0x0a, 0xcb, 0x43, 0xa6, 0x50, 0xc5, 0x37, 0x60, 0x98, 0x7e, 0xf4,
+	0xb3, 0x22, 0x78, 0x09, 0x46, 0xb8, 0xae, 0x70, 0xbf, 0x00, 0x49, 0x3a, 0x23, 0xce, 0xf7, 0x93,
+	0x05, 0x7e, 0x67, 0x28, 0xb8, 0x77, 0x86, 0xc2, 0xa2, 0xb9, 0x57, 0x4a, 0xfd, 0xe6, 0xe7, 0xf9,
+	0xa3, 0x2c, 0x6d, 0x65, 0x26, 0x4c, 0xa9, 0x2d, 0x56, 0xab, 0x6d, 0xd4, 0x56, 0x21, 0xdd, 0x1a,
+	0x12, 0xb6, 0xaf, 0xc2, 0x51, 0x97, 0xd6, 0x40, 0x14, 0xe3, 0x5c, 0x1a, 0x2f, 0xc2, 0xe9, 0xbb,
+	0xba, 0x43, 0x98, 0xad, 0xd2, 0x1e, 0xcb, 0x03, 0x97, 0xea, 0x79, 0x38, 0xca, 0xd3, 0x88, 0x2f,
+	0x55, 0xba, 0xd9, 0xc8, 0x8d, 0x70, 0xa2, 0x22, 0x7b, 0xf8, 0x34, 0x7e, 0x13, 0x32, 0xfb, 0x4d,
+	0x1c, 0x0c, 0xd5, 0x33, 0x09, 0xd2, 0xf7, 0x6d, 0x8b, 0xac, 0xd5, 0xf4, 0xb2, 0xd6, 0x57, 0x31,
+	0xdc, 0x86, 0x34, 0xbd, 0x2b, 0x2a, 0xaa, 0xe3, 0x68, 0xa4, 0xad, 0x1c, 0xce, 0xb4, 0xb6, 0xf5,
+	0xa0, 0x04, 0x96, 0xc7, 0xe8, 0xd0, 0x22, 0x1d, 0xe1, 0x25, 0xb1, 0x02, 0xc7, 0x1f, 0xd7, 0x2d,
+	0xd2, 0x6e, 0x87, 0x97, 0xc6, 0xd9, 0x66, 0x23, 0x97, 0xe1, 0x76, 0xf6, 0x89, 0x60, 0x79, 0x9c,
+	0x8d, 0xb5, 0x2c, 0xe1, 0x55, 0x38, 0xee, 0x63, 0x24, 0xc2, 0x73, 0x05, 0xc0, 0xb1, 0x2d, 0xa2,
+	0xd8, 0x74, 0x54, 0xc4, 0x79, 0xa2, 0xd9, 0xc8, 0x1d, 0xe7, 0x76, 0x5b, 0x73, 0x58, 0x4e, 0x39,
+	0xae, 0x36, 0x5e, 0x81, 0xc9, 0x75, 0x8b, 0xa8, 0x2c, 0x01, 0xee, 0xea, 0x8f, 0xeb, 0x7a, 0x45,
+	0x27, 0x7b, 0x7d, 0x25, 0xe8, 0x77, 0x25, 0xc8, 0x76, 0x32, 0x25, 0xe0, 0xbd, 0x0f, 0xa9, 0xaa,
+	0x3b, 0x28, 0x56, 0x70, 0xb2, 0x20, 0xee, 0xc5, 0x34, 0x50, 0xde, 0xd1, 0xb3, 0x64, 0xe9, 0x66,
+	0x69, 0x59, 0x1c, 0x36, 0xa2, 0x9a, 0x3c, 0x4d, 0xfc, 0xa3, 0x8f, 0x73, 0x33, 0x9b, 0x3a, 0xd9,
+	0xaa, 0x6f, 0x14, 0xca, 0x96, 0x21, 0x2e, 0xd6, 0xe2, 0x4f, 0xde, 0xa9, 0x6c, 0x17, 0x09, 0x3d,
+	0x1b, 0x98, 0x11, 0x47, 0x6e, 0x79, 0xc4, 0xa7, 0x61, 0x82, 0x81, 0x0b, 0x72, 0xc4, 0x1f, 0x49,
+	0x70, 0x2a, 0x38, 0xf3, 0xbf, 0x01, 0xd9, 0x5d, 0x9a, 0xb7, 0xd9, 0xe3, 0xe6, 0x8e, 0x55, 0xeb,
+	0x7b, 0xef, 0xf8, 0x96, 0xbb, 0x34, 0x01, 0x53, 0x82, 0x27, 0x81, 0x41, 0xfe, 0x80, 0xea, 0x4d,
+	0x72, 0xb1, 0xfd, 0x12, 0xc0, 0xd5, 0xe2, 0x31, 0x14, 0xbe, 0xf0, 0xd7, 0x25, 0xc8, 0x50, 0x18,
+	0x1c, 0xd3, 0x8a, 0xee, 0x10, 0xab, 0xd6, 0x57, 0xe6, 0xa1, 0x25, 0x18, 0xd7, 0xcd, 0x72, 0xb5,
+	0x5e, 0xd1, 0x94, 0x8d, 0x7a, 0x79, 0x5b, 0x23, 0x0e, 0x2b, 0xcf, 0x21, 0xff, 0xed, 0x2f, 0x20,
+	0x80, 0xe5, 0x31, 0x31, 0x52, 0x12, 0x03, 0x7f, 0x92, 0x60, 0xb2, 0x03, 0x1c, 0xef, 0xd0, 0x39,
+	0xf6, 0x44, 0x37, 0x2b, 0xd6, 0x13, 0x77, 0xf7, 0xc9, 0x87, 0x3f, 0xa8, 0x3c, 0x43, 0x0f, 0x98,
+	0x56, 0xe9, 0x94, 0x88, 0x9b, 0xa0, 0x20, 0x6c, 0x61, 0xd9, 0xb5, 0x8a, 0xde, 0x83, 0x63, 0x2d,
+	0xec, 0xd4, 0xc1, 0x85, 0x50, 0x07, 0xdc, 0x38, 0xc7, 0x1e, 0x34, 0xee, 0x51, 0x74, 0x2d, 0xe2,
+	0x5f, 0x26, 0x20, 0x1d, 0x84, 0x84, 0xee, 0xc2, 0x20, 0x77, 0x2e, 0x8e, 0x90, 0xc9, 0x7d, 0xfb,
+	0xe9, 0xb2, 0x78, 0x76, 0x96, 0x26, 0xdb, 0x57, 0x9d, 0xab, 0xe1, 0xef, 0x7c, 0x9c, 0x93, 0x64,
+	0x61, 0x03, 0x7d, 0x20, 0xc1, 0x28, 0x7d, 0x39, 0xef, 0x68, 0xe2, 0x31, 0x9e, 0x49, 0xf4, 0xca,
+	0xa5, 0x15, 0x61, 0xf5, 0xa4, 0x38, 0x31, 0xfd, 0xda, 0xf1, 0x52, 0x6a, 0x84, 0xeb, 0x72, 0x7a,
+	0x68, 0x1d, 0x86, 0xa9, 0x98, 0x8b, 0x83, 0xef, 0xb0, 0x0b, 0xbd, 0x2e, 0x07, 0x88, 0xa3, 0xf0,
+	0x69, 0x62, 0x19, 0xe8, 0x2f, 0x6e, 0x15, 0xef, 0x40, 0x76, 0xbd, 0xa6, 0x56, 0x74, 0x73, 0x73,
+	0x4d, 0xd5, 0x6b, 0xeb, 0xea, 0xb6, 0x56, 0xbb, 0xa3, 0xf9, 0xcf, 0x13, 0xb6, 0x59, 0x2b, 0x97,
+	0xc5, 0xce, 0xeb, 0xcb, 0x57, 0x31, 0x81, 0xe5, 0x41, 0xf6, 0x75, 0xb9, 0x25, 0x3c, 0x97, 0x49,
+	0x74, 0x16, 0x9e, 0x73, 0x85, 0xe7, 0xf0, 0x97, 0xe0, 0x4c, 0x47, 0xbf, 0x22, 0x31, 0x3f, 0x03,
+	0x29, 0x42, 0xc7, 0x94, 0x47, 0x9a, 0xbb, 0xe9, 0x17, 0x04, 0xd5, 0xf3, 0x11, 0xe2, 0xb7, 0xac,
+	0x95, 0xe5, 0x21, 0x22, 0x8c, 0xe2, 0x3f, 0x24, 0xe0, 0xbc, 0x7b, 0x03, 0xa3, 0x4e, 0xb5, 0x92,
+	0xea, 0x68, 0x95, 0x7b, 0x26, 0x3b, 0x2a, 0x56, 0x0d, 0x5b, 0x2d, 0x7b, 0xb7, 0xc9, 0x4f, 0x42,
+	0xea, 0x51, 0xcd, 0x32, 0x14, 0xda, 0x57, 0xf1, 0x12, 0xa8, 0xeb, 0x52, 0xf3, 0xce, 0xc2, 0x10,
+	0xd5, 0xa0, 0xbf, 0x11, 0x86, 0x51, 0x62, 0x31, 0x5d, 0xff, 0x71, 0x2a, 0x0f, 0x13, 0x8b, 0x4e,
+	0xf3, 0xe3, 0xf2, 0x74, 0x6b, 0x0b, 0xa0, 0x4b, 0x98, 0xf4, 0xca, 0xfd, 0x1d, 0x48, 0x1b, 0xea,
+	0x2e, 0x3f, 0xcb, 0x14, 0x9d, 0xa1, 0xca, 0x24, 0xfb, 0x62, 0x3e, 0x66, 0xa8, 0xbb, 0x3e, 0x6e,
+	0xe8, 0x2d, 0x18, 0xd3, 0x76, 0x89, 0x56, 0x33, 0xd5, 0xaa, 0x38, 0x46, 0x8f, 0xf6, 0x65, 0x77,
+	0xd4, 0xb5, 0xc2, 0xcf, 0xd8, 0x1f, 0x4b, 0xf0, 0x4a, 0xcf, 0xb0, 0x8a, 0xf5, 0x7c, 0x1d, 0x40,
+	0x37, 0xed, 0x3a, 0x89, 0x15, 0xd8, 0x14, 0x53, 0x61, 0x91, 0xfd, 0x14, 0x0c, 0x5b, 0x75, 0xe2,
+	0x19, 0x48, 0x44, 0x33, 0x00, 0x5c, 0x87, 0x8e, 0xe0, 0x7f, 0x49, 0x70, 0xe2, 0x9e, 0x4d, 0xd1,
+	0x56, 0xd9, 0xc3, 0xcf, 0x5d, 0x71, 0xff, 0x9b, 0x5c, 0xea, 0xef, 0x4d, 0x9e, 0x88, 0xf9, 0x26,
+	0xa7, 0x3e, 0xe9, 0x52, 0x6f, 0x59, 0xb6, 0xc3, 0x93, 0xc0, 0xef, 0xd3, 0x9d, 0xc1, 0xf2, 0x31,
+	0x43, 0xdd, 0x5d, 0xb1, 0x6c, 0x87, 0xde, 0x81, 0xe8, 0xa8, 0x63, 0x57, 0x75, 0xe2, 0xb0, 0xa4,
+	0x48, 0xfa, 0xef, 0x40, 0xad, 0x39, 0x2c, 0xa7, 0x0c, 0x75, 0xf7, 0x3e, 0xff, 0x6e, 0x48, 0x70,
+	0xb2, 0x9d, 0xb1, 0xf7, 0xd8, 0x77, 0xdf, 0xcc, 0x7c, 0xd3, 0x5f, 0x88, 0xdc, 0x7e, 0x62, 0x96,
+	0x23, 0xbd, 0x9b, 0x3b, 0x35, 0x14, 0x12, 0x87, 0xdb, 0x50, 0x98, 0xff, 0xcf, 0x14, 0x1c, 0x7d,
+	0x93, 0x36, 0x3a, 0xd1, 0x37, 0x24, 0x18, 0xe4, 0xdd, 0x3e, 0x74, 0x31, 0x42, 0x4b, 0x50, 0xac,
+	0x7d, 0x76, 0x36, 0x92, 0x2c, 0x8f, 0x1a, 0x9e, 0xfd, 0xca, 0xef, 0xff, 0xfa, 0xed, 0xc4, 0xff,
+	0xa3, 0x73, 0xc5, 0xb0, 0xd6, 0xad, 0x40, 0xf1, 0x37, 0x09, 0x26, 0xbb, 0x76, 0x5d, 0xd0, 0xcd,
+	0x50, 0xbf, 0xbd, 0xba, 0x93, 0xd9, 0xd7, 0xfb, 0x55, 0x17, 0x4c, 0xee, 0x32, 0x26, 0x77, 0xd0,
+	0x72, 0x28, 0x93, 0x2f, 0x8b, 0x6d, 0xea, 0xfd, 0xa2, 0x26, 0x2c, 0xf2, 0x2e, 0xb6, 0x46, 0x6d,
+	0x8a, 0x35, 0x51, 0x74, 0x13, 0x7d, 0x3f, 0x01, 0xb3, 0x5d, 0x7d, 0xee, 0xef, 0x6f, 0xa0, 0x7b,
+	0xfd, 0xa1, 0xef, 0xda, 0x29, 0x39, 0x70, 0x38, 0x54, 0x16, 0x8e, 0xf7, 0xd0, 0xe7, 0x0f, 0x23,
+	0x1c, 0xca, 0x13, 0x9d, 0x6c, 0x29, 0xb6, 0x0b, 0x54, 0x61, 0xbb, 0x27, 0xfa, 0x20, 0x01, 0xe7,
+	0x22, 0x34, 0x15, 0xd1, 0x1b, 0xd1, 0xa8, 0xf4, 0x6c, 0x4b, 0x1e, 0x38, 0x26, 0xef, 0xb0, 0x98,
+	0xc8, 0x68, 0x2d, 0x76, 0x4c, 0x18, 0x36, 0xde, 0x64, 0xea, 0x98, 0x2e, 0xff, 0x90, 0x20, 0xdb,
+	0xbd, 0x1d, 0x82, 0xfa, 0x02, 0xde, 0x6a, 0x07, 0x65, 0x6f, 0xf5, 0xad, 0x2f, 0x98, 0x7f, 0x96,
+	0x31, 0x7f, 0x03, 0xdd, 0x3e, 0x78, 0x36, 0x58, 0x75, 0x82, 0x7e, 0x90, 0x80, 0x4b, 0x71, 0xda,
+	0x7f, 0x68, 0xad, 0x4f, 0x02, 0xdd, 0xeb, 0xe3, 0xc0, 0x21, 0xd9, 0x60, 0x21, 0xf9, 0x02, 0x7a,
+	0xf7, 0x50, 0x42, 0xd2, 0xb9, 0x42, 0x3e, 0x4c, 0xc0, 0xcb, 0x51, 0xda, 0x7e, 0x68, 0xe5, 0x60,
+	0x25, 0x72, 0x98, 0xa9, 0xf2, 0x90, 0xc5, 0xe5, 0x01, 0x7a, 0x2b, 0x66, 0x5c, 0x68, 0x14, 0x7a,
+	0x14, 0x0a, 0x4d, 0x9d, 0x8f, 0x24, 0x18, 0x72, 0xdb, 0x73, 0xe8, 0x52, 0x28, 0xd8, 0x40, 0x63,
+	0x2f, 0x9b, 0x8f, 0x28, 0x2d, 0x88, 0x14, 0x18, 0x91, 0x19, 0x74, 0x3e, 0x94, 0x88, 0xd7, 0xfb,
+	0x43, 0xdf, 0x94, 0x20, 0x49, 0x2d, 0xa0, 0x99, 0x9e, 0xcf, 0x45, 0x17, 0xd1, 0x85, 0x08, 0x92,
+	0x02, 0xcd, 0x15, 0x86, 0xa6, 0x80, 0x2e, 0x85, 0xa2, 0x61, 0x48, 0x5a, 0xc1, 0x65, 0xd1, 0x72,
+	0x3b, 0x7e, 0x3d, 0xa2, 0x15, 0xe8, 0x15, 0x66, 0xf3, 0x11, 0xa5, 0x63, 0x45, 0x4b, 0xad, 0x56,
+	0xf3, 0x3c, 0x5a, 0xbf, 0x90, 0x20, 0x1d, 0xec, 0xfe, 0xa1, 0x2b, 0xa1, 0x3e, 0xbb, 0xf4, 0x1b,
+	0xb3, 0x57, 0x63, 0x6a, 0x09, 0xc4, 0xd7, 0x19, 0xe2, 0x79, 0x74, 0x39, 0x14, 0x71, 0x55, 0x77,
+	0x08, 0x87, 0x9c, 0xdf, 0xd8, 0xcb, 0xb3, 0x9b, 0x2b, 0xfa, 0x9e, 0x04, 0x29, 0xaf, 0x27, 0x87,
+	0xc2, 0x03, 0x15, 0xec, 0x46, 0x66, 0x0b, 0x51, 0xc5, 0x05, 0xcc, 0x05, 0x06, 0x33, 0x8f, 0x66,
+	0x3b, 0xc2, 0x0c, 0x2c, 0x78, 0x91, 0xbd, 0x64, 0x1c, 0xf4, 0x4c, 0x02, 0xb4, 0xbf, 0x3f, 0x87,
+	0x5e, 0x0d, 0xf5, 0xdd, 0xb5, 0x37, 0x98, 0xbd, 0x16, 0x5b, 0x4f, 0x80, 0x5f, 0x65, 0xe0, 0x97,
+	0xd0, 0x62, 0x9c, 0xac, 0x2d, 0x12, 0x6a, 0x90, 0x6f, 0x02, 0x5e, 0x87, 0x0c, 0xfd, 0x4c, 0x82,
+	0xb1, 0xf6, 0xde, 0x1d, 0x9a, 0xef, 0x0d, 0x6b, 0x1f, 0x95, 0x85, 0x58, 0x3a, 0xb1, 0x8a, 0x8f,
+	0xc3, 0x6e, 0x21, 0x7e, 0xea, 0x2e, 0x42, 0x5b, 0x27, 0x2e, 0xca, 0x22, 0x74, 0xea, 0x02, 0x66,
+	0xaf, 0xc5, 0xd6, 0x13, 0xe8, 0x17, 0x19, 0xfa, 0xd7, 0xd0, 0x27, 0xfa, 0x58, 0x04, 0xde, 0x1d,
+	0x41, 0xbf, 0x96, 0xe0, 0xf8, 0xbe, 0x86, 0x19, 0xba, 0x1a, 0xb1, 0x2f, 0xd6, 0xde, 0xef, 0xcb,
+	0xbe, 0x1a, 0x57, 0x4d, 0xf0, 0x58, 0x62, 0x3c, 0x6e, 0xa2, 0xd7, 0x62, 0xf1, 0xe0, 0x0c, 0x94,
+	0x2d, 0x81, 0xf9, 0x57, 0x12, 0x9c, 0xe8, 0xd0, 0x63, 0x41, 0x3d, 0xa2, 0xdb, 0xb5, 0x1b, 0x94,
+	0xbd, 0x1e, 0x5f, 0x51, 0xf0, 0xb9, 0xc1, 0xf8, 0x5c, 0x41, 0xf3, 0xc5, 0x1e, 0xff, 0xf6, 0x42,
+	0x2d, 0x28, 0xb6, 0xaa, 0xd7, 0x14, 0xd6, 0xbd, 0x79, 0xa4, 0x69, 0xe8, 0xef, 0x12, 0xe4, 0x7a,
+	0xb4, 0x19, 0xd0, 0x52, 0xa4, 0xa3, 0x3c, 0xbc, 0xf7, 0x93, 0x5d, 0x3e, 0x98, 0x11, 0x41, 0xf5,
+	0x26, 0xa3, 0x7a, 0x0d, 0x5d, 0x8d, 0x7b, 0x29, 0xa0, 0xec, 0x35, 0xf4, 0x43, 0x09, 0x46, 0xfc,
+	0x8f, 0x76, 0x74, 0x39, 0x14, 0x55, 0x87, 0x8e, 0x46, 0x76, 0x2e, 0x86, 0x86, 0x00, 0x3d, 0xcf,
+	0x40, 0x5f, 0x42, 0x17, 0x43, 0x41, 0x5b, 0x5c, 0x95, 0xff, 0x1f, 0x53, 0xe9, 0xe1, 0xd3, 0xe7,
+	0x53, 0xd2, 0xb3, 0xe7, 0x53, 0xd2, 0x5f, 0x9e, 0x4f, 0x49, 0x1f, 0xbe, 0x98, 0x3a, 0xf2, 0xec,
+	0xc5, 0xd4, 0x91, 0x3f, 0xbe, 0x98, 0x3a, 0xf2, 0xee, 0x92, 0xaf, 0x9f, 0x24, 0xec, 0xe5, 0xab,
+	0xea, 0x86, 0xe3, 0x19, 0xdf, 0x99, 0x9f, 0x2f, 0xee, 0xb6, 0xb9, 0x28, 0x57, 0x75, 0xcd, 0x24,
+	0xfc, 0xdf, 0x96, 0x78, 0xa3, 0x76, 0x90, 0xfd, 0x59, 0xf8, 0xef, 0x00, 0xdb, 0xc8, 0xd1, 0x66,
+	0x04, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1747,12 +2066,20 @@ type QueryClient interface {
 	TotalLiquidity(ctx context.Context, in *TotalLiquidityRequest, opts ...grpc.CallOption) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(ctx context.Context, in *TotalVolumeForPoolRequest, opts ...grpc.CallOption) (*TotalVolumeForPoolResponse, error)
+	// PoolVolumeHistory returns the swap volume of the specified pool over the
+	// last 24 hours, 7 days and 30 days, in the token in denoms and in OSMO.
+	PoolVolumeHistory(ctx context.Context, in *PoolVolumeHistoryRequest, opts ...grpc.CallOption) (*PoolVolumeHistoryResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// OptimalRoute returns the routes across all pool types that yield the most
+	// token out for the given token in, split across up to max_splits routes of
+	// up to max_hops pools, along with the estimated token out amount. The
+	// routes can be used as is in MsgSplitRouteSwapExactAmountIn.
+	OptimalRoute(ctx context.Context, in *OptimalRouteRequest, opts ...grpc.CallOption) (*OptimalRouteResponse, error)
 }
 
 type queryClient struct {
@@ -1898,6 +2225,15 @@ func (c *queryClient) TotalVolumeForPool(ctx context.Context, in *TotalVolumeFor
 	return out, nil
 }
 
+func (c *queryClient) PoolVolumeHistory(ctx context.Context, in *PoolVolumeHistoryRequest, opts ...grpc.CallOption) (*PoolVolumeHistoryResponse, error) {
+	out := new(PoolVolumeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolVolumeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TradingPairTakerFee(ctx context.Context, in *TradingPairTakerFeeRequest, opts ...grpc.CallOption) (*TradingPairTakerFeeResponse, error) {
 	out := new(TradingPairTakerFeeResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", in, out, opts...)
@@ -1916,6 +2252,15 @@ func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *queryClient) OptimalRoute(ctx context.Context, in *OptimalRouteRequest, opts ...grpc.CallOption) (*OptimalRouteResponse, error) {
+	out := new(OptimalRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/OptimalRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -1955,12 +2300,20 @@ type QueryServer interface {
 	TotalLiquidity(context.Context, *TotalLiquidityRequest) (*TotalLiquidityResponse, error)
 	// TotalVolumeForPool returns the total volume of the specified pool.
 	TotalVolumeForPool(context.Context, *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error)
+	// PoolVolumeHistory returns the swap volume of the specified pool over the
+	// last 24 hours, 7 days and 30 days, in the token in denoms and in OSMO.
+	PoolVolumeHistory(context.Context, *PoolVolumeHistoryRequest) (*PoolVolumeHistoryResponse, error)
 	// TradingPairTakerFee returns the taker fee for a given set of denoms
 	TradingPairTakerFee(context.Context, *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error)
 	// EstimateTradeBasedOnPriceImpact returns an estimated trade based on price
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// OptimalRoute returns the routes across all pool types that yield the most
+	// token out for the given token in, split across up to max_splits routes of
+	// up to max_hops pools, along with the estimated token out amount. The
+	// routes can be used as is in MsgSplitRouteSwapExactAmountIn.
+	OptimalRoute(context.Context, *OptimalRouteRequest) (*OptimalRouteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2012,12 +2365,18 @@ func (*UnimplementedQueryServer) TotalLiquidity(ctx context.Context, req *TotalL
 func (*UnimplementedQueryServer) TotalVolumeForPool(ctx context.Context, req *TotalVolumeForPoolRequest) (*TotalVolumeForPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalVolumeForPool not implemented")
 }
+func (*UnimplementedQueryServer) PoolVolumeHistory(ctx context.Context, req *PoolVolumeHistoryRequest) (*PoolVolumeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVolumeHistory not implemented")
+}
 func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *TradingPairTakerFeeRequest) (*TradingPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TradingPairTakerFee not implemented")
 }
 func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
 }
+func (*UnimplementedQueryServer) OptimalRoute(ctx context.Context, req *OptimalRouteRequest) (*OptimalRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimalRoute not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2293,6 +2652,24 @@ func _Query_TotalVolumeForPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolVolumeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolVolumeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVolumeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolVolumeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVolumeHistory(ctx, req.(*PoolVolumeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TradingPairTakerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TradingPairTakerFeeRequest)
 	if err := dec(in); err != nil {
@@ -2329,6 +2706,24 @@ func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OptimalRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimalRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OptimalRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/OptimalRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptimalRoute(ctx, req.(*OptimalRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2393,6 +2788,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalVolumeForPool",
 			Handler:    _Query_TotalVolumeForPool_Handler,
 		},
+		{
+			MethodName: "PoolVolumeHistory",
+			Handler:    _Query_PoolVolumeHistory_Handler,
+		},
 		{
 			MethodName: "TradingPairTakerFee",
 			Handler:    _Query_TradingPairTakerFee_Handler,
@@ -2401,6 +2800,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateTradeBasedOnPriceImpact",
 			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
 		},
+		{
+			MethodName: "OptimalRoute",
+			Handler:    _Query_OptimalRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
@@ -3337,7 +3740,7 @@ func (m *TotalVolumeForPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *PoolVolumeHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3347,34 +3750,35 @@ func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom_1) > 0 {
-		i -= len(m.Denom_1)
-		copy(dAtA[i:], m.Denom_1)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+	if m.IncludeBuckets {
 		i--
-		dAtA[i] = 0x12
+		if m.IncludeBuckets {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Denom_0) > 0 {
-		i -= len(m.Denom_0)
-		copy(dAtA[i:], m.Denom_0)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *PoolVolumeHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3384,28 +3788,171 @@ func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolVolumeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVolumeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoVolume.Size()
+		i -= size
+		if _, err := m.OsmoVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NativeVolume) > 0 {
+		for iNdEx := len(m.NativeVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingPairTakerFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingPairTakerFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingPairTakerFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
 func (m *EstimateTradeBasedOnPriceImpactRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -3515,6 +4062,100 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *OptimalRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptimalRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptimalRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSplits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxSplits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptimalRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptimalRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptimalRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3919,6 +4560,61 @@ func (m *TotalVolumeForPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolumeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.IncludeBuckets {
+		n += 2
+	}
+	return n
+}
+
+func (m *PoolVolumeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolVolumeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.NativeVolume) > 0 {
+		for _, e := range m.NativeVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.OsmoVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *TradingPairTakerFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3982,14 +4678,54 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *OptimalRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	if m.MaxSplits != 0 {
+		n += 1 + sovQuery(uint64(m.MaxSplits))
+	}
+	return n
+}
+
+func (m *OptimalRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -6472,7 +7208,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6495,17 +7231,17 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6515,29 +7251,16 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom_0 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeBuckets", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6547,24 +7270,12 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom_1 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IncludeBuckets = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6586,7 +7297,7 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6609,17 +7320,17 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6629,23 +7340,57 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, PoolVolumeWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, types.VolumeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6670,7 +7415,7 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolVolumeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6693,15 +7438,15 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolVolumeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolVolumeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6728,15 +7473,15 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeVolume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6746,29 +7491,31 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			m.NativeVolume = append(m.NativeVolume, types2.Coin{})
+			if err := m.NativeVolume[len(m.NativeVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoVolume", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6778,14 +7525,79 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6813,13 +7625,11 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6847,9 +7657,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6872,7 +7680,7 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6895,17 +7703,17 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6915,28 +7723,549 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptimalRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptimalRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptimalRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSplits", wireType)
+			}
+			m.MaxSplits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSplits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptimalRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptimalRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptimalRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6963,7 +8292,42 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, types.SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,34 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// VolumeBucketPruneEpochIdentifier is the epoch at the end of which the expired volume buckets are pruned.
+const VolumeBucketPruneEpochIdentifier = "day"
+
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart is the epoch start hook.
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd is the epoch end hook.
+// It prunes the volume buckets that are older than VolumeBucketRetention.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == VolumeBucketPruneEpochIdentifier {
+		h.k.PruneVolumeBuckets(ctx)
+	}
+	return nil
+}
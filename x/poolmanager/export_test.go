@@ -52,3 +52,7 @@ func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
 	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn)
 }
+
+func (k Keeper) FindRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops int, maxSteps int) ([]types.SwapAmountInRoutes, error) {
+	return k.findRoutes(ctx, tokenInDenom, tokenOutDenom, maxHops, maxSteps)
+}
@@ -121,6 +121,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the pool volume buckets KVStore.
+	for _, poolVolumeBucket := range genState.PoolVolumeBuckets {
+		k.setVolumeBucket(ctx, poolVolumeBucket.PoolId, poolVolumeBucket.VolumeBucket)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -146,6 +151,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	poolVolumeBuckets, err := k.GetAllPoolVolumeBuckets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -159,6 +169,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		PoolVolumeBuckets:      poolVolumeBuckets,
 	}
 }
 
@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +62,33 @@ var (
 		},
 	}
 
+	testPoolVolumeBuckets = []types.PoolVolumeBucket{
+		{
+			PoolId: 1,
+			VolumeBucket: types.VolumeBucket{
+				StartTime:    time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				NativeVolume: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(1000000))),
+				OsmoVolume:   sdk.NewInt(1000000),
+			},
+		},
+		{
+			PoolId: 1,
+			VolumeBucket: types.VolumeBucket{
+				StartTime:    time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+				NativeVolume: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(500000))),
+				OsmoVolume:   sdk.NewInt(4000000),
+			},
+		},
+		{
+			PoolId: 2,
+			VolumeBucket: types.VolumeBucket{
+				StartTime:    time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				NativeVolume: sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(2000000))),
+				OsmoVolume:   sdk.NewInt(2000000),
+			},
+		},
+	}
+
 	testDenomPairTakerFees = []types.DenomPairTakerFee{
 		{
 			Denom0:   "uion",
@@ -136,6 +164,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolVolumeBuckets:      testPoolVolumeBuckets,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -160,6 +189,13 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].Denom0, testDenomPairTakerFees[1].Denom1)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	buckets, err := s.App.PoolManagerKeeper.GetVolumeBuckets(s.Ctx, 1, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VolumeBucket{testPoolVolumeBuckets[0].VolumeBucket, testPoolVolumeBuckets[1].VolumeBucket}, buckets)
+	buckets, err = s.App.PoolManagerKeeper.GetVolumeBuckets(s.Ctx, 2, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Equal([]types.VolumeBucket{testPoolVolumeBuckets[2].VolumeBucket}, buckets)
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -184,6 +220,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		PoolVolumeBuckets:      testPoolVolumeBuckets,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -202,4 +239,12 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testPoolVolumeBuckets, genesis.PoolVolumeBuckets)
+
+	// Importing the exported genesis in a fresh state results in the same genesis.
+	s.SetupTest()
+	s.PrepareBalancerPool()
+	s.PrepareConcentratedPool()
+	s.App.PoolManagerKeeper.InitGenesis(s.Ctx, genesis)
+	s.Require().Equal(genesis, s.App.PoolManagerKeeper.ExportGenesis(s.Ctx))
 }
@@ -120,6 +120,56 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
 }
 
+func (server msgServer) SwapExactAmountInWithMaxPriceImpact(goCtx context.Context, msg *types.MsgSwapExactAmountInWithMaxPriceImpact) (*types.MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, tokenOutAmount, err := server.keeper.RouteExactAmountInWithMaxPriceImpact(ctx, sender, msg.Routes, msg.TokenIn, msg.MaxPriceImpact, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSwapExactAmountInWithMaxPriceImpactResponse{TokenInAmount: tokenInAmount, TokenOutAmount: tokenOutAmount}, nil
+}
+
+func (server msgServer) SplitRouteSwapExactAmountInWithMaxPriceImpact(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) (*types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, tokenOutAmount, err := server.keeper.SplitRouteExactAmountInWithMaxPriceImpact(ctx, sender, msg.Routes, msg.TokenInDenom, msg.MaxPriceImpact, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap event is handled in each pool module's SwapExactAmountIn
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse{TokenInAmount: tokenInAmount, TokenOutAmount: tokenOutAmount}, nil
+}
+
 func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.MsgSetDenomPairTakerFee) (*types.MsgSetDenomPairTakerFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
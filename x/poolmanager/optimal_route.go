@@ -0,0 +1,336 @@
+package poolmanager
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+const (
+	// DefaultOptimalRouteMaxHops is the maximum number of hops of a route when none is requested.
+	DefaultOptimalRouteMaxHops = uint64(3)
+	// MaxOptimalRouteMaxHops bounds the depth of the route search.
+	MaxOptimalRouteMaxHops = uint64(4)
+	// DefaultOptimalRouteMaxSplits is the maximum number of routes the token in is split across when none is requested.
+	DefaultOptimalRouteMaxSplits = uint64(3)
+	// MaxOptimalRouteMaxSplits bounds the number of routes the token in is split across.
+	MaxOptimalRouteMaxSplits = uint64(5)
+
+	// maxOptimalRouteCandidates bounds the number of routes that are estimated
+	// so that the query gas stays bounded on pool graphs with many paths.
+	maxOptimalRouteCandidates = 50
+	// maxOptimalRouteSearchSteps bounds the number of pool graph edges visited when searching
+	// the routes of more than one hop, so that the search stays bounded on pool graphs with many pools.
+	maxOptimalRouteSearchSteps = 1_000
+	// optimalRouteSplitIncrements is the number of increments the token in is split into
+	// when allocating it across routes.
+	optimalRouteSplitIncrements = 10
+)
+
+var ErrNoRouteFound = errors.New("no route found")
+
+// candidateRoute is a route found by the route search with its estimated token out amount
+// when swapping the whole token in through it.
+type candidateRoute struct {
+	route     types.SwapAmountInRoutes
+	amountOut osmomath.Int
+}
+
+// OptimalRoute finds the routes that yield the most tokenOutDenom for tokenIn.
+// It searches the graph of pools of every pool module, where the denoms are the nodes
+// and the pools are the edges, for routes of up to maxHops pools that use every pool
+// and every denom at most once, shortest first and with a bounded number of steps. The routes are estimated with MultihopEstimateOutGivenExactAmountIn
+// so that the taker fees and the spread factors are applied.
+//
+// The token in is then split across up to maxSplits routes that do not share any pool
+// by greedily allocating increments of the token in to the route with the highest marginal output.
+// Zero maxHops and maxSplits default to DefaultOptimalRouteMaxHops and DefaultOptimalRouteMaxSplits.
+//
+// Returns the split routes sorted by their token in amount and the estimated total token out amount.
+// Returns ErrNoRouteFound if there is no route with a positive token out amount.
+func (k Keeper) OptimalRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops uint64,
+	maxSplits uint64,
+) ([]types.SwapAmountInSplitRoute, osmomath.Int, error) {
+	if maxHops == 0 {
+		maxHops = DefaultOptimalRouteMaxHops
+	}
+	if maxSplits == 0 {
+		maxSplits = DefaultOptimalRouteMaxSplits
+	}
+
+	if err := tokenIn.Validate(); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return nil, osmomath.Int{}, fmt.Errorf("token in amount must be positive, was (%s)", tokenIn.Amount)
+	}
+	if err := sdk.ValidateDenom(tokenOutDenom); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, osmomath.Int{}, fmt.Errorf("token in denom and token out denom must differ, both were (%s)", tokenOutDenom)
+	}
+	if maxHops > MaxOptimalRouteMaxHops {
+		return nil, osmomath.Int{}, fmt.Errorf("max hops (%d) must not exceed (%d)", maxHops, MaxOptimalRouteMaxHops)
+	}
+	if maxSplits > MaxOptimalRouteMaxSplits {
+		return nil, osmomath.Int{}, fmt.Errorf("max splits (%d) must not exceed (%d)", maxSplits, MaxOptimalRouteMaxSplits)
+	}
+
+	routes, err := k.findRoutes(ctx, tokenIn.Denom, tokenOutDenom, int(maxHops), maxOptimalRouteSearchSteps)
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	candidates := make([]candidateRoute, 0, len(routes))
+	for _, route := range routes {
+		amountOut := k.estimateRouteOrZero(ctx, route, tokenIn)
+		if amountOut.IsPositive() {
+			candidates = append(candidates, candidateRoute{route: route, amountOut: amountOut})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, osmomath.Int{}, ErrNoRouteFound
+	}
+
+	// Prefer the routes with the highest output, then the shortest ones.
+	// The sort is stable to keep the search order, which is by pool id, for the remaining ties.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].amountOut.Equal(candidates[j].amountOut) {
+			return candidates[i].amountOut.GT(candidates[j].amountOut)
+		}
+		return len(candidates[i].route) < len(candidates[j].route)
+	})
+
+	splitCandidates := selectDisjointRoutes(candidates, int(maxSplits))
+	splitRoutes, totalAmountOut := k.splitAcrossRoutes(ctx, splitCandidates, tokenIn)
+
+	if err := types.ValidateSwapAmountInSplitRoute(splitRoutes); err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	return splitRoutes, totalAmountOut, nil
+}
+
+// findRoutes returns the routes from tokenInDenom to tokenOutDenom of up to maxHops pools,
+// using every pool and every denom at most once, in breadth first order, i.e. shortest first, then by pool id.
+// The pools are listed once and inactive pools are skipped.
+//
+// The direct routes are always returned. The longer routes are searched by visiting
+// at most maxSteps pool graph edges so that the search is bounded regardless of the number of pools.
+// At most maxOptimalRouteCandidates routes are returned.
+func (k Keeper) findRoutes(ctx sdk.Context, tokenInDenom, tokenOutDenom string, maxHops int, maxSteps int) ([]types.SwapAmountInRoutes, error) {
+	pools, err := k.AllPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// poolDenoms are the denoms of the active pools by pool id, and poolIdsByDenom the ids
+	// of the active pools of every denom sorted by pool id.
+	poolDenoms := map[uint64][]string{}
+	poolIdsByDenom := map[string][]uint64{}
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+
+		denoms := pool.GetPoolDenoms(ctx)
+		poolDenoms[pool.GetId()] = denoms
+		for _, denom := range denoms {
+			poolIdsByDenom[denom] = append(poolIdsByDenom[denom], pool.GetId())
+		}
+	}
+
+	routes := []types.SwapAmountInRoutes{}
+	for _, poolId := range poolIdsByDenom[tokenInDenom] {
+		if len(routes) >= maxOptimalRouteCandidates {
+			return routes, nil
+		}
+		if osmoutils.Contains(poolDenoms[poolId], tokenOutDenom) {
+			routes = append(routes, types.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: tokenOutDenom}})
+		}
+	}
+
+	// The queue holds the partial routes to extend, starting with the empty route at the token in denom.
+	// Every queued route consumes a step, so the queue is bounded by maxSteps.
+	queue := []types.SwapAmountInRoutes{{}}
+	remainingSteps := maxSteps
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+
+		denom := tokenInDenom
+		if len(path) > 0 {
+			denom = path[len(path)-1].TokenOutDenom
+		}
+
+		for _, poolId := range poolIdsByDenom[denom] {
+			if routeUsesPool(path, poolId) {
+				continue
+			}
+
+			for _, nextDenom := range poolDenoms[poolId] {
+				if nextDenom == tokenInDenom || routeVisitsDenom(path, nextDenom) {
+					continue
+				}
+				// The direct routes were already found.
+				if nextDenom == tokenOutDenom && len(path) == 0 {
+					continue
+				}
+				if len(routes) >= maxOptimalRouteCandidates || remainingSteps <= 0 {
+					return routes, nil
+				}
+				remainingSteps--
+
+				route := make(types.SwapAmountInRoutes, len(path), len(path)+1)
+				copy(route, path)
+				route = append(route, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: nextDenom})
+
+				if nextDenom == tokenOutDenom {
+					routes = append(routes, route)
+				} else if len(route) < maxHops {
+					queue = append(queue, route)
+				}
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// routeUsesPool returns true if the route swaps through the pool.
+func routeUsesPool(route types.SwapAmountInRoutes, poolId uint64) bool {
+	for _, hop := range route {
+		if hop.PoolId == poolId {
+			return true
+		}
+	}
+	return false
+}
+
+// routeVisitsDenom returns true if the route swaps into the denom.
+func routeVisitsDenom(route types.SwapAmountInRoutes, denom string) bool {
+	for _, hop := range route {
+		if hop.TokenOutDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// selectDisjointRoutes returns up to maxRoutes of the given candidates, in order,
+// skipping the candidates that share a pool with an already selected one.
+// Routes sharing a pool cannot be estimated independently, since swapping through
+// one route moves the price of the shared pool for the other.
+func selectDisjointRoutes(candidates []candidateRoute, maxRoutes int) []candidateRoute {
+	selected := make([]candidateRoute, 0, maxRoutes)
+	usedPools := map[uint64]bool{}
+	for _, candidate := range candidates {
+		if len(selected) >= maxRoutes {
+			break
+		}
+
+		sharesPool := false
+		for _, poolId := range candidate.route.PoolIds() {
+			if usedPools[poolId] {
+				sharesPool = true
+				break
+			}
+		}
+		if sharesPool {
+			continue
+		}
+
+		for _, poolId := range candidate.route.PoolIds() {
+			usedPools[poolId] = true
+		}
+		selected = append(selected, candidate)
+	}
+	return selected
+}
+
+// splitAcrossRoutes allocates tokenIn across the given routes in optimalRouteSplitIncrements increments.
+// Each increment goes to the route whose estimated token out amount increases the most with it.
+// Returns the routes that were allocated a positive amount sorted by that amount,
+// and the sum of their estimated token out amounts.
+// CONTRACT: routes is non-empty and sorted by their token out amount for the whole tokenIn.
+func (k Keeper) splitAcrossRoutes(ctx sdk.Context, routes []candidateRoute, tokenIn sdk.Coin) ([]types.SwapAmountInSplitRoute, osmomath.Int) {
+	increment := tokenIn.Amount.QuoRaw(optimalRouteSplitIncrements)
+
+	// With a single route or a token in amount too small to be split,
+	// the whole amount goes through the best route.
+	if len(routes) == 1 || increment.IsZero() {
+		return []types.SwapAmountInSplitRoute{{
+			Pools:         routes[0].route,
+			TokenInAmount: tokenIn.Amount,
+		}}, routes[0].amountOut
+	}
+
+	amountsIn := make([]osmomath.Int, len(routes))
+	amountsOut := make([]osmomath.Int, len(routes))
+	for i := range routes {
+		amountsIn[i] = osmomath.ZeroInt()
+		amountsOut[i] = osmomath.ZeroInt()
+	}
+
+	remaining := tokenIn.Amount
+	for remaining.IsPositive() {
+		stepAmount := increment
+		// The last increment also takes the rounding remainder.
+		if remaining.LT(increment.MulRaw(2)) {
+			stepAmount = remaining
+		}
+
+		bestIndex, bestAmountOut, bestGain := 0, osmomath.Int{}, osmomath.Int{}
+		for i, candidate := range routes {
+			amountOut := k.estimateRouteOrZero(ctx, candidate.route, sdk.NewCoin(tokenIn.Denom, amountsIn[i].Add(stepAmount)))
+			gain := amountOut.Sub(amountsOut[i])
+			if bestGain.IsNil() || gain.GT(bestGain) {
+				bestIndex, bestAmountOut, bestGain = i, amountOut, gain
+			}
+		}
+
+		amountsIn[bestIndex] = amountsIn[bestIndex].Add(stepAmount)
+		amountsOut[bestIndex] = bestAmountOut
+		remaining = remaining.Sub(stepAmount)
+	}
+
+	splitRoutes := make([]types.SwapAmountInSplitRoute, 0, len(routes))
+	totalAmountOut := osmomath.ZeroInt()
+	for i, candidate := range routes {
+		if !amountsIn[i].IsPositive() {
+			continue
+		}
+		splitRoutes = append(splitRoutes, types.SwapAmountInSplitRoute{
+			Pools:         candidate.route,
+			TokenInAmount: amountsIn[i],
+		})
+		totalAmountOut = totalAmountOut.Add(amountsOut[i])
+	}
+
+	sort.SliceStable(splitRoutes, func(i, j int) bool {
+		return splitRoutes[i].TokenInAmount.GT(splitRoutes[j].TokenInAmount)
+	})
+
+	return splitRoutes, totalAmountOut
+}
+
+// estimateRouteOrZero returns the estimated token out amount of swapping tokenIn through the route,
+// or zero if the swap cannot be estimated, e.g. because the pools lack liquidity.
+func (k Keeper) estimateRouteOrZero(ctx sdk.Context, route types.SwapAmountInRoutes, tokenIn sdk.Coin) osmomath.Int {
+	amountOut, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+	if err != nil {
+		return osmomath.ZeroInt()
+	}
+	return amountOut
+}
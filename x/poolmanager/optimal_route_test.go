@@ -0,0 +1,201 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestOptimalRoute() {
+	const (
+		uosmo = "uosmo"
+		uatom = "uatom"
+		uion  = "uion"
+		uakt  = "uakt"
+	)
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       uint64
+		maxSplits     uint64
+
+		// expectedRoutes are the expected pool ids of the routes in order.
+		expectedRoutes [][]uint64
+		expectedErr    error
+		expectErr      bool
+	}{
+		"small amount goes through the best direct route": {
+			tokenIn:        sdk.NewCoin(uosmo, osmomath.NewInt(1_000)),
+			tokenOutDenom:  uatom,
+			maxHops:        1,
+			maxSplits:      3,
+			expectedRoutes: [][]uint64{{1}},
+		},
+		"large amount is split across the disjoint routes": {
+			tokenIn:        sdk.NewCoin(uosmo, osmomath.NewInt(500_000_000)),
+			tokenOutDenom:  uatom,
+			maxHops:        2,
+			maxSplits:      3,
+			expectedRoutes: [][]uint64{{1}, {2, 3}},
+		},
+		"single split": {
+			tokenIn:        sdk.NewCoin(uosmo, osmomath.NewInt(500_000_000)),
+			tokenOutDenom:  uatom,
+			maxHops:        2,
+			maxSplits:      1,
+			expectedRoutes: [][]uint64{{1}},
+		},
+		"two hops required": {
+			tokenIn:        sdk.NewCoin(uion, osmomath.NewInt(1_000)),
+			tokenOutDenom:  uakt,
+			expectedRoutes: [][]uint64{{3, 4}},
+		},
+		"route longer than max hops": {
+			tokenIn:       sdk.NewCoin(uion, osmomath.NewInt(1_000)),
+			tokenOutDenom: uakt,
+			maxHops:       1,
+			expectedErr:   poolmanager.ErrNoRouteFound,
+		},
+		"no pool with token out denom": {
+			tokenIn:       sdk.NewCoin(uosmo, osmomath.NewInt(1_000)),
+			tokenOutDenom: "uusdc",
+			expectedErr:   poolmanager.ErrNoRouteFound,
+		},
+		"same token in and out denoms": {
+			tokenIn:       sdk.NewCoin(uosmo, osmomath.NewInt(1_000)),
+			tokenOutDenom: uosmo,
+			expectErr:     true,
+		},
+		"too many hops": {
+			tokenIn:       sdk.NewCoin(uosmo, osmomath.NewInt(1_000)),
+			tokenOutDenom: uatom,
+			maxHops:       poolmanager.MaxOptimalRouteMaxHops + 1,
+			expectErr:     true,
+		},
+		"too many splits": {
+			tokenIn:       sdk.NewCoin(uosmo, osmomath.NewInt(1_000)),
+			tokenOutDenom: uatom,
+			maxSplits:     poolmanager.MaxOptimalRouteMaxSplits + 1,
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			// Pool 1 is the deepest uosmo/uatom pool, pools 2 and 3 form a shallower
+			// uosmo -> uion -> uatom route and pool 4 is the only pool with uakt.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uosmo, osmomath.NewInt(2_000_000_000)), sdk.NewCoin(uatom, osmomath.NewInt(2_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uosmo, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uion, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uion, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uatom, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uatom, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uakt, osmomath.NewInt(1_000_000_000)))
+
+			k := s.App.PoolManagerKeeper
+
+			routes, tokenOutAmount, err := k.OptimalRoute(s.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops, tc.maxSplits)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Len(routes, len(tc.expectedRoutes))
+			totalTokenIn := osmomath.ZeroInt()
+			expectedTokenOutAmount := osmomath.ZeroInt()
+			for i, route := range routes {
+				s.Require().Equal(tc.expectedRoutes[i], types.SwapAmountInRoutes(route.Pools).PoolIds())
+				s.Require().Equal(tc.tokenOutDenom, route.Pools[len(route.Pools)-1].TokenOutDenom)
+				s.Require().True(route.TokenInAmount.IsPositive())
+				totalTokenIn = totalTokenIn.Add(route.TokenInAmount)
+
+				routeTokenOutAmount, err := k.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route.Pools, sdk.NewCoin(tc.tokenIn.Denom, route.TokenInAmount))
+				s.Require().NoError(err)
+				expectedTokenOutAmount = expectedTokenOutAmount.Add(routeTokenOutAmount)
+			}
+			s.Require().Equal(tc.tokenIn.Amount, totalTokenIn)
+			s.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			// The split never yields less than the best single route.
+			bestSingleRouteAmount, err := k.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routes[0].Pools, tc.tokenIn)
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.GTE(bestSingleRouteAmount))
+
+			// The routes can be swapped as is.
+			sender := s.TestAccs[0]
+			s.FundAcc(sender, sdk.NewCoins(tc.tokenIn))
+			swappedAmount, err := k.SplitRouteExactAmountIn(s.Ctx, sender, routes, tc.tokenIn.Denom, tokenOutAmount)
+			s.Require().NoError(err)
+			s.Require().Equal(tokenOutAmount, swappedAmount)
+		})
+	}
+}
+
+// Tests that the routes are found shortest first, that the direct routes are found
+// regardless of the search steps and that the longer routes are bounded by the search steps.
+func (s *KeeperTestSuite) TestFindRoutes() {
+	const (
+		uosmo = "uosmo"
+		uatom = "uatom"
+		uion  = "uion"
+		uakt  = "uakt"
+	)
+
+	tests := map[string]struct {
+		maxHops  int
+		maxSteps int
+
+		// expectedRoutes are the expected pool ids of the routes in order.
+		expectedRoutes [][]uint64
+	}{
+		"routes are found shortest first": {
+			maxHops:        3,
+			maxSteps:       1_000,
+			expectedRoutes: [][]uint64{{3}, {1, 2}, {4, 5, 2}},
+		},
+		"routes longer than max hops are not found": {
+			maxHops:        2,
+			maxSteps:       1_000,
+			expectedRoutes: [][]uint64{{3}, {1, 2}},
+		},
+		"direct route is found without search steps": {
+			maxHops:        3,
+			maxSteps:       0,
+			expectedRoutes: [][]uint64{{3}},
+		},
+		"search stops once the steps are used": {
+			maxHops:        3,
+			maxSteps:       2,
+			expectedRoutes: [][]uint64{{3}},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			// The direct uosmo/uatom pool 3 is created after the pools of the longer routes.
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uosmo, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uion, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uion, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uatom, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uosmo, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uatom, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uosmo, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uakt, osmomath.NewInt(1_000_000_000)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(uakt, osmomath.NewInt(1_000_000_000)), sdk.NewCoin(uion, osmomath.NewInt(1_000_000_000)))
+
+			routes, err := s.App.PoolManagerKeeper.FindRoutes(s.Ctx, uosmo, uatom, tc.maxHops, tc.maxSteps)
+			s.Require().NoError(err)
+
+			s.Require().Len(routes, len(tc.expectedRoutes))
+			for i, route := range routes {
+				s.Require().Equal(tc.expectedRoutes[i], route.PoolIds())
+				s.Require().Equal(uatom, route[len(route)-1].TokenOutDenom)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// RouteExactAmountInWithMaxPriceImpact swaps the largest amount of tokenIn through the route
+// whose price deviates from the spot price of the route by at most maxPriceImpact.
+// The price deviation is that of the swap before spread and taker fees, as estimated by the
+// EstimateTradeBasedOnPriceImpact query. The rest of tokenIn is left to the sender.
+//
+// tokenOutMinAmount, if positive, is the minimum amount of token out for the whole of tokenIn. It is scaled to
+// the amount of tokenIn swapped, so that it bounds the price of the swap independently of the spot price.
+//
+// Returns the amount of tokenIn swapped and the amount of token out.
+// Returns error if:
+// - the route is invalid
+// - maxPriceImpact is not positive
+// - tokenOutMinAmount is negative
+// - no amount of tokenIn can be swapped within maxPriceImpact
+// - the swap fails, including when its token out is less than the scaled tokenOutMinAmount
+func (k Keeper) RouteExactAmountInWithMaxPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	maxPriceImpact osmomath.Dec,
+	tokenOutMinAmount osmomath.Int,
+) (tokenInAmount osmomath.Int, tokenOutAmount osmomath.Int, err error) {
+	return k.SplitRouteExactAmountInWithMaxPriceImpact(
+		ctx,
+		sender,
+		[]types.SwapAmountInSplitRoute{{Pools: route, TokenInAmount: tokenIn.Amount}},
+		tokenIn.Denom,
+		maxPriceImpact,
+		tokenOutMinAmount,
+	)
+}
+
+// SplitRouteExactAmountInWithMaxPriceImpact swaps, through each of the routes in order, the largest part
+// of its token in amount whose price deviates from the spot price of the route by at most maxPriceImpact.
+// The spot prices of all the routes are taken before swapping through any of them, so that swapping through
+// a route bounds the amount swapped through the next routes sharing its pools.
+// The price deviation is that of the swap before spread and taker fees. The rest of the token in amount of
+// each route is left to the sender.
+//
+// tokenOutMinAmount, if positive, is the minimum amount of token out for the sum of the token in amounts of the
+// routes. The swap through each route must get at least its share of tokenOutMinAmount, scaled to the amount of
+// token in swapped through it and rounded up, so that the minimum bounds the price of the swaps independently
+// of the spot prices.
+//
+// Returns the total amount of token in swapped and the total amount of token out.
+// Returns error if:
+// - the routes are invalid
+// - maxPriceImpact is not positive
+// - tokenOutMinAmount is negative
+// - no amount of token in can be swapped within maxPriceImpact through any of the routes
+// - any of the swaps fails, including when its token out is less than its share of tokenOutMinAmount
+func (k Keeper) SplitRouteExactAmountInWithMaxPriceImpact(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	maxPriceImpact osmomath.Dec,
+	tokenOutMinAmount osmomath.Int,
+) (tokenInAmount osmomath.Int, tokenOutAmount osmomath.Int, err error) {
+	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+	if maxPriceImpact.IsNil() || !maxPriceImpact.IsPositive() {
+		return osmomath.Int{}, osmomath.Int{}, types.NonPositiveMaxPriceImpactError{MaxPriceImpact: maxPriceImpact}
+	}
+	if err := types.ValidateTokenOutMinAmount(tokenOutMinAmount); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
+	totalTokenInAmount := osmomath.ZeroInt()
+	for _, route := range routes {
+		totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+	}
+
+	spotPrices := make([]osmomath.Dec, len(routes))
+	for i, route := range routes {
+		spotPrices[i], err = k.routeSpotPrice(ctx, route.Pools, tokenInDenom)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+	}
+
+	tokenInAmount, tokenOutAmount = osmomath.ZeroInt(), osmomath.ZeroInt()
+	for i, route := range routes {
+		routeTokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		routeTokenInAmount := k.maxAmountInWithinPriceImpact(ctx, route.Pools, routeTokenIn, spotPrices[i], maxPriceImpact)
+		if !routeTokenInAmount.IsPositive() {
+			continue
+		}
+
+		routeTokenOutMinAmount := scaleTokenOutMinAmount(tokenOutMinAmount, totalTokenInAmount, routeTokenInAmount)
+		routeTokenOutAmount, err := k.RouteExactAmountIn(ctx, sender, route.Pools, sdk.NewCoin(tokenInDenom, routeTokenInAmount), routeTokenOutMinAmount)
+		if err != nil {
+			return osmomath.Int{}, osmomath.Int{}, err
+		}
+
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if !tokenInAmount.IsPositive() {
+		return osmomath.Int{}, osmomath.Int{}, types.MaxPriceImpactExceededError{TokenInDenom: tokenInDenom, MaxPriceImpact: maxPriceImpact}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSwapWithMaxPriceImpact,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensIn, sdk.NewCoin(tokenInDenom, tokenInAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOutAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPriceImpact, maxPriceImpact.String()),
+		),
+	})
+
+	return tokenInAmount, tokenOutAmount, nil
+}
+
+// scaleTokenOutMinAmount returns the minimum amount of token out of swapping tokenInAmount, given the minimum
+// amount of token out tokenOutMinAmount of swapping totalTokenInAmount, rounded up. It is at least one, which
+// is the minimum amount of token out when tokenOutMinAmount is unset or zero.
+func scaleTokenOutMinAmount(tokenOutMinAmount, totalTokenInAmount, tokenInAmount osmomath.Int) osmomath.Int {
+	if tokenOutMinAmount.IsNil() || !tokenOutMinAmount.IsPositive() {
+		return osmomath.OneInt()
+	}
+	scaledTokenOutMinAmount := tokenOutMinAmount.Mul(tokenInAmount).Add(totalTokenInAmount.Sub(osmomath.OneInt())).Quo(totalTokenInAmount)
+	return osmomath.MaxInt(scaledTokenOutMinAmount, osmomath.OneInt())
+}
+
+// routeSpotPrice returns the spot price of the last token out of the route, in units of tokenInDenom.
+// It is the product of the spot prices of the token out of each pool of the route in units of its token in.
+func (k Keeper) routeSpotPrice(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) (osmomath.Dec, error) {
+	spotPrice := osmomath.OneBigDec()
+	for _, routeStep := range route {
+		poolSpotPrice, err := k.RouteCalculateSpotPrice(ctx, routeStep.PoolId, tokenInDenom, routeStep.TokenOutDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+		spotPrice = spotPrice.Mul(poolSpotPrice)
+		tokenInDenom = routeStep.TokenOutDenom
+	}
+
+	if !spotPrice.Dec().IsPositive() {
+		return osmomath.Dec{}, types.FinalAmountIsNotPositiveError{IsAmountOut: true, Amount: osmomath.ZeroInt()}
+	}
+	return spotPrice.Dec(), nil
+}
+
+// maxAmountInWithinPriceImpact returns the largest amount of tokenIn, up to tokenIn.Amount, whose price through
+// the route deviates from spotPrice by at most maxPriceImpact, before spread and taker fees.
+// Returns zero if there is no such amount.
+//
+// Similarly to the EstimateTradeBasedOnPriceImpact query, the amount is binary searched, assuming the price deviation
+// grows with the amount. The amounts whose estimate fails, e.g. because they exceed the liquidity of a pool, or
+// estimate no token out, are considered beyond maxPriceImpact.
+func (k Keeper) maxAmountInWithinPriceImpact(
+	ctx sdk.Context,
+	route []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	spotPrice, maxPriceImpact osmomath.Dec,
+) osmomath.Int {
+	isWithinPriceImpact := func(amountIn osmomath.Int) bool {
+		tokenOut, err := k.estimateRouteOutWithoutFees(ctx, route, sdk.NewCoin(tokenIn.Denom, amountIn))
+		if err != nil || !tokenOut.IsPositive() {
+			return false
+		}
+		priceDeviation := calculatePriceDeviation(sdk.NewCoin(tokenIn.Denom, amountIn), tokenOut, spotPrice)
+		return priceDeviation.LTE(maxPriceImpact)
+	}
+
+	if isWithinPriceImpact(tokenIn.Amount) {
+		return tokenIn.Amount
+	}
+
+	// Binary search the largest amount within the price impact, lowAmount - 1 being the largest found so far.
+	lowAmount := osmomath.OneInt()
+	highAmount := tokenIn.Amount.Sub(osmomath.OneInt())
+	for lowAmount.LTE(highAmount) {
+		midAmount := lowAmount.Add(highAmount).Quo(osmomath.NewInt(2))
+		if isWithinPriceImpact(midAmount) {
+			lowAmount = midAmount.Add(osmomath.OneInt())
+		} else {
+			highAmount = midAmount.Sub(osmomath.OneInt())
+		}
+	}
+	return highAmount
+}
+
+// estimateRouteOutWithoutFees returns the token out of swapping tokenIn through the route, without spread
+// and taker fees. Panics, e.g. of stableswap pools given amounts exceeding their liquidity, are returned as errors.
+// The state changes of the estimate, if any, are discarded.
+func (k Keeper) estimateRouteOutWithoutFees(ctx sdk.Context, route []types.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Coin, error) {
+	ctx, _ = ctx.CacheContext()
+	for _, routeStep := range route {
+		swapModule, err := k.GetPoolModule(ctx, routeStep.PoolId)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		poolI, err := swapModule.GetPool(ctx, routeStep.PoolId)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		var tokenOut sdk.Coin
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			tokenOut, err = swapModule.CalcOutAmtGivenIn(ctx, poolI, tokenIn, routeStep.TokenOutDenom, osmomath.ZeroDec())
+			return err
+		})
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		tokenIn = tokenOut
+	}
+	return tokenIn, nil
+}
@@ -0,0 +1,165 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+func (s *KeeperTestSuite) TestSplitRouteExactAmountInWithMaxPriceImpact() {
+	const (
+		denomIn  = "foo"
+		denomOut = "bar"
+	)
+
+	var (
+		poolCoins = sdk.NewCoins(sdk.NewInt64Coin(denomIn, 1_000_000), sdk.NewInt64Coin(denomOut, 1_000_000))
+		// Swapping x through a pool of the above coins has a price deviation of x / 1e6 before fees and rounding,
+		// so that the largest amount within a max price impact of 0.01 is at most 10_000, less the rounding of the token out.
+		maxPriceImpact   = osmomath.MustNewDecFromStr("0.01")
+		maxAmountInRoute = osmomath.NewInt(10_000)
+		roundingMargin   = osmomath.NewInt(100)
+	)
+
+	routeThroughPool := func(poolId uint64, tokenInAmount int64) types.SwapAmountInSplitRoute {
+		return types.SwapAmountInSplitRoute{
+			Pools:         []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: denomOut}},
+			TokenInAmount: osmomath.NewInt(tokenInAmount),
+		}
+	}
+
+	tests := map[string]struct {
+		routes            []types.SwapAmountInSplitRoute
+		maxPriceImpact    osmomath.Dec
+		tokenOutMinAmount osmomath.Int
+
+		expectedTokenIn osmomath.Int
+		// the token in swapped is in [expectedTokenIn - margin, expectedTokenIn]
+		margin      osmomath.Int
+		expectError error
+	}{
+		"single route within the max price impact: swapped entirely": {
+			routes:          []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000)},
+			maxPriceImpact:  maxPriceImpact,
+			expectedTokenIn: osmomath.NewInt(1_000),
+		},
+		"single route beyond the max price impact: swapped up to the max price impact": {
+			routes:          []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000_000)},
+			maxPriceImpact:  maxPriceImpact,
+			expectedTokenIn: maxAmountInRoute,
+			margin:          roundingMargin,
+		},
+		"split routes through different pools: each swapped up to the max price impact": {
+			routes:          []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000_000), routeThroughPool(2, 500_000)},
+			maxPriceImpact:  maxPriceImpact,
+			expectedTokenIn: maxAmountInRoute.MulRaw(2),
+			margin:          roundingMargin.MulRaw(2),
+		},
+		"split routes, one within the max price impact": {
+			routes:          []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000), routeThroughPool(2, 500_000)},
+			maxPriceImpact:  maxPriceImpact,
+			expectedTokenIn: maxAmountInRoute.AddRaw(1_000),
+			margin:          roundingMargin,
+		},
+		"single route within the max price impact and the token out min amount: swapped entirely": {
+			routes:            []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000)},
+			maxPriceImpact:    maxPriceImpact,
+			tokenOutMinAmount: osmomath.NewInt(990),
+			expectedTokenIn:   osmomath.NewInt(1_000),
+		},
+		"split routes beyond the max price impact: token out min amount scaled to the amount swapped": {
+			routes:            []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000_000), routeThroughPool(2, 1_000)},
+			maxPriceImpact:    maxPriceImpact,
+			tokenOutMinAmount: osmomath.NewInt(900_000),
+			expectedTokenIn:   maxAmountInRoute.AddRaw(1_000),
+			margin:            roundingMargin,
+		},
+		"error: single route below the token out min amount": {
+			routes:            []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000)},
+			maxPriceImpact:    maxPriceImpact,
+			tokenOutMinAmount: osmomath.NewInt(1_000),
+			expectError:       gammtypes.ErrLimitMinAmount,
+		},
+		"error: split routes below the scaled token out min amount": {
+			routes:            []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000_000), routeThroughPool(2, 1_000)},
+			maxPriceImpact:    maxPriceImpact,
+			tokenOutMinAmount: osmomath.NewInt(1_000_000),
+			expectError:       gammtypes.ErrLimitMinAmount,
+		},
+		"error: negative token out min amount": {
+			routes:            []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000)},
+			maxPriceImpact:    maxPriceImpact,
+			tokenOutMinAmount: osmomath.NewInt(-1),
+			expectError:       types.NegativeTokenOutMinAmountError{TokenOutMinAmount: osmomath.NewInt(-1)},
+		},
+		"error: no amount within the max price impact": {
+			routes:         []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000_000)},
+			maxPriceImpact: osmomath.MustNewDecFromStr("0.000000001"),
+			expectError:    types.MaxPriceImpactExceededError{TokenInDenom: denomIn, MaxPriceImpact: osmomath.MustNewDecFromStr("0.000000001")},
+		},
+		"error: zero max price impact": {
+			routes:         []types.SwapAmountInSplitRoute{routeThroughPool(1, 1_000)},
+			maxPriceImpact: osmomath.ZeroDec(),
+			expectError:    types.NonPositiveMaxPriceImpactError{MaxPriceImpact: osmomath.ZeroDec()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.PrepareBalancerPoolWithCoins(poolCoins...)
+			s.PrepareBalancerPoolWithCoins(poolCoins...)
+
+			sender := s.TestAccs[1]
+			initialBalance := sdk.NewCoins(sdk.NewInt64Coin(denomIn, 2_000_000))
+			s.FundAcc(sender, initialBalance)
+
+			tokenInAmount, tokenOutAmount, err := s.App.PoolManagerKeeper.SplitRouteExactAmountInWithMaxPriceImpact(s.Ctx, sender, tc.routes, denomIn, tc.maxPriceImpact, tc.tokenOutMinAmount)
+			if tc.expectError != nil {
+				s.Require().ErrorContains(err, tc.expectError.Error())
+				s.Require().Equal(initialBalance.AmountOf(denomIn), s.App.BankKeeper.GetBalance(s.Ctx, sender, denomIn).Amount)
+				return
+			}
+			s.Require().NoError(err)
+
+			if tc.margin.IsNil() {
+				s.Require().Equal(tc.expectedTokenIn, tokenInAmount)
+			} else {
+				s.Require().True(tokenInAmount.LTE(tc.expectedTokenIn), "token in %s", tokenInAmount)
+				s.Require().True(tokenInAmount.GTE(tc.expectedTokenIn.Sub(tc.margin)), "token in %s", tokenInAmount)
+			}
+			s.Require().True(tokenOutAmount.IsPositive())
+
+			// The amount in not swapped is left to the sender.
+			s.Require().Equal(initialBalance.AmountOf(denomIn).Sub(tokenInAmount), s.App.BankKeeper.GetBalance(s.Ctx, sender, denomIn).Amount)
+			s.Require().Equal(tokenOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, sender, denomOut).Amount)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRouteExactAmountInWithMaxPriceImpact_MultiHop() {
+	s.SetupTest()
+	// Pools of 1e6 foo / 1e6 bar and of 1e6 bar / 1e6 baz, so that swapping foo for baz is priced at 1 before fees.
+	fooBarPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	barBazPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("baz", 1_000_000))
+	route := []types.SwapAmountInRoute{
+		{PoolId: fooBarPoolId, TokenOutDenom: "bar"},
+		{PoolId: barBazPoolId, TokenOutDenom: "baz"},
+	}
+	maxPriceImpact := osmomath.MustNewDecFromStr("0.02")
+	// The price deviation of swapping x through both pools is about 2x / 1e6 before fees.
+	maxTokenInAmount := osmomath.NewInt(10_000)
+
+	sender := s.TestAccs[1]
+	tokenIn := sdk.NewInt64Coin("foo", 1_000_000)
+	s.FundAcc(sender, sdk.NewCoins(tokenIn))
+
+	tokenInAmount, tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountInWithMaxPriceImpact(s.Ctx, sender, route, tokenIn, maxPriceImpact, osmomath.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().True(tokenInAmount.IsPositive())
+	s.Require().True(tokenInAmount.LTE(maxTokenInAmount), "token in %s", tokenInAmount)
+	s.Require().True(tokenOutAmount.IsPositive())
+	s.Require().Equal(tokenIn.Amount.Sub(tokenInAmount), s.App.BankKeeper.GetBalance(s.Ctx, sender, "foo").Amount)
+}
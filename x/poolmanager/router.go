@@ -681,14 +681,29 @@ func isDenomWhitelisted(denom string, authorizedQuoteDenoms []string) bool {
 // Fails quietly if an OSMO paired pool cannot be found, although this should only happen in rare scenarios where OSMO is
 // removed as a base denom from the protorev module (which this function relies on).
 //
+// The input token is also added to the current volume bucket of the pool, along with its OSMO value if it could be found.
+//
 // CONTRACT: `volumeGenerated` corresponds to one of the denoms in the pool
 // CONTRACT: pool with `poolId` exists
 func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin) {
+	volumeInOsmo, found := k.calcOsmoVolume(ctx, volumeGenerated)
+	if found {
+		// Add this new volume to the global tracked volume for the pool ID
+		k.addVolume(ctx, poolId, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), volumeInOsmo))
+	} else {
+		volumeInOsmo = osmomath.ZeroInt()
+	}
+
+	k.addVolumeToBucket(ctx, poolId, volumeGenerated, volumeInOsmo)
+}
+
+// calcOsmoVolume converts the input token into OSMO units.
+// Returns false if the OSMO value of the input token cannot be found.
+func (k Keeper) calcOsmoVolume(ctx sdk.Context, volumeGenerated sdk.Coin) (osmomath.Int, bool) {
 	// If the denom is already denominated in uosmo, we can just use it directly
 	OSMO := k.stakingKeeper.BondDenom(ctx)
 	if volumeGenerated.Denom == OSMO {
-		k.addVolume(ctx, poolId, volumeGenerated)
-		return
+		return volumeGenerated.Amount, true
 	}
 
 	// Get the most liquid OSMO-paired pool with `volumeGenerated`'s denom using `GetPoolForDenomPair`
@@ -704,7 +719,7 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	// We simply do not track volume in these cases. Importantly, volume splitting gauge logic should prevent a gauge from being
 	// created for such a pool that includes such a token, although it is okay to no-op in these cases regardless.
 	if err != nil {
-		return
+		return osmomath.Int{}, false
 	}
 
 	// Since we want to ultimately multiply the volume by this spot price, we want to quote OSMO in terms of the input token.
@@ -715,15 +730,12 @@ func (k Keeper) trackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	// That being said, if there is an error finding the spot price, we fail quietly and leave tracked volume unchanged.
 	// This is because we do not want to escalate an issue with finding spot price to locking all swaps involving the given asset.
 	if err != nil {
-		return
+		return osmomath.Int{}, false
 	}
 
 	// Multiply `volumeGenerated.Amount.ToDec()` by this spot price.
 	// While rounding does not particularly matter here, we round down to ensure that we do not overcount volume.
-	volumeInOsmo := osmomath.BigDecFromSDKInt(volumeGenerated.Amount).Mul(osmoPerInputToken).Dec().TruncateInt()
-
-	// Add this new volume to the global tracked volume for the pool ID
-	k.addVolume(ctx, poolId, sdk.NewCoin(OSMO, volumeInOsmo))
+	return osmomath.BigDecFromSDKInt(volumeGenerated.Amount).Mul(osmoPerInputToken).Dec().TruncateInt(), true
 }
 
 // addVolume adds the given volume to the global tracked volume for the given pool ID.
@@ -1127,7 +1139,7 @@ func (k Keeper) EstimateTradeBasedOnPriceImpactConcentratedLiquidity(
 // We have an `Abs()` at the end of the priceDeviation equation as we cannot be sure if any pool types based on their
 // configurations trade out more tokens than given for a trade, it is added just in-case.
 func calculatePriceDeviation(currFromCoin, tokenOut sdk.Coin, spotPrice osmomath.Dec) osmomath.Dec {
-	currTradePrice := sdk.NewDec(currFromCoin.Amount.Int64()).QuoInt(tokenOut.Amount)
+	currTradePrice := currFromCoin.Amount.ToLegacyDec().QuoInt(tokenOut.Amount)
 	priceDeviation := currTradePrice.Sub(spotPrice).Quo(spotPrice).Abs()
 	return priceDeviation
 }
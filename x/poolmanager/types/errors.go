@@ -133,3 +133,28 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type NonPositiveMaxPriceImpactError struct {
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e NonPositiveMaxPriceImpactError) Error() string {
+	return fmt.Sprintf("max price impact must be positive, was (%s)", e.MaxPriceImpact)
+}
+
+type NegativeTokenOutMinAmountError struct {
+	TokenOutMinAmount osmomath.Int
+}
+
+func (e NegativeTokenOutMinAmountError) Error() string {
+	return fmt.Sprintf("token out min amount must not be negative, was (%s)", e.TokenOutMinAmount)
+}
+
+type MaxPriceImpactExceededError struct {
+	TokenInDenom   string
+	MaxPriceImpact osmomath.Dec
+}
+
+func (e MaxPriceImpactExceededError) Error() string {
+	return fmt.Sprintf("no amount of (%s) can be swapped with a price impact of at most (%s)", e.TokenInDenom, e.MaxPriceImpact)
+}
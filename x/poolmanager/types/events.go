@@ -1,13 +1,15 @@
 package types
 
 const (
-	AttributeValueCategory       = ModuleName
-	TypeEvtPoolCreated           = "pool_created"
-	TypeEvtSplitRouteSwapExactIn = "split_route_swap_exact_in"
-	AttributeKeyTokensIn         = "tokens_in"
-	AttributeKeyTokensOut        = "tokens_out"
-	AttributeKeyPoolId           = "pool_id"
-	AttributeKeyDenom0           = "denom0"
-	AttributeKeyDenom1           = "denom1"
-	AttributeKeyTakerFee         = "taker_fee"
+	AttributeValueCategory        = ModuleName
+	TypeEvtPoolCreated            = "pool_created"
+	TypeEvtSplitRouteSwapExactIn  = "split_route_swap_exact_in"
+	TypeEvtSwapWithMaxPriceImpact = "swap_exact_amount_in_with_max_price_impact"
+	AttributeKeyTokensIn          = "tokens_in"
+	AttributeKeyTokensOut         = "tokens_out"
+	AttributeKeyPoolId            = "pool_id"
+	AttributeKeyDenom0            = "denom0"
+	AttributeKeyDenom1            = "denom1"
+	AttributeKeyTakerFee          = "taker_fee"
+	AttributeKeyMaxPriceImpact    = "max_price_impact"
 )
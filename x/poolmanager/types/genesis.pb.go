@@ -111,6 +111,7 @@ type GenesisState struct {
 	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
 	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
 	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	PoolVolumeBuckets      []PoolVolumeBucket  `protobuf:"bytes,7,rep,name=pool_volume_buckets,json=poolVolumeBuckets,proto3" json:"pool_volume_buckets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +189,13 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetPoolVolumeBuckets() []PoolVolumeBucket {
+	if m != nil {
+		return m.PoolVolumeBuckets
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -453,6 +461,62 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// PoolVolumeBucket stores the KVStore entries for the volume buckets of each
+// pool, which is used in export/import genesis.
+type PoolVolumeBucket struct {
+	// pool_id is the id of the pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// volume_bucket is the volume of the pool over the time bucket.
+	VolumeBucket VolumeBucket `protobuf:"bytes,2,opt,name=volume_bucket,json=volumeBucket,proto3" json:"volume_bucket"`
+}
+
+func (m *PoolVolumeBucket) Reset()         { *m = PoolVolumeBucket{} }
+func (m *PoolVolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolVolumeBucket) ProtoMessage()    {}
+func (*PoolVolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
+}
+func (m *PoolVolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVolumeBucket.Merge(m, src)
+}
+func (m *PoolVolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolVolumeBucket proto.InternalMessageInfo
+
+func (m *PoolVolumeBucket) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolVolumeBucket) GetVolumeBucket() VolumeBucket {
+	if m != nil {
+		return m.VolumeBucket
+	}
+	return VolumeBucket{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
@@ -460,6 +524,7 @@ func init() {
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*PoolVolumeBucket)(nil), "osmosis.poolmanager.v1beta1.PoolVolumeBucket")
 }
 
 func init() {
@@ -467,75 +532,79 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
-	0x18, 0x8e, 0xb3, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0xe2, 0xa5, 0x41, 0x2a, 0x12, 0xd0, 0x43, 0x36, 0x51, 0x10, 0xa8, 0xb4, 0xa9, 0x13,
-	0x81, 0x54, 0x0e, 0xa3, 0x59, 0xfb, 0x8d, 0xd7, 0x5a, 0xdb, 0xb3, 0xcc, 0x8c, 0xf3, 0xc1, 0x5f,
-	0xe0, 0x82, 0xd4, 0x2b, 0x67, 0x0e, 0xdc, 0x90, 0xf8, 0x11, 0x3d, 0xf6, 0x88, 0x38, 0x6c, 0x51,
-	0x72, 0xe6, 0xb2, 0xbf, 0x00, 0x79, 0x66, 0xf6, 0xb3, 0xc9, 0x36, 0xc0, 0x69, 0xd7, 0xef, 0xfb,
-	0x3e, 0x8f, 0x9f, 0xf7, 0x6b, 0xc6, 0xe8, 0x03, 0x26, 0x52, 0x26, 0x62, 0x51, 0xef, 0x30, 0x96,
-	0xa4, 0x34, 0xa3, 0x11, 0xf0, 0xfa, 0xf1, 0xfd, 0x26, 0x48, 0x7a, 0xbf, 0x1e, 0x41, 0x06, 0x22,
-	0x16, 0x5e, 0x87, 0x33, 0xc9, 0xf0, 0x86, 0x09, 0xf5, 0x46, 0x42, 0x3d, 0x13, 0xba, 0xfe, 0x6e,
-	0xc4, 0x22, 0xa6, 0xe2, 0xea, 0xc5, 0x3f, 0x0d, 0x59, 0xbf, 0x1d, 0x31, 0x16, 0x25, 0x50, 0x57,
-	0x4f, 0xcd, 0xfc, 0xa8, 0x4e, 0xb3, 0xb3, 0xbe, 0x2b, 0x50, 0x74, 0x44, 0x63, 0xf4, 0x83, 0x71,
-	0x55, 0x26, 0x51, 0x61, 0xce, 0xa9, 0x8c, 0x59, 0xd6, 0xf7, 0xeb, 0xe8, 0x7a, 0x93, 0x0a, 0x18,
-	0x68, 0x0d, 0x58, 0xdc, 0xf7, 0x7b, 0xd3, 0x72, 0x4a, 0x59, 0x98, 0x27, 0x40, 0x38, 0xcb, 0x25,
-	0x98, 0xf8, 0x7b, 0xd3, 0xe2, 0xe5, 0xa9, 0x8e, 0x72, 0x7b, 0xb3, 0xa8, 0xbc, 0x4f, 0x39, 0x4d,
-	0x05, 0x7e, 0x6e, 0xa1, 0x9b, 0x45, 0x2c, 0x09, 0x38, 0x28, 0x61, 0xe4, 0x08, 0xc0, 0xb6, 0xaa,
-	0xa5, 0xda, 0xc2, 0xd6, 0x6d, 0xcf, 0xe4, 0x52, 0xa8, 0xeb, 0x97, 0xc7, 0xdb, 0x61, 0x71, 0xd6,
-	0x78, 0xf4, 0xa2, 0xeb, 0xcc, 0xf4, 0xba, 0x8e, 0x7d, 0x46, 0xd3, 0xe4, 0x53, 0xf7, 0x35, 0x06,
-	0xf7, 0xd7, 0x57, 0x4e, 0x2d, 0x8a, 0x65, 0x2b, 0x6f, 0x7a, 0x01, 0x4b, 0x4d, 0x51, 0xcc, 0xcf,
-	0xa6, 0x08, 0xdb, 0x75, 0x79, 0xd6, 0x01, 0xa1, 0xc8, 0x84, 0xbf, 0x5c, 0xe0, 0x77, 0x0c, 0x7c,
-	0x0f, 0x00, 0x1f, 0xa3, 0x15, 0x49, 0xdb, 0xc0, 0x0b, 0x2a, 0xd2, 0x51, 0x4a, 0xed, 0xd9, 0xaa,
-	0x55, 0x5b, 0xd8, 0xfa, 0xd0, 0x9b, 0xd2, 0x3a, 0xef, 0xb0, 0x00, 0xed, 0x01, 0xe8, 0xe4, 0x1a,
-	0x8e, 0x51, 0xb9, 0xa6, 0x55, 0x4e, 0x52, 0xba, 0xfe, 0x92, 0x1c, 0x03, 0xe0, 0x67, 0x68, 0x8d,
-	0xe6, 0xb2, 0xc5, 0x78, 0xfc, 0x03, 0x84, 0xe4, 0xfb, 0x9c, 0x49, 0x20, 0x21, 0x64, 0x2c, 0x15,
-	0x76, 0xa9, 0x5a, 0xaa, 0xcd, 0x37, 0xdc, 0x5e, 0xd7, 0xa9, 0x68, 0xb6, 0x2b, 0x02, 0x5d, 0x7f,
-	0x75, 0xe8, 0x79, 0x5a, 0x38, 0x76, 0xb5, 0xfd, 0x55, 0x09, 0x2d, 0x7e, 0xa1, 0xa7, 0xf0, 0x40,
-	0x52, 0x09, 0xb8, 0x8a, 0x16, 0x33, 0x38, 0x95, 0x44, 0x15, 0x2f, 0x0e, 0x6d, 0xab, 0x6a, 0xd5,
-	0xe6, 0x7c, 0x54, 0xd8, 0xf6, 0x19, 0x4b, 0xbe, 0x0c, 0xf1, 0x36, 0x2a, 0x8f, 0x25, 0x7f, 0x77,
-	0x6a, 0xf2, 0x26, 0xe9, 0xb9, 0x22, 0x69, 0xdf, 0x00, 0xf1, 0x13, 0xb4, 0xa0, 0xf8, 0xd5, 0x90,
-	0xe8, 0x2c, 0x16, 0xb6, 0x6a, 0x53, 0x79, 0xbe, 0x56, 0x63, 0xe5, 0x17, 0x00, 0x43, 0x86, 0x8a,
-	0x30, 0x65, 0x10, 0xf8, 0x3b, 0x84, 0x07, 0x75, 0x14, 0x44, 0x72, 0x1a, 0xb4, 0x81, 0xdb, 0x73,
-	0x4a, 0xdf, 0xe6, 0xb5, 0x9a, 0x23, 0x0e, 0x35, 0xc8, 0x5f, 0x91, 0x13, 0x16, 0xfc, 0x15, 0x5a,
-	0x54, 0x6a, 0x8f, 0x59, 0x92, 0xa7, 0x20, 0xec, 0x1b, 0x4a, 0xee, 0xfb, 0xd3, 0xd3, 0x66, 0x2c,
-	0xf9, 0x46, 0xc5, 0xfb, 0x2a, 0x55, 0xfd, 0x5f, 0xe0, 0x0e, 0x5a, 0x57, 0x1d, 0x21, 0x1d, 0x1a,
-	0x73, 0x32, 0xec, 0xbd, 0x90, 0x8c, 0x83, 0x5d, 0x56, 0xcc, 0xde, 0x54, 0x66, 0xd5, 0xb8, 0x7d,
-	0x1a, 0xf3, 0xbe, 0x72, 0x53, 0x8e, 0x5b, 0xe1, 0xa4, 0xe3, 0xa0, 0xe0, 0x74, 0x7f, 0x2c, 0xa3,
-	0xa5, 0xf1, 0x09, 0xc4, 0x4d, 0x74, 0x33, 0x84, 0x23, 0x9a, 0x27, 0x72, 0xa8, 0x40, 0x35, 0x7a,
-	0xbe, 0xf1, 0xa0, 0xe0, 0xfa, 0xb3, 0xeb, 0x6c, 0xe8, 0xa5, 0x10, 0x61, 0xdb, 0x8b, 0x59, 0x3d,
-	0xa5, 0xb2, 0xe5, 0x3d, 0x82, 0x88, 0x06, 0x67, 0xbb, 0x10, 0x9c, 0x77, 0x9d, 0xe5, 0x5d, 0x8d,
-	0xef, 0x13, 0xfb, 0xcb, 0xe1, 0xb8, 0x01, 0xff, 0x6c, 0x21, 0x75, 0x9e, 0x8d, 0xe4, 0x18, 0xc6,
-	0x42, 0xf2, 0xb8, 0x99, 0x17, 0xfb, 0x64, 0x66, 0xe7, 0xb3, 0x6b, 0xf5, 0x66, 0x77, 0x04, 0xb8,
-	0x0f, 0x3c, 0x80, 0x4c, 0xd2, 0x08, 0x1a, 0xd5, 0x42, 0xeb, 0x79, 0xd7, 0xb1, 0x9f, 0x88, 0x94,
-	0x5d, 0x16, 0xeb, 0xdb, 0xec, 0x0a, 0x0f, 0xfe, 0xc5, 0x42, 0x4e, 0xc6, 0x32, 0x32, 0x4d, 0x62,
-	0xe9, 0xff, 0x4b, 0xbc, 0x6b, 0x24, 0x6e, 0x3c, 0x66, 0xd9, 0x95, 0x2a, 0x37, 0xb2, 0xab, 0x9d,
-	0x78, 0x07, 0x2d, 0xd3, 0x30, 0x8d, 0x33, 0x42, 0xc3, 0x90, 0x83, 0x10, 0x20, 0xec, 0x39, 0xb5,
-	0xf4, 0xeb, 0xbd, 0xae, 0x73, 0xcb, 0x2c, 0xfd, 0x78, 0x80, 0xeb, 0x2f, 0x29, 0xcb, 0x76, 0xdf,
-	0x80, 0x7f, 0xb3, 0xd0, 0x83, 0x80, 0xa5, 0x69, 0x9e, 0xc5, 0xf2, 0x4c, 0xaf, 0xb6, 0x9e, 0x42,
-	0xc9, 0x88, 0x38, 0xa1, 0x1d, 0x52, 0x94, 0xe2, 0xa4, 0x15, 0x4b, 0x48, 0x62, 0x21, 0x21, 0x24,
-	0x54, 0x08, 0x90, 0x82, 0x48, 0x66, 0xdf, 0x50, 0x63, 0xb1, 0xdd, 0xeb, 0x3a, 0x0f, 0xf5, 0xcb,
-	0xfe, 0x1b, 0x8f, 0xeb, 0x7b, 0x03, 0x60, 0xb1, 0x1b, 0x6a, 0x8a, 0x0f, 0xd9, 0xc1, 0x09, 0xed,
-	0x3c, 0x66, 0xd9, 0xb7, 0x43, 0xc8, 0xb6, 0x42, 0x1c, 0x32, 0x7c, 0x88, 0x56, 0x39, 0x84, 0x79,
-	0x00, 0xa1, 0xea, 0xcc, 0x80, 0x55, 0x2d, 0xc9, 0x7c, 0xa3, 0xda, 0xeb, 0x3a, 0x77, 0xb4, 0xa2,
-	0x4b, 0xc3, 0x5c, 0xff, 0x1d, 0x63, 0xdf, 0x03, 0x18, 0xf0, 0xbb, 0x7f, 0x5b, 0xa8, 0x32, 0xbd,
-	0x67, 0xf8, 0x08, 0x2d, 0x0b, 0x49, 0xdb, 0x71, 0x16, 0x11, 0x0e, 0x27, 0x94, 0x87, 0xc2, 0xec,
-	0xc6, 0xc3, 0x6b, 0xec, 0xc6, 0xb0, 0x29, 0x13, 0x1c, 0xae, 0xbf, 0x64, 0x2c, 0xbe, 0x36, 0xe0,
-	0x00, 0x2d, 0x8d, 0xd7, 0x52, 0xed, 0xc4, 0x7c, 0xe3, 0xf3, 0xeb, 0xbd, 0x66, 0xf5, 0xb2, 0x76,
-	0xb8, 0xfe, 0xdb, 0x63, 0x65, 0x76, 0x7f, 0x9f, 0x45, 0x2b, 0x93, 0x47, 0x1c, 0xf6, 0xd1, 0xea,
-	0xe8, 0x69, 0xc9, 0x88, 0x50, 0x8f, 0xe2, 0xcd, 0x37, 0xac, 0x3e, 0x6a, 0xf0, 0xf0, 0x88, 0x64,
-	0x07, 0x1a, 0x8a, 0x09, 0xba, 0x33, 0xce, 0xf9, 0x5a, 0x6e, 0xd7, 0xa2, 0xb6, 0x47, 0xa8, 0x77,
-	0x46, 0x33, 0xc1, 0x6d, 0xf4, 0x5e, 0x0b, 0xe2, 0xa8, 0x25, 0x09, 0x0d, 0x02, 0x96, 0x67, 0xb2,
-	0x28, 0xae, 0x90, 0x94, 0x4b, 0x41, 0x8e, 0x38, 0x4b, 0xd5, 0xba, 0x96, 0x1a, 0xb5, 0x5e, 0xd7,
-	0xb9, 0xa7, 0x4b, 0x33, 0x35, 0xdc, 0xf5, 0xd7, 0xb5, 0x7f, 0x7b, 0xe0, 0x3e, 0x50, 0xde, 0xbd,
-	0xc2, 0xf9, 0xdc, 0x42, 0x68, 0x78, 0x84, 0xe3, 0x35, 0xf4, 0xd6, 0xf8, 0x7d, 0x58, 0xee, 0xe8,
-	0xbb, 0x30, 0x31, 0x17, 0x99, 0xbe, 0x1a, 0xde, 0x9c, 0xe4, 0x47, 0x45, 0x92, 0xff, 0xea, 0x2b,
-	0x04, 0x0d, 0x6f, 0x8f, 0xc6, 0xd3, 0x17, 0xe7, 0x15, 0xeb, 0xe5, 0x79, 0xc5, 0xfa, 0xeb, 0xbc,
-	0x62, 0xfd, 0x74, 0x51, 0x99, 0x79, 0x79, 0x51, 0x99, 0xf9, 0xe3, 0xa2, 0x32, 0xf3, 0xec, 0x93,
-	0x11, 0x3e, 0x73, 0x5c, 0x6d, 0x26, 0xb4, 0x29, 0xfa, 0x0f, 0xf5, 0xe3, 0xad, 0xad, 0xfa, 0xe9,
-	0xd8, 0xf7, 0x97, 0x7a, 0x49, 0xb3, 0xac, 0xbe, 0xbd, 0x3e, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0xf3, 0x27, 0x68, 0xcc, 0xa7, 0x0a, 0x00, 0x00,
+	// 1146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xbb, 0xe9, 0x56, 0x99, 0x4d, 0xf3, 0x31, 0x25, 0x8d, 0x9b, 0x94, 0xf5, 0xca, 0xad,
+	0xc4, 0x56, 0x28, 0x76, 0x1b, 0xa4, 0x22, 0x01, 0x3d, 0x64, 0x13, 0x05, 0x81, 0x4a, 0x9b, 0x3a,
+	0x2b, 0x90, 0xca, 0x61, 0x34, 0x6b, 0x4f, 0xbc, 0xd6, 0xda, 0x1e, 0xe3, 0x19, 0xe7, 0x83, 0x1b,
+	0x67, 0x2e, 0x48, 0xbd, 0x72, 0xe6, 0xc0, 0x0d, 0x89, 0x3f, 0x81, 0x43, 0x8f, 0x3d, 0x22, 0x0e,
+	0x0b, 0x4a, 0xce, 0x5c, 0xf6, 0x2f, 0x40, 0x9e, 0x99, 0xfd, 0xf0, 0x36, 0x71, 0x02, 0x9c, 0x76,
+	0xfd, 0xde, 0xfb, 0xfd, 0xfc, 0x7e, 0xef, 0xbd, 0x79, 0x63, 0xf0, 0x80, 0xb2, 0x88, 0xb2, 0x80,
+	0xd9, 0x09, 0xa5, 0x61, 0x84, 0x63, 0xec, 0x93, 0xd4, 0x3e, 0x7c, 0xd4, 0x21, 0x1c, 0x3f, 0xb2,
+	0x7d, 0x12, 0x13, 0x16, 0x30, 0x2b, 0x49, 0x29, 0xa7, 0x70, 0x5d, 0x85, 0x5a, 0x13, 0xa1, 0x96,
+	0x0a, 0x5d, 0x7b, 0xc7, 0xa7, 0x3e, 0x15, 0x71, 0x76, 0xfe, 0x4f, 0x42, 0xd6, 0xee, 0xf8, 0x94,
+	0xfa, 0x21, 0xb1, 0xc5, 0x53, 0x27, 0x3b, 0xb0, 0x71, 0x7c, 0x32, 0x74, 0xb9, 0x82, 0x0e, 0x49,
+	0x8c, 0x7c, 0x50, 0xae, 0xfa, 0x34, 0xca, 0xcb, 0x52, 0xcc, 0x03, 0x1a, 0x0f, 0xfd, 0x32, 0xda,
+	0xee, 0x60, 0x46, 0x46, 0xb9, 0xba, 0x34, 0x18, 0xfa, 0xad, 0x32, 0x4d, 0x11, 0xf5, 0xb2, 0x90,
+	0xa0, 0x94, 0x66, 0x9c, 0xa8, 0xf8, 0xfb, 0x65, 0xf1, 0xfc, 0x58, 0x45, 0x3d, 0x2c, 0x8d, 0x4a,
+	0xb1, 0xdb, 0x23, 0x1e, 0x3a, 0xa4, 0x61, 0x16, 0x29, 0x5e, 0x73, 0x70, 0x0d, 0x54, 0xf7, 0x70,
+	0x8a, 0x23, 0x06, 0x5f, 0x69, 0x60, 0x39, 0xc7, 0x21, 0x37, 0x25, 0x42, 0x0a, 0x3a, 0x20, 0x44,
+	0xd7, 0x1a, 0x95, 0x66, 0x6d, 0xf3, 0x8e, 0xa5, 0xd4, 0xe7, 0x7a, 0x86, 0x05, 0xb5, 0xb6, 0x69,
+	0x10, 0xb7, 0x9e, 0xbe, 0xee, 0x1b, 0x33, 0x83, 0xbe, 0xa1, 0x9f, 0xe0, 0x28, 0xfc, 0xc8, 0x7c,
+	0x8b, 0xc1, 0xfc, 0xf9, 0x4f, 0xa3, 0xe9, 0x07, 0xbc, 0x9b, 0x75, 0x2c, 0x97, 0x46, 0xaa, 0x8c,
+	0xea, 0x67, 0x83, 0x79, 0x3d, 0x9b, 0x9f, 0x24, 0x84, 0x09, 0x32, 0xe6, 0x2c, 0xe6, 0xf8, 0x6d,
+	0x05, 0xdf, 0x25, 0x04, 0x1e, 0x82, 0x25, 0x8e, 0x7b, 0x24, 0xcd, 0xa9, 0x50, 0x22, 0x32, 0xd5,
+	0xaf, 0x35, 0xb4, 0x66, 0x6d, 0xf3, 0x7d, 0xab, 0xa4, 0xd9, 0x56, 0x3b, 0x07, 0xed, 0x12, 0x22,
+	0xc5, 0xb5, 0x0c, 0x95, 0xe5, 0xaa, 0xcc, 0x72, 0x9a, 0xd2, 0x74, 0x16, 0x78, 0x01, 0x00, 0x5f,
+	0x82, 0x55, 0x9c, 0xf1, 0x2e, 0x4d, 0x83, 0x6f, 0x89, 0x87, 0xbe, 0xc9, 0x28, 0x27, 0xc8, 0x23,
+	0x31, 0x8d, 0x98, 0x5e, 0x69, 0x54, 0x9a, 0x73, 0x2d, 0x73, 0xd0, 0x37, 0xea, 0x92, 0xed, 0x82,
+	0x40, 0xd3, 0x59, 0x19, 0x7b, 0x5e, 0xe4, 0x8e, 0x1d, 0x69, 0xff, 0x6d, 0x16, 0xcc, 0x7f, 0x2a,
+	0xe7, 0x76, 0x9f, 0x63, 0x4e, 0x60, 0x03, 0xcc, 0xc7, 0xe4, 0x98, 0x23, 0x51, 0xbc, 0xc0, 0xd3,
+	0xb5, 0x86, 0xd6, 0x9c, 0x75, 0x40, 0x6e, 0xdb, 0xa3, 0x34, 0xfc, 0xcc, 0x83, 0x5b, 0xa0, 0x5a,
+	0x10, 0x7f, 0xaf, 0x54, 0xbc, 0x12, 0x3d, 0x9b, 0x8b, 0x76, 0x14, 0x10, 0x3e, 0x07, 0x35, 0xc1,
+	0x2f, 0xc6, 0x4a, 0xaa, 0xa8, 0x6d, 0x36, 0x4b, 0x79, 0xbe, 0x10, 0x83, 0xe8, 0xe4, 0x00, 0x45,
+	0x06, 0xf2, 0x30, 0x61, 0x60, 0xf0, 0x6b, 0x00, 0x47, 0x75, 0x64, 0x48, 0x8e, 0x57, 0xaa, 0xcf,
+	0x8a, 0xfc, 0x36, 0xae, 0xd4, 0x1c, 0xd6, 0x96, 0x20, 0x67, 0x89, 0x4f, 0x59, 0xe0, 0xe7, 0x60,
+	0x5e, 0x64, 0x2b, 0xa7, 0x95, 0xe9, 0xd7, 0x45, 0xba, 0xef, 0x95, 0xcb, 0xa6, 0x34, 0xfc, 0x52,
+	0xc4, 0x3b, 0xb5, 0x64, 0xf4, 0x9f, 0xc1, 0x04, 0xac, 0x89, 0x8e, 0xa0, 0x04, 0x07, 0x29, 0x1a,
+	0xf7, 0x9e, 0x71, 0x9a, 0x12, 0xbd, 0x2a, 0x98, 0xad, 0x52, 0x66, 0xd1, 0xb8, 0x3d, 0x1c, 0xa4,
+	0xc3, 0xcc, 0x55, 0x39, 0x6e, 0x7b, 0xd3, 0x8e, 0xfd, 0x9c, 0x13, 0xba, 0xe0, 0xd6, 0x44, 0xf6,
+	0xa8, 0x93, 0xb9, 0x3d, 0xc2, 0x99, 0x7e, 0xa3, 0x51, 0xb9, 0xb4, 0x36, 0x63, 0x11, 0x2d, 0x81,
+	0x52, 0x6f, 0x5a, 0x4e, 0xa6, 0xec, 0xcc, 0xfc, 0xbe, 0x0a, 0x16, 0x8a, 0x63, 0x0e, 0x3b, 0x60,
+	0xd9, 0x23, 0x07, 0x38, 0x0b, 0xf9, 0x58, 0xa6, 0x98, 0xa6, 0xb9, 0xd6, 0xe3, 0x9c, 0xe6, 0x8f,
+	0xbe, 0xb1, 0x2e, 0x4f, 0x1e, 0xf3, 0x7a, 0x56, 0x40, 0xed, 0x08, 0xf3, 0xae, 0xf5, 0x94, 0xf8,
+	0xd8, 0x3d, 0xd9, 0x21, 0xee, 0x69, 0xdf, 0x58, 0xdc, 0x91, 0xf8, 0x21, 0xb1, 0xb3, 0xe8, 0x15,
+	0x0d, 0xf0, 0x47, 0x0d, 0x88, 0x35, 0x3b, 0x51, 0x48, 0x2f, 0x60, 0x3c, 0x0d, 0x3a, 0x59, 0x7e,
+	0x68, 0xd5, 0x80, 0x7e, 0x7c, 0xa5, 0x01, 0xd8, 0x99, 0x00, 0xee, 0x91, 0xd4, 0x25, 0x31, 0xc7,
+	0x3e, 0x69, 0x35, 0xf2, 0x5c, 0x4f, 0xfb, 0x86, 0xfe, 0x9c, 0x45, 0xf4, 0xbc, 0x58, 0x47, 0xa7,
+	0x17, 0x78, 0xe0, 0x4f, 0x1a, 0x30, 0x62, 0x1a, 0xa3, 0xb2, 0x14, 0x2b, 0xff, 0x3f, 0xc5, 0x7b,
+	0x2a, 0xc5, 0xf5, 0x67, 0x34, 0xbe, 0x30, 0xcb, 0xf5, 0xf8, 0x62, 0x27, 0xdc, 0x06, 0x8b, 0xd8,
+	0x8b, 0x82, 0x18, 0x61, 0xcf, 0x4b, 0x09, 0x63, 0x84, 0xe9, 0xb3, 0x62, 0xb3, 0xac, 0x0d, 0xfa,
+	0xc6, 0x6d, 0xb5, 0x59, 0x8a, 0x01, 0xa6, 0xb3, 0x20, 0x2c, 0x5b, 0x43, 0x03, 0xfc, 0x45, 0x03,
+	0x8f, 0x5d, 0x1a, 0x45, 0x59, 0x1c, 0xf0, 0x13, 0xb9, 0x3f, 0xe4, 0xa8, 0x73, 0x8a, 0xd8, 0x11,
+	0x4e, 0x50, 0x5e, 0x8a, 0xa3, 0x6e, 0xc0, 0x49, 0x18, 0x30, 0x4e, 0x3c, 0x84, 0x19, 0x23, 0x9c,
+	0x21, 0x4e, 0xf5, 0xeb, 0x62, 0x2c, 0xb6, 0x06, 0x7d, 0xe3, 0x89, 0x7c, 0xd9, 0x7f, 0xe3, 0x31,
+	0x1d, 0x6b, 0x04, 0xcc, 0x67, 0x57, 0x1c, 0x95, 0x36, 0xdd, 0x3f, 0xc2, 0xc9, 0x33, 0x1a, 0x7f,
+	0x35, 0x86, 0x6c, 0x09, 0x44, 0x9b, 0xc2, 0x36, 0x58, 0x49, 0x89, 0x97, 0xb9, 0xc4, 0x13, 0x9d,
+	0x19, 0xb1, 0x8a, 0x93, 0x38, 0xd7, 0x6a, 0x0c, 0xfa, 0xc6, 0x5d, 0x99, 0xd1, 0xb9, 0x61, 0xa6,
+	0x73, 0x4b, 0xd9, 0x77, 0x09, 0x19, 0xf1, 0x9b, 0x7f, 0x6b, 0xa0, 0x5e, 0xde, 0x33, 0x78, 0x00,
+	0x16, 0x19, 0xc7, 0xbd, 0x20, 0xf6, 0x51, 0x4a, 0x8e, 0x70, 0xea, 0x31, 0x75, 0x36, 0x9e, 0x5c,
+	0xe1, 0x6c, 0x8c, 0x9b, 0x32, 0xc5, 0x61, 0x3a, 0x0b, 0xca, 0xe2, 0x48, 0x03, 0x74, 0xc1, 0x42,
+	0xb1, 0x96, 0xe2, 0x4c, 0xcc, 0xb5, 0x3e, 0xb9, 0xda, 0x6b, 0x56, 0xce, 0x6b, 0x87, 0xe9, 0xdc,
+	0x2c, 0x94, 0xd9, 0xfc, 0xf5, 0x1a, 0x58, 0x9a, 0xde, 0xa3, 0xd0, 0x01, 0x2b, 0x93, 0x2b, 0x99,
+	0x22, 0x26, 0x1e, 0xd9, 0xe5, 0xd7, 0xb8, 0xdc, 0x32, 0x70, 0xbc, 0x87, 0xe9, 0xbe, 0x84, 0x42,
+	0x04, 0xee, 0x16, 0x39, 0xdf, 0xd2, 0x76, 0x25, 0x6a, 0x7d, 0x82, 0x7a, 0x7b, 0x52, 0x09, 0xec,
+	0x81, 0x77, 0xbb, 0x24, 0xf0, 0xbb, 0x1c, 0x61, 0xd7, 0xa5, 0x59, 0xcc, 0xf3, 0xe2, 0x32, 0x8e,
+	0x53, 0xce, 0xd0, 0x41, 0x4a, 0x23, 0x71, 0x5c, 0x2b, 0xad, 0xe6, 0xa0, 0x6f, 0xdc, 0x97, 0xa5,
+	0x29, 0x0d, 0x37, 0x9d, 0x35, 0xe9, 0xdf, 0x1a, 0xb9, 0xf7, 0x85, 0x77, 0x37, 0x77, 0xbe, 0xd2,
+	0x00, 0x18, 0xaf, 0x58, 0xb8, 0x0a, 0x6e, 0x14, 0x2f, 0xdd, 0x6a, 0x22, 0x2f, 0xdc, 0x10, 0xd4,
+	0x26, 0x36, 0xf8, 0xe5, 0x22, 0x1f, 0xe6, 0x22, 0xff, 0xd5, 0xa7, 0x0e, 0x18, 0x6f, 0x74, 0xf3,
+	0x3b, 0x0d, 0x2c, 0x4d, 0x2f, 0xfe, 0x8b, 0x73, 0x6b, 0x83, 0x9b, 0x85, 0x8b, 0x45, 0xad, 0xdc,
+	0x07, 0xa5, 0xfb, 0xec, 0x9c, 0x3b, 0x65, 0xfe, 0x70, 0xd2, 0xf6, 0xe2, 0xf5, 0x69, 0x5d, 0x7b,
+	0x73, 0x5a, 0xd7, 0xfe, 0x3a, 0xad, 0x6b, 0x3f, 0x9c, 0xd5, 0x67, 0xde, 0x9c, 0xd5, 0x67, 0x7e,
+	0x3f, 0xab, 0xcf, 0xbc, 0xfc, 0x70, 0x42, 0x93, 0x7a, 0xc5, 0x46, 0x88, 0x3b, 0x6c, 0xf8, 0x60,
+	0x1f, 0x6e, 0x6e, 0xda, 0xc7, 0x85, 0x8f, 0x4e, 0x21, 0xb4, 0x53, 0x15, 0x1f, 0x99, 0x1f, 0xfc,
+	0x33, 0x00, 0xd5, 0x53, 0xa6, 0x26, 0xc2, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -614,6 +683,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolVolumeBuckets) > 0 {
+		for iNdEx := len(m.PoolVolumeBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVolumeBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -905,6 +988,44 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolVolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VolumeBucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -972,6 +1093,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PoolVolumeBuckets) > 0 {
+		for _, e := range m.PoolVolumeBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1061,6 +1188,20 @@ func (m *PoolVolume) Size() (n int) {
 	return n
 }
 
+func (m *PoolVolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.VolumeBucket.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1435,6 +1576,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumeBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumeBuckets = append(m.PoolVolumeBuckets, PoolVolumeBucket{})
+			if err := m.PoolVolumeBuckets[len(m.PoolVolumeBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2060,6 +2235,108 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolVolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolumeBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -48,6 +49,9 @@ var (
 
 	// KeyTakerFeeCommunityPoolProtoRevArray defines key to store the taker fee for community pool tracker coin array.
 	KeyTakerFeeCommunityPoolProtoRevArray = []byte{0x09}
+
+	// KeyPoolVolumeBucketPrefix defines prefix to store the time bucketed pool volume.
+	KeyPoolVolumeBucketPrefix = []byte{0x0A}
 )
 
 // ModuleRouteToBytes serializes moduleRoute to bytes.
@@ -88,6 +92,33 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// KeyPoolVolumeBucketPoolPrefix returns the prefix of the volume buckets of the given poolId.
+func KeyPoolVolumeBucketPoolPrefix(poolId uint64) []byte {
+	return append(append([]byte{}, KeyPoolVolumeBucketPrefix...), sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyPoolVolumeBucket returns the key for the volume bucket of the given poolId starting at startTime.
+// The start time is encoded in big endian unix seconds so that the buckets of a pool are sorted by time.
+// Start times before the unix epoch are encoded as the unix epoch.
+func KeyPoolVolumeBucket(poolId uint64, startTime time.Time) []byte {
+	unixSeconds := startTime.Unix()
+	if unixSeconds < 0 {
+		unixSeconds = 0
+	}
+	return append(KeyPoolVolumeBucketPoolPrefix(poolId), sdk.Uint64ToBigEndian(uint64(unixSeconds))...)
+}
+
+// ParsePoolVolumeBucketKey parses the poolId and the start time of a volume bucket key
+// without the KeyPoolVolumeBucketPrefix.
+func ParsePoolVolumeBucketKey(key []byte) (poolId uint64, startTime time.Time, err error) {
+	if len(key) != 16 {
+		return 0, time.Time{}, fmt.Errorf("invalid volume bucket key length, expected 16, got %d", len(key))
+	}
+	poolId = sdk.BigEndianToUint64(key[:8])
+	startTime = time.Unix(int64(sdk.BigEndianToUint64(key[8:])), 0).UTC()
+	return poolId, startTime, nil
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (denom0, denom1 string, err error) {
 	keyStr := string(key)
@@ -19,6 +19,14 @@ func (msg MsgSplitRouteSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
 	return routes
 }
 
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetSwapMsgs() []SwapMsgRoute {
+	routes := make([]SwapMsgRoute, len(msg.Routes))
+	for i := 0; i < len(msg.Routes); i++ {
+		routes[i] = SwapAmountInSplitRouteWrapper{msg.Routes[i].Pools, msg.TokenInDenom}
+	}
+	return routes
+}
+
 func (msg MsgSplitRouteSwapExactAmountOut) GetSwapMsgs() []SwapMsgRoute {
 	routes := make([]SwapMsgRoute, len(msg.Routes))
 	for i := 0; i < len(msg.Routes); i++ {
@@ -40,8 +48,13 @@ type SwapAmountOutSplitRouteWrapper struct {
 var (
 	_ SwapMsgRoute = MsgSwapExactAmountIn{}
 	_ SwapMsgRoute = MsgSwapExactAmountOut{}
+	_ SwapMsgRoute = MsgSwapExactAmountInWithMaxPriceImpact{}
 	_ SwapMsgRoute = SwapAmountInSplitRouteWrapper{}
 	_ SwapMsgRoute = SwapAmountOutSplitRouteWrapper{}
+
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountIn{}
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountOut{}
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountInWithMaxPriceImpact{}
 )
 
 func (msg SwapAmountOutSplitRouteWrapper) TokenInDenom() string {
@@ -108,3 +121,21 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) TokenInDenom() string {
+	return msg.TokenIn.Denom
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) TokenOutDenom() string {
+	lastRouteIndex := len(msg.Routes) - 1
+	return msg.Routes[lastRouteIndex].GetTokenOutDenom()
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) TokenDenomsOnPath() []string {
+	denoms := make([]string, 0, len(msg.Routes)+1)
+	denoms = append(denoms, msg.TokenInDenom())
+	for i := 0; i < len(msg.Routes); i++ {
+		denoms = append(denoms, msg.Routes[i].TokenOutDenom)
+	}
+	return denoms
+}
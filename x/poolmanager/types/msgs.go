@@ -4,6 +4,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 // constants.
@@ -13,6 +15,9 @@ const (
 	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
 	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
 	TypeMsgSetDenomPairTakerFee         = "set_denom_pair_taker_fee"
+
+	TypeMsgSwapExactAmountInWithMaxPriceImpact           = "swap_exact_amount_in_with_max_price_impact"
+	TypeMsgSplitRouteSwapExactAmountInWithMaxPriceImpact = "split_route_swap_exact_amount_in_with_max_price_impact"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -193,3 +198,101 @@ func (msg MsgSetDenomPairTakerFee) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithMaxPriceImpact{}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) Type() string {
+	return TypeMsgSwapExactAmountInWithMaxPriceImpact
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	err = SwapAmountInRoutes(msg.Routes).Validate()
+	if err != nil {
+		return err
+	}
+
+	if !msg.TokenIn.IsValid() || !msg.TokenIn.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.TokenIn.String())
+	}
+
+	if err := ValidateTokenOutMinAmount(msg.TokenOutMinAmount); err != nil {
+		return err
+	}
+
+	return validateMaxPriceImpact(msg.MaxPriceImpact)
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountInWithMaxPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountInWithMaxPriceImpact{}
+
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Type() string {
+	return TypeMsgSplitRouteSwapExactAmountInWithMaxPriceImpact
+}
+
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return InvalidSenderError{Sender: msg.Sender}
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenInDenom); err != nil {
+		return err
+	}
+
+	if err := ValidateSwapAmountInSplitRoute(msg.Routes); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenOutMinAmount(msg.TokenOutMinAmount); err != nil {
+		return err
+	}
+
+	return validateMaxPriceImpact(msg.MaxPriceImpact)
+}
+
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateMaxPriceImpact returns an error if the max price impact is nil or not positive.
+// ValidateTokenOutMinAmount validates the optional token out min amount of the swaps with a max price impact,
+// which is unset or zero when there is no minimum.
+func ValidateTokenOutMinAmount(tokenOutMinAmount osmomath.Int) error {
+	if !tokenOutMinAmount.IsNil() && tokenOutMinAmount.IsNegative() {
+		return NegativeTokenOutMinAmountError{TokenOutMinAmount: tokenOutMinAmount}
+	}
+	return nil
+}
+
+func validateMaxPriceImpact(maxPriceImpact osmomath.Dec) error {
+	if maxPriceImpact.IsNil() || !maxPriceImpact.IsPositive() {
+		return NonPositiveMaxPriceImpactError{MaxPriceImpact: maxPriceImpact}
+	}
+	return nil
+}
@@ -654,3 +654,188 @@ func TestMsgSetDenomPairTakerFee(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountInWithMaxPriceImpact(t *testing.T) {
+	properMsg := types.MsgSwapExactAmountInWithMaxPriceImpact{
+		Sender:         addr1,
+		Routes:         validSwapExactAmountInRoutes,
+		TokenIn:        sdk.NewCoin("test", osmomath.NewInt(100)),
+		MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), types.TypeMsgSwapExactAmountInWithMaxPriceImpact)
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSwapExactAmountInWithMaxPriceImpact
+		expectError bool
+	}{
+		"valid": {
+			msg: properMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectError: true,
+		},
+		"empty routes": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.Routes = nil
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token in": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenIn.Amount = osmomath.ZeroInt()
+				return msg
+			}),
+			expectError: true,
+		},
+		"nil max price impact": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.Dec{}
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+		},
+		"positive token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.NewInt(90)
+				return msg
+			}),
+		},
+		"negative token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.NewInt(-1)
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero max price impact": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				return msg
+			}),
+			expectError: true,
+		},
+		"negative max price impact": {
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountInWithMaxPriceImpact) types.MsgSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.MustNewDecFromStr("-0.01")
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountInWithMaxPriceImpact(t *testing.T) {
+	properMsg := types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact{
+		Sender: addr1,
+		Routes: []types.SwapAmountInSplitRoute{
+			{Pools: validSwapExactAmountInRoutes, TokenInAmount: osmomath.OneInt()},
+			{Pools: []types.SwapAmountInRoute{validSwapRoutePoolThreeAmountIn}, TokenInAmount: osmomath.OneInt()},
+		},
+		TokenInDenom:   "udai",
+		MaxPriceImpact: osmomath.MustNewDecFromStr("0.01"),
+	}
+
+	require.Equal(t, properMsg.Route(), types.RouterKey)
+	require.Equal(t, properMsg.Type(), types.TypeMsgSplitRouteSwapExactAmountInWithMaxPriceImpact)
+	signers := properMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := map[string]struct {
+		msg         types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact
+		expectError bool
+	}{
+		"valid": {
+			msg: properMsg,
+		},
+		"invalid sender": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.Sender = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"duplicate multihop routes": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.Routes = []types.SwapAmountInSplitRoute{properMsg.Routes[0], properMsg.Routes[0]}
+				return msg
+			}),
+			expectError: true,
+		},
+		"invalid token in denom": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenInDenom = ""
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.ZeroInt()
+				return msg
+			}),
+		},
+		"positive token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.NewInt(90)
+				return msg
+			}),
+		},
+		"negative token out min amount": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.TokenOutMinAmount = osmomath.NewInt(-1)
+				return msg
+			}),
+			expectError: true,
+		},
+		"zero max price impact": {
+			msg: createMsg(properMsg, func(msg types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) types.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact {
+				msg.MaxPriceImpact = osmomath.ZeroDec()
+				return msg
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
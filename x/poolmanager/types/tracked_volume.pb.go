@@ -4,20 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -69,8 +74,69 @@ func (m *TrackedVolume) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// VolumeBucket is the swap volume of a pool over a time bucket of
+// VolumeBucketDuration.
+type VolumeBucket struct {
+	// start_time is the start of the time bucket.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// native_volume is the volume in the token in denoms of the swaps.
+	NativeVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=native_volume,json=nativeVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"native_volume" yaml:"native_volume"`
+	// osmo_volume is the volume in OSMO, valued at the spot price of the most
+	// liquid OSMO paired pool at the time of the swaps. Swaps of tokens without
+	// an OSMO paired pool are only counted in native_volume.
+	OsmoVolume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=osmo_volume,json=osmoVolume,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_volume" yaml:"osmo_volume"`
+}
+
+func (m *VolumeBucket) Reset()         { *m = VolumeBucket{} }
+func (m *VolumeBucket) String() string { return proto.CompactTextString(m) }
+func (*VolumeBucket) ProtoMessage()    {}
+func (*VolumeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a2e3e91de3baf1a, []int{1}
+}
+func (m *VolumeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeBucket.Merge(m, src)
+}
+func (m *VolumeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeBucket proto.InternalMessageInfo
+
+func (m *VolumeBucket) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VolumeBucket) GetNativeVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NativeVolume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrackedVolume)(nil), "osmosis.poolmanager.v1beta1.TrackedVolume")
+	proto.RegisterType((*VolumeBucket)(nil), "osmosis.poolmanager.v1beta1.VolumeBucket")
 }
 
 func init() {
@@ -78,23 +144,34 @@ func init() {
 }
 
 var fileDescriptor_0a2e3e91de3baf1a = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc8, 0xcf, 0xcf, 0xc9, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0x2d, 0xd2,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0xce, 0x4e, 0x4d, 0x89,
-	0x2f, 0xcb, 0xcf, 0x29, 0xcd, 0x4d, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x86, 0xea,
-	0xd0, 0x43, 0xd2, 0xa1, 0x07, 0xd5, 0x21, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f,
-	0x62, 0x41, 0xb4, 0x48, 0xc9, 0x25, 0x83, 0xf5, 0xe8, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x0d, 0x4f,
-	0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0x95, 0x70, 0xf1, 0x86, 0x40, 0xac, 0x0a, 0x03, 0xdb, 0x24,
-	0x94, 0xcc, 0xc5, 0x96, 0x98, 0x9b, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d,
-	0x24, 0xa9, 0x07, 0x31, 0x41, 0x0f, 0x64, 0x02, 0xcc, 0x32, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27,
-	0x83, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x5a, 0x07, 0xa1, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x1a, 0x8a, 0x83, 0xa0, 0x46, 0x3b, 0x05, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x39, 0x92, 0x59, 0x50, 0xdf, 0xea, 0xe6, 0x24, 0x26, 0x15, 0xc3,
-	0x38, 0xfa, 0x65, 0x46, 0x46, 0xfa, 0x15, 0x28, 0x41, 0x06, 0xb6, 0x20, 0x89, 0x0d, 0xec, 0x1f,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xde, 0x04, 0x3f, 0x56, 0x01, 0x00, 0x00,
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x6e, 0xd4, 0x40,
+	0x14, 0x87, 0x77, 0x12, 0x29, 0x52, 0x66, 0x93, 0x02, 0x2b, 0x48, 0xcb, 0x22, 0xec, 0x95, 0x2b,
+	0x37, 0x99, 0x49, 0x9c, 0x02, 0x89, 0xd2, 0x34, 0x50, 0xb2, 0x5a, 0x21, 0x44, 0x13, 0x8d, 0xbd,
+	0x83, 0x63, 0xd9, 0xe3, 0x67, 0x79, 0xc6, 0x16, 0xb9, 0x01, 0x65, 0xce, 0xc1, 0x49, 0x42, 0x97,
+	0x12, 0x51, 0x38, 0x68, 0xf7, 0x06, 0x39, 0x01, 0x9a, 0x3f, 0x86, 0x4d, 0x85, 0x52, 0xd9, 0xcf,
+	0x7e, 0xdf, 0xfb, 0x7d, 0xf3, 0x34, 0xf8, 0x0c, 0xa4, 0x00, 0x59, 0x48, 0xda, 0x00, 0x54, 0x82,
+	0xd5, 0x2c, 0xe7, 0x2d, 0xed, 0xcf, 0x53, 0xae, 0xd8, 0x39, 0x55, 0x2d, 0xcb, 0x4a, 0xbe, 0xbe,
+	0xec, 0xa1, 0xea, 0x04, 0x27, 0x4d, 0x0b, 0x0a, 0xbc, 0x97, 0x8e, 0x20, 0x3b, 0x04, 0x71, 0xc4,
+	0xfc, 0x24, 0x87, 0x1c, 0x4c, 0x1f, 0xd5, 0x6f, 0x16, 0x99, 0xfb, 0x99, 0x61, 0x68, 0xca, 0x24,
+	0xff, 0x3b, 0x3c, 0x83, 0xa2, 0x76, 0xff, 0x83, 0x1c, 0x20, 0xaf, 0x38, 0x35, 0x55, 0xda, 0x7d,
+	0xa1, 0xaa, 0x10, 0x5c, 0x2a, 0x26, 0x1a, 0xdb, 0x10, 0x2a, 0x7c, 0xbc, 0xb2, 0x2e, 0x1f, 0x8d,
+	0x8a, 0x97, 0xe1, 0x03, 0x26, 0xa0, 0xab, 0xd5, 0x0c, 0x2d, 0xf6, 0xa3, 0x69, 0xfc, 0x82, 0xd8,
+	0x08, 0xa2, 0x23, 0x46, 0x1b, 0xf2, 0x16, 0x8a, 0x3a, 0x39, 0xbb, 0x1d, 0x82, 0xc9, 0xf7, 0xfb,
+	0x20, 0xca, 0x0b, 0x75, 0xd5, 0xa5, 0x24, 0x03, 0x41, 0x9d, 0x8f, 0x7d, 0x9c, 0xca, 0x75, 0x49,
+	0xd5, 0x75, 0xc3, 0xa5, 0x01, 0xe4, 0xd2, 0x8d, 0x0e, 0x7f, 0xec, 0xe1, 0x23, 0x9b, 0x97, 0x74,
+	0x59, 0xc9, 0x95, 0xf7, 0x09, 0x63, 0xa9, 0x58, 0xab, 0x2e, 0xb5, 0xdf, 0x0c, 0x2d, 0x50, 0x34,
+	0x8d, 0xe7, 0xc4, 0xca, 0x93, 0x51, 0x9e, 0xac, 0x46, 0xf9, 0xe4, 0x95, 0x8e, 0x7e, 0x18, 0x82,
+	0x67, 0xd7, 0x4c, 0x54, 0x6f, 0xc2, 0x7f, 0x6c, 0x78, 0x73, 0x1f, 0xa0, 0xe5, 0xa1, 0xf9, 0xa0,
+	0xdb, 0xbd, 0x6f, 0x08, 0x1f, 0xd7, 0x4c, 0x15, 0x3d, 0x77, 0xcb, 0x9e, 0xed, 0xfd, 0xef, 0x5c,
+	0xef, 0xdc, 0xf0, 0x13, 0x3b, 0xfc, 0x11, 0x1d, 0x3e, 0xe9, 0xbc, 0x47, 0x96, 0x75, 0xab, 0x5d,
+	0xe1, 0xa9, 0x6e, 0x1b, 0x3d, 0xf6, 0x17, 0x28, 0x3a, 0x4c, 0x2e, 0x74, 0xd8, 0xaf, 0x21, 0x78,
+	0x6e, 0x47, 0xc8, 0x75, 0x49, 0x0a, 0xa0, 0x82, 0xa9, 0x2b, 0xf2, 0xbe, 0x56, 0x0f, 0x43, 0xe0,
+	0x59, 0x8b, 0x1d, 0x32, 0x5c, 0x62, 0x5d, 0xb9, 0x05, 0x7e, 0xb8, 0xdd, 0xf8, 0xe8, 0x6e, 0xe3,
+	0xa3, 0xdf, 0x1b, 0x1f, 0xdd, 0x6c, 0xfd, 0xc9, 0xdd, 0xd6, 0x9f, 0xfc, 0xdc, 0xfa, 0x93, 0xcf,
+	0xaf, 0x77, 0x3c, 0xdd, 0xd5, 0x3a, 0xad, 0x58, 0x2a, 0xc7, 0x82, 0xf6, 0x71, 0x4c, 0xbf, 0x3e,
+	0xba, 0x9f, 0x46, 0x3e, 0x3d, 0x30, 0x1b, 0xbf, 0xf8, 0x33, 0x00, 0x69, 0xf5, 0x52, 0xf8, 0xc3,
+	0x02, 0x00, 0x00,
 }
 
 func (m *TrackedVolume) Marshal() (dAtA []byte, err error) {
@@ -134,6 +211,61 @@ func (m *TrackedVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VolumeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoVolume.Size()
+		i -= size
+		if _, err := m.OsmoVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NativeVolume) > 0 {
+		for iNdEx := len(m.NativeVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrackedVolume(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTrackedVolume(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTrackedVolume(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTrackedVolume(v)
 	base := offset
@@ -160,6 +292,25 @@ func (m *TrackedVolume) Size() (n int) {
 	return n
 }
 
+func (m *VolumeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTrackedVolume(uint64(l))
+	if len(m.NativeVolume) > 0 {
+		for _, e := range m.NativeVolume {
+			l = e.Size()
+			n += 1 + l + sovTrackedVolume(uint64(l))
+		}
+	}
+	l = m.OsmoVolume.Size()
+	n += 1 + l + sovTrackedVolume(uint64(l))
+	return n
+}
+
 func sovTrackedVolume(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -250,6 +401,157 @@ func (m *TrackedVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VolumeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrackedVolume
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeVolume = append(m.NativeVolume, types.Coin{})
+			if err := m.NativeVolume[len(m.NativeVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrackedVolume
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrackedVolume(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrackedVolume
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTrackedVolume(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
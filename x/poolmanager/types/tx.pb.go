@@ -431,6 +431,235 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSwapExactAmountInWithMaxPriceImpact
+// MsgSwapExactAmountInWithMaxPriceImpact swaps the largest amount of token_in
+// whose price through the routes deviates from their spot price by at most
+// max_price_impact, before spread and taker fees. The rest of token_in is left
+// to the sender. The optional token_out_min_amount is the minimum amount of
+// token out for the whole of token_in, scaled to the amount of token_in
+// swapped.
+type MsgSwapExactAmountInWithMaxPriceImpact struct {
+	Sender            string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInRoute         `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenIn           types.Coin                  `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	MaxPriceImpact    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+	TokenOutMinAmount cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Reset() {
+	*m = MsgSwapExactAmountInWithMaxPriceImpact{}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInWithMaxPriceImpact) ProtoMessage()    {}
+func (*MsgSwapExactAmountInWithMaxPriceImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{8}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpact proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+type MsgSwapExactAmountInWithMaxPriceImpactResponse struct {
+	TokenInAmount  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Reset() {
+	*m = MsgSwapExactAmountInWithMaxPriceImpactResponse{}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSwapExactAmountInWithMaxPriceImpactResponse) ProtoMessage() {}
+func (*MsgSwapExactAmountInWithMaxPriceImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{9}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithMaxPriceImpactResponse proto.InternalMessageInfo
+
+// ===================== MsgSplitRouteSwapExactAmountInWithMaxPriceImpact
+// MsgSplitRouteSwapExactAmountInWithMaxPriceImpact swaps, through each route,
+// the largest part of its token in amount whose price deviates from the spot
+// price of the route by at most max_price_impact, before spread and taker fees.
+// The routes are bounded in order, against their spot price before the swap.
+// The optional token_out_min_amount is the minimum amount of token out for the
+// sum of the token in amounts of the routes, and each route must get at least
+// its share of it, scaled to the amount swapped through the route.
+type MsgSplitRouteSwapExactAmountInWithMaxPriceImpact struct {
+	Sender            string                      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInSplitRoute    `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+	TokenInDenom      string                      `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	MaxPriceImpact    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_price_impact" yaml:"max_price_impact"`
+	TokenOutMinAmount cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInWithMaxPriceImpact{}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) ProtoMessage() {}
+func (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{10}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpact.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpact proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse struct {
+	TokenInAmount  cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) ProtoMessage() {}
+func (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse proto.InternalMessageInfo
+
 // ===================== MsgSetDenomPairTakerFee
 type MsgSetDenomPairTakerFee struct {
 	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -441,7 +670,7 @@ func (m *MsgSetDenomPairTakerFee) Reset()         { *m = MsgSetDenomPairTakerFee
 func (m *MsgSetDenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFee) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{8}
+	return fileDescriptor_acd130b4825d67dc, []int{12}
 }
 func (m *MsgSetDenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +721,7 @@ func (m *MsgSetDenomPairTakerFeeResponse) Reset()         { *m = MsgSetDenomPair
 func (m *MsgSetDenomPairTakerFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetDenomPairTakerFeeResponse) ProtoMessage()    {}
 func (*MsgSetDenomPairTakerFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{9}
+	return fileDescriptor_acd130b4825d67dc, []int{13}
 }
 func (m *MsgSetDenomPairTakerFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +769,7 @@ func (m *DenomPairTakerFee) Reset()         { *m = DenomPairTakerFee{} }
 func (m *DenomPairTakerFee) String() string { return proto.CompactTextString(m) }
 func (*DenomPairTakerFee) ProtoMessage()    {}
 func (*DenomPairTakerFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd130b4825d67dc, []int{10}
+	return fileDescriptor_acd130b4825d67dc, []int{14}
 }
 func (m *DenomPairTakerFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -592,6 +821,10 @@ func init() {
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountOutResponse")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOut)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOut")
 	proto.RegisterType((*MsgSplitRouteSwapExactAmountOutResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountOutResponse")
+	proto.RegisterType((*MsgSwapExactAmountInWithMaxPriceImpact)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInWithMaxPriceImpact")
+	proto.RegisterType((*MsgSwapExactAmountInWithMaxPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInWithMaxPriceImpactResponse")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInWithMaxPriceImpact)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInWithMaxPriceImpact")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse")
 	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
 	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
 	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
@@ -602,69 +835,80 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x48, 0x93, 0x29, 0x6d, 0xe3, 0xc5, 0x21, 0xae, 0x53, 0xec, 0x68, 0x5b,
-	0x81, 0x83, 0xd8, 0x5d, 0xec, 0x56, 0x2a, 0x38, 0x91, 0x10, 0x6e, 0x40, 0x8a, 0x54, 0xcb, 0xed,
-	0xd2, 0x13, 0x97, 0xd5, 0xd8, 0x19, 0xdc, 0x25, 0xd9, 0x1d, 0xcb, 0x33, 0xdb, 0x3a, 0x37, 0x40,
-	0x3d, 0x45, 0x1c, 0xf8, 0x06, 0x48, 0x7c, 0x02, 0xbe, 0x01, 0xd7, 0x1e, 0x7b, 0x44, 0x1c, 0x2c,
-	0x94, 0x20, 0x71, 0xcf, 0x09, 0x09, 0x04, 0x68, 0x5e, 0x76, 0x6d, 0xaf, 0x37, 0x7e, 0x49, 0xd4,
-	0x5c, 0x22, 0xef, 0xec, 0xf3, 0xfa, 0x7f, 0x7e, 0xfb, 0x68, 0x02, 0xef, 0x10, 0xea, 0x11, 0xea,
-	0x52, 0xab, 0x4d, 0xc8, 0x81, 0x87, 0x7c, 0xd4, 0xc2, 0x1d, 0xeb, 0x59, 0xa9, 0x81, 0x19, 0x2a,
-	0x59, 0xac, 0x6b, 0xb6, 0x3b, 0x84, 0x11, 0x6d, 0x5d, 0x59, 0x99, 0x03, 0x56, 0xa6, 0xb2, 0xca,
-	0x65, 0x5a, 0xa4, 0x45, 0x84, 0x9d, 0xc5, 0x7f, 0x49, 0x97, 0x5c, 0x1a, 0x79, 0xae, 0x4f, 0x2c,
-	0xf1, 0x57, 0x1d, 0xe5, 0x9b, 0x22, 0x8c, 0xd5, 0x40, 0x14, 0x47, 0x39, 0x9a, 0xc4, 0xf5, 0xd5,
-	0xfb, 0x0f, 0xc6, 0xd5, 0x42, 0x9f, 0xa3, 0xb6, 0xd3, 0x21, 0x01, 0xc3, 0xd2, 0x5a, 0xff, 0x27,
-	0x05, 0x33, 0x35, 0xda, 0xfa, 0xe2, 0x39, 0x6a, 0x7f, 0xd6, 0x45, 0x4d, 0xf6, 0xa9, 0x47, 0x02,
-	0x9f, 0xed, 0xfa, 0xda, 0x26, 0x5c, 0xa4, 0xd8, 0xdf, 0xc3, 0x9d, 0x2c, 0xd8, 0x00, 0xc5, 0xe5,
-	0x6a, 0xfa, 0xb4, 0x57, 0xb8, 0x76, 0x88, 0xbc, 0x83, 0x8a, 0x2e, 0xcf, 0x75, 0x5b, 0x19, 0x68,
-	0x0f, 0xe1, 0xa2, 0x08, 0x49, 0xb3, 0xa9, 0x8d, 0xf9, 0xe2, 0xd5, 0xb2, 0x69, 0x8e, 0x69, 0xd4,
-	0xe4, 0xa9, 0xc2, 0x2c, 0x36, 0x77, 0xab, 0x2e, 0xbc, 0xec, 0x15, 0xe6, 0x6c, 0x15, 0x43, 0xab,
-	0xc1, 0x25, 0x46, 0xf6, 0xb1, 0xef, 0xb8, 0x7e, 0x76, 0x7e, 0x03, 0x14, 0xaf, 0x96, 0x6f, 0x9a,
-	0xb2, 0x65, 0x93, 0xb7, 0x1c, 0xc5, 0x79, 0x40, 0x5c, 0xbf, 0xba, 0xc6, 0x5d, 0x4f, 0x7b, 0x85,
-	0x1b, 0xb2, 0xb2, 0xd0, 0x51, 0xb7, 0xaf, 0x88, 0x9f, 0xbb, 0xbe, 0xe6, 0xc1, 0x8c, 0x3c, 0x25,
-	0x01, 0x73, 0x3c, 0xd7, 0x77, 0x90, 0xc8, 0x9d, 0x5d, 0x10, 0x5d, 0x6d, 0x73, 0xff, 0xdf, 0x7a,
-	0x85, 0x55, 0x99, 0x81, 0xee, 0xed, 0x9b, 0x2e, 0xb1, 0x3c, 0xc4, 0x9e, 0x9a, 0xbb, 0x3e, 0x3b,
-	0xed, 0x15, 0xd6, 0x07, 0x03, 0x0f, 0x87, 0xd0, 0xed, 0xb4, 0x38, 0xae, 0x07, 0xac, 0xe6, 0xfa,
-	0xb2, 0xa5, 0x8a, 0x71, 0xf4, 0xe7, 0xcf, 0xef, 0x17, 0x93, 0x46, 0xc0, 0xa5, 0x37, 0x30, 0xd7,
-	0xd8, 0x90, 0xfe, 0x86, 0xeb, 0xeb, 0xdf, 0x01, 0x78, 0x2b, 0x49, 0x7e, 0x1b, 0xd3, 0x36, 0xf1,
-	0x29, 0xd6, 0x1a, 0x70, 0xa5, 0x9f, 0x5b, 0x95, 0x2e, 0x07, 0xf2, 0xd1, 0xa4, 0xd2, 0xd7, 0xe2,
-	0xa5, 0x87, 0x65, 0x5f, 0x0f, 0xcb, 0x96, 0xd9, 0xf4, 0xbf, 0x52, 0x30, 0xcf, 0x8b, 0x68, 0x1f,
-	0xb8, 0x4c, 0x4c, 0xe4, 0x42, 0x34, 0x3c, 0x8e, 0xd1, 0x70, 0x77, 0x6a, 0x1a, 0xfa, 0x05, 0xc4,
-	0x90, 0xf8, 0x04, 0x5e, 0x0f, 0x27, 0xeb, 0xec, 0x61, 0x9f, 0x78, 0x02, 0x8c, 0xe5, 0xea, 0xcd,
-	0xd3, 0x5e, 0x61, 0x75, 0x78, 0xf2, 0xf2, 0xbd, 0x6e, 0xbf, 0xa9, 0xe6, 0xbf, 0xc3, 0x1f, 0x2f,
-	0x1b, 0x82, 0x22, 0x87, 0xe0, 0x76, 0x22, 0x04, 0xbc, 0xc5, 0x81, 0xf9, 0x7f, 0x0f, 0xe0, 0xbb,
-	0xe3, 0xa5, 0xbf, 0x54, 0x12, 0xfe, 0x4b, 0xc1, 0xd5, 0x51, 0x1c, 0xeb, 0x01, 0x9b, 0x05, 0x80,
-	0x5a, 0x0c, 0x00, 0x6b, 0x4a, 0x00, 0xea, 0x41, 0xe2, 0xf0, 0xbf, 0x86, 0x6f, 0x45, 0xc3, 0xf5,
-	0x50, 0x37, 0x6c, 0x5d, 0x12, 0xb0, 0x35, 0xa9, 0xf5, 0x5c, 0x0c, 0x8f, 0x7e, 0x04, 0xdd, 0x5e,
-	0x51, 0x8c, 0xd4, 0x50, 0x57, 0x56, 0xa0, 0x3d, 0x82, 0xcb, 0x91, 0x48, 0x02, 0x8e, 0xb1, 0xcb,
-	0x27, 0xab, 0x96, 0xcf, 0x4a, 0x4c, 0x5e, 0xdd, 0x5e, 0x0a, 0x75, 0xad, 0x98, 0x1c, 0x85, 0xcd,
-	0xe9, 0xf6, 0x01, 0x77, 0xfd, 0x06, 0xc0, 0x77, 0x12, 0x27, 0x10, 0x71, 0xe0, 0xc0, 0x1b, 0x51,
-	0x37, 0x43, 0x18, 0xdc, 0x9f, 0xa4, 0xc5, 0xdb, 0x31, 0x2d, 0x42, 0x1d, 0xae, 0x29, 0x1d, 0x14,
-	0x04, 0x7f, 0xa7, 0x60, 0x61, 0x1c, 0x93, 0x33, 0xe2, 0x60, 0xc7, 0x70, 0xb8, 0x37, 0x3d, 0x0e,
-	0x67, 0x2e, 0x84, 0x6a, 0xa8, 0x01, 0x87, 0x79, 0x70, 0x23, 0xe4, 0xe2, 0x6d, 0x46, 0x06, 0x61,
-	0x9b, 0xf5, 0x80, 0xc9, 0x9d, 0x70, 0x06, 0x57, 0x0b, 0xaf, 0x81, 0xab, 0xca, 0x26, 0xa7, 0xe0,
-	0xce, 0xc4, 0x85, 0xc0, 0x01, 0x38, 0x02, 0xf0, 0xbd, 0x09, 0xea, 0x5f, 0x1e, 0x0a, 0xff, 0x02,
-	0xb8, 0xc6, 0x8b, 0xc1, 0x52, 0xb3, 0x47, 0xc8, 0xed, 0x3c, 0x41, 0xfb, 0xb8, 0xf3, 0x39, 0xc6,
-	0xb3, 0x20, 0xf0, 0x02, 0xc0, 0x8c, 0x18, 0x82, 0xd3, 0x46, 0x6e, 0xc7, 0x61, 0x3c, 0x84, 0xf3,
-	0x15, 0xc6, 0x53, 0xdd, 0x17, 0x46, 0x32, 0x57, 0x6f, 0xab, 0xef, 0x4e, 0xad, 0xe5, 0xa4, 0xc8,
-	0xba, 0x9d, 0xde, 0x8b, 0xfb, 0x55, 0x4a, 0x7c, 0x0a, 0x89, 0xd7, 0x23, 0x8a, 0x99, 0x21, 0xec,
-	0x0d, 0x1e, 0xc6, 0x10, 0x61, 0x0c, 0x1e, 0x66, 0x4b, 0x7e, 0x0a, 0x09, 0xfd, 0x47, 0x43, 0xc8,
-	0xc2, 0x2b, 0x34, 0x68, 0x36, 0x31, 0xa5, 0x42, 0x88, 0x25, 0x3b, 0x7c, 0xd4, 0x7f, 0x01, 0x30,
-	0x9d, 0xa8, 0x9b, 0x48, 0xf5, 0xe1, 0xa8, 0x6e, 0xf2, 0x5c, 0xb7, 0x95, 0x41, 0x64, 0x5a, 0xca,
-	0xa6, 0x12, 0x4d, 0x4b, 0xa1, 0x69, 0x49, 0x7b, 0x02, 0x97, 0xfb, 0xb2, 0xce, 0x0f, 0x41, 0xb0,
-	0x3e, 0x0a, 0xc1, 0x43, 0xdc, 0x42, 0xcd, 0xc3, 0x1d, 0xdc, 0x1c, 0xd8, 0x5e, 0x7d, 0xe9, 0x96,
-	0x98, 0xaa, 0xb5, 0xfc, 0xc7, 0x1b, 0x70, 0xbe, 0x46, 0x5b, 0xda, 0xb7, 0x00, 0xa6, 0x47, 0x2f,
-	0x05, 0xa5, 0xb1, 0x73, 0x4b, 0xba, 0xd6, 0xe4, 0x3e, 0x9e, 0xd9, 0x25, 0xd2, 0xf9, 0x05, 0x80,
-	0x5a, 0xc2, 0x26, 0x2a, 0xcf, 0x18, 0xb1, 0x1e, 0xb0, 0x5c, 0x65, 0x76, 0x9f, 0xa8, 0x8c, 0x1f,
-	0x01, 0x5c, 0x1f, 0x77, 0x53, 0xda, 0x9a, 0x18, 0xfb, 0x6c, 0xe7, 0xdc, 0x83, 0x0b, 0x38, 0x47,
-	0x15, 0xfe, 0x04, 0xe0, 0xad, 0xb1, 0xcb, 0x7b, 0xfb, 0xdc, 0x59, 0xb8, 0x78, 0x3b, 0x17, 0xf1,
-	0x8e, 0x8a, 0x3c, 0x02, 0x30, 0x93, 0xb8, 0x56, 0xee, 0x4d, 0x0c, 0x9f, 0xe0, 0x95, 0xdb, 0x3e,
-	0x8f, 0x57, 0x58, 0x4c, 0xf5, 0xf1, 0xcb, 0xe3, 0x3c, 0x78, 0x75, 0x9c, 0x07, 0xbf, 0x1f, 0xe7,
-	0xc1, 0x0f, 0x27, 0xf9, 0xb9, 0x57, 0x27, 0xf9, 0xb9, 0x5f, 0x4f, 0xf2, 0x73, 0x5f, 0xde, 0x6f,
-	0xb9, 0xec, 0x69, 0xd0, 0x30, 0x9b, 0xc4, 0xb3, 0x54, 0x06, 0xe3, 0x00, 0x35, 0x68, 0xf8, 0x60,
-	0x3d, 0x2b, 0x97, 0xad, 0xee, 0xd0, 0x2e, 0x61, 0x87, 0x6d, 0x4c, 0x1b, 0x8b, 0xe2, 0xdf, 0xab,
-	0xbb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x96, 0x15, 0x36, 0xd8, 0x1a, 0x0e, 0x00, 0x00,
+	// 1157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xc7, 0x33, 0x76, 0x48, 0x9d, 0x29, 0x4d, 0xe2, 0xc5, 0x21, 0xae, 0x53, 0xec, 0x68, 0x53,
+	0xb5, 0x0e, 0x74, 0x77, 0x6b, 0xb7, 0x52, 0x8b, 0x13, 0x81, 0x70, 0x02, 0x52, 0x44, 0x2d, 0xa7,
+	0x4b, 0x11, 0x12, 0x97, 0xd5, 0x78, 0x33, 0x38, 0x4b, 0xb2, 0x2f, 0xf2, 0xce, 0xb6, 0xce, 0x0d,
+	0x50, 0x4f, 0x11, 0x07, 0xbe, 0x01, 0x12, 0x9f, 0x80, 0x1b, 0x47, 0xae, 0x3d, 0xf6, 0x82, 0x84,
+	0x38, 0x58, 0x28, 0x39, 0x70, 0x8f, 0x44, 0x85, 0x04, 0xa2, 0x68, 0x76, 0x67, 0xd7, 0xf1, 0x7a,
+	0xfd, 0x56, 0x27, 0x11, 0xca, 0xa5, 0xb2, 0xd7, 0xcf, 0xeb, 0xff, 0xf9, 0xf5, 0x99, 0xd9, 0xc0,
+	0xeb, 0xa6, 0xad, 0x9b, 0xb6, 0x66, 0x4b, 0x96, 0x69, 0xee, 0xe9, 0xc8, 0x40, 0x75, 0xdc, 0x90,
+	0x1e, 0x17, 0x6a, 0x98, 0xa0, 0x82, 0x44, 0x9a, 0xa2, 0xd5, 0x30, 0x89, 0xc9, 0x2d, 0x32, 0x2b,
+	0xf1, 0x84, 0x95, 0xc8, 0xac, 0x32, 0xa9, 0xba, 0x59, 0x37, 0x5d, 0x3b, 0x89, 0x7e, 0xf2, 0x5c,
+	0x32, 0x49, 0xa4, 0x6b, 0x86, 0x29, 0xb9, 0xff, 0xb2, 0x47, 0x59, 0xd5, 0x0d, 0x23, 0xd5, 0x90,
+	0x8d, 0x83, 0x1c, 0xaa, 0xa9, 0x19, 0xec, 0xf7, 0x5b, 0xfd, 0x6a, 0xb1, 0x9f, 0x20, 0x4b, 0x69,
+	0x98, 0x0e, 0xc1, 0x9e, 0x35, 0xff, 0x4f, 0x0c, 0xa6, 0x2a, 0x76, 0xfd, 0x93, 0x27, 0xc8, 0xfa,
+	0xb0, 0x89, 0x54, 0xf2, 0x81, 0x6e, 0x3a, 0x06, 0xd9, 0x34, 0xb8, 0x15, 0x38, 0x65, 0x63, 0x63,
+	0x1b, 0x37, 0xd2, 0x60, 0x09, 0xe4, 0xa7, 0xcb, 0xc9, 0xe3, 0x56, 0xee, 0xca, 0x3e, 0xd2, 0xf7,
+	0x4a, 0xbc, 0xf7, 0x9c, 0x97, 0x99, 0x01, 0xf7, 0x00, 0x4e, 0xb9, 0x21, 0xed, 0x74, 0x6c, 0x29,
+	0x9e, 0xbf, 0x5c, 0x14, 0xc5, 0x3e, 0x8d, 0x8a, 0x34, 0x95, 0x9f, 0x45, 0xa6, 0x6e, 0xe5, 0xc9,
+	0x67, 0xad, 0xdc, 0x84, 0xcc, 0x62, 0x70, 0x15, 0x98, 0x20, 0xe6, 0x2e, 0x36, 0x14, 0xcd, 0x48,
+	0xc7, 0x97, 0x40, 0xfe, 0x72, 0xf1, 0xaa, 0xe8, 0xb5, 0x2c, 0xd2, 0x96, 0x83, 0x38, 0xeb, 0xa6,
+	0x66, 0x94, 0x17, 0xa8, 0xeb, 0x71, 0x2b, 0x37, 0xeb, 0x55, 0xe6, 0x3b, 0xf2, 0xf2, 0x25, 0xf7,
+	0xe3, 0xa6, 0xc1, 0xe9, 0x30, 0xe5, 0x3d, 0x35, 0x1d, 0xa2, 0xe8, 0x9a, 0xa1, 0x20, 0x37, 0x77,
+	0x7a, 0xd2, 0xed, 0x6a, 0x8d, 0xfa, 0xff, 0xd6, 0xca, 0xcd, 0x7b, 0x19, 0xec, 0xed, 0x5d, 0x51,
+	0x33, 0x25, 0x1d, 0x91, 0x1d, 0x71, 0xd3, 0x20, 0xc7, 0xad, 0xdc, 0xe2, 0xc9, 0xc0, 0x9d, 0x21,
+	0x78, 0x39, 0xe9, 0x3e, 0xae, 0x3a, 0xa4, 0xa2, 0x19, 0x5e, 0x4b, 0x25, 0xe1, 0xe0, 0x8f, 0x1f,
+	0xdf, 0xce, 0x47, 0x8d, 0x80, 0x4a, 0x2f, 0x60, 0xaa, 0xb1, 0xe0, 0xf9, 0x0b, 0x9a, 0xc1, 0x7f,
+	0x03, 0xe0, 0xb5, 0x28, 0xf9, 0x65, 0x6c, 0x5b, 0xa6, 0x61, 0x63, 0xae, 0x06, 0xe7, 0xda, 0xb9,
+	0x59, 0xe9, 0xde, 0x40, 0xee, 0x0f, 0x2a, 0x7d, 0x21, 0x5c, 0xba, 0x5f, 0xf6, 0x8c, 0x5f, 0xb6,
+	0x97, 0x8d, 0xff, 0x2b, 0x06, 0xb3, 0xb4, 0x08, 0x6b, 0x4f, 0x23, 0xee, 0x44, 0xc6, 0xa2, 0xe1,
+	0x61, 0x88, 0x86, 0x3b, 0x43, 0xd3, 0xd0, 0x2e, 0x20, 0x84, 0xc4, 0xfb, 0x70, 0xc6, 0x9f, 0xac,
+	0xb2, 0x8d, 0x0d, 0x53, 0x77, 0xc1, 0x98, 0x2e, 0x5f, 0x3d, 0x6e, 0xe5, 0xe6, 0x3b, 0x27, 0xef,
+	0xfd, 0xce, 0xcb, 0xaf, 0xb3, 0xf9, 0x6f, 0xd0, 0xaf, 0xe7, 0x0d, 0x41, 0x9e, 0x42, 0xb0, 0x1c,
+	0x09, 0x01, 0x6d, 0xf1, 0xc4, 0xfc, 0xbf, 0x05, 0xf0, 0x46, 0x7f, 0xe9, 0xcf, 0x95, 0x84, 0x97,
+	0x31, 0x38, 0xdf, 0x8d, 0x63, 0xd5, 0x21, 0xa3, 0x00, 0x50, 0x09, 0x01, 0x20, 0x0d, 0x09, 0x40,
+	0xd5, 0x89, 0x1c, 0xfe, 0x97, 0xf0, 0x8d, 0x60, 0xb8, 0x3a, 0x6a, 0xfa, 0xad, 0x7b, 0x04, 0xac,
+	0x0e, 0x6a, 0x3d, 0x13, 0xc2, 0xa3, 0x1d, 0x81, 0x97, 0xe7, 0x18, 0x23, 0x15, 0xd4, 0xf4, 0x2a,
+	0xe0, 0xb6, 0xe0, 0x74, 0x20, 0x52, 0x7a, 0x72, 0xd0, 0xf2, 0x49, 0xb3, 0xe5, 0x33, 0x17, 0x92,
+	0x97, 0x97, 0x13, 0xbe, 0xae, 0x25, 0x91, 0xa2, 0xb0, 0x32, 0xdc, 0x3e, 0xa0, 0xae, 0x5f, 0x01,
+	0xf8, 0x56, 0xe4, 0x04, 0x02, 0x0e, 0x14, 0x38, 0x1b, 0x74, 0xd3, 0x81, 0xc1, 0xbd, 0x41, 0x5a,
+	0xbc, 0x19, 0xd2, 0xc2, 0xd7, 0xe1, 0x0a, 0xd3, 0x81, 0x41, 0xf0, 0x77, 0x0c, 0xe6, 0xfa, 0x31,
+	0x39, 0x22, 0x0e, 0x72, 0x08, 0x87, 0xbb, 0xc3, 0xe3, 0xd0, 0x73, 0x21, 0x94, 0xe1, 0x6c, 0x1b,
+	0xe6, 0x93, 0x1b, 0x21, 0x13, 0x6e, 0x33, 0x30, 0xf0, 0xdb, 0xac, 0x3a, 0xc4, 0xdb, 0x09, 0x3d,
+	0xb8, 0x9a, 0x3c, 0x03, 0xae, 0x4a, 0x2b, 0x94, 0x82, 0xeb, 0x03, 0x17, 0x02, 0x05, 0xe0, 0x00,
+	0xc0, 0x9b, 0x03, 0xd4, 0x3f, 0x3f, 0x14, 0x5e, 0xc4, 0xbd, 0xf5, 0x14, 0x5e, 0x4a, 0x9f, 0x69,
+	0x64, 0xa7, 0x82, 0x9a, 0x5b, 0x0d, 0x4d, 0xc5, 0x9b, 0xba, 0x85, 0x54, 0x72, 0x61, 0xee, 0x0b,
+	0x3b, 0x70, 0x8e, 0xce, 0xd2, 0xa2, 0xad, 0x29, 0x9a, 0xdb, 0x1b, 0x63, 0xe2, 0x3d, 0x26, 0xea,
+	0x62, 0xb7, 0xa8, 0x0f, 0x70, 0x1d, 0xa9, 0xfb, 0x1b, 0x58, 0x6d, 0x2f, 0xdb, 0x70, 0x10, 0x5e,
+	0x9e, 0xd1, 0x3b, 0x15, 0xeb, 0x75, 0x28, 0xbd, 0x76, 0x36, 0x87, 0xd2, 0x3b, 0x94, 0xc1, 0x1b,
+	0x3d, 0x37, 0x91, 0x66, 0x08, 0x3a, 0x6a, 0x0a, 0xac, 0xd8, 0x3f, 0x01, 0x14, 0x87, 0x1b, 0xfc,
+	0xb9, 0xc1, 0x18, 0x79, 0x00, 0xc6, 0x4e, 0xfb, 0x2a, 0x14, 0x87, 0xb7, 0xfb, 0x9f, 0xc7, 0xe3,
+	0xa1, 0xff, 0x7f, 0xbc, 0x1c, 0x5d, 0x58, 0xe2, 0x6f, 0x51, 0xe2, 0x6f, 0xf6, 0xde, 0xba, 0x9d,
+	0xc8, 0xbf, 0x04, 0xf0, 0xfe, 0xa8, 0xa3, 0xbf, 0x58, 0xf0, 0xff, 0x0b, 0xe0, 0x02, 0x55, 0x00,
+	0x7b, 0x27, 0xe4, 0x16, 0xd2, 0x1a, 0x8f, 0xd0, 0x2e, 0x6e, 0x7c, 0x84, 0xf1, 0x28, 0x8c, 0x3f,
+	0x05, 0x30, 0xe5, 0x82, 0xa6, 0x58, 0x48, 0x6b, 0x28, 0x84, 0x86, 0x50, 0xbe, 0xc0, 0x78, 0xa8,
+	0x6d, 0xdf, 0x95, 0xb9, 0xbc, 0xcc, 0x56, 0x36, 0x9b, 0x7e, 0x54, 0x64, 0x5e, 0x4e, 0x6e, 0x87,
+	0xfd, 0x4a, 0x05, 0x3a, 0xfd, 0xc8, 0x97, 0x61, 0x1b, 0x13, 0xc1, 0xb5, 0x17, 0x68, 0x18, 0xc1,
+	0x0d, 0x23, 0xd0, 0x30, 0xab, 0x30, 0xd7, 0xa3, 0xff, 0x60, 0xd0, 0x69, 0x78, 0xc9, 0x76, 0x54,
+	0x15, 0xdb, 0xb6, 0x2b, 0x44, 0x42, 0xf6, 0xbf, 0xf2, 0x3f, 0x03, 0x98, 0x8c, 0xd4, 0xcd, 0x4d,
+	0x75, 0xbb, 0x5b, 0x37, 0xef, 0x39, 0x2f, 0x33, 0x83, 0xc0, 0xb4, 0x90, 0x8e, 0x45, 0x9a, 0x16,
+	0x7c, 0xd3, 0x02, 0xf7, 0x08, 0x4e, 0xb7, 0x65, 0x8d, 0x77, 0x80, 0x36, 0xe0, 0xff, 0xaa, 0x7f,
+	0x57, 0x6d, 0x4b, 0x97, 0x20, 0xac, 0xd6, 0xe2, 0x8b, 0x04, 0x8c, 0x57, 0xec, 0x3a, 0xf7, 0x35,
+	0x80, 0xc9, 0xee, 0x57, 0xc0, 0x42, 0xdf, 0xb9, 0x45, 0x1d, 0x16, 0x99, 0x77, 0x47, 0x76, 0x09,
+	0x74, 0x7e, 0x0a, 0x20, 0x17, 0x71, 0xef, 0x2c, 0x8e, 0x18, 0xb1, 0xea, 0x90, 0x4c, 0x69, 0x74,
+	0x9f, 0xa0, 0x8c, 0xef, 0x01, 0x5c, 0xec, 0xf7, 0x5e, 0xbc, 0x3a, 0x30, 0x76, 0x6f, 0xe7, 0xcc,
+	0xfa, 0x18, 0xce, 0x41, 0x85, 0x3f, 0x00, 0x78, 0xad, 0xef, 0x55, 0x7d, 0xed, 0x95, 0xb3, 0x50,
+	0xf1, 0x36, 0xc6, 0xf1, 0x0e, 0x8a, 0x3c, 0x00, 0x30, 0x15, 0xb9, 0x56, 0xee, 0x0e, 0x0c, 0x1f,
+	0xe1, 0x95, 0x59, 0x7b, 0x15, 0xaf, 0xa0, 0x98, 0x9f, 0x00, 0x5c, 0x1e, 0xe6, 0x58, 0x5f, 0x1f,
+	0x99, 0xde, 0xee, 0x20, 0x99, 0x8f, 0x4f, 0x21, 0x48, 0x50, 0xf9, 0x2f, 0x00, 0x0a, 0xa3, 0x5d,
+	0x4d, 0x2a, 0x63, 0x20, 0x16, 0xd1, 0xcd, 0xa7, 0xa7, 0x1a, 0xce, 0xef, 0xab, 0xfc, 0xf0, 0xd9,
+	0x61, 0x16, 0x3c, 0x3f, 0xcc, 0x82, 0xdf, 0x0f, 0xb3, 0xe0, 0xbb, 0xa3, 0xec, 0xc4, 0xf3, 0xa3,
+	0xec, 0xc4, 0xaf, 0x47, 0xd9, 0x89, 0xcf, 0xef, 0xd5, 0x35, 0xb2, 0xe3, 0xd4, 0x44, 0xd5, 0xd4,
+	0x25, 0x96, 0x5a, 0xd8, 0x43, 0x35, 0xdb, 0xff, 0x22, 0x3d, 0x2e, 0x16, 0xa5, 0x66, 0xc7, 0x76,
+	0x27, 0xfb, 0x16, 0xb6, 0x6b, 0x53, 0xee, 0x9f, 0x37, 0xef, 0xfc, 0x37, 0x00, 0x0a, 0xb2, 0x77,
+	0xab, 0x9a, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -684,6 +928,8 @@ type MsgClient interface {
 	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(ctx context.Context, in *MsgSplitRouteSwapExactAmountOut, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountOutResponse, error)
 	SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPairTakerFee, opts ...grpc.CallOption) (*MsgSetDenomPairTakerFeeResponse, error)
+	SwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error)
+	SplitRouteSwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse, error)
 }
 
 type msgClient struct {
@@ -739,6 +985,24 @@ func (c *msgClient) SetDenomPairTakerFee(ctx context.Context, in *MsgSetDenomPai
 	return out, nil
 }
 
+func (c *msgClient) SwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	out := new(MsgSwapExactAmountInWithMaxPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInWithMaxPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SplitRouteSwapExactAmountInWithMaxPriceImpact(ctx context.Context, in *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountInWithMaxPriceImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	SwapExactAmountIn(context.Context, *MsgSwapExactAmountIn) (*MsgSwapExactAmountInResponse, error)
@@ -746,6 +1010,8 @@ type MsgServer interface {
 	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 	SplitRouteSwapExactAmountOut(context.Context, *MsgSplitRouteSwapExactAmountOut) (*MsgSplitRouteSwapExactAmountOutResponse, error)
 	SetDenomPairTakerFee(context.Context, *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error)
+	SwapExactAmountInWithMaxPriceImpact(context.Context, *MsgSwapExactAmountInWithMaxPriceImpact) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error)
+	SplitRouteSwapExactAmountInWithMaxPriceImpact(context.Context, *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -767,6 +1033,12 @@ func (*UnimplementedMsgServer) SplitRouteSwapExactAmountOut(ctx context.Context,
 func (*UnimplementedMsgServer) SetDenomPairTakerFee(ctx context.Context, req *MsgSetDenomPairTakerFee) (*MsgSetDenomPairTakerFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPairTakerFee not implemented")
 }
+func (*UnimplementedMsgServer) SwapExactAmountInWithMaxPriceImpact(ctx context.Context, req *MsgSwapExactAmountInWithMaxPriceImpact) (*MsgSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountInWithMaxPriceImpact not implemented")
+}
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountInWithMaxPriceImpact(ctx context.Context, req *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) (*MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountInWithMaxPriceImpact not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -862,6 +1134,42 @@ func _Msg_SetDenomPairTakerFee_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapExactAmountInWithMaxPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountInWithMaxPriceImpact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountInWithMaxPriceImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SwapExactAmountInWithMaxPriceImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountInWithMaxPriceImpact(ctx, req.(*MsgSwapExactAmountInWithMaxPriceImpact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SplitRouteSwapExactAmountInWithMaxPriceImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountInWithMaxPriceImpact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountInWithMaxPriceImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Msg/SplitRouteSwapExactAmountInWithMaxPriceImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountInWithMaxPriceImpact(ctx, req.(*MsgSplitRouteSwapExactAmountInWithMaxPriceImpact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -886,6 +1194,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDenomPairTakerFee",
 			Handler:    _Msg_SetDenomPairTakerFee_Handler,
 		},
+		{
+			MethodName: "SwapExactAmountInWithMaxPriceImpact",
+			Handler:    _Msg_SwapExactAmountInWithMaxPriceImpact_Handler,
+		},
+		{
+			MethodName: "SplitRouteSwapExactAmountInWithMaxPriceImpact",
+			Handler:    _Msg_SplitRouteSwapExactAmountInWithMaxPriceImpact_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/tx.proto",
@@ -1273,7 +1589,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1283,20 +1599,50 @@ func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomPairTakerFee) > 0 {
-		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1317,7 +1663,7 @@ func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1327,30 +1673,40 @@ func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1360,36 +1716,227 @@ func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TakerFee.Size()
+		size := m.TokenOutMinAmount.Size()
 		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Denom1) > 0 {
-		i -= len(m.Denom1)
-		copy(dAtA[i:], m.Denom1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom0) > 0 {
-		i -= len(m.Denom0)
-		copy(dAtA[i:], m.Denom0)
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPairTakerFee) > 0 {
+		for iNdEx := len(m.DenomPairTakerFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairTakerFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairTakerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairTakerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom0)))
 		i--
 		dAtA[i] = 0xa
@@ -1548,7 +2095,7 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1558,53 +2105,664 @@ func (m *MsgSetDenomPairTakerFee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.DenomPairTakerFee) > 0 {
-		for _, e := range m.DenomPairTakerFee {
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Success {
-		n += 2
-	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *DenomPairTakerFee) Size() (n int) {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom0)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom1)
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.TakerFee.Size()
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetDenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPairTakerFee) > 0 {
+		for _, e := range m.DenomPairTakerFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPairTakerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomPairTakerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1627,10 +2785,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1694,16 +2852,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1713,30 +2871,31 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1746,23 +2905,22 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1787,7 +2945,7 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1810,15 +2968,15 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1846,7 +3004,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1871,7 +3029,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1894,10 +3052,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1961,14 +3119,14 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1996,11 +3154,11 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2028,7 +3186,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2053,7 +3211,7 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2076,15 +3234,15 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2112,7 +3270,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2137,7 +3295,7 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithMaxPriceImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +3318,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2227,14 +3385,47 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2262,15 +3453,15 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2280,22 +3471,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2320,7 +3512,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithMaxPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,10 +3535,10 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithMaxPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2383,6 +3575,40 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2404,7 +3630,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,10 +3653,10 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInWithMaxPriceImpact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInWithMaxPriceImpact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2494,14 +3720,14 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutSplitRoute{})
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
 			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2529,11 +3755,11 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2561,7 +3787,41 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2586,7 +3846,7 @@ func (m *MsgSplitRouteSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2609,10 +3869,10 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInWithMaxPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2649,6 +3909,40 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+const (
+	// VolumeBucketDuration is the duration of the time buckets that the pool volume is tracked in.
+	VolumeBucketDuration = time.Hour
+	// VolumeBucketRetention is how long the volume buckets are kept before being pruned.
+	VolumeBucketRetention = 30 * 24 * time.Hour
+)
+
+// VolumeWindows are the windows that the pool volume history is reported over.
+var VolumeWindows = []time.Duration{
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+}
+
+// VolumeBucketStartTime returns the start time of the volume bucket that t falls in.
+func VolumeBucketStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(VolumeBucketDuration)
+}
@@ -0,0 +1,128 @@
+package poolmanager
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// addVolumeToBucket adds the given native volume and its OSMO value to the volume bucket
+// of the given pool ID that the current block time falls in.
+func (k Keeper) addVolumeToBucket(ctx sdk.Context, poolId uint64, volumeGenerated sdk.Coin, volumeInOsmo osmomath.Int) {
+	startTime := types.VolumeBucketStartTime(ctx.BlockTime())
+	key := types.KeyPoolVolumeBucket(poolId, startTime)
+	store := ctx.KVStore(k.storeKey)
+
+	bucket := types.VolumeBucket{
+		StartTime:    startTime,
+		NativeVolume: sdk.NewCoins(),
+		OsmoVolume:   osmomath.ZeroInt(),
+	}
+	if _, err := osmoutils.Get(store, key, &bucket); err != nil {
+		// We can only encounter an error if a database or serialization errors occurs, so we panic here.
+		panic(err)
+	}
+
+	bucket.NativeVolume = bucket.NativeVolume.Add(volumeGenerated)
+	bucket.OsmoVolume = bucket.OsmoVolume.Add(volumeInOsmo)
+	osmoutils.MustSet(store, key, &bucket)
+}
+
+// GetVolumeBuckets returns the volume buckets of the given pool ID starting at or after startTime, sorted by start time.
+func (k Keeper) GetVolumeBuckets(ctx sdk.Context, poolId uint64, startTime time.Time) ([]types.VolumeBucket, error) {
+	return osmoutils.GatherValuesFromStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPoolVolumeBucket(poolId, types.VolumeBucketStartTime(startTime)),
+		sdk.PrefixEndBytes(types.KeyPoolVolumeBucketPoolPrefix(poolId)),
+		parseVolumeBucket,
+	)
+}
+
+// GetAllPoolVolumeBuckets returns the volume buckets of all pools, sorted by pool ID and start time.
+func (k Keeper) GetAllPoolVolumeBuckets(ctx sdk.Context) ([]types.PoolVolumeBucket, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucketPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	poolVolumeBuckets := []types.PoolVolumeBucket{}
+	for ; iter.Valid(); iter.Next() {
+		poolId, _, err := types.ParsePoolVolumeBucketKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		bucket, err := parseVolumeBucket(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		poolVolumeBuckets = append(poolVolumeBuckets, types.PoolVolumeBucket{PoolId: poolId, VolumeBucket: bucket})
+	}
+	return poolVolumeBuckets, nil
+}
+
+// setVolumeBucket sets the volume bucket of the given pool ID at the bucket's start time.
+func (k Keeper) setVolumeBucket(ctx sdk.Context, poolId uint64, bucket types.VolumeBucket) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucket(poolId, bucket.StartTime), &bucket)
+}
+
+// GetVolumeForWindow returns the native and OSMO volume of the given pool ID over the latest buckets
+// spanning window, the bucket the current block time falls in included.
+// For example, the 24 hour window sums the current bucket and the 23 previous ones.
+func (k Keeper) GetVolumeForWindow(ctx sdk.Context, poolId uint64, window time.Duration) (sdk.Coins, osmomath.Int, error) {
+	startTime := types.VolumeBucketStartTime(ctx.BlockTime()).Add(-window + types.VolumeBucketDuration)
+	buckets, err := k.GetVolumeBuckets(ctx, poolId, startTime)
+	if err != nil {
+		return nil, osmomath.Int{}, err
+	}
+
+	nativeVolume, osmoVolume := sumVolumeBuckets(buckets)
+	return nativeVolume, osmoVolume, nil
+}
+
+// PruneVolumeBuckets deletes the volume buckets of every pool that started more than
+// VolumeBucketRetention before the bucket the current block time falls in.
+// The volume bucket store is iterated once, so the cost is linear in the number of stored buckets,
+// which is bounded by the retention, rather than in the number of pools.
+func (k Keeper) PruneVolumeBuckets(ctx sdk.Context) {
+	cutoff := types.VolumeBucketStartTime(ctx.BlockTime()).Add(-types.VolumeBucketRetention)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPoolVolumeBucketPrefix)
+
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		_, startTime, err := types.ParsePoolVolumeBucketKey(iter.Key())
+		if err != nil {
+			// We can only encounter an error if the store is corrupted, so we panic here.
+			panic(err)
+		}
+		if startTime.Before(cutoff) {
+			keys = append(keys, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// sumVolumeBuckets returns the sums of the native and OSMO volumes of the buckets.
+func sumVolumeBuckets(buckets []types.VolumeBucket) (sdk.Coins, osmomath.Int) {
+	nativeVolume := sdk.NewCoins()
+	osmoVolume := osmomath.ZeroInt()
+	for _, bucket := range buckets {
+		nativeVolume = nativeVolume.Add(bucket.NativeVolume...)
+		osmoVolume = osmoVolume.Add(bucket.OsmoVolume)
+	}
+	return nativeVolume, osmoVolume
+}
+
+func parseVolumeBucket(bz []byte) (types.VolumeBucket, error) {
+	var bucket types.VolumeBucket
+	err := bucket.Unmarshal(bz)
+	return bucket, err
+}
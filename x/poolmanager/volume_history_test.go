@@ -0,0 +1,109 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/client"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// TestVolumeBuckets tests that the volume is tracked in hourly buckets in the native denoms and in OSMO,
+// that the windows sum the latest buckets and that the expired buckets are pruned at the end of the day epoch.
+func (s *KeeperTestSuite) TestVolumeBuckets() {
+	s.SetupTest()
+
+	const barDenom = "bar"
+
+	var (
+		hundredFoo   = sdk.NewCoin(FOO, osmomath.NewInt(100))
+		hundredUosmo = sdk.NewCoin(UOSMO, osmomath.NewInt(100))
+		hundredBar   = sdk.NewCoin(barDenom, osmomath.NewInt(100))
+		startTime    = time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	)
+
+	targetPoolId := s.PrepareBalancerPool()
+
+	// 1 foo = 10 osmo, bar has no OSMO-paired pool.
+	osmoPairedPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(FOO, osmomath.NewInt(100)), sdk.NewCoin(UOSMO, osmomath.NewInt(1000)))
+	s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, FOO, osmoPairedPoolId)
+
+	k := s.App.PoolManagerKeeper
+
+	trackAt := func(blockTime time.Time, volumes ...sdk.Coin) {
+		s.Ctx = s.Ctx.WithBlockTime(blockTime)
+		for _, volume := range volumes {
+			k.TrackVolume(s.Ctx, targetPoolId, volume)
+		}
+	}
+
+	// Current bucket: tracked twice within the same hour.
+	trackAt(startTime, hundredFoo, hundredUosmo)
+	trackAt(startTime.Add(20*time.Minute), hundredBar)
+	// 2 days later.
+	trackAt(startTime.Add(48*time.Hour), hundredUosmo)
+	// 10 days later.
+	trackAt(startTime.Add(10*24*time.Hour), hundredFoo)
+
+	buckets, err := k.GetVolumeBuckets(s.Ctx, targetPoolId, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(buckets, 3)
+	s.Require().Equal(types.VolumeBucket{
+		StartTime:    startTime.Truncate(time.Hour),
+		NativeVolume: sdk.NewCoins(hundredFoo, hundredUosmo, hundredBar),
+		// bar cannot be valued in OSMO.
+		OsmoVolume: osmomath.NewInt(1000 + 100),
+	}, buckets[0])
+
+	// The lifetime volume is unchanged.
+	s.Require().Equal(osmomath.NewInt(1000+100+100+1000), k.GetOsmoVolumeForPool(s.Ctx, targetPoolId))
+
+	// Query 11 days after the start: the 24h window has no volume, the 7d window has the 10 days later volume
+	// and the 30d window has all the volume.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(11 * 24 * time.Hour))
+	response, err := client.NewQuerier(*k).PoolVolumeHistory(s.Ctx, queryproto.PoolVolumeHistoryRequest{PoolId: targetPoolId, IncludeBuckets: true})
+	s.Require().NoError(err)
+	s.Require().Equal([]queryproto.PoolVolumeWindow{
+		{Window: 24 * time.Hour, NativeVolume: sdk.NewCoins(), OsmoVolume: osmomath.ZeroInt()},
+		{Window: 7 * 24 * time.Hour, NativeVolume: sdk.NewCoins(hundredFoo), OsmoVolume: osmomath.NewInt(1000)},
+		{
+			Window:       30 * 24 * time.Hour,
+			NativeVolume: sdk.NewCoins(hundredFoo.Add(hundredFoo), hundredUosmo.Add(hundredUosmo), hundredBar),
+			OsmoVolume:   osmomath.NewInt(1000 + 100 + 100 + 1000),
+		},
+	}, response.Windows)
+	s.Require().Equal(buckets, response.Buckets)
+
+	// The other pool has a bucket older than 30 days and a recent one at 40 days after the start.
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	k.TrackVolume(s.Ctx, osmoPairedPoolId, hundredUosmo)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(40 * 24 * time.Hour))
+	k.TrackVolume(s.Ctx, osmoPairedPoolId, hundredUosmo)
+
+	// 40 days after the start, only the buckets of the first two days are older than 30 days.
+	trackAt(startTime.Add(40*24*time.Hour), hundredUosmo)
+	s.Require().NoError(k.EpochHooks().AfterEpochEnd(s.Ctx, "week", 1))
+	buckets, err = k.GetVolumeBuckets(s.Ctx, targetPoolId, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(buckets, 4)
+
+	s.Require().NoError(k.EpochHooks().AfterEpochEnd(s.Ctx, poolmanager.VolumeBucketPruneEpochIdentifier, 1))
+	buckets, err = k.GetVolumeBuckets(s.Ctx, targetPoolId, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(buckets, 2)
+	s.Require().Equal(startTime.Add(10*24*time.Hour).Truncate(time.Hour), buckets[0].StartTime)
+
+	// The expired buckets of the other pools are pruned as well.
+	otherBuckets, err := k.GetVolumeBuckets(s.Ctx, osmoPairedPoolId, time.Unix(0, 0))
+	s.Require().NoError(err)
+	s.Require().Len(otherBuckets, 1)
+	s.Require().Equal(startTime.Add(40*24*time.Hour).Truncate(time.Hour), otherBuckets[0].StartTime)
+
+	// Unknown pools error.
+	_, err = client.NewQuerier(*k).PoolVolumeHistory(s.Ctx, queryproto.PoolVolumeHistoryRequest{PoolId: 100})
+	s.Require().Error(err)
+}
@@ -30,6 +30,9 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryCyclicRoutesCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTradeHistoryCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitSharesDistributedCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
 
 	return cmd
@@ -158,6 +161,32 @@ func NewQueryPoolCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevPoolReq
 	}, &types.QueryGetProtoRevPoolRequest{}
 }
 
+// NewQueryCyclicRoutesCmd returns the command to query the cyclic routes discovered by searching the pool graph for a swapped pool
+func NewQueryCyclicRoutesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevCyclicRoutesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "cyclic-routes",
+		Short: "Query the cyclic arbitrage routes ProtoRev discovers by searching the pool graph after a swap on a given pool",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} cyclic-routes 1`,
+	}, &types.QueryGetProtoRevCyclicRoutesRequest{}
+}
+
+// NewQueryTradeHistoryCmd returns the command to query the cyclic arbitrage trades executed by protorev in the latest blocks
+func NewQueryTradeHistoryCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevTradeHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "trade-history",
+		Short: "Query the cyclic arbitrage trades ProtoRev executed in the latest blocks, along with the swaps that triggered them",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} trade-history --limit 10 --reverse`,
+	}, &types.QueryGetProtoRevTradeHistoryRequest{}
+}
+
+// NewQueryProfitSharesDistributedCmd returns the command to query the cumulative amounts protorev sent to each profit share recipient
+func NewQueryProfitSharesDistributedCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitSharesDistributedRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "profit-shares-distributed",
+		Short: "Query the cumulative amounts of the profits ProtoRev sent to each profit share recipient",
+	}, &types.QueryGetProtoRevProfitSharesDistributedRequest{}
+}
+
 // NewQueryAllProtocolRevenueCmd returns the command to query protocol revenue across all modules
 func NewQueryAllProtocolRevenueCmd() (*osmocli.QueryDescriptor, *types.QueryGetAllProtocolRevenueRequest) {
 	return &osmocli.QueryDescriptor{
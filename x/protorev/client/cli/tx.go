@@ -188,9 +188,11 @@ func CmdSetBaseDenoms() *osmocli.TxCliDesc {
 			},
 			{
 				"step_size" : 10000,
-				"denom" : "atom"
+				"denom" : "atom",
+				"max_cyclic_route_hops" : 4
 			}
 		]
+		max_cyclic_route_hops is optional, and enables the search of the pool graph for cyclic routes of 2 to 4 hops through the base denom.
 		`,
 		Example:          fmt.Sprintf(`$ %s tx protorev set-base-denoms denoms.json --from mykey`, version.AppName),
 		NumArgs:          1,
@@ -214,8 +214,9 @@ func BuildSetInfoByPoolTypeMsg(clientCtx client.Context, args []string, fs *flag
 
 // ------------ types/functions to handle a SetBaseDenoms CLI TX ------------ //
 type baseDenomInput struct {
-	Denom    string `json:"denom"`
-	StepSize uint64 `json:"step_size"`
+	Denom              string `json:"denom"`
+	StepSize           uint64 `json:"step_size"`
+	MaxCyclicRouteHops uint64 `json:"max_cyclic_route_hops"`
 }
 
 type createBaseDenomsInput []baseDenomInput
@@ -270,8 +271,9 @@ func BuildSetBaseDenomsMsg(clientCtx client.Context, args []string, fs *flag.Fla
 	baseDenoms := make([]types.BaseDenom, 0)
 	for _, baseDenom := range *input {
 		baseDenoms = append(baseDenoms, types.BaseDenom{
-			Denom:    baseDenom.Denom,
-			StepSize: osmomath.NewIntFromUint64(baseDenom.StepSize),
+			Denom:              baseDenom.Denom,
+			StepSize:           osmomath.NewIntFromUint64(baseDenom.StepSize),
+			MaxCyclicRouteHops: baseDenom.MaxCyclicRouteHops,
 		})
 	}
 
@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
+
+// cyclicRouteSearch holds the state of the depth first search of the pool graph for the cyclic routes through
+// a base denom after a swap. The routes start and end with the base denom and swap tokenOut for tokenIn on the
+// swapped pool, which is the reverse of the swap being backrun.
+type cyclicRouteSearch struct {
+	k   Keeper
+	ctx sdk.Context
+
+	baseDenom         string
+	tokenIn, tokenOut string
+	poolId            uint64
+	maxHops           int
+
+	// remainingSteps is the number of pool graph edges that can still be visited
+	remainingSteps int
+
+	route         poolmanagertypes.SwapAmountInRoutes
+	visitedDenoms map[string]bool
+	usedPools     map[uint64]bool
+	routes        []poolmanagertypes.SwapAmountInRoutes
+}
+
+// BuildCyclicRoutes discovers the cyclic arbitrage routes through the base denom, of 2 to baseDenom.MaxCyclicRouteHops hops, by
+// searching the pool graph made of the highest liquidity pool between any two denoms. The routes swap tokenOut for tokenIn on the
+// swapped pool, traverse every denom and pool at most once, and are returned shortest first.
+//
+// The search visits at most MaxPoolGraphSearchSteps pool graph edges, and the routes are returned until their pool points exceed
+// the remaining pool points of the transaction.
+// Returns error if the cyclic routes search is disabled for the base denom.
+func (k Keeper) BuildCyclicRoutes(ctx sdk.Context, baseDenom types.BaseDenom, tokenIn, tokenOut string, poolId uint64) ([]RouteMetaData, error) {
+	if baseDenom.MaxCyclicRouteHops == 0 {
+		return nil, fmt.Errorf("cyclic routes search is disabled for base denom %s", baseDenom.Denom)
+	}
+
+	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search := &cyclicRouteSearch{
+		k:              k,
+		ctx:            ctx,
+		baseDenom:      baseDenom.Denom,
+		tokenIn:        tokenIn,
+		tokenOut:       tokenOut,
+		poolId:         poolId,
+		maxHops:        int(baseDenom.MaxCyclicRouteHops),
+		remainingSteps: types.MaxPoolGraphSearchSteps,
+		visitedDenoms:  map[string]bool{baseDenom.Denom: true},
+		usedPools:      map[uint64]bool{poolId: true},
+	}
+	search.visit(baseDenom.Denom, false)
+
+	sort.SliceStable(search.routes, func(i, j int) bool {
+		return len(search.routes[i]) < len(search.routes[j])
+	})
+
+	routes := make([]RouteMetaData, 0, len(search.routes))
+	totalPoolPoints := uint64(0)
+	for _, route := range search.routes {
+		// Check that the route is valid and update the number of pool points that this route will consume when simulating and executing trades
+		routePoolPoints, err := k.CalculateRoutePoolPoints(ctx, route)
+		if err != nil {
+			continue
+		}
+
+		totalPoolPoints += routePoolPoints
+		if totalPoolPoints > remainingPoolPoints {
+			break
+		}
+
+		routes = append(routes, RouteMetaData{
+			Route:      route,
+			PoolPoints: routePoolPoints,
+			StepSize:   baseDenom.StepSize,
+		})
+	}
+
+	return routes, nil
+}
+
+// visit extends the current route from denom, which is reached after the swapped pool if swapped is true.
+// It records the route once it returns to the base denom after the swapped pool.
+func (s *cyclicRouteSearch) visit(denom string, swapped bool) {
+	if swapped && denom == s.baseDenom {
+		s.routes = append(s.routes, append(poolmanagertypes.SwapAmountInRoutes{}, s.route...))
+		return
+	}
+
+	remainingHops := s.maxHops - len(s.route)
+	minHops := s.minHopsToComplete(denom, swapped)
+	if minHops > remainingHops {
+		return
+	}
+
+	// tokenOut can't be visited again, so that the swapped pool is traded on as soon as tokenOut is reached
+	if !swapped && denom == s.tokenOut {
+		if s.tokenIn != s.baseDenom && s.visitedDenoms[s.tokenIn] {
+			return
+		}
+		s.traverse(s.poolId, s.tokenIn, true)
+		return
+	}
+
+	// Without hops to spare, the next denom is either tokenOut or the base denom
+	if minHops == remainingHops {
+		next := s.tokenOut
+		if swapped {
+			next = s.baseDenom
+		}
+		if s.remainingSteps <= 0 {
+			return
+		}
+		s.remainingSteps--
+
+		if poolId, err := s.k.GetPoolGraphEdge(s.ctx, denom, next); err == nil && s.canTraverse(poolId, next, swapped) {
+			s.traverse(poolId, next, swapped)
+		}
+		return
+	}
+
+	// Collect the neighbors before traversing them, so that no store iterator is left open while searching
+	type edge struct {
+		denom  string
+		poolId uint64
+	}
+	edges := []edge{}
+	s.k.IteratePoolGraphNeighbors(s.ctx, denom, func(neighbor string, poolId uint64) bool {
+		if s.remainingSteps <= 0 {
+			return true
+		}
+		s.remainingSteps--
+
+		if s.canTraverse(poolId, neighbor, swapped) {
+			edges = append(edges, edge{denom: neighbor, poolId: poolId})
+		}
+		return false
+	})
+
+	for _, e := range edges {
+		s.traverse(e.poolId, e.denom, swapped)
+	}
+}
+
+// traverse appends the hop through the pool to the route, visits the denom out, and removes the hop.
+func (s *cyclicRouteSearch) traverse(poolId uint64, denomOut string, swapped bool) {
+	s.route = append(s.route, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: denomOut})
+	s.usedPools[poolId] = true
+	s.visitedDenoms[denomOut] = true
+
+	s.visit(denomOut, swapped)
+
+	// The base denom stays visited, so that it is only reached again to complete the route,
+	// and the swapped pool stays used, so that it is only traded on from tokenOut
+	if denomOut != s.baseDenom {
+		delete(s.visitedDenoms, denomOut)
+	}
+	if poolId != s.poolId {
+		delete(s.usedPools, poolId)
+	}
+	s.route = s.route[:len(s.route)-1]
+}
+
+// canTraverse returns true if the route can be extended through the pool to the denom out.
+func (s *cyclicRouteSearch) canTraverse(poolId uint64, denomOut string, swapped bool) bool {
+	if s.usedPools[poolId] {
+		return false
+	}
+	if denomOut == s.baseDenom {
+		return swapped
+	}
+	return !s.visitedDenoms[denomOut]
+}
+
+// minHopsToComplete returns the minimum number of hops needed to complete the route from the denom.
+func (s *cyclicRouteSearch) minHopsToComplete(denom string, swapped bool) int {
+	if swapped {
+		return 1
+	}
+
+	// The swapped pool
+	minHops := 1
+	if denom != s.tokenOut {
+		minHops++
+	}
+	if s.tokenIn != s.baseDenom {
+		minHops++
+	}
+	return minHops
+}
+
+// GetCyclicRoutesForPool returns the cyclic routes discovered by searching the pool graph, through every base denom, after a swap
+// in either direction between any two denoms of the pool. The routes of each swap are in the order they would be simulated.
+func (k Keeper) GetCyclicRoutesForPool(ctx sdk.Context, poolId uint64) ([]types.Route, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms, err := k.poolmanagerKeeper.RouteGetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]types.Route, 0)
+	for _, tokenIn := range denoms {
+		for _, tokenOut := range denoms {
+			if tokenIn == tokenOut {
+				continue
+			}
+
+			for _, baseDenom := range baseDenoms {
+				cyclicRoutes, err := k.BuildCyclicRoutes(ctx, baseDenom, tokenIn, tokenOut, poolId)
+				if err != nil {
+					continue
+				}
+
+				for _, cyclicRoute := range cyclicRoutes {
+					routes = append(routes, newRouteFromSwapAmountInRoutes(baseDenom.Denom, cyclicRoute))
+				}
+			}
+		}
+	}
+
+	return routes, nil
+}
+
+// newRouteFromSwapAmountInRoutes returns the route of the trades of the route metadata, starting with the token in.
+func newRouteFromSwapAmountInRoutes(tokenIn string, routeMetaData RouteMetaData) types.Route {
+	trades := make([]types.Trade, 0, len(routeMetaData.Route))
+	for _, hop := range routeMetaData.Route {
+		trades = append(trades, types.Trade{
+			Pool:     hop.PoolId,
+			TokenIn:  tokenIn,
+			TokenOut: hop.TokenOutDenom,
+		})
+		tokenIn = hop.TokenOutDenom
+	}
+
+	return types.Route{Trades: trades, StepSize: routeMetaData.StepSize}
+}
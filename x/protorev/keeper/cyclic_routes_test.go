@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
+
+// TestBuildCyclicRoutes tests the BuildCyclicRoutes function
+func (s *KeeperTestSuite) TestBuildCyclicRoutes() {
+	cases := []struct {
+		description   string
+		maxHops       uint64
+		tokenIn       string
+		tokenOut      string
+		poolId        uint64
+		expectedRoute []TestRoute
+		expectErr     bool
+	}{
+		{
+			description: "three pool route through osmo is discovered",
+			maxHops:     3,
+			tokenIn:     "akash",
+			tokenOut:    "Atom",
+			poolId:      1,
+			expectedRoute: []TestRoute{
+				{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+				{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+				{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
+			},
+		},
+		{
+			description: "four pool routes are discovered along with the three pool route",
+			maxHops:     4,
+			tokenIn:     "akash",
+			tokenOut:    "Atom",
+			poolId:      1,
+			expectedRoute: []TestRoute{
+				{PoolId: 25, InputDenom: types.OsmosisDenomination, OutputDenom: "Atom"},
+				{PoolId: 1, InputDenom: "Atom", OutputDenom: "akash"},
+				{PoolId: 7, InputDenom: "akash", OutputDenom: types.OsmosisDenomination},
+			},
+		},
+		{
+			description: "two pool route is discovered with base as token out",
+			maxHops:     2,
+			tokenIn:     "stake",
+			tokenOut:    types.OsmosisDenomination,
+			poolId:      54,
+			expectedRoute: []TestRoute{
+				{PoolId: 54, InputDenom: types.OsmosisDenomination, OutputDenom: "stake"},
+				{PoolId: 55, InputDenom: "stake", OutputDenom: types.OsmosisDenomination},
+			},
+		},
+		{
+			description: "no three pool route fits in two hops",
+			maxHops:     2,
+			tokenIn:     "akash",
+			tokenOut:    "Atom",
+			poolId:      1,
+		},
+		{
+			description: "search is disabled",
+			maxHops:     0,
+			tokenIn:     "akash",
+			tokenOut:    "Atom",
+			poolId:      1,
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.description, func() {
+			baseDenom := types.BaseDenom{
+				Denom:              types.OsmosisDenomination,
+				StepSize:           osmomath.NewInt(1_000_000),
+				MaxCyclicRouteHops: tc.maxHops,
+			}
+
+			routes, err := s.App.ProtoRevKeeper.BuildCyclicRoutes(s.Ctx, baseDenom, tc.tokenIn, tc.tokenOut, tc.poolId)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			found := len(tc.expectedRoute) == 0
+			for i, route := range routes {
+				s.Require().GreaterOrEqual(len(route.Route), 2)
+				s.Require().LessOrEqual(uint64(len(route.Route)), tc.maxHops)
+				s.Require().Equal(baseDenom.StepSize, route.StepSize)
+				if i > 0 {
+					s.Require().GreaterOrEqual(len(route.Route), len(routes[i-1].Route))
+				}
+
+				// Every route ends with the base denom, uses each pool once and swaps tokenOut for tokenIn on the swapped pool
+				s.Require().Equal(types.OsmosisDenomination, route.Route[len(route.Route)-1].TokenOutDenom)
+				usedPools := make(map[uint64]bool)
+				swapped := false
+				for j, hop := range route.Route {
+					s.Require().False(usedPools[hop.PoolId])
+					usedPools[hop.PoolId] = true
+
+					if hop.PoolId == tc.poolId {
+						s.Require().Equal(tc.tokenIn, hop.TokenOutDenom)
+						if j > 0 {
+							s.Require().Equal(tc.tokenOut, route.Route[j-1].TokenOutDenom)
+						}
+						swapped = true
+					}
+				}
+				s.Require().True(swapped)
+
+				if len(route.Route) == len(tc.expectedRoute) {
+					matches := true
+					for j, trade := range tc.expectedRoute {
+						if trade.PoolId != route.Route[j].PoolId || trade.OutputDenom != route.Route[j].TokenOutDenom {
+							matches = false
+						}
+					}
+					found = found || matches
+				}
+			}
+			s.Require().True(found)
+
+			if len(tc.expectedRoute) == 0 {
+				s.Require().Empty(routes)
+			}
+		})
+	}
+}
+
+// TestPoolGraph tests that the pool graph is rebuilt with the highest liquidity pool between any two denoms
+func (s *KeeperTestSuite) TestPoolGraph() {
+	// Edges are stored in both directions
+	poolId, err := s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(25), poolId)
+
+	poolId, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "Atom", types.OsmosisDenomination)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(25), poolId)
+
+	// Edges are not limited to the base denoms
+	poolId, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "bitcoin")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(14), poolId)
+
+	_, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, "akash", "doesnotexist")
+	s.Require().Error(err)
+
+	// Neighbors are iterated in lexicographic order
+	neighbors := []string{}
+	s.App.ProtoRevKeeper.IteratePoolGraphNeighbors(s.Ctx, "akash", func(neighbor string, poolId uint64) bool {
+		neighbors = append(neighbors, neighbor)
+		return false
+	})
+	s.Require().Contains(neighbors, "Atom")
+	s.Require().Contains(neighbors, "bitcoin")
+	s.Require().Contains(neighbors, types.OsmosisDenomination)
+	s.Require().IsNonDecreasing(neighbors)
+
+	s.App.ProtoRevKeeper.DeletePoolGraph(s.Ctx)
+	_, err = s.App.ProtoRevKeeper.GetPoolGraphEdge(s.Ctx, types.OsmosisDenomination, "Atom")
+	s.Require().Error(err)
+}
@@ -66,23 +66,23 @@ func (k Keeper) UpdateDeveloperFees(ctx sdk.Context, denom string, profit osmoma
 	return nil
 }
 
-// SendDeveloperFee sends the developer fee from the module account to the developer account
-func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
+// SendDeveloperFee sends the developer fee from the module account to the developer account and returns the fee sent
+func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) (sdk.Coin, error) {
+	// Initialize the developer profit to 0
+	devProfit := sdk.NewCoin(arbProfit.Denom, osmomath.ZeroInt())
+
 	// Developer account must be set in order to be able to withdraw developer fees
 	developerAccount, err := k.GetDeveloperAccount(ctx)
 	if err != nil {
-		return err
+		return devProfit, err
 	}
 
 	// Get the days since genesis
 	daysSinceGenesis, err := k.GetDaysSinceModuleGenesis(ctx)
 	if err != nil {
-		return err
+		return devProfit, err
 	}
 
-	// Initialize the developer profit to 0
-	devProfit := sdk.NewCoin(arbProfit.Denom, osmomath.ZeroInt())
-
 	// Calculate the developer fee
 	if daysSinceGenesis < types.Phase1Length {
 		devProfit.Amount = arbProfit.Amount.MulRaw(types.ProfitSplitPhase1).QuoRaw(100)
@@ -94,8 +94,8 @@ func (k Keeper) SendDeveloperFee(ctx sdk.Context, arbProfit sdk.Coin) error {
 
 	// Send the developer profit to the developer account
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, developerAccount, sdk.NewCoins(devProfit)); err != nil {
-		return err
+		return sdk.NewCoin(arbProfit.Denom, osmomath.ZeroInt()), err
 	}
 
-	return nil
+	return devProfit, nil
 }
@@ -65,11 +65,13 @@ func (suite *KeeperTestSuite) TestSendDeveloperFee() {
 			suite.SetupTest()
 			tc.alterState()
 
-			err := suite.App.ProtoRevKeeper.SendDeveloperFee(suite.Ctx, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
+			devFee, err := suite.App.ProtoRevKeeper.SendDeveloperFee(suite.Ctx, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100)))
 			if tc.expectedErr {
 				suite.Require().Error(err)
+				suite.Require().True(devFee.IsZero())
 			} else {
 				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedDevProfit, devFee)
 			}
 
 			developerAccount, err := suite.App.ProtoRevKeeper.GetDeveloperAccount(suite.Ctx)
@@ -34,3 +34,29 @@ func EmitBackrunEvent(ctx sdk.Context, pool SwapToBackrun, inputCoin sdk.Coin, p
 	)
 	ctx.EventManager().EmitEvent(backrunEvent)
 }
+
+// EmitProfitShareEvent emits an event for a share of the profits sent to a profit share recipient, along with the
+// id of the pool whose LPs were sent the share if any
+func EmitProfitShareEvent(ctx sdk.Context, recipient types.ProfitShareRecipientType, amount sdk.Coins, poolId *uint64) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProfitShareRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyProfitShareAmount, amount.String()),
+	}
+	if poolId != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyProfitSharePoolId, strconv.FormatUint(*poolId, 10)))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.TypeEvtProfitShare, attributes...))
+}
+
+// EmitProfitShareUnpaidEvent emits an event for a share of the profits that could not be sent to a profit share
+// recipient and is carried forward to the next distribution
+func EmitProfitShareUnpaidEvent(ctx sdk.Context, recipient types.ProfitShareRecipientType, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtProfitShareUnpaid,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyProfitShareRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyProfitShareAmount, amount.String()),
+	))
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
@@ -40,6 +41,13 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 				h.k.SetDaysSinceModuleGenesis(ctx, daysSinceGenesis+1)
 			}
 
+			// Share the profits made since the last day epoch with the profit share recipients. The shares that
+			// cannot be sent to a recipient are carried forward to the next day epoch. Errors reading the profits
+			// are logged and the profits are shared on the next day epoch instead, so that the pools are still updated.
+			_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+				return h.k.DistributeProfitShares(cacheCtx)
+			})
+
 			// Update the pools in the store
 			return h.k.UpdatePools(ctx)
 		}
@@ -48,7 +56,8 @@ func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epoch
 	return nil
 }
 
-// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store
+// UpdatePools first deletes all of the pools paired with any base denom in the store and then adds the highest liquidity pools that match to the store.
+// The pool graph, made of the highest liquidity pool between any two denoms, is rebuilt along the way.
 func (k Keeper) UpdatePools(ctx sdk.Context) error {
 	// baseDenomPools maps each base denom to a map of the highest liquidity pools paired with that base denom
 	// ex. {osmo -> {atom : 100, weth : 200}}
@@ -63,9 +72,14 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 		k.DeleteAllPoolsForBaseDenom(ctx, baseDenom.Denom)
 		baseDenomPools[baseDenom.Denom] = make(map[string]LiquidityPoolStruct)
 	}
+	k.DeletePoolGraph(ctx)
+
+	// graphPools maps each denom to a map of the highest liquidity pools paired with that denom, the denom being
+	// the first in the pool coins, ex. {atom -> {osmo : 100}}
+	graphPools := make(map[string]map[string]LiquidityPoolStruct)
 
-	// Update baseDenomPools with the highest liquidity pools
-	if err := k.UpdateHighestLiquidityPools(ctx, baseDenomPools); err != nil {
+	// Update baseDenomPools and graphPools with the highest liquidity pools
+	if err := k.updateHighestLiquidityPools(ctx, baseDenomPools, graphPools); err != nil {
 		return err
 	}
 
@@ -75,6 +89,11 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 			k.SetPoolForDenomPair(ctx, baseDenom, denom, pool.PoolId)
 		}
 	}
+	for denom, pools := range graphPools {
+		for otherDenom, pool := range pools {
+			k.SetPoolGraphEdge(ctx, denom, otherDenom, pool.PoolId)
+		}
+	}
 
 	return nil
 }
@@ -83,6 +102,12 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 // highest liquidity pools for each base denom by iterating through all pools, getting the
 // total liquidity for each pool, and updating the highest liquidity pools based upon comparing total liquidity.
 func (k Keeper) UpdateHighestLiquidityPools(ctx sdk.Context, baseDenomPools map[string]map[string]LiquidityPoolStruct) error {
+	return k.updateHighestLiquidityPools(ctx, baseDenomPools, nil)
+}
+
+// updateHighestLiquidityPools updates the baseDenomPools map like UpdateHighestLiquidityPools and, if not nil, the graphPools map
+// with the highest liquidity pool between any two denoms, keyed by the first denom of the pool coins.
+func (k Keeper) updateHighestLiquidityPools(ctx sdk.Context, baseDenomPools, graphPools map[string]map[string]LiquidityPoolStruct) error {
 	pools, err := k.poolmanagerKeeper.AllPools(ctx)
 	if err != nil {
 		return err
@@ -111,6 +136,14 @@ func (k Keeper) UpdateHighestLiquidityPools(ctx sdk.Context, baseDenomPools map[
 			if highestLiquidityPools, ok := baseDenomPools[tokenB.Denom]; ok {
 				k.compareAndStoreHighestLiquidityPool(tokenA.Denom, highestLiquidityPools, newPool)
 			}
+
+			// The pool coins are sorted, so that the pools of a denom pair are always keyed by the same denom
+			if graphPools != nil {
+				if _, ok := graphPools[tokenA.Denom]; !ok {
+					graphPools[tokenA.Denom] = make(map[string]LiquidityPoolStruct)
+				}
+				k.compareAndStoreHighestLiquidityPool(tokenB.Denom, graphPools[tokenA.Denom], newPool)
+			}
 		}
 	}
 
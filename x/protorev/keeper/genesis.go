@@ -87,6 +87,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 
+	// Set the profits that have not been shared with the profit share recipients yet.
+	for _, pendingProfitShare := range genState.PendingProfitShares {
+		if err := k.SetPendingProfitShare(ctx, pendingProfitShare); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the number of trades executed on each pool since the profits were last shared.
+	for _, poolTradeCount := range genState.PoolTradeCounts {
+		k.SetPoolTradeCount(ctx, poolTradeCount.PoolId, poolTradeCount.TradeCount)
+	}
+
+	// Set the cumulative amounts sent to each profit share recipient.
+	for _, distribution := range genState.ProfitSharesDistributed {
+		if err := k.SetProfitSharesDistributed(ctx, distribution); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the shares of the profits that could not be sent to each profit share recipient.
+	for _, unpaidProfitShare := range genState.UnpaidProfitShares {
+		if err := k.SetUnpaidProfitShare(ctx, unpaidProfitShare); err != nil {
+			panic(err)
+		}
+	}
+
 	// Since we now track all aspects of protocol revenue, we need to take a snapshot of cyclic arb profits from this module at a certain block height.
 	// This allows us to display how much protocol revenue has been generated since block "X" instead of just since the module was initialized.
 	if len(genState.CyclicArbTracker.CyclicArb) > 0 {
@@ -169,6 +195,33 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// Export the profits that have been collected by Protorev.
 	genesis.Profits = k.GetAllProfits(ctx)
 
+	// Export the profits that have not been shared with the profit share recipients yet.
+	pendingProfitShares, err := k.GetAllPendingProfitShares(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PendingProfitShares = pendingProfitShares
+
+	// Export the number of trades executed on each pool since the profits were last shared.
+	poolIds, tradeCounts := k.GetAllPoolTradeCounts(ctx)
+	for _, poolId := range poolIds {
+		genesis.PoolTradeCounts = append(genesis.PoolTradeCounts, types.PoolTradeCount{PoolId: poolId, TradeCount: tradeCounts[poolId]})
+	}
+
+	// Export the cumulative amounts sent to each profit share recipient.
+	profitSharesDistributed, err := k.GetAllProfitSharesDistributed(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ProfitSharesDistributed = profitSharesDistributed
+
+	// Export the shares of the profits that could not be sent to each profit share recipient.
+	unpaidProfitShares, err := k.GetAllUnpaidProfitShares(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.UnpaidProfitShares = unpaidProfitShares
+
 	// Export the profits that have been collected by Protorev since a certain block height.
 	cyclicArbTracker := types.CyclicArbTracker{
 		CyclicArb:                  k.GetCyclicArbProfitTrackerValue(ctx),
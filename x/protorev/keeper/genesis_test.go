@@ -1,9 +1,30 @@
 package keeper_test
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
 
 // TestInitGenesis tests the initialization and export of the module's genesis state.
 func (s *KeeperTestSuite) TestInitGenesis() {
+	// Set the profit sharing state
+	err := s.App.ProtoRevKeeper.SetPendingProfitShare(s.Ctx, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1_000)))
+	s.Require().NoError(err)
+	s.App.ProtoRevKeeper.SetPoolTradeCount(s.Ctx, 1, 3)
+	s.App.ProtoRevKeeper.SetPoolTradeCount(s.Ctx, 2, 5)
+	err = s.App.ProtoRevKeeper.SetProfitSharesDistributed(s.Ctx, types.ProfitShareDistribution{
+		Recipient: types.ProfitShareRecipientBurn,
+		Amount:    sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(500))),
+	})
+	s.Require().NoError(err)
+	err = s.App.ProtoRevKeeper.SetUnpaidProfitShare(s.Ctx, types.ProfitShareDistribution{
+		Recipient: types.ProfitShareRecipientPoolLPs,
+		Amount:    sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(200))),
+	})
+	s.Require().NoError(err)
+
 	// Export the genesis state
 	exportedGenesis := s.App.ProtoRevKeeper.ExportGenesis(s.Ctx)
 
@@ -68,4 +89,42 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 
 	cyclicArbProfitAccountingHeight := s.App.ProtoRevKeeper.GetCyclicArbProfitTrackerStartHeight(s.Ctx)
 	s.Require().Equal(cyclicArbProfitAccountingHeight, exportedGenesis.CyclicArbTracker.HeightAccountingStartsFrom)
+
+	// Test the profit sharing state exported correctly
+	pendingProfitShares, err := s.App.ProtoRevKeeper.GetAllPendingProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin(pendingProfitShares), exportedGenesis.PendingProfitShares)
+
+	s.Require().Equal([]types.PoolTradeCount{
+		{PoolId: 1, TradeCount: 3},
+		{PoolId: 2, TradeCount: 5},
+	}, exportedGenesis.PoolTradeCounts)
+
+	profitSharesDistributed, err := s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(profitSharesDistributed, exportedGenesis.ProfitSharesDistributed)
+
+	unpaidProfitShares, err := s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(unpaidProfitShares, exportedGenesis.UnpaidProfitShares)
+
+	// Clear the profit sharing state and check that it is imported back from the exported genesis state
+	s.App.ProtoRevKeeper.DeletePendingProfitShares(s.Ctx)
+	err = s.App.ProtoRevKeeper.SetUnpaidProfitShare(s.Ctx, types.ProfitShareDistribution{Recipient: types.ProfitShareRecipientPoolLPs})
+	s.Require().NoError(err)
+	s.App.ProtoRevKeeper.InitGenesis(s.Ctx, *exportedGenesis)
+
+	pendingProfitShares, err = s.App.ProtoRevKeeper.GetAllPendingProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin(pendingProfitShares), exportedGenesis.PendingProfitShares)
+	s.Require().Equal(uint64(3), s.App.ProtoRevKeeper.GetPoolTradeCount(s.Ctx, 1))
+	s.Require().Equal(uint64(5), s.App.ProtoRevKeeper.GetPoolTradeCount(s.Ctx, 2))
+
+	profitSharesDistributed, err = s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(profitSharesDistributed, exportedGenesis.ProfitSharesDistributed)
+
+	unpaidProfitShares, err = s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(unpaidProfitShares, exportedGenesis.UnpaidProfitShares)
 }
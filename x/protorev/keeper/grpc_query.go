@@ -247,6 +247,51 @@ func (q Querier) GetProtoRevPool(c context.Context, req *types.QueryGetProtoRevP
 	return &types.QueryGetProtoRevPoolResponse{PoolId: poolId}, nil
 }
 
+// GetProtoRevCyclicRoutes queries the cyclic arbitrage routes discovered by searching the pool graph after a swap on a given pool
+func (q Querier) GetProtoRevCyclicRoutes(c context.Context, req *types.QueryGetProtoRevCyclicRoutesRequest) (*types.QueryGetProtoRevCyclicRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	routes, err := q.Keeper.GetCyclicRoutesForPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevCyclicRoutesResponse{Routes: routes}, nil
+}
+
+// GetProtoRevTradeHistory queries the cyclic arbitrage trades executed by the module in the latest blocks
+func (q Querier) GetProtoRevTradeHistory(c context.Context, req *types.QueryGetProtoRevTradeHistoryRequest) (*types.QueryGetProtoRevTradeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trades, pageRes, err := q.Keeper.GetTradeHistory(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevTradeHistoryResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+// GetProtoRevProfitSharesDistributed queries the cumulative amounts of the profits of the module sent to each profit share recipient
+func (q Querier) GetProtoRevProfitSharesDistributed(c context.Context, req *types.QueryGetProtoRevProfitSharesDistributedRequest) (*types.QueryGetProtoRevProfitSharesDistributedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	distributions, err := q.Keeper.GetAllProfitSharesDistributed(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitSharesDistributedResponse{Distributions: distributions}, nil
+}
+
 // GetAllProtocolRevenue queries all types of protocol revenue (txfees, taker fees, and cyclic arbitrage profits)
 func (q Querier) GetAllProtocolRevenue(c context.Context, req *types.QueryGetAllProtocolRevenueRequest) (*types.QueryGetAllProtocolRevenueResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/keeper"
 
 	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
 )
@@ -381,6 +383,102 @@ func (s *KeeperTestSuite) TestGetProtoRevPool() {
 	s.Require().Equal(res.PoolId, uint64(1))
 }
 
+// TestGetProtoRevCyclicRoutes tests the query for the cyclic routes discovered for a pool
+func (s *KeeperTestSuite) TestGetProtoRevCyclicRoutes() {
+	// No route is discovered while the search is disabled for every base denom
+	req := &types.QueryGetProtoRevCyclicRoutesRequest{PoolId: 1}
+	res, err := s.queryClient.GetProtoRevCyclicRoutes(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.Routes)
+
+	// Enable the search for osmo
+	baseDenoms, err := s.App.ProtoRevKeeper.GetAllBaseDenoms(s.Ctx)
+	s.Require().NoError(err)
+	baseDenoms[0].MaxCyclicRouteHops = 3
+	s.Require().NoError(s.App.ProtoRevKeeper.SetBaseDenoms(s.Ctx, baseDenoms))
+
+	res, err = s.queryClient.GetProtoRevCyclicRoutes(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	trades := make([][]types.Trade, 0, len(res.Routes))
+	for _, route := range res.Routes {
+		s.Require().Equal(baseDenoms[0].StepSize, route.StepSize)
+		trades = append(trades, route.Trades)
+	}
+	s.Require().Contains(trades, []types.Trade{
+		{Pool: 25, TokenIn: types.OsmosisDenomination, TokenOut: "Atom"},
+		{Pool: 1, TokenIn: "Atom", TokenOut: "akash"},
+		{Pool: 7, TokenIn: "akash", TokenOut: types.OsmosisDenomination},
+	})
+
+	// Request for a pool that does not exist should return an error
+	req = &types.QueryGetProtoRevCyclicRoutesRequest{PoolId: 1000000}
+	_, err = s.queryClient.GetProtoRevCyclicRoutes(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().Error(err)
+}
+
+// TestGetProtoRevTradeHistory tests the query for the trade history
+func (s *KeeperTestSuite) TestGetProtoRevTradeHistory() {
+	// Should be empty initially
+	req := &types.QueryGetProtoRevTradeHistoryRequest{}
+	res, err := s.queryClient.GetProtoRevTradeHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.Trades)
+
+	// Pseudo execute 3 trades
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: "Atom"}, {PoolId: 2, TokenOutDenom: types.OsmosisDenomination}}
+	pool := keeper.SwapToBackrun{PoolId: 3, TokenInDenom: "akash", TokenOutDenom: "Atom"}
+	for i := int64(1); i <= 3; i++ {
+		err = s.App.ProtoRevKeeper.UpdateStatistics(s.Ctx, route, types.OsmosisDenomination, osmomath.NewInt(i))
+		s.Require().NoError(err)
+		err = s.App.ProtoRevKeeper.RecordTrade(s.Ctx, route, sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1000)), pool, osmomath.NewInt(i), 4)
+		s.Require().NoError(err)
+	}
+
+	res, err = s.queryClient.GetProtoRevTradeHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Len(res.Trades, 3)
+	s.Require().Equal(osmomath.NewInt(1), res.Trades[0].Profit)
+
+	// Paginate from the latest trade
+	req = &types.QueryGetProtoRevTradeHistoryRequest{Pagination: &query.PageRequest{Limit: 2, Reverse: true}}
+	res, err = s.queryClient.GetProtoRevTradeHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Len(res.Trades, 2)
+	s.Require().Equal(osmomath.NewInt(3), res.Trades[0].Profit)
+	s.Require().Equal(osmomath.NewInt(2), res.Trades[1].Profit)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	req = &types.QueryGetProtoRevTradeHistoryRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2, Reverse: true}}
+	res, err = s.queryClient.GetProtoRevTradeHistory(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Len(res.Trades, 1)
+	s.Require().Equal(osmomath.NewInt(1), res.Trades[0].Profit)
+}
+
+// TestGetProtoRevProfitSharesDistributed tests the query for the cumulative amounts sent to each profit share recipient
+func (s *KeeperTestSuite) TestGetProtoRevProfitSharesDistributed() {
+	// Should be empty initially
+	req := &types.QueryGetProtoRevProfitSharesDistributedRequest{}
+	res, err := s.queryClient.GetProtoRevProfitSharesDistributed(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Empty(res.Distributions)
+
+	// Pseudo distribute profit shares twice
+	for i := 0; i < 2; i++ {
+		err = s.App.ProtoRevKeeper.UpdateProfitSharesDistributed(s.Ctx, types.ProfitShareRecipientBurn, sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(100))))
+		s.Require().NoError(err)
+		err = s.App.ProtoRevKeeper.UpdateProfitSharesDistributed(s.Ctx, types.ProfitShareRecipientCommunityPool, sdk.NewCoins(sdk.NewCoin("Atom", osmomath.NewInt(50))))
+		s.Require().NoError(err)
+	}
+
+	res, err = s.queryClient.GetProtoRevProfitSharesDistributed(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin("Atom", osmomath.NewInt(100))}},
+		{Recipient: types.ProfitShareRecipientBurn, Amount: []sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(200))}},
+	}, res.Distributions)
+}
+
 // TestGetAllProtocolRevenue tests the query for all protocol revenue profits
 func (s *KeeperTestSuite) TestGetAllProtocolRevenueGRPCQuery() {
 	poolManagerParams := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -25,6 +25,9 @@ type (
 		epochKeeper                 types.EpochKeeper
 		poolmanagerKeeper           types.PoolManagerKeeper
 		concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper
+		distributionKeeper          types.DistributionKeeper
+		incentivesKeeper            types.IncentivesKeeper
+		poolIncentivesKeeper        types.PoolIncentivesKeeper
 	}
 )
 
@@ -38,6 +41,7 @@ func NewKeeper(
 	epochKeeper types.EpochKeeper,
 	poolmanagerKeeper types.PoolManagerKeeper,
 	concentratedLiquidityKeeper types.ConcentratedLiquidityKeeper,
+	distributionKeeper types.DistributionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -54,9 +58,20 @@ func NewKeeper(
 		epochKeeper:                 epochKeeper,
 		poolmanagerKeeper:           poolmanagerKeeper,
 		concentratedLiquidityKeeper: concentratedLiquidityKeeper,
+		distributionKeeper:          distributionKeeper,
 	}
 }
 
+// Set the incentives keeper, used to add the profit share of the pool LPs to the pool gauges.
+func (k *Keeper) SetIncentivesKeeper(incentivesKeeper types.IncentivesKeeper) {
+	k.incentivesKeeper = incentivesKeeper
+}
+
+// Set the pool incentives keeper, used to find the gauges of the pools the profit share of the pool LPs is added to.
+func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentivesKeeper) {
+	k.poolIncentivesKeeper = poolIncentivesKeeper
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -0,0 +1,433 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
+
+// ----------------------- Profit Sharing Stores  ----------------------- //
+
+// GetPendingProfitShare returns the profits of the given denom that have not been shared with the profit share recipients yet
+func (k Keeper) GetPendingProfitShare(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingProfitShares)
+	key := types.GetKeyPrefixPendingProfitShare(denom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, osmomath.ZeroInt()), fmt.Errorf("no pending profit share for denom %s", denom)
+	}
+
+	pendingProfitShare := sdk.Coin{}
+	if err := pendingProfitShare.Unmarshal(bz); err != nil {
+		return sdk.NewCoin(denom, osmomath.ZeroInt()), err
+	}
+
+	return pendingProfitShare, nil
+}
+
+// GetAllPendingProfitShares returns all of the profits that have not been shared with the profit share recipients yet
+func (k Keeper) GetAllPendingProfitShares(ctx sdk.Context) (sdk.Coins, error) {
+	pendingProfitShares := sdk.NewCoins()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingProfitShares)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingProfitShare := sdk.Coin{}
+		if err := pendingProfitShare.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("error unmarshalling pending profit share: %w", err)
+		}
+
+		pendingProfitShares = pendingProfitShares.Add(pendingProfitShare)
+	}
+
+	return pendingProfitShares, nil
+}
+
+// GetPoolTradeCount returns the number of trades executed on the given pool since the profits were last shared
+func (k Keeper) GetPoolTradeCount(ctx sdk.Context, poolId uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolTradeCounts)
+
+	bz := store.Get(types.GetKeyPrefixPoolTradeCount(poolId))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetAllPoolTradeCounts returns the number of trades executed on each pool since the profits were last shared,
+// along with the ids of the pools sorted in ascending order
+func (k Keeper) GetAllPoolTradeCounts(ctx sdk.Context) ([]uint64, map[uint64]uint64) {
+	poolIds := []uint64{}
+	tradeCounts := make(map[uint64]uint64)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolTradeCounts)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolTradeCounts)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		poolId := sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixPoolTradeCounts):])
+		poolIds = append(poolIds, poolId)
+		tradeCounts[poolId] = sdk.BigEndianToUint64(iterator.Value())
+	}
+
+	return poolIds, tradeCounts
+}
+
+// SetPendingProfitShare sets the profits of the coin's denom that have not been shared with the profit share recipients yet
+func (k Keeper) SetPendingProfitShare(ctx sdk.Context, pendingProfitShare sdk.Coin) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingProfitShares)
+
+	bz, err := pendingProfitShare.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetKeyPrefixPendingProfitShare(pendingProfitShare.Denom), bz)
+	return nil
+}
+
+// SetPoolTradeCount sets the number of trades executed on the given pool since the profits were last shared
+func (k Keeper) SetPoolTradeCount(ctx sdk.Context, poolId uint64, tradeCount uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolTradeCounts)
+	store.Set(types.GetKeyPrefixPoolTradeCount(poolId), sdk.Uint64ToBigEndian(tradeCount))
+}
+
+// UpdatePendingProfitShares adds the profit of a trade left after the developer fee to the profits that have not been
+// shared yet, and increments the number of trades executed on every pool of the route
+func (k Keeper) UpdatePendingProfitShares(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, profit sdk.Coin) error {
+	pendingProfitShare, _ := k.GetPendingProfitShare(ctx, profit.Denom)
+	pendingProfitShare.Amount = pendingProfitShare.Amount.Add(profit.Amount)
+	if err := k.SetPendingProfitShare(ctx, pendingProfitShare); err != nil {
+		return err
+	}
+
+	for _, poolId := range route.PoolIds() {
+		k.SetPoolTradeCount(ctx, poolId, k.GetPoolTradeCount(ctx, poolId)+1)
+	}
+
+	return nil
+}
+
+// DeletePendingProfitShares deletes the profits that have not been shared yet and the number of trades executed on
+// each pool since the profits were last shared
+func (k Keeper) DeletePendingProfitShares(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.KeyPrefixPendingProfitShares, types.KeyPrefixPoolTradeCounts} {
+		iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// GetProfitSharesDistributed returns the cumulative amounts sent to the given profit share recipient
+func (k Keeper) GetProfitSharesDistributed(ctx sdk.Context, recipient types.ProfitShareRecipientType) (types.ProfitShareDistribution, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitSharesDistributed)
+
+	bz := store.Get(types.GetKeyPrefixProfitSharesDistributed(recipient))
+	if len(bz) == 0 {
+		return types.ProfitShareDistribution{Recipient: recipient, Amount: sdk.NewCoins()}, nil
+	}
+
+	distribution := types.ProfitShareDistribution{}
+	if err := distribution.Unmarshal(bz); err != nil {
+		return types.ProfitShareDistribution{}, err
+	}
+
+	return distribution, nil
+}
+
+// GetAllProfitSharesDistributed returns the cumulative amounts sent to every profit share recipient that has been sent
+// a share of the profits
+func (k Keeper) GetAllProfitSharesDistributed(ctx sdk.Context) ([]types.ProfitShareDistribution, error) {
+	distributions := []types.ProfitShareDistribution{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixProfitSharesDistributed)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		distribution := types.ProfitShareDistribution{}
+		if err := distribution.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("error unmarshalling profit share distribution: %w", err)
+		}
+
+		distributions = append(distributions, distribution)
+	}
+
+	return distributions, nil
+}
+
+// SetProfitSharesDistributed sets the cumulative amounts sent to the profit share recipient of the distribution
+func (k Keeper) SetProfitSharesDistributed(ctx sdk.Context, distribution types.ProfitShareDistribution) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixProfitSharesDistributed)
+
+	bz, err := distribution.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetKeyPrefixProfitSharesDistributed(distribution.Recipient), bz)
+	return nil
+}
+
+// UpdateProfitSharesDistributed adds the given coins to the cumulative amounts sent to the profit share recipient
+func (k Keeper) UpdateProfitSharesDistributed(ctx sdk.Context, recipient types.ProfitShareRecipientType, amount sdk.Coins) error {
+	distribution, err := k.GetProfitSharesDistributed(ctx, recipient)
+	if err != nil {
+		return err
+	}
+	distribution.Amount = sdk.NewCoins(distribution.Amount...).Add(amount...)
+
+	return k.SetProfitSharesDistributed(ctx, distribution)
+}
+
+// GetUnpaidProfitShare returns the share of the profits that could not be sent to the given profit share recipient
+func (k Keeper) GetUnpaidProfitShare(ctx sdk.Context, recipient types.ProfitShareRecipientType) (types.ProfitShareDistribution, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnpaidProfitShares)
+
+	bz := store.Get(types.GetKeyPrefixUnpaidProfitShare(recipient))
+	if len(bz) == 0 {
+		return types.ProfitShareDistribution{Recipient: recipient, Amount: sdk.NewCoins()}, nil
+	}
+
+	unpaidProfitShare := types.ProfitShareDistribution{}
+	if err := unpaidProfitShare.Unmarshal(bz); err != nil {
+		return types.ProfitShareDistribution{}, err
+	}
+
+	return unpaidProfitShare, nil
+}
+
+// GetAllUnpaidProfitShares returns the shares of the profits that could not be sent to every profit share recipient
+// that has an unpaid share
+func (k Keeper) GetAllUnpaidProfitShares(ctx sdk.Context) ([]types.ProfitShareDistribution, error) {
+	unpaidProfitShares := []types.ProfitShareDistribution{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUnpaidProfitShares)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		unpaidProfitShare := types.ProfitShareDistribution{}
+		if err := unpaidProfitShare.Unmarshal(iterator.Value()); err != nil {
+			return nil, fmt.Errorf("error unmarshalling unpaid profit share: %w", err)
+		}
+
+		unpaidProfitShares = append(unpaidProfitShares, unpaidProfitShare)
+	}
+
+	return unpaidProfitShares, nil
+}
+
+// SetUnpaidProfitShare sets the share of the profits that could not be sent to the profit share recipient of the
+// given unpaid share, deleting it if the amount is empty
+func (k Keeper) SetUnpaidProfitShare(ctx sdk.Context, unpaidProfitShare types.ProfitShareDistribution) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnpaidProfitShares)
+	key := types.GetKeyPrefixUnpaidProfitShare(unpaidProfitShare.Recipient)
+
+	if sdk.Coins(unpaidProfitShare.Amount).Empty() {
+		store.Delete(key)
+		return nil
+	}
+
+	bz, err := unpaidProfitShare.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// ----------------------- Profit Sharing ----------------------- //
+
+// DistributeProfitShares sends every profit share recipient its share of the profits made since the profits were last
+// shared, along with the share that could not be sent to it at the previous distributions. The profits that are not
+// shared with any recipient are held in the module account.
+//
+// The share of each recipient is sent in its own cache context, so that a recipient that fails to be sent its share
+// does not prevent the other recipients from being sent theirs. The part of the share that is not sent is carried
+// forward to the next distribution and an unpaid profit share event is emitted. The share of the pool LPs is the
+// exception: it is split by the trades executed since the profits were last shared, so the part that is not sent,
+// either because sending it failed or because some of the pools traded on have no internal gauge, is sent to the
+// community pool instead of being split by the trades of the next distribution.
+func (k Keeper) DistributeProfitShares(ctx sdk.Context) error {
+	pendingProfitShares, err := k.GetAllPendingProfitShares(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The community pool is always distributed to, so that the pool LPs share carried forward to it is sent even if
+	// it is not a profit share recipient
+	recipients := k.GetParams(ctx).ProfitShareRecipients
+	hasCommunityPool := false
+	for _, recipient := range recipients {
+		if recipient.Recipient == types.ProfitShareRecipientCommunityPool {
+			hasCommunityPool = true
+		}
+	}
+	if !hasCommunityPool {
+		recipients = append(recipients, types.ProfitShareRecipient{Recipient: types.ProfitShareRecipientCommunityPool, Share: osmomath.ZeroDec()})
+	}
+
+	for _, recipient := range recipients {
+		unpaidProfitShare, err := k.GetUnpaidProfitShare(ctx, recipient.Recipient)
+		if err != nil {
+			return err
+		}
+
+		share := sdk.NewCoins(unpaidProfitShare.Amount...)
+		for _, coin := range pendingProfitShares {
+			share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToLegacyDec().MulTruncate(recipient.Share).TruncateInt()))
+		}
+		if share.Empty() {
+			continue
+		}
+
+		unpaid := k.distributeProfitShare(ctx, recipient.Recipient, share)
+		if recipient.Recipient == types.ProfitShareRecipientPoolLPs && !unpaid.Empty() {
+			if err := k.distributeUnpaidPoolLPsProfitShare(ctx, unpaid); err != nil {
+				return err
+			}
+			unpaid = sdk.NewCoins()
+		} else if !unpaid.Empty() {
+			EmitProfitShareUnpaidEvent(ctx, recipient.Recipient, unpaid)
+		}
+
+		if err := k.SetUnpaidProfitShare(ctx, types.ProfitShareDistribution{Recipient: recipient.Recipient, Amount: unpaid}); err != nil {
+			return err
+		}
+	}
+
+	k.DeletePendingProfitShares(ctx)
+
+	return nil
+}
+
+// distributeProfitShare sends the share of the profits to the profit share recipient in a cache context, tracking the
+// amount sent, and returns the part of the share that was not sent
+func (k Keeper) distributeProfitShare(ctx sdk.Context, recipient types.ProfitShareRecipientType, share sdk.Coins) sdk.Coins {
+	sent := sdk.NewCoins()
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		sentInCacheCtx, err := k.sendProfitShare(cacheCtx, recipient, share)
+		if err != nil {
+			return err
+		}
+
+		sent = sentInCacheCtx
+		return k.UpdateProfitSharesDistributed(cacheCtx, recipient, sent)
+	})
+	if err != nil {
+		return share
+	}
+
+	return share.Sub(sent...)
+}
+
+// distributeUnpaidPoolLPsProfitShare sends the part of the pool LPs share that was not sent to the community pool. If
+// it cannot be sent either, it is carried forward to the next distribution as part of the community pool share.
+func (k Keeper) distributeUnpaidPoolLPsProfitShare(ctx sdk.Context, unpaid sdk.Coins) error {
+	unpaid = k.distributeProfitShare(ctx, types.ProfitShareRecipientCommunityPool, unpaid)
+	if unpaid.Empty() {
+		return nil
+	}
+
+	EmitProfitShareUnpaidEvent(ctx, types.ProfitShareRecipientCommunityPool, unpaid)
+
+	unpaidProfitShare, err := k.GetUnpaidProfitShare(ctx, types.ProfitShareRecipientCommunityPool)
+	if err != nil {
+		return err
+	}
+	unpaidProfitShare.Amount = sdk.NewCoins(unpaidProfitShare.Amount...).Add(unpaid...)
+
+	return k.SetUnpaidProfitShare(ctx, unpaidProfitShare)
+}
+
+// sendProfitShare sends the share of the profits to the profit share recipient and returns the coins that were sent
+func (k Keeper) sendProfitShare(ctx sdk.Context, recipient types.ProfitShareRecipientType, share sdk.Coins) (sdk.Coins, error) {
+	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	switch recipient {
+	case types.ProfitShareRecipientCommunityPool:
+		if err := k.distributionKeeper.FundCommunityPool(ctx, share, protorevModuleAddress); err != nil {
+			return nil, err
+		}
+	case types.ProfitShareRecipientBurn:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, share); err != nil {
+			return nil, err
+		}
+	case types.ProfitShareRecipientStakers:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, share); err != nil {
+			return nil, err
+		}
+	case types.ProfitShareRecipientPoolLPs:
+		return k.sendProfitShareToPoolLPs(ctx, share)
+	default:
+		return nil, fmt.Errorf("invalid profit share recipient: %d", recipient)
+	}
+
+	EmitProfitShareEvent(ctx, recipient, share, nil)
+
+	return share, nil
+}
+
+// sendProfitShareToPoolLPs splits the share of the profits between the pools traded on since the profits were last
+// shared, pro-rata to the number of trades executed on each pool, and adds each part to the internal incentive gauge
+// of the pool. The parts of the pools without an internal gauge are not sent, and are sent to the community pool by the
+// caller.
+func (k Keeper) sendProfitShareToPoolLPs(ctx sdk.Context, share sdk.Coins) (sdk.Coins, error) {
+	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	poolIds, tradeCounts := k.GetAllPoolTradeCounts(ctx)
+	totalTradeCount := uint64(0)
+	for _, poolId := range poolIds {
+		totalTradeCount += tradeCounts[poolId]
+	}
+	if totalTradeCount == 0 {
+		return sdk.NewCoins(), nil
+	}
+
+	sent := sdk.NewCoins()
+	for _, poolId := range poolIds {
+		gaugeId, err := k.poolIncentivesKeeper.GetInternalGaugeIDForPool(ctx, poolId)
+		if err != nil {
+			continue
+		}
+
+		poolShare := sdk.NewCoins()
+		for _, coin := range share {
+			poolShare = poolShare.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(tradeCounts[poolId])).QuoRaw(int64(totalTradeCount))))
+		}
+		if poolShare.Empty() {
+			continue
+		}
+
+		if err := k.incentivesKeeper.AddToGaugeRewards(ctx, protorevModuleAddress, poolShare, gaugeId); err != nil {
+			return nil, err
+		}
+
+		EmitProfitShareEvent(ctx, types.ProfitShareRecipientPoolLPs, poolShare, &poolId)
+		sent = sent.Add(poolShare...)
+	}
+
+	return sent, nil
+}
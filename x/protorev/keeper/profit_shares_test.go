@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
+
+// TestDistributeProfitShares tests that the profits made since the last day epoch are shared with the profit share
+// recipients at the end of the day epoch
+func (s *KeeperTestSuite) TestDistributeProfitShares() {
+	denom := types.OsmosisDenomination
+	profit := sdk.NewCoin(denom, osmomath.NewInt(1_000_000))
+
+	// Mint the profit to the module account and hold it, as if it was made by a trade on the route
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(profit)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, profit))
+
+	pendingProfitShares, err := s.App.ProtoRevKeeper.GetAllPendingProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(profit), pendingProfitShares)
+	for _, poolId := range routeTwoAssetSameWeight.PoolIds() {
+		s.Require().Equal(uint64(1), s.App.ProtoRevKeeper.GetPoolTradeCount(s.Ctx, poolId))
+	}
+
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitShareRecipients = []types.ProfitShareRecipient{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Share: osmomath.NewDecWithPrec(1, 1)},
+		{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(2, 1)},
+		{Recipient: types.ProfitShareRecipientStakers, Share: osmomath.NewDecWithPrec(3, 1)},
+		{Recipient: types.ProfitShareRecipientPoolLPs, Share: osmomath.NewDecWithPrec(2, 1)},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollectorAddress := s.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	moduleBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, denom)
+	feeCollectorBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, denom)
+	communityPoolBefore := s.App.DistrKeeper.GetFeePool(s.Ctx).CommunityPool.AmountOf(denom)
+	supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, denom)
+
+	gaugeIds := []uint64{}
+	gaugeCoinsBefore := []osmomath.Int{}
+	for _, poolId := range routeTwoAssetSameWeight.PoolIds() {
+		gaugeId, err := s.App.PoolIncentivesKeeper.GetInternalGaugeIDForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+		s.Require().NoError(err)
+
+		gaugeIds = append(gaugeIds, gaugeId)
+		gaugeCoinsBefore = append(gaugeCoinsBefore, gauge.Coins.AmountOf(denom))
+	}
+
+	err = s.App.ProtoRevKeeper.EpochHooks().AfterEpochEnd(s.Ctx, "day", 1)
+	s.Require().NoError(err)
+
+	// Each recipient should be sent its share of the profit
+	s.Require().Equal(communityPoolBefore.Add(osmomath.NewDec(100_000)), s.App.DistrKeeper.GetFeePool(s.Ctx).CommunityPool.AmountOf(denom))
+	s.Require().Equal(supplyBefore.Amount.Sub(osmomath.NewInt(200_000)), s.App.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+	s.Require().Equal(feeCollectorBalanceBefore.Amount.Add(osmomath.NewInt(300_000)), s.App.BankKeeper.GetBalance(s.Ctx, feeCollectorAddress, denom).Amount)
+
+	// The share of the pool LPs should be split evenly between the two pools of the route
+	for i, gaugeId := range gaugeIds {
+		gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+		s.Require().NoError(err)
+		s.Require().Equal(gaugeCoinsBefore[i].Add(osmomath.NewInt(100_000)), gauge.Coins.AmountOf(denom))
+	}
+
+	// The profit that is not shared should be held in the module account
+	s.Require().Equal(moduleBalanceBefore.Amount.Sub(osmomath.NewInt(800_000)), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, denom).Amount)
+
+	// The pending profits and pool trade counts should be reset
+	pendingProfitShares, err = s.App.ProtoRevKeeper.GetAllPendingProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(pendingProfitShares)
+	poolIds, _ := s.App.ProtoRevKeeper.GetAllPoolTradeCounts(s.Ctx)
+	s.Require().Empty(poolIds)
+
+	// The cumulative amounts sent to each recipient should be tracked
+	distributions, err := s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(100_000))}},
+		{Recipient: types.ProfitShareRecipientBurn, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(200_000))}},
+		{Recipient: types.ProfitShareRecipientStakers, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(300_000))}},
+		{Recipient: types.ProfitShareRecipientPoolLPs, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(200_000))}},
+	}, distributions)
+
+	// Nothing should be distributed again until more profits are made
+	err = s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	distributionsAfter, err := s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(distributions, distributionsAfter)
+}
+
+// TestDistributeProfitSharesUnpaid tests that the share of a profit share recipient that cannot be sent is carried
+// forward to the next distribution without preventing the other recipients from being sent their shares
+func (s *KeeperTestSuite) TestDistributeProfitSharesUnpaid() {
+	denom := "unpaid"
+	profit := sdk.NewCoin(denom, osmomath.NewInt(1_000_000))
+
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitShareRecipients = []types.ProfitShareRecipient{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Share: osmomath.NewDecWithPrec(1, 1)},
+		{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(2, 1)},
+		{Recipient: types.ProfitShareRecipientStakers, Share: osmomath.NewDecWithPrec(3, 1)},
+		{Recipient: types.ProfitShareRecipientPoolLPs, Share: osmomath.NewDecWithPrec(2, 1)},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	// The profit is not held in the module account, so none of the recipients can be sent its share
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, profit))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err := s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+
+	unpaidEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == types.TypeEvtProfitShareUnpaid {
+			unpaidEvents++
+		}
+	}
+	s.Require().Equal(4, unpaidEvents)
+
+	unpaidProfitShares, err := s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	// The share of the pool LPs is carried forward as part of the community pool share
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(300_000))}},
+		{Recipient: types.ProfitShareRecipientBurn, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(200_000))}},
+		{Recipient: types.ProfitShareRecipientStakers, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(300_000))}},
+	}, unpaidProfitShares)
+
+	distributions, err := s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(distributions)
+
+	// Only the second profit is held in the module account, so the module account runs out of funds when sending the
+	// share of the stakers, which is carried forward again while the pool LPs are still sent their share of the second
+	// profit only
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(profit)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, profit))
+
+	err = s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+
+	distributions, err = s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(400_000))}},
+		{Recipient: types.ProfitShareRecipientBurn, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(400_000))}},
+		{Recipient: types.ProfitShareRecipientPoolLPs, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(200_000))}},
+	}, distributions)
+
+	unpaidProfitShares, err = s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientStakers, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(600_000))}},
+	}, unpaidProfitShares)
+
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, denom).IsZero())
+}
+
+// TestDistributeProfitSharesPoolLPsCarriedForward tests that the share of the pool LPs that cannot be sent to the pools
+// traded on is sent to the community pool, rather than being split by the trades of the next distribution
+func (s *KeeperTestSuite) TestDistributeProfitSharesPoolLPsCarriedForward() {
+	denom := "carried"
+	noGaugePoolId := uint64(9999)
+
+	params := s.App.ProtoRevKeeper.GetParams(s.Ctx)
+	params.ProfitShareRecipients = []types.ProfitShareRecipient{
+		{Recipient: types.ProfitShareRecipientPoolLPs, Share: osmomath.NewDecWithPrec(5, 1)},
+	}
+	s.App.ProtoRevKeeper.SetParams(s.Ctx, params)
+
+	gaugeIds := []uint64{}
+	for _, poolId := range routeTwoAssetSameWeight.PoolIds() {
+		gaugeId, err := s.App.PoolIncentivesKeeper.GetInternalGaugeIDForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		gaugeIds = append(gaugeIds, gaugeId)
+	}
+	gaugeCoins := func() []osmomath.Int {
+		coins := []osmomath.Int{}
+		for _, gaugeId := range gaugeIds {
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeId)
+			s.Require().NoError(err)
+			coins = append(coins, gauge.Coins.AmountOf(denom))
+		}
+		return coins
+	}
+	communityPool := func() osmomath.Dec {
+		return s.App.DistrKeeper.GetFeePool(s.Ctx).CommunityPool.AmountOf(denom)
+	}
+
+	// Half of the trades are executed on a pool without an internal gauge, so its part of the pool LPs share is sent to
+	// the community pool even though the community pool is not a profit share recipient
+	profit := sdk.NewCoin(denom, osmomath.NewInt(1_000_000))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(profit)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, profit))
+	s.App.ProtoRevKeeper.SetPoolTradeCount(s.Ctx, noGaugePoolId, 2)
+
+	gaugeCoinsBefore := gaugeCoins()
+	communityPoolBefore := communityPool()
+
+	err := s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+
+	for i, coins := range gaugeCoins() {
+		s.Require().Equal(gaugeCoinsBefore[i].Add(osmomath.NewInt(125_000)), coins)
+	}
+	s.Require().Equal(communityPoolBefore.Add(osmomath.NewDec(250_000)), communityPool())
+
+	distributions, err := s.App.ProtoRevKeeper.GetAllProfitSharesDistributed(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(250_000))}},
+		{Recipient: types.ProfitShareRecipientPoolLPs, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(250_000))}},
+	}, distributions)
+
+	// The module account does not hold enough funds to send the pool LPs share of a profit that is not minted, so it is
+	// carried forward as part of the community pool share
+	unfundedProfit := sdk.NewCoin(denom, osmomath.NewInt(2_000_000))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, unfundedProfit))
+
+	err = s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+
+	unpaidProfitShares, err := s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ProfitShareDistribution{
+		{Recipient: types.ProfitShareRecipientCommunityPool, Amount: []sdk.Coin{sdk.NewCoin(denom, osmomath.NewInt(1_000_000))}},
+	}, unpaidProfitShares)
+
+	// Once the module account holds enough funds, the carried forward share is sent to the community pool while the
+	// pool LPs are only sent their share of the new profit
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(unfundedProfit, profit)))
+	s.Require().NoError(s.App.ProtoRevKeeper.UpdatePendingProfitShares(s.Ctx, routeTwoAssetSameWeight, profit))
+
+	gaugeCoinsBefore = gaugeCoins()
+	communityPoolBefore = communityPool()
+
+	err = s.App.ProtoRevKeeper.DistributeProfitShares(s.Ctx)
+	s.Require().NoError(err)
+
+	for i, coins := range gaugeCoins() {
+		s.Require().Equal(gaugeCoinsBefore[i].Add(osmomath.NewInt(250_000)), coins)
+	}
+	s.Require().Equal(communityPoolBefore.Add(osmomath.NewDec(1_000_000)), communityPool())
+
+	unpaidProfitShares, err = s.App.ProtoRevKeeper.GetAllUnpaidProfitShares(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(unpaidProfitShares)
+}
@@ -168,6 +168,47 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// GetPoolGraphEdge returns the id of the highest liquidity pool between the two denoms in the pool graph
+func (k Keeper) GetPoolGraphEdge(ctx sdk.Context, denom, otherDenom string) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyPrefixPoolGraphEdge(denom, otherDenom)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, types.NoPoolForDenomPairError{BaseDenom: denom, MatchDenom: otherDenom}
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetPoolGraphEdge sets the id of the highest liquidity pool between the two denoms in the pool graph, in both directions
+func (k Keeper) SetPoolGraphEdge(ctx sdk.Context, denomA, denomB string, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetKeyPrefixPoolGraphEdge(denomA, denomB), sdk.Uint64ToBigEndian(poolId))
+	store.Set(types.GetKeyPrefixPoolGraphEdge(denomB, denomA), sdk.Uint64ToBigEndian(poolId))
+}
+
+// IteratePoolGraphNeighbors iterates over the denoms paired with the denom in the pool graph, in lexicographic order,
+// along with the ids of the pools pairing them. The iteration stops when cb returns true.
+func (k Keeper) IteratePoolGraphNeighbors(ctx sdk.Context, denom string, cb func(neighbor string, poolId uint64) (stop bool)) {
+	keyPrefix := types.GetKeyPrefixPoolGraphNeighbors(denom)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		neighbor := string(iterator.Key()[len(keyPrefix):])
+		if cb(neighbor, sdk.BigEndianToUint64(iterator.Value())) {
+			return
+		}
+	}
+}
+
+// DeletePoolGraph deletes all the edges of the pool graph
+func (k Keeper) DeletePoolGraph(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixPoolGraph)
+}
+
 // SetSwapsToBackrun sets the swaps to backrun, updated via hooks
 func (k Keeper) SetSwapsToBackrun(ctx sdk.Context, swapsToBackrun types.Route) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSwapsToBackrun)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -11,9 +13,10 @@ import (
 var zeroInt = osmomath.ZeroInt()
 
 // IterateRoutes checks the profitability of every single route that is passed in
-// and returns the optimal route if there is one
-func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes) {
+// and returns the optimal route, along with the pool points it consumes, if there is one
+func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, poolmanagertypes.SwapAmountInRoutes, uint64) {
 	var optimalRoute poolmanagertypes.SwapAmountInRoutes
+	var optimalRoutePoolPoints uint64
 	var maxProfitInputCoin sdk.Coin
 	maxProfit := osmomath.ZeroInt()
 
@@ -42,13 +45,14 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 			// Select the optimal route King of the Hill style (route with the highest profit will be executed)
 			if profit.GT(maxProfit) {
 				optimalRoute = routes[index].Route
+				optimalRoutePoolPoints = routes[index].PoolPoints
 				maxProfit = profit
 				maxProfitInputCoin = inputCoin
 			}
 		}
 	}
 
-	return maxProfitInputCoin, maxProfit, optimalRoute
+	return maxProfitInputCoin, maxProfit, optimalRoute, optimalRoutePoolPoints
 }
 
 // ConvertProfits converts the profit denom to uosmo to allow for a fair comparison of profits
@@ -106,23 +110,17 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 	return tokenIn, profit, nil
 }
 
-// FindMaxProfitRoute runs a binary search to find the max profit for a given route
+// FindMaxProfitForRoute finds the max profit for a given route. The optimal amount in is first searched on the swap curves
+// of the route, which only needs a single simulated swap to confirm the profit. The binary search is used as a fallback if
+// the curves cannot be built for the route or do not yield a profit.
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingTxPoolPoints, remainingBlockPoolPoints *uint64) (sdk.Coin, osmomath.Int, error) {
-	// Track the tokenIn amount/denom and the profit
-	tokenIn := sdk.Coin{}
-	profit := osmomath.ZeroInt()
-
-	// Track the left and right bounds of the binary search
-	curLeft := osmomath.OneInt()
-	curRight := types.MaxInputAmount
-
 	// Input denom used for cyclic arbitrage
 	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
 
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
-	// If there is no profit, then we can return early and not run the binary search.
-	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
+	// If there is no profit, then we can return early and not run the search.
+	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, route.StepSize, route.Route)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	} else if minInProfit.LTE(osmomath.ZeroInt()) {
@@ -138,8 +136,118 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
 
+	tokenIn, profit, err := k.FindMaxProfitWithSwapCurves(ctx, route, inputDenom)
+	if err == nil && profit.IsPositive() {
+		return tokenIn, profit, nil
+	}
+	if err != nil {
+		k.Logger(ctx).Debug("Falling back to binary search for route: " + err.Error())
+	}
+
+	return k.FindMaxProfitWithBinarySearch(ctx, route, inputDenom)
+}
+
+// FindMaxProfitWithSwapCurves searches for the optimal amount in on the swap curves of the route, without simulating any swap, and
+// then simulates the swap of the optimal amount in to confirm the profit. The profit of a cyclic route is concave in the amount in,
+// so that the optimal amount in is found with a fibonacci search. Amounts in beyond the curves are considered unprofitable.
+func (k Keeper) FindMaxProfitWithSwapCurves(ctx sdk.Context, route RouteMetaData, inputDenom string) (sdk.Coin, osmomath.Int, error) {
+	curve, err := k.BuildRouteCurve(ctx, route.Route, inputDenom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+
+	curveProfit := func(amount osmomath.Int) (osmomath.Int, bool) {
+		amountIn := amount.Mul(route.StepSize)
+		amountOut, err := curve.AmountOut(amountIn)
+		if err != nil {
+			return osmomath.Int{}, false
+		}
+		return amountOut.Sub(amountIn), true
+	}
+
+	// The search range is bounded in the same way as the binary search, so that concentrated pools are not moved across
+	// more than the max ticks crossed
+	curRight, err := k.CalculateUpperBoundForSearch(ctx, route, inputDenom)
+	if err != nil {
+		return sdk.Coin{}, osmomath.ZeroInt(), err
+	}
+
+	optimalAmount, ok := fibonacciSearch(osmomath.OneInt(), curRight, curveProfit)
+	if !ok {
+		return sdk.Coin{}, osmomath.ZeroInt(), fmt.Errorf("no amount in on the swap curves of route %v", route.Route.PoolIds())
+	}
+
+	return k.EstimateMultihopProfit(ctx, inputDenom, optimalAmount.Mul(route.StepSize), route.Route)
+}
+
+// fibonacciSearch returns the amount in [left, right] with the max profit, for a profit that increases and then decreases
+// with the amount. The search keeps two amounts inside the range that split it at consecutive fibonacci numbers, so that
+// one of them is reused after the range is narrowed and the profit is computed once per step. Amounts for which the
+// profit is not defined are considered unprofitable, and ties are resolved towards the larger amount as in the binary
+// search. Returns false if the profit is not defined for any amount of the range.
+func fibonacciSearch(left, right osmomath.Int, profit func(osmomath.Int) (osmomath.Int, bool)) (osmomath.Int, bool) {
+	// The search runs on [left, left + fibs[k]], which can extend past right
+	fibs := []osmomath.Int{osmomath.OneInt(), osmomath.OneInt(), osmomath.NewInt(2)}
+	for fibs[len(fibs)-1].LT(right.Sub(left)) {
+		fibs = append(fibs, fibs[len(fibs)-1].Add(fibs[len(fibs)-2]))
+	}
+
+	profitInRange := func(amount osmomath.Int) (osmomath.Int, bool) {
+		if amount.GT(right) {
+			return osmomath.Int{}, false
+		}
+		return profit(amount)
+	}
+	isBetter := func(amountProfit osmomath.Int, ok bool, thanProfit osmomath.Int, thanOk bool) bool {
+		return ok && (!thanOk || thanProfit.LTE(amountProfit))
+	}
+
+	k := len(fibs) - 1
+	lower, upper := left.Add(fibs[k-2]), left.Add(fibs[k-1])
+	lowerProfit, lowerOk := profitInRange(lower)
+	upperProfit, upperOk := profitInRange(upper)
+
+	for ; k > 2; k-- {
+		if isBetter(upperProfit, upperOk, lowerProfit, lowerOk) {
+			// The max is not below the lower amount, which becomes the start of the range
+			left = lower
+			lower, lowerProfit, lowerOk = upper, upperProfit, upperOk
+			upper = left.Add(fibs[k-2])
+			upperProfit, upperOk = profitInRange(upper)
+		} else {
+			// The max is not above the upper amount, which becomes the end of the range
+			upper, upperProfit, upperOk = lower, lowerProfit, lowerOk
+			lower = left.Add(fibs[k-3])
+			lowerProfit, lowerOk = profitInRange(lower)
+		}
+	}
+
+	// The range is down to left, lower = left + 1 and left + 2
+	best := left
+	bestProfit, bestOk := profitInRange(left)
+	if isBetter(lowerProfit, lowerOk, bestProfit, bestOk) {
+		best, bestProfit, bestOk = lower, lowerProfit, lowerOk
+	}
+	last := left.Add(osmomath.NewInt(2))
+	if lastProfit, lastOk := profitInRange(last); isBetter(lastProfit, lastOk, bestProfit, bestOk) {
+		best, bestOk = last, lastOk
+	}
+
+	return best, bestOk
+}
+
+// FindMaxProfitWithBinarySearch runs a binary search to find the max profit for a given route, simulating two swaps per iteration
+func (k Keeper) FindMaxProfitWithBinarySearch(ctx sdk.Context, route RouteMetaData, inputDenom string) (sdk.Coin, osmomath.Int, error) {
+	// Track the tokenIn amount/denom and the profit
+	tokenIn := sdk.Coin{}
+	profit := osmomath.ZeroInt()
+
+	// Track the left and right bounds of the binary search
+	curLeft := osmomath.OneInt()
+	curRight := types.MaxInputAmount
+
 	// Update the search range if the max input amount is too small/large
-	curLeft, curRight, err = k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
+	curLeft, curRight, err := k.UpdateSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
 	if err != nil {
 		return sdk.Coin{}, osmomath.ZeroInt(), err
 	}
@@ -348,7 +456,7 @@ func (k Keeper) ExtendSearchRangeIfNeeded(
 }
 
 // ExecuteTrade inputs a route, amount in, and rebalances the pool
-func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints uint64) error {
+func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, remainingTxPoolPoints, remainingBlockPoolPoints, poolPointsUsed uint64) error {
 	// Get the module address which will execute the trade
 	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
@@ -376,11 +484,22 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 		return err
 	}
 
+	// Record the trade in the trade history
+	if err = k.RecordTrade(ctx, route, inputCoin, pool, profit, poolPointsUsed); err != nil {
+		return err
+	}
+
 	// Send the developer fee to the developer address
-	if err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit)); err != nil {
+	devFee, err := k.SendDeveloperFee(ctx, sdk.NewCoin(inputCoin.Denom, profit))
+	if err != nil {
 		ctx.Logger().Error("failed to send developer fee: " + err.Error())
 	}
 
+	// Hold the profit left after the developer fee until it is shared with the profit share recipients
+	if err = k.UpdatePendingProfitShares(ctx, route, sdk.NewCoin(inputCoin.Denom, profit.Sub(devFee.Amount))); err != nil {
+		return err
+	}
+
 	// Create and emit the backrun event and add it to the context
 	EmitBackrunEvent(ctx, pool, inputCoin, profit, tokenOutAmount, remainingTxPoolPoints, remainingBlockPoolPoints)
 
@@ -493,6 +493,7 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 		pool := protorevtypes.SwapToBackrun{}
 		txPoolPointsRemaining := uint64(100)
 		blockPoolPointsRemaining := uint64(100)
+		poolPointsUsed := uint64(6)
 
 		err := s.App.ProtoRevKeeper.ExecuteTrade(
 			s.Ctx,
@@ -501,6 +502,7 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			pool,
 			txPoolPointsRemaining,
 			blockPoolPointsRemaining,
+			poolPointsUsed,
 		)
 
 		if test.expectPass {
@@ -523,6 +525,17 @@ func (s *KeeperTestSuite) TestExecuteTrade() {
 			s.Require().NoError(err)
 			s.Require().Equal(test.expectedNumOfTrades, totalNumberOfTrades)
 
+			// Check the trade was recorded in the trade history
+			tradeHistory, _, err := s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedNumOfTrades.Int64(), int64(len(tradeHistory)))
+			record := tradeHistory[len(tradeHistory)-1]
+			s.Require().Equal(s.Ctx.BlockHeight(), record.Height)
+			s.Require().Equal(test.param.inputCoin, record.TokenIn)
+			s.Require().Equal(test.param.expectedProfit, record.Profit)
+			s.Require().Equal(poolPointsUsed, record.PoolPoints)
+			s.Require().Equal(test.param.route.PoolIds()[0], record.Route[0].Pool)
+
 			// Check the dev account was paid the correct amount
 			developerAccBalance := s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, devAccount, test.arbDenom)
 			s.Require().Equal(test.param.expectedProfit.MulRaw(types.ProfitSplitPhase1).QuoRaw(100), developerAccBalance.Amount)
@@ -622,7 +635,7 @@ func (s *KeeperTestSuite) TestIterateRoutes() {
 			for i, route := range test.params.routes {
 				routes[i] = protorevtypes.RouteMetaData{
 					Route:      route,
-					PoolPoints: 0,
+					PoolPoints: uint64(len(route)),
 					StepSize:   osmomath.NewInt(1_000_000),
 				}
 			}
@@ -630,11 +643,12 @@ func (s *KeeperTestSuite) TestIterateRoutes() {
 			remainingPoolPoints := uint64(40)
 			remainingBlockPoolPoints := uint64(40)
 
-			maxProfitInputCoin, maxProfitAmount, optimalRoute := s.App.ProtoRevKeeper.IterateRoutes(s.Ctx, routes, &remainingPoolPoints, &remainingBlockPoolPoints)
+			maxProfitInputCoin, maxProfitAmount, optimalRoute, optimalRoutePoolPoints := s.App.ProtoRevKeeper.IterateRoutes(s.Ctx, routes, &remainingPoolPoints, &remainingBlockPoolPoints)
 			if test.expectPass {
 				s.Require().Equal(test.params.expectedMaxProfitAmount, maxProfitAmount)
 				s.Require().Equal(test.params.expectedMaxProfitInputCoin, maxProfitInputCoin)
 				s.Require().Equal(test.params.expectedOptimalRoute, optimalRoute)
+				s.Require().Equal(uint64(len(test.params.expectedOptimalRoute)), optimalRoutePoolPoints)
 			}
 		})
 	}
@@ -100,13 +100,25 @@ func (k Keeper) BuildHighestLiquidityRoutes(ctx sdk.Context, tokenIn, tokenOut s
 	// have priority over those that are later in the list. This way we can build routes that are more likely to succeed and bring in
 	// higher profits.
 	for _, baseDenom := range baseDenoms {
+		baseDenomRoutes := make([]RouteMetaData, 0)
 		if newRoute, err := k.BuildHighestLiquidityRoute(ctx, baseDenom, tokenIn, tokenOut, poolId); err == nil {
-			routes = append(routes, newRoute)
+			baseDenomRoutes = append(baseDenomRoutes, newRoute)
 		}
 
 		if newRoute, err := k.BuildTwoPoolRoute(ctx, baseDenom, tokenIn, tokenOut, poolId); err == nil {
-			routes = append(routes, newRoute)
+			baseDenomRoutes = append(baseDenomRoutes, newRoute)
+		}
+
+		// Append the cyclic routes discovered by searching the pool graph that were not already built, if enabled for the base denom
+		if cyclicRoutes, err := k.BuildCyclicRoutes(ctx, baseDenom, tokenIn, tokenOut, poolId); err == nil {
+			for _, cyclicRoute := range cyclicRoutes {
+				if !containsRoute(baseDenomRoutes, cyclicRoute.Route) {
+					baseDenomRoutes = append(baseDenomRoutes, cyclicRoute)
+				}
+			}
 		}
+
+		routes = append(routes, baseDenomRoutes...)
 	}
 
 	return routes, nil
@@ -218,6 +230,27 @@ func (k Keeper) BuildTwoPoolRoute(
 	}, nil
 }
 
+// containsRoute returns true if one of the routes trades on the same pools for the same denoms as the route.
+func containsRoute(routes []RouteMetaData, route poolmanagertypes.SwapAmountInRoutes) bool {
+	for _, r := range routes {
+		if len(r.Route) != len(route) {
+			continue
+		}
+
+		equal := true
+		for i := range route {
+			if r.Route[i] != route[i] {
+				equal = false
+				break
+			}
+		}
+		if equal {
+			return true
+		}
+	}
+	return false
+}
+
 // CalculateRoutePoolPoints calculates the number of pool points that will be consumed by a route when simulating and executing trades. This
 // is only added to the global pool point counter if the route simulated is minimally profitable i.e. it will make a profit.
 func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) (uint64, error) {
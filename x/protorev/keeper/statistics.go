@@ -1,11 +1,16 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
@@ -277,3 +282,78 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 
 	return nil
 }
+
+// RecordTrade stores the record of a trade executed by the module after a swap, keyed by the current height and the
+// number of trades executed by the module so far
+func (k Keeper) RecordTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, pool SwapToBackrun, profit osmomath.Int, poolPointsUsed uint64) error {
+	numberOfTrades, err := k.GetNumberOfTrades(ctx)
+	if err != nil {
+		return err
+	}
+
+	record := types.TradeRecord{
+		Height: ctx.BlockHeight(),
+		TxHash: strings.ToUpper(hex.EncodeToString(tmhash.Sum(ctx.TxBytes()))),
+		UserSwap: types.Trade{
+			Pool:     pool.PoolId,
+			TokenIn:  pool.TokenInDenom,
+			TokenOut: pool.TokenOutDenom,
+		},
+		Route:      newRouteFromSwapAmountInRoutes(inputCoin.Denom, RouteMetaData{Route: route}).Trades,
+		TokenIn:    inputCoin,
+		Profit:     profit,
+		PoolPoints: poolPointsUsed,
+	}
+
+	bz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixTradeRecord(record.Height, numberOfTrades.Uint64()), bz)
+
+	return nil
+}
+
+// GetTradeHistory returns the records of the trades executed in the latest blocks, sorted by height
+func (k Keeper) GetTradeHistory(ctx sdk.Context, pagination *query.PageRequest) ([]types.TradeRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTradeHistory)
+
+	records := []types.TradeRecord{}
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		record := types.TradeRecord{}
+		if err := record.Unmarshal(value); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return records, pageRes, nil
+}
+
+// PruneTradeHistory deletes the records of the trades executed at least TradeHistoryRetentionBlocks blocks ago
+func (k Keeper) PruneTradeHistory(ctx sdk.Context) {
+	pruneHeight := ctx.BlockHeight() - types.TradeHistoryRetentionBlocks
+	if pruneHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixTradeHistory, types.GetKeyPrefixTradeHistoryByHeight(pruneHeight+1))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
 )
 
@@ -273,3 +274,59 @@ func (s *KeeperTestSuite) TestGetSetCyclicArbProfitTrackerStartHeight() {
 		})
 	}
 }
+
+// TestRecordAndPruneTradeHistory tests RecordTrade, GetTradeHistory and PruneTradeHistory
+func (s *KeeperTestSuite) TestRecordAndPruneTradeHistory() {
+	// Should be empty by default
+	tradeHistory, _, err := s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(tradeHistory)
+
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: 1, TokenOutDenom: "Atom"}, {PoolId: 2, TokenOutDenom: types.OsmosisDenomination}}
+	pool := keeper.SwapToBackrun{PoolId: 3, TokenInDenom: "akash", TokenOutDenom: "Atom"}
+	inputCoin := sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1000))
+
+	// Pseudo execute a trade at two consecutive heights
+	startHeight := s.Ctx.BlockHeight()
+	for _, height := range []int64{startHeight, startHeight + 1} {
+		ctx := s.Ctx.WithBlockHeight(height)
+		err = s.App.ProtoRevKeeper.UpdateStatistics(ctx, route, types.OsmosisDenomination, osmomath.NewInt(100))
+		s.Require().NoError(err)
+		err = s.App.ProtoRevKeeper.RecordTrade(ctx, route, inputCoin, pool, osmomath.NewInt(100), 5)
+		s.Require().NoError(err)
+	}
+
+	tradeHistory, _, err = s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(tradeHistory, 2)
+	s.Require().Equal(types.TradeRecord{
+		Height:   startHeight,
+		TxHash:   tradeHistory[0].TxHash,
+		UserSwap: types.Trade{Pool: 3, TokenIn: "akash", TokenOut: "Atom"},
+		Route: []types.Trade{
+			{Pool: 1, TokenIn: types.OsmosisDenomination, TokenOut: "Atom"},
+			{Pool: 2, TokenIn: "Atom", TokenOut: types.OsmosisDenomination},
+		},
+		TokenIn:    inputCoin,
+		Profit:     osmomath.NewInt(100),
+		PoolPoints: 5,
+	}, tradeHistory[0])
+	s.Require().Equal(startHeight+1, tradeHistory[1].Height)
+
+	// Records are kept until they are TradeHistoryRetentionBlocks blocks old
+	s.App.ProtoRevKeeper.PruneTradeHistory(s.Ctx.WithBlockHeight(startHeight + types.TradeHistoryRetentionBlocks - 1))
+	tradeHistory, _, err = s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(tradeHistory, 2)
+
+	s.App.ProtoRevKeeper.PruneTradeHistory(s.Ctx.WithBlockHeight(startHeight + types.TradeHistoryRetentionBlocks))
+	tradeHistory, _, err = s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(tradeHistory, 1)
+	s.Require().Equal(startHeight+1, tradeHistory[0].Height)
+
+	s.App.ProtoRevKeeper.PruneTradeHistory(s.Ctx.WithBlockHeight(startHeight + types.TradeHistoryRetentionBlocks + 1))
+	tradeHistory, _, err = s.App.ProtoRevKeeper.GetTradeHistory(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(tradeHistory)
+}
@@ -0,0 +1,289 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v22/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v22/x/gamm/pool-models/stableswap"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+)
+
+// SwapCurve is the input/output curve of a swap on a pool in a given direction, built once from the pool state so that
+// the amount out of any amount in can be computed without reading the store.
+type SwapCurve interface {
+	// AmountOut returns the amount out for the amount in, after the taker fee and spread factor are charged.
+	// Returns error if the amount in is beyond the part of the curve that was built.
+	AmountOut(amountIn osmomath.Int) (osmomath.Int, error)
+}
+
+// RouteCurve is the composition of the swap curves of every hop of a route.
+type RouteCurve []SwapCurve
+
+// AmountOut returns the amount out of the route for the amount in, chaining the output of each hop as the input of the next.
+func (r RouteCurve) AmountOut(amountIn osmomath.Int) (osmomath.Int, error) {
+	amount := amountIn
+	for _, curve := range r {
+		amountOut, err := curve.AmountOut(amount)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		if !amountOut.IsPositive() {
+			return osmomath.Int{}, fmt.Errorf("token amount must be positive")
+		}
+		amount = amountOut
+	}
+	return amount, nil
+}
+
+// balancerSwapCurve is the closed-form swap curve of a balancer pool, built from the balances and weights of the
+// two pool assets: amountOut = balanceOut * (1 - (balanceIn / (balanceIn + amountIn)) ^ (weightIn / weightOut)).
+type balancerSwapCurve struct {
+	balanceIn    osmomath.Dec
+	weightIn     osmomath.Dec
+	balanceOut   osmomath.Dec
+	weightOut    osmomath.Dec
+	spreadFactor osmomath.Dec
+	takerFee     osmomath.Dec
+}
+
+// AmountOut implements SwapCurve. The amount out is rounded in the same way as the balancer pool.
+func (c balancerSwapCurve) AmountOut(amountIn osmomath.Int) (osmomath.Int, error) {
+	amountInAfterFees := amountInAfterTakerFee(amountIn, c.takerFee).ToLegacyDec().Mul(osmomath.OneDec().Sub(c.spreadFactor))
+	balanceInRatio := c.balanceIn.Quo(c.balanceIn.Add(amountInAfterFees))
+
+	amountOut := c.balanceOut.Mul(osmomath.OneDec().Sub(osmomath.Pow(balanceInRatio, c.weightIn.Quo(c.weightOut)))).TruncateInt()
+	if !amountOut.IsPositive() {
+		return osmomath.Int{}, fmt.Errorf("token amount must be positive")
+	}
+	return amountOut, nil
+}
+
+// stableswapSwapCurve is the swap curve of a stableswap pool. The stableswap invariant has no closed-form amount out,
+// so the curve solves it numerically on the pool read when the curve is built, which does not read the store.
+type stableswapSwapCurve struct {
+	pool          *stableswap.Pool
+	tokenInDenom  string
+	tokenOutDenom string
+	spreadFactor  osmomath.Dec
+	takerFee      osmomath.Dec
+}
+
+// AmountOut implements SwapCurve.
+func (c stableswapSwapCurve) AmountOut(amountIn osmomath.Int) (osmomath.Int, error) {
+	tokenIn := sdk.NewCoin(c.tokenInDenom, amountInAfterTakerFee(amountIn, c.takerFee))
+
+	// The context is not used by the stableswap pool.
+	tokenOut, err := c.pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(tokenIn), c.tokenOutDenom, c.spreadFactor)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	return tokenOut.Amount, nil
+}
+
+// clTickRange is a range of sqrt prices over which the liquidity of a concentrated pool is constant.
+type clTickRange struct {
+	sqrtPriceStart osmomath.BigDec
+	sqrtPriceEnd   osmomath.BigDec
+	liquidity      osmomath.BigDec
+}
+
+// clSwapCurve is the piecewise swap curve of a concentrated liquidity pool, made of one piece per tick range
+// from the current sqrt price up to the max number of initialized ticks crossed.
+type clSwapCurve struct {
+	zeroForOne   bool
+	tickRanges   []clTickRange
+	spreadFactor osmomath.Dec
+	takerFee     osmomath.Dec
+}
+
+// AmountOut implements SwapCurve. Returns error if the amount in crosses more ticks than the curve was built with.
+func (c clSwapCurve) AmountOut(amountIn osmomath.Int) (osmomath.Int, error) {
+	// The spread reward is charged on the amount in consumed by every tick range, so that it can be charged upfront
+	amountRemaining := osmomath.BigDecFromDec(amountInAfterTakerFee(amountIn, c.takerFee).ToLegacyDec().MulTruncate(osmomath.OneDec().Sub(c.spreadFactor)))
+	amountOut := osmomath.ZeroBigDec()
+
+	for _, tickRange := range c.tickRanges {
+		if tickRange.liquidity.IsZero() {
+			continue
+		}
+
+		// Consume the whole tick range if the amount remaining is enough to reach its end
+		amountInToEnd := c.amountIn(tickRange.liquidity, tickRange.sqrtPriceStart, tickRange.sqrtPriceEnd)
+		if amountRemaining.GTE(amountInToEnd) {
+			amountOut = amountOut.Add(c.amountOut(tickRange.liquidity, tickRange.sqrtPriceStart, tickRange.sqrtPriceEnd))
+			amountRemaining = amountRemaining.Sub(amountInToEnd)
+			continue
+		}
+
+		var sqrtPriceNext osmomath.BigDec
+		if c.zeroForOne {
+			sqrtPriceNext = math.GetNextSqrtPriceFromAmount0InRoundingUp(tickRange.sqrtPriceStart, tickRange.liquidity, amountRemaining)
+		} else {
+			sqrtPriceNext = math.GetNextSqrtPriceFromAmount1InRoundingDown(tickRange.sqrtPriceStart, tickRange.liquidity, amountRemaining)
+		}
+		amountOut = amountOut.Add(c.amountOut(tickRange.liquidity, tickRange.sqrtPriceStart, sqrtPriceNext))
+		return amountOut.Dec().TruncateInt(), nil
+	}
+
+	if amountRemaining.IsPositive() {
+		return osmomath.Int{}, fmt.Errorf("amount in %s crosses more than the %d tick ranges of the swap curve", amountIn, len(c.tickRanges))
+	}
+	return amountOut.Dec().TruncateInt(), nil
+}
+
+// amountIn returns the amount in needed to move the sqrt price between the two sqrt prices.
+func (c clSwapCurve) amountIn(liquidity, sqrtPriceStart, sqrtPriceEnd osmomath.BigDec) osmomath.BigDec {
+	if c.zeroForOne {
+		return math.CalcAmount0Delta(liquidity, sqrtPriceEnd, sqrtPriceStart, true)
+	}
+	return math.CalcAmount1Delta(liquidity, sqrtPriceEnd, sqrtPriceStart, true)
+}
+
+// amountOut returns the amount out when moving the sqrt price between the two sqrt prices.
+func (c clSwapCurve) amountOut(liquidity, sqrtPriceStart, sqrtPriceEnd osmomath.BigDec) osmomath.BigDec {
+	if c.zeroForOne {
+		return math.CalcAmount1Delta(liquidity, sqrtPriceEnd, sqrtPriceStart, false)
+	}
+	return math.CalcAmount0Delta(liquidity, sqrtPriceEnd, sqrtPriceStart, false)
+}
+
+// amountInAfterTakerFee returns the amount in left once the taker fee is charged, in the same way as the pool manager.
+func amountInAfterTakerFee(amountIn osmomath.Int, takerFee osmomath.Dec) osmomath.Int {
+	return amountIn.ToLegacyDec().MulTruncate(osmomath.OneDec().Sub(takerFee)).TruncateInt()
+}
+
+// BuildRouteCurve builds the swap curve of every hop of the route. Balancer pools use their closed-form curve, stableswap
+// pools solve their invariant on the pool state and concentrated pools use a piecewise curve built from the liquidity net
+// of the next initialized ticks, up to the max ticks crossed. Returns error if the route trades on any other pool type.
+func (k Keeper) BuildRouteCurve(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputDenom string) (RouteCurve, error) {
+	poolInfo := k.GetInfoByPoolType(ctx)
+
+	curves := make(RouteCurve, 0, len(route))
+	tokenInDenom := inputDenom
+	for _, hop := range route {
+		takerFee, err := k.poolmanagerKeeper.GetTradingPairTakerFee(ctx, hop.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := k.poolmanagerKeeper.GetPool(ctx, hop.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		var curve SwapCurve
+		switch pool.GetType() {
+		case poolmanagertypes.Balancer, poolmanagertypes.Stableswap:
+			curve, err = k.buildCFMMSwapCurve(ctx, hop.PoolId, tokenInDenom, hop.TokenOutDenom, takerFee)
+		case poolmanagertypes.Concentrated:
+			curve, err = k.buildCLSwapCurve(ctx, hop.PoolId, tokenInDenom, poolInfo.Concentrated.MaxTicksCrossed, takerFee)
+		default:
+			err = fmt.Errorf("no swap curve for pool %d of type %s", hop.PoolId, pool.GetType())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		curves = append(curves, curve)
+		tokenInDenom = hop.TokenOutDenom
+	}
+
+	return curves, nil
+}
+
+// buildCFMMSwapCurve builds the swap curve of a balancer or stableswap pool.
+func (k Keeper) buildCFMMSwapCurve(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, takerFee osmomath.Dec) (SwapCurve, error) {
+	pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pool := pool.(type) {
+	case *balancer.Pool:
+		poolAssetIn, err := pool.GetPoolAsset(tokenInDenom)
+		if err != nil {
+			return nil, err
+		}
+		poolAssetOut, err := pool.GetPoolAsset(tokenOutDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		return balancerSwapCurve{
+			balanceIn:    poolAssetIn.Token.Amount.ToLegacyDec(),
+			weightIn:     poolAssetIn.Weight.ToLegacyDec(),
+			balanceOut:   poolAssetOut.Token.Amount.ToLegacyDec(),
+			weightOut:    poolAssetOut.Weight.ToLegacyDec(),
+			spreadFactor: pool.GetSpreadFactor(ctx),
+			takerFee:     takerFee,
+		}, nil
+	case *stableswap.Pool:
+		return stableswapSwapCurve{
+			pool:          pool,
+			tokenInDenom:  tokenInDenom,
+			tokenOutDenom: tokenOutDenom,
+			spreadFactor:  pool.GetSpreadFactor(ctx),
+			takerFee:      takerFee,
+		}, nil
+	default:
+		return nil, fmt.Errorf("no swap curve for pool %d of type %T", poolId, pool)
+	}
+}
+
+// buildCLSwapCurve builds the piecewise swap curve of a concentrated pool across the next maxTicksCrossed initialized ticks.
+func (k Keeper) buildCLSwapCurve(ctx sdk.Context, poolId uint64, tokenInDenom string, maxTicksCrossed uint64, takerFee osmomath.Dec) (SwapCurve, error) {
+	pool, err := k.concentratedLiquidityKeeper.GetConcentratedPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	spreadFactor, _, err := k.concentratedLiquidityKeeper.GetEffectiveSpreadFactor(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidityNets, err := k.concentratedLiquidityKeeper.GetNumNextInitializedTicks(ctx, poolId, maxTicksCrossed, tokenInDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	zeroForOne := tokenInDenom == pool.GetToken0()
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+	liquidity := osmomath.BigDecFromDec(pool.GetLiquidity())
+
+	tickRanges := make([]clTickRange, 0, len(liquidityNets))
+	for _, liquidityNet := range liquidityNets {
+		nextSqrtPrice, err := math.TickToSqrtPrice(liquidityNet.TickIndex)
+		if err != nil {
+			return nil, err
+		}
+
+		tickRanges = append(tickRanges, clTickRange{
+			sqrtPriceStart: sqrtPrice,
+			sqrtPriceEnd:   nextSqrtPrice,
+			liquidity:      liquidity,
+		})
+
+		// The liquidity net is added when crossing a tick from left to right, and subtracted from right to left.
+		// It includes the liquidity of the limit orders starting or ending at the tick, which the swap converts
+		// like any other liquidity, so that crossing into the range of an order adds its liquidity and crossing
+		// the tick that fills it removes it, as the swap does.
+		if zeroForOne {
+			liquidity = liquidity.Sub(osmomath.BigDecFromDec(liquidityNet.LiquidityNet))
+		} else {
+			liquidity = liquidity.Add(osmomath.BigDecFromDec(liquidityNet.LiquidityNet))
+		}
+		sqrtPrice = nextSqrtPrice
+	}
+
+	return clSwapCurve{
+		zeroForOne:   zeroForOne,
+		tickRanges:   tickRanges,
+		spreadFactor: spreadFactor,
+		takerFee:     takerFee,
+	}, nil
+}
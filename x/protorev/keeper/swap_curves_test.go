@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/app/apptesting"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/keeper"
+)
+
+// TestBuildRouteCurve tests that the swap curves of a route match the simulated swaps of the route
+func (s *KeeperTestSuite) TestBuildRouteCurve() {
+	tests := []struct {
+		name      string
+		route     poolmanagertypes.SwapAmountInRoutes
+		exact     bool
+		expectErr bool
+	}{
+		{
+			name:  "Balancer route",
+			route: routeTwoAssetSameWeight,
+			exact: true,
+		},
+		{
+			name:  "Multi asset balancer route",
+			route: routeDiffDenom,
+			exact: true,
+		},
+		{
+			name:  "StableSwap route",
+			route: routeStableSwap,
+			exact: true,
+		},
+		{
+			name:  "CL route",
+			route: clPoolRoute,
+		},
+		{
+			name:  "CL route with multiple CL pools",
+			route: clPoolRouteMulti,
+		},
+		{
+			name:      "CW pool route has no swap curve",
+			route:     cwPoolRoute,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			inputDenom := test.route[test.route.Length()-1].TokenOutDenom
+
+			curve, err := s.App.ProtoRevKeeper.BuildRouteCurve(s.Ctx, test.route, inputDenom)
+			if test.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(curve, test.route.Length())
+
+			for _, amountIn := range []osmomath.Int{osmomath.NewInt(1_000_000), osmomath.NewInt(10_000_000), osmomath.NewInt(100_000_000)} {
+				expectedAmountOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, test.route, sdk.NewCoin(inputDenom, amountIn))
+				s.Require().NoError(err)
+
+				amountOut, err := curve.AmountOut(amountIn)
+				s.Require().NoError(err)
+
+				if test.exact {
+					s.Require().Equal(expectedAmountOut, amountOut)
+				} else {
+					// The spread factor of concentrated pools is charged upfront rather than per tick range, which only differs by rounding
+					tolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 6)}
+					s.Require().Equal(0, tolerance.Compare(expectedAmountOut, amountOut))
+				}
+			}
+		})
+	}
+}
+
+// TestBuildRouteCurveWithLimitOrders tests that the swap curve of a concentrated pool converts the liquidity of the limit
+// orders on the ticks it crosses like the simulated swap
+func (s *KeeperTestSuite) TestBuildRouteCurveWithLimitOrders() {
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], "epochTwo", "uosmo", apptesting.DefaultTickSpacing, osmomath.ZeroDec())
+	fundCoins := sdk.NewCoins(sdk.NewCoin("epochTwo", osmomath.NewInt(10_000_000_000)), sdk.NewCoin("uosmo", osmomath.NewInt(10_000_000_000)))
+	s.FundAcc(s.TestAccs[0], fundCoins)
+	s.CreateFullRangePosition(clPool, fundCoins)
+
+	// Swapping uosmo for epochTwo increases the price into the range of the order selling epochTwo, and the largest
+	// amount in crosses the whole range and fills the order
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: clPool.GetId(), TokenOutDenom: "epochTwo"}}
+	amountsIn := []osmomath.Int{osmomath.NewInt(10_000_000), osmomath.NewInt(2_000_000_000)}
+
+	curveWithoutOrder, err := s.App.ProtoRevKeeper.BuildRouteCurve(s.Ctx, route, "uosmo")
+	s.Require().NoError(err)
+
+	orderTokenIn := sdk.NewCoin("epochTwo", osmomath.NewInt(1_000_000_000))
+	s.FundAcc(s.TestAccs[1], sdk.NewCoins(orderTokenIn))
+	_, _, err = s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, clPool.GetId(), s.TestAccs[1], int64(apptesting.DefaultTickSpacing), orderTokenIn)
+	s.Require().NoError(err)
+
+	curve, err := s.App.ProtoRevKeeper.BuildRouteCurve(s.Ctx, route, "uosmo")
+	s.Require().NoError(err)
+
+	tolerance := osmomath.ErrTolerance{MultiplicativeTolerance: osmomath.NewDecWithPrec(1, 6)}
+	for _, amountIn := range amountsIn {
+		expectedAmountOut, err := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, sdk.NewCoin("uosmo", amountIn))
+		s.Require().NoError(err)
+
+		amountOut, err := curve.AmountOut(amountIn)
+		s.Require().NoError(err)
+		s.Require().Equal(0, tolerance.Compare(expectedAmountOut, amountOut))
+
+		// The liquidity of the order lowers the price impact of the swap
+		amountOutWithoutOrder, err := curveWithoutOrder.AmountOut(amountIn)
+		s.Require().NoError(err)
+		s.Require().True(amountOutWithoutOrder.LT(amountOut))
+	}
+}
+
+// TestFindMaxProfitWithSwapCurves tests that the optimal amount in found on the swap curves matches the binary search
+func (s *KeeperTestSuite) TestFindMaxProfitWithSwapCurves() {
+	tests := []struct {
+		name      string
+		route     poolmanagertypes.SwapAmountInRoutes
+		expectErr bool
+	}{
+		{
+			name:  "Balancer route",
+			route: routeTwoAssetSameWeight,
+		},
+		{
+			name:  "Most profitable balancer route",
+			route: routeMostProfitable,
+		},
+		{
+			name:  "StableSwap route",
+			route: routeStableSwap,
+		},
+		{
+			name:  "Four pool route",
+			route: fourPoolRoute,
+		},
+		{
+			name:  "Extended range route",
+			route: extendedRangeRoute,
+		},
+		{
+			name:      "CW pool route falls back to the binary search",
+			route:     cwPoolRoute,
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			route := keeper.RouteMetaData{
+				Route:    test.route,
+				StepSize: osmomath.NewInt(1_000_000),
+			}
+			inputDenom := test.route[test.route.Length()-1].TokenOutDenom
+
+			amtIn, profit, err := s.App.ProtoRevKeeper.FindMaxProfitWithSwapCurves(s.Ctx, route, inputDenom)
+			if test.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedAmtIn, expectedProfit, err := s.App.ProtoRevKeeper.FindMaxProfitWithBinarySearch(s.Ctx, route, inputDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedAmtIn, amtIn)
+			s.Require().Equal(expectedProfit, profit)
+		})
+	}
+}
@@ -28,6 +28,20 @@ const MaxPoolPointsPerBlock uint64 = 200
 // Max number of ticks we can move in a concentrated pool swap.
 const MaxTicksCrossed uint64 = 10
 
+// Min and max number of hops of the cyclic routes discovered by searching the pool graph
+const (
+	MinCyclicRouteHops uint64 = 2
+	MaxCyclicRouteHops uint64 = 4
+)
+
+// Max number of pool graph edges visited when searching the cyclic routes through a base denom after a swap.
+// This bounds the store reads of the search regardless of the number of pools.
+const MaxPoolGraphSearchSteps int = 100
+
+// Number of blocks the records of the executed trades are kept for before being pruned at the end of a block.
+// At ~6 seconds per block this is roughly 3 days of trade history.
+const TradeHistoryRetentionBlocks int64 = 43_200
+
 // ---------------- Module Profit Splitting Constants ---------------- //
 
 // Year 1 (20% of total profit)
@@ -1,7 +1,9 @@
 package types
 
 const (
-	TypeEvtBackrun = "protorev_backrun"
+	TypeEvtBackrun           = "protorev_backrun"
+	TypeEvtProfitShare       = "protorev_profit_share"
+	TypeEvtProfitShareUnpaid = "protorev_profit_share_unpaid"
 
 	AttributeValueCategory               = ModuleName
 	AttributeKeyTxHash                   = "tx_hash"
@@ -14,4 +16,7 @@ const (
 	AttributeKeyProtorevAmountIn         = "amount_in"
 	AttributeKeyProtorevAmountOut        = "amount_out"
 	AttributeKeyProtorevArbDenom         = "arb_denom"
+	AttributeKeyProfitShareRecipient     = "recipient"
+	AttributeKeyProfitShareAmount        = "amount"
+	AttributeKeyProfitSharePoolId        = "pool_id"
 )
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/client/queryproto"
+	cltypes "github.com/osmosis-labs/osmosis/v22/x/concentrated-liquidity/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v22/x/poolmanager/types"
 	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
@@ -19,6 +21,7 @@ type AccountKeeper interface {
 // creating a x/protorev keeper.
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
@@ -63,6 +66,7 @@ type PoolManagerKeeper interface {
 	GetTakerFeeTrackerForStakers(ctx sdk.Context) []sdk.Coin
 	GetTakerFeeTrackerForCommunityPool(ctx sdk.Context) []sdk.Coin
 	GetTakerFeeTrackerStartHeight(ctx sdk.Context) int64
+	GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
@@ -80,4 +84,25 @@ type ConcentratedLiquidityKeeper interface {
 		tokenInDenom string,
 		maxTicksCrossed uint64,
 	) (maxTokenIn, resultingTokenOut sdk.Coin, err error)
+	GetConcentratedPoolById(ctx sdk.Context, poolId uint64) (cltypes.ConcentratedPoolExtension, error)
+	GetNumNextInitializedTicks(ctx sdk.Context, poolId, numberOfNextInitializedTicks uint64, tokenInDenom string) ([]queryproto.TickLiquidityNet, error)
+	GetEffectiveSpreadFactor(ctx sdk.Context, poolId uint64) (effectiveSpreadFactor osmomath.Dec, volatility osmomath.Dec, err error)
+}
+
+// DistributionKeeper defines the Distribution contract that must be fulfilled when
+// creating a x/protorev keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// IncentivesKeeper defines the Incentives contract that must be fulfilled when
+// creating a x/protorev keeper.
+type IncentivesKeeper interface {
+	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
+}
+
+// PoolIncentivesKeeper defines the PoolIncentives contract that must be fulfilled when
+// creating a x/protorev keeper.
+type PoolIncentivesKeeper interface {
+	GetInternalGaugeIDForPool(ctx sdk.Context, poolID uint64) (uint64, error)
 }
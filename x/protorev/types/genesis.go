@@ -39,6 +39,10 @@ var (
 	DefaultMaxPoolPointsPerTx        = uint64(18)
 	DefaultPoolPointsConsumedInBlock = uint64(0)
 	DefaultProfits                   = []sdk.Coin{}
+	DefaultPendingProfitShares       = []sdk.Coin{}
+	DefaultPoolTradeCounts           = []PoolTradeCount{}
+	DefaultProfitSharesDistributed   = []ProfitShareDistribution{}
+	DefaultUnpaidProfitShares        = []ProfitShareDistribution{}
 	DefaultCyclicArbTracker          = CyclicArbTracker{
 		CyclicArb:                  sdk.Coins(nil),
 		HeightAccountingStartsFrom: 0,
@@ -48,19 +52,23 @@ var (
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:                 DefaultParams(),
-		TokenPairArbRoutes:     DefaultTokenPairArbRoutes,
-		BaseDenoms:             DefaultBaseDenoms,
-		InfoByPoolType:         DefaultPoolTypeInfo,
-		DaysSinceModuleGenesis: DefaultDaysSinceModuleGenesis,
-		DeveloperFees:          DefaultDeveloperFees,
-		DeveloperAddress:       DefaultDeveloperAddress,
-		LatestBlockHeight:      DefaultLatestBlockHeight,
-		MaxPoolPointsPerBlock:  DefaultMaxPoolPointsPerBlock,
-		MaxPoolPointsPerTx:     DefaultMaxPoolPointsPerTx,
-		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
-		Profits:                DefaultProfits,
-		CyclicArbTracker:       &DefaultCyclicArbTracker,
+		Params:                  DefaultParams(),
+		TokenPairArbRoutes:      DefaultTokenPairArbRoutes,
+		BaseDenoms:              DefaultBaseDenoms,
+		InfoByPoolType:          DefaultPoolTypeInfo,
+		DaysSinceModuleGenesis:  DefaultDaysSinceModuleGenesis,
+		DeveloperFees:           DefaultDeveloperFees,
+		DeveloperAddress:        DefaultDeveloperAddress,
+		LatestBlockHeight:       DefaultLatestBlockHeight,
+		MaxPoolPointsPerBlock:   DefaultMaxPoolPointsPerBlock,
+		MaxPoolPointsPerTx:      DefaultMaxPoolPointsPerTx,
+		PointCountForBlock:      DefaultPoolPointsConsumedInBlock,
+		Profits:                 DefaultProfits,
+		CyclicArbTracker:        &DefaultCyclicArbTracker,
+		PendingProfitShares:     DefaultPendingProfitShares,
+		PoolTradeCounts:         DefaultPoolTradeCounts,
+		ProfitSharesDistributed: DefaultProfitSharesDistributed,
+		UnpaidProfitShares:      DefaultUnpaidProfitShares,
 	}
 }
 
@@ -103,6 +111,26 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the pending profit shares
+	if err := ValidatePendingProfitShares(gs.PendingProfitShares); err != nil {
+		return err
+	}
+
+	// Validate the pool trade counts
+	if err := ValidatePoolTradeCounts(gs.PoolTradeCounts); err != nil {
+		return err
+	}
+
+	// Validate the profit shares distributed
+	if err := ValidateProfitShareDistributions(gs.ProfitSharesDistributed); err != nil {
+		return err
+	}
+
+	// Validate the unpaid profit shares
+	if err := ValidateProfitShareDistributions(gs.UnpaidProfitShares); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
@@ -62,6 +62,17 @@ type GenesisState struct {
 	// consumption of a swap on a given pool type.
 	InfoByPoolType   InfoByPoolType    `protobuf:"bytes,13,opt,name=info_by_pool_type,json=infoByPoolType,proto3" json:"info_by_pool_type" yaml:"info_by_pool_type"`
 	CyclicArbTracker *CyclicArbTracker `protobuf:"bytes,14,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker,omitempty" yaml:"cyclic_arb_tracker"`
+	// The profits left after the developer fee that have not been shared with
+	// the profit share recipients yet.
+	PendingProfitShares []types.Coin `protobuf:"bytes,15,rep,name=pending_profit_shares,json=pendingProfitShares,proto3" json:"pending_profit_shares" yaml:"pending_profit_shares"`
+	// The number of trades executed on each pool since the profits were last
+	// shared.
+	PoolTradeCounts []PoolTradeCount `protobuf:"bytes,16,rep,name=pool_trade_counts,json=poolTradeCounts,proto3" json:"pool_trade_counts" yaml:"pool_trade_counts"`
+	// The cumulative amounts sent to each profit share recipient.
+	ProfitSharesDistributed []ProfitShareDistribution `protobuf:"bytes,17,rep,name=profit_shares_distributed,json=profitSharesDistributed,proto3" json:"profit_shares_distributed" yaml:"profit_shares_distributed"`
+	// The shares of the profits that could not be sent to each profit share
+	// recipient, carried forward to the next distribution.
+	UnpaidProfitShares []ProfitShareDistribution `protobuf:"bytes,18,rep,name=unpaid_profit_shares,json=unpaidProfitShares,proto3" json:"unpaid_profit_shares" yaml:"unpaid_profit_shares"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -195,6 +206,34 @@ func (m *GenesisState) GetCyclicArbTracker() *CyclicArbTracker {
 	return nil
 }
 
+func (m *GenesisState) GetPendingProfitShares() []types.Coin {
+	if m != nil {
+		return m.PendingProfitShares
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolTradeCounts() []PoolTradeCount {
+	if m != nil {
+		return m.PoolTradeCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProfitSharesDistributed() []ProfitShareDistribution {
+	if m != nil {
+		return m.ProfitSharesDistributed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnpaidProfitShares() []ProfitShareDistribution {
+	if m != nil {
+		return m.UnpaidProfitShares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -204,56 +243,65 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x69, 0x69, 0xe9, 0xa4, 0x8d, 0xda, 0x29, 0xa9, 0x9c, 0x40, 0x1d, 0x63, 0x5a, 0x88,
-	0x10, 0xb5, 0xd5, 0xc0, 0x8a, 0x05, 0x52, 0x5d, 0x54, 0x40, 0x88, 0x2a, 0x72, 0x83, 0x90, 0x40,
-	0x62, 0x18, 0x3b, 0x93, 0xd4, 0xaa, 0xed, 0xb1, 0x3c, 0x93, 0x34, 0x79, 0x00, 0xf6, 0x3c, 0x0c,
-	0x0f, 0xd1, 0x65, 0xc5, 0xe6, 0xde, 0x55, 0x74, 0xd5, 0xbe, 0x41, 0x9e, 0xe0, 0xca, 0x33, 0x93,
-	0xa4, 0x4d, 0xe3, 0x7b, 0x77, 0x9e, 0x73, 0xbe, 0x9f, 0xf3, 0xcd, 0x8f, 0xc1, 0x17, 0x94, 0xc5,
-	0x94, 0x85, 0xcc, 0x49, 0x33, 0xca, 0x69, 0x46, 0x86, 0xce, 0xf0, 0xd4, 0x27, 0x1c, 0x9f, 0x3a,
-	0x7d, 0x92, 0x10, 0x16, 0x32, 0x5b, 0x34, 0xa0, 0xae, 0x70, 0xf6, 0x0c, 0x67, 0x2b, 0x5c, 0xfd,
-	0xe3, 0x3e, 0xed, 0x53, 0x51, 0x75, 0xf2, 0x2f, 0x09, 0xa8, 0x7f, 0x59, 0xa8, 0x3b, 0x17, 0x90,
-	0xc0, 0xe3, 0x62, 0x20, 0xce, 0x70, 0xac, 0x0c, 0xeb, 0xb5, 0x40, 0xe0, 0x90, 0x34, 0x92, 0x0b,
-	0xd5, 0x32, 0xe4, 0xca, 0xf1, 0x31, 0x23, 0x73, 0x72, 0x40, 0xc3, 0x44, 0xf6, 0xad, 0x57, 0x00,
-	0x6c, 0xff, 0x28, 0xc3, 0x5c, 0x71, 0xcc, 0x09, 0xfc, 0x1e, 0x6c, 0x48, 0x6d, 0x5d, 0x33, 0xb5,
-	0x66, 0xb9, 0x65, 0xda, 0x45, 0xe1, 0xec, 0xb6, 0xc0, 0xb9, 0xeb, 0x77, 0x93, 0x46, 0xc9, 0x53,
-	0x2c, 0xf8, 0x8f, 0x06, 0xaa, 0x9c, 0xde, 0x90, 0x04, 0xa5, 0x38, 0xcc, 0x10, 0xce, 0x7c, 0x94,
-	0xd1, 0x01, 0x27, 0x4c, 0xff, 0xc0, 0x5c, 0x6b, 0x96, 0x5b, 0x5f, 0x17, 0xeb, 0x75, 0x72, 0x5a,
-	0x1b, 0x87, 0xd9, 0x59, 0xe6, 0x7b, 0x82, 0xe3, 0x1e, 0xe5, 0xda, 0xd3, 0x49, 0xe3, 0xd3, 0x31,
-	0x8e, 0xa3, 0xef, 0xac, 0x95, 0xc2, 0x96, 0x07, 0xf9, 0x0b, 0x26, 0xfc, 0x1b, 0x94, 0xf3, 0xcc,
-	0xa8, 0x4b, 0x12, 0x1a, 0x33, 0x7d, 0x4d, 0x98, 0x7f, 0x5e, 0x6c, 0xee, 0x62, 0x46, 0x7e, 0xc8,
-	0xb1, 0x6e, 0x5d, 0x79, 0x42, 0xe9, 0xf9, 0x44, 0xc5, 0xf2, 0x80, 0x3f, 0x83, 0x31, 0x48, 0xc0,
-	0x76, 0x4a, 0x69, 0x84, 0x6e, 0x49, 0xd8, 0xbf, 0xe6, 0x4c, 0x5f, 0x17, 0xfb, 0x75, 0xfc, 0x8e,
-	0xfd, 0xa2, 0x34, 0xfa, 0x5d, 0x82, 0xdd, 0x4f, 0x94, 0xc9, 0xbe, 0x34, 0x79, 0x2a, 0x64, 0x79,
-	0xe5, 0x74, 0x81, 0x84, 0x08, 0xd4, 0xba, 0x78, 0xcc, 0x10, 0x0b, 0x93, 0x80, 0xa0, 0x98, 0x76,
-	0x07, 0x11, 0x41, 0xea, 0xfe, 0xe9, 0x1f, 0x9a, 0x5a, 0x73, 0xdd, 0x3d, 0x9a, 0x4e, 0x1a, 0xa6,
-	0x14, 0x2a, 0x84, 0x5a, 0xde, 0x41, 0xde, 0xbb, 0xca, 0x5b, 0xbf, 0x8a, 0x8e, 0x3a, 0x76, 0x88,
-	0x40, 0xa5, 0x4b, 0x86, 0x24, 0xa2, 0x29, 0xc9, 0x50, 0x8f, 0x10, 0xa6, 0x6f, 0x88, 0xcd, 0xaa,
-	0xd9, 0xea, 0x26, 0xe5, 0x99, 0xe7, 0x21, 0xce, 0x69, 0x98, 0xb8, 0x87, 0x6a, 0xfa, 0xaa, 0x32,
-	0x7d, 0x46, 0xb7, 0xbc, 0x9d, 0x79, 0xe1, 0x82, 0x10, 0x06, 0x2f, 0xc1, 0x7e, 0x84, 0x39, 0x61,
-	0x1c, 0xf9, 0x11, 0x0d, 0x6e, 0xd0, 0xb5, 0x48, 0xa6, 0x6f, 0x8a, 0xd9, 0x8d, 0xe9, 0xa4, 0x51,
-	0x97, 0x32, 0x2b, 0x40, 0x96, 0xb7, 0x27, 0xab, 0x6e, 0x5e, 0xfc, 0x49, 0xd4, 0xe0, 0x9f, 0x60,
-	0x6f, 0xe1, 0x88, 0xbb, 0xdd, 0x8c, 0x30, 0xa6, 0x7f, 0x64, 0x6a, 0xcd, 0x2d, 0xd7, 0x9e, 0x4e,
-	0x1a, 0xfa, 0xf2, 0x50, 0x0a, 0x62, 0xfd, 0xff, 0xdf, 0x49, 0x45, 0x45, 0x3a, 0x93, 0x25, 0x6f,
-	0x77, 0x8e, 0x52, 0x15, 0xf8, 0x17, 0xa8, 0xc5, 0x78, 0x84, 0xc4, 0x81, 0xa4, 0x34, 0x4c, 0x38,
-	0x43, 0xb9, 0x86, 0x18, 0x4a, 0xdf, 0x5a, 0xde, 0xee, 0x42, 0xa8, 0xe5, 0x55, 0x63, 0x3c, 0xca,
-	0x4f, 0xbc, 0x2d, 0x3a, 0x6d, 0x92, 0x89, 0x08, 0xf0, 0x37, 0x70, 0xb0, 0x8a, 0xc4, 0x47, 0x3a,
-	0x10, 0xe2, 0x9f, 0x4d, 0x27, 0x8d, 0xc3, 0x62, 0x71, 0x3e, 0xb2, 0x3c, 0xb8, 0xac, 0xdc, 0x19,
-	0xc1, 0x2b, 0x50, 0x15, 0x28, 0x14, 0xd0, 0x41, 0xc2, 0x51, 0x8f, 0xce, 0x46, 0x2e, 0x0b, 0x55,
-	0x73, 0xf1, 0x86, 0x56, 0xc2, 0x2c, 0x0f, 0x8a, 0xfa, 0x79, 0x5e, 0xbe, 0xa0, 0x6a, 0xd6, 0x5f,
-	0xc0, 0x66, 0x9a, 0xd1, 0x5e, 0xc8, 0x99, 0xbe, 0xfd, 0xbe, 0x2b, 0x71, 0xa0, 0xae, 0x44, 0x45,
-	0xb9, 0x48, 0x9e, 0xe5, 0xcd, 0x14, 0xe0, 0x00, 0xec, 0x85, 0x49, 0x8f, 0x22, 0x7f, 0x2c, 0x43,
-	0xf1, 0x71, 0x4a, 0xf4, 0x1d, 0xf1, 0x66, 0x9a, 0xc5, 0x6f, 0xe6, 0xe7, 0xa4, 0x47, 0xdd, 0x71,
-	0x9e, 0xb6, 0x33, 0x4e, 0x89, 0x6b, 0x2a, 0x17, 0x75, 0xc6, 0x2f, 0x04, 0x2d, 0xaf, 0x12, 0x3e,
-	0x63, 0xc0, 0x5b, 0x00, 0x83, 0x71, 0x10, 0x85, 0x81, 0xf8, 0x63, 0xf0, 0x0c, 0x07, 0x37, 0x24,
-	0xd3, 0x2b, 0xc2, 0xf7, 0xab, 0x62, 0xdf, 0x73, 0xc1, 0x39, 0xcb, 0xfc, 0x8e, 0x64, 0xb8, 0x87,
-	0xd3, 0x49, 0xa3, 0x26, 0x5d, 0x5f, 0xea, 0x59, 0xde, 0x6e, 0xb0, 0x4c, 0xb8, 0xbc, 0x7b, 0x30,
-	0xb4, 0xfb, 0x07, 0x43, 0x7b, 0xf3, 0x60, 0x68, 0xff, 0x3e, 0x1a, 0xa5, 0xfb, 0x47, 0xa3, 0xf4,
-	0xfa, 0xd1, 0x28, 0xfd, 0xf1, 0x6d, 0x3f, 0xe4, 0xd7, 0x03, 0xdf, 0x0e, 0x68, 0xec, 0xa8, 0x01,
-	0x4e, 0x22, 0xec, 0xb3, 0xd9, 0xc2, 0x19, 0xb6, 0x5a, 0xce, 0x68, 0xf1, 0xcf, 0xcf, 0x83, 0x31,
-	0x7f, 0x43, 0xac, 0xbf, 0x79, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x0c, 0x84, 0x81, 0x95, 0x06,
-	0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0x23, 0x35,
+	0x1c, 0xef, 0xd0, 0xd2, 0x65, 0x9d, 0x6e, 0xb6, 0x71, 0x37, 0x65, 0x92, 0xa5, 0xc9, 0xe0, 0xdd,
+	0x85, 0x08, 0xb1, 0x89, 0x5a, 0x38, 0x71, 0x40, 0xea, 0xb4, 0x5a, 0x40, 0x88, 0x55, 0xe4, 0x16,
+	0x21, 0x81, 0x84, 0xf1, 0xcc, 0x38, 0xa9, 0xd5, 0x64, 0x3c, 0x1a, 0x3b, 0xd9, 0xe4, 0x01, 0x90,
+	0x38, 0x72, 0xe3, 0x45, 0x78, 0x88, 0x3d, 0xae, 0x38, 0x71, 0x8a, 0x50, 0x7b, 0xe2, 0x9a, 0x27,
+	0x40, 0x63, 0x3b, 0x1f, 0x4d, 0x33, 0x5d, 0x89, 0x5b, 0xfc, 0xff, 0xff, 0x3e, 0xfe, 0x1f, 0xf6,
+	0x28, 0xe0, 0x23, 0x21, 0xfb, 0x42, 0x72, 0xd9, 0x4a, 0x52, 0xa1, 0x44, 0xca, 0x86, 0xad, 0xe1,
+	0x61, 0xc0, 0x14, 0x3d, 0x6c, 0x75, 0x59, 0xcc, 0x24, 0x97, 0x4d, 0x9d, 0x80, 0xae, 0xc5, 0x35,
+	0x67, 0xb8, 0xa6, 0xc5, 0x55, 0x1f, 0x75, 0x45, 0x57, 0xe8, 0x68, 0x2b, 0xfb, 0x65, 0x00, 0xd5,
+	0x8f, 0x73, 0x75, 0xe7, 0x02, 0x06, 0xf8, 0x2c, 0x1f, 0x48, 0x53, 0xda, 0xb7, 0x86, 0xd5, 0x4a,
+	0xa8, 0x71, 0xc4, 0x18, 0x99, 0x83, 0x4d, 0xd5, 0xcc, 0xa9, 0x15, 0x50, 0xc9, 0xe6, 0xe4, 0x50,
+	0xf0, 0xd8, 0xe4, 0xd1, 0xbf, 0x45, 0xb0, 0xf3, 0x95, 0x69, 0xe6, 0x4c, 0x51, 0xc5, 0xe0, 0x97,
+	0x60, 0xdb, 0x68, 0xbb, 0x8e, 0xe7, 0x34, 0x0a, 0x47, 0x5e, 0x33, 0xaf, 0xb9, 0x66, 0x5b, 0xe3,
+	0xfc, 0xad, 0xd7, 0x93, 0xfa, 0x06, 0xb6, 0x2c, 0xf8, 0xab, 0x03, 0xca, 0x4a, 0x5c, 0xb2, 0x98,
+	0x24, 0x94, 0xa7, 0x84, 0xa6, 0x01, 0x49, 0xc5, 0x40, 0x31, 0xe9, 0xbe, 0xe3, 0x6d, 0x36, 0x0a,
+	0x47, 0x9f, 0xe6, 0xeb, 0x9d, 0x67, 0xb4, 0x36, 0xe5, 0xe9, 0x71, 0x1a, 0x60, 0xcd, 0xf1, 0x9f,
+	0x66, 0xda, 0xd3, 0x49, 0xfd, 0x83, 0x31, 0xed, 0xf7, 0xbe, 0x40, 0x6b, 0x85, 0x11, 0x86, 0xea,
+	0x16, 0x13, 0xfe, 0x02, 0x0a, 0x59, 0xcf, 0x24, 0x62, 0xb1, 0xe8, 0x4b, 0x77, 0x53, 0x9b, 0x3f,
+	0xc9, 0x37, 0xf7, 0xa9, 0x64, 0xa7, 0x19, 0xd6, 0xaf, 0x5a, 0x4f, 0x68, 0x3c, 0x97, 0x54, 0x10,
+	0x06, 0xc1, 0x0c, 0x26, 0x21, 0x03, 0x3b, 0x89, 0x10, 0x3d, 0xf2, 0x8a, 0xf1, 0xee, 0x85, 0x92,
+	0xee, 0x96, 0x9e, 0xd7, 0xb3, 0x3b, 0xe6, 0x25, 0x44, 0xef, 0x07, 0x03, 0xf6, 0x1f, 0x5b, 0x93,
+	0x3d, 0x63, 0xb2, 0x2c, 0x84, 0x70, 0x21, 0x59, 0x20, 0x21, 0x01, 0x95, 0x88, 0x8e, 0x25, 0x91,
+	0x3c, 0x0e, 0x19, 0xe9, 0x8b, 0x68, 0xd0, 0x63, 0xc4, 0xde, 0x3f, 0xf7, 0x5d, 0xcf, 0x69, 0x6c,
+	0xf9, 0x4f, 0xa7, 0x93, 0xba, 0x67, 0x84, 0x72, 0xa1, 0x08, 0xef, 0x67, 0xb9, 0xb3, 0x2c, 0xf5,
+	0x9d, 0xce, 0xd8, 0xb5, 0x43, 0x02, 0x8a, 0x11, 0x1b, 0xb2, 0x9e, 0x48, 0x58, 0x4a, 0x3a, 0x8c,
+	0x49, 0x77, 0x5b, 0x0f, 0xab, 0xd2, 0xb4, 0x37, 0x29, 0xeb, 0x79, 0xde, 0xc4, 0x89, 0xe0, 0xb1,
+	0x7f, 0x60, 0xab, 0x2f, 0x5b, 0xd3, 0x1b, 0x74, 0x84, 0x1f, 0xcc, 0x03, 0x2f, 0x18, 0x93, 0xf0,
+	0x25, 0xd8, 0xeb, 0x51, 0xc5, 0xa4, 0x22, 0x41, 0x4f, 0x84, 0x97, 0xe4, 0x42, 0x77, 0xe6, 0xde,
+	0xd3, 0xb5, 0xd7, 0xa6, 0x93, 0x7a, 0xd5, 0xc8, 0xac, 0x01, 0x21, 0x5c, 0x32, 0x51, 0x3f, 0x0b,
+	0x7e, 0xad, 0x63, 0xf0, 0x27, 0x50, 0x5a, 0x38, 0xd2, 0x28, 0x4a, 0x99, 0x94, 0xee, 0x7b, 0x9e,
+	0xd3, 0xb8, 0xef, 0x37, 0xa7, 0x93, 0xba, 0xbb, 0x5a, 0x94, 0x85, 0xa0, 0xbf, 0xfe, 0x7c, 0x5e,
+	0xb4, 0x2d, 0x1d, 0x9b, 0x10, 0xde, 0x9d, 0xa3, 0x6c, 0x04, 0xfe, 0x0c, 0x2a, 0x7d, 0x3a, 0x22,
+	0x7a, 0x21, 0x89, 0xe0, 0xb1, 0x92, 0x24, 0xd3, 0xd0, 0x45, 0xb9, 0xf7, 0x57, 0xc7, 0x9d, 0x0b,
+	0x45, 0xb8, 0xdc, 0xa7, 0xa3, 0x6c, 0xe3, 0x6d, 0x9d, 0x69, 0xb3, 0x54, 0xb7, 0x00, 0xbf, 0x07,
+	0xfb, 0xeb, 0x48, 0x6a, 0xe4, 0x02, 0x2d, 0xfe, 0xe1, 0x74, 0x52, 0x3f, 0xc8, 0x17, 0x57, 0x23,
+	0x84, 0xe1, 0xaa, 0xf2, 0xf9, 0x08, 0x9e, 0x81, 0xb2, 0x46, 0x91, 0x50, 0x0c, 0x62, 0x45, 0x3a,
+	0x62, 0x56, 0x72, 0x41, 0xab, 0x7a, 0x8b, 0x37, 0xb4, 0x16, 0x86, 0x30, 0xd4, 0xf1, 0x93, 0x2c,
+	0xfc, 0x42, 0xd8, 0x5a, 0xbf, 0x05, 0xf7, 0x92, 0x54, 0x74, 0xb8, 0x92, 0xee, 0xce, 0xdb, 0xae,
+	0xc4, 0xbe, 0xbd, 0x12, 0x45, 0xeb, 0x62, 0x78, 0x08, 0xcf, 0x14, 0xe0, 0x00, 0x94, 0x78, 0xdc,
+	0x11, 0x24, 0x18, 0x9b, 0xa6, 0xd4, 0x38, 0x61, 0xee, 0x03, 0xfd, 0x66, 0x1a, 0xf9, 0x6f, 0xe6,
+	0x9b, 0xb8, 0x23, 0xfc, 0x71, 0xd6, 0xed, 0xf9, 0x38, 0x61, 0xbe, 0x67, 0x5d, 0xec, 0x8e, 0x6f,
+	0x09, 0x22, 0x5c, 0xe4, 0x37, 0x18, 0xf0, 0x15, 0x80, 0xe1, 0x38, 0xec, 0xf1, 0x50, 0x7f, 0x31,
+	0x54, 0x4a, 0xc3, 0x4b, 0x96, 0xba, 0x45, 0xed, 0xfb, 0x49, 0xbe, 0xef, 0x89, 0xe6, 0x1c, 0xa7,
+	0xc1, 0xb9, 0x61, 0xf8, 0x07, 0xd3, 0x49, 0xbd, 0x62, 0x5c, 0x6f, 0xeb, 0x21, 0xbc, 0x1b, 0xae,
+	0x10, 0xa0, 0x04, 0xe5, 0x84, 0xc5, 0x11, 0x8f, 0xbb, 0xc4, 0x8c, 0x80, 0xc8, 0x0b, 0x9a, 0x32,
+	0xe9, 0x3e, 0x7c, 0xdb, 0x28, 0x57, 0x3e, 0x7a, 0x6b, 0x55, 0x10, 0xde, 0xb3, 0xf1, 0xb6, 0x0e,
+	0x9f, 0xe9, 0x28, 0x1c, 0x82, 0x92, 0x99, 0x45, 0x4a, 0x23, 0x66, 0x96, 0x2c, 0xdd, 0x5d, 0x6f,
+	0xf3, 0xee, 0x21, 0xeb, 0x61, 0x65, 0x0c, 0xbd, 0xfe, 0xd5, 0x21, 0xdf, 0x12, 0x44, 0xf8, 0x61,
+	0x72, 0x83, 0x21, 0xe1, 0x1f, 0x0e, 0xa8, 0xdc, 0xa8, 0x8f, 0x44, 0x5c, 0xaa, 0x94, 0x07, 0x03,
+	0xc5, 0x22, 0xb7, 0xa4, 0x0b, 0x38, 0xbc, 0xa3, 0x80, 0x45, 0x0f, 0xa7, 0x33, 0x1e, 0x17, 0xb1,
+	0xdf, 0xb0, 0x95, 0x78, 0xcb, 0x97, 0x6a, 0x8d, 0x03, 0xc2, 0xef, 0x27, 0x4b, 0x63, 0x38, 0x5d,
+	0x64, 0xe0, 0x6f, 0x0e, 0x78, 0x34, 0x88, 0x13, 0xca, 0xa3, 0x95, 0x35, 0xc0, 0xff, 0x5b, 0xd4,
+	0x13, 0x5b, 0xd4, 0x63, 0x53, 0xd4, 0x3a, 0x71, 0x84, 0xa1, 0x09, 0x2f, 0x2f, 0xc7, 0x7f, 0xf9,
+	0xfa, 0xaa, 0xe6, 0xbc, 0xb9, 0xaa, 0x39, 0xff, 0x5c, 0xd5, 0x9c, 0xdf, 0xaf, 0x6b, 0x1b, 0x6f,
+	0xae, 0x6b, 0x1b, 0x7f, 0x5f, 0xd7, 0x36, 0x7e, 0xfc, 0xbc, 0xcb, 0xd5, 0xc5, 0x20, 0x68, 0x86,
+	0xa2, 0xdf, 0xb2, 0xf5, 0x3c, 0xef, 0xd1, 0x40, 0xce, 0x0e, 0xad, 0xe1, 0xd1, 0x51, 0x6b, 0xb4,
+	0xf8, 0x17, 0x90, 0x5d, 0x75, 0x19, 0x6c, 0xeb, 0xf3, 0x67, 0xff, 0x0d, 0x00, 0x90, 0x0f, 0xc6,
+	0xbc, 0xa7, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -276,6 +324,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnpaidProfitShares) > 0 {
+		for iNdEx := len(m.UnpaidProfitShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnpaidProfitShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ProfitSharesDistributed) > 0 {
+		for iNdEx := len(m.ProfitSharesDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitSharesDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PoolTradeCounts) > 0 {
+		for iNdEx := len(m.PoolTradeCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTradeCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingProfitShares) > 0 {
+		for iNdEx := len(m.PendingProfitShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingProfitShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.CyclicArbTracker != nil {
 		{
 			size, err := m.CyclicArbTracker.MarshalToSizedBuffer(dAtA[:i])
@@ -479,6 +589,30 @@ func (m *GenesisState) Size() (n int) {
 		l = m.CyclicArbTracker.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PendingProfitShares) > 0 {
+		for _, e := range m.PendingProfitShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolTradeCounts) > 0 {
+		for _, e := range m.PoolTradeCounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProfitSharesDistributed) > 0 {
+		for _, e := range m.ProfitSharesDistributed {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnpaidProfitShares) > 0 {
+		for _, e := range m.UnpaidProfitShares {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -915,6 +1049,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProfitShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProfitShares = append(m.PendingProfitShares, types.Coin{})
+			if err := m.PendingProfitShares[len(m.PendingProfitShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTradeCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTradeCounts = append(m.PoolTradeCounts, PoolTradeCount{})
+			if err := m.PoolTradeCounts[len(m.PoolTradeCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitSharesDistributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitSharesDistributed = append(m.ProfitSharesDistributed, ProfitShareDistribution{})
+			if err := m.ProfitSharesDistributed[len(m.ProfitSharesDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpaidProfitShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpaidProfitShares = append(m.UnpaidProfitShares, ProfitShareDistribution{})
+			if err := m.UnpaidProfitShares[len(m.UnpaidProfitShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
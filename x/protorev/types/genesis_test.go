@@ -3,8 +3,10 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
 )
 
@@ -19,6 +21,61 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Duplicate pending profit shares",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingProfitShares = []sdk.Coin{
+					sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1)),
+					sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(2)),
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			description: "Duplicate pool trade counts",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PoolTradeCounts = []types.PoolTradeCount{{PoolId: 1, TradeCount: 1}, {PoolId: 1, TradeCount: 2}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			description: "Invalid profit shares distributed recipient",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.ProfitSharesDistributed = []types.ProfitShareDistribution{{Recipient: types.ProfitShareRecipientType(10)}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			description: "Duplicate unpaid profit shares",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.UnpaidProfitShares = []types.ProfitShareDistribution{
+					{Recipient: types.ProfitShareRecipientBurn},
+					{Recipient: types.ProfitShareRecipientBurn},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			description: "Valid profit sharing state",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.PendingProfitShares = []sdk.Coin{sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1))}
+				genState.PoolTradeCounts = []types.PoolTradeCount{{PoolId: 1, TradeCount: 1}, {PoolId: 2, TradeCount: 2}}
+				genState.ProfitSharesDistributed = []types.ProfitShareDistribution{
+					{Recipient: types.ProfitShareRecipientBurn, Amount: sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, osmomath.NewInt(1)))},
+				}
+				return genState
+			}(),
+			valid: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -38,6 +38,12 @@ const (
 	prefixSwapsToBackrun
 	prefixcyclicArbTracker
 	prefixcyclicArbTrackerStartHeight
+	prefixPoolGraph
+	prefixTradeHistory
+	prefixPendingProfitShares
+	prefixPoolTradeCounts
+	prefixProfitSharesDistributed
+	prefixUnpaidProfitShares
 )
 
 var (
@@ -97,6 +103,30 @@ var (
 
 	// KeyCyclicArbTracker is the prefix for store that keeps track of the height we began tracking cyclic arbitrage
 	KeyCyclicArbTrackerStartHeight = []byte{prefixcyclicArbTrackerStartHeight}
+
+	// KeyPrefixPoolGraph is the prefix for store that keeps track of the highest liquidity pool between any two denoms,
+	// which are the edges of the pool graph searched for cyclic arbitrage routes
+	KeyPrefixPoolGraph = []byte{prefixPoolGraph}
+
+	// KeyPrefixTradeHistory is the prefix for store that keeps track of the records of the trades executed in the latest blocks
+	KeyPrefixTradeHistory = []byte{prefixTradeHistory}
+
+	// -------------- Keys for profit sharing stores -------------- //
+	// KeyPrefixPendingProfitShares is the prefix for store that keeps track of the profits left after the developer fee
+	// that have not been shared with the profit share recipients yet
+	KeyPrefixPendingProfitShares = []byte{prefixPendingProfitShares}
+
+	// KeyPrefixPoolTradeCounts is the prefix for store that keeps track of the number of trades executed on each pool
+	// since the profits were last shared, used to split the share of the pool LPs
+	KeyPrefixPoolTradeCounts = []byte{prefixPoolTradeCounts}
+
+	// KeyPrefixProfitSharesDistributed is the prefix for store that keeps track of the cumulative amounts sent to each
+	// profit share recipient
+	KeyPrefixProfitSharesDistributed = []byte{prefixProfitSharesDistributed}
+
+	// KeyPrefixUnpaidProfitShares is the prefix for store that keeps track of the shares of the profits that could not
+	// be sent to each profit share recipient, carried forward to the next distribution
+	KeyPrefixUnpaidProfitShares = []byte{prefixUnpaidProfitShares}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -104,6 +134,46 @@ func GetKeyPrefixDenomPairToPool(baseDenom, matchDenom string) []byte {
 	return append(KeyPrefixDenomPairToPool, []byte(baseDenom+"|"+matchDenom)...)
 }
 
+// Returns the key needed to fetch the pool id of the pool graph edge between two denoms
+func GetKeyPrefixPoolGraphEdge(denom, otherDenom string) []byte {
+	return append(GetKeyPrefixPoolGraphNeighbors(denom), []byte(otherDenom)...)
+}
+
+// Returns the key needed to iterate over the pool graph edges of a denom
+func GetKeyPrefixPoolGraphNeighbors(denom string) []byte {
+	return append(KeyPrefixPoolGraph, []byte(denom+"|")...)
+}
+
+// Returns the key needed to fetch the record of a trade, sorted by the height it was executed at and then by trade number
+func GetKeyPrefixTradeRecord(height int64, tradeNumber uint64) []byte {
+	return append(GetKeyPrefixTradeHistoryByHeight(height), sdk.Uint64ToBigEndian(tradeNumber)...)
+}
+
+// Returns the key needed to iterate over the records of the trades executed at a given height
+func GetKeyPrefixTradeHistoryByHeight(height int64) []byte {
+	return append(KeyPrefixTradeHistory, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// Returns the key needed to fetch the pending profit share by coin
+func GetKeyPrefixPendingProfitShare(denom string) []byte {
+	return append(KeyPrefixPendingProfitShares, []byte(denom)...)
+}
+
+// Returns the key needed to fetch the number of trades executed on a pool since the profits were last shared
+func GetKeyPrefixPoolTradeCount(poolId uint64) []byte {
+	return append(KeyPrefixPoolTradeCounts, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to fetch the cumulative amounts sent to a profit share recipient
+func GetKeyPrefixProfitSharesDistributed(recipient ProfitShareRecipientType) []byte {
+	return append(KeyPrefixProfitSharesDistributed, sdk.Uint64ToBigEndian(uint64(recipient))...)
+}
+
+// Returns the key needed to fetch the share of the profits that could not be sent to a profit share recipient
+func GetKeyPrefixUnpaidProfitShare(recipient ProfitShareRecipientType) []byte {
+	return append(KeyPrefixUnpaidProfitShares, sdk.Uint64ToBigEndian(uint64(recipient))...)
+}
+
 // Returns the key needed to fetch info about base denoms
 func GetKeyPrefixBaseDenom(priority uint64) []byte {
 	return append(KeyPrefixBaseDenoms, sdk.Uint64ToBigEndian(priority)...)
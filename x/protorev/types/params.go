@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 var (
@@ -14,9 +16,12 @@ var (
 	// Note that governance has full ability to change this live on-chain, and this admin can at most prevent protorev from working.
 	// All the settings manager's controls have limits, so it can't lead to a chain halt, excess processing time or prevention of swaps.
 	DefaultAdminAccount = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
+	// No profits are shared by default, so that they are held in the module account
+	DefaultProfitShareRecipients []ProfitShareRecipient
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	ParamStoreKeyEnableModule          = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount          = []byte("AdminAccount")
+	ParamStoreKeyProfitShareRecipients = []byte("ProfitShareRecipients")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -25,16 +30,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, profitShareRecipients []ProfitShareRecipient) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:               enable,
+		Admin:                 admin,
+		ProfitShareRecipients: profitShareRecipients,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultProfitShareRecipients)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -42,6 +48,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyProfitShareRecipients, &p.ProfitShareRecipients, ValidateProfitShareRecipients),
 	}
 }
 
@@ -51,6 +58,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
+	if err := ValidateProfitShareRecipients(p.ProfitShareRecipients); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,3 +85,36 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+// ValidateProfitShareRecipients validates that every recipient is known and appears once, that every share
+// is positive and that the shares add up to at most one
+func ValidateProfitShareRecipients(i interface{}) error {
+	recipients, ok := i.([]ProfitShareRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[ProfitShareRecipientType]bool)
+	totalShare := osmomath.ZeroDec()
+	for _, recipient := range recipients {
+		if _, ok := ProfitShareRecipientType_name[int32(recipient.Recipient)]; !ok {
+			return fmt.Errorf("invalid profit share recipient: %d", recipient.Recipient)
+		}
+
+		if seen[recipient.Recipient] {
+			return fmt.Errorf("duplicate profit share recipient: %s", recipient.Recipient)
+		}
+		seen[recipient.Recipient] = true
+
+		if recipient.Share.IsNil() || !recipient.Share.IsPositive() {
+			return fmt.Errorf("profit share of %s must be positive", recipient.Recipient)
+		}
+		totalShare = totalShare.Add(recipient.Share)
+	}
+
+	if totalShare.GT(osmomath.OneDec()) {
+		return fmt.Errorf("profit shares must add up to at most 1, got %s", totalShare)
+	}
+
+	return nil
+}
@@ -30,6 +30,10 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The recipients of a share of the profits of the module left after the
+	// developer fee, paid out at the end of every day epoch. The profits not
+	// shared with any recipient are held in the module account.
+	ProfitShareRecipients []ProfitShareRecipient `protobuf:"bytes,3,rep,name=profit_share_recipients,json=profitShareRecipients,proto3" json:"profit_share_recipients" yaml:"profit_share_recipients"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +83,13 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetProfitShareRecipients() []ProfitShareRecipient {
+	if m != nil {
+		return m.ProfitShareRecipients
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
 }
@@ -88,22 +99,27 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x8a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0x48, 0x8d, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0x52, 0x10, 0x44, 0xda, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xa1, 0xfe, 0xd1, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb, 0x8c, 0x8c, 0xf4, 0x2b, 0x10,
-	0x21, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x7a, 0x36, 0x7f, 0xb1, 0x2a, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x63, 0x2a, 0x0a, 0x04, 0x84, 0x50, 0x04, 0x22, 0x74, 0x70, 0xaa, 0x48, 0x94, 0x0e,
+	0x60, 0xab, 0x85, 0x89, 0x31, 0x07, 0x40, 0x55, 0xd8, 0x58, 0x2a, 0xa7, 0x35, 0xad, 0xa5, 0xa6,
+	0xb6, 0x6c, 0x53, 0xd1, 0x4b, 0x20, 0x8e, 0xd5, 0xb1, 0x23, 0x53, 0x84, 0x92, 0x03, 0x20, 0xe5,
+	0x04, 0xa8, 0x76, 0x22, 0x16, 0xb2, 0xbd, 0xf7, 0xff, 0xdf, 0xff, 0xde, 0xb3, 0xdd, 0x6b, 0xae,
+	0x52, 0xae, 0x98, 0xc2, 0x42, 0x72, 0xcd, 0x25, 0x5d, 0xe1, 0xd5, 0x20, 0xa1, 0x9a, 0x0c, 0xb0,
+	0x20, 0x92, 0xa4, 0x0a, 0x19, 0xdd, 0xf3, 0x2b, 0x0c, 0xd5, 0x18, 0xaa, 0xb0, 0xce, 0xf9, 0x8c,
+	0xcf, 0xb8, 0x51, 0xf1, 0xae, 0xb2, 0x40, 0xe7, 0x6a, 0x62, 0x02, 0x63, 0x6b, 0xd8, 0xa6, 0xb2,
+	0x6e, 0x9a, 0x37, 0xd6, 0xb3, 0x4d, 0x11, 0xfe, 0x00, 0xb7, 0x3d, 0x32, 0x47, 0x78, 0xb7, 0xee,
+	0x01, 0x5d, 0x92, 0x64, 0x41, 0xa7, 0x3e, 0xe8, 0x82, 0xfe, 0x61, 0xe4, 0x95, 0x59, 0x70, 0xba,
+	0x26, 0xe9, 0xe2, 0x31, 0xac, 0x8c, 0x30, 0xae, 0x11, 0xaf, 0xe7, 0xee, 0x93, 0x69, 0xca, 0x96,
+	0xfe, 0x5e, 0x17, 0xf4, 0x8f, 0xa2, 0xb3, 0x32, 0x0b, 0x4e, 0x2c, 0x6b, 0xe4, 0x30, 0xb6, 0xb6,
+	0xf7, 0x01, 0xdc, 0x4b, 0x21, 0xf9, 0x2b, 0xd3, 0x63, 0x35, 0x27, 0x92, 0x8e, 0x25, 0x9d, 0x30,
+	0xc1, 0xe8, 0x52, 0x2b, 0xbf, 0xd5, 0x6d, 0xf5, 0x8f, 0x87, 0x08, 0x35, 0xbd, 0x1b, 0x8d, 0x4c,
+	0xf0, 0x79, 0x97, 0x8b, 0xeb, 0x58, 0xd4, 0xdb, 0x64, 0x81, 0x53, 0x66, 0x01, 0xb4, 0xeb, 0x1a,
+	0x86, 0x87, 0xf1, 0x85, 0xf8, 0x27, 0xad, 0xa2, 0xa7, 0x4d, 0x0e, 0xc1, 0x36, 0x87, 0xe0, 0x3b,
+	0x87, 0xe0, 0xb3, 0x80, 0xce, 0xb6, 0x80, 0xce, 0x57, 0x01, 0x9d, 0x97, 0x87, 0x19, 0xd3, 0xf3,
+	0xb7, 0x04, 0x4d, 0x78, 0x8a, 0xab, 0x93, 0xee, 0x16, 0x24, 0x51, 0x75, 0x83, 0x57, 0xc3, 0x21,
+	0x7e, 0xff, 0xfb, 0x52, 0xbd, 0x16, 0x54, 0x25, 0x6d, 0xd3, 0xdf, 0xff, 0x0e, 0x00, 0x44, 0xa8,
+	0x2c, 0x72, 0xe5, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +142,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProfitShareRecipients) > 0 {
+		for iNdEx := len(m.ProfitShareRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProfitShareRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -170,6 +200,12 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.ProfitShareRecipients) > 0 {
+		for _, e := range m.ProfitShareRecipients {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -260,6 +296,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitShareRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitShareRecipients = append(m.ProfitShareRecipients, ProfitShareRecipient{})
+			if err := m.ProfitShareRecipients[len(m.ProfitShareRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/protorev/types"
+)
+
+func TestValidateProfitShareRecipients(t *testing.T) {
+	cases := []struct {
+		description string
+		recipients  interface{}
+		valid       bool
+	}{
+		{
+			description: "Default profit share recipients",
+			recipients:  types.DefaultProfitShareRecipients,
+			valid:       true,
+		},
+		{
+			description: "Shares adding up to 1",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientCommunityPool, Share: osmomath.NewDecWithPrec(25, 2)},
+				{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(25, 2)},
+				{Recipient: types.ProfitShareRecipientStakers, Share: osmomath.NewDecWithPrec(25, 2)},
+				{Recipient: types.ProfitShareRecipientPoolLPs, Share: osmomath.NewDecWithPrec(25, 2)},
+			},
+			valid: true,
+		},
+		{
+			description: "Invalid parameter type",
+			recipients:  []string{"burn"},
+			valid:       false,
+		},
+		{
+			description: "Unknown recipient",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientType(10), Share: osmomath.NewDecWithPrec(1, 1)},
+			},
+			valid: false,
+		},
+		{
+			description: "Duplicate recipient",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(1, 1)},
+				{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(1, 1)},
+			},
+			valid: false,
+		},
+		{
+			description: "Zero share",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.ZeroDec()},
+			},
+			valid: false,
+		},
+		{
+			description: "Nil share",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientBurn},
+			},
+			valid: false,
+		},
+		{
+			description: "Shares adding up to more than 1",
+			recipients: []types.ProfitShareRecipient{
+				{Recipient: types.ProfitShareRecipientBurn, Share: osmomath.NewDecWithPrec(6, 1)},
+				{Recipient: types.ProfitShareRecipientStakers, Share: osmomath.NewDecWithPrec(5, 1)},
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := types.ValidateProfitShareRecipients(tc.recipients)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -28,6 +28,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProfitShareRecipientType is the type of a recipient of a share of the
+// profits of the module
+type ProfitShareRecipientType int32
+
+const (
+	// ProfitShareRecipientCommunityPool funds the community pool
+	ProfitShareRecipientCommunityPool ProfitShareRecipientType = 0
+	// ProfitShareRecipientBurn burns the share
+	ProfitShareRecipientBurn ProfitShareRecipientType = 1
+	// ProfitShareRecipientStakers sends the share to the fee collector, which
+	// distributes it to the stakers
+	ProfitShareRecipientStakers ProfitShareRecipientType = 2
+	// ProfitShareRecipientPoolLPs adds the share to the internal incentive gauges
+	// of the arbitraged pools, pro-rata to the number of trades executed on each
+	// pool
+	ProfitShareRecipientPoolLPs ProfitShareRecipientType = 3
+)
+
+var ProfitShareRecipientType_name = map[int32]string{
+	0: "ProfitShareRecipientCommunityPool",
+	1: "ProfitShareRecipientBurn",
+	2: "ProfitShareRecipientStakers",
+	3: "ProfitShareRecipientPoolLPs",
+}
+
+var ProfitShareRecipientType_value = map[string]int32{
+	"ProfitShareRecipientCommunityPool": 0,
+	"ProfitShareRecipientBurn":          1,
+	"ProfitShareRecipientStakers":       2,
+	"ProfitShareRecipientPoolLPs":       3,
+}
+
+func (x ProfitShareRecipientType) String() string {
+	return proto.EnumName(ProfitShareRecipientType_name, int32(x))
+}
+
+func (ProfitShareRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{0}
+}
+
 // TokenPairArbRoutes tracks all of the hot routes for a given pair of tokens
 type TokenPairArbRoutes struct {
 	// Stores all of the possible hot paths for a given pair of tokens
@@ -681,6 +721,10 @@ type BaseDenom struct {
 	// The step size of the binary search that is used to find the optimal swap
 	// amount
 	StepSize cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=step_size,json=stepSize,proto3,customtype=cosmossdk.io/math.Int" json:"step_size" yaml:"step_size"`
+	// The maximum number of hops, between 2 and 4, of the cyclic routes through
+	// the base denom that are discovered by searching the pool graph. 0 disables
+	// the search, in which case only the highest liquidity routes are built.
+	MaxCyclicRouteHops uint64 `protobuf:"varint,3,opt,name=max_cyclic_route_hops,json=maxCyclicRouteHops,proto3" json:"max_cyclic_route_hops,omitempty" yaml:"max_cyclic_route_hops"`
 }
 
 func (m *BaseDenom) Reset()         { *m = BaseDenom{} }
@@ -723,6 +767,13 @@ func (m *BaseDenom) GetDenom() string {
 	return ""
 }
 
+func (m *BaseDenom) GetMaxCyclicRouteHops() uint64 {
+	if m != nil {
+		return m.MaxCyclicRouteHops
+	}
+	return 0
+}
+
 type AllProtocolRevenue struct {
 	TakerFeesTracker types1.TakerFeesTracker `protobuf:"bytes,1,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker" yaml:"taker_fees_tracker"`
 	CyclicArbTracker CyclicArbTracker        `protobuf:"bytes,3,opt,name=cyclic_arb_tracker,json=cyclicArbTracker,proto3" json:"cyclic_arb_tracker" yaml:"cyclic_arb_tracker"`
@@ -827,7 +878,263 @@ func (m *CyclicArbTracker) GetHeightAccountingStartsFrom() int64 {
 	return 0
 }
 
+// TradeRecord is the record of a cyclic arbitrage trade executed by the module
+// after a swap, kept for a limited number of blocks
+type TradeRecord struct {
+	// height is the height of the block the trade was executed in
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// tx_hash is the hash of the transaction whose swap triggered the trade
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// user_swap is the swap of the transaction that was backrun
+	UserSwap Trade `protobuf:"bytes,3,opt,name=user_swap,json=userSwap,proto3" json:"user_swap" yaml:"user_swap"`
+	// route is the list of trades of the cyclic arbitrage route
+	Route []Trade `protobuf:"bytes,4,rep,name=route,proto3" json:"route" yaml:"route"`
+	// token_in is the coin the route was entered with
+	TokenIn types.Coin `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// profit is the profit of the trade, in the denom of token_in
+	Profit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=profit,proto3,customtype=cosmossdk.io/math.Int" json:"profit" yaml:"profit"`
+	// pool_points is the number of pool points of the executed route
+	PoolPoints uint64 `protobuf:"varint,7,opt,name=pool_points,json=poolPoints,proto3" json:"pool_points,omitempty" yaml:"pool_points"`
+}
+
+func (m *TradeRecord) Reset()         { *m = TradeRecord{} }
+func (m *TradeRecord) String() string { return proto.CompactTextString(m) }
+func (*TradeRecord) ProtoMessage()    {}
+func (*TradeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{14}
+}
+func (m *TradeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeRecord.Merge(m, src)
+}
+func (m *TradeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeRecord proto.InternalMessageInfo
+
+func (m *TradeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TradeRecord) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TradeRecord) GetUserSwap() Trade {
+	if m != nil {
+		return m.UserSwap
+	}
+	return Trade{}
+}
+
+func (m *TradeRecord) GetRoute() []Trade {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *TradeRecord) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *TradeRecord) GetPoolPoints() uint64 {
+	if m != nil {
+		return m.PoolPoints
+	}
+	return 0
+}
+
+// ProfitShareRecipient is a recipient of a share of the profits of the module
+// left after the developer fee
+type ProfitShareRecipient struct {
+	// recipient is the type of the recipient
+	Recipient ProfitShareRecipientType `protobuf:"varint,1,opt,name=recipient,proto3,enum=osmosis.protorev.v1beta1.ProfitShareRecipientType" json:"recipient,omitempty" yaml:"recipient"`
+	// share is the share of the profits sent to the recipient
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share" yaml:"share"`
+}
+
+func (m *ProfitShareRecipient) Reset()         { *m = ProfitShareRecipient{} }
+func (m *ProfitShareRecipient) String() string { return proto.CompactTextString(m) }
+func (*ProfitShareRecipient) ProtoMessage()    {}
+func (*ProfitShareRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{15}
+}
+func (m *ProfitShareRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitShareRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitShareRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitShareRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitShareRecipient.Merge(m, src)
+}
+func (m *ProfitShareRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitShareRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitShareRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitShareRecipient proto.InternalMessageInfo
+
+func (m *ProfitShareRecipient) GetRecipient() ProfitShareRecipientType {
+	if m != nil {
+		return m.Recipient
+	}
+	return ProfitShareRecipientCommunityPool
+}
+
+// ProfitShareDistribution is the cumulative amount of the profits of the
+// module sent to a profit share recipient
+type ProfitShareDistribution struct {
+	// recipient is the type of the recipient
+	Recipient ProfitShareRecipientType `protobuf:"varint,1,opt,name=recipient,proto3,enum=osmosis.protorev.v1beta1.ProfitShareRecipientType" json:"recipient,omitempty" yaml:"recipient"`
+	// amount is the cumulative amount sent to the recipient
+	Amount []types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *ProfitShareDistribution) Reset()         { *m = ProfitShareDistribution{} }
+func (m *ProfitShareDistribution) String() string { return proto.CompactTextString(m) }
+func (*ProfitShareDistribution) ProtoMessage()    {}
+func (*ProfitShareDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{16}
+}
+func (m *ProfitShareDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitShareDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitShareDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitShareDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitShareDistribution.Merge(m, src)
+}
+func (m *ProfitShareDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitShareDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitShareDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitShareDistribution proto.InternalMessageInfo
+
+func (m *ProfitShareDistribution) GetRecipient() ProfitShareRecipientType {
+	if m != nil {
+		return m.Recipient
+	}
+	return ProfitShareRecipientCommunityPool
+}
+
+func (m *ProfitShareDistribution) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// PoolTradeCount is the number of trades executed by the module on a pool since
+// the profits were last shared with the profit share recipients
+type PoolTradeCount struct {
+	// pool_id is the id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// trade_count is the number of trades executed on the pool
+	TradeCount uint64 `protobuf:"varint,2,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty" yaml:"trade_count"`
+}
+
+func (m *PoolTradeCount) Reset()         { *m = PoolTradeCount{} }
+func (m *PoolTradeCount) String() string { return proto.CompactTextString(m) }
+func (*PoolTradeCount) ProtoMessage()    {}
+func (*PoolTradeCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{17}
+}
+func (m *PoolTradeCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTradeCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTradeCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTradeCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTradeCount.Merge(m, src)
+}
+func (m *PoolTradeCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTradeCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTradeCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTradeCount proto.InternalMessageInfo
+
+func (m *PoolTradeCount) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolTradeCount) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.protorev.v1beta1.ProfitShareRecipientType", ProfitShareRecipientType_name, ProfitShareRecipientType_value)
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
@@ -842,6 +1149,10 @@ func init() {
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
 	proto.RegisterType((*AllProtocolRevenue)(nil), "osmosis.protorev.v1beta1.AllProtocolRevenue")
 	proto.RegisterType((*CyclicArbTracker)(nil), "osmosis.protorev.v1beta1.CyclicArbTracker")
+	proto.RegisterType((*TradeRecord)(nil), "osmosis.protorev.v1beta1.TradeRecord")
+	proto.RegisterType((*ProfitShareRecipient)(nil), "osmosis.protorev.v1beta1.ProfitShareRecipient")
+	proto.RegisterType((*ProfitShareDistribution)(nil), "osmosis.protorev.v1beta1.ProfitShareDistribution")
+	proto.RegisterType((*PoolTradeCount)(nil), "osmosis.protorev.v1beta1.PoolTradeCount")
 }
 
 func init() {
@@ -849,79 +1160,104 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 1154 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0x6e, 0x1a, 0x8f, 0x5b, 0xdb, 0x99, 0xa4, 0xad, 0xe3, 0x82, 0x37, 0x4c, 0x2b,
-	0x70, 0x91, 0x6a, 0x2b, 0x86, 0x03, 0x2a, 0x2a, 0x52, 0x36, 0x28, 0x22, 0x20, 0x92, 0x68, 0x62,
-	0xa9, 0x82, 0xcb, 0x32, 0xbb, 0x1e, 0x3b, 0x2b, 0x7b, 0x77, 0xac, 0x9d, 0x71, 0x7e, 0x14, 0xa9,
-	0x17, 0x8e, 0x5c, 0xb8, 0x70, 0xe3, 0xc0, 0x0d, 0x2e, 0xfc, 0x0d, 0x5c, 0x7b, 0xec, 0xb1, 0xe2,
-	0xb0, 0x42, 0xc9, 0x05, 0x71, 0xf4, 0x5f, 0x80, 0x76, 0x66, 0x76, 0xed, 0x38, 0x71, 0x43, 0x24,
-	0xc4, 0x6d, 0xe7, 0xbd, 0xef, 0xfb, 0xde, 0xbc, 0xef, 0xcd, 0xce, 0x2e, 0x78, 0x8f, 0x71, 0x9f,
-	0x71, 0x8f, 0x37, 0x06, 0x21, 0x13, 0x2c, 0xa4, 0x87, 0x8d, 0xc3, 0x75, 0x87, 0x0a, 0xb2, 0x9e,
-	0x06, 0xea, 0xf2, 0x01, 0x96, 0x35, 0xb0, 0x9e, 0xc6, 0x35, 0xb0, 0xb2, 0xea, 0xca, 0x94, 0x2d,
-	0x13, 0x0d, 0xb5, 0x50, 0xa8, 0xca, 0x4a, 0x97, 0x75, 0x99, 0x8a, 0xc7, 0x4f, 0x3a, 0x5a, 0x55,
-	0x98, 0x86, 0x43, 0x38, 0x4d, 0xcb, 0xb9, 0xcc, 0x0b, 0x74, 0xfe, 0x51, 0xba, 0x27, 0xc6, 0xfa,
-	0x3e, 0x09, 0x48, 0x97, 0x86, 0x29, 0xae, 0x4b, 0x03, 0x9a, 0x6e, 0xa3, 0xf2, 0x30, 0x81, 0x8a,
-	0xe3, 0x0e, 0xa5, 0xfc, 0x72, 0x14, 0x7a, 0x6d, 0x00, 0xd8, 0x62, 0x3d, 0x1a, 0xec, 0x11, 0x2f,
-	0xdc, 0x08, 0x1d, 0xcc, 0x86, 0x82, 0x72, 0xf8, 0x15, 0x00, 0x24, 0x74, 0xec, 0x50, 0xae, 0xca,
-	0xc6, 0x5a, 0xa6, 0x96, 0x6f, 0x9a, 0xf5, 0x59, 0x7d, 0xd6, 0x25, 0xcb, 0x5a, 0x7d, 0x19, 0x99,
-	0x73, 0xa3, 0xc8, 0x5c, 0x3a, 0x21, 0x7e, 0xff, 0x09, 0x1a, 0x0b, 0x20, 0x9c, 0x23, 0xa9, 0x74,
-	0x1d, 0x2c, 0x8a, 0xb8, 0xa0, 0xed, 0x05, 0xe5, 0xf9, 0x35, 0xa3, 0x96, 0xb3, 0x96, 0x47, 0x91,
-	0x59, 0x54, 0x9c, 0x24, 0x83, 0xf0, 0x4d, 0xf9, 0xb8, 0x1d, 0xc0, 0x75, 0x90, 0x53, 0x51, 0x36,
-	0x14, 0xe5, 0x8c, 0x24, 0xac, 0x8c, 0x22, 0xb3, 0x34, 0x49, 0x60, 0x43, 0x81, 0xb0, 0x92, 0xdd,
-	0x1d, 0x8a, 0x27, 0xd9, 0xbf, 0x7e, 0x36, 0x0d, 0xf4, 0x9b, 0x01, 0x6e, 0xc8, 0x9a, 0x70, 0x07,
-	0x2c, 0x88, 0x90, 0xb4, 0xff, 0x4d, 0x27, 0xad, 0x18, 0x67, 0xdd, 0xd1, 0x9d, 0xdc, 0xd6, 0x45,
-	0x24, 0x19, 0x61, 0xad, 0x02, 0x77, 0x40, 0x8e, 0x0b, 0x3a, 0xb0, 0xb9, 0xf7, 0x9c, 0xea, 0x1e,
-	0xd6, 0x63, 0xc6, 0x1f, 0x91, 0x79, 0x47, 0x0d, 0x90, 0xb7, 0x7b, 0x75, 0x8f, 0x35, 0x7c, 0x22,
-	0x0e, 0xea, 0xdb, 0x81, 0x18, 0xef, 0x37, 0xe5, 0x21, 0xbc, 0x18, 0x3f, 0xef, 0x7b, 0xcf, 0xa9,
-	0xde, 0xef, 0x8f, 0x06, 0xb8, 0x21, 0xcb, 0xc3, 0x07, 0x20, 0x1b, 0xcf, 0xb7, 0x6c, 0xac, 0x19,
-	0xb5, 0xac, 0x55, 0x1c, 0x45, 0x66, 0x5e, 0xb1, 0xe3, 0x28, 0xc2, 0x32, 0xf9, 0xff, 0xf9, 0xf8,
-	0xb7, 0x01, 0x8a, 0xd2, 0xc7, 0x7d, 0x41, 0x84, 0xc7, 0x85, 0xe7, 0x72, 0xf8, 0x05, 0xb8, 0x39,
-	0x08, 0x59, 0xc7, 0x13, 0x89, 0xa5, 0xab, 0x75, 0x7d, 0xba, 0xe3, 0x93, 0x9b, 0xba, 0xb9, 0xc9,
-	0xbc, 0xc0, 0xba, 0xab, 0xcd, 0x2c, 0xe8, 0x1e, 0x14, 0x0f, 0xe1, 0x44, 0x01, 0x3a, 0xa0, 0x14,
-	0x0c, 0x7d, 0x87, 0x86, 0x36, 0xeb, 0xd8, 0x7a, 0x50, 0xaa, 0xa3, 0x8f, 0xae, 0x72, 0xf5, 0x9e,
-	0xd2, 0x9c, 0xa6, 0x23, 0x5c, 0x50, 0xa1, 0xdd, 0x4e, 0x4b, 0x8d, 0xec, 0x5d, 0x70, 0x43, 0x9e,
-	0xc5, 0x72, 0x66, 0x2d, 0x53, 0xcb, 0x5a, 0xa5, 0x51, 0x64, 0xde, 0x52, 0x5c, 0x19, 0x46, 0x58,
-	0xa5, 0xd1, 0x2f, 0xf3, 0x20, 0xbf, 0xc7, 0x58, 0xff, 0x19, 0xf5, 0xba, 0x07, 0x82, 0xc3, 0xa7,
-	0xe0, 0x36, 0x17, 0xc4, 0xe9, 0x53, 0xfb, 0x48, 0x46, 0xf4, 0x4c, 0xca, 0xa3, 0xc8, 0x5c, 0x49,
-	0x26, 0x3a, 0x91, 0x46, 0xf8, 0x96, 0x5a, 0x2b, 0x3e, 0xdc, 0x04, 0x45, 0x87, 0xf4, 0x49, 0xe0,
-	0xd2, 0x30, 0x11, 0x98, 0x97, 0x02, 0x95, 0x51, 0x64, 0xde, 0x55, 0x02, 0x53, 0x00, 0x84, 0x0b,
-	0x49, 0x44, 0x8b, 0xec, 0x82, 0x65, 0x97, 0x05, 0x2e, 0x0d, 0x44, 0x48, 0x04, 0x6d, 0x27, 0x42,
-	0x19, 0x29, 0x54, 0x1d, 0x45, 0x66, 0x45, 0x09, 0x5d, 0x02, 0x42, 0x18, 0x4e, 0x46, 0xc7, 0xbb,
-	0x8a, 0x0d, 0x3d, 0x22, 0xdc, 0x4f, 0xc4, 0xb2, 0xd3, 0xbb, 0x9a, 0x02, 0x20, 0x5c, 0x48, 0x22,
-	0x4a, 0x04, 0xfd, 0x94, 0x01, 0x85, 0xed, 0xa0, 0xc3, 0xac, 0x93, 0xd8, 0xaf, 0xd6, 0xc9, 0x80,
-	0xc2, 0x67, 0x60, 0x41, 0x75, 0x2f, 0x5d, 0xca, 0x37, 0x6b, 0xb3, 0xdf, 0xb3, 0x7d, 0x89, 0x8b,
-	0x99, 0x52, 0x63, 0xea, 0x85, 0x53, 0x2a, 0x08, 0x6b, 0x39, 0x68, 0x83, 0xc5, 0xc4, 0x13, 0xe9,
-	0x5f, 0xbe, 0xf9, 0xfe, 0x6c, 0x69, 0x4b, 0x23, 0x53, 0xf1, 0x7b, 0x5a, 0xbc, 0x78, 0xde, 0x6f,
-	0x84, 0x53, 0x51, 0xc8, 0xc0, 0xad, 0x49, 0x9f, 0xa4, 0xb7, 0xf9, 0x66, 0x7d, 0x76, 0x91, 0xcd,
-	0x09, 0x74, 0x5a, 0xe8, 0xbe, 0x2e, 0xb4, 0x7c, 0x71, 0x1e, 0x08, 0x9f, 0x2b, 0x10, 0x77, 0x94,
-	0xf8, 0x29, 0xbd, 0x7f, 0x63, 0x47, 0x9b, 0x1a, 0x39, 0xab, 0xa3, 0x44, 0x09, 0xe1, 0x54, 0x14,
-	0x7d, 0x0c, 0x0a, 0xe7, 0x3d, 0x86, 0x8f, 0xc0, 0xc2, 0xb9, 0x33, 0xbc, 0x34, 0xf6, 0x3b, 0x99,
-	0xb1, 0x06, 0xa0, 0xa7, 0xa0, 0x34, 0xed, 0xe2, 0x75, 0xe8, 0xdf, 0x1b, 0x60, 0xe5, 0x32, 0x83,
-	0xae, 0xa1, 0x01, 0x3f, 0x03, 0x4b, 0x3e, 0x39, 0xb6, 0x85, 0xe7, 0xf6, 0xb8, 0xed, 0x86, 0x8c,
-	0x73, 0xda, 0xd6, 0xef, 0xce, 0x5b, 0xa3, 0xc8, 0x2c, 0x2b, 0xd6, 0x05, 0x08, 0xc2, 0x45, 0x9f,
-	0x1c, 0xb7, 0xe2, 0xd0, 0xa6, 0x8e, 0x08, 0x50, 0x9a, 0x36, 0x10, 0x7e, 0x03, 0xf2, 0xaa, 0x8e,
-	0xed, 0x93, 0x41, 0x72, 0x87, 0x3d, 0x98, 0x3d, 0x01, 0x75, 0xe6, 0xbf, 0x24, 0x03, 0xab, 0xa2,
-	0xad, 0x87, 0x93, 0xdb, 0x96, 0x2a, 0x08, 0x83, 0xa3, 0x04, 0xc6, 0xd1, 0x0b, 0x90, 0x4b, 0x49,
-	0xd7, 0xe9, 0x7b, 0x0b, 0x94, 0x5c, 0x16, 0xfb, 0xe6, 0x0a, 0x9b, 0xb4, 0xdb, 0x21, 0xe5, 0xc9,
-	0x65, 0x78, 0x7f, 0x7c, 0xdf, 0x4d, 0x23, 0x10, 0x2e, 0x26, 0xa1, 0x0d, 0x1d, 0xf9, 0xce, 0x00,
-	0x39, 0x8b, 0x70, 0xfa, 0x29, 0x0d, 0x98, 0x1f, 0x5f, 0x7f, 0xed, 0xf8, 0x41, 0xd6, 0xcf, 0x4d,
-	0x5e, 0x7f, 0x32, 0x8c, 0xb0, 0x4a, 0xff, 0xd7, 0x5f, 0x36, 0xf4, 0xeb, 0x3c, 0x80, 0x1b, 0xfd,
-	0xfe, 0x5e, 0xec, 0xa7, 0xcb, 0xfa, 0x98, 0x1e, 0xd2, 0x60, 0x48, 0xe1, 0x0b, 0x00, 0x05, 0xe9,
-	0xd1, 0xd0, 0x8e, 0xff, 0x4c, 0xe2, 0x3b, 0xdb, 0xed, 0xd1, 0x50, 0x5f, 0x1a, 0x8f, 0xc7, 0x53,
-	0x18, 0xff, 0xe3, 0x8c, 0xbf, 0xcf, 0x31, 0x6d, 0x8b, 0x52, 0xde, 0x52, 0x24, 0xeb, 0x1d, 0x3d,
-	0x8f, 0x55, 0xfd, 0x1d, 0xbb, 0x20, 0x8b, 0x70, 0x49, 0x4c, 0x91, 0xe0, 0xb7, 0x00, 0xba, 0x27,
-	0x6e, 0xdf, 0x73, 0xed, 0xf8, 0x27, 0x25, 0xa9, 0x9f, 0xb9, 0xf2, 0x3d, 0x94, 0x9c, 0x8d, 0xd0,
-	0x99, 0x51, 0xfc, 0xa2, 0x26, 0xc2, 0x25, 0x77, 0x8a, 0xf4, 0x79, 0x76, 0x71, 0xbe, 0x94, 0xc1,
-	0x45, 0x71, 0x7c, 0x7e, 0x9b, 0xbf, 0x1b, 0xa0, 0x34, 0x5d, 0x00, 0x7e, 0x02, 0xc0, 0x58, 0xf4,
-	0xea, 0x4f, 0x6d, 0x36, 0xde, 0x0f, 0xce, 0xa5, 0x25, 0x61, 0x0f, 0xbc, 0x7d, 0xa0, 0x4e, 0x28,
-	0x71, 0x5d, 0x36, 0x0c, 0x84, 0x17, 0x74, 0x6d, 0x2e, 0x48, 0x28, 0xb8, 0xdd, 0x09, 0x99, 0x2f,
-	0x67, 0x9c, 0xb1, 0x6a, 0xa3, 0xc8, 0x7c, 0xa8, 0x7a, 0x78, 0x23, 0x1c, 0xe1, 0x8a, 0xca, 0x6f,
-	0xa4, 0xe9, 0x7d, 0x99, 0xdd, 0x0a, 0x99, 0x6f, 0xed, 0xbc, 0x3c, 0xad, 0x1a, 0xaf, 0x4e, 0xab,
-	0xc6, 0x9f, 0xa7, 0x55, 0xe3, 0x87, 0xb3, 0xea, 0xdc, 0xab, 0xb3, 0xea, 0xdc, 0xeb, 0xb3, 0xea,
-	0xdc, 0xd7, 0x1f, 0x76, 0x3d, 0x71, 0x30, 0x74, 0xea, 0x2e, 0xf3, 0x1b, 0xda, 0xdd, 0xc7, 0x7d,
-	0xe2, 0xf0, 0x64, 0xd1, 0x38, 0x6c, 0x36, 0x1b, 0xc7, 0xe3, 0x1f, 0x6d, 0x71, 0x32, 0xa0, 0xdc,
-	0x59, 0x90, 0xeb, 0x0f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x75, 0x81, 0xeb, 0x8b, 0x89, 0x0b,
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbd, 0x6f, 0x1b, 0xcb,
+	0x11, 0xd7, 0x89, 0xd4, 0x07, 0x97, 0x36, 0x49, 0xaf, 0x65, 0x9b, 0xa2, 0x1c, 0x9e, 0xde, 0xbe,
+	0x97, 0x44, 0x76, 0x60, 0x12, 0xa2, 0x03, 0x24, 0x70, 0xe0, 0x00, 0x22, 0x0d, 0x41, 0x8a, 0xbf,
+	0x84, 0xa5, 0x10, 0x23, 0x69, 0x2e, 0xcb, 0xe3, 0x8a, 0x3c, 0x88, 0x77, 0x4b, 0xdc, 0x2e, 0x25,
+	0xca, 0x01, 0x5c, 0x07, 0x48, 0x93, 0x26, 0x5d, 0x8a, 0x00, 0x29, 0x9c, 0x26, 0x7f, 0x43, 0x5a,
+	0x07, 0x69, 0x5c, 0x1a, 0x2e, 0x88, 0xc0, 0x6e, 0x82, 0x94, 0xfc, 0x0b, 0x82, 0xfd, 0xb8, 0x3b,
+	0x8a, 0x22, 0x2d, 0x1b, 0x78, 0x70, 0x77, 0x3b, 0x33, 0xbf, 0xdf, 0xec, 0xcc, 0xec, 0xec, 0x0e,
+	0x0e, 0xfc, 0x98, 0x71, 0x9f, 0x71, 0x8f, 0x57, 0xfb, 0x21, 0x13, 0x2c, 0xa4, 0x27, 0xd5, 0x93,
+	0xed, 0x16, 0x15, 0x64, 0x3b, 0x16, 0x54, 0xd4, 0x07, 0x2c, 0x1a, 0xc3, 0x4a, 0x2c, 0x37, 0x86,
+	0xa5, 0x75, 0x57, 0xa9, 0x1c, 0xa5, 0xa8, 0xea, 0x85, 0xb6, 0x2a, 0xad, 0x75, 0x58, 0x87, 0x69,
+	0xb9, 0xfc, 0x32, 0xd2, 0xb2, 0xb6, 0xa9, 0xb6, 0x08, 0xa7, 0xb1, 0x3b, 0x97, 0x79, 0x81, 0xd1,
+	0xdf, 0x89, 0xf7, 0xc4, 0x58, 0xcf, 0x27, 0x01, 0xe9, 0xd0, 0x30, 0xb6, 0xeb, 0xd0, 0x80, 0xc6,
+	0xdb, 0x28, 0x7d, 0x17, 0x99, 0x8a, 0xe1, 0x11, 0xa5, 0x7c, 0xb6, 0x15, 0x7a, 0x67, 0x01, 0x78,
+	0xc8, 0x8e, 0x69, 0x70, 0x40, 0xbc, 0x70, 0x27, 0x6c, 0x61, 0x36, 0x10, 0x94, 0xc3, 0xdf, 0x00,
+	0x40, 0xc2, 0x96, 0x13, 0xaa, 0x55, 0xd1, 0xda, 0x4c, 0x6d, 0x65, 0x6b, 0x76, 0x65, 0x5e, 0x9c,
+	0x15, 0x85, 0xaa, 0xaf, 0xbf, 0x19, 0xd9, 0x0b, 0xe3, 0x91, 0x7d, 0xed, 0x8c, 0xf8, 0xbd, 0x07,
+	0x28, 0x21, 0x40, 0x38, 0x43, 0x62, 0xea, 0x0a, 0x58, 0x15, 0xd2, 0xa1, 0xe3, 0x05, 0xc5, 0xc5,
+	0x4d, 0x6b, 0x2b, 0x53, 0xbf, 0x3e, 0x1e, 0xd9, 0x79, 0x8d, 0x89, 0x34, 0x08, 0xaf, 0xa8, 0xcf,
+	0xfd, 0x00, 0x6e, 0x83, 0x8c, 0x96, 0xb2, 0x81, 0x28, 0xa6, 0x14, 0x60, 0x6d, 0x3c, 0xb2, 0x0b,
+	0x93, 0x00, 0x36, 0x10, 0x08, 0x6b, 0xda, 0xe7, 0x03, 0xf1, 0x20, 0xfd, 0xdf, 0xbf, 0xda, 0x16,
+	0xfa, 0x87, 0x05, 0x96, 0x94, 0x4f, 0xf8, 0x0c, 0x2c, 0x8b, 0x90, 0xb4, 0x3f, 0x27, 0x92, 0x43,
+	0x69, 0x57, 0xbf, 0x61, 0x22, 0xb9, 0x6a, 0x9c, 0x28, 0x30, 0xc2, 0x86, 0x05, 0x3e, 0x03, 0x19,
+	0x2e, 0x68, 0xdf, 0xe1, 0xde, 0x4b, 0x6a, 0x62, 0xd8, 0x96, 0x88, 0xf7, 0x23, 0xfb, 0x86, 0x2e,
+	0x20, 0x6f, 0x1f, 0x57, 0x3c, 0x56, 0xf5, 0x89, 0xe8, 0x56, 0xf6, 0x03, 0x91, 0xec, 0x37, 0xc6,
+	0x21, 0xbc, 0x2a, 0xbf, 0x9b, 0xde, 0x4b, 0x6a, 0xf6, 0xfb, 0x67, 0x0b, 0x2c, 0x29, 0xf7, 0xf0,
+	0x5b, 0x90, 0x96, 0xf5, 0x2d, 0x5a, 0x9b, 0xd6, 0x56, 0xba, 0x9e, 0x1f, 0x8f, 0xec, 0xac, 0x46,
+	0x4b, 0x29, 0xc2, 0x4a, 0xf9, 0xf5, 0xf2, 0xf8, 0x3f, 0x0b, 0xe4, 0x55, 0x1e, 0x9b, 0x82, 0x08,
+	0x8f, 0x0b, 0xcf, 0xe5, 0xf0, 0x31, 0x58, 0xe9, 0x87, 0xec, 0xc8, 0x13, 0x51, 0x4a, 0xd7, 0x2b,
+	0xe6, 0x74, 0xcb, 0x93, 0x1b, 0x67, 0xb3, 0xc1, 0xbc, 0xa0, 0x7e, 0xd3, 0x24, 0x33, 0x67, 0x62,
+	0xd0, 0x38, 0x84, 0x23, 0x06, 0xd8, 0x02, 0x85, 0x60, 0xe0, 0xb7, 0x68, 0xe8, 0xb0, 0x23, 0xc7,
+	0x14, 0x4a, 0x47, 0xf4, 0xf3, 0xcb, 0xb2, 0x7a, 0x4b, 0x73, 0x4e, 0xc3, 0x11, 0xce, 0x69, 0xd1,
+	0xf3, 0xa3, 0x43, 0x5d, 0xb2, 0x1f, 0x81, 0x25, 0x75, 0x16, 0x8b, 0xa9, 0xcd, 0xd4, 0x56, 0xba,
+	0x5e, 0x18, 0x8f, 0xec, 0x2b, 0x1a, 0xab, 0xc4, 0x08, 0x6b, 0x35, 0x7a, 0xbd, 0x08, 0xb2, 0x07,
+	0x8c, 0xf5, 0x5e, 0x50, 0xaf, 0xd3, 0x15, 0x1c, 0x3e, 0x04, 0x57, 0xb9, 0x20, 0xad, 0x1e, 0x75,
+	0x4e, 0x95, 0xc4, 0xd4, 0xa4, 0x38, 0x1e, 0xd9, 0x6b, 0x51, 0x45, 0x27, 0xd4, 0x08, 0x5f, 0xd1,
+	0x6b, 0x8d, 0x87, 0x0d, 0x90, 0x6f, 0x91, 0x1e, 0x09, 0x5c, 0x1a, 0x46, 0x04, 0x8b, 0x8a, 0xa0,
+	0x34, 0x1e, 0xd9, 0x37, 0x35, 0xc1, 0x94, 0x01, 0xc2, 0xb9, 0x48, 0x62, 0x48, 0x9e, 0x83, 0xeb,
+	0x2e, 0x0b, 0x5c, 0x1a, 0x88, 0x90, 0x08, 0xda, 0x8e, 0x88, 0x52, 0x8a, 0xa8, 0x3c, 0x1e, 0xd9,
+	0x25, 0x4d, 0x34, 0xc3, 0x08, 0x61, 0x38, 0x29, 0x4d, 0x76, 0x25, 0x13, 0x7a, 0x4a, 0xb8, 0x1f,
+	0x91, 0xa5, 0xa7, 0x77, 0x35, 0x65, 0x80, 0x70, 0x2e, 0x92, 0x68, 0x12, 0xf4, 0x97, 0x14, 0xc8,
+	0xed, 0x07, 0x47, 0xac, 0x7e, 0x26, 0xf3, 0x75, 0x78, 0xd6, 0xa7, 0xf0, 0x05, 0x58, 0xd6, 0xd1,
+	0xab, 0x2c, 0x65, 0x6b, 0x5b, 0xf3, 0xfb, 0xac, 0xa9, 0xec, 0x24, 0x52, 0x71, 0x4c, 0x35, 0x9c,
+	0x66, 0x41, 0xd8, 0xd0, 0x41, 0x07, 0xac, 0x46, 0x39, 0x51, 0xf9, 0xcb, 0xd6, 0xee, 0xce, 0xa7,
+	0xae, 0x1b, 0xcb, 0x98, 0xfc, 0x96, 0x21, 0xcf, 0x9f, 0xcf, 0x37, 0xc2, 0x31, 0x29, 0x64, 0xe0,
+	0xca, 0x64, 0x9e, 0x54, 0x6e, 0xb3, 0xb5, 0xca, 0x7c, 0x27, 0x8d, 0x09, 0xeb, 0xd8, 0xd1, 0x86,
+	0x71, 0x74, 0xfd, 0x62, 0x3d, 0x10, 0x3e, 0xe7, 0x40, 0x46, 0x14, 0xe5, 0xb3, 0x98, 0xbe, 0x2c,
+	0xa2, 0x86, 0xb1, 0x9c, 0x17, 0x51, 0xc4, 0x84, 0x70, 0x4c, 0x8a, 0x7e, 0x01, 0x72, 0xe7, 0x73,
+	0x0c, 0xef, 0x80, 0xe5, 0x73, 0x67, 0xf8, 0x5a, 0x92, 0xef, 0xa8, 0xc6, 0xc6, 0x00, 0x3d, 0x04,
+	0x85, 0xe9, 0x2c, 0x7e, 0x09, 0xfc, 0x8f, 0x16, 0x58, 0x9b, 0x95, 0xa0, 0x2f, 0xe0, 0x80, 0x7b,
+	0xe0, 0x9a, 0x4f, 0x86, 0x8e, 0xf0, 0xdc, 0x63, 0xee, 0xb8, 0x21, 0xe3, 0x9c, 0xb6, 0x4d, 0xef,
+	0xdc, 0x1e, 0x8f, 0xec, 0xa2, 0x46, 0x5d, 0x30, 0x41, 0x38, 0xef, 0x93, 0xe1, 0xa1, 0x14, 0x35,
+	0x8c, 0x44, 0x80, 0xc2, 0x74, 0x02, 0xe1, 0xef, 0x40, 0x56, 0xfb, 0x71, 0x7c, 0xd2, 0x8f, 0xee,
+	0xb0, 0x6f, 0xe7, 0x57, 0x40, 0x9f, 0xf9, 0xa7, 0xa4, 0x5f, 0x2f, 0x99, 0xd4, 0xc3, 0xc9, 0x6d,
+	0x2b, 0x16, 0x84, 0xc1, 0x69, 0x64, 0xc6, 0xd1, 0x2b, 0x90, 0x89, 0x41, 0x5f, 0x12, 0xf7, 0x2e,
+	0x28, 0xb8, 0x4c, 0xe6, 0xcd, 0x15, 0x0e, 0x69, 0xb7, 0x43, 0xca, 0xa3, 0xcb, 0x70, 0x23, 0xb9,
+	0xef, 0xa6, 0x2d, 0x10, 0xce, 0x47, 0xa2, 0x1d, 0x23, 0x79, 0x67, 0x81, 0x4c, 0x9d, 0x70, 0xfa,
+	0x88, 0x06, 0xcc, 0x97, 0xd7, 0x5f, 0x5b, 0x7e, 0x28, 0xff, 0x99, 0xc9, 0xeb, 0x4f, 0x89, 0x11,
+	0xd6, 0xea, 0xef, 0xfb, 0x65, 0x83, 0x4d, 0x70, 0x43, 0x96, 0xc8, 0x3d, 0x73, 0x7b, 0x9e, 0xab,
+	0xa7, 0x01, 0xa7, 0xcb, 0xfa, 0xdc, 0x5c, 0x5e, 0x9b, 0xe3, 0x91, 0x7d, 0x3b, 0xa9, 0xe4, 0x05,
+	0x33, 0x84, 0xa1, 0x4f, 0x86, 0x0d, 0x25, 0x56, 0x4f, 0xd0, 0x9e, 0x14, 0xfe, 0x7d, 0x11, 0xc0,
+	0x9d, 0x5e, 0xef, 0x40, 0x16, 0xc9, 0x65, 0x3d, 0x4c, 0x4f, 0x68, 0x30, 0xa0, 0xf0, 0x15, 0x80,
+	0x82, 0x1c, 0xd3, 0xd0, 0x91, 0xe3, 0x8e, 0x7c, 0x08, 0xdc, 0x63, 0x1a, 0x9a, 0x9b, 0xe8, 0x5e,
+	0x52, 0xda, 0x64, 0x70, 0x4a, 0x1e, 0x7d, 0x09, 0xdb, 0xa5, 0x94, 0x1f, 0x6a, 0x50, 0xfd, 0x1b,
+	0x53, 0xe4, 0x75, 0xf3, 0x38, 0x5e, 0xa0, 0x45, 0xb8, 0x20, 0xa6, 0x40, 0xf0, 0xf7, 0x00, 0x9a,
+	0x00, 0xe4, 0xe4, 0x13, 0xf9, 0x4f, 0x5d, 0xda, 0xdc, 0x0a, 0xb3, 0x13, 0xb6, 0xe6, 0x38, 0xbf,
+	0xc8, 0x89, 0x70, 0xc1, 0x9d, 0x02, 0xfd, 0x2a, 0xbd, 0xba, 0x58, 0x48, 0xe1, 0xbc, 0x18, 0x9e,
+	0xdf, 0xe6, 0x3f, 0x2d, 0x50, 0x98, 0x76, 0x00, 0x7f, 0x09, 0x40, 0x42, 0x7a, 0xf9, 0xfb, 0x9d,
+	0x96, 0xfb, 0xc1, 0x99, 0xd8, 0x25, 0x3c, 0x06, 0x3f, 0xe8, 0xea, 0x63, 0x4f, 0x5c, 0x97, 0x0d,
+	0x02, 0xe1, 0x05, 0x1d, 0x87, 0x0b, 0x12, 0x0a, 0xee, 0x1c, 0x85, 0xcc, 0x57, 0x07, 0x27, 0x55,
+	0xdf, 0x1a, 0x8f, 0xec, 0xef, 0x74, 0x0c, 0x9f, 0x34, 0x47, 0xb8, 0xa4, 0xf5, 0x3b, 0xb1, 0xba,
+	0xa9, 0xb4, 0xbb, 0x52, 0xf9, 0x3e, 0x05, 0xb2, 0xea, 0x0d, 0xc7, 0xd4, 0x65, 0x61, 0x5b, 0xb6,
+	0x52, 0x37, 0x69, 0xa5, 0xd4, 0x64, 0x2b, 0x75, 0xa3, 0x56, 0xd2, 0x1f, 0xf0, 0x27, 0x60, 0x45,
+	0x0c, 0x9d, 0x2e, 0xe1, 0x5d, 0x73, 0x94, 0x61, 0x32, 0x85, 0x18, 0x85, 0x9c, 0xe9, 0x86, 0x7b,
+	0x84, 0x77, 0xe1, 0xaf, 0x41, 0x66, 0xc0, 0x69, 0xe8, 0xf0, 0x53, 0xd2, 0x37, 0x45, 0xbb, 0x74,
+	0x4c, 0x2c, 0x9a, 0x4a, 0x99, 0x0e, 0x88, 0xf1, 0x08, 0xaf, 0xca, 0xef, 0xe6, 0x29, 0xe9, 0xc3,
+	0xc7, 0xd1, 0xe0, 0x91, 0xfe, 0xbc, 0xd1, 0x73, 0xcd, 0x70, 0xce, 0x9a, 0x4e, 0xe0, 0xd3, 0x89,
+	0x99, 0x6f, 0x69, 0xd3, 0xfa, 0x74, 0xdd, 0xa6, 0x1e, 0x89, 0x19, 0x23, 0xe1, 0x2e, 0x58, 0xd6,
+	0x33, 0x58, 0x71, 0x59, 0xe5, 0xa7, 0x72, 0x59, 0xab, 0x5f, 0x9d, 0x1c, 0xe1, 0x10, 0x36, 0x68,
+	0xf8, 0x33, 0x90, 0x95, 0x6d, 0xe5, 0xf4, 0x99, 0x17, 0x08, 0x5e, 0x5c, 0x51, 0xbd, 0x7d, 0x33,
+	0xb9, 0x24, 0x27, 0x94, 0x08, 0x03, 0xb9, 0x3a, 0xd0, 0x8b, 0x7f, 0x59, 0x60, 0xed, 0x40, 0x71,
+	0x34, 0xbb, 0x24, 0x94, 0x25, 0xf6, 0xfa, 0x1e, 0x0d, 0x04, 0x3c, 0x02, 0x99, 0x30, 0x5a, 0xa8,
+	0x42, 0xe7, 0x6a, 0xb5, 0xf9, 0x99, 0x9b, 0x45, 0x21, 0x07, 0x92, 0xc9, 0x01, 0x37, 0xa6, 0x43,
+	0x38, 0xa1, 0x86, 0xfb, 0x60, 0x89, 0x4b, 0x98, 0x39, 0x20, 0xf7, 0x4d, 0x02, 0x36, 0x2e, 0x26,
+	0xe0, 0x09, 0xed, 0x10, 0xf7, 0xec, 0x11, 0x75, 0x93, 0xda, 0x28, 0x24, 0xc2, 0x9a, 0x01, 0xfd,
+	0xdb, 0x02, 0xb7, 0x26, 0x36, 0xf2, 0xc8, 0xe3, 0x22, 0xf4, 0x5a, 0x03, 0xe1, 0xb1, 0xe0, 0xab,
+	0x85, 0xb3, 0x07, 0x96, 0x89, 0x2f, 0x7b, 0xa8, 0xb8, 0x78, 0x59, 0x57, 0x4f, 0x4d, 0x5c, 0x1a,
+	0x86, 0xb0, 0xc1, 0xa3, 0x13, 0x90, 0x53, 0x63, 0x9d, 0x3c, 0x93, 0x0d, 0x29, 0x91, 0xdd, 0xa4,
+	0xea, 0xe8, 0xb5, 0xcd, 0x23, 0x36, 0xd1, 0x4d, 0x46, 0x21, 0x4f, 0x84, 0x7c, 0x61, 0xdb, 0xf2,
+	0x44, 0xa8, 0x49, 0xdc, 0x71, 0xcd, 0x6e, 0xa6, 0x4e, 0xc4, 0x84, 0x12, 0x61, 0x20, 0x62, 0x2f,
+	0x77, 0x5f, 0x5b, 0xa0, 0x38, 0x2f, 0x7e, 0xf8, 0x43, 0xf0, 0xcd, 0x2c, 0x5d, 0x83, 0xf9, 0xfe,
+	0x20, 0xf0, 0x84, 0x1a, 0x44, 0x0b, 0x0b, 0xf0, 0xf6, 0x6c, 0x8a, 0xfa, 0x20, 0x0c, 0x0a, 0x16,
+	0xb4, 0xc1, 0xc6, 0x2c, 0x6d, 0x53, 0xdd, 0xe7, 0xbc, 0xb0, 0x38, 0xcf, 0x40, 0x92, 0x3f, 0x39,
+	0xe0, 0x85, 0x54, 0x29, 0xfd, 0x87, 0xbf, 0x95, 0x17, 0xea, 0xcf, 0xde, 0x7c, 0x28, 0x5b, 0x6f,
+	0x3f, 0x94, 0xad, 0xff, 0x7c, 0x28, 0x5b, 0x7f, 0xfa, 0x58, 0x5e, 0x78, 0xfb, 0xb1, 0xbc, 0xf0,
+	0xee, 0x63, 0x79, 0xe1, 0xb7, 0x3f, 0xed, 0x78, 0xa2, 0x3b, 0x68, 0x55, 0x5c, 0xe6, 0x57, 0x4d,
+	0x91, 0xef, 0xf5, 0x48, 0x8b, 0x47, 0x8b, 0xea, 0x49, 0xad, 0x56, 0x1d, 0x26, 0xbf, 0x15, 0xc4,
+	0x59, 0x9f, 0xf2, 0xd6, 0xb2, 0x5a, 0xdf, 0xff, 0xff, 0x00, 0x8a, 0xda, 0x29, 0xe9, 0x77, 0x10,
 	0x00, 0x00,
 }
 
@@ -1514,6 +1850,11 @@ func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCyclicRouteHops != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.MaxCyclicRouteHops))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.StepSize.Size()
 		i -= size
@@ -1619,53 +1960,250 @@ func (m *CyclicArbTracker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtorev(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TradeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenPairArbRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TradeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ArbRoutes) > 0 {
-		for _, e := range m.ArbRoutes {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
+	if m.PoolPoints != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolPoints))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Route[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	{
+		size, err := m.UserSwap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Route) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProfitShareRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitShareRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitShareRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Trades) > 0 {
-		for _, e := range m.Trades {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	l = m.StepSize.Size()
+	i--
+	dAtA[i] = 0x12
+	if m.Recipient != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProfitShareDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfitShareDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitShareDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Recipient != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Recipient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTradeCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTradeCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTradeCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeCount != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPairArbRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArbRoutes) > 0 {
+		for _, e := range m.ArbRoutes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.StepSize.Size()
 	n += 1 + l + sovProtorev(uint64(l))
 	return n
 }
@@ -1834,6 +2372,9 @@ func (m *BaseDenom) Size() (n int) {
 	}
 	l = m.StepSize.Size()
 	n += 1 + l + sovProtorev(uint64(l))
+	if m.MaxCyclicRouteHops != 0 {
+		n += 1 + sovProtorev(uint64(m.MaxCyclicRouteHops))
+	}
 	return n
 }
 
@@ -1868,6 +2409,84 @@ func (m *CyclicArbTracker) Size() (n int) {
 	return n
 }
 
+func (m *TradeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProtorev(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = m.UserSwap.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	if m.PoolPoints != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolPoints))
+	}
+	return n
+}
+
+func (m *ProfitShareRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Recipient != 0 {
+		n += 1 + sovProtorev(uint64(m.Recipient))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *ProfitShareDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Recipient != 0 {
+		n += 1 + sovProtorev(uint64(m.Recipient))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolTradeCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovProtorev(uint64(m.PoolId))
+	}
+	if m.TradeCount != 0 {
+		n += 1 + sovProtorev(uint64(m.TradeCount))
+	}
+	return n
+}
+
 func sovProtorev(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3281,6 +3900,25 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCyclicRouteHops", wireType)
+			}
+			m.MaxCyclicRouteHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCyclicRouteHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -3521,6 +4159,554 @@ func (m *CyclicArbTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TradeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserSwap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, Trade{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPoints", wireType)
+			}
+			m.PoolPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPoints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitShareRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitShareRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitShareRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= ProfitShareRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitShareDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitShareDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitShareDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			m.Recipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Recipient |= ProfitShareRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolTradeCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTradeCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTradeCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProtorev(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,7 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1389,6 +1389,299 @@ func (m *QueryGetProtoRevPoolResponse) GetPoolId() uint64 {
 	return 0
 }
 
+// QueryGetProtoRevCyclicRoutesRequest is request type for the
+// Query/GetProtoRevCyclicRoutes RPC method.
+type QueryGetProtoRevCyclicRoutesRequest struct {
+	// pool_id is the id of the swapped pool to discover the routes of
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryGetProtoRevCyclicRoutesRequest) Reset()         { *m = QueryGetProtoRevCyclicRoutesRequest{} }
+func (m *QueryGetProtoRevCyclicRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevCyclicRoutesRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevCyclicRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{30}
+}
+func (m *QueryGetProtoRevCyclicRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevCyclicRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevCyclicRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevCyclicRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevCyclicRoutesRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevCyclicRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevCyclicRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevCyclicRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevCyclicRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevCyclicRoutesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// QueryGetProtoRevCyclicRoutesResponse is response type for the
+// Query/GetProtoRevCyclicRoutes RPC method.
+type QueryGetProtoRevCyclicRoutesResponse struct {
+	// routes is the list of the routes discovered for swaps in either direction
+	// between any two denoms of the pool, in the order they would be simulated
+	Routes []Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *QueryGetProtoRevCyclicRoutesResponse) Reset()         { *m = QueryGetProtoRevCyclicRoutesResponse{} }
+func (m *QueryGetProtoRevCyclicRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevCyclicRoutesResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevCyclicRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{31}
+}
+func (m *QueryGetProtoRevCyclicRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevCyclicRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevCyclicRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevCyclicRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevCyclicRoutesResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevCyclicRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevCyclicRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevCyclicRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevCyclicRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevCyclicRoutesResponse) GetRoutes() []Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// QueryGetProtoRevTradeHistoryRequest is request type for the
+// Query/GetProtoRevTradeHistory RPC method.
+type QueryGetProtoRevTradeHistoryRequest struct {
+	// pagination defines an optional pagination for the request, the trades
+	// being sorted by height
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetProtoRevTradeHistoryRequest) Reset()         { *m = QueryGetProtoRevTradeHistoryRequest{} }
+func (m *QueryGetProtoRevTradeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevTradeHistoryRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevTradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevTradeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTradeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTradeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTradeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTradeHistoryRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevTradeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTradeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTradeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTradeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTradeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetProtoRevTradeHistoryResponse is response type for the
+// Query/GetProtoRevTradeHistory RPC method.
+type QueryGetProtoRevTradeHistoryResponse struct {
+	// trades is the list of the trades executed by the module
+	Trades []TradeRecord `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades" yaml:"trades"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetProtoRevTradeHistoryResponse) Reset()         { *m = QueryGetProtoRevTradeHistoryResponse{} }
+func (m *QueryGetProtoRevTradeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevTradeHistoryResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevTradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevTradeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTradeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTradeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTradeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTradeHistoryResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevTradeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTradeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTradeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTradeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTradeHistoryResponse) GetTrades() []TradeRecord {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevTradeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetProtoRevProfitSharesDistributedRequest is request type for the
+// Query/GetProtoRevProfitSharesDistributed RPC method.
+type QueryGetProtoRevProfitSharesDistributedRequest struct {
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) Reset() {
+	*m = QueryGetProtoRevProfitSharesDistributedRequest{}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitSharesDistributedRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProfitSharesDistributedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevProfitSharesDistributedResponse is response type for the
+// Query/GetProtoRevProfitSharesDistributed RPC method.
+type QueryGetProtoRevProfitSharesDistributedResponse struct {
+	// distributions is the list of the cumulative amounts sent to each profit
+	// share recipient
+	Distributions []ProfitShareDistribution `protobuf:"bytes,1,rep,name=distributions,proto3" json:"distributions" yaml:"distributions"`
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) Reset() {
+	*m = QueryGetProtoRevProfitSharesDistributedResponse{}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitSharesDistributedResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProfitSharesDistributedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevProfitSharesDistributedResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) GetDistributions() []ProfitShareDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
 type QueryGetAllProtocolRevenueRequest struct {
 }
 
@@ -1396,7 +1689,7 @@ func (m *QueryGetAllProtocolRevenueRequest) Reset()         { *m = QueryGetAllPr
 func (m *QueryGetAllProtocolRevenueRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllProtocolRevenueRequest) ProtoMessage()    {}
 func (*QueryGetAllProtocolRevenueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{30}
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
 }
 func (m *QueryGetAllProtocolRevenueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1726,7 @@ func (m *QueryGetAllProtocolRevenueResponse) Reset()         { *m = QueryGetAllP
 func (m *QueryGetAllProtocolRevenueResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllProtocolRevenueResponse) ProtoMessage()    {}
 func (*QueryGetAllProtocolRevenueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{31}
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
 }
 func (m *QueryGetAllProtocolRevenueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,6 +1793,12 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
 	proto.RegisterType((*QueryGetProtoRevPoolRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolRequest")
 	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
+	proto.RegisterType((*QueryGetProtoRevCyclicRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevCyclicRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevCyclicRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevCyclicRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevTradeHistoryRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTradeHistoryRequest")
+	proto.RegisterType((*QueryGetProtoRevTradeHistoryResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTradeHistoryResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitSharesDistributedRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSharesDistributedRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitSharesDistributedResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSharesDistributedResponse")
 	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
 	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
 }
@@ -1509,108 +1808,123 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0x1b, 0xd5,
-	0x16, 0xce, 0xf4, 0x92, 0x9c, 0xee, 0x5e, 0x4e, 0xb3, 0x4f, 0x92, 0x3a, 0x93, 0xd4, 0x76, 0x76,
-	0x92, 0xe6, 0xd6, 0xd8, 0xa7, 0x69, 0xcf, 0xa1, 0x5c, 0x0a, 0x64, 0x1a, 0xa8, 0xa2, 0x8a, 0xc6,
-	0x0c, 0xe1, 0x05, 0x24, 0xcc, 0xd8, 0x9e, 0xa4, 0xa3, 0x8e, 0x67, 0x4f, 0x67, 0xc6, 0x51, 0xfc,
-	0x4a, 0x25, 0x10, 0x12, 0x12, 0xb7, 0x1f, 0x00, 0xcf, 0x88, 0x3f, 0xc0, 0x23, 0x3c, 0x55, 0xf0,
-	0x52, 0x84, 0x84, 0x50, 0x41, 0x16, 0x6a, 0x79, 0xe0, 0xd9, 0x3f, 0x00, 0xa1, 0xd9, 0x7b, 0x8d,
-	0x3d, 0x9e, 0x3d, 0xe3, 0x78, 0x12, 0x89, 0x37, 0x7b, 0xf6, 0x5a, 0xdf, 0xfa, 0xbe, 0x7d, 0xfd,
-	0x16, 0x9a, 0xa3, 0x6e, 0x9d, 0xba, 0x86, 0x5b, 0xb4, 0x1d, 0xea, 0x51, 0x47, 0xdf, 0x2b, 0xee,
-	0x5d, 0xa9, 0xe8, 0x9e, 0x76, 0xa5, 0x78, 0xbf, 0xa1, 0x3b, 0xcd, 0x02, 0xfb, 0x8c, 0x33, 0x10,
-	0x55, 0x08, 0xa2, 0x0a, 0x10, 0x25, 0x8f, 0xed, 0xd2, 0x5d, 0xca, 0xbe, 0x16, 0xfd, 0x5f, 0x3c,
-	0x40, 0x9e, 0xde, 0xa5, 0x74, 0xd7, 0xd4, 0x8b, 0x9a, 0x6d, 0x14, 0x35, 0xcb, 0xa2, 0x9e, 0xe6,
-	0x19, 0xd4, 0x82, 0x74, 0x79, 0xb9, 0xca, 0xe0, 0x8a, 0x15, 0xcd, 0xd5, 0x79, 0x99, 0x4e, 0x51,
-	0x5b, 0xdb, 0x35, 0x2c, 0x16, 0x0c, 0xb1, 0xf3, 0x89, 0xfc, 0x6c, 0xcd, 0xd1, 0xea, 0x01, 0xe4,
-	0x42, 0x72, 0x58, 0xc0, 0x98, 0x07, 0x66, 0xc3, 0xb5, 0x83, 0x98, 0x2a, 0x35, 0xa0, 0x1e, 0x19,
-	0x43, 0xf8, 0x75, 0x9f, 0x51, 0x89, 0xa1, 0xab, 0xfa, 0xfd, 0x86, 0xee, 0x7a, 0x64, 0x07, 0xfd,
-	0xa7, 0xe7, 0xab, 0x6b, 0x53, 0xcb, 0xd5, 0xf1, 0x16, 0x1a, 0xe6, 0x2c, 0x32, 0x52, 0x5e, 0x5a,
-	0x3c, 0xbd, 0x96, 0x2f, 0x24, 0xcd, 0x53, 0x81, 0x67, 0x2a, 0xe3, 0x0f, 0x5b, 0xb9, 0xa1, 0x76,
-	0x2b, 0x77, 0xb6, 0xa9, 0xd5, 0xcd, 0xe7, 0x08, 0xcf, 0x26, 0x2a, 0xc0, 0x90, 0x05, 0x34, 0xcf,
-	0xea, 0xdc, 0xd2, 0xbd, 0x92, 0x8f, 0xa0, 0xea, 0x7b, 0x77, 0x1a, 0xf5, 0x8a, 0xee, 0x6c, 0xed,
-	0x6c, 0x3b, 0x5a, 0x4d, 0xef, 0x10, 0xfa, 0x48, 0x42, 0x97, 0x0e, 0x8a, 0x04, 0x92, 0x15, 0x74,
-	0xde, 0x62, 0x23, 0x65, 0xba, 0x53, 0xf6, 0xd8, 0x18, 0xa3, 0x7b, 0x4a, 0xb9, 0xee, 0x93, 0x79,
-	0xdc, 0xca, 0x8d, 0xf3, 0x39, 0x71, 0x6b, 0xf7, 0x0a, 0x06, 0x2d, 0xd6, 0x35, 0xef, 0x6e, 0x61,
-	0xd3, 0xf2, 0xda, 0xad, 0xdc, 0x05, 0xce, 0x32, 0x9a, 0x4e, 0xd4, 0x73, 0x56, 0x4f, 0x2d, 0xb2,
-	0x25, 0xf2, 0x2e, 0x39, 0x74, 0xc7, 0xf0, 0x5c, 0xa5, 0xb9, 0xa1, 0x5b, 0xb4, 0x0e, 0xbc, 0xf1,
-	0x25, 0x74, 0xb2, 0xe6, 0xff, 0x07, 0x06, 0xe7, 0xdb, 0xad, 0xdc, 0x19, 0x5e, 0x84, 0x7d, 0x26,
-	0x2a, 0x1f, 0x26, 0x96, 0x28, 0x2f, 0x0a, 0x08, 0xf2, 0x36, 0xd0, 0xb0, 0xcd, 0x46, 0x60, 0x0d,
-	0x26, 0x0b, 0x5c, 0x4d, 0xc1, 0x5f, 0xe1, 0xce, 0xf4, 0xdf, 0xa4, 0x86, 0xa5, 0x8c, 0x86, 0x26,
-	0x9e, 0xa5, 0xf8, 0x13, 0xcf, 0x7f, 0xcc, 0xa2, 0x99, 0x68, 0xbd, 0x75, 0xd3, 0x84, 0x92, 0xc1,
-	0xa4, 0xdf, 0x47, 0xa4, 0x5f, 0x10, 0x10, 0xba, 0x8d, 0x46, 0x38, 0xa8, 0x3f, 0xcd, 0xc7, 0xfb,
-	0x33, 0x9a, 0x80, 0xed, 0x70, 0x2e, 0xcc, 0xca, 0x25, 0xea, 0x48, 0xe7, 0x17, 0x5a, 0x8c, 0x96,
-	0x7c, 0xc3, 0x3f, 0x4c, 0xae, 0x67, 0x54, 0x5d, 0xa5, 0xa9, 0xd2, 0x86, 0xa7, 0x87, 0xe6, 0xd6,
-	0xf1, 0xff, 0xb3, 0xb2, 0x27, 0xc2, 0x73, 0xcb, 0x3e, 0x13, 0x95, 0x0f, 0x93, 0x4f, 0x25, 0xb4,
-	0x34, 0x00, 0x28, 0xc8, 0xa9, 0x21, 0xe4, 0x76, 0x06, 0x61, 0x8e, 0x97, 0x92, 0xf7, 0x39, 0x4b,
-	0x0e, 0xa1, 0x4d, 0x82, 0xc2, 0x51, 0xce, 0xa4, 0x0b, 0x45, 0xd4, 0x10, 0x2e, 0x59, 0x11, 0x29,
-	0xad, 0x9b, 0x66, 0x04, 0x2c, 0x58, 0x87, 0xcf, 0x24, 0xb4, 0x3c, 0x48, 0x74, 0x82, 0x82, 0xe3,
-	0xff, 0x94, 0x82, 0x6d, 0x7a, 0x4f, 0xb7, 0x4a, 0x9a, 0xe1, 0xac, 0x3b, 0x15, 0x86, 0xda, 0x51,
-	0xf0, 0x61, 0x8c, 0x82, 0xb8, 0x68, 0x50, 0xf0, 0x36, 0x1a, 0x66, 0x4b, 0x17, 0xb0, 0xbf, 0x9c,
-	0xcc, 0x5e, 0x44, 0x89, 0xde, 0x39, 0x1c, 0x89, 0xa8, 0x00, 0x49, 0xe6, 0xd1, 0xac, 0x30, 0x99,
-	0xb5, 0xba, 0x61, 0xad, 0x57, 0xab, 0xb4, 0x61, 0x79, 0x01, 0x65, 0x1d, 0xcd, 0xf5, 0x0f, 0x03,
-	0xae, 0x37, 0xd0, 0x59, 0xcd, 0xff, 0x5e, 0xd6, 0xf8, 0x00, 0x9c, 0xf4, 0x4c, 0xbb, 0x95, 0x1b,
-	0xe3, 0x04, 0x7a, 0x86, 0x89, 0x7a, 0x46, 0x0b, 0xc1, 0x90, 0x25, 0xb4, 0x10, 0x2d, 0xb3, 0xa1,
-	0xef, 0xe9, 0x26, 0xb5, 0x75, 0x27, 0xc2, 0xa8, 0x21, 0x9e, 0x0d, 0x31, 0x14, 0x58, 0x6d, 0xa2,
-	0xd1, 0x5a, 0x30, 0x16, 0x61, 0x36, 0xdd, 0x6e, 0xe5, 0x32, 0xc1, 0x1d, 0x14, 0x09, 0x21, 0xea,
-	0xf9, 0x5a, 0x04, 0x32, 0xee, 0x8e, 0xde, 0xb4, 0x76, 0xa8, 0xd2, 0x2c, 0x51, 0x6a, 0x6e, 0x37,
-	0xed, 0xe0, 0x3c, 0x92, 0x2f, 0x62, 0xee, 0xe8, 0x68, 0x24, 0xd0, 0x6b, 0xa0, 0x51, 0xc3, 0xda,
-	0xa1, 0xe5, 0x4a, 0xb3, 0x6c, 0x53, 0x6a, 0x96, 0xbd, 0xa6, 0xad, 0xc3, 0x59, 0x5b, 0x4c, 0x5e,
-	0xeb, 0x5e, 0x30, 0x25, 0x0f, 0xeb, 0x0c, 0x62, 0x04, 0x40, 0xa2, 0x9e, 0x33, 0x7a, 0x32, 0x48,
-	0x01, 0x5d, 0x8e, 0x12, 0x7c, 0x4d, 0xdb, 0xf7, 0x87, 0x4b, 0xd4, 0xb0, 0x3c, 0xb7, 0xa4, 0x3b,
-	0x8a, 0x49, 0xab, 0xf7, 0x02, 0x45, 0x1f, 0x4b, 0x68, 0x75, 0xc0, 0x04, 0x10, 0xf6, 0x0e, 0x9a,
-	0xac, 0x6b, 0xfb, 0x9c, 0x83, 0xcd, 0x42, 0xca, 0xfe, 0xf4, 0x56, 0xfc, 0x20, 0x26, 0xf0, 0x84,
-	0x32, 0xd7, 0x6e, 0xe5, 0xf2, 0x9c, 0x72, 0x62, 0x28, 0x51, 0xc7, 0xeb, 0x71, 0x75, 0xe2, 0x4e,
-	0x5d, 0x94, 0xd0, 0xf6, 0x7e, 0x40, 0xff, 0x41, 0xcc, 0xa9, 0x8b, 0x8b, 0x06, 0xee, 0x6f, 0xa2,
-	0x89, 0x38, 0x42, 0xde, 0x3e, 0x10, 0x9f, 0x69, 0xb7, 0x72, 0x17, 0x93, 0x89, 0x7b, 0xfb, 0x44,
-	0xc5, 0x75, 0x01, 0x3e, 0xee, 0xa9, 0x51, 0x34, 0x57, 0x67, 0xaf, 0x5a, 0xe7, 0x82, 0x78, 0x5f,
-	0x12, 0xdf, 0x9a, 0x70, 0x14, 0x50, 0x7c, 0x17, 0x9d, 0xf6, 0x1f, 0x95, 0x32, 0x7b, 0x34, 0x83,
-	0xdb, 0x61, 0x36, 0x79, 0xc7, 0x74, 0x20, 0x14, 0x19, 0x36, 0x0b, 0xe6, 0x02, 0x42, 0x28, 0x44,
-	0x45, 0x95, 0x4e, 0x25, 0x92, 0x47, 0xd9, 0x28, 0x8f, 0x57, 0x2c, 0xad, 0x62, 0xea, 0xb5, 0x80,
-	0xea, 0x16, 0xca, 0x25, 0x46, 0x00, 0xcd, 0xcb, 0x68, 0x44, 0xe7, 0x9f, 0xd8, 0xd4, 0xfd, 0x4b,
-	0xc1, 0xdd, 0x37, 0x0f, 0x06, 0x88, 0x1a, 0x84, 0xf8, 0xde, 0x66, 0x4a, 0x78, 0xfc, 0x29, 0x35,
-	0x83, 0x77, 0xee, 0x1a, 0x42, 0x5d, 0xba, 0x70, 0x88, 0xc7, 0xbb, 0x17, 0x74, 0x77, 0x8c, 0xa8,
-	0xa7, 0x3a, 0x4a, 0xf0, 0x33, 0xe8, 0x34, 0xf5, 0xee, 0xea, 0x0e, 0xa4, 0x1d, 0x63, 0x69, 0x13,
-	0xdd, 0x19, 0x08, 0x0d, 0x12, 0x15, 0xb1, 0x7f, 0x2c, 0x91, 0xdc, 0x46, 0xd3, 0xf1, 0x6c, 0x40,
-	0xdc, 0x0a, 0x1a, 0x61, 0x4b, 0x6f, 0xd4, 0x60, 0x5f, 0x84, 0xc4, 0xc1, 0x80, 0xef, 0x33, 0x28,
-	0x35, 0x37, 0x6b, 0xe1, 0xc5, 0xe7, 0xd6, 0xc1, 0xa3, 0x55, 0x1f, 0x6b, 0x4f, 0xb7, 0x1a, 0x9d,
-	0x8b, 0xe3, 0xab, 0xd0, 0xe2, 0xc7, 0x45, 0x41, 0xe1, 0x07, 0x12, 0x1a, 0xd3, 0x4c, 0xb3, 0x6c,
-	0xc3, 0x78, 0xd9, 0xe1, 0x01, 0x70, 0x71, 0xf4, 0x79, 0x24, 0x44, 0x50, 0x65, 0x16, 0xf6, 0xc3,
-	0x14, 0xdc, 0xd1, 0x31, 0xb8, 0x44, 0xc5, 0x9a, 0x90, 0xb8, 0xf6, 0x57, 0x06, 0x9d, 0x64, 0x64,
-	0xf1, 0x07, 0x12, 0x1a, 0xe6, 0x36, 0x17, 0xf7, 0xa9, 0x2d, 0xba, 0x6b, 0x79, 0x75, 0xc0, 0x68,
-	0xae, 0x9b, 0xe4, 0xdf, 0xfb, 0xe9, 0x8f, 0xcf, 0x8f, 0xc9, 0x38, 0x53, 0x14, 0x4c, 0x3f, 0xb7,
-	0xd1, 0xf8, 0x7b, 0x09, 0x4d, 0x26, 0x1a, 0x63, 0xfc, 0xd2, 0x01, 0xe5, 0x0e, 0x32, 0xdf, 0xf2,
-	0xcb, 0x87, 0x07, 0x00, 0x09, 0xcb, 0x4c, 0xc2, 0x1c, 0x26, 0xa2, 0x84, 0xa8, 0xd9, 0x8e, 0x8a,
-	0xe9, 0xb5, 0xc1, 0x69, 0xc4, 0xc4, 0x3a, 0xf2, 0x34, 0x62, 0xe2, 0x1d, 0x78, 0x3f, 0x31, 0x60,
-	0x63, 0xfd, 0x67, 0x88, 0x9d, 0x2c, 0xfc, 0x8d, 0x84, 0xc6, 0x63, 0xed, 0x33, 0x7e, 0x7e, 0x70,
-	0x1e, 0x82, 0x33, 0x97, 0x5f, 0x38, 0x5c, 0x32, 0x08, 0x98, 0x67, 0x02, 0x72, 0xf8, 0xa2, 0x28,
-	0x00, 0xce, 0x01, 0x63, 0xf8, 0xb3, 0x84, 0xa6, 0xfb, 0x59, 0x66, 0xac, 0x0c, 0xce, 0x22, 0xc9,
-	0xc4, 0xcb, 0x37, 0x8f, 0x84, 0x01, 0x82, 0x56, 0x99, 0xa0, 0x05, 0x3c, 0x2f, 0x0a, 0xea, 0x3a,
-	0x56, 0x7f, 0x51, 0x98, 0x05, 0xc4, 0x8f, 0x25, 0x74, 0xb1, 0xaf, 0x95, 0xc6, 0x37, 0x53, 0xcd,
-	0x6f, 0xbc, 0x6d, 0x97, 0x37, 0x8e, 0x06, 0x02, 0xda, 0x0a, 0x4c, 0xdb, 0x22, 0xbe, 0x14, 0xbf,
-	0x58, 0x4c, 0x51, 0xb9, 0xab, 0x12, 0xff, 0xda, 0x2b, 0x4e, 0xf4, 0xc7, 0x69, 0xc4, 0x25, 0x3a,
-	0xfa, 0x34, 0xe2, 0x92, 0x8d, 0x3e, 0x29, 0x32, 0x71, 0x4b, 0x78, 0x41, 0x14, 0xe7, 0xf9, 0x59,
-	0x65, 0x5b, 0x33, 0x9c, 0xb2, 0xe6, 0x54, 0xb8, 0x4e, 0x17, 0x7f, 0x2b, 0xa1, 0x0b, 0x09, 0x8e,
-	0x1c, 0xdf, 0x48, 0x31, 0xdf, 0xa2, 0xe1, 0x97, 0x5f, 0x3c, 0x6c, 0x3a, 0x68, 0x59, 0x60, 0x5a,
-	0x66, 0x70, 0x2e, 0x66, 0xa1, 0xc2, 0x1d, 0x00, 0xfe, 0x51, 0x42, 0x53, 0x7d, 0x3c, 0x3c, 0x5e,
-	0x1f, 0x9c, 0x48, 0x42, 0xab, 0x20, 0x2b, 0x47, 0x81, 0x00, 0x3d, 0x2b, 0x4c, 0xcf, 0x3c, 0x9e,
-	0x15, 0xf5, 0x08, 0x7d, 0x03, 0xfe, 0xa1, 0xf7, 0xd2, 0xee, 0x75, 0xea, 0x69, 0x2e, 0xed, 0xd8,
-	0xd6, 0x22, 0xcd, 0xa5, 0x1d, 0xdf, 0x71, 0xf4, 0x53, 0x23, 0x34, 0x0e, 0xf8, 0xb7, 0xde, 0x33,
-	0x24, 0x7a, 0xe6, 0x34, 0x67, 0x28, 0xd1, 0x9f, 0xa7, 0x39, 0x43, 0xc9, 0xb6, 0x9d, 0xfc, 0x97,
-	0x29, 0x5b, 0xc6, 0x8b, 0xa2, 0xb2, 0x78, 0x9b, 0x8e, 0xff, 0x94, 0x50, 0xfe, 0xa0, 0x8e, 0x06,
-	0xbf, 0x7a, 0x78, 0x72, 0xe1, 0x1e, 0x4a, 0xbe, 0x75, 0x64, 0x1c, 0xd0, 0x79, 0x95, 0xe9, 0x5c,
-	0xc5, 0x2b, 0x83, 0xe9, 0x64, 0x7d, 0x54, 0xf4, 0xfd, 0xed, 0xb6, 0x14, 0x69, 0xde, 0x5f, 0xa1,
-	0x5d, 0x49, 0xf3, 0xfe, 0x8a, 0x5d, 0x4c, 0xbf, 0xf7, 0x37, 0xd4, 0x97, 0xe0, 0xaf, 0x25, 0x84,
-	0xc5, 0x26, 0x03, 0x5f, 0x1f, 0xbc, 0x76, 0x6f, 0xe7, 0x22, 0x3f, 0x7b, 0x88, 0x4c, 0xa0, 0x3c,
-	0xc3, 0x28, 0x4f, 0xe1, 0x49, 0x91, 0x32, 0xb4, 0x31, 0xf8, 0x4b, 0x09, 0xfd, 0x3b, 0xd2, 0x33,
-	0xe0, 0xff, 0xa5, 0x30, 0x5b, 0xdd, 0x8e, 0x47, 0xfe, 0x7f, 0xda, 0x34, 0x60, 0x99, 0x65, 0x2c,
-	0x33, 0x78, 0x22, 0xc6, 0x99, 0xf9, 0x74, 0xbe, 0xe3, 0xbb, 0x41, 0x6c, 0x07, 0x06, 0xd9, 0x0d,
-	0x89, 0xfd, 0xcb, 0x20, 0xbb, 0x21, 0xb9, 0xad, 0x39, 0xe8, 0x81, 0x8f, 0x76, 0x25, 0xca, 0x9d,
-	0x87, 0x4f, 0xb2, 0xd2, 0xa3, 0x27, 0x59, 0xe9, 0xf7, 0x27, 0x59, 0xe9, 0x93, 0xa7, 0xd9, 0xa1,
-	0x47, 0x4f, 0xb3, 0x43, 0xbf, 0x3c, 0xcd, 0x0e, 0xbd, 0x75, 0x6d, 0xd7, 0xf0, 0xee, 0x36, 0x2a,
-	0x85, 0x2a, 0xad, 0x07, 0x58, 0xab, 0xa6, 0x56, 0x71, 0x3b, 0xc0, 0x7b, 0x6b, 0x6b, 0xc5, 0xfd,
-	0xd0, 0x13, 0xdb, 0xb4, 0x75, 0xb7, 0x32, 0xcc, 0xfe, 0x5f, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x44, 0x3e, 0xe6, 0xa8, 0x1a, 0x19, 0x00, 0x00,
+	// 1846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x19, 0xd6, 0xfa, 0x43, 0xaa, 0xc7, 0x1f, 0xb5, 0xa6, 0x92, 0x2c, 0xad, 0x24, 0x52, 0x1a, 0x7d,
+	0x4b, 0x16, 0x69, 0xcb, 0x6e, 0xeb, 0xb6, 0xb6, 0x6b, 0xad, 0x58, 0xdb, 0x82, 0x51, 0x4b, 0x5d,
+	0xab, 0x97, 0x16, 0xe8, 0x76, 0x49, 0xae, 0xa4, 0x85, 0x97, 0x3b, 0xf4, 0xee, 0x52, 0x10, 0xaf,
+	0x35, 0xd0, 0xa2, 0x40, 0x80, 0x7c, 0xfd, 0x80, 0xe4, 0x18, 0x04, 0xf9, 0x03, 0x39, 0x26, 0x40,
+	0x00, 0x23, 0xb9, 0x38, 0x08, 0x10, 0x04, 0x4e, 0x40, 0x04, 0x76, 0x0e, 0x39, 0xe5, 0xa0, 0x4b,
+	0xae, 0xc1, 0xce, 0xbc, 0x4b, 0xee, 0x27, 0x97, 0xa4, 0x82, 0xdc, 0xb8, 0x3b, 0xef, 0xfb, 0xbc,
+	0xcf, 0x33, 0xb3, 0x33, 0xef, 0x3c, 0x44, 0xb3, 0xd4, 0xae, 0x50, 0x5b, 0xb7, 0xf3, 0x55, 0x8b,
+	0x3a, 0xd4, 0xd2, 0x0e, 0xf2, 0x07, 0x57, 0x8b, 0x9a, 0xa3, 0x5e, 0xcd, 0x3f, 0xa9, 0x69, 0x56,
+	0x3d, 0xc7, 0x5e, 0xe3, 0x51, 0x88, 0xca, 0x79, 0x51, 0x39, 0x88, 0x12, 0x87, 0xf6, 0xe8, 0x1e,
+	0x65, 0x6f, 0xf3, 0xee, 0x2f, 0x1e, 0x20, 0x4e, 0xec, 0x51, 0xba, 0x67, 0x68, 0x79, 0xb5, 0xaa,
+	0xe7, 0x55, 0xd3, 0xa4, 0x8e, 0xea, 0xe8, 0xd4, 0x84, 0x74, 0x71, 0xb9, 0xc4, 0xe0, 0xf2, 0x45,
+	0xd5, 0xd6, 0x78, 0x99, 0x66, 0xd1, 0xaa, 0xba, 0xa7, 0x9b, 0x2c, 0x18, 0x62, 0xe7, 0x12, 0xf9,
+	0x55, 0x55, 0x4b, 0xad, 0x78, 0x90, 0x0b, 0xc9, 0x61, 0x1e, 0x63, 0x1e, 0x98, 0xf1, 0xd7, 0xf6,
+	0x62, 0x4a, 0x54, 0x87, 0x7a, 0x64, 0x08, 0xe1, 0xbf, 0xb9, 0x8c, 0xb6, 0x19, 0xba, 0xac, 0x3d,
+	0xa9, 0x69, 0xb6, 0x43, 0x76, 0xd1, 0x6f, 0x02, 0x6f, 0xed, 0x2a, 0x35, 0x6d, 0x0d, 0x6f, 0xa1,
+	0x7e, 0xce, 0x62, 0x54, 0x98, 0x12, 0x16, 0xcf, 0xae, 0x4d, 0xe5, 0x92, 0xe6, 0x29, 0xc7, 0x33,
+	0xa5, 0xe1, 0x67, 0x8d, 0x6c, 0xdf, 0x51, 0x23, 0x7b, 0xbe, 0xae, 0x56, 0x8c, 0x3f, 0x12, 0x9e,
+	0x4d, 0x64, 0x80, 0x21, 0x0b, 0x68, 0x8e, 0xd5, 0xb9, 0xa7, 0x39, 0xdb, 0x2e, 0x82, 0xac, 0x1d,
+	0x3c, 0xac, 0x55, 0x8a, 0x9a, 0xb5, 0xb5, 0xbb, 0x63, 0xa9, 0x65, 0xad, 0x49, 0xe8, 0x35, 0x01,
+	0xcd, 0xa7, 0x45, 0x02, 0xc9, 0x22, 0xba, 0x68, 0xb2, 0x11, 0x85, 0xee, 0x2a, 0x0e, 0x1b, 0x63,
+	0x74, 0xcf, 0x48, 0x37, 0x5c, 0x32, 0x2f, 0x1a, 0xd9, 0x61, 0x3e, 0x27, 0x76, 0xf9, 0x71, 0x4e,
+	0xa7, 0xf9, 0x8a, 0xea, 0xec, 0xe7, 0x36, 0x4d, 0xe7, 0xa8, 0x91, 0xbd, 0xc4, 0x59, 0x86, 0xd3,
+	0x89, 0x7c, 0xc1, 0x0c, 0xd4, 0x22, 0x5b, 0x51, 0xde, 0xdb, 0x16, 0xdd, 0xd5, 0x1d, 0x5b, 0xaa,
+	0x17, 0x34, 0x93, 0x56, 0x80, 0x37, 0x9e, 0x47, 0xa7, 0xcb, 0xee, 0x33, 0x30, 0xb8, 0x78, 0xd4,
+	0xc8, 0x9e, 0xe3, 0x45, 0xd8, 0x6b, 0x22, 0xf3, 0x61, 0x62, 0xa2, 0xf9, 0x34, 0x40, 0x90, 0x57,
+	0x40, 0xfd, 0x55, 0x36, 0x02, 0x6b, 0x30, 0x96, 0xe3, 0x6a, 0x72, 0xee, 0x0a, 0x37, 0xa7, 0x7f,
+	0x83, 0xea, 0xa6, 0x34, 0xe8, 0x9b, 0x78, 0x96, 0xe2, 0x4e, 0x3c, 0xff, 0x31, 0x83, 0xa6, 0xc3,
+	0xf5, 0xd6, 0x0d, 0x03, 0x4a, 0x7a, 0x93, 0xfe, 0x04, 0x91, 0x76, 0x41, 0x40, 0xe8, 0x01, 0x1a,
+	0xe0, 0xa0, 0xee, 0x34, 0x9f, 0x6c, 0xcf, 0x68, 0x04, 0x3e, 0x87, 0x0b, 0x7e, 0x56, 0x36, 0x91,
+	0x07, 0x9a, 0xbf, 0xd0, 0x62, 0xb8, 0xe4, 0x23, 0x77, 0x33, 0xd9, 0x8e, 0x5e, 0xb2, 0xa5, 0xba,
+	0x4c, 0x6b, 0x8e, 0xe6, 0x9b, 0x5b, 0xcb, 0x7d, 0x66, 0x65, 0x4f, 0xf9, 0xe7, 0x96, 0xbd, 0x26,
+	0x32, 0x1f, 0x26, 0x6f, 0x0a, 0x68, 0xa9, 0x03, 0x50, 0x90, 0x53, 0x46, 0xc8, 0x6e, 0x0e, 0xc2,
+	0x1c, 0x2f, 0x25, 0x7f, 0xe7, 0x2c, 0xd9, 0x87, 0x36, 0x06, 0x0a, 0x07, 0x39, 0x93, 0x16, 0x14,
+	0x91, 0x7d, 0xb8, 0x64, 0x25, 0x4a, 0x69, 0xdd, 0x30, 0x42, 0x60, 0xde, 0x3a, 0xbc, 0x25, 0xa0,
+	0xe5, 0x4e, 0xa2, 0x13, 0x14, 0x9c, 0xfc, 0xa5, 0x14, 0xec, 0xd0, 0xc7, 0x9a, 0xb9, 0xad, 0xea,
+	0xd6, 0xba, 0x55, 0x64, 0xa8, 0x4d, 0x05, 0xff, 0x8f, 0x51, 0x10, 0x17, 0x0d, 0x0a, 0xfe, 0x89,
+	0xfa, 0xd9, 0xd2, 0x79, 0xec, 0x2f, 0x27, 0xb3, 0x8f, 0xa2, 0x84, 0xcf, 0x1c, 0x8e, 0x44, 0x64,
+	0x80, 0x24, 0x73, 0x68, 0x26, 0x32, 0x99, 0xe5, 0x8a, 0x6e, 0xae, 0x97, 0x4a, 0xb4, 0x66, 0x3a,
+	0x1e, 0x65, 0x0d, 0xcd, 0xb6, 0x0f, 0x03, 0xae, 0xb7, 0xd0, 0x79, 0xd5, 0x7d, 0xaf, 0xa8, 0x7c,
+	0x00, 0x76, 0xfa, 0xe8, 0x51, 0x23, 0x3b, 0xc4, 0x09, 0x04, 0x86, 0x89, 0x7c, 0x4e, 0xf5, 0xc1,
+	0x90, 0x25, 0xb4, 0x10, 0x2e, 0x53, 0xd0, 0x0e, 0x34, 0x83, 0x56, 0x35, 0x2b, 0xc4, 0xa8, 0x86,
+	0x16, 0xd3, 0x43, 0x81, 0xd5, 0x26, 0x1a, 0x2c, 0x7b, 0x63, 0x21, 0x66, 0x13, 0x47, 0x8d, 0xec,
+	0xa8, 0x77, 0x06, 0x85, 0x42, 0x88, 0x7c, 0xb1, 0x1c, 0x82, 0x8c, 0x3b, 0xa3, 0x37, 0xcd, 0x5d,
+	0x2a, 0xd5, 0xb7, 0x29, 0x35, 0x76, 0xea, 0x55, 0x6f, 0x3f, 0x92, 0x77, 0x62, 0xce, 0xe8, 0x70,
+	0x24, 0xd0, 0xab, 0xa1, 0x41, 0xdd, 0xdc, 0xa5, 0x4a, 0xb1, 0xae, 0x54, 0x29, 0x35, 0x14, 0xa7,
+	0x5e, 0xd5, 0x60, 0xaf, 0x2d, 0x26, 0xaf, 0x75, 0x10, 0x4c, 0x9a, 0x82, 0x75, 0x06, 0x31, 0x11,
+	0x40, 0x22, 0x5f, 0xd0, 0x03, 0x19, 0x24, 0x87, 0x2e, 0x87, 0x09, 0xfe, 0x55, 0x3d, 0x74, 0x87,
+	0xb7, 0xa9, 0x6e, 0x3a, 0xf6, 0xb6, 0x66, 0x49, 0x06, 0x2d, 0x3d, 0xf6, 0x14, 0xbd, 0x2e, 0xa0,
+	0xd5, 0x0e, 0x13, 0x40, 0xd8, 0xbf, 0xd0, 0x58, 0x45, 0x3d, 0xe4, 0x1c, 0xaa, 0x2c, 0x44, 0x71,
+	0xa7, 0xb7, 0xe8, 0x06, 0x31, 0x81, 0xa7, 0xa4, 0xd9, 0xa3, 0x46, 0x76, 0x8a, 0x53, 0x4e, 0x0c,
+	0x25, 0xf2, 0x70, 0x25, 0xae, 0x4e, 0xdc, 0xae, 0x0b, 0x13, 0xda, 0x39, 0xf4, 0xe8, 0x3f, 0x8d,
+	0xd9, 0x75, 0x71, 0xd1, 0xc0, 0xfd, 0xef, 0x68, 0x24, 0x8e, 0x90, 0x73, 0x08, 0xc4, 0xa7, 0x8f,
+	0x1a, 0xd9, 0xc9, 0x64, 0xe2, 0xce, 0x21, 0x91, 0x71, 0x25, 0x02, 0x1f, 0xd7, 0x6a, 0x24, 0xd5,
+	0xd6, 0x58, 0x57, 0x6b, 0x1e, 0x10, 0xff, 0x15, 0x10, 0x69, 0x17, 0x05, 0x14, 0xff, 0x8d, 0xce,
+	0xba, 0x4d, 0x45, 0x61, 0x4d, 0xd3, 0x3b, 0x1d, 0x66, 0x92, 0xbf, 0x98, 0x26, 0x84, 0x24, 0xc2,
+	0xc7, 0x82, 0xb9, 0x00, 0x1f, 0x0a, 0x91, 0x51, 0xb1, 0x59, 0x89, 0x4c, 0xa1, 0x4c, 0x98, 0xc7,
+	0x5f, 0x4c, 0xb5, 0x68, 0x68, 0x65, 0x8f, 0xea, 0x16, 0xca, 0x26, 0x46, 0x00, 0xcd, 0xcb, 0x68,
+	0x40, 0xe3, 0xaf, 0xd8, 0xd4, 0xfd, 0x4a, 0xc2, 0xad, 0x9e, 0x07, 0x03, 0x44, 0xf6, 0x42, 0xdc,
+	0xbb, 0xcd, 0x78, 0xa4, 0xf9, 0x53, 0x6a, 0x78, 0x7d, 0xee, 0x3a, 0x42, 0x2d, 0xba, 0xb0, 0x89,
+	0x87, 0x5b, 0x07, 0x74, 0x6b, 0x8c, 0xc8, 0x67, 0x9a, 0x4a, 0xf0, 0xef, 0xd1, 0x59, 0xea, 0xec,
+	0x6b, 0x16, 0xa4, 0x9d, 0x60, 0x69, 0x23, 0xad, 0x19, 0xf0, 0x0d, 0x12, 0x19, 0xb1, 0x27, 0x96,
+	0x48, 0x1e, 0xa0, 0x89, 0x78, 0x36, 0x20, 0x6e, 0x05, 0x0d, 0xb0, 0xa5, 0xd7, 0xcb, 0xf0, 0x5d,
+	0xf8, 0xc4, 0xc1, 0x80, 0x7b, 0xcf, 0xa0, 0xd4, 0xd8, 0x2c, 0x13, 0x39, 0x7a, 0xd8, 0x6e, 0xd4,
+	0x4b, 0x86, 0x5e, 0x0a, 0xf4, 0x87, 0xee, 0x30, 0x0f, 0xd0, 0x6c, 0x7b, 0x4c, 0x20, 0xfa, 0x30,
+	0xd4, 0x45, 0xb2, 0x29, 0x3d, 0x30, 0xad, 0x71, 0x54, 0xa2, 0x5a, 0xd8, 0x75, 0xf0, 0xbe, 0x6e,
+	0x3b, 0xd4, 0xaa, 0x7b, 0x5a, 0xee, 0x22, 0xd4, 0xba, 0xd5, 0xc3, 0xa1, 0x36, 0x1f, 0xb8, 0x12,
+	0x71, 0xa7, 0xd1, 0xba, 0x29, 0xef, 0x79, 0x47, 0xa8, 0xec, 0xcb, 0x24, 0x9f, 0x08, 0x68, 0xb6,
+	0x7d, 0x3d, 0xd0, 0xb9, 0x83, 0xfa, 0x9b, 0xd7, 0x5c, 0x57, 0xe7, 0x5c, 0x9b, 0x6e, 0xe9, 0xc6,
+	0xc9, 0x5a, 0x89, 0x5a, 0xe5, 0xb0, 0x5a, 0xef, 0xaa, 0x0b, 0x58, 0xf8, 0x5e, 0x40, 0xc6, 0x09,
+	0x26, 0x63, 0x21, 0x55, 0x06, 0xa7, 0x14, 0xd0, 0x71, 0x05, 0xe5, 0xe2, 0xaf, 0xb6, 0x8f, 0xf6,
+	0x55, 0x4b, 0xb3, 0x0b, 0xba, 0xed, 0x58, 0x7a, 0xb1, 0xe6, 0xb4, 0x76, 0xd8, 0x7b, 0x02, 0xca,
+	0x77, 0x9c, 0xd2, 0xec, 0x28, 0xe7, 0xcb, 0xde, 0x6b, 0xd7, 0x7a, 0xc1, 0x5c, 0x5c, 0x6d, 0xe3,
+	0x50, 0x5a, 0x88, 0x05, 0x5f, 0xa6, 0x34, 0x01, 0xf3, 0x02, 0xdd, 0x3b, 0x80, 0x4a, 0xe4, 0x60,
+	0x15, 0xff, 0xe1, 0xc6, 0xaf, 0xc6, 0x0e, 0x2d, 0xb9, 0x7b, 0xe5, 0x40, 0x33, 0x6b, 0xcd, 0xc6,
+	0xf8, 0xbe, 0xef, 0x70, 0x8b, 0x8b, 0x02, 0x09, 0x4f, 0x05, 0x34, 0xa4, 0x1a, 0x86, 0x52, 0x85,
+	0x71, 0xc5, 0xe2, 0x01, 0xf0, 0x0d, 0xb5, 0xb9, 0x04, 0x45, 0x41, 0xa5, 0x19, 0x50, 0x31, 0x0e,
+	0x77, 0x90, 0x18, 0x5c, 0x22, 0x63, 0x35, 0x92, 0xb8, 0xf6, 0xe3, 0x24, 0x3a, 0xcd, 0xc8, 0xe2,
+	0xff, 0x09, 0xa8, 0x9f, 0xdb, 0x38, 0xdc, 0xa6, 0x76, 0xd4, 0x3d, 0x8a, 0xab, 0x1d, 0x46, 0x73,
+	0xdd, 0x64, 0xea, 0x3f, 0x5f, 0x7c, 0xf7, 0xf6, 0x09, 0x11, 0x8f, 0xe6, 0x23, 0xa6, 0x96, 0xdb,
+	0x44, 0xfc, 0xa9, 0x80, 0xc6, 0x12, 0x8d, 0x1f, 0xfe, 0x73, 0x4a, 0xb9, 0x34, 0x73, 0x29, 0xde,
+	0xe9, 0x1d, 0x00, 0x24, 0x2c, 0x33, 0x09, 0xb3, 0x98, 0x44, 0x25, 0x84, 0xcd, 0x64, 0x58, 0x4c,
+	0xd0, 0xe6, 0x75, 0x23, 0x26, 0xd6, 0x71, 0x8a, 0x77, 0x7a, 0x07, 0x48, 0x17, 0x03, 0x36, 0xcd,
+	0xbd, 0x66, 0xb1, 0xce, 0x81, 0x3f, 0x14, 0xd0, 0x70, 0xac, 0x3d, 0xc4, 0x7f, 0xea, 0x9c, 0x47,
+	0xc4, 0x79, 0x8a, 0x37, 0x7b, 0x4b, 0x06, 0x01, 0x73, 0x4c, 0x40, 0x16, 0x4f, 0x46, 0x05, 0xc0,
+	0x3e, 0x60, 0x0c, 0xbf, 0x14, 0xd0, 0x44, 0x3b, 0x4b, 0x88, 0xa5, 0xce, 0x59, 0x24, 0x99, 0x54,
+	0x71, 0xe3, 0x58, 0x18, 0x20, 0x68, 0x95, 0x09, 0x5a, 0xc0, 0x73, 0x51, 0x41, 0x2d, 0x47, 0xe6,
+	0x2e, 0x0a, 0xeb, 0x54, 0xf8, 0x85, 0x80, 0x26, 0xdb, 0x5a, 0x45, 0xbc, 0xd1, 0xd5, 0xfc, 0xc6,
+	0xdb, 0x52, 0xb1, 0x70, 0x3c, 0x10, 0xd0, 0x96, 0x63, 0xda, 0x16, 0xf1, 0x7c, 0xfc, 0x62, 0x31,
+	0x45, 0x4a, 0x4b, 0x25, 0xfe, 0x3a, 0x28, 0x2e, 0xea, 0xff, 0xba, 0x11, 0x97, 0xe8, 0x58, 0xc5,
+	0xc2, 0xf1, 0x40, 0x40, 0x5c, 0x9e, 0x89, 0x5b, 0xc2, 0x0b, 0x51, 0x71, 0x8e, 0x9b, 0xa5, 0x54,
+	0x55, 0xdd, 0x52, 0x54, 0xab, 0xc8, 0x75, 0xda, 0xf8, 0x23, 0x01, 0x5d, 0x4a, 0x70, 0x9c, 0xf8,
+	0x56, 0x17, 0xf3, 0x1d, 0x35, 0xb4, 0xe2, 0xed, 0x5e, 0xd3, 0x41, 0xcb, 0x02, 0xd3, 0x32, 0x8d,
+	0xb3, 0x31, 0x0b, 0xe5, 0x77, 0xb8, 0xf8, 0x73, 0x01, 0x8d, 0xb7, 0xf1, 0xa8, 0x78, 0xbd, 0x73,
+	0x22, 0x09, 0x56, 0x58, 0x94, 0x8e, 0x03, 0x01, 0x7a, 0x56, 0x98, 0x9e, 0x39, 0x3c, 0x13, 0xd5,
+	0x13, 0xf1, 0xc5, 0xf8, 0xb3, 0xe0, 0xa1, 0x1d, 0x74, 0xa2, 0xdd, 0x1c, 0xda, 0xb1, 0xd6, 0x59,
+	0xbc, 0xd3, 0x3b, 0x40, 0xba, 0x9a, 0x88, 0x31, 0xc6, 0xdf, 0x04, 0xf7, 0x50, 0xd4, 0x13, 0x76,
+	0xb3, 0x87, 0x12, 0xfd, 0xa7, 0x58, 0x38, 0x1e, 0x08, 0x28, 0xbb, 0xc2, 0x94, 0x2d, 0xe3, 0xc5,
+	0xa8, 0xb2, 0x78, 0x1b, 0x8a, 0xbf, 0x17, 0xd0, 0x54, 0x9a, 0x63, 0xc7, 0x77, 0x7b, 0x27, 0xe7,
+	0xff, 0x8f, 0x40, 0xbc, 0x77, 0x6c, 0x1c, 0xd0, 0x79, 0x8d, 0xe9, 0x5c, 0xc5, 0x2b, 0x9d, 0xe9,
+	0x64, 0xff, 0x13, 0x84, 0xfb, 0x6f, 0xcb, 0x32, 0x77, 0xd3, 0x7f, 0x23, 0x76, 0x5c, 0xbc, 0xd9,
+	0x5b, 0x72, 0x7a, 0xff, 0xf5, 0xf9, 0x6e, 0xfc, 0x81, 0x80, 0x70, 0xd4, 0x44, 0xe3, 0x1b, 0x9d,
+	0xd7, 0x0e, 0x3a, 0x73, 0xf1, 0x0f, 0x3d, 0x64, 0x02, 0xe5, 0x69, 0x46, 0x79, 0x1c, 0x8f, 0x45,
+	0x29, 0x83, 0x4d, 0xc7, 0xef, 0x0a, 0xe8, 0xd7, 0x21, 0x4f, 0x8c, 0x7f, 0xdb, 0xc5, 0x65, 0xab,
+	0xe5, 0xe8, 0xc5, 0xdf, 0x75, 0x9b, 0x06, 0x2c, 0x33, 0x8c, 0xe5, 0x28, 0x1e, 0x89, 0xb2, 0x74,
+	0x3f, 0x8f, 0x70, 0xf7, 0xf0, 0xbb, 0xe2, 0x6e, 0xba, 0x47, 0x8c, 0x43, 0x17, 0x6f, 0xf7, 0x9a,
+	0x9e, 0xde, 0x3d, 0x4a, 0x2c, 0x3e, 0xa1, 0x03, 0xfa, 0x1d, 0x6f, 0x37, 0x1a, 0x62, 0x9c, 0xb9,
+	0x78, 0xbb, 0xd7, 0xf4, 0x74, 0x0d, 0xec, 0x6e, 0xaf, 0xec, 0x03, 0xcf, 0x1f, 0x04, 0x44, 0xd2,
+	0xbd, 0x2b, 0xbe, 0xdf, 0xed, 0x55, 0x3d, 0xc9, 0x31, 0x8b, 0x9b, 0x3f, 0x03, 0x52, 0xfa, 0x31,
+	0xc4, 0x2f, 0xce, 0x8a, 0xcd, 0x72, 0x95, 0xb2, 0x4f, 0xc9, 0xc7, 0xfc, 0x18, 0x8a, 0xfa, 0xd0,
+	0x4e, 0x8e, 0xa1, 0x44, 0xe3, 0x2c, 0xde, 0xec, 0x2d, 0xb9, 0xb3, 0x9b, 0x65, 0xd8, 0x0e, 0x4b,
+	0x0f, 0x9f, 0xbd, 0xcc, 0x08, 0xcf, 0x5f, 0x66, 0x84, 0x6f, 0x5f, 0x66, 0x84, 0x37, 0x5e, 0x65,
+	0xfa, 0x9e, 0xbf, 0xca, 0xf4, 0x7d, 0xf5, 0x2a, 0xd3, 0xf7, 0x8f, 0xeb, 0x7b, 0xba, 0xb3, 0x5f,
+	0x2b, 0xe6, 0x4a, 0xb4, 0xe2, 0x61, 0xad, 0x1a, 0x6a, 0xd1, 0x6e, 0x02, 0x1f, 0xac, 0xad, 0xe5,
+	0x0f, 0x5b, 0xf0, 0x6e, 0x93, 0xb5, 0x8b, 0xfd, 0xec, 0xf9, 0xda, 0x4f, 0x03, 0x00, 0x5a, 0x01,
+	0x7f, 0x5e, 0x73, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1665,6 +1979,15 @@ type QueryClient interface {
 	// GetProtoRevPool queries the pool id used via the highest liquidity method
 	// for arbitrage route building given a pair of denominations
 	GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevPoolRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevCyclicRoutes queries the cyclic arbitrage routes discovered by
+	// searching the pool graph after a swap on a given pool
+	GetProtoRevCyclicRoutes(ctx context.Context, in *QueryGetProtoRevCyclicRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevCyclicRoutesResponse, error)
+	// GetProtoRevTradeHistory queries the cyclic arbitrage trades executed by the
+	// module in the latest blocks
+	GetProtoRevTradeHistory(ctx context.Context, in *QueryGetProtoRevTradeHistoryRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTradeHistoryResponse, error)
+	// GetProtoRevProfitSharesDistributed queries the cumulative amounts of the
+	// profits of the module sent to each profit share recipient
+	GetProtoRevProfitSharesDistributed(ctx context.Context, in *QueryGetProtoRevProfitSharesDistributedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSharesDistributedResponse, error)
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
@@ -1813,6 +2136,33 @@ func (c *queryClient) GetProtoRevPool(ctx context.Context, in *QueryGetProtoRevP
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevCyclicRoutes(ctx context.Context, in *QueryGetProtoRevCyclicRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevCyclicRoutesResponse, error) {
+	out := new(QueryGetProtoRevCyclicRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevCyclicRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevTradeHistory(ctx context.Context, in *QueryGetProtoRevTradeHistoryRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTradeHistoryResponse, error) {
+	out := new(QueryGetProtoRevTradeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTradeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevProfitSharesDistributed(ctx context.Context, in *QueryGetProtoRevProfitSharesDistributedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSharesDistributedResponse, error) {
+	out := new(QueryGetProtoRevProfitSharesDistributedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSharesDistributed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error) {
 	out := new(QueryGetAllProtocolRevenueResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetAllProtocolRevenue", in, out, opts...)
@@ -1864,6 +2214,15 @@ type QueryServer interface {
 	// GetProtoRevPool queries the pool id used via the highest liquidity method
 	// for arbitrage route building given a pair of denominations
 	GetProtoRevPool(context.Context, *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error)
+	// GetProtoRevCyclicRoutes queries the cyclic arbitrage routes discovered by
+	// searching the pool graph after a swap on a given pool
+	GetProtoRevCyclicRoutes(context.Context, *QueryGetProtoRevCyclicRoutesRequest) (*QueryGetProtoRevCyclicRoutesResponse, error)
+	// GetProtoRevTradeHistory queries the cyclic arbitrage trades executed by the
+	// module in the latest blocks
+	GetProtoRevTradeHistory(context.Context, *QueryGetProtoRevTradeHistoryRequest) (*QueryGetProtoRevTradeHistoryResponse, error)
+	// GetProtoRevProfitSharesDistributed queries the cumulative amounts of the
+	// profits of the module sent to each profit share recipient
+	GetProtoRevProfitSharesDistributed(context.Context, *QueryGetProtoRevProfitSharesDistributedRequest) (*QueryGetProtoRevProfitSharesDistributedResponse, error)
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
@@ -1918,6 +2277,15 @@ func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *QueryGetProtoRevPoolRequest) (*QueryGetProtoRevPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPool not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevCyclicRoutes(ctx context.Context, req *QueryGetProtoRevCyclicRoutesRequest) (*QueryGetProtoRevCyclicRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevCyclicRoutes not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevTradeHistory(ctx context.Context, req *QueryGetProtoRevTradeHistoryRequest) (*QueryGetProtoRevTradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTradeHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitSharesDistributed(ctx context.Context, req *QueryGetProtoRevProfitSharesDistributedRequest) (*QueryGetProtoRevProfitSharesDistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitSharesDistributed not implemented")
+}
 func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
 }
@@ -2196,6 +2564,60 @@ func _Query_GetProtoRevPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevCyclicRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevCyclicRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevCyclicRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevCyclicRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevCyclicRoutes(ctx, req.(*QueryGetProtoRevCyclicRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevTradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTradeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTradeHistory(ctx, req.(*QueryGetProtoRevTradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProfitSharesDistributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitSharesDistributedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitSharesDistributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSharesDistributed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitSharesDistributed(ctx, req.(*QueryGetProtoRevProfitSharesDistributedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetAllProtocolRevenueRequest)
 	if err := dec(in); err != nil {
@@ -2278,6 +2700,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProtoRevPool",
 			Handler:    _Query_GetProtoRevPool_Handler,
 		},
+		{
+			MethodName: "GetProtoRevCyclicRoutes",
+			Handler:    _Query_GetProtoRevCyclicRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTradeHistory",
+			Handler:    _Query_GetProtoRevTradeHistory_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitSharesDistributed",
+			Handler:    _Query_GetProtoRevProfitSharesDistributed_Handler,
+		},
 		{
 			MethodName: "GetAllProtocolRevenue",
 			Handler:    _Query_GetAllProtocolRevenue_Handler,
@@ -3163,7 +3597,7 @@ func (m *QueryGetProtoRevPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevCyclicRoutesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3173,20 +3607,25 @@ func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevCyclicRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevCyclicRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetProtoRevCyclicRoutesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3196,26 +3635,230 @@ func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevCyclicRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetProtoRevCyclicRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AllProtocolRevenue.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTradeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTradeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTradeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTradeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTradeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTradeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllProtocolRevenue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -3575,63 +4218,146 @@ func (m *QueryGetProtoRevPoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetAllProtocolRevenueRequest) Size() (n int) {
+func (m *QueryGetProtoRevCyclicRoutesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
 	return n
 }
 
-func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
+func (m *QueryGetProtoRevCyclicRoutesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AllProtocolRevenue.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryGetProtoRevTradeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *QueryGetProtoRevTradeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAllProtocolRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllProtocolRevenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -4296,7 +5022,408 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, RouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4319,17 +5446,17 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4339,24 +5466,23 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4379,7 +5505,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4402,10 +5528,10 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4429,7 +5555,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4452,17 +5578,17 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4472,25 +5598,23 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statistics = append(m.Statistics, RouteStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4513,7 +5637,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4536,10 +5660,10 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4563,7 +5687,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,15 +5710,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InfoByPoolType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4621,8 +5745,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InfoByPoolType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4647,7 +5770,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4670,10 +5793,10 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4697,7 +5820,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4720,17 +5843,17 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
 			}
-			var stringLen uint64
+			m.MaxPoolPointsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4740,24 +5863,11 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdminAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4779,7 +5889,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4802,10 +5912,10 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4829,7 +5939,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4852,17 +5962,17 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var stringLen uint64
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4872,24 +5982,11 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4911,7 +6008,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,10 +6031,10 @@ func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4961,7 +6058,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,15 +6081,15 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevInfoByPoolTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfoByPoolType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5019,7 +6116,8 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InfoByPoolType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5044,7 +6142,7 @@ func (m *QueryGetProtoRevInfoByPoolTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,10 +6165,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5094,7 +6192,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5117,17 +6215,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5137,11 +6235,12 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5163,7 +6262,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5173,25 +6272,89 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5213,7 +6376,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5236,17 +6399,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5256,7 +6419,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5282,7 +6445,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevCyclicRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5305,12 +6468,31 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevCyclicRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevCyclicRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5332,7 +6514,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevCyclicRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5355,15 +6537,15 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevCyclicRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevCyclicRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5390,8 +6572,8 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5416,57 +6598,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTradeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5489,17 +6621,17 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTradeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTradeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5509,12 +6641,28 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5536,7 +6684,7 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevTradeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5559,17 +6707,17 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevTradeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevTradeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5579,29 +6727,31 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Trades = append(m.Trades, TradeRecord{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5611,23 +6761,27 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OtherDenom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5650,7 +6804,7 @@ func (m *QueryGetProtoRevPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitSharesDistributedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5673,17 +6827,67 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSharesDistributedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSharesDistributedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitSharesDistributedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSharesDistributedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSharesDistributedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5693,11 +6897,26 @@ func (m *QueryGetProtoRevPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, ProfitShareDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -19,6 +19,10 @@ func (base *BaseDenom) Validate() error {
 		return fmt.Errorf("step size must be greater than 0")
 	}
 
+	if base.MaxCyclicRouteHops != 0 && (base.MaxCyclicRouteHops < MinCyclicRouteHops || base.MaxCyclicRouteHops > MaxCyclicRouteHops) {
+		return fmt.Errorf("max cyclic route hops must be 0 or between %d and %d, got %d", MinCyclicRouteHops, MaxCyclicRouteHops, base.MaxCyclicRouteHops)
+	}
+
 	return nil
 }
 
@@ -151,6 +155,63 @@ func ValidateDeveloperFees(fees []sdk.Coin) error {
 	return nil
 }
 
+// ---------------------- Profit Share Validation ---------------------- //
+// ValidatePendingProfitShares does some basic validation on the pending profit shares passed into the module genesis.
+func ValidatePendingProfitShares(pendingProfitShares []sdk.Coin) error {
+	seenDenoms := make(map[string]bool)
+	for _, pendingProfitShare := range pendingProfitShares {
+		if err := pendingProfitShare.Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the pending profit share is unique
+		if seenDenoms[pendingProfitShare.Denom] {
+			return fmt.Errorf("duplicate pending profit share %s", pendingProfitShare)
+		}
+		seenDenoms[pendingProfitShare.Denom] = true
+	}
+	return nil
+}
+
+// ValidatePoolTradeCounts does some basic validation on the pool trade counts passed into the module genesis.
+func ValidatePoolTradeCounts(poolTradeCounts []PoolTradeCount) error {
+	seenPoolIds := make(map[uint64]bool)
+	for _, poolTradeCount := range poolTradeCounts {
+		if poolTradeCount.PoolId == 0 {
+			return fmt.Errorf("pool trade count pool id cannot be 0")
+		}
+
+		// Ensure that the pool trade count is unique
+		if seenPoolIds[poolTradeCount.PoolId] {
+			return fmt.Errorf("duplicate pool trade count for pool %d", poolTradeCount.PoolId)
+		}
+		seenPoolIds[poolTradeCount.PoolId] = true
+	}
+	return nil
+}
+
+// ValidateProfitShareDistributions does some basic validation on the profit shares distributed and the unpaid profit
+// shares passed into the module genesis.
+func ValidateProfitShareDistributions(distributions []ProfitShareDistribution) error {
+	seenRecipients := make(map[ProfitShareRecipientType]bool)
+	for _, distribution := range distributions {
+		if _, ok := ProfitShareRecipientType_name[int32(distribution.Recipient)]; !ok {
+			return fmt.Errorf("invalid profit share recipient: %d", distribution.Recipient)
+		}
+
+		if err := sdk.Coins(distribution.Amount).Validate(); err != nil {
+			return err
+		}
+
+		// Ensure that the profit share recipient is unique
+		if seenRecipients[distribution.Recipient] {
+			return fmt.Errorf("duplicate profit share distribution for recipient %s", distribution.Recipient)
+		}
+		seenRecipients[distribution.Recipient] = true
+	}
+	return nil
+}
+
 // ---------------------- Pool Point Validation ---------------------- //
 // ValidateMaxPoolPointsPerBlock validates the max pool points per block.
 func ValidateMaxPoolPointsPerBlock(points uint64) error {
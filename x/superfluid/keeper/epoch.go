@@ -38,6 +38,10 @@ func (k Keeper) AfterEpochStartBeginBlock(ctx sdk.Context) {
 		return nil
 	})
 
+	// Delete the redelegations that have completed, as they can no longer be slashed.
+	ctx.Logger().Info("Delete mature superfluid redelegations")
+	k.DeleteMatureLockRedelegations(ctx)
+
 	// Update all LP tokens multipliers for the upcoming epoch.
 	// This affects staking reward distribution until the next epochs rewards.
 	// Exclusive of current epoch's rewards, inclusive of next epoch's rewards.
@@ -46,6 +46,15 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	Redelegations: []types.SuperfluidRedelegation{
+		{
+			LockId:              1,
+			SrcValidatorAddress: "osmovaloper1cyw4vw20el8e7ez8080md0r8psg25n0cq98a9n",
+			DstValidatorAddress: "osmovaloper1hpgapnfl3thkevvl0jp3wqtk8jw7mpqu0v6f8h",
+			CreationHeight:      1,
+			CompletionTime:      now.Add(time.Hour),
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -90,6 +99,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	redelegations := app.SuperfluidKeeper.GetAllLockRedelegations(ctx)
+	require.Equal(t, redelegations, genesis.Redelegations)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -114,4 +126,5 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesisExported.Redelegations, genesis.Redelegations)
 }
@@ -115,5 +115,6 @@ func (h Hooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, in
 	if slashFactor.IsZero() {
 		return
 	}
+	h.k.SlashRedelegationsForValidatorSlash(ctx, valAddr, infractionHeight, slashFactor)
 	h.k.RefreshIntermediaryDelegationAmounts(ctx)
 }
@@ -66,14 +66,20 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// SuperfluidRedelegate is a method to redelegate superfluid staked asset into a different validator.
-// Currently this feature is not supported.
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
-
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+// SuperfluidRedelegate moves currently superfluid delegated position to a different validator.
+// The synthetic lock and the delegation of the current intermediary account are removed,
+// and the lock is superfluid delegated to the new validator via the intermediary account of the new validator.
+// Like the staking module's redelegation, the lock cannot be redelegated again until the unbonding time has passed,
+// and the lock is slashed on infractions the previous validator committed before the redelegation.
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err == nil {
+		events.EmitSuperfluidRedelegateEvent(ctx, msg.LockId, msg.NewValAddr)
+	}
+	return &types.MsgSuperfluidRedelegateResponse{}, err
+}
 
 // SuperfluidUnbondLock starts unbonding for currently superfluid undelegating lock.
 // This method would return an error when the underlying lock is not in an superfluid undelegating state,
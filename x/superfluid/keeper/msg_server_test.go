@@ -411,6 +411,34 @@ func (s *KeeperTestSuite) TestMsgSuperfluidUndelegate_Event() {
 	}
 }
 
+// TestMsgSuperfluidRedelegate_Event tests that events are correctly emitted
+// when calling SuperfluidRedelegate.
+func (s *KeeperTestSuite) TestMsgSuperfluidRedelegate_Event() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.SuperfluidKeeper)
+
+	// setup validators
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+
+	// setup superfluid delegations
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+	for _, lock := range locks {
+		sender, _ := sdk.AccAddressFromBech32(lock.Owner)
+
+		// redelegating to the same validator fails without emitting an event
+		_, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(s.Ctx), types.NewMsgSuperfluidRedelegate(sender, lock.ID, valAddrs[0]))
+		s.Require().Error(err)
+		s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidRedelegate, 0)
+
+		_, err = msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(s.Ctx), types.NewMsgSuperfluidRedelegate(sender, lock.ID, valAddrs[1]))
+		s.Require().NoError(err)
+		s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidRedelegate, 1)
+	}
+}
+
 // TestMsgSuperfluidUnbondLock_Event tests that events are correctly emitted
 // when calling SuperfluidUnbondLock.
 func (s *KeeperTestSuite) TestMsgSuperfluidUnbondLock_Event() {
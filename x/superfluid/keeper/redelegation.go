@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v22/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) SetLockRedelegation(ctx sdk.Context, redelegation types.SuperfluidRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	bz, err := proto.Marshal(&redelegation)
+	if err != nil {
+		panic(err)
+	}
+	prefixStore.Set(sdk.Uint64ToBigEndian(redelegation.LockId), bz)
+}
+
+// Returns the latest superfluid redelegation of the lock and a bool if found / not found.
+func (k Keeper) GetLockRedelegation(ctx sdk.Context, lockId uint64) (types.SuperfluidRedelegation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	bz := prefixStore.Get(sdk.Uint64ToBigEndian(lockId))
+	if bz == nil {
+		return types.SuperfluidRedelegation{}, false
+	}
+
+	redelegation := types.SuperfluidRedelegation{}
+	err := proto.Unmarshal(bz, &redelegation)
+	if err != nil {
+		panic(err)
+	}
+	return redelegation, true
+}
+
+func (k Keeper) GetAllLockRedelegations(ctx sdk.Context) []types.SuperfluidRedelegation {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	redelegations := []types.SuperfluidRedelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		redelegation := types.SuperfluidRedelegation{}
+		err := proto.Unmarshal(iterator.Value(), &redelegation)
+		if err != nil {
+			panic(err)
+		}
+
+		redelegations = append(redelegations, redelegation)
+	}
+	return redelegations
+}
+
+func (k Keeper) DeleteLockRedelegation(ctx sdk.Context, lockId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixLockRedelegation)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+// DeleteMatureLockRedelegations deletes the superfluid redelegations that have completed,
+// as they can no longer be slashed nor block another redelegation of their lock.
+func (k Keeper) DeleteMatureLockRedelegations(ctx sdk.Context) {
+	for _, redelegation := range k.GetAllLockRedelegations(ctx) {
+		if redelegation.IsMature(ctx.BlockTime()) {
+			k.DeleteLockRedelegation(ctx, redelegation.LockId)
+		}
+	}
+}
+
+// SuperfluidRedelegate moves the superfluid delegation of the given lock to a new validator.
+// The delegation of the intermediary account of the current validator is undelegated and the minted osmo is burnt,
+// then the lock is superfluid delegated to the new validator through the intermediary account of the (denom, new validator) pair.
+// This works the same for gamm share locks and concentrated full range position locks.
+//
+// As the two delegations belong to different intermediary accounts, we cannot use the staking module's redelegation,
+// so we follow its semantics by storing a redelegation record that completes after the staking unbonding time:
+// - the lock cannot be redelegated again until the redelegation is complete (no transitive redelegation)
+// - the lock is slashed if the source validator is slashed, before the redelegation completes,
+// for an infraction committed while the lock was still delegated to it
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(lock, sender)
+	if err != nil {
+		return err
+	}
+
+	intermediaryAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	if intermediaryAcc.ValAddr == newValAddr {
+		return types.ErrSameValidatorRedelegation
+	}
+	_, err = k.validateValAddrForDelegate(ctx, newValAddr)
+	if err != nil {
+		return err
+	}
+
+	// the lock cannot be redelegated while it is still the destination of an incomplete redelegation.
+	redelegation, found := k.GetLockRedelegation(ctx, lockID)
+	if found && !redelegation.IsMature(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrTransitiveRedelegation, "lock id : %d, completion time : %s", lockID, redelegation.CompletionTime)
+	}
+
+	_, err = k.undelegateCommon(ctx, sender, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.SuperfluidDelegate(ctx, sender, lockID, newValAddr)
+	if err != nil {
+		return err
+	}
+
+	k.SetLockRedelegation(ctx, types.SuperfluidRedelegation{
+		LockId:              lockID,
+		SrcValidatorAddress: intermediaryAcc.ValAddr,
+		DstValidatorAddress: newValAddr,
+		CreationHeight:      ctx.BlockHeight(),
+		CompletionTime:      ctx.BlockTime().Add(k.sk.GetParams(ctx).UnbondingTime),
+	})
+	return nil
+}
+
+// SlashRedelegationsForValidatorSlash slashes the locks redelegated away from the validator at valAddr
+// the same way the staking module slashes redelegations.
+// A lock is slashed by slashFactor only if its redelegation has not completed yet
+// and was started at or after the infraction height, as the stake was bonded to the validator when it misbehaved.
+func (k Keeper) SlashRedelegationsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, slashFactor osmomath.Dec) {
+	for _, redelegation := range k.GetAllLockRedelegations(ctx) {
+		if redelegation.SrcValidatorAddress != valAddr.String() {
+			continue
+		}
+		// the redelegation started before the infraction, so the stake was not bonded to the validator when it misbehaved.
+		if redelegation.CreationHeight < infractionHeight {
+			continue
+		}
+		// the redelegation has completed, so the stake is no longer liable for the validator's infractions.
+		if redelegation.IsMature(ctx.BlockTime()) {
+			continue
+		}
+
+		k.slashLock(ctx, redelegation.LockId, slashFactor)
+	}
+}
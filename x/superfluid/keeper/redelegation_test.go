@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	lockuptypes "github.com/osmosis-labs/osmosis/v22/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v22/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v22/x/superfluid/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type superfluidRedelegation struct {
+	lockId      uint64
+	newValIndex int64
+	// whether the unbonding time has passed since the previous redelegations before redelegating
+	afterCompletion bool
+}
+
+func (s *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name               string
+		superDelegations   []superfluidDelegation
+		superRedelegations []superfluidRedelegation
+		expErr             []error
+	}{
+		{
+			"with single superfluid delegation with single redelegation",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1, false}}, // lock1 => val0 -> val1
+			[]error{nil},
+		},
+		{
+			"with multiple superfluid delegations with multiple redelegations",
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1, false}, {2, 2, false}}, // lock1 => val0 -> val1, lock2 => val0 -> val2
+			[]error{nil, nil},
+		},
+		{
+			"try redelegating a lock again before the redelegation completes",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1, false}, {1, 2, false}}, // lock1 => val0 -> val1, lock1 => val1 -> val2
+			[]error{nil, types.ErrTransitiveRedelegation},
+		},
+		{
+			"try redelegating back to the original validator before the redelegation completes",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1, false}, {1, 0, false}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]error{nil, types.ErrTransitiveRedelegation},
+		},
+		{
+			"redelegating a lock again after the redelegation completes",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 1, false}, {1, 2, true}}, // lock1 => val0 -> val1, lock1 => val1 -> val2
+			[]error{nil, nil},
+		},
+		{
+			"redelegation for same validator",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, false}}, // lock1 => val0 -> val0
+			[]error{types.ErrSameValidatorRedelegation},
+		},
+		{
+			"not available lock id redelegation",
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 1, false}}, // lock2 => val0 -> val1
+			[]error{lockuptypes.ErrLockupNotFound},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// setup validators
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded})
+
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+			// setup superfluid delegations
+			_, intermediaryAccs, _ := s.setupSuperfluidDelegations(valAddrs, tc.superDelegations, denoms)
+			s.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			unbondingTime := s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime
+
+			// execute redelegation and check changes on store
+			for index, srd := range tc.superRedelegations {
+				if srd.afterCompletion {
+					s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(unbondingTime))
+				}
+
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+				oldAcc, _ := s.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(s.Ctx, srd.lockId)
+				newValAddr := valAddrs[srd.newValIndex]
+
+				// superfluid redelegate
+				err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, srd.lockId, newValAddr.String())
+				if tc.expErr[index] != nil {
+					s.Require().ErrorIs(err, tc.expErr[index])
+					continue
+				}
+				s.Require().NoError(err)
+
+				denom := lock.Coins[0].Denom
+
+				// check previous validator bonding synthetic lockup deletion
+				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, oldAcc.ValAddr))
+				s.Require().Error(err)
+
+				// check that the lock does not unbond from the previous validator
+				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(denom, oldAcc.ValAddr))
+				s.Require().Error(err)
+
+				// check new validator bonding synthetic lockup creation
+				synthLock, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, newValAddr.String()))
+				s.Require().NoError(err)
+				s.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
+				s.Require().Equal(synthLock.EndTime, time.Time{})
+
+				// check lockID connection with the intermediary account of the new validator
+				expAcc := types.NewSuperfluidIntermediaryAccount(denom, newValAddr.String(), 0)
+				intAcc := s.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(s.Ctx, srd.lockId)
+				s.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
+
+				// check delegation from the intermediary account to the new validator
+				_, found := s.App.StakingKeeper.GetDelegation(s.Ctx, expAcc.GetAccAddress(), newValAddr)
+				s.Require().True(found)
+
+				// check redelegation record
+				redelegation, found := s.App.SuperfluidKeeper.GetLockRedelegation(s.Ctx, srd.lockId)
+				s.Require().True(found)
+				s.Require().Equal(types.SuperfluidRedelegation{
+					LockId:              srd.lockId,
+					SrcValidatorAddress: oldAcc.ValAddr,
+					DstValidatorAddress: newValAddr.String(),
+					CreationHeight:      s.Ctx.BlockHeight(),
+					CompletionTime:      s.Ctx.BlockTime().Add(unbondingTime),
+				}, redelegation)
+			}
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*s.App.SuperfluidKeeper)(s.Ctx)
+			s.Require().False(broken, reason)
+
+			// mature redelegation records are deleted
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(unbondingTime))
+			s.App.SuperfluidKeeper.DeleteMatureLockRedelegations(s.Ctx)
+			s.Require().Empty(s.App.SuperfluidKeeper.GetAllLockRedelegations(s.Ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSuperfluidRedelegateConcentratedPosition() {
+	s.SetupTest()
+
+	positionId, lockId, _, _, valAddr, poolJoinAcc := s.SetupSuperfluidConcentratedPosition(s.Ctx, true, false, false, s.TestAccs[0])
+	newValAddr := s.SetupValidator(stakingtypes.Bonded)
+
+	positionBefore, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	lockBefore, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+	s.Require().NoError(err)
+	clDenom := lockBefore.Coins[0].Denom
+
+	err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, poolJoinAcc.String(), lockId, newValAddr.String())
+	s.Require().NoError(err)
+
+	// the synthetic lockup is moved to the new validator
+	_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, lockId, keeper.StakingSyntheticDenom(clDenom, valAddr.String()))
+	s.Require().Error(err)
+	_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, lockId, keeper.StakingSyntheticDenom(clDenom, newValAddr.String()))
+	s.Require().NoError(err)
+
+	expAcc := types.NewSuperfluidIntermediaryAccount(clDenom, newValAddr.String(), 0)
+	_, found := s.App.StakingKeeper.GetDelegation(s.Ctx, expAcc.GetAccAddress(), newValAddr)
+	s.Require().True(found)
+
+	// the underlying position is left untouched
+	positionAfter, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(positionBefore.Liquidity, positionAfter.Liquidity)
+
+	// the position is slashed when the previous validator is slashed for an infraction before the redelegation
+	slashFactor := osmomath.NewDecWithPrec(5, 2)
+	s.App.SuperfluidKeeper.SlashRedelegationsForValidatorSlash(s.Ctx, valAddr, s.Ctx.BlockHeight(), slashFactor)
+
+	lockAfter, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+	s.Require().NoError(err)
+	amountBefore := lockBefore.Coins.AmountOf(clDenom)
+	s.Require().Equal(amountBefore.Sub(amountBefore.ToLegacyDec().Mul(slashFactor).TruncateInt()), lockAfter.Coins.AmountOf(clDenom))
+
+	positionAfter, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(positionAfter.Liquidity.LT(positionBefore.Liquidity))
+}
+
+func (s *KeeperTestSuite) TestSlashRedelegationsForValidatorSlash() {
+	testCases := []struct {
+		name             string
+		infractionHeight int64
+		afterCompletion  bool
+		expSlashed       bool
+	}{
+		{
+			"infraction before the redelegation is slashed",
+			80,
+			false,
+			true,
+		},
+		{
+			"infraction at the height of the redelegation is slashed",
+			100,
+			false,
+			true,
+		},
+		{
+			"infraction after the redelegation is not slashed",
+			105,
+			false,
+			false,
+		},
+		{
+			"completed redelegation is not slashed",
+			80,
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+			delAddrs := CreateRandomAccounts(1)
+			slashFactor := osmomath.NewDecWithPrec(5, 2)
+
+			// redelegate the lock from val0 to val1 at height 100
+			lock := s.setupSuperfluidDelegate(delAddrs[0], valAddrs[0], denoms[0], 1000000)
+			s.Ctx = s.Ctx.WithBlockHeight(100)
+			err := s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithBlockHeight(110)
+			if tc.afterCompletion {
+				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime))
+			}
+
+			// slash the source validator of the redelegation
+			validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddrs[0])
+			s.Require().True(found)
+			consAddr, err := validator.GetConsAddr()
+			s.Require().NoError(err)
+			power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+			s.App.StakingKeeper.Slash(s.Ctx, consAddr, tc.infractionHeight, power, slashFactor)
+			// Note: this calls AfterValidatorSlashed hook
+
+			// check invariant is fine
+			reason, broken := keeper.AllInvariants(*s.App.SuperfluidKeeper)(s.Ctx)
+			s.Require().False(broken, reason)
+
+			expAmount := osmomath.NewInt(1000000)
+			if tc.expSlashed {
+				expAmount = osmomath.NewDec(1000000).Mul(osmomath.OneDec().Sub(slashFactor)).TruncateInt()
+			}
+			gotLock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lock.ID)
+			s.Require().NoError(err)
+			s.Require().Equal(expAmount.String(), gotLock.Coins.AmountOf(denoms[0]).String())
+		})
+	}
+}
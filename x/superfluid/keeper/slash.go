@@ -56,14 +56,20 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 			// slash the lock whether its bonding or unbonding.
 			// this overslashes unbondings that started unbonding before the slash infraction,
 			// but this seems to be an acceptable trade-off based upon choices taken in the SDK.
-			k.slashSynthLock(ctx, synthLock, slashFactor)
+			k.slashLock(ctx, synthLock.UnderlyingLockId, slashFactor)
 		}
 	}
 }
 
-func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.SyntheticLock, slashFactor osmomath.Dec) {
+// slashLock slashes slashFactor of the tokens of the superfluid staked lock, whether it is superfluid bonded, unbonding
+// or redelegated away from the slashed validator.
+func (k Keeper) slashLock(ctx sdk.Context, lockID uint64, slashFactor osmomath.Dec) {
 	// Only single token lock is allowed here
-	lock, _ := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	// the lock no longer exists, so there is nothing left to slash
+	if err != nil {
+		return
+	}
 	slashAmt := lock.Coins[0].Amount.ToLegacyDec().Mul(slashFactor)
 	lockSharesToSlash := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt.TruncateInt()))
 
@@ -1738,159 +1738,3 @@ func (s *KeeperTestSuite) getExpectedBondDenomPoolAmtAfterConvert(sender sdk.Acc
 
 	return expectedLiquidity.AmountOf(bondDenom)
 }
-
-// type superfluidRedelegation struct {
-// 	lockId      uint64
-// 	oldValIndex int64
-// 	newValIndex int64
-// }
-
-// func (s *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}, {0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, DefaultGammAsset, 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		s.Run(tc.name, func() {
-// 			s.SetupTest()
-
-// 			poolId := s.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			s.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := s.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := s.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			s.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = s.App.SuperfluidKeeper.SuperfluidRedelegate(s.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					s.Require().Error(err)
-// 					continue
-// 				}
-// 				s.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := s.App.SuperfluidKeeper.GetParams(s.Ctx)
-// 				synthLock, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				s.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				s.Require().Equal(synthLock.EndTime, s.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := s.App.LockupKeeper.GetSyntheticLockup(s.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				s.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				s.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				s.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := s.App.SuperfluidKeeper.GetIntermediaryAccount(s.Ctx, expAcc.GetAccAddress())
-// 				s.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				s.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gotAcc.GaugeId)
-// 				s.Require().NoError(err)
-// 				s.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				s.Require().Equal(gauge.IsPerpetual, true)
-// 				s.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				s.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				s.Require().Equal(gauge.StartTime, s.Ctx.BlockTime())
-// 				s.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				s.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				s.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := s.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(s.Ctx, srd.lockId)
-// 				s.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := s.App.StakingKeeper.GetDelegation(s.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				s.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := s.Ctx.CacheContext()
-// 				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, srd.lockId)
-// 				s.Require().NoError(err)
-// 				err = s.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				s.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
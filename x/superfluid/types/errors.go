@@ -22,7 +22,8 @@ var (
 	ErrUnbondingSyntheticLockupExists  = errorsmod.Register(ModuleName, 8, "unbonding synthetic lockup exists on the validator")
 	ErrBondingLockupNotSupported       = errorsmod.Register(ModuleName, 9, "bonded superfluid stake is not allowed to have underlying lock unlocked")
 
-	ErrNonSuperfluidAsset = errorsmod.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
+	ErrNonSuperfluidAsset     = errorsmod.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
+	ErrTransitiveRedelegation = errorsmod.Register(ModuleName, 11, "redelegation of a lockup that is still receiving a redelegation is not allowed")
 
 	ErrPoolNotWhitelisted   = errorsmod.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = errorsmod.Register(ModuleName, 42, "lock not eligible for unpooling")
@@ -15,6 +15,7 @@ func DefaultGenesis() *GenesisState {
 		Params:                    DefaultParams(),
 		SuperfluidAssets:          []SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []OsmoEquivalentMultiplierRecord{},
+		Redelegations:             []SuperfluidRedelegation{},
 	}
 }
 
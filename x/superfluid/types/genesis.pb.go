@@ -36,6 +36,9 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// redelegations is the records of the superfluid redelegations that have
+	// not matured yet.
+	Redelegations []SuperfluidRedelegation `protobuf:"bytes,6,rep,name=redelegations,proto3" json:"redelegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetRedelegations() []SuperfluidRedelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +123,33 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
-	0x14, 0xc7, 0x5b, 0x41, 0x86, 0xe2, 0xa0, 0x0d, 0x26, 0x15, 0x63, 0x21, 0xb2, 0xb0, 0xd8, 0xc6,
-	0x9a, 0xa8, 0x2b, 0x18, 0x63, 0x48, 0x34, 0x12, 0x48, 0x1c, 0x5c, 0x9a, 0xa3, 0x9c, 0xf5, 0x62,
-	0xdb, 0xab, 0xf7, 0xae, 0x04, 0x3e, 0x80, 0x3b, 0x1f, 0x8b, 0x91, 0xd1, 0xc9, 0x18, 0xf8, 0x22,
-	0xa6, 0xed, 0x59, 0x50, 0xaa, 0xdb, 0x6b, 0xdf, 0xef, 0xff, 0x7e, 0xef, 0x92, 0xa7, 0xd4, 0x29,
-	0xf8, 0x14, 0x08, 0x98, 0x10, 0x85, 0x98, 0x3d, 0x79, 0x11, 0x19, 0x9a, 0x2e, 0x0e, 0x30, 0x10,
-	0x30, 0x42, 0x46, 0x39, 0x55, 0x55, 0x41, 0x18, 0x2b, 0xa2, 0x5a, 0x71, 0xa9, 0x4b, 0x93, 0xb6,
-	0x19, 0x57, 0x29, 0x59, 0x6d, 0xe4, 0xcc, 0x5a, 0x95, 0x02, 0xaa, 0xe5, 0x40, 0x21, 0x62, 0xc8,
-	0x17, 0xbe, 0xe3, 0x69, 0x51, 0xd9, 0xb9, 0x49, 0x37, 0xe8, 0x73, 0xc4, 0xb1, 0x7a, 0xa9, 0x94,
-	0x52, 0x40, 0x93, 0xeb, 0x72, 0xb3, 0x6c, 0x55, 0x8d, 0xcd, 0x8d, 0x8c, 0x6e, 0x42, 0xb4, 0x8b,
-	0xb3, 0x8f, 0x9a, 0xd4, 0x13, 0xbc, 0xfa, 0xa0, 0xec, 0xad, 0x10, 0x1b, 0x01, 0x60, 0x0e, 0xda,
-	0x56, 0xbd, 0xd0, 0x2c, 0x5b, 0x8d, 0xbc, 0x21, 0xfd, 0xac, 0x6c, 0xc5, 0xac, 0x98, 0xb6, 0x0b,
-	0x3f, 0x7f, 0x83, 0x3a, 0x56, 0x0e, 0xe3, 0xb4, 0x8d, 0x5f, 0x23, 0x32, 0x42, 0x1e, 0x0e, 0xb8,
-	0xed, 0x47, 0x1e, 0x27, 0xa1, 0x47, 0x30, 0x03, 0xad, 0x90, 0x18, 0xac, 0x3c, 0xc3, 0x3d, 0xf8,
-	0xf4, 0x3a, 0x4b, 0xdd, 0x65, 0xa1, 0x1e, 0x76, 0x28, 0x1b, 0x0a, 0xe1, 0x01, 0xfd, 0x83, 0x02,
-	0xd5, 0x53, 0xf6, 0x49, 0xc0, 0x31, 0xf3, 0xf1, 0x90, 0x20, 0x36, 0xb1, 0x91, 0xe3, 0xd0, 0x28,
-	0xe0, 0xa0, 0x15, 0x13, 0xe7, 0xe9, 0xff, 0xaf, 0xea, 0xac, 0x45, 0x5b, 0x69, 0x52, 0x28, 0x2b,
-	0x64, 0xb3, 0x05, 0xea, 0x9b, 0xac, 0xd4, 0xe2, 0xc6, 0x2f, 0x9b, 0xed, 0xd0, 0x20, 0xc0, 0x0e,
-	0x27, 0x34, 0x00, 0x6d, 0x3b, 0x11, 0x5f, 0xe4, 0x89, 0x6f, 0xa9, 0xf3, 0xd2, 0xc9, 0x93, 0x5e,
-	0x65, 0x79, 0xa1, 0x3f, 0x5a, 0xb3, 0x6c, 0x30, 0xd0, 0xee, 0xce, 0x16, 0xba, 0x3c, 0x5f, 0xe8,
-	0xf2, 0xe7, 0x42, 0x97, 0xa7, 0x4b, 0x5d, 0x9a, 0x2f, 0x75, 0xe9, 0x7d, 0xa9, 0x4b, 0x8f, 0xe7,
-	0x2e, 0xe1, 0xcf, 0xd1, 0xc0, 0x70, 0xa8, 0x6f, 0x8a, 0x0d, 0x4e, 0x3c, 0x34, 0x80, 0xef, 0x0f,
-	0x73, 0x64, 0x59, 0xe6, 0x78, 0xfd, 0xd6, 0xf8, 0x24, 0xc4, 0x30, 0x28, 0x25, 0xb7, 0x76, 0xf6,
-	0x15, 0x00, 0x00, 0xff, 0xff, 0x53, 0xea, 0x54, 0x25, 0xff, 0x02, 0x00, 0x00,
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x13, 0x5b, 0x7b, 0x48, 0x15, 0x34, 0x54, 0x88, 0x15, 0xd3, 0x62, 0x2f, 0x45, 0x30,
+	0xc1, 0x08, 0xea, 0xb5, 0x15, 0x91, 0x82, 0x62, 0x69, 0xc1, 0x83, 0x97, 0x30, 0x4d, 0x9e, 0x71,
+	0x30, 0xc9, 0xc4, 0x79, 0x93, 0xd2, 0x7e, 0x00, 0xef, 0x7e, 0xac, 0x1e, 0x7b, 0xdc, 0xd3, 0xb2,
+	0xb4, 0x97, 0xfd, 0x18, 0x4b, 0x92, 0xd9, 0x34, 0xdd, 0x66, 0x7b, 0x7b, 0xc9, 0xfb, 0xfd, 0xdf,
+	0x6f, 0x86, 0x79, 0x5a, 0x9f, 0x61, 0xc4, 0x90, 0xa2, 0x8d, 0x69, 0x02, 0xfc, 0x57, 0x98, 0x52,
+	0xdf, 0x0e, 0x20, 0x06, 0xa4, 0x68, 0x25, 0x9c, 0x09, 0xa6, 0xeb, 0x92, 0xb0, 0x0e, 0x44, 0xb7,
+	0x13, 0xb0, 0x80, 0xe5, 0x6d, 0x3b, 0xab, 0x0a, 0xb2, 0x3b, 0xa8, 0x99, 0x75, 0x28, 0x25, 0xd4,
+	0xab, 0x81, 0x12, 0xc2, 0x49, 0x24, 0x7d, 0xaf, 0xae, 0x9b, 0xda, 0xa3, 0x2f, 0xc5, 0x09, 0xe6,
+	0x82, 0x08, 0xd0, 0x3f, 0x6a, 0xad, 0x02, 0x30, 0xd4, 0xbe, 0x3a, 0x6c, 0x3b, 0x5d, 0xeb, 0xf4,
+	0x44, 0xd6, 0x34, 0x27, 0xc6, 0xcd, 0xcd, 0x65, 0x4f, 0x99, 0x49, 0x5e, 0xff, 0xa1, 0x3d, 0x3d,
+	0x20, 0x2e, 0x41, 0x04, 0x81, 0xc6, 0x83, 0x7e, 0x63, 0xd8, 0x76, 0x06, 0x75, 0x43, 0xe6, 0x65,
+	0x39, 0xca, 0x58, 0x39, 0xed, 0x09, 0x1e, 0xff, 0x46, 0x7d, 0xa5, 0xbd, 0xc8, 0xd2, 0x2e, 0xfc,
+	0x4d, 0xe9, 0x92, 0x84, 0x10, 0x0b, 0x37, 0x4a, 0x43, 0x41, 0x93, 0x90, 0x02, 0x47, 0xa3, 0x91,
+	0x1b, 0x9c, 0x3a, 0xc3, 0x77, 0x8c, 0xd8, 0xe7, 0x32, 0xf5, 0xad, 0x0c, 0xcd, 0xc0, 0x63, 0xdc,
+	0x97, 0xc2, 0xe7, 0xec, 0x1e, 0x0a, 0xf5, 0x50, 0x7b, 0x46, 0x63, 0x01, 0x3c, 0x02, 0x9f, 0x12,
+	0xbe, 0x76, 0x89, 0xe7, 0xb1, 0x34, 0x16, 0x68, 0x34, 0x73, 0xe7, 0xdb, 0xf3, 0xb7, 0x9a, 0x54,
+	0xa2, 0xa3, 0x22, 0x29, 0x95, 0x1d, 0x7a, 0xda, 0x42, 0xfd, 0x9f, 0xaa, 0xf5, 0xb2, 0xc6, 0x1d,
+	0x9b, 0xeb, 0xb1, 0x38, 0x06, 0x4f, 0x50, 0x16, 0xa3, 0xf1, 0x30, 0x17, 0x7f, 0xa8, 0x13, 0x7f,
+	0x65, 0xde, 0x9f, 0x49, 0x9d, 0xf4, 0x53, 0x99, 0x97, 0xfa, 0x97, 0x15, 0xcb, 0x09, 0x93, 0xbd,
+	0xe3, 0x63, 0x0e, 0x3e, 0x84, 0x10, 0x90, 0x42, 0xda, 0xca, 0xa5, 0xaf, 0xcf, 0xdf, 0x76, 0x56,
+	0x89, 0x48, 0xcf, 0xf1, 0x98, 0xf1, 0x74, 0xb3, 0x33, 0xd5, 0xed, 0xce, 0x54, 0xaf, 0x76, 0xa6,
+	0xfa, 0x7f, 0x6f, 0x2a, 0xdb, 0xbd, 0xa9, 0x5c, 0xec, 0x4d, 0xe5, 0xe7, 0xfb, 0x80, 0x8a, 0xdf,
+	0xe9, 0xc2, 0xf2, 0x58, 0x64, 0x4b, 0xc9, 0x9b, 0x90, 0x2c, 0xf0, 0xf6, 0xc3, 0x5e, 0x3a, 0x8e,
+	0xbd, 0xaa, 0xee, 0xb0, 0x58, 0x27, 0x80, 0x8b, 0x56, 0xbe, 0xc3, 0xef, 0x6e, 0x06, 0x00, 0xd3,
+	0xe2, 0x31, 0x37, 0x57, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +172,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +298,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -479,6 +511,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, SuperfluidRedelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
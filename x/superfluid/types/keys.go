@@ -27,4 +27,7 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixLockRedelegation defines prefix to connect lockId and its latest superfluid redelegation.
+	KeyPrefixLockRedelegation = []byte{0x07}
 )
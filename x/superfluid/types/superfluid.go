@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -37,3 +39,8 @@ func GetSuperfluidIntermediaryAccountAddr(denom, valAddr string) sdk.AccAddress
 	// We are launching with the address as is, so this will have to be done as a migration in the future.
 	return authtypes.NewModuleAddress(denom + valAddr)
 }
+
+// IsMature returns true if the redelegation has completed at the given time.
+func (r SuperfluidRedelegation) IsMature(currentTime time.Time) bool {
+	return !r.CompletionTime.After(currentTime)
+}
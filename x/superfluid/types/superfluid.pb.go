@@ -9,18 +9,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/osmosis-labs/osmosis/v22/x/lockup/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -478,6 +481,87 @@ func (m *ConcentratedPoolUserPositionRecord) GetEquivalentStakedAmount() *types.
 	return nil
 }
 
+// SuperfluidRedelegation is a record of the superfluid redelegation of a lock
+// from a source validator to a destination validator. Until the redelegation
+// matures at its completion time, the lock is slashed for the infractions the
+// source validator committed at or after the creation height, and the lock
+// cannot be redelegated again.
+type SuperfluidRedelegation struct {
+	LockId              uint64    `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	SrcValidatorAddress string    `protobuf:"bytes,2,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	DstValidatorAddress string    `protobuf:"bytes,3,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	CreationHeight      int64     `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	CompletionTime      time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *SuperfluidRedelegation) Reset()         { *m = SuperfluidRedelegation{} }
+func (m *SuperfluidRedelegation) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidRedelegation) ProtoMessage()    {}
+func (*SuperfluidRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
+}
+func (m *SuperfluidRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidRedelegation.Merge(m, src)
+}
+func (m *SuperfluidRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidRedelegation proto.InternalMessageInfo
+
+func (m *SuperfluidRedelegation) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *SuperfluidRedelegation) GetSrcValidatorAddress() string {
+	if m != nil {
+		return m.SrcValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidRedelegation) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
+	}
+	return ""
+}
+
+func (m *SuperfluidRedelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *SuperfluidRedelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
@@ -487,6 +571,7 @@ func init() {
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
+	proto.RegisterType((*SuperfluidRedelegation)(nil), "osmosis.superfluid.SuperfluidRedelegation")
 }
 
 func init() {
@@ -494,59 +579,65 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0x09, 0xa1, 0xde, 0x9c, 0x14, 0x24, 0xa3, 0x50, 0xac, 0x35, 0x15,
-	0x88, 0xcb, 0x6a, 0xbc, 0xf3, 0xba, 0x1e, 0x79, 0x76, 0x67, 0xbb, 0x33, 0x6b, 0xf0, 0x8d, 0x03,
-	0x87, 0x1e, 0xf9, 0x13, 0x2a, 0x71, 0xe3, 0xca, 0x3f, 0xd1, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x40,
-	0xc9, 0x85, 0x73, 0xff, 0x02, 0x34, 0xb3, 0x3f, 0xbc, 0x69, 0x1c, 0x21, 0x2e, 0x70, 0xf2, 0xcc,
-	0xfb, 0xde, 0xbc, 0xf7, 0x7d, 0xf3, 0x3e, 0xcf, 0xa2, 0x3b, 0x5c, 0xc4, 0x5c, 0x50, 0xe1, 0x89,
-	0x3c, 0x85, 0xec, 0x29, 0xcb, 0x29, 0x69, 0x2c, 0xdd, 0x34, 0xe3, 0x92, 0x9b, 0x66, 0x99, 0xe4,
-	0x2e, 0x91, 0xdd, 0xad, 0x88, 0x47, 0x5c, 0xc3, 0x9e, 0x5a, 0x15, 0x99, 0xbb, 0x56, 0xc4, 0x79,
-	0xc4, 0xc0, 0xd3, 0xbb, 0x49, 0xfe, 0xd4, 0x23, 0x79, 0x86, 0x25, 0xe5, 0x49, 0x89, 0xdb, 0x6f,
-	0xe2, 0x92, 0xc6, 0x20, 0x24, 0x8e, 0xd3, 0xaa, 0x40, 0xa8, 0x7b, 0x79, 0x13, 0x2c, 0xc0, 0x9b,
-	0x1f, 0x4c, 0x40, 0xe2, 0x03, 0x2f, 0xe4, 0xb4, 0x2a, 0xb0, 0x53, 0xf1, 0x65, 0x3c, 0x9c, 0xe5,
-	0xa9, 0xfe, 0x29, 0x20, 0x67, 0x81, 0xde, 0x19, 0xd7, 0xfc, 0x06, 0x42, 0x80, 0x34, 0xb7, 0xd0,
-	0x35, 0x02, 0x09, 0x8f, 0xb7, 0x8d, 0x7d, 0xa3, 0xbf, 0xe1, 0x17, 0x1b, 0xf3, 0x53, 0x84, 0xb0,
-	0x82, 0x03, 0xb9, 0x48, 0x61, 0xbb, 0xbd, 0x6f, 0xf4, 0x6f, 0x1c, 0xde, 0x75, 0x2f, 0x6b, 0x74,
-	0xdf, 0x28, 0xf7, 0xe5, 0x22, 0x05, 0x7f, 0x03, 0x57, 0xcb, 0x87, 0xd7, 0x9f, 0xbf, 0xb0, 0x5b,
-	0x7f, 0xbd, 0xb0, 0x0d, 0x67, 0x86, 0xf6, 0x96, 0xb9, 0xc3, 0x44, 0x42, 0x16, 0x03, 0xa1, 0x38,
-	0x5b, 0x0c, 0xc2, 0x90, 0xe7, 0xc9, 0x55, 0x44, 0x76, 0xd0, 0xf5, 0x39, 0x66, 0x01, 0x26, 0x24,
-	0xd3, 0x34, 0x36, 0xfc, 0xf5, 0x39, 0x66, 0x03, 0x42, 0x32, 0x05, 0x45, 0x38, 0x8f, 0x20, 0xa0,
-	0x64, 0xbb, 0xb3, 0x6f, 0xf4, 0xbb, 0xfe, 0xba, 0xde, 0x0f, 0x89, 0xf3, 0x8b, 0x81, 0xac, 0x2f,
-	0x44, 0xcc, 0x3f, 0x79, 0x96, 0xd3, 0x39, 0x66, 0x90, 0xc8, 0xcf, 0x73, 0x26, 0x69, 0xca, 0x28,
-	0x64, 0x3e, 0x84, 0x3c, 0x23, 0xe6, 0xfb, 0xe8, 0x2d, 0x48, 0x79, 0x38, 0x0d, 0x92, 0x3c, 0x9e,
-	0x40, 0xa6, 0xbb, 0x76, 0xfc, 0x4d, 0x1d, 0x7b, 0xac, 0x43, 0x4b, 0x46, 0xed, 0x26, 0xa3, 0xaf,
-	0x11, 0x8a, 0xeb, 0x62, 0xba, 0xf1, 0xc6, 0xd1, 0xc7, 0x2f, 0x4f, 0xed, 0xd6, 0xef, 0xa7, 0xf6,
-	0x7b, 0xc5, 0x68, 0x04, 0x99, 0xb9, 0x94, 0x7b, 0x31, 0x96, 0x53, 0xf7, 0x04, 0x22, 0x1c, 0x2e,
-	0x1e, 0x41, 0xf8, 0xfa, 0xd4, 0xbe, 0xb9, 0xc0, 0x31, 0x7b, 0xe8, 0x2c, 0x8f, 0x3b, 0x7e, 0xa3,
-	0x96, 0xf3, 0xba, 0x8d, 0x76, 0x97, 0x77, 0xf4, 0x08, 0x18, 0x44, 0xda, 0x18, 0x25, 0xe3, 0xfb,
-	0xe8, 0x26, 0x29, 0x62, 0x3c, 0xd3, 0x17, 0x02, 0x42, 0x94, 0x97, 0xd5, 0xab, 0x81, 0x41, 0x11,
-	0x57, 0xc9, 0x73, 0xcc, 0x28, 0xb9, 0x90, 0x5c, 0xe8, 0xe8, 0xd5, 0x40, 0x95, 0xfc, 0x6d, 0x5d,
-	0x99, 0xf2, 0x24, 0xc0, 0xb1, 0x9a, 0x87, 0x56, 0xb6, 0x79, 0xb8, 0xe3, 0x16, 0x92, 0x5c, 0xe5,
-	0x36, 0xb7, 0x74, 0x9b, 0x7b, 0xcc, 0x69, 0x72, 0xe4, 0x29, 0xd1, 0x3f, 0xff, 0x61, 0xdf, 0x8d,
-	0xa8, 0x9c, 0xe6, 0x13, 0x37, 0xe4, 0xb1, 0x57, 0x5a, 0xb3, 0xf8, 0x79, 0x20, 0xc8, 0xcc, 0x53,
-	0x06, 0x12, 0xfa, 0x40, 0xcd, 0x92, 0xf2, 0x64, 0xa0, 0x7b, 0x98, 0xdf, 0x1b, 0x68, 0x1b, 0xea,
-	0x19, 0x05, 0x42, 0xe2, 0x19, 0x90, 0x8a, 0x40, 0xf7, 0x9f, 0x08, 0xdc, 0xff, 0x37, 0xcd, 0x6f,
-	0x2f, 0xfb, 0x8c, 0x75, 0x9b, 0x82, 0x82, 0xf3, 0x0c, 0xdd, 0x39, 0xe1, 0xe1, 0x6c, 0xb8, 0xca,
-	0x93, 0xc7, 0x3c, 0x49, 0x20, 0x54, 0x7c, 0xcd, 0x77, 0xd1, 0xba, 0xfa, 0x1f, 0x29, 0xaf, 0x19,
-	0xda, 0x6b, 0x6b, 0x4c, 0x9f, 0x32, 0x0f, 0xd0, 0x16, 0x6d, 0x9c, 0x0c, 0x70, 0x71, 0xb4, 0xbc,
-	0xeb, 0x5b, 0xf4, 0x72, 0x55, 0xe7, 0x1e, 0xba, 0xfd, 0x24, 0x49, 0x39, 0x67, 0x5f, 0x4d, 0xa9,
-	0x04, 0x46, 0x85, 0x04, 0x32, 0xe2, 0x9c, 0x09, 0xb3, 0x87, 0x3a, 0x94, 0xa8, 0xa1, 0x76, 0xfa,
-	0x5d, 0x5f, 0x2d, 0x9d, 0x5f, 0x3b, 0xc8, 0x39, 0xe6, 0x49, 0x08, 0x89, 0xcc, 0x70, 0x99, 0xf7,
-	0x44, 0x40, 0x36, 0xe2, 0x82, 0x5e, 0xf4, 0xc6, 0xe5, 0x71, 0x1b, 0x57, 0x8c, 0xdb, 0x46, 0x9b,
-	0x69, 0x79, 0x5c, 0xe9, 0x69, 0x6b, 0x3d, 0xa8, 0x0a, 0x0d, 0x49, 0x53, 0x6c, 0xe7, 0x82, 0xd8,
-	0xcf, 0xd0, 0x0d, 0xb1, 0x48, 0xe4, 0x14, 0x24, 0x0d, 0x03, 0x15, 0x2b, 0x87, 0xb4, 0x57, 0x3f,
-	0x0d, 0xc5, 0x9b, 0xe3, 0x8e, 0xab, 0x2c, 0x75, 0xb7, 0x47, 0x5d, 0xe5, 0x14, 0xff, 0x6d, 0xd1,
-	0x0c, 0xae, 0x36, 0xdd, 0xb5, 0xff, 0xdb, 0x74, 0x6b, 0xff, 0x85, 0xe9, 0xee, 0xfd, 0x60, 0xa0,
-	0x5b, 0x2b, 0x5e, 0x4e, 0x73, 0x0f, 0xed, 0xac, 0x08, 0x3f, 0xc6, 0x92, 0xce, 0xa1, 0xd7, 0x32,
-	0xad, 0xe6, 0xfb, 0x50, 0xc3, 0x27, 0xa3, 0xf1, 0x14, 0x67, 0xd0, 0x33, 0xcc, 0x3e, 0xfa, 0x60,
-	0x05, 0xde, 0xb4, 0x4f, 0x91, 0xd9, 0xde, 0xed, 0x3e, 0xff, 0xc9, 0x6a, 0x1d, 0x8d, 0x5e, 0x9e,
-	0x59, 0xc6, 0xab, 0x33, 0xcb, 0xf8, 0xf3, 0xcc, 0x32, 0x7e, 0x3c, 0xb7, 0x5a, 0xaf, 0xce, 0xad,
-	0xd6, 0x6f, 0xe7, 0x56, 0xeb, 0x9b, 0x8f, 0x1a, 0x0a, 0xcb, 0xd1, 0x3e, 0x60, 0x78, 0x22, 0xaa,
-	0x8d, 0x37, 0x3f, 0x3c, 0xf4, 0xbe, 0x6b, 0x7e, 0x11, 0xb5, 0xea, 0xc9, 0x9a, 0xfe, 0xce, 0x7c,
-	0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x25, 0xd3, 0x34, 0x07, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0xda, 0x6e, 0x3e, 0x26, 0x90, 0xb8, 0x9b, 0x34, 0x24, 0x46, 0x59, 0x87, 0x2d, 0x52,
+	0xac, 0x56, 0xdd, 0x55, 0x8c, 0x84, 0x50, 0x6f, 0x76, 0x0a, 0x22, 0x28, 0x2d, 0xd1, 0xa6, 0x05,
+	0xc4, 0xc5, 0x1a, 0xcf, 0xbc, 0x5d, 0x8f, 0xbc, 0xbb, 0xb3, 0xdd, 0x99, 0x35, 0xf8, 0xc6, 0x81,
+	0x43, 0x8f, 0xe5, 0x1f, 0x54, 0xe2, 0xc6, 0x95, 0x3f, 0xd1, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x8a,
+	0x92, 0x0b, 0xe7, 0xfe, 0x02, 0x34, 0xb3, 0xbb, 0xf6, 0x26, 0x71, 0x84, 0xb8, 0xc0, 0xc9, 0x33,
+	0xef, 0xe7, 0xf3, 0xce, 0xf3, 0xcc, 0x8e, 0xd1, 0x6d, 0x2e, 0x42, 0x2e, 0x98, 0x70, 0x45, 0x1a,
+	0x43, 0xf2, 0x34, 0x48, 0x19, 0x2d, 0x2d, 0x9d, 0x38, 0xe1, 0x92, 0x9b, 0x66, 0x1e, 0xe4, 0xcc,
+	0x3c, 0xcd, 0x0d, 0x9f, 0xfb, 0x5c, 0xbb, 0x5d, 0xb5, 0xca, 0x22, 0x9b, 0x96, 0xcf, 0xb9, 0x1f,
+	0x80, 0xab, 0x77, 0x83, 0xf4, 0xa9, 0x4b, 0xd3, 0x04, 0x4b, 0xc6, 0xa3, 0xdc, 0xdf, 0xba, 0xec,
+	0x97, 0x2c, 0x04, 0x21, 0x71, 0x18, 0x17, 0x05, 0x88, 0xee, 0xe5, 0x0e, 0xb0, 0x00, 0x77, 0xbc,
+	0x3f, 0x00, 0x89, 0xf7, 0x5d, 0xc2, 0x59, 0x51, 0x60, 0xbb, 0xc0, 0x1b, 0x70, 0x32, 0x4a, 0x63,
+	0xfd, 0x93, 0xb9, 0xec, 0x09, 0x5a, 0x3b, 0x99, 0xe2, 0xeb, 0x0a, 0x01, 0xd2, 0xdc, 0x40, 0x37,
+	0x28, 0x44, 0x3c, 0xdc, 0x32, 0x76, 0x8d, 0xf6, 0xb2, 0x97, 0x6d, 0xcc, 0xcf, 0x10, 0xc2, 0xca,
+	0xdd, 0x97, 0x93, 0x18, 0xb6, 0xaa, 0xbb, 0x46, 0x7b, 0xb5, 0xb3, 0xe7, 0x5c, 0x9d, 0xd1, 0xb9,
+	0x54, 0xee, 0xf1, 0x24, 0x06, 0x6f, 0x19, 0x17, 0xcb, 0xfb, 0x4b, 0xcf, 0x5f, 0xb6, 0x2a, 0x7f,
+	0xbd, 0x6c, 0x19, 0xf6, 0x08, 0xed, 0xcc, 0x62, 0x0f, 0x23, 0x09, 0x49, 0x08, 0x94, 0xe1, 0x64,
+	0xd2, 0x25, 0x84, 0xa7, 0xd1, 0x75, 0x40, 0xb6, 0xd1, 0xd2, 0x18, 0x07, 0x7d, 0x4c, 0x69, 0xa2,
+	0x61, 0x2c, 0x7b, 0x8b, 0x63, 0x1c, 0x74, 0x29, 0x4d, 0x94, 0xcb, 0xc7, 0xa9, 0x0f, 0x7d, 0x46,
+	0xb7, 0x6a, 0xbb, 0x46, 0xbb, 0xee, 0x2d, 0xea, 0xfd, 0x21, 0xb5, 0x7f, 0x35, 0x90, 0xf5, 0xa5,
+	0x08, 0xf9, 0xa7, 0xcf, 0x52, 0x36, 0xc6, 0x01, 0x44, 0xf2, 0x61, 0x1a, 0x48, 0x16, 0x07, 0x0c,
+	0x12, 0x0f, 0x08, 0x4f, 0xa8, 0xf9, 0x01, 0x7a, 0x07, 0x62, 0x4e, 0x86, 0xfd, 0x28, 0x0d, 0x07,
+	0x90, 0xe8, 0xae, 0x35, 0x6f, 0x45, 0xdb, 0x1e, 0x69, 0xd3, 0x0c, 0x51, 0xb5, 0x8c, 0xe8, 0x1b,
+	0x84, 0xc2, 0x69, 0x31, 0xdd, 0x78, 0xb9, 0xf7, 0xc9, 0xab, 0xd3, 0x56, 0xe5, 0x8f, 0xd3, 0xd6,
+	0xfb, 0x19, 0x35, 0x82, 0x8e, 0x1c, 0xc6, 0xdd, 0x10, 0xcb, 0xa1, 0x73, 0x04, 0x3e, 0x26, 0x93,
+	0x07, 0x40, 0xde, 0x9e, 0xb6, 0x6e, 0x4e, 0x70, 0x18, 0xdc, 0xb7, 0x67, 0xe9, 0xb6, 0x57, 0xaa,
+	0x65, 0xbf, 0xad, 0xa2, 0xe6, 0xec, 0x8c, 0x1e, 0x40, 0x00, 0xbe, 0x16, 0x46, 0x8e, 0xf8, 0x2e,
+	0xba, 0x49, 0x33, 0x1b, 0x4f, 0xf4, 0x81, 0x80, 0x10, 0xf9, 0x61, 0x35, 0xa6, 0x8e, 0x6e, 0x66,
+	0x57, 0xc1, 0x63, 0x1c, 0x30, 0x7a, 0x21, 0x38, 0x9b, 0xa3, 0x31, 0x75, 0x14, 0xc1, 0xdf, 0x4d,
+	0x2b, 0x33, 0x1e, 0xf5, 0x71, 0xa8, 0xf8, 0xd0, 0x93, 0xad, 0x74, 0xb6, 0x9d, 0x6c, 0x24, 0x47,
+	0xa9, 0xcd, 0xc9, 0xd5, 0xe6, 0x1c, 0x70, 0x16, 0xf5, 0x5c, 0x35, 0xf4, 0x2f, 0x6f, 0x5a, 0x7b,
+	0x3e, 0x93, 0xc3, 0x74, 0xe0, 0x10, 0x1e, 0xba, 0xb9, 0x34, 0xb3, 0x9f, 0x7b, 0x82, 0x8e, 0x5c,
+	0x25, 0x20, 0xa1, 0x13, 0xa6, 0x28, 0x19, 0x8f, 0xba, 0xba, 0x87, 0xf9, 0x83, 0x81, 0xb6, 0x60,
+	0xca, 0x51, 0x5f, 0x48, 0x3c, 0x02, 0x5a, 0x00, 0xa8, 0xff, 0x13, 0x80, 0xbb, 0xff, 0xa6, 0xf9,
+	0xe6, 0xac, 0xcf, 0x89, 0x6e, 0x93, 0x41, 0xb0, 0x9f, 0xa1, 0xdb, 0x47, 0x9c, 0x8c, 0x0e, 0xe7,
+	0x69, 0xf2, 0x80, 0x47, 0x11, 0x10, 0x85, 0xd7, 0x7c, 0x0f, 0x2d, 0xaa, 0x7b, 0xa4, 0xb4, 0x66,
+	0x68, 0xad, 0x2d, 0x04, 0x3a, 0xcb, 0xdc, 0x47, 0x1b, 0xac, 0x94, 0xd9, 0xc7, 0x59, 0x6a, 0x7e,
+	0xd6, 0xeb, 0xec, 0x6a, 0x55, 0xfb, 0x0e, 0xda, 0x7c, 0x12, 0xc5, 0x9c, 0x07, 0x5f, 0x0f, 0x99,
+	0x84, 0x80, 0x09, 0x09, 0xf4, 0x98, 0xf3, 0x40, 0x98, 0x0d, 0x54, 0x63, 0x54, 0x91, 0x5a, 0x6b,
+	0xd7, 0x3d, 0xb5, 0xb4, 0x7f, 0xab, 0x21, 0xfb, 0x80, 0x47, 0x04, 0x22, 0x99, 0xe0, 0x3c, 0xee,
+	0x89, 0x80, 0xe4, 0x98, 0x0b, 0x76, 0x51, 0x1b, 0x57, 0xe9, 0x36, 0xae, 0xa1, 0xbb, 0x85, 0x56,
+	0xe2, 0x3c, 0x5d, 0xcd, 0x53, 0xd5, 0xf3, 0xa0, 0xc2, 0x74, 0x48, 0xcb, 0xc3, 0xd6, 0x2e, 0x0c,
+	0xfb, 0x05, 0x5a, 0x15, 0x93, 0x48, 0x0e, 0x41, 0x32, 0xd2, 0x57, 0xb6, 0x9c, 0xa4, 0x9d, 0xe9,
+	0xa7, 0x21, 0xfb, 0xe6, 0x38, 0x27, 0x45, 0x94, 0x3a, 0xdb, 0x5e, 0x5d, 0x29, 0xc5, 0x7b, 0x57,
+	0x94, 0x8d, 0xf3, 0x45, 0x77, 0xe3, 0xff, 0x16, 0xdd, 0xc2, 0x7f, 0x22, 0xba, 0x9f, 0xaa, 0x68,
+	0x73, 0x76, 0xd3, 0x3d, 0x98, 0x61, 0xbc, 0x5e, 0x68, 0x1d, 0x74, 0x4b, 0x24, 0xa4, 0x7f, 0xdd,
+	0xad, 0x5e, 0x17, 0x09, 0xf9, 0xea, 0x32, 0xd3, 0x1d, 0x74, 0x8b, 0x0a, 0x39, 0x27, 0xa7, 0x96,
+	0xe5, 0x50, 0x21, 0xaf, 0xe4, 0xec, 0xa1, 0x35, 0x92, 0x40, 0xc6, 0xca, 0x10, 0x98, 0x3f, 0xcc,
+	0x6e, 0x62, 0xcd, 0x5b, 0x2d, 0xcc, 0x9f, 0x6b, 0xab, 0xf9, 0x10, 0xad, 0x11, 0x1e, 0xc6, 0x01,
+	0xe8, 0x50, 0xf5, 0x4a, 0xe5, 0xf4, 0x35, 0x9d, 0xec, 0x09, 0x73, 0x8a, 0x27, 0xcc, 0x79, 0x5c,
+	0x3c, 0x61, 0xbd, 0x25, 0xc5, 0xdf, 0x8b, 0x37, 0x2d, 0xc3, 0x5b, 0x9d, 0x25, 0x2b, 0xf7, 0x9d,
+	0x1f, 0x0d, 0xb4, 0x3e, 0xe7, 0x35, 0x31, 0x77, 0xd0, 0xf6, 0x1c, 0xf3, 0x23, 0x2c, 0xd9, 0x18,
+	0x1a, 0x15, 0xd3, 0x42, 0xcd, 0x39, 0xee, 0xa3, 0xe3, 0x93, 0x21, 0x4e, 0xa0, 0x61, 0x98, 0x6d,
+	0xf4, 0xe1, 0x1c, 0x7f, 0xf9, 0x4a, 0x65, 0x91, 0xd5, 0x66, 0xfd, 0xf9, 0xcf, 0x56, 0xa5, 0x77,
+	0xfc, 0xea, 0xcc, 0x32, 0x5e, 0x9f, 0x59, 0xc6, 0x9f, 0x67, 0x96, 0xf1, 0xe2, 0xdc, 0xaa, 0xbc,
+	0x3e, 0xb7, 0x2a, 0xbf, 0x9f, 0x5b, 0x95, 0x6f, 0x3f, 0x2e, 0xb1, 0x9e, 0xcb, 0xfd, 0x5e, 0x80,
+	0x07, 0xa2, 0xd8, 0xb8, 0xe3, 0x4e, 0xc7, 0xfd, 0xbe, 0xfc, 0x2f, 0x41, 0x2b, 0x61, 0xb0, 0xa0,
+	0x8f, 0xe1, 0xa3, 0xbf, 0x07, 0x00, 0x6a, 0x9f, 0x23, 0xab, 0x48, 0x08, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -905,6 +996,61 @@ func (m *ConcentratedPoolUserPositionRecord) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *SuperfluidRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.CreationHeight != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -1052,6 +1198,31 @@ func (m *ConcentratedPoolUserPositionRecord) Size() (n int) {
 	return n
 }
 
+func (m *SuperfluidRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovSuperfluid(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2059,6 +2230,191 @@ func (m *ConcentratedPoolUserPositionRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SuperfluidRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -424,6 +424,106 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) GetLockId() uint64 {
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
+// MsgSuperfluidRedelegate moves the superfluid delegation of a lock to a new
+// validator. The lock keeps being slashed for the infractions of the previous
+// validator until the redelegation matures after the unbonding period, and
+// cannot be redelegated again in the meantime.
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{8}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{9}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
 type MsgLockAndSuperfluidDelegate struct {
 	Sender  string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
@@ -434,7 +534,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{8}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +592,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{9}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +645,7 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegate) String() string {
 }
 func (*MsgCreateFullRangePositionAndSuperfluidDelegate) ProtoMessage() {}
 func (*MsgCreateFullRangePositionAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{10}
+	return fileDescriptor_55b645f187d22814, []int{12}
 }
 func (m *MsgCreateFullRangePositionAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -615,7 +715,7 @@ func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) String() strin
 }
 func (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse) ProtoMessage() {}
 func (*MsgCreateFullRangePositionAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{11}
+	return fileDescriptor_55b645f187d22814, []int{13}
 }
 func (m *MsgCreateFullRangePositionAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +775,7 @@ func (m *MsgUnPoolWhitelistedPool) Reset()         { *m = MsgUnPoolWhitelistedPo
 func (m *MsgUnPoolWhitelistedPool) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPool) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{12}
+	return fileDescriptor_55b645f187d22814, []int{14}
 }
 func (m *MsgUnPoolWhitelistedPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -726,7 +826,7 @@ func (m *MsgUnPoolWhitelistedPoolResponse) Reset()         { *m = MsgUnPoolWhite
 func (m *MsgUnPoolWhitelistedPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnPoolWhitelistedPoolResponse) ProtoMessage()    {}
 func (*MsgUnPoolWhitelistedPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{13}
+	return fileDescriptor_55b645f187d22814, []int{15}
 }
 func (m *MsgUnPoolWhitelistedPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +880,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) String() stri
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{14}
+	return fileDescriptor_55b645f187d22814, []int{16}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +952,7 @@ func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Strin
 }
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) ProtoMessage() {}
 func (*MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{15}
+	return fileDescriptor_55b645f187d22814, []int{17}
 }
 func (m *MsgUnlockAndMigrateSharesToFullRangeConcentratedPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +1004,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) String() string {
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{16}
+	return fileDescriptor_55b645f187d22814, []int{18}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1080,7 @@ func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) String() strin
 }
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) ProtoMessage() {}
 func (*MsgAddToConcentratedLiquiditySuperfluidPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{17}
+	return fileDescriptor_55b645f187d22814, []int{19}
 }
 func (m *MsgAddToConcentratedLiquiditySuperfluidPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1145,7 @@ func (m *MsgUnbondConvertAndStake) Reset()         { *m = MsgUnbondConvertAndSta
 func (m *MsgUnbondConvertAndStake) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStake) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{18}
+	return fileDescriptor_55b645f187d22814, []int{20}
 }
 func (m *MsgUnbondConvertAndStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1110,7 +1210,7 @@ func (m *MsgUnbondConvertAndStakeResponse) Reset()         { *m = MsgUnbondConve
 func (m *MsgUnbondConvertAndStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbondConvertAndStakeResponse) ProtoMessage()    {}
 func (*MsgUnbondConvertAndStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{19}
+	return fileDescriptor_55b645f187d22814, []int{21}
 }
 func (m *MsgUnbondConvertAndStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,6 +1248,8 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
 	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUndelegateAndUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
 	proto.RegisterType((*MsgCreateFullRangePositionAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgCreateFullRangePositionAndSuperfluidDelegate")
@@ -1165,102 +1267,105 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xcf, 0xda, 0x21, 0x81, 0x09, 0x09, 0xc9, 0xbe, 0x04, 0x8c, 0x01, 0xdb, 0x0c, 0xf0, 0x12,
-	0x3e, 0xbc, 0x1b, 0x87, 0xf7, 0x85, 0x28, 0x3d, 0x94, 0x38, 0x56, 0x2b, 0x97, 0x44, 0x45, 0x4b,
-	0x50, 0xa5, 0x5e, 0xdc, 0xb5, 0x67, 0xb2, 0xd9, 0x66, 0x77, 0xc7, 0x78, 0xc6, 0x21, 0x51, 0x4f,
-	0x6d, 0xa5, 0x56, 0xe2, 0x84, 0x7a, 0x69, 0x2f, 0x55, 0xcf, 0xad, 0xaa, 0x8a, 0x3f, 0xa1, 0x47,
-	0x8e, 0x1c, 0xab, 0x56, 0x0a, 0x15, 0x1c, 0x7a, 0xcf, 0xa5, 0xd7, 0x6a, 0x76, 0x67, 0xd7, 0xeb,
-	0x78, 0x37, 0xf6, 0x9a, 0x5c, 0x7a, 0x49, 0x76, 0x66, 0x9e, 0x8f, 0xdf, 0xf3, 0x3d, 0x63, 0x70,
-	0x9e, 0x50, 0x9b, 0x50, 0x93, 0xaa, 0xb4, 0xdd, 0xc4, 0xad, 0x0d, 0xab, 0x6d, 0x22, 0x95, 0xed,
-	0x28, 0xcd, 0x16, 0x61, 0x44, 0x96, 0xc5, 0xa1, 0xd2, 0x39, 0xcc, 0x9e, 0x36, 0x88, 0x41, 0xdc,
-	0x63, 0x95, 0x7f, 0x79, 0x94, 0xd9, 0x19, 0xdd, 0x36, 0x1d, 0xa2, 0xba, 0x7f, 0xc5, 0x56, 0xce,
-	0x20, 0xc4, 0xb0, 0xb0, 0xea, 0xae, 0xea, 0xed, 0x0d, 0x15, 0xb5, 0x5b, 0x3a, 0x33, 0x89, 0xe3,
-	0x9f, 0x37, 0x5c, 0xe9, 0x6a, 0x5d, 0xa7, 0x58, 0xdd, 0x2e, 0xd5, 0x31, 0xd3, 0x4b, 0x6a, 0x83,
-	0x98, 0xfe, 0x79, 0xfe, 0x20, 0x3f, 0x33, 0x6d, 0x4c, 0x99, 0x6e, 0x37, 0x05, 0xc1, 0xe5, 0x08,
-	0xe8, 0x9d, 0x4f, 0x8f, 0x08, 0x7e, 0x27, 0x81, 0xd9, 0x35, 0x6a, 0x3c, 0x0c, 0xf6, 0x2b, 0xd8,
-	0xc2, 0x86, 0xce, 0xb0, 0x7c, 0x1d, 0x8c, 0x51, 0xec, 0x20, 0xdc, 0xca, 0x48, 0x05, 0x69, 0xee,
-	0x44, 0x79, 0x66, 0x7f, 0x2f, 0x3f, 0xb9, 0xab, 0xdb, 0xd6, 0x12, 0xf4, 0xf6, 0xa1, 0x26, 0x08,
-	0xe4, 0xb3, 0x60, 0xdc, 0x22, 0x8d, 0xad, 0x9a, 0x89, 0x32, 0xa9, 0x82, 0x34, 0x37, 0xaa, 0x8d,
-	0xf1, 0x65, 0x15, 0xc9, 0xe7, 0xc0, 0xf1, 0x6d, 0xdd, 0xaa, 0xe9, 0x08, 0xb5, 0x32, 0x69, 0x2e,
-	0x45, 0x1b, 0xdf, 0xd6, 0xad, 0x65, 0x84, 0x5a, 0x4b, 0x85, 0xa7, 0x7f, 0x3d, 0xbf, 0x11, 0xe1,
-	0xdd, 0x22, 0x12, 0x00, 0x60, 0x1e, 0x5c, 0x8c, 0x44, 0xa6, 0x61, 0xda, 0x24, 0x0e, 0xc5, 0xf0,
-	0x73, 0x09, 0x9c, 0xed, 0xa2, 0x78, 0xe4, 0xa0, 0x23, 0x44, 0xbf, 0x04, 0x39, 0xc4, 0x8b, 0x11,
-	0x10, 0xdb, 0x81, 0x1e, 0x78, 0x09, 0xe4, 0x63, 0x20, 0x04, 0x30, 0xbf, 0xe8, 0x85, 0x59, 0x27,
-	0x0e, 0x5a, 0x25, 0x8d, 0xad, 0x23, 0x81, 0x79, 0x99, 0xc3, 0xcc, 0x45, 0xc2, 0xe4, 0x7a, 0x8a,
-	0x9c, 0x2c, 0x02, 0xa7, 0x8f, 0x21, 0xc0, 0xf9, 0x8b, 0x04, 0xae, 0xc4, 0xd8, 0xb2, 0xec, 0x1c,
-	0x31, 0x68, 0xb9, 0x0c, 0x46, 0x79, 0x2e, 0xbb, 0x59, 0x31, 0xb1, 0x70, 0x4e, 0xf1, 0x92, 0x5d,
-	0xe1, 0xc9, 0xae, 0x88, 0x64, 0x57, 0x56, 0x88, 0xe9, 0x94, 0xff, 0xf3, 0x62, 0x2f, 0x3f, 0xb2,
-	0xbf, 0x97, 0x9f, 0xf0, 0x14, 0x70, 0x26, 0xa8, 0xb9, 0xbc, 0xf0, 0x7d, 0x70, 0x6b, 0x10, 0xbc,
-	0xbe, 0x81, 0x61, 0x30, 0x52, 0x18, 0x0c, 0xdc, 0x97, 0xc0, 0x85, 0x35, 0x6a, 0x70, 0xe2, 0x65,
-	0x07, 0xbd, 0x5d, 0x2d, 0xe8, 0xe0, 0x18, 0x07, 0x47, 0x33, 0xa9, 0x42, 0xfa, 0x70, 0xcb, 0xe6,
-	0xb9, 0x65, 0x3f, 0xbd, 0xca, 0xcf, 0x19, 0x26, 0xdb, 0x6c, 0xd7, 0x95, 0x06, 0xb1, 0x55, 0x51,
-	0xf3, 0xde, 0xbf, 0x22, 0x45, 0x5b, 0x2a, 0xdb, 0x6d, 0x62, 0xea, 0x32, 0x50, 0xcd, 0x93, 0x7c,
-	0x58, 0x55, 0x5d, 0xe7, 0xb9, 0x70, 0xc5, 0xcf, 0x05, 0x6e, 0x5e, 0x51, 0x77, 0x50, 0x31, 0xaa,
-	0xbc, 0xee, 0xb8, 0xd1, 0x8e, 0xb5, 0x39, 0xf0, 0xda, 0x14, 0x48, 0x55, 0x2b, 0xc2, 0x61, 0xa9,
-	0x6a, 0x05, 0x3e, 0x4f, 0x01, 0x75, 0x8d, 0x1a, 0x2b, 0x2d, 0xac, 0x33, 0xfc, 0x5e, 0xdb, 0xb2,
-	0x34, 0xdd, 0x31, 0xf0, 0x03, 0x42, 0x4d, 0xde, 0xbc, 0xfe, 0xdd, 0xfe, 0x93, 0x6f, 0x82, 0xf1,
-	0x26, 0x21, 0x16, 0x4f, 0x91, 0x51, 0x6e, 0x71, 0x59, 0xde, 0xdf, 0xcb, 0x4f, 0x79, 0x48, 0xc5,
-	0x01, 0xd4, 0xc6, 0xf8, 0x57, 0x15, 0x2d, 0x5d, 0xe3, 0xce, 0x86, 0xbe, 0xb3, 0x37, 0xda, 0x96,
-	0x55, 0x6c, 0x71, 0x5f, 0x78, 0x2e, 0xdf, 0xe8, 0xb8, 0xfa, 0x31, 0xb8, 0x9b, 0xd0, 0x63, 0x81,
-	0xf7, 0xcf, 0x00, 0x2f, 0x49, 0x2b, 0x5d, 0x29, 0x5b, 0x91, 0x73, 0x00, 0x34, 0x85, 0x80, 0x6a,
-	0x45, 0xd4, 0x56, 0x68, 0x87, 0xf7, 0xf5, 0xcc, 0x1a, 0x35, 0x1e, 0x39, 0x0f, 0x08, 0xb1, 0x3e,
-	0xda, 0x34, 0x19, 0xb6, 0x4c, 0xca, 0x30, 0xe2, 0xcb, 0x24, 0xe1, 0x08, 0x39, 0x24, 0xd5, 0xd7,
-	0x21, 0x57, 0xb8, 0x43, 0xf2, 0xbe, 0x43, 0xda, 0x0e, 0xdf, 0x2e, 0x3e, 0xe9, 0x28, 0x2f, 0xf2,
-	0x0d, 0xf8, 0x01, 0x28, 0xc4, 0x21, 0x0b, 0xcc, 0xfe, 0x2f, 0x38, 0x85, 0x77, 0x4c, 0x86, 0x51,
-	0x4d, 0x54, 0x2c, 0xcd, 0x48, 0x85, 0xf4, 0xdc, 0xa8, 0x36, 0xe9, 0x6d, 0xaf, 0xba, 0x85, 0x4b,
-	0xe1, 0x8f, 0x69, 0xb0, 0xe8, 0x0a, 0xb3, 0xbc, 0x3c, 0x5e, 0x33, 0x8d, 0x96, 0xce, 0xf0, 0xc3,
-	0x4d, 0xbd, 0x85, 0xe9, 0x3a, 0x09, 0x9c, 0xbd, 0x42, 0x9c, 0x06, 0x76, 0x18, 0x3f, 0x43, 0xbe,
-	0xe3, 0x13, 0xba, 0x21, 0xdc, 0xc7, 0xd2, 0x61, 0x37, 0x88, 0x03, 0x18, 0xf4, 0x36, 0x03, 0xcc,
-	0x50, 0x17, 0x40, 0x8d, 0x91, 0x9a, 0xed, 0x21, 0xea, 0xdf, 0xe8, 0x0a, 0xa2, 0xd1, 0x65, 0x04,
-	0x82, 0x83, 0x12, 0xa0, 0x76, 0x8a, 0x0a, 0xb3, 0x84, 0x95, 0xf2, 0x53, 0x09, 0x4c, 0x31, 0xb2,
-	0x85, 0x9d, 0x1a, 0x69, 0xb3, 0x9a, 0xcd, 0xab, 0x66, 0xb4, 0x5f, 0xd5, 0x54, 0x85, 0x9a, 0x59,
-	0x4f, 0x4d, 0x37, 0x3b, 0x4c, 0x54, 0x4e, 0x27, 0x5d, 0xe6, 0x0f, 0xdb, 0x6c, 0xcd, 0x74, 0xe8,
-	0x52, 0x9e, 0x07, 0x3f, 0xdb, 0x09, 0x7e, 0xd0, 0x7c, 0x7c, 0xfc, 0xdf, 0xa7, 0xc1, 0xbd, 0x61,
-	0x63, 0x15, 0x24, 0x46, 0x15, 0x8c, 0xeb, 0x36, 0x69, 0x3b, 0x6c, 0x5e, 0x04, 0x4d, 0xe5, 0xf6,
-	0xfc, 0xbe, 0x97, 0x9f, 0xf5, 0x40, 0x52, 0xb4, 0xa5, 0x98, 0x44, 0xb5, 0x75, 0xb6, 0xa9, 0x54,
-	0x1d, 0xd6, 0x89, 0x92, 0xe0, 0x82, 0x9a, 0xcf, 0xdf, 0x11, 0x55, 0x72, 0x63, 0x9a, 0x54, 0x54,
-	0x29, 0x10, 0x55, 0x92, 0x2d, 0x30, 0x63, 0x99, 0x8f, 0xdb, 0x26, 0x32, 0xd9, 0x6e, 0xad, 0xe1,
-	0xd6, 0x39, 0xf2, 0x5a, 0x4b, 0xf9, 0x5d, 0x21, 0xf4, 0x7c, 0xaf, 0xd0, 0x55, 0x6c, 0xe8, 0x8d,
-	0xdd, 0x0a, 0x6e, 0x74, 0xa2, 0xde, 0x23, 0x05, 0x6a, 0xd3, 0xc1, 0x9e, 0xd7, 0x40, 0x90, 0xfc,
-	0x08, 0x9c, 0xf8, 0x94, 0x98, 0x4e, 0x8d, 0x5f, 0xf8, 0xdc, 0x36, 0x35, 0xb1, 0x90, 0x55, 0xbc,
-	0xdb, 0xa0, 0xe2, 0xdf, 0x06, 0x95, 0x75, 0xff, 0x36, 0x58, 0xbe, 0x20, 0x22, 0x3e, 0xed, 0xa9,
-	0x08, 0x58, 0xe1, 0xb3, 0x57, 0x79, 0x49, 0x3b, 0xce, 0xd7, 0x9c, 0x18, 0x7e, 0x99, 0x76, 0x1b,
-	0xfb, 0x32, 0x42, 0xeb, 0x24, 0x1c, 0x83, 0x55, 0x5f, 0x7f, 0xa7, 0x4d, 0x05, 0x25, 0x74, 0x17,
-	0x4c, 0xf8, 0x4d, 0x27, 0x18, 0xab, 0xe5, 0x33, 0xfb, 0x7b, 0x79, 0xd9, 0x6f, 0x11, 0xc1, 0x21,
-	0x0c, 0xf5, 0x27, 0x14, 0xaa, 0xbd, 0x54, 0xbf, 0xda, 0xab, 0xf9, 0x49, 0x8e, 0x30, 0x35, 0x5b,
-	0x18, 0xcd, 0xf7, 0xaf, 0xa5, 0x8b, 0x51, 0x49, 0xee, 0xb3, 0x43, 0x6d, 0xd2, 0xdd, 0xa8, 0x88,
-	0x75, 0x8f, 0x82, 0x92, 0x70, 0xea, 0x90, 0x0a, 0x4a, 0x07, 0x14, 0x94, 0x96, 0x6e, 0xf0, 0xd2,
-	0xb8, 0xea, 0x97, 0x86, 0x8e, 0x50, 0x91, 0x91, 0x62, 0xc3, 0x0a, 0x8f, 0x65, 0xdf, 0x35, 0xf0,
-	0xdb, 0xb4, 0x3b, 0x2c, 0x92, 0x44, 0x21, 0x28, 0x8e, 0xa1, 0xa3, 0x11, 0xaa, 0xaa, 0xd4, 0xd1,
-	0x55, 0x55, 0xfa, 0x2d, 0xab, 0xea, 0x13, 0x30, 0xe9, 0xe0, 0x27, 0xb5, 0x20, 0xff, 0x33, 0xc7,
-	0x5c, 0x81, 0xef, 0x0c, 0x56, 0x51, 0xa7, 0x3d, 0xb1, 0x5d, 0x12, 0xa0, 0x76, 0xd2, 0xc1, 0x4f,
-	0x02, 0x57, 0x86, 0xdb, 0x7a, 0xcf, 0xb8, 0x3f, 0xd8, 0xd6, 0xe1, 0xcf, 0x69, 0x31, 0x52, 0xf9,
-	0xc5, 0x72, 0x85, 0x38, 0xdb, 0xb8, 0xc5, 0xf8, 0xf0, 0x66, 0xfa, 0x16, 0x0e, 0x4b, 0x92, 0xfa,
-	0x49, 0x4a, 0x92, 0xfc, 0x87, 0xdc, 0x55, 0x74, 0x30, 0x6d, 0x9b, 0x4e, 0x4d, 0xb7, 0x19, 0x9f,
-	0x12, 0x94, 0xc3, 0x70, 0xad, 0x38, 0x51, 0x5e, 0xec, 0xe7, 0xf2, 0xb3, 0x9e, 0xb2, 0x83, 0xec,
-	0x50, 0x9b, 0xb4, 0x4d, 0x67, 0xd9, 0x66, 0xeb, 0xc4, 0xb3, 0xea, 0x1b, 0x29, 0x3c, 0xca, 0x1a,
-	0x9e, 0xcd, 0x6e, 0x18, 0x0e, 0xad, 0x8e, 0xfb, 0x71, 0xa3, 0x4c, 0x48, 0xe0, 0x63, 0xe6, 0xda,
-	0x80, 0x63, 0xa6, 0x33, 0xf5, 0x84, 0xcb, 0x97, 0xae, 0xf2, 0x6a, 0x2a, 0x74, 0x06, 0x8d, 0xfb,
-	0xc8, 0x11, 0x92, 0xbd, 0xab, 0x97, 0x6b, 0xcb, 0x57, 0x92, 0xb8, 0x67, 0x44, 0x84, 0x2b, 0xa8,
-	0x98, 0x3a, 0x98, 0x66, 0x84, 0x71, 0x07, 0xdb, 0xcc, 0xf3, 0x01, 0x12, 0x73, 0x65, 0x50, 0x1f,
-	0x1e, 0x64, 0x87, 0xda, 0x94, 0xbb, 0xb5, 0x6c, 0x33, 0x57, 0x15, 0x5a, 0xf8, 0x7b, 0x02, 0xa4,
-	0xd7, 0xa8, 0x21, 0xb7, 0x80, 0x1c, 0x75, 0x35, 0x56, 0x7a, 0x7f, 0x44, 0x50, 0x22, 0xdf, 0xbd,
-	0xd9, 0xd2, 0xc0, 0xa4, 0x81, 0x7d, 0x3b, 0xe0, 0x74, 0xe4, 0xf3, 0xf8, 0x66, 0x5f, 0x51, 0x1d,
-	0xe2, 0xec, 0xed, 0x04, 0xc4, 0x71, 0x9a, 0x83, 0xc7, 0xe3, 0x20, 0x9a, 0x7d, 0xe2, 0x81, 0x34,
-	0xf7, 0x3c, 0xf3, 0x7e, 0x90, 0xc0, 0xa5, 0xfe, 0x8f, 0xd8, 0xc5, 0x04, 0x46, 0x75, 0x71, 0x66,
-	0xef, 0x0d, 0xcb, 0x19, 0x20, 0xfc, 0x5a, 0x02, 0xe7, 0xe2, 0x1f, 0x9b, 0xf3, 0x31, 0xf2, 0x63,
-	0x39, 0xb2, 0x8b, 0x49, 0x39, 0x02, 0x24, 0xbf, 0x4a, 0xe0, 0x56, 0xa2, 0x97, 0xdc, 0x4a, 0x8c,
-	0xaa, 0x24, 0x42, 0xb2, 0xf7, 0x8f, 0x40, 0x48, 0x60, 0xc2, 0x67, 0x60, 0x36, 0xfa, 0x95, 0x73,
-	0x2b, 0x46, 0x4b, 0x24, 0x75, 0xf6, 0x7f, 0x49, 0xa8, 0x03, 0xe5, 0x7f, 0x48, 0xe0, 0xff, 0xc3,
-	0x3d, 0x3e, 0x56, 0x63, 0xf5, 0x0d, 0x21, 0x2d, 0xbb, 0x7e, 0x94, 0xd2, 0xba, 0xb2, 0x23, 0xd1,
-	0x75, 0x30, 0x2e, 0x3b, 0x92, 0x08, 0x89, 0xcd, 0x8e, 0xa1, 0xae, 0x44, 0x6e, 0x76, 0x44, 0x0d,
-	0xec, 0xf8, 0xec, 0x88, 0xa0, 0x3e, 0x24, 0x3b, 0x0e, 0x99, 0x2e, 0xe5, 0x07, 0x2f, 0x5e, 0xe7,
-	0xa4, 0x97, 0xaf, 0x73, 0xd2, 0x9f, 0xaf, 0x73, 0xd2, 0xb3, 0x37, 0xb9, 0x91, 0x97, 0x6f, 0x72,
-	0x23, 0xbf, 0xbd, 0xc9, 0x8d, 0x7c, 0x7c, 0x27, 0x34, 0xfd, 0x84, 0xe4, 0xa2, 0xa5, 0xd7, 0xa9,
-	0xbf, 0x50, 0xb7, 0x17, 0x16, 0xd4, 0x9d, 0xae, 0x1f, 0x9d, 0xf9, 0x44, 0xac, 0x8f, 0xb9, 0xf7,
-	0xfb, 0xdb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xed, 0x74, 0x02, 0x97, 0x16, 0x00, 0x00,
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x1e, 0xcf, 0xda, 0x21, 0x81, 0x09, 0x09, 0xc9, 0x3e, 0x02, 0xc6, 0x80, 0x6d, 0x86, 0xf0, 0x08,
+	0x3f, 0xec, 0x8d, 0x03, 0x0f, 0xa2, 0xbc, 0xc3, 0x23, 0x8e, 0xf5, 0x2a, 0x97, 0x44, 0x45, 0x4b,
+	0x68, 0xa5, 0x5e, 0xdc, 0xb5, 0x67, 0xb2, 0xd9, 0x66, 0x77, 0xc7, 0x78, 0xc6, 0x21, 0x51, 0x4f,
+	0x6d, 0xa5, 0x56, 0xe2, 0x84, 0x7a, 0x69, 0x2f, 0x55, 0x0f, 0x3d, 0xb5, 0xaa, 0x2a, 0xfe, 0x84,
+	0x1e, 0x39, 0x72, 0xac, 0x5a, 0x29, 0x54, 0x70, 0xe8, 0xb9, 0xf9, 0x0b, 0xaa, 0xd9, 0x9d, 0x1d,
+	0xaf, 0x9d, 0x75, 0x9c, 0x0d, 0xbe, 0xf4, 0x02, 0xd9, 0x99, 0xef, 0x8f, 0xcf, 0xf7, 0xf3, 0xfd,
+	0x31, 0x33, 0x06, 0xe7, 0x09, 0x75, 0x08, 0xb5, 0xa8, 0x46, 0x5b, 0x0d, 0xdc, 0x5c, 0xb7, 0x5b,
+	0x16, 0xd2, 0xd8, 0x76, 0xa1, 0xd1, 0x24, 0x8c, 0xa8, 0xaa, 0xd8, 0x2c, 0xb4, 0x37, 0xd3, 0xa7,
+	0x4d, 0x62, 0x12, 0x6f, 0x5b, 0xe3, 0x7f, 0xf9, 0x92, 0xe9, 0x29, 0xc3, 0xb1, 0x5c, 0xa2, 0x79,
+	0xff, 0x8a, 0xa5, 0x8c, 0x49, 0x88, 0x69, 0x63, 0xcd, 0xfb, 0xaa, 0xb5, 0xd6, 0x35, 0xd4, 0x6a,
+	0x1a, 0xcc, 0x22, 0x6e, 0xb0, 0x5f, 0xf7, 0xac, 0x6b, 0x35, 0x83, 0x62, 0x6d, 0xab, 0x58, 0xc3,
+	0xcc, 0x28, 0x6a, 0x75, 0x62, 0x05, 0xfb, 0xd9, 0x6e, 0x7d, 0x66, 0x39, 0x98, 0x32, 0xc3, 0x69,
+	0x08, 0x81, 0xcb, 0x11, 0xd0, 0xdb, 0x7f, 0xfa, 0x42, 0xf0, 0x1b, 0x05, 0x4c, 0xaf, 0x52, 0xf3,
+	0xa1, 0x5c, 0x2f, 0x63, 0x1b, 0x9b, 0x06, 0xc3, 0xea, 0x35, 0x30, 0x42, 0xb1, 0x8b, 0x70, 0x33,
+	0xa5, 0xe4, 0x94, 0xd9, 0x13, 0xa5, 0xa9, 0xbd, 0xdd, 0xec, 0xf8, 0x8e, 0xe1, 0xd8, 0x8b, 0xd0,
+	0x5f, 0x87, 0xba, 0x10, 0x50, 0xcf, 0x82, 0x51, 0x9b, 0xd4, 0x37, 0xab, 0x16, 0x4a, 0x25, 0x72,
+	0xca, 0xec, 0xb0, 0x3e, 0xc2, 0x3f, 0x2b, 0x48, 0x3d, 0x07, 0x8e, 0x6f, 0x19, 0x76, 0xd5, 0x40,
+	0xa8, 0x99, 0x4a, 0x72, 0x2b, 0xfa, 0xe8, 0x96, 0x61, 0x2f, 0x21, 0xd4, 0x5c, 0xcc, 0x3d, 0xfd,
+	0xf3, 0xf9, 0xf5, 0x08, 0x76, 0xf3, 0x48, 0x00, 0x80, 0x59, 0x70, 0x31, 0x12, 0x99, 0x8e, 0x69,
+	0x83, 0xb8, 0x14, 0xc3, 0x4f, 0x15, 0x70, 0xb6, 0x43, 0xe2, 0x91, 0x8b, 0x06, 0x88, 0x7e, 0x11,
+	0x72, 0x88, 0x17, 0x23, 0x20, 0xb6, 0xa4, 0x1f, 0x78, 0x09, 0x64, 0x7b, 0x40, 0x90, 0x30, 0x3f,
+	0xdb, 0x0f, 0xb3, 0x46, 0x5c, 0xb4, 0x42, 0xea, 0x9b, 0x03, 0x81, 0x79, 0x99, 0xc3, 0xcc, 0x44,
+	0xc2, 0xe4, 0x7e, 0xf2, 0x5c, 0x2c, 0x02, 0x67, 0x80, 0x41, 0xe2, 0xfc, 0x59, 0x01, 0x33, 0x3d,
+	0x62, 0x59, 0x72, 0x07, 0x0c, 0x5a, 0x2d, 0x81, 0x61, 0x5e, 0xcb, 0x5e, 0x55, 0x8c, 0xcd, 0x9f,
+	0x2b, 0xf8, 0xc5, 0x5e, 0xe0, 0xc5, 0x5e, 0x10, 0xc5, 0x5e, 0x58, 0x26, 0x96, 0x5b, 0xfa, 0xd7,
+	0x8b, 0xdd, 0xec, 0xd0, 0xde, 0x6e, 0x76, 0xcc, 0x77, 0xc0, 0x95, 0xa0, 0xee, 0xe9, 0xc2, 0x77,
+	0xc0, 0xcd, 0xc3, 0xe0, 0x0d, 0x02, 0x0c, 0x83, 0x51, 0xc2, 0x60, 0xe0, 0xf7, 0xdd, 0x19, 0xd2,
+	0xf1, 0x20, 0x0b, 0x49, 0xcd, 0x81, 0x93, 0x2e, 0x7e, 0x52, 0xed, 0x6a, 0x05, 0xe0, 0xe2, 0x27,
+	0xef, 0x8b, 0x6e, 0xe8, 0x59, 0x6a, 0x4d, 0xdc, 0xb3, 0xd4, 0xda, 0x20, 0x65, 0x0a, 0xf7, 0x14,
+	0x70, 0x61, 0x95, 0x9a, 0x3c, 0xea, 0x25, 0x17, 0xbd, 0x5d, 0x53, 0x1b, 0xe0, 0x18, 0x67, 0x99,
+	0xa6, 0x12, 0xb9, 0xe4, 0xc1, 0x29, 0x9a, 0xe3, 0x29, 0xfa, 0xf1, 0x55, 0x76, 0xd6, 0xb4, 0xd8,
+	0x46, 0xab, 0x56, 0xa8, 0x13, 0x47, 0x13, 0xc3, 0xcb, 0xff, 0x2f, 0x4f, 0xd1, 0xa6, 0xc6, 0x76,
+	0x1a, 0x98, 0x7a, 0x0a, 0x54, 0xf7, 0x2d, 0x1f, 0x34, 0x1e, 0xae, 0x71, 0x42, 0x66, 0x02, 0x42,
+	0x38, 0x8f, 0x79, 0xc3, 0x45, 0xf9, 0xa8, 0x39, 0x71, 0x07, 0xcc, 0x1c, 0x14, 0xb3, 0x4c, 0xff,
+	0x04, 0x48, 0x54, 0xca, 0x22, 0xf3, 0x89, 0x4a, 0x19, 0x3e, 0x4f, 0x00, 0x6d, 0x95, 0x9a, 0xcb,
+	0x4d, 0x6c, 0x30, 0xfc, 0xff, 0x96, 0x6d, 0xeb, 0x86, 0x6b, 0xe2, 0x07, 0x84, 0x5a, 0x7c, 0x0a,
+	0xff, 0xb3, 0xf9, 0x53, 0x6f, 0x80, 0xd1, 0x06, 0x21, 0x36, 0xaf, 0xc5, 0x61, 0x1e, 0x71, 0x49,
+	0xdd, 0xdb, 0xcd, 0x4e, 0xf8, 0x48, 0xc5, 0x06, 0xd4, 0x47, 0xf8, 0x5f, 0x15, 0xb4, 0x78, 0x95,
+	0x93, 0x0d, 0x03, 0xb2, 0xd7, 0x5b, 0xb6, 0x9d, 0x6f, 0x72, 0x2e, 0x7c, 0xca, 0xd7, 0xdb, 0x54,
+	0x3f, 0x06, 0x77, 0x63, 0x32, 0x26, 0xd9, 0x3f, 0x03, 0xfc, 0x6e, 0x28, 0x77, 0xf4, 0x5e, 0x59,
+	0xcd, 0x00, 0xd0, 0x10, 0x06, 0x2a, 0x65, 0xd1, 0x37, 0xa1, 0x15, 0x7e, 0x40, 0xa5, 0x56, 0xa9,
+	0xf9, 0xc8, 0x7d, 0x40, 0x88, 0xfd, 0xc1, 0x86, 0xc5, 0xb0, 0x6d, 0x51, 0x86, 0x11, 0xff, 0x8c,
+	0x93, 0x8e, 0x10, 0x21, 0x89, 0xbe, 0x84, 0xcc, 0x70, 0x42, 0xb2, 0x01, 0x21, 0x2d, 0x97, 0x2f,
+	0xe7, 0x9f, 0xb4, 0x9d, 0xe7, 0xf9, 0x02, 0x7c, 0x17, 0xe4, 0x7a, 0x21, 0x93, 0x61, 0xff, 0x1b,
+	0x9c, 0xc2, 0xdb, 0x16, 0xc3, 0xa8, 0x2a, 0x46, 0x03, 0x4d, 0x29, 0xb9, 0xe4, 0xec, 0xb0, 0x3e,
+	0xee, 0x2f, 0xaf, 0x78, 0x13, 0x82, 0xc2, 0x1f, 0x92, 0x60, 0xc1, 0x33, 0x66, 0xfb, 0x75, 0xbc,
+	0x6a, 0x99, 0x4d, 0x83, 0xe1, 0x87, 0x1b, 0x46, 0x13, 0xd3, 0x35, 0x22, 0xc9, 0x5e, 0x26, 0x6e,
+	0x1d, 0xbb, 0x8c, 0xef, 0xa1, 0x80, 0xf8, 0x98, 0x34, 0x84, 0x67, 0x54, 0x32, 0x4c, 0x83, 0xd8,
+	0x80, 0x72, 0x6e, 0x99, 0x60, 0x8a, 0x7a, 0x00, 0xaa, 0x8c, 0x54, 0x1d, 0x1f, 0x51, 0xff, 0x89,
+	0x9d, 0x13, 0x13, 0x3b, 0x25, 0x10, 0x74, 0x5b, 0x80, 0xfa, 0x29, 0x2a, 0xc2, 0x12, 0x51, 0xaa,
+	0x4f, 0x15, 0x30, 0xc1, 0xc8, 0x26, 0x76, 0xab, 0xa4, 0xc5, 0xaa, 0x0e, 0xef, 0x9a, 0xe1, 0x7e,
+	0x5d, 0x53, 0x11, 0x6e, 0xa6, 0x7d, 0x37, 0x9d, 0xea, 0x30, 0x56, 0x3b, 0x9d, 0xf4, 0x94, 0xdf,
+	0x6b, 0xb1, 0x55, 0xcb, 0xa5, 0x8b, 0x59, 0x9e, 0xfc, 0x74, 0x3b, 0xf9, 0x72, 0xf8, 0x04, 0xf8,
+	0xbf, 0x4d, 0x82, 0x7b, 0x47, 0xcd, 0x95, 0x2c, 0x8c, 0x0a, 0x18, 0x35, 0x1c, 0xd2, 0x72, 0xd9,
+	0x9c, 0x48, 0x9a, 0xc6, 0xe3, 0xf9, 0x6d, 0x37, 0x3b, 0xed, 0x83, 0xa4, 0x68, 0xb3, 0x60, 0x11,
+	0xcd, 0x31, 0xd8, 0x46, 0xa1, 0xe2, 0xb2, 0x76, 0x96, 0x84, 0x16, 0xd4, 0x03, 0xfd, 0xb6, 0xa9,
+	0x62, 0x2a, 0x71, 0x04, 0x53, 0x45, 0x69, 0xaa, 0xa8, 0xda, 0x60, 0xca, 0xb6, 0x1e, 0xb7, 0x2c,
+	0x64, 0xb1, 0x9d, 0x6a, 0xdd, 0xeb, 0x73, 0xe4, 0x8f, 0x96, 0xd2, 0xff, 0x84, 0xd1, 0xf3, 0xfb,
+	0x8d, 0xae, 0x60, 0xd3, 0xa8, 0xef, 0x94, 0x71, 0xbd, 0x9d, 0xf5, 0x7d, 0x56, 0xa0, 0x3e, 0x29,
+	0xd7, 0xfc, 0x01, 0x82, 0xd4, 0x47, 0xe0, 0xc4, 0xc7, 0xc4, 0x72, 0xab, 0xfc, 0xe6, 0xea, 0x8d,
+	0xa9, 0xb1, 0xf9, 0x74, 0xc1, 0xbf, 0xd6, 0x16, 0x82, 0x6b, 0x6d, 0x61, 0x2d, 0xb8, 0xd6, 0x96,
+	0x2e, 0x88, 0x8c, 0x4f, 0xfa, 0x2e, 0xa4, 0x2a, 0x7c, 0xf6, 0x2a, 0xab, 0xe8, 0xc7, 0xf9, 0x37,
+	0x17, 0x86, 0x9f, 0x27, 0xbd, 0xc1, 0xbe, 0x84, 0xd0, 0x1a, 0x09, 0xe7, 0x60, 0x25, 0xf0, 0xdf,
+	0x1e, 0x53, 0xb2, 0x85, 0xee, 0x82, 0xb1, 0x60, 0xe8, 0xc8, 0xfb, 0x41, 0xe9, 0xcc, 0xde, 0x6e,
+	0x56, 0x0d, 0x46, 0x84, 0xdc, 0x84, 0xa1, 0xf9, 0x84, 0x42, 0xbd, 0x97, 0xe8, 0xd7, 0x7b, 0xd5,
+	0xa0, 0xc8, 0x11, 0xa6, 0x56, 0x13, 0xa3, 0xb9, 0xfe, 0xbd, 0x74, 0x31, 0xaa, 0xc8, 0x03, 0x75,
+	0xa8, 0x8f, 0x7b, 0x0b, 0x65, 0xf1, 0xbd, 0xcf, 0x41, 0x31, 0x35, 0xfc, 0x36, 0x0e, 0x8a, 0x5d,
+	0x0e, 0x8a, 0x8b, 0xd7, 0x79, 0x6b, 0x5c, 0x09, 0x5a, 0xc3, 0x40, 0x28, 0xcf, 0x48, 0xbe, 0x6e,
+	0x87, 0x8f, 0xe5, 0x80, 0x1a, 0xf8, 0x75, 0x12, 0xdc, 0x8d, 0x99, 0x05, 0xd9, 0x1c, 0x47, 0xce,
+	0x46, 0xa8, 0xab, 0x12, 0x83, 0xeb, 0xaa, 0xe4, 0x5b, 0x76, 0xd5, 0x47, 0x60, 0x9c, 0xdf, 0xff,
+	0x64, 0xfd, 0xa7, 0x8e, 0x79, 0x06, 0xff, 0x7b, 0xb8, 0x8e, 0x3a, 0xed, 0x9b, 0xed, 0xb0, 0x00,
+	0x75, 0x7e, 0xa3, 0x94, 0x54, 0x86, 0xc7, 0xfa, 0xbe, 0xe3, 0xbe, 0x7b, 0xac, 0xc3, 0x9f, 0x92,
+	0xe2, 0x48, 0xe5, 0x37, 0xe4, 0x65, 0xe2, 0x6e, 0xe1, 0x26, 0xe3, 0x87, 0x37, 0x33, 0x36, 0x71,
+	0xd8, 0x92, 0xd2, 0xcf, 0x52, 0x9c, 0xe2, 0x3f, 0xe0, 0xae, 0x62, 0x80, 0x49, 0xc7, 0x72, 0xab,
+	0x86, 0xc3, 0xf8, 0x29, 0x41, 0x39, 0x0c, 0x2f, 0x8a, 0x13, 0xa5, 0x85, 0x7e, 0x94, 0x9f, 0xf5,
+	0x9d, 0x75, 0xab, 0x43, 0x7d, 0xdc, 0xb1, 0xdc, 0x25, 0x87, 0xad, 0x11, 0x3f, 0xaa, 0xaf, 0x94,
+	0xf0, 0x51, 0x56, 0xf7, 0x63, 0x4e, 0x1d, 0xeb, 0xd7, 0x1d, 0xf7, 0x7b, 0x1d, 0x65, 0xc2, 0x02,
+	0x3f, 0x66, 0xae, 0x1e, 0xf2, 0x98, 0x69, 0x9f, 0x7a, 0x82, 0xf2, 0xc5, 0x2b, 0xbc, 0x9b, 0x72,
+	0xed, 0x83, 0xc6, 0x7b, 0xad, 0x09, 0xcb, 0xfe, 0xd5, 0xcb, 0x8b, 0xe5, 0x0b, 0x45, 0xdc, 0x33,
+	0x22, 0xd2, 0x25, 0x3b, 0xa6, 0x06, 0x26, 0x19, 0x61, 0x9c, 0x60, 0x87, 0xf9, 0x1c, 0xa0, 0x94,
+	0x12, 0x8b, 0xc3, 0x6e, 0x75, 0xa8, 0x4f, 0x78, 0x4b, 0x4b, 0x0e, 0xf3, 0x5c, 0xa1, 0xf9, 0xbf,
+	0x4e, 0x82, 0xe4, 0x2a, 0x35, 0xd5, 0x26, 0x50, 0xa3, 0xae, 0xc6, 0x85, 0xfd, 0xbf, 0x86, 0x14,
+	0x22, 0x1f, 0xf0, 0xe9, 0xe2, 0xa1, 0x45, 0x65, 0x7c, 0xdb, 0xe0, 0x74, 0xe4, 0x3b, 0xff, 0x46,
+	0x5f, 0x53, 0x6d, 0xe1, 0xf4, 0xad, 0x18, 0xc2, 0xd1, 0x9e, 0x75, 0x1c, 0xc3, 0xb3, 0x8e, 0x63,
+	0x78, 0xd6, 0xf1, 0xc1, 0x9e, 0x43, 0xef, 0xef, 0xc3, 0xc4, 0x1c, 0x08, 0xa7, 0x6f, 0xc5, 0x10,
+	0x96, 0x9e, 0xbf, 0x53, 0xc0, 0xa5, 0xfe, 0xbf, 0x03, 0x2c, 0xc4, 0xa0, 0xb3, 0x43, 0x33, 0x7d,
+	0xef, 0xa8, 0x9a, 0x12, 0xe1, 0x97, 0x0a, 0x38, 0xd7, 0xfb, 0x99, 0x3b, 0xd7, 0xc3, 0x7e, 0x4f,
+	0x8d, 0xf4, 0x42, 0x5c, 0x0d, 0x89, 0xe4, 0x17, 0x05, 0xdc, 0x8c, 0xf5, 0x86, 0x5c, 0xee, 0xe1,
+	0x2a, 0x8e, 0x91, 0xf4, 0xfd, 0x01, 0x18, 0x91, 0x21, 0x7c, 0x02, 0xa6, 0xa3, 0xdf, 0x57, 0x37,
+	0x7b, 0x78, 0x89, 0x94, 0x4e, 0xdf, 0x8e, 0x23, 0x2d, 0x9d, 0xff, 0xae, 0x80, 0xff, 0x1c, 0xed,
+	0xd9, 0xb3, 0xd2, 0xd3, 0xdf, 0x11, 0xac, 0xa5, 0xd7, 0x06, 0x69, 0xad, 0xa3, 0x3a, 0x62, 0x5d,
+	0x44, 0x7b, 0x55, 0x47, 0x1c, 0x23, 0xe9, 0xfb, 0x03, 0x30, 0xd2, 0x59, 0x1d, 0x51, 0x57, 0x85,
+	0xde, 0xd5, 0x11, 0x21, 0x9d, 0xbe, 0x1d, 0x47, 0x3a, 0x70, 0x5e, 0x7a, 0xf0, 0xe2, 0x75, 0x46,
+	0x79, 0xf9, 0x3a, 0xa3, 0xfc, 0xf1, 0x3a, 0xa3, 0x3c, 0x7b, 0x93, 0x19, 0x7a, 0xf9, 0x26, 0x33,
+	0xf4, 0xeb, 0x9b, 0xcc, 0xd0, 0x87, 0x77, 0x42, 0xe7, 0xae, 0xb0, 0x9c, 0xb7, 0x8d, 0x1a, 0x0d,
+	0x3e, 0xb4, 0xad, 0xf9, 0x79, 0x6d, 0xbb, 0xe3, 0x77, 0x7b, 0x7e, 0x16, 0xd7, 0x46, 0xbc, 0x97,
+	0xc5, 0xad, 0xbf, 0x07, 0x00, 0x6f, 0x19, 0x7e, 0x04, 0xda, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1279,6 +1384,8 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
@@ -1321,6 +1428,15 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -1399,6 +1515,8 @@ type MsgServer interface {
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
@@ -1425,6 +1543,9 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
@@ -1490,6 +1611,24 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -1646,6 +1785,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -1937,6 +2080,71 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2634,6 +2842,35 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3553,6 +3790,189 @@ func (m *MsgSuperfluidUndelegateAndUnbondLockResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,321 @@
+package twap
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+// GetAggregatedArithmeticTwap returns the arithmetic twap of the base asset in units of the quote asset
+// from (startTime, endTime), aggregated across every pool listing both assets and every two hop path
+// through the reference denoms of the options.
+//
+// The twap of each pool or path is weighted by its liquidity valued in the quote asset at the median twap
+// of the pools, see getPoolTwapSources. Pools and paths with less than the minimum liquidity, and those
+// deviating from the liquidity weighted median twap by more than the maximum deviation, are left out.
+// Pools without a twap over the whole time range, e.g. created after startTime, or with a spot price error
+// within it, are left out as well.
+//
+// Every pool and path considered is returned along with the aggregated twap, with the left out ones marked as rejected.
+//
+// This function will error if:
+// * the options are invalid
+// * the base and quote assets are the same
+// * startTime > endTime
+// * endTime in the future
+// * no pool or path is left to aggregate
+func (k Keeper) GetAggregatedArithmeticTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	options types.TwapAggregationOptions,
+) (osmomath.Dec, []types.TwapSource, error) {
+	return k.getAggregatedTwap(ctx, baseAssetDenom, quoteAssetDenom, startTime, endTime, options, k.GetArithmeticStrategy())
+}
+
+// GetAggregatedGeometricTwap returns the geometric twap of the base asset in units of the quote asset
+// from (startTime, endTime), aggregated the same way as GetAggregatedArithmeticTwap.
+func (k Keeper) GetAggregatedGeometricTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	options types.TwapAggregationOptions,
+) (osmomath.Dec, []types.TwapSource, error) {
+	return k.getAggregatedTwap(ctx, baseAssetDenom, quoteAssetDenom, startTime, endTime, options, k.GetGeometricStrategy())
+}
+
+// getAggregatedTwap computes the twap of every pool listing the base and quote assets, and of every two hop
+// path through the reference denoms, and aggregates them. The type of twap aggregated depends on the strategy
+// given and can be either arithmetic or geometric.
+//
+// The path through a reference denom goes through the most liquid pool of each hop.
+func (k Keeper) getAggregatedTwap(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	options types.TwapAggregationOptions,
+	strategy twapStrategy,
+) (osmomath.Dec, []types.TwapSource, error) {
+	if err := options.Validate(); err != nil {
+		return osmomath.Dec{}, nil, err
+	}
+	if _, _, err := types.LexicographicalOrderDenoms(baseAssetDenom, quoteAssetDenom); err != nil {
+		return osmomath.Dec{}, nil, err
+	}
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, nil, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, nil, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+
+	sources, _, err := k.getPoolTwapSources(ctx, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+	if err != nil {
+		return osmomath.Dec{}, nil, err
+	}
+
+	for _, referenceDenom := range options.ReferenceDenoms {
+		if referenceDenom == baseAssetDenom || referenceDenom == quoteAssetDenom {
+			continue
+		}
+		firstHopSources, _, err := k.getPoolTwapSources(ctx, baseAssetDenom, referenceDenom, startTime, endTime, strategy)
+		if err != nil {
+			return osmomath.Dec{}, nil, err
+		}
+		secondHopSources, secondHopReferencePrice, err := k.getPoolTwapSources(ctx, referenceDenom, quoteAssetDenom, startTime, endTime, strategy)
+		if err != nil {
+			return osmomath.Dec{}, nil, err
+		}
+		if len(firstHopSources) == 0 || len(secondHopSources) == 0 {
+			continue
+		}
+
+		firstHop, secondHop := mostLiquidTwapSource(firstHopSources), mostLiquidTwapSource(secondHopSources)
+		sources = append(sources, types.TwapSource{
+			PoolIds: []uint64{firstHop.PoolIds[0], secondHop.PoolIds[0]},
+			Twap:    firstHop.Twap.Mul(secondHop.Twap),
+			// The liquidity of the first hop is valued in the reference denom, at the reference price of the second hop.
+			Liquidity: osmomath.MinDec(firstHop.Liquidity.Mul(secondHopReferencePrice), secondHop.Liquidity),
+		})
+	}
+
+	twap, found := aggregateTwapSources(sources, options)
+	if !found {
+		return osmomath.Dec{}, sources, types.NoTwapSourcesError{BaseAssetDenom: baseAssetDenom, QuoteAssetDenom: quoteAssetDenom}
+	}
+	return twap, sources, nil
+}
+
+// getPoolTwapSources returns a twap source for every pool with twap records for the base and quote assets,
+// with its twap over the time range and the liquidity of the two assets valued in the quote asset, along with
+// the reference price the liquidity was valued at.
+// Pools whose twap can't be computed over the time range are skipped.
+//
+// The liquidity of every pool is valued at the same reference price, the median twap of the pools, so that a
+// pool whose twap was pushed away from the others can't raise its own weight. The liquidity of a pool is the
+// lowest of its current liquidity and of its liquidity at the end of the last block it changed in, so that it
+// can't be raised by adding liquidity within the block.
+func (k Keeper) getPoolTwapSources(
+	ctx sdk.Context,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	strategy twapStrategy,
+) ([]types.TwapSource, osmomath.Dec, error) {
+	denom0, denom1, err := types.LexicographicalOrderDenoms(baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return nil, osmomath.Dec{}, err
+	}
+	poolIds, err := types.GetPoolIdsWithMostRecentTwapsForDenomPair(ctx.KVStore(k.storeKey), denom0, denom1)
+	if err != nil {
+		return nil, osmomath.Dec{}, err
+	}
+
+	sources := []types.TwapSource{}
+	liquidities := []sdk.Coins{}
+	for _, poolId := range poolIds {
+		twap, err := k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, strategy)
+		if err != nil {
+			continue
+		}
+		liquidity, err := k.getPoolLiquidity(ctx, poolId)
+		if err != nil {
+			return nil, osmomath.Dec{}, err
+		}
+
+		sources = append(sources, types.TwapSource{PoolIds: []uint64{poolId}, Twap: twap})
+		liquidities = append(liquidities, liquidity)
+	}
+	if len(sources) == 0 {
+		return sources, osmomath.ZeroDec(), nil
+	}
+
+	referencePrice := medianTwap(sources)
+	for i := range sources {
+		sources[i].Liquidity = liquidities[i].AmountOf(baseAssetDenom).ToLegacyDec().Mul(referencePrice).Add(liquidities[i].AmountOf(quoteAssetDenom).ToLegacyDec())
+	}
+	return sources, referencePrice, nil
+}
+
+// getPoolLiquidity returns the lowest amount of each denom between the current liquidity of the pool
+// and its liquidity stored at the end of the last block it changed in.
+// The liquidity of a pool without stored liquidity is empty.
+func (k Keeper) getPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	currentLiquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	storedLiquidity := sdk.NewCoins(osmoutils.GetCoinArrayFromPrefix(ctx, k.storeKey, types.FormatPoolLiquidityPrefix(poolId))...)
+	return currentLiquidity.Min(storedLiquidity), nil
+}
+
+// storePoolLiquidity stores the current liquidity of the pool, replacing the previously stored one.
+func (k Keeper) storePoolLiquidity(ctx sdk.Context, poolId uint64) error {
+	liquidity, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	liquidityPrefix := types.FormatPoolLiquidityPrefix(poolId)
+	osmoutils.DeleteAllKeysFromPrefix(store, liquidityPrefix)
+	prefixStore := prefix.NewStore(store, liquidityPrefix)
+	for _, coin := range liquidity {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+		prefixStore.Set([]byte(coin.Denom), bz)
+	}
+	return nil
+}
+
+// StoreAllPoolLiquidities stores the current liquidity of every pool with twap records, to be used by
+// the aggregated twaps until the pool changes.
+func (k Keeper) StoreAllPoolLiquidities(ctx sdk.Context) error {
+	records, err := types.GetAllMostRecentTwaps(ctx.KVStore(k.storeKey))
+	if err != nil {
+		return err
+	}
+	stored := map[uint64]bool{}
+	for _, record := range records {
+		if stored[record.PoolId] {
+			continue
+		}
+		if err := k.storePoolLiquidity(ctx, record.PoolId); err != nil {
+			return err
+		}
+		stored[record.PoolId] = true
+	}
+	return nil
+}
+
+// medianTwap returns the median twap of the sources, the mean of the two middle ones for an even number of sources.
+// Contract: sources is not empty.
+func medianTwap(sources []types.TwapSource) osmomath.Dec {
+	twaps := make([]osmomath.Dec, len(sources))
+	for i, source := range sources {
+		twaps[i] = source.Twap
+	}
+	sort.SliceStable(twaps, func(i, j int) bool {
+		return twaps[i].LT(twaps[j])
+	})
+
+	middle := len(twaps) / 2
+	if len(twaps)%2 == 1 {
+		return twaps[middle]
+	}
+	return twaps[middle-1].Add(twaps[middle]).QuoInt64(2)
+}
+
+// mostLiquidTwapSource returns the source with the most liquidity, the first one on ties.
+// Contract: sources is not empty.
+func mostLiquidTwapSource(sources []types.TwapSource) types.TwapSource {
+	mostLiquid := sources[0]
+	for _, source := range sources[1:] {
+		if source.Liquidity.GT(mostLiquid.Liquidity) {
+			mostLiquid = source
+		}
+	}
+	return mostLiquid
+}
+
+// aggregateTwapSources returns the liquidity weighted mean twap of the sources, marking as rejected those
+// with less than the minimum liquidity, and then those deviating from the liquidity weighted median twap
+// of the others by more than the maximum deviation.
+// Returns false if no source has a positive twap and enough liquidity.
+func aggregateTwapSources(sources []types.TwapSource, options types.TwapAggregationOptions) (osmomath.Dec, bool) {
+	minLiquidity := osmomath.ZeroDec()
+	if !options.MinLiquidity.IsNil() {
+		minLiquidity = options.MinLiquidity.ToLegacyDec()
+	}
+
+	eligible := []int{}
+	for i, source := range sources {
+		if !source.Twap.IsPositive() || !source.Liquidity.IsPositive() || source.Liquidity.LT(minLiquidity) {
+			sources[i].Rejected = true
+			continue
+		}
+		eligible = append(eligible, i)
+	}
+	if len(eligible) == 0 {
+		return osmomath.Dec{}, false
+	}
+
+	if !options.MaxDeviation.IsNil() && options.MaxDeviation.IsPositive() {
+		median := weightedMedianTwap(sources, eligible)
+		withinDeviation := []int{}
+		for _, i := range eligible {
+			if sources[i].Twap.Sub(median).Abs().Quo(median).GT(options.MaxDeviation) {
+				sources[i].Rejected = true
+				continue
+			}
+			withinDeviation = append(withinDeviation, i)
+		}
+		// The median is always within the deviation, so some sources are left.
+		eligible = withinDeviation
+	}
+
+	weightedTwapSum, liquiditySum := osmomath.ZeroDec(), osmomath.ZeroDec()
+	for _, i := range eligible {
+		weightedTwapSum = weightedTwapSum.Add(sources[i].Twap.Mul(sources[i].Liquidity))
+		liquiditySum = liquiditySum.Add(sources[i].Liquidity)
+	}
+	return weightedTwapSum.Quo(liquiditySum), true
+}
+
+// weightedMedianTwap returns the twap of the eligible sources at which half of their liquidity is reached,
+// going through them from the lowest twap.
+func weightedMedianTwap(sources []types.TwapSource, eligible []int) osmomath.Dec {
+	sorted := make([]int, len(eligible))
+	copy(sorted, eligible)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sources[sorted[i]].Twap.LT(sources[sorted[j]].Twap)
+	})
+
+	liquiditySum := osmomath.ZeroDec()
+	for _, i := range sorted {
+		liquiditySum = liquiditySum.Add(sources[i].Liquidity)
+	}
+	cumulativeLiquidity := osmomath.ZeroDec()
+	for _, i := range sorted {
+		cumulativeLiquidity = cumulativeLiquidity.Add(sources[i].Liquidity)
+		if cumulativeLiquidity.MulInt64(2).GTE(liquiditySum) {
+			return sources[i].Twap
+		}
+	}
+	return sources[sorted[len(sorted)-1]].Twap
+}
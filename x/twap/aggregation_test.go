@@ -0,0 +1,198 @@
+package twap_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	gammtypes "github.com/osmosis-labs/osmosis/v22/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+func (s *TestSuite) TestGetAggregatedTwap() {
+	var (
+		startTime = baseTime
+		endTime   = baseTime.Add(time.Hour)
+
+		// Pools 1 and 2 price denom0 at 1 denom1, with 2e9 and 4e9 of liquidity valued in denom1.
+		// Pool 3 prices it at 2 denom1, with 3e6 of liquidity valued at the median twap of 1 denom1.
+		// Pools 4 and 5 price it at 1 denom1 through denom2, with 2e9 of liquidity each.
+		poolCoins = []sdk.Coins{
+			defaultTwoAssetCoins,
+			sdk.NewCoins(sdk.NewInt64Coin(denom0, 2_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000), sdk.NewInt64Coin(denom1, 2_000_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom2, 1_000_000_000)),
+			sdk.NewCoins(sdk.NewInt64Coin(denom2, 1_000_000_000), sdk.NewInt64Coin(denom1, 1_000_000_000)),
+		}
+
+		directSources = []types.TwapSource{
+			{PoolIds: []uint64{1}, Twap: osmomath.OneDec(), Liquidity: osmomath.NewDec(2_000_000_000)},
+			{PoolIds: []uint64{2}, Twap: osmomath.OneDec(), Liquidity: osmomath.NewDec(4_000_000_000)},
+			{PoolIds: []uint64{3}, Twap: osmomath.NewDec(2), Liquidity: osmomath.NewDec(3_000_000)},
+		}
+		pathSource = types.TwapSource{PoolIds: []uint64{4, 5}, Twap: osmomath.OneDec(), Liquidity: osmomath.NewDec(2_000_000_000)}
+	)
+
+	withRejected := func(sources []types.TwapSource, rejectedIndex int) []types.TwapSource {
+		result := make([]types.TwapSource, len(sources))
+		copy(result, sources)
+		result[rejectedIndex].Rejected = true
+		return result
+	}
+
+	tests := map[string]struct {
+		baseAssetDenom  string
+		quoteAssetDenom string
+		startTime       time.Time
+		endTime         time.Time
+		geometric       bool
+		options         types.TwapAggregationOptions
+
+		expTwap    osmomath.Dec
+		expSources []types.TwapSource
+		expectErr  bool
+		// checked when set, in addition to expectErr
+		expectError error
+	}{
+		"no options: all the pools listing the pair are aggregated": {
+			options: types.TwapAggregationOptions{},
+			// (1 * 2e9 + 1 * 4e9 + 2 * 3e6) / (2e9 + 4e9 + 3e6)
+			expTwap:    osmomath.NewDec(6_006_000_000).Quo(osmomath.NewDec(6_003_000_000)),
+			expSources: directSources,
+		},
+		"reference denom and max deviation: the outlier is rejected": {
+			options: types.TwapAggregationOptions{
+				ReferenceDenoms: []string{denom2},
+				MaxDeviation:    osmomath.MustNewDecFromStr("0.1"),
+			},
+			expTwap:    osmomath.OneDec(),
+			expSources: withRejected(append(directSources, pathSource), 2),
+		},
+		"min liquidity: the shallow pool is rejected": {
+			options:    types.TwapAggregationOptions{MinLiquidity: osmomath.NewInt(10_000_000)},
+			expTwap:    osmomath.OneDec(),
+			expSources: withRejected(directSources, 2),
+		},
+		"reference denom that is the quote asset is ignored": {
+			options:    types.TwapAggregationOptions{ReferenceDenoms: []string{denom1}},
+			expTwap:    osmomath.NewDec(6_006_000_000).Quo(osmomath.NewDec(6_003_000_000)),
+			expSources: directSources,
+		},
+		"geometric: the outlier is rejected": {
+			geometric: true,
+			options: types.TwapAggregationOptions{
+				ReferenceDenoms: []string{denom2},
+				MaxDeviation:    osmomath.MustNewDecFromStr("0.1"),
+			},
+			expTwap: osmomath.OneDec(),
+		},
+		"inverse pair": {
+			baseAssetDenom:  denom1,
+			quoteAssetDenom: denom0,
+			options:         types.TwapAggregationOptions{MaxDeviation: osmomath.MustNewDecFromStr("0.1")},
+			expTwap:         osmomath.OneDec(),
+		},
+		"error: min liquidity above all the pools": {
+			options:     types.TwapAggregationOptions{MinLiquidity: osmomath.NewInt(5_000_000_000)},
+			expectErr:   true,
+			expectError: types.NoTwapSourcesError{BaseAssetDenom: denom0, QuoteAssetDenom: denom1},
+		},
+		"error: start time before the pools were created": {
+			startTime:   baseTime.Add(-time.Hour),
+			expectErr:   true,
+			expectError: types.NoTwapSourcesError{BaseAssetDenom: denom0, QuoteAssetDenom: denom1},
+		},
+		"error: same base and quote asset": {
+			quoteAssetDenom: denom0,
+			expectErr:       true,
+		},
+		"error: end time in the future": {
+			endTime:     endTime.Add(time.Second),
+			expectErr:   true,
+			expectError: types.EndTimeInFutureError{EndTime: endTime.Add(time.Second), BlockTime: endTime},
+		},
+		"error: negative max deviation": {
+			options:   types.TwapAggregationOptions{MaxDeviation: osmomath.MustNewDecFromStr("-0.1")},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			for _, coins := range poolCoins {
+				s.PrepareBalancerPoolWithCoins(coins...)
+			}
+			s.twapkeeper.EndBlock(s.Ctx)
+			s.Ctx = s.Ctx.WithBlockTime(endTime)
+
+			if tc.baseAssetDenom == "" {
+				tc.baseAssetDenom = denom0
+			}
+			if tc.quoteAssetDenom == "" {
+				tc.quoteAssetDenom = denom1
+			}
+			if tc.startTime.IsZero() {
+				tc.startTime = startTime
+			}
+			if tc.endTime.IsZero() {
+				tc.endTime = endTime
+			}
+
+			getAggregatedTwap := s.twapkeeper.GetAggregatedArithmeticTwap
+			if tc.geometric {
+				getAggregatedTwap = s.twapkeeper.GetAggregatedGeometricTwap
+			}
+			twap, sources, err := getAggregatedTwap(s.Ctx, tc.baseAssetDenom, tc.quoteAssetDenom, tc.startTime, tc.endTime, tc.options)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				if tc.expectError != nil {
+					s.Require().ErrorIs(err, tc.expectError)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), tc.expTwap, twap, osmomath.NewDecWithPrec(1, 12))
+			if tc.expSources != nil {
+				s.Require().Equal(tc.expSources, sources)
+			}
+		})
+	}
+}
+
+// TestGetAggregatedTwapManipulatedPool tests that a pool whose twap was pushed up is rejected as an outlier,
+// even if its liquidity valued at its own twap would outweigh the other pools, and even after liquidity is
+// added to it within the block of the query.
+func (s *TestSuite) TestGetAggregatedTwapManipulatedPool() {
+	startTime := baseTime
+	endTime := baseTime.Add(time.Hour)
+	options := types.TwapAggregationOptions{MaxDeviation: osmomath.MustNewDecFromStr("0.1")}
+
+	s.SetupTest()
+	// Pools 1 and 2 price denom0 at 1 denom1, with 2e9 and 4e9 of liquidity valued in denom1.
+	s.PrepareBalancerPoolWithCoins(defaultTwoAssetCoins...)
+	s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 2_000_000_000), sdk.NewInt64Coin(denom1, 2_000_000_000))
+	// Pool 3 prices denom0 at 40 denom1. Valued at its own twap, its 8e9 of liquidity would make it the
+	// weighted median, while valued at the median twap of 1 denom1, it only has 4.1e9 of liquidity.
+	manipulatedPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(denom0, 100_000_000), sdk.NewInt64Coin(denom1, 4_000_000_000))
+	s.twapkeeper.EndBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockTime(endTime)
+
+	// Multiply the liquidity of pool 3 by 10 within the block of the query.
+	joinCoins := sdk.NewCoins(sdk.NewInt64Coin(denom0, 1_000_000_000), sdk.NewInt64Coin(denom1, 40_000_000_000))
+	s.FundAcc(s.TestAccs[0], joinCoins)
+	_, _, err := s.App.GAMMKeeper.JoinPoolNoSwap(s.Ctx, s.TestAccs[0], manipulatedPoolId, gammtypes.InitPoolSharesSupply.MulRaw(10), joinCoins)
+	s.Require().NoError(err)
+
+	twap, sources, err := s.twapkeeper.GetAggregatedArithmeticTwap(s.Ctx, denom0, denom1, startTime, endTime, options)
+	s.Require().NoError(err)
+	osmoassert.DecApproxEq(s.T(), osmomath.OneDec(), twap, osmomath.NewDecWithPrec(1, 12))
+	s.Require().Equal([]types.TwapSource{
+		{PoolIds: []uint64{1}, Twap: osmomath.OneDec(), Liquidity: osmomath.NewDec(2_000_000_000)},
+		{PoolIds: []uint64{2}, Twap: osmomath.OneDec(), Liquidity: osmomath.NewDec(4_000_000_000)},
+		{PoolIds: []uint64{manipulatedPoolId}, Twap: osmomath.NewDec(40), Liquidity: osmomath.NewDec(4_100_000_000), Rejected: true},
+	}, sources)
+}
@@ -79,8 +79,80 @@ func (k Keeper) GetGeometricTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetGeometricStrategy())
 }
 
+// GetHarmonicTwap returns a harmonic time weighted average price, with the same semantics as
+// GetArithmeticTwap except that the harmonic mean of the price is used. It gives less weight to
+// price spikes than the arithmetic mean.
+func (k Keeper) GetHarmonicTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, k.GetHarmonicStrategy())
+}
+
+// GetHarmonicTwapToNow returns harmonic twap from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetHarmonicTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (osmomath.Dec, error) {
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, k.GetHarmonicStrategy())
+}
+
+// GetRealizedVolatility returns the realized volatility of the price of the base asset
+// in units of the quote asset from (startTime, endTime), as determined by prices from AMM pool `poolId`.
+// It is the time weighted standard deviation of the natural logarithm of the price, which
+// is the same for both assets.
+//
+// endTime is interpolated the same way as in GetArithmeticTwap, and is the current block time when
+// the query doesn't provide it.
+//
+// This function will error if:
+// * startTime > endTime
+// * endTime in the future
+// * startTime older than 48 hours OR pool creation
+// * pool with id poolId does not exist, or does not contain quoteAssetDenom, baseAssetDenom
+// * the squared log price accumulator was not tracked at startTime, i.e. before the upgrade introducing it
+// * there were some spot price errors within the time range, along with the result
+func (k Keeper) GetRealizedVolatility(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (osmomath.Dec, error) {
+	if startTime.After(endTime) {
+		return osmomath.Dec{}, types.StartTimeAfterEndTimeError{StartTime: startTime, EndTime: endTime}
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return osmomath.Dec{}, types.EndTimeInFutureError{EndTime: endTime, BlockTime: ctx.BlockTime()}
+	}
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, startTime, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+	var endRecord types.TwapRecord
+	if endTime.Equal(ctx.BlockTime()) {
+		endRecord, err = k.GetBeginBlockAccumulatorRecord(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+	} else {
+		endRecord, err = k.getInterpolatedRecord(ctx, poolId, endTime, baseAssetDenom, quoteAssetDenom)
+	}
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	return computeRealizedVolatility(startRecord, endRecord)
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or harmonic.
 func (k Keeper) getTwap(
 	ctx sdk.Context,
 	poolId uint64,
@@ -111,7 +183,7 @@ func (k Keeper) getTwap(
 }
 
 // getTwapToNow computes and returns twap from the start time until the current block time. The type
-// of twap returned depends on the strategy given and can be either arithmetic or geometric.
+// of twap returned depends on the strategy given and can be arithmetic, geometric or harmonic.
 func (k Keeper) getTwapToNow(
 	ctx sdk.Context,
 	poolId uint64,
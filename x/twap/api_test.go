@@ -905,3 +905,92 @@ func (s *TestSuite) TestGeometricTwapToNow_BalancerPool_Randomized() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetHarmonicTwap() {
+	s.SetupTest()
+	poolId, denomA, denomB := s.setupDefaultPool()
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Hour))
+
+	// spot price of 1 over the whole time range.
+	twap, err := s.twapkeeper.GetHarmonicTwap(s.Ctx, poolId, denomA, denomB, baseTime, baseTime.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneDec(), twap)
+
+	twap, err = s.twapkeeper.GetHarmonicTwapToNow(s.Ctx, poolId, denomB, denomA, baseTime)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneDec(), twap)
+
+	_, err = s.twapkeeper.GetHarmonicTwap(s.Ctx, poolId, denomA, denomB, baseTime.Add(-time.Hour), s.Ctx.BlockTime())
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestGetRealizedVolatility() {
+	endTime := baseTime.Add(time.Hour)
+
+	tests := map[string]struct {
+		preSetRecords []types.TwapRecord
+		startTime     time.Time
+		endTime       time.Time
+
+		expVolatility osmomath.Dec
+		expectErr     bool
+		// checked when set, in addition to expectErr
+		expectError error
+	}{
+		"constant spot price: zero volatility": {
+			startTime:     baseTime,
+			endTime:       endTime,
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"end time before block time": {
+			startTime:     baseTime,
+			endTime:       baseTime.Add(time.Minute),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"error: start time after end time": {
+			startTime:   endTime,
+			endTime:     baseTime,
+			expectErr:   true,
+			expectError: types.StartTimeAfterEndTimeError{StartTime: endTime, EndTime: baseTime},
+		},
+		"error: end time in the future": {
+			startTime:   baseTime,
+			endTime:     endTime.Add(time.Second),
+			expectErr:   true,
+			expectError: types.EndTimeInFutureError{EndTime: endTime.Add(time.Second), BlockTime: endTime},
+		},
+		"error: start time before the pool was created": {
+			startTime: baseTime.Add(-time.Hour),
+			endTime:   endTime,
+			expectErr: true,
+		},
+		"error: records stored before the squared accumulator was tracked": {
+			preSetRecords: []types.TwapRecord{baseRecord},
+			startTime:     baseTime,
+			endTime:       endTime,
+			expectErr:     true,
+			expectError:   types.LogPriceSquaredAccumulatorNotTrackedError{PoolId: baseRecord.PoolId, StartTime: baseTime},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId, denomA, denomB := s.setupDefaultPool()
+			s.preSetRecords(tc.preSetRecords)
+			s.Ctx = s.Ctx.WithBlockTime(endTime)
+
+			volatility, err := s.twapkeeper.GetRealizedVolatility(s.Ctx, poolId, denomA, denomB, tc.startTime, tc.endTime)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				if tc.expectError != nil {
+					s.Require().ErrorIs(err, tc.expectError)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expVolatility, volatility)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	poolmanager "github.com/osmosis-labs/osmosis/v22/x/poolmanager/client/queryproto"
 	"github.com/osmosis-labs/osmosis/v22/x/twap/client/queryproto"
@@ -30,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQueryHarmonicCommand())
+	cmd.AddCommand(GetQueryVolatilityCommand())
+	cmd.AddCommand(GetQueryAggregatedCommand())
 
 	return cmd
 }
@@ -134,6 +138,189 @@ Example:
 	return cmd
 }
 
+// GetQueryHarmonicCommand returns a harmonic twap query command.
+func GetQueryHarmonicCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "harmonic [poolid] [base denom] [start time] [end time]",
+		Short: "Query harmonic twap",
+		Long: osmocli.FormatLongDescDirect(`Query harmonic twap for pool. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} harmonic 1 uosmo 1667088000 24h
+{{.CommandPrefix}} harmonic 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.HarmonicTwap(cmd.Context(), &queryproto.HarmonicTwapRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryVolatilityCommand returns a realized volatility query command.
+func GetQueryVolatilityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "volatility [poolid] [base denom] [start time] [end time]",
+		Short: "Query realized volatility",
+		Long: osmocli.FormatLongDescDirect(`Query realized volatility of the price in pool, the time weighted standard deviation of its natural logarithm. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 24h
+{{.CommandPrefix}} volatility 1 uosmo 1667088000 1667174400
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// boilerplate parse fields
+			twapArgs, err := twapQueryParseArgs(args)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			quoteDenom, err := getQuoteDenomFromLiquidity(cmd.Context(), clientCtx, twapArgs.PoolId, twapArgs.BaseDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.RealizedVolatility(cmd.Context(), &queryproto.RealizedVolatilityRequest{
+				PoolId:     twapArgs.PoolId,
+				BaseAsset:  twapArgs.BaseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  twapArgs.StartTime,
+				EndTime:    &twapArgs.EndTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagGeometric       = "geometric"
+	FlagReferenceDenoms = "reference-denoms"
+	FlagMinLiquidity    = "min-liquidity"
+	FlagMaxDeviation    = "max-deviation"
+)
+
+// GetQueryAggregatedCommand returns an aggregated twap query command.
+func GetQueryAggregatedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregated [base denom] [quote denom] [start time] [end time]",
+		Short: "Query twap aggregated across pools",
+		Long: osmocli.FormatLongDescDirect(`Query twap of the base denom in the quote denom, aggregated across all pools listing both denoms
+and two hop paths through the reference denoms, weighted by liquidity. Start time must be unix time. End time can be unix time or duration.
+
+Example:
+{{.CommandPrefix}} aggregated uatom uosmo 1667088000 24h
+{{.CommandPrefix}} aggregated uatom uosmo 1667088000 1667174400 --reference-denoms=uusdc --min-liquidity=1000000000 --max-deviation=0.05
+`, types.ModuleName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			baseDenom := strings.TrimSpace(args[0])
+			quoteDenom := strings.TrimSpace(args[1])
+			startTime, endTime, err := parseTimeRange(args[2], args[3])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			geometric, err := cmd.Flags().GetBool(FlagGeometric)
+			if err != nil {
+				return err
+			}
+			referenceDenoms, err := cmd.Flags().GetStringSlice(FlagReferenceDenoms)
+			if err != nil {
+				return err
+			}
+			minLiquidityStr, err := cmd.Flags().GetString(FlagMinLiquidity)
+			if err != nil {
+				return err
+			}
+			minLiquidity, ok := osmomath.NewIntFromString(minLiquidityStr)
+			if !ok {
+				return fmt.Errorf("invalid min liquidity %s", minLiquidityStr)
+			}
+			maxDeviationStr, err := cmd.Flags().GetString(FlagMaxDeviation)
+			if err != nil {
+				return err
+			}
+			maxDeviation, err := osmomath.NewDecFromStr(maxDeviationStr)
+			if err != nil {
+				return err
+			}
+
+			queryClient := queryproto.NewQueryClient(clientCtx)
+			res, err := queryClient.AggregatedTwap(cmd.Context(), &queryproto.AggregatedTwapRequest{
+				BaseAsset:  baseDenom,
+				QuoteAsset: quoteDenom,
+				StartTime:  startTime,
+				EndTime:    &endTime,
+				Geometric:  geometric,
+				Options: types.TwapAggregationOptions{
+					ReferenceDenoms: referenceDenoms,
+					MinLiquidity:    minLiquidity,
+					MaxDeviation:    maxDeviation,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagGeometric, false, "Aggregate geometric rather than arithmetic twaps")
+	cmd.Flags().StringSlice(FlagReferenceDenoms, []string{}, "Denoms through which two hop paths are also aggregated")
+	cmd.Flags().String(FlagMinLiquidity, "0", "Minimum liquidity, valued in the quote denom, of a pool or path to be aggregated")
+	cmd.Flags().String(FlagMaxDeviation, "0", "Maximum relative deviation from the liquidity weighted median twap, zero to disable outlier rejection")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -169,29 +356,38 @@ func twapQueryParseArgs(args []string) (twapQueryArgs, error) {
 	// <DENOM PARSE>
 	baseDenom := strings.TrimSpace(args[1])
 
-	// <UNIX TIME PARSE>
-	startTime, err := osmocli.ParseUnixTime(args[2], "start time")
+	startTime, endTime, err := parseTimeRange(args[2], args[3])
 	if err != nil {
 		return twapQueryArgs{}, err
 	}
+	return twapQueryArgs{
+		PoolId:    poolId,
+		BaseDenom: baseDenom,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, nil
+}
+
+// parseTimeRange parses the start time, in unix time, and the end time, in unix time or as a duration
+// from the start time.
+func parseTimeRange(startTimeArg, endTimeArg string) (time.Time, time.Time, error) {
+	// <UNIX TIME PARSE>
+	startTime, err := osmocli.ParseUnixTime(startTimeArg, "start time")
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
 
 	// END TIME PARSE: ONEOF {<UNIX TIME PARSE>, <DURATION>}
 	// try parsing in unix time, if failed try parsing in duration
-	endTime, err := osmocli.ParseUnixTime(args[3], "end time")
+	endTime, err := osmocli.ParseUnixTime(endTimeArg, "end time")
 	if err != nil {
 		// TODO if we don't use protoreflect:
 		// make better error combiner, rather than just returning last error
-		duration, err2 := time.ParseDuration(args[3])
+		duration, err2 := time.ParseDuration(endTimeArg)
 		if err2 != nil {
-			err = err2
-			return twapQueryArgs{}, err
+			return time.Time{}, time.Time{}, err2
 		}
 		endTime = startTime.Add(duration)
 	}
-	return twapQueryArgs{
-		PoolId:    poolId,
-		BaseDenom: baseDenom,
-		StartTime: startTime,
-		EndTime:   endTime,
-	}, nil
+	return startTime, endTime, nil
 }
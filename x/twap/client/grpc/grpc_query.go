@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RealizedVolatility(grpcCtx context.Context,
+	req *queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RealizedVolatility(ctx, *req)
+}
+
 func (q Querier) Params(grpcCtx context.Context,
 	req *queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
@@ -31,6 +41,26 @@ func (q Querier) Params(grpcCtx context.Context,
 	return q.Q.Params(ctx, *req)
 }
 
+func (q Querier) HarmonicTwapToNow(grpcCtx context.Context,
+	req *queryproto.HarmonicTwapToNowRequest,
+) (*queryproto.HarmonicTwapToNowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicTwapToNow(ctx, *req)
+}
+
+func (q Querier) HarmonicTwap(grpcCtx context.Context,
+	req *queryproto.HarmonicTwapRequest,
+) (*queryproto.HarmonicTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.HarmonicTwap(ctx, *req)
+}
+
 func (q Querier) GeometricTwapToNow(grpcCtx context.Context,
 	req *queryproto.GeometricTwapToNowRequest,
 ) (*queryproto.GeometricTwapToNowResponse, error) {
@@ -71,3 +101,13 @@ func (q Querier) ArithmeticTwap(grpcCtx context.Context,
 	return q.Q.ArithmeticTwap(ctx, *req)
 }
 
+func (q Querier) AggregatedTwap(grpcCtx context.Context,
+	req *queryproto.AggregatedTwapRequest,
+) (*queryproto.AggregatedTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.AggregatedTwap(ctx, *req)
+}
+
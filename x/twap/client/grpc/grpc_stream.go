@@ -0,0 +1,21 @@
+package grpc
+
+// Server streaming queries are not generated from `proto/osmosis/twap/v1beta1/query.yml`,
+// as they are only served over gRPC and have no sdk context.
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osmosis-labs/osmosis/v22/x/twap/client/queryproto"
+)
+
+func (q Querier) SubscribeTwapRecords(
+	req *queryproto.SubscribeTwapRecordsRequest,
+	stream queryproto.Query_SubscribeTwapRecordsServer,
+) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	return q.Q.SubscribeTwapRecords(*req, stream)
+}
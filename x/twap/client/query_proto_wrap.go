@@ -1,12 +1,18 @@
 package client
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v22/x/twap"
 	"github.com/osmosis-labs/osmosis/v22/x/twap/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
 )
 
 // This file should evolve to being code gen'd, off of `proto/twap/v1beta/query.yml`
@@ -61,6 +67,160 @@ func (q Querier) GeometricTwapToNow(ctx sdk.Context,
 	return &queryproto.GeometricTwapToNowResponse{GeometricTwap: twap}, err
 }
 
+func (q Querier) HarmonicTwap(ctx sdk.Context,
+	req queryproto.HarmonicTwapRequest,
+) (*queryproto.HarmonicTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	twap, err := q.K.GetHarmonicTwap(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.HarmonicTwapResponse{HarmonicTwap: twap}, err
+}
+
+func (q Querier) HarmonicTwapToNow(ctx sdk.Context,
+	req queryproto.HarmonicTwapToNowRequest,
+) (*queryproto.HarmonicTwapToNowResponse, error) {
+	twap, err := q.K.GetHarmonicTwapToNow(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime)
+
+	return &queryproto.HarmonicTwapToNowResponse{HarmonicTwap: twap}, err
+}
+
+func (q Querier) RealizedVolatility(ctx sdk.Context,
+	req queryproto.RealizedVolatilityRequest,
+) (*queryproto.RealizedVolatilityResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	volatility, err := q.K.GetRealizedVolatility(ctx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime)
+
+	return &queryproto.RealizedVolatilityResponse{RealizedVolatility: volatility}, err
+}
+
+func (q Querier) AggregatedTwap(ctx sdk.Context,
+	req queryproto.AggregatedTwapRequest,
+) (*queryproto.AggregatedTwapResponse, error) {
+	if req.EndTime == nil {
+		req.EndTime = &time.Time{}
+	}
+	if (*req.EndTime == time.Time{}) {
+		*req.EndTime = ctx.BlockTime()
+	}
+
+	getAggregatedTwap := q.K.GetAggregatedArithmeticTwap
+	if req.Geometric {
+		getAggregatedTwap = q.K.GetAggregatedGeometricTwap
+	}
+	twap, sources, err := getAggregatedTwap(ctx, req.BaseAsset, req.QuoteAsset, req.StartTime, *req.EndTime, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.AggregatedTwapResponse{Twap: twap, Sources: sources}, nil
+}
+
+// SubscribeTwapRecords sends the records of the subscribed pools and denom pairs updated in every block
+// to the stream, until the stream is done or the subscriber falls too far behind.
+func (q Querier) SubscribeTwapRecords(
+	req queryproto.SubscribeTwapRecordsRequest,
+	stream queryproto.Query_SubscribeTwapRecordsServer,
+) error {
+	filter, err := newTwapRecordSubscriptionsFilter(req.Subscriptions)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, unsubscribe, err := q.K.SubscribeRecordUpdates(filter)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case blockRecords, ok := <-records:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber fell too far behind the twap record updates")
+			}
+			for _, record := range blockRecords {
+				if err := stream.Send(&queryproto.SubscribeTwapRecordsResponse{Record: record}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// maxTwapRecordSubscriptions is the max number of subscriptions of a SubscribeTwapRecords request.
+const maxTwapRecordSubscriptions = 100
+
+// newTwapRecordSubscriptionsFilter returns a filter of the records matching any of the subscriptions.
+// Returns error if there are no subscriptions or more than maxTwapRecordSubscriptions, or if a subscription
+// has no pool id, only one of the base and quote assets, or the same base and quote asset.
+func newTwapRecordSubscriptionsFilter(subscriptions []queryproto.TwapRecordSubscription) (func(types.TwapRecord) bool, error) {
+	if len(subscriptions) == 0 {
+		return nil, errors.New("at least one subscription is required")
+	}
+	if len(subscriptions) > maxTwapRecordSubscriptions {
+		return nil, fmt.Errorf("at most %d subscriptions are allowed, got %d", maxTwapRecordSubscriptions, len(subscriptions))
+	}
+
+	type denomPair struct {
+		denom0 string
+		denom1 string
+	}
+	// The pools subscribed to with every denom pair are mapped to a nil set of denom pairs.
+	subscribedPairs := map[uint64]map[denomPair]struct{}{}
+	for _, subscription := range subscriptions {
+		if subscription.PoolId == 0 {
+			return nil, errors.New("subscription pool id must be positive")
+		}
+		if subscription.BaseAsset == "" && subscription.QuoteAsset == "" {
+			subscribedPairs[subscription.PoolId] = nil
+			continue
+		}
+		if subscription.BaseAsset == "" || subscription.QuoteAsset == "" {
+			return nil, fmt.Errorf("subscription to pool %d must have both a base and a quote asset, or neither", subscription.PoolId)
+		}
+		denom0, denom1, err := types.LexicographicalOrderDenoms(subscription.BaseAsset, subscription.QuoteAsset)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs, found := subscribedPairs[subscription.PoolId]
+		if found && pairs == nil {
+			continue
+		}
+		if !found {
+			pairs = map[denomPair]struct{}{}
+			subscribedPairs[subscription.PoolId] = pairs
+		}
+		pairs[denomPair{denom0: denom0, denom1: denom1}] = struct{}{}
+	}
+
+	return func(record types.TwapRecord) bool {
+		pairs, found := subscribedPairs[record.PoolId]
+		if !found {
+			return false
+		}
+		if pairs == nil {
+			return true
+		}
+		_, found = pairs[denomPair{denom0: record.Asset0Denom, denom1: record.Asset1Denom}]
+		return found
+	}, nil
+}
+
 func (q Querier) Params(ctx sdk.Context,
 	req queryproto.ParamsRequest,
 ) (*queryproto.ParamsResponse, error) {
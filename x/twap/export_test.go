@@ -9,11 +9,18 @@ import (
 	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
 )
 
+const (
+	RecordSubscriberBufferSize = recordSubscriberBufferSize
+	RecordStreamQueueSize      = recordStreamQueueSize
+	MaxRecordSubscribers       = maxRecordSubscribers
+)
+
 type (
 	TimeTooOldError        = timeTooOldError
 	TwapStrategy           = twapStrategy
 	ArithmeticTwapStrategy = arithmetic
 	GeometricTwapStrategy  = geometric
+	HarmonicTwapStrategy   = harmonic
 )
 
 func (k Keeper) GetMostRecentRecordStoreRepresentation(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
@@ -40,8 +47,8 @@ func (k Keeper) UpdateRecords(ctx sdk.Context, poolId uint64) error {
 	return k.updateRecords(ctx, poolId)
 }
 
-func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes)
 }
 
 func (k Keeper) PruneRecords(ctx sdk.Context) error {
@@ -64,6 +71,14 @@ func (s geometric) ComputeTwap(startRecord types.TwapRecord, endRecord types.Twa
 	return s.computeTwap(startRecord, endRecord, quoteAsset)
 }
 
+func (s harmonic) ComputeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	return s.computeTwap(startRecord, endRecord, quoteAsset)
+}
+
+func ComputeRealizedVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	return computeRealizedVolatility(startRecord, endRecord)
+}
+
 func RecordWithUpdatedAccumulators(record types.TwapRecord, t time.Time) types.TwapRecord {
 	return recordWithUpdatedAccumulators(record, t)
 }
@@ -111,3 +126,7 @@ func (k *Keeper) AfterCreatePool(ctx sdk.Context, poolId uint64) error {
 func (k Keeper) GetAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return k.getAllHistoricalPoolIndexedTWAPs(ctx)
 }
+
+func (k Keeper) GetHistoricalPoolIndexedTWAPsFrom(ctx sdk.Context, startKey []byte, limit int) ([]types.TwapRecord, []byte, error) {
+	return k.getHistoricalPoolIndexedTWAPsFrom(ctx, startKey, limit)
+}
@@ -20,6 +20,10 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 
 	poolmanagerKeeper types.PoolManagerInterface
+
+	// recordStream is shared by the copies of the keeper, so that the records
+	// published by the app reach the subscribers of the query server.
+	recordStream *recordStream
 }
 
 func NewKeeper(storeKey storetypes.StoreKey, transientKey *storetypes.TransientStoreKey, paramSpace paramtypes.Subspace, poolmanagerKeeper types.PoolManagerInterface) *Keeper {
@@ -28,7 +32,7 @@ func NewKeeper(storeKey storetypes.StoreKey, transientKey *storetypes.TransientS
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{storeKey: storeKey, transientKey: transientKey, paramSpace: paramSpace, poolmanagerKeeper: poolmanagerKeeper}
+	return &Keeper{storeKey: storeKey, transientKey: transientKey, paramSpace: paramSpace, poolmanagerKeeper: poolmanagerKeeper, recordStream: newRecordStream()}
 }
 
 // GetParams returns the total set of twap parameters.
@@ -100,3 +104,8 @@ func (k Keeper) GetGeometricStrategy() *geometric {
 func (k Keeper) GetArithmeticStrategy() *arithmetic {
 	return &arithmetic{k}
 }
+
+// GetHarmonicStrategy gets harmonic TWAP keeper.
+func (k Keeper) GetHarmonicStrategy() *harmonic {
+	return &harmonic{k}
+}
@@ -170,6 +170,11 @@ func withSp1(twap types.TwapRecord, sp osmomath.Dec) types.TwapRecord {
 	return twap
 }
 
+func withLogPriceSquaredAccum(twap types.TwapRecord, accum osmomath.Dec) types.TwapRecord {
+	twap.LogPriceSquaredAccumulator = &accum
+	return twap
+}
+
 // TestTWAPInitGenesis tests that genesis is initialized correctly
 // with different parameters and state.
 // Asserts that the most recent records are set correctly.
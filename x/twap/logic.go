@@ -18,6 +18,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 	}
 	previousErrorTime := time.Time{} // no previous error
 	sp0, sp1, lastErrorTime := getSpotPrices(ctx, k, poolId, denom0, denom1, previousErrorTime)
+	logPriceSquaredAccumulator := osmomath.ZeroDec()
 	return types.TwapRecord{
 		PoolId:                      poolId,
 		Asset0Denom:                 denom0,
@@ -29,6 +30,7 @@ func newTwapRecord(k types.PoolManagerInterface, ctx sdk.Context, poolId uint64,
 		P0ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		P1ArithmeticTwapAccumulator: osmomath.ZeroDec(),
 		GeometricTwapAccumulator:    osmomath.ZeroDec(),
+		LogPriceSquaredAccumulator:  &logPriceSquaredAccumulator,
 		LastErrorTime:               lastErrorTime,
 	}, nil
 }
@@ -114,12 +116,21 @@ func (k Keeper) EndBlock(ctx sdk.Context) {
 				"error in TWAP end block, for updating records for pool id %d."+
 					" Skipping record update. Underlying err: %w", id, err).Error())
 		}
+		// the liquidity at the end of the block is stored for the aggregated twaps,
+		// so that liquidity added within a later block doesn't weigh until that block ends.
+		err = k.storePoolLiquidity(ctx, id)
+		if err != nil {
+			ctx.Logger().Error(fmt.Errorf(
+				"error in TWAP end block, for storing the liquidity of pool id %d."+
+					" Skipping liquidity update. Underlying err: %w", id, err).Error())
+		}
 	}
 }
 
 // updateRecords updates all records for a given pool id.
 // it does so by creating new records for all asset pairs
 // with updated spot prices and spot price errors, if any.
+// A twap_record_updated event is emitted for every new record.
 // Returns nil on success.
 // Returns error if:
 //   - fails to get previous records.
@@ -153,6 +164,7 @@ func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
 			return err
 		}
 		k.StoreNewRecord(ctx, newRecord)
+		ctx.EventManager().EmitEvent(types.NewTwapRecordUpdatedEvent(newRecord))
 	}
 	return nil
 }
@@ -198,13 +210,25 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
 // before current block time while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
+// The pools with a record history keep period override are pruned according to it instead.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
+	lastKeptTime, poolLastKeptTimes := k.getLastKeptTimes(ctx)
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes)
+}
 
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+// getLastKeptTimes returns the time before which records are pruned as of the current block time,
+// along with that of every pool with a record history keep period override.
+func (k Keeper) getLastKeptTimes(ctx sdk.Context) (time.Time, map[uint64]time.Time) {
+	params := k.GetParams(ctx)
+
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	poolLastKeptTimes := make(map[uint64]time.Time, len(params.PoolRecordHistoryKeepPeriods))
+	for _, poolKeepPeriod := range params.PoolRecordHistoryKeepPeriods {
+		poolLastKeptTimes[poolKeepPeriod.PoolId] = ctx.BlockTime().Add(-poolKeepPeriod.RecordHistoryKeepPeriod)
+	}
+	return lastKeptTime, poolLastKeptTimes
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -251,6 +275,15 @@ func recordWithUpdatedAccumulators(record types.TwapRecord, newTime time.Time) t
 	p0NewGeomAccum := types.SpotPriceMulDuration(logP0SpotPrice, timeDelta)
 	newRecord.GeometricTwapAccumulator = p0NewGeomAccum.AddMut(newRecord.GeometricTwapAccumulator)
 
+	// Records stored before the squared accumulator was tracked are left without it.
+	// N.B. the accumulator is a pointer shared with the given record, so a new one is allocated.
+	if record.LogPriceSquaredAccumulator != nil {
+		// p0NewSquaredAccum = (log_{2}{P_0})^2 * timeDelta
+		p0NewSquaredAccum := types.SpotPriceMulDuration(logP0SpotPrice.Mul(logP0SpotPrice), timeDelta)
+		p0NewSquaredAccum = p0NewSquaredAccum.AddMut(*record.LogPriceSquaredAccumulator)
+		newRecord.LogPriceSquaredAccumulator = &p0NewSquaredAccum
+	}
+
 	return newRecord
 }
 
@@ -281,7 +314,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 }
 
 // computeTwap computes and returns a TWAP of a given
-// type - arithmetic or geometric.
+// type - arithmetic, geometric or harmonic.
 // Between two records given the quote asset.
 // precondition: endRecord.Time >= startRecord.Time
 // if (endRecord.LastErrorTime >= startRecord.Time) returns an error at end + result
@@ -291,12 +324,7 @@ func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, assetA, asse
 // (endRecord.Accumulator - startRecord.Accumulator) / (endRecord.Time - startRecord.Time)
 func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string, strategy twapStrategy) (osmomath.Dec, error) {
 	// see if we need to return an error, due to spot price issues
-	var err error = nil
-	if endRecord.LastErrorTime.After(startRecord.Time) ||
-		endRecord.LastErrorTime.Equal(startRecord.Time) ||
-		startRecord.LastErrorTime.Equal(startRecord.Time) {
-		err = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
-	}
+	err := spotPriceErrorBetweenRecords(startRecord, endRecord)
 	timeDelta := endRecord.Time.Sub(startRecord.Time)
 	// if time difference is 0, then return the last spot price based off of start.
 	if timeDelta == time.Duration(0) {
@@ -309,6 +337,53 @@ func computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quote
 	return strategy.computeTwap(startRecord, endRecord, quoteAsset), err
 }
 
+// spotPriceErrorBetweenRecords returns an error if there was a spot price error
+// at or after the start record time, up to the end record.
+func spotPriceErrorBetweenRecords(startRecord types.TwapRecord, endRecord types.TwapRecord) error {
+	if endRecord.LastErrorTime.After(startRecord.Time) ||
+		endRecord.LastErrorTime.Equal(startRecord.Time) ||
+		startRecord.LastErrorTime.Equal(startRecord.Time) {
+		return errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
+	}
+	return nil
+}
+
+// computeRealizedVolatility computes and returns the realized volatility between two records,
+// as the time weighted standard deviation of the natural logarithm of the price.
+// It is the same for both assets, as the logarithm of the price of one is the opposite of the other's.
+// precondition: endRecord.Time >= startRecord.Time
+// if (endRecord.Time == startRecord.Time) returns zero
+// else returns
+// sqrt(E[(log_{2}{P_0})^2] - E[log_{2}{P_0}]^2) * ln(2)
+// where the time weighted means E are the accumulator differences divided by the time difference.
+// Errors like computeTwap on spot price errors, along with the result, and if either record has
+// no squared accumulator.
+func computeRealizedVolatility(startRecord types.TwapRecord, endRecord types.TwapRecord) (osmomath.Dec, error) {
+	if startRecord.LogPriceSquaredAccumulator == nil || endRecord.LogPriceSquaredAccumulator == nil {
+		return osmomath.Dec{}, types.LogPriceSquaredAccumulatorNotTrackedError{PoolId: startRecord.PoolId, StartTime: startRecord.Time}
+	}
+	err := spotPriceErrorBetweenRecords(startRecord, endRecord)
+
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	if timeDelta == 0 {
+		return osmomath.ZeroDec(), err
+	}
+
+	meanLogPrice := types.AccumDiffDivDuration(endRecord.GeometricTwapAccumulator.Sub(startRecord.GeometricTwapAccumulator), timeDelta)
+	meanSquaredLogPrice := types.AccumDiffDivDuration(endRecord.LogPriceSquaredAccumulator.Sub(*startRecord.LogPriceSquaredAccumulator), timeDelta)
+
+	// The variance can be slightly negative due to rounding when the price is constant.
+	variance := meanSquaredLogPrice.Sub(meanLogPrice.Mul(meanLogPrice))
+	if !variance.IsPositive() {
+		return osmomath.ZeroDec(), err
+	}
+	stdDevLogPrice, sqrtErr := osmomath.MonotonicSqrt(variance)
+	if sqrtErr != nil {
+		return osmomath.Dec{}, sqrtErr
+	}
+	return stdDevLogPrice.Mul(types.LnTwo), err
+}
+
 // twapLog returns the logarithm of the given spot price, base 2.
 // Panics if zero is given.
 func twapLog(price osmomath.Dec) osmomath.Dec {
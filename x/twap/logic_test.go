@@ -158,6 +158,7 @@ func (s *TestSuite) TestNewTwapRecord() {
 					s.Require().Equal(osmomath.ZeroDec(), twapRecord.P0ArithmeticTwapAccumulator)
 					s.Require().Equal(osmomath.ZeroDec(), twapRecord.P1ArithmeticTwapAccumulator)
 					s.Require().Equal(osmomath.ZeroDec(), twapRecord.GeometricTwapAccumulator)
+					s.Require().Equal(osmomath.ZeroDec(), *twapRecord.LogPriceSquaredAccumulator)
 				}
 			})
 		}
@@ -306,6 +307,18 @@ func TestRecordWithUpdatedAccumulators(t *testing.T) {
 			newTime:   time.Unix(2, 0),
 			expRecord: newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
 		},
+		"log price squared accum updated": {
+			record:  withLogPriceSquaredAccum(defaultRecord, pointFiveDec),
+			newTime: time.Unix(2, 0),
+			expRecord: withLogPriceSquaredAccum(
+				newExpRecord(oneDec.Add(OneSec.MulInt64(10)), twoDec.Add(OneSec.QuoInt64(10)), pointFiveDec.Add(geometricTenSecAccum)),
+				pointFiveDec.Add(OneSec.Mul(logTen.Mul(logTen)))),
+		},
+		"sp0 - zero spot price - log price squared accum unchanged": {
+			record:    withLogPriceSquaredAccum(withPrice0Set(defaultRecord, osmomath.ZeroDec()), pointFiveDec),
+			newTime:   defaultRecord.Time.Add(time.Second),
+			expRecord: withLogPriceSquaredAccum(withLastErrTime(newExpRecord(oneDec, twoDec.Add(osmomath.NewDecWithPrec(1, 1).Mul(OneSec)), pointFiveDec), defaultRecord.Time.Add(time.Second)), pointFiveDec),
+		},
 	}
 
 	for name, test := range tests {
@@ -628,6 +641,72 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_PoolRecordHistoryKeepPeriods tests that the pools with a record history keep period
+// override are pruned according to it, and that IteratePruneEligibleRecords goes through the records pruned.
+func (s *TestSuite) TestPruneRecords_PoolRecordHistoryKeepPeriods() {
+	recordHistoryKeepPeriod := s.twapkeeper.RecordHistoryKeepPeriod(s.Ctx)
+
+	pool1OlderMin2MsRecord, // kept since within the longer keep period of pool 1
+		pool2OlderMin1MsRecordAB, pool2OlderMin1MsRecordAC, pool2OlderMin1MsRecordBC, // deleted
+		pool3OlderBaseRecord,    // deleted since pool 3 has a shorter keep period
+		pool4OlderPlus1Record := // kept as newest under keep period
+		s.createTestRecordsFromTime(baseTime.Add(2 * -recordHistoryKeepPeriod))
+
+	pool1Min2MsRecord, // kept since within the longer keep period of pool 1
+		pool2Min1MsRecordAB, pool2Min1MsRecordAC, pool2Min1MsRecordBC, // kept as newest under keep period
+		pool3BaseRecord,    // kept as newest under the shorter keep period of pool 3
+		pool4Plus1Record := // kept as it is above the keep period boundary
+		s.createTestRecordsFromTime(baseTime.Add(-recordHistoryKeepPeriod))
+
+	recordsToPreSet := []types.TwapRecord{
+		pool1OlderMin2MsRecord,
+		pool2OlderMin1MsRecordAB, pool2OlderMin1MsRecordAC, pool2OlderMin1MsRecordBC,
+		pool3OlderBaseRecord,
+		pool4OlderPlus1Record,
+		pool1Min2MsRecord,
+		pool2Min1MsRecordAB, pool2Min1MsRecordAC, pool2Min1MsRecordBC,
+		pool3BaseRecord,
+		pool4Plus1Record,
+	}
+
+	expectedPrunedRecords := []types.TwapRecord{
+		pool2OlderMin1MsRecordAB, pool2OlderMin1MsRecordAC, pool2OlderMin1MsRecordBC,
+		pool3OlderBaseRecord,
+	}
+	expectedKeptRecords := []types.TwapRecord{
+		pool1OlderMin2MsRecord,
+		pool4OlderPlus1Record,
+		pool1Min2MsRecord,
+		pool2Min1MsRecordAB, pool2Min1MsRecordAC, pool2Min1MsRecordBC,
+		pool3BaseRecord,
+		pool4Plus1Record,
+	}
+	s.SetupTest()
+	s.preSetRecords(recordsToPreSet)
+
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.PoolRecordHistoryKeepPeriods = []types.PoolRecordHistoryKeepPeriod{
+		{PoolId: 1, RecordHistoryKeepPeriod: 3 * recordHistoryKeepPeriod},
+		{PoolId: 3, RecordHistoryKeepPeriod: recordHistoryKeepPeriod / 2},
+	}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	ctx := s.Ctx.WithBlockTime(baseTime)
+
+	pruneEligibleRecords := []types.TwapRecord{}
+	err := s.twapkeeper.IteratePruneEligibleRecords(ctx, func(record types.TwapRecord) error {
+		pruneEligibleRecords = append(pruneEligibleRecords, record)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(expectedPrunedRecords, pruneEligibleRecords)
+
+	err = s.twapkeeper.PruneRecords(ctx)
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords(expectedKeptRecords)
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -1420,6 +1499,67 @@ func (s *TestSuite) TestComputeArithmeticTwapWithSpotPriceError() {
 	}
 }
 
+func (s *TestSuite) TestComputeRealizedVolatility() {
+	// newVolatilityRecord returns a record with the given geometric and log price squared accumulators.
+	newVolatilityRecord := func(time time.Time, geomAccum, logPriceSquaredAccum osmomath.Dec) types.TwapRecord {
+		return withLogPriceSquaredAccum(newOneSidedGeometricRecord(time, geomAccum), logPriceSquaredAccum)
+	}
+
+	tests := map[string]struct {
+		startRecord   types.TwapRecord
+		endRecord     types.TwapRecord
+		expVolatility osmomath.Dec
+		expErr        bool
+		expectError   error
+	}{
+		"constant price: zero volatility": {
+			// log_{2}{4} = 2 for 10 seconds.
+			startRecord:   newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:     newVolatilityRecord(baseTime.Add(10*time.Second), tenSecAccum.MulInt64(2), tenSecAccum.MulInt64(4)),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"price of 1 then 4 for one second each: ln(2) volatility": {
+			// log_{2}{P} is 0 then 2, so its mean is 1, the mean of its square 2 and its variance 1.
+			startRecord:   newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:     newVolatilityRecord(baseTime.Add(2*time.Second), OneSec.MulInt64(2), OneSec.MulInt64(4)),
+			expVolatility: types.LnTwo,
+		},
+		"start accumulators have no impact": {
+			startRecord:   newVolatilityRecord(baseTime, tenSecAccum, tenSecAccum),
+			endRecord:     newVolatilityRecord(baseTime.Add(2*time.Second), tenSecAccum.Add(OneSec.MulInt64(2)), tenSecAccum.Add(OneSec.MulInt64(4))),
+			expVolatility: types.LnTwo,
+		},
+		"same record (zero time delta): zero volatility": {
+			startRecord:   newVolatilityRecord(baseTime, tenSecAccum, tenSecAccum),
+			endRecord:     newVolatilityRecord(baseTime, tenSecAccum, tenSecAccum),
+			expVolatility: osmomath.ZeroDec(),
+		},
+		"spot price error in between: result along with error": {
+			startRecord:   newVolatilityRecord(baseTime, osmomath.ZeroDec(), osmomath.ZeroDec()),
+			endRecord:     withLastErrTime(newVolatilityRecord(baseTime.Add(2*time.Second), OneSec.MulInt64(2), OneSec.MulInt64(4)), tPlusOne),
+			expVolatility: types.LnTwo,
+			expErr:        true,
+		},
+		"start record without log price squared accumulator: error": {
+			startRecord: newOneSidedGeometricRecord(baseTime, osmomath.ZeroDec()),
+			endRecord:   newVolatilityRecord(baseTime.Add(2*time.Second), OneSec.MulInt64(2), OneSec.MulInt64(4)),
+			expErr:      true,
+			expectError: types.LogPriceSquaredAccumulatorNotTrackedError{StartTime: baseTime},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			actualVolatility, err := twap.ComputeRealizedVolatility(test.startRecord, test.endRecord)
+			osmoassert.ConditionalError(s.T(), test.expErr, err)
+			if test.expectError != nil {
+				s.Require().ErrorIs(err, test.expectError)
+				return
+			}
+			s.Require().Equal(test.expVolatility, actualVolatility)
+		})
+	}
+}
+
 // TestTwapLog_CorrectBase tests that the base of 2 is used for the twap log function.
 // log_2{16} = 4
 func (s *TestSuite) TestTwapLog_CorrectBase() {
@@ -0,0 +1,165 @@
+package twap
+
+import (
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+const (
+	// recordSubscriberBufferSize is the number of blocks of records buffered for a subscriber.
+	// Subscribers falling further behind are dropped, so that publishing never blocks the node.
+	recordSubscriberBufferSize = 64
+	// recordStreamQueueSize is the number of blocks of records queued for the fan-out to the subscribers.
+	// If the fan-out falls further behind, all the subscribers are dropped, so that publishing never blocks the node.
+	recordStreamQueueSize = 64
+	// maxRecordSubscribers is the max number of subscribers to the record updates at a time.
+	maxRecordSubscribers = 100
+)
+
+// recordStream fans out the twap records updated at the end of every block to the subscribers.
+// It is fed with the end block events of the node rather than by the state machine, and holds
+// no state that is part of consensus.
+//
+// The records of a block are queued as is, and filtered and sent to the subscribers by a dispatcher
+// goroutine, so that the end of the block does not wait for the fan-out.
+type recordStream struct {
+	mu          sync.Mutex
+	nextId      uint64
+	subscribers map[uint64]*recordSubscriber
+
+	startDispatcher sync.Once
+	queue           chan []types.TwapRecord
+}
+
+type recordSubscriber struct {
+	filter  func(types.TwapRecord) bool
+	records chan []types.TwapRecord
+}
+
+func newRecordStream() *recordStream {
+	return &recordStream{
+		subscribers: map[uint64]*recordSubscriber{},
+		queue:       make(chan []types.TwapRecord, recordStreamQueueSize),
+	}
+}
+
+// SubscribeRecordUpdates returns a channel receiving, for every block, the updated twap records
+// for which filter returns true. Blocks without such records are skipped.
+// The channel is closed if the subscriber falls too far behind, or when unsubscribing.
+// The returned unsubscribe function must be called once the records are no longer received.
+// Returns error if there are already maxRecordSubscribers subscribers.
+func (k Keeper) SubscribeRecordUpdates(filter func(types.TwapRecord) bool) (records <-chan []types.TwapRecord, unsubscribe func(), err error) {
+	s := k.recordStream
+	s.startDispatcher.Do(func() { go s.dispatch() })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.subscribers) >= maxRecordSubscribers {
+		return nil, nil, types.TooManyRecordSubscribersError{MaxSubscribers: maxRecordSubscribers}
+	}
+
+	id := s.nextId
+	s.nextId++
+	subscriber := &recordSubscriber{
+		filter:  filter,
+		records: make(chan []types.TwapRecord, recordSubscriberBufferSize),
+	}
+	s.subscribers[id] = subscriber
+
+	return subscriber.records, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.removeSubscriber(id)
+	}, nil
+}
+
+// PublishRecordUpdates queues the twap records carried by the twap_record_updated events of a block
+// for the subscribers. If the queue is full, all the subscribers are dropped.
+// Returns error if an event of the twap_record_updated type can't be parsed, in which case
+// nothing is published.
+func (k Keeper) PublishRecordUpdates(events []abci.Event) error {
+	s := k.recordStream
+	s.mu.Lock()
+	hasSubscribers := len(s.subscribers) > 0
+	s.mu.Unlock()
+	if !hasSubscribers {
+		return nil
+	}
+
+	records := []types.TwapRecord{}
+	for _, event := range events {
+		if event.Type != types.TypeEvtTwapRecordUpdated {
+			continue
+		}
+		record, err := types.ParseTwapRecordUpdatedEvent(event)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	select {
+	case s.queue <- records:
+	default:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for id := range s.subscribers {
+			s.removeSubscriber(id)
+		}
+	}
+	return nil
+}
+
+// dispatch sends the queued records of every block to the subscribers whose filter they match.
+// Subscribers whose buffer is full are dropped. The subscribers are filtered for without holding the lock,
+// which is only taken to send to a subscriber that has not been removed meanwhile.
+func (s *recordStream) dispatch() {
+	for records := range s.queue {
+		s.mu.Lock()
+		subscribers := make(map[uint64]*recordSubscriber, len(s.subscribers))
+		for id, subscriber := range s.subscribers {
+			subscribers[id] = subscriber
+		}
+		s.mu.Unlock()
+
+		for id, subscriber := range subscribers {
+			subscribedRecords := []types.TwapRecord{}
+			for _, record := range records {
+				if subscriber.filter(record) {
+					subscribedRecords = append(subscribedRecords, record)
+				}
+			}
+			if len(subscribedRecords) == 0 {
+				continue
+			}
+
+			s.mu.Lock()
+			if s.subscribers[id] == subscriber {
+				select {
+				case subscriber.records <- subscribedRecords:
+				default:
+					s.removeSubscriber(id)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// removeSubscriber closes the channel of the subscriber and removes it, if not already removed.
+// Contract: the caller holds the lock.
+func (s *recordStream) removeSubscriber(id uint64) {
+	subscriber, ok := s.subscribers[id]
+	if !ok {
+		return
+	}
+	close(subscriber.records)
+	delete(s.subscribers, id)
+}
@@ -0,0 +1,147 @@
+package twap_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v22/x/twap"
+	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
+)
+
+// TestPublishRecordUpdates tests that the records updated in end block are published
+// from the end block events to the subscribers whose filter they match.
+func (s *TestSuite) TestPublishRecordUpdates() {
+	s.SetupTest()
+	poolId := s.PrepareBalancerPoolWithCoins(defaultThreeAssetCoins...)
+
+	isPairRecord := func(record types.TwapRecord) bool {
+		return record.PoolId == poolId && record.Asset0Denom == denom0 && record.Asset1Denom == denom1
+	}
+	isPoolRecord := func(record types.TwapRecord) bool { return record.PoolId == poolId }
+	isOtherPoolRecord := func(record types.TwapRecord) bool { return record.PoolId != poolId }
+
+	pairRecords, unsubscribePair, err := s.twapkeeper.SubscribeRecordUpdates(isPairRecord)
+	s.Require().NoError(err)
+	defer unsubscribePair()
+	poolRecords, unsubscribePool, err := s.twapkeeper.SubscribeRecordUpdates(isPoolRecord)
+	s.Require().NoError(err)
+	defer unsubscribePool()
+	otherPoolRecords, unsubscribeOtherPool, err := s.twapkeeper.SubscribeRecordUpdates(isOtherPoolRecord)
+	s.Require().NoError(err)
+	defer unsubscribeOtherPool()
+
+	// Swap in the next block and update the records at its end.
+	s.Ctx = s.Ctx.WithBlockTime(tPlusOneMin).WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.RunBasicSwap(poolId)
+	s.twapkeeper.EndBlock(s.Ctx)
+
+	err = s.twapkeeper.PublishRecordUpdates(s.Ctx.EventManager().ABCIEvents())
+	s.Require().NoError(err)
+
+	updatedRecords, err := s.twapkeeper.GetAllMostRecentRecordsForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Len(updatedRecords, 3)
+	s.Require().Equal(tPlusOneMin, updatedRecords[0].Time)
+
+	s.Require().Equal([]types.TwapRecord{updatedRecords[0]}, <-pairRecords)
+	s.Require().Equal(updatedRecords, <-poolRecords)
+	s.Require().Empty(otherPoolRecords)
+}
+
+// TestPublishRecordUpdates_SlowSubscriber tests that the subscribers falling too far behind are dropped,
+// without blocking the others.
+func (s *TestSuite) TestPublishRecordUpdates_SlowSubscriber() {
+	s.SetupTest()
+	record := newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1)
+	events := []abci.Event{abci.Event(types.NewTwapRecordUpdatedEvent(record))}
+	all := func(types.TwapRecord) bool { return true }
+
+	slowRecords, unsubscribeSlow, err := s.twapkeeper.SubscribeRecordUpdates(all)
+	s.Require().NoError(err)
+	records, unsubscribe, err := s.twapkeeper.SubscribeRecordUpdates(all)
+	s.Require().NoError(err)
+	defer unsubscribe()
+
+	for i := 0; i <= twap.RecordSubscriberBufferSize; i++ {
+		err := s.twapkeeper.PublishRecordUpdates(events)
+		s.Require().NoError(err)
+		// The other subscriber keeps up.
+		s.Require().Equal([]types.TwapRecord{record}, <-records)
+	}
+
+	for i := 0; i < twap.RecordSubscriberBufferSize; i++ {
+		s.Require().Equal([]types.TwapRecord{record}, <-slowRecords)
+	}
+	_, ok := <-slowRecords
+	s.Require().False(ok)
+
+	// Unsubscribing after being dropped is a no-op.
+	s.Require().NotPanics(unsubscribeSlow)
+
+	// Malformed events are not published.
+	malformedEvent := abci.Event(types.NewTwapRecordUpdatedEvent(record))
+	malformedEvent.Attributes = malformedEvent.Attributes[:1]
+	err = s.twapkeeper.PublishRecordUpdates([]abci.Event{malformedEvent})
+	s.Require().Error(err)
+
+	s.Require().Empty(records)
+}
+
+// TestPublishRecordUpdates_SlowDispatch tests that all the subscribers are dropped if the fan-out of the records
+// falls too far behind, without blocking the publisher.
+func (s *TestSuite) TestPublishRecordUpdates_SlowDispatch() {
+	s.SetupTest()
+	record := newEmptyPriceRecord(basePoolId, baseTime, denom0, denom1)
+	events := []abci.Event{abci.Event(types.NewTwapRecordUpdatedEvent(record))}
+
+	// The filter of the blocked subscriber blocks the fan-out until released.
+	release := make(chan struct{})
+	blockedRecords, unsubscribeBlocked, err := s.twapkeeper.SubscribeRecordUpdates(func(types.TwapRecord) bool {
+		<-release
+		return true
+	})
+	s.Require().NoError(err)
+	defer unsubscribeBlocked()
+	records, unsubscribe, err := s.twapkeeper.SubscribeRecordUpdates(func(types.TwapRecord) bool { return true })
+	s.Require().NoError(err)
+	defer unsubscribe()
+
+	// The queue fills up behind the block being fanned out, after which the subscribers are dropped.
+	for i := 0; i < twap.RecordStreamQueueSize+2; i++ {
+		err := s.twapkeeper.PublishRecordUpdates(events)
+		s.Require().NoError(err)
+	}
+	close(release)
+
+	// Both channels are closed.
+	for _, subscriberRecords := range []<-chan []types.TwapRecord{blockedRecords, records} {
+		for range subscriberRecords {
+		}
+	}
+}
+
+// TestSubscribeRecordUpdates_MaxSubscribers tests that the number of subscribers is capped.
+func (s *TestSuite) TestSubscribeRecordUpdates_MaxSubscribers() {
+	s.SetupTest()
+	all := func(types.TwapRecord) bool { return true }
+
+	unsubscribes := make([]func(), twap.MaxRecordSubscribers)
+	for i := range unsubscribes {
+		_, unsubscribe, err := s.twapkeeper.SubscribeRecordUpdates(all)
+		s.Require().NoError(err)
+		unsubscribes[i] = unsubscribe
+	}
+
+	_, _, err := s.twapkeeper.SubscribeRecordUpdates(all)
+	s.Require().ErrorIs(err, types.TooManyRecordSubscribersError{MaxSubscribers: twap.MaxRecordSubscribers})
+
+	// Unsubscribing makes room for a new subscriber.
+	unsubscribes[0]()
+	_, unsubscribe, err := s.twapkeeper.SubscribeRecordUpdates(all)
+	s.Require().NoError(err)
+	unsubscribe()
+	for _, unsubscribe := range unsubscribes[1:] {
+		unsubscribe()
+	}
+}
@@ -3,10 +3,14 @@ package twap
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v22/x/twap/types"
 )
@@ -73,7 +77,29 @@ func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // So, in order to have correct behavior for the desired guarantee,
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
-func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+//
+// The pools in poolLastKeptTimes are pruned before their own time instead.
+func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time) error {
+	return k.iterateRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes, func(twapToRemove types.TwapRecord) error {
+		k.DeleteHistoricalRecord(ctx, twapToRemove)
+		return nil
+	})
+}
+
+// IteratePruneEligibleRecords calls cb on every historical record that would be pruned
+// as of the current block time. See iterateRecordsBeforeTimeButNewest for the order of the records.
+// Iteration stops at the first error returned by cb, which is returned.
+func (k Keeper) IteratePruneEligibleRecords(ctx sdk.Context, cb func(types.TwapRecord) error) error {
+	lastKeptTime, poolLastKeptTimes := k.getLastKeptTimes(ctx)
+	return k.iterateRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolLastKeptTimes, cb)
+}
+
+// iterateRecordsBeforeTimeButNewest calls cb on all records for each pool before the given time
+// but the newest, from the newest to the oldest. The records of the pools in poolLastKeptTimes
+// are compared to their own time instead, and are iterated pool by pool, in order of pool id, once
+// the records of the other pools are.
+// See pruneRecordsBeforeTimeButNewest for why the newest record is left out.
+func (k Keeper) iterateRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolLastKeptTimes map[uint64]time.Time, cb func(types.TwapRecord) error) error {
 	store := ctx.KVStore(k.storeKey)
 
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
@@ -82,10 +108,43 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 	iter := store.ReverseIterator(
 		[]byte(types.HistoricalTWAPTimeIndexPrefix),
 		types.FormatHistoricalTimeIndexTWAPKey(lastKeptTime, 0, "", ""))
-	defer iter.Close()
+	err := iterateRecordsButNewest(iter, func(twapRecord types.TwapRecord) bool {
+		_, hasOverride := poolLastKeptTimes[twapRecord.PoolId]
+		return !hasOverride
+	}, cb)
+	iter.Close()
+	if err != nil {
+		return err
+	}
 
+	// The pools with an override are iterated through their pool index, so that only their own records are scanned.
+	poolIds := make([]uint64, 0, len(poolLastKeptTimes))
+	for poolId := range poolLastKeptTimes {
+		poolIds = append(poolIds, poolId)
+	}
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+	for _, poolId := range poolIds {
+		poolLastKeptTime := poolLastKeptTimes[poolId]
+		// The pool index is ordered by (asset 0, asset 1), then time, so that reverse iterating it
+		// also goes through the newest record of every pair first.
+		poolPrefix := append(types.FormatKeyPoolTwapRecords(poolId), types.KeySeparator...)
+		iter := sdk.KVStoreReversePrefixIterator(store, poolPrefix)
+		err := iterateRecordsButNewest(iter, func(twapRecord types.TwapRecord) bool {
+			return twapRecord.Time.Before(poolLastKeptTime)
+		}, cb)
+		iter.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iterateRecordsButNewest calls cb on the records of the given reverse iterator for which include returns true,
+// but the first one of every (pool id, asset 0, asset 1) triplet.
+func iterateRecordsButNewest(iter dbm.Iterator, include func(types.TwapRecord) bool, cb func(types.TwapRecord) error) error {
 	// We mark what (pool id, asset 0, asset 1) triplets we've seen.
-	// We prune all records for a triplet that we haven't already seen.
+	// We iterate over all records for a triplet that we haven't already seen.
 	type uniqueTriplet struct {
 		poolId uint64
 		asset0 string
@@ -94,15 +153,18 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 	seenPoolAssetTriplets := map[uniqueTriplet]struct{}{}
 
 	for ; iter.Valid(); iter.Next() {
-		twapToRemove, err := types.ParseTwapFromBz(iter.Value())
+		twapRecord, err := types.ParseTwapFromBz(iter.Value())
 		if err != nil {
 			return err
 		}
+		if !include(twapRecord) {
+			continue
+		}
 
 		poolKey := uniqueTriplet{
-			poolId: twapToRemove.PoolId,
-			asset0: twapToRemove.Asset0Denom,
-			asset1: twapToRemove.Asset1Denom,
+			poolId: twapRecord.PoolId,
+			asset0: twapRecord.Asset0Denom,
+			asset1: twapRecord.Asset1Denom,
 		}
 		_, hasSeenPoolRecord := seenPoolAssetTriplets[poolKey]
 		if !hasSeenPoolRecord {
@@ -110,7 +172,9 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			continue
 		}
 
-		k.DeleteHistoricalRecord(ctx, twapToRemove)
+		if err := cb(twapRecord); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -157,7 +221,6 @@ func (k Keeper) GetAllHistoricalTimeIndexedTWAPs(ctx sdk.Context) ([]types.TwapR
 }
 
 // getAllHistoricalPoolIndexedTWAPs returns all historical TWAPs indexed by pool id.
-// nolint: unused
 func (k Keeper) getAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.HistoricalTWAPPoolIndexPrefix), types.ParseTwapFromBz)
 }
@@ -175,6 +238,85 @@ func (k Keeper) StoreNewRecord(ctx sdk.Context, twap types.TwapRecord) {
 	k.StoreHistoricalTWAP(ctx, twap)
 }
 
+// logPriceSquaredMigrationBatchSize is the number of historical records read at once by MigrateLogPriceSquaredAccumulators.
+const logPriceSquaredMigrationBatchSize = 1000
+
+// MigrateLogPriceSquaredAccumulators sets the squared log price accumulator of the records
+// stored before it was tracked, so that realized volatility can be queried over their time range.
+// The accumulator is replayed from zero at the oldest historical record of each (pool, asset0, asset1),
+// using the last spot prices of the records, then the most recent records are updated from the
+// historical record at or before their time.
+// Records already tracking the accumulator are left as is.
+// The historical records are read and written in batches of logPriceSquaredMigrationBatchSize, so that
+// they are never all held in memory, and the store is not written while it is iterated.
+func (k Keeper) MigrateLogPriceSquaredAccumulators(ctx sdk.Context) error {
+	// historical records are iterated by (pool id, asset0, asset1), then time,
+	// so only the previous record of the current (pool id, asset0, asset1) is kept.
+	var previousRecord *types.TwapRecord
+	startKey := []byte(types.HistoricalTWAPPoolIndexPrefix)
+	for startKey != nil {
+		historicalRecords, nextKey, err := k.getHistoricalPoolIndexedTWAPsFrom(ctx, startKey, logPriceSquaredMigrationBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, record := range historicalRecords {
+			record := record
+			isSamePair := previousRecord != nil && previousRecord.PoolId == record.PoolId &&
+				previousRecord.Asset0Denom == record.Asset0Denom && previousRecord.Asset1Denom == record.Asset1Denom
+			if record.LogPriceSquaredAccumulator == nil {
+				if isSamePair {
+					record.LogPriceSquaredAccumulator = recordWithUpdatedAccumulators(*previousRecord, record.Time).LogPriceSquaredAccumulator
+				} else {
+					logPriceSquaredAccumulator := osmomath.ZeroDec()
+					record.LogPriceSquaredAccumulator = &logPriceSquaredAccumulator
+				}
+				k.StoreHistoricalTWAP(ctx, record)
+			}
+			previousRecord = &record
+		}
+		startKey = nextKey
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	mostRecentRecords, err := types.GetAllMostRecentTwaps(store)
+	if err != nil {
+		return err
+	}
+	for _, record := range mostRecentRecords {
+		if record.LogPriceSquaredAccumulator != nil {
+			continue
+		}
+		historicalRecord, err := k.getRecordAtOrBeforeTime(ctx, record.PoolId, record.Time, record.Asset0Denom, record.Asset1Denom)
+		if err != nil {
+			return err
+		}
+		record.LogPriceSquaredAccumulator = recordWithUpdatedAccumulators(historicalRecord, record.Time).LogPriceSquaredAccumulator
+		osmoutils.MustSet(store, types.FormatMostRecentTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom), &record)
+	}
+	return nil
+}
+
+// getHistoricalPoolIndexedTWAPsFrom returns at most limit historical TWAPs indexed by pool id, starting from the given key,
+// along with the key of the next one, or nil if there are none left.
+func (k Keeper) getHistoricalPoolIndexedTWAPsFrom(ctx sdk.Context, startKey []byte, limit int) ([]types.TwapRecord, []byte, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(startKey, sdk.PrefixEndBytes([]byte(types.HistoricalTWAPPoolIndexPrefix)))
+	defer iterator.Close()
+
+	records := make([]types.TwapRecord, 0, limit)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(records) == limit {
+			return records, append([]byte{}, iterator.Key()...), nil
+		}
+		record, err := types.ParseTwapFromBz(iterator.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil, nil
+}
+
 // DeleteMostRecentRecord deletes a given record in most recent record store.
 // Note that if there are entries in historical indexes for this record, they are not deleted by this method.
 func (k Keeper) DeleteMostRecentRecord(ctx sdk.Context, twap types.TwapRecord) {
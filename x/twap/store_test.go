@@ -285,7 +285,8 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 		// across many test cases on purpose.
 		recordsToPreSet []types.TwapRecord
 
-		lastKeptTime time.Time
+		lastKeptTime      time.Time
+		poolLastKeptTimes map[uint64]time.Time
 
 		expectedKeptRecords []types.TwapRecord
 	}{
@@ -429,6 +430,50 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 				pool5Plus1SBaseMsAB, pool5Plus1SBaseMsAC, pool5Plus1SBaseMsBC, // base time + 1s; kept since older
 			},
 		},
+		"base time; across pool 3 and pool 4; pool 4 kept since base time - 1s; pool 3: 2 deleted and newest 2 kept. pool 4: none before its lastKeptTime; none pruned": {
+			recordsToPreSet: []types.TwapRecord{
+				pool3BaseSecMin3Ms, // base time - 3ms; deleted
+				pool3BaseSecMin2Ms, // base time - 2ms; deleted
+				pool3BaseSecMin1Ms, // base time - 1ms; kept since newest before lastKeptTime
+				pool3BaseSecBaseMs, // base time; kept since at lastKeptTime
+
+				pool4Plus1SMin3Ms, // base time + 1s - 3ms; kept since older than pool lastKeptTime
+				pool4Plus1SMin2Ms, // base time + 1s - 2ms; kept since older than pool lastKeptTime
+				pool4Plus1SMin1Ms, // base time + 1s -1ms; kept since older than pool lastKeptTime
+				pool4Plus1SBaseMs, // base time + 1s; kept since older than pool lastKeptTime
+			},
+
+			lastKeptTime:      baseTime,
+			poolLastKeptTimes: map[uint64]time.Time{4: baseTime.Add(-time.Second)},
+
+			expectedKeptRecords: []types.TwapRecord{
+				pool3BaseSecMin1Ms,
+				pool3BaseSecBaseMs,
+				pool4Plus1SMin3Ms, pool4Plus1SMin2Ms, pool4Plus1SMin1Ms, pool4Plus1SBaseMs,
+			},
+		},
+		"base time - 2s - 3 ms; across pool 1 and pool 3; pool 3 kept since base time; pool 1: none before lastKeptTime; none pruned. pool 3: 2 deleted and newest 2 kept": {
+			recordsToPreSet: []types.TwapRecord{
+				pool1Min2SMin3Ms, // base time - 2s - 3ms; kept since older than lastKeptTime
+				pool1Min2SMin1Ms, // base time - 2s - 1ms; kept since older than lastKeptTime
+				pool1Min2SMin2Ms, // base time - 2s - 2ms; kept since older than lastKeptTime
+				pool1Min2SBaseMs, // base time - 2s; kept since older than lastKeptTime
+
+				pool3BaseSecMin3Ms, // base time - 3ms; deleted
+				pool3BaseSecMin2Ms, // base time - 2ms; deleted
+				pool3BaseSecMin1Ms, // base time - 1ms; kept since newest before pool lastKeptTime
+				pool3BaseSecBaseMs, // base time; kept since at pool lastKeptTime
+			},
+
+			lastKeptTime:      baseTime.Add(2 * -time.Second).Add(3 * -time.Millisecond),
+			poolLastKeptTimes: map[uint64]time.Time{3: baseTime},
+
+			expectedKeptRecords: []types.TwapRecord{
+				pool1Min2SMin3Ms, pool1Min2SMin2Ms, pool1Min2SMin1Ms, pool1Min2SBaseMs,
+				pool3BaseSecMin1Ms,
+				pool3BaseSecBaseMs,
+			},
+		},
 		"no pre-set records - no error": {
 			recordsToPreSet: []types.TwapRecord{},
 
@@ -445,7 +490,7 @@ func (s *TestSuite) TestPruneRecordsBeforeTimeButNewest() {
 			ctx := s.Ctx
 			twapKeeper := s.twapkeeper
 
-			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime)
+			err := twapKeeper.PruneRecordsBeforeTimeButNewest(ctx, tc.lastKeptTime, tc.poolLastKeptTimes)
 			s.Require().NoError(err)
 
 			s.validateExpectedRecords(tc.expectedKeptRecords)
@@ -529,6 +574,33 @@ func (s *TestSuite) TestGetAllHistoricalPoolIndexedTWAPs() {
 	}
 }
 
+// TestGetHistoricalPoolIndexedTWAPsFrom tests that the historical records indexed by pool id are read in batches
+// that resume from the returned key, and together return all the records.
+func (s *TestSuite) TestGetHistoricalPoolIndexedTWAPsFrom() {
+	s.SetupTest()
+	expectedRecords := []types.TwapRecord{
+		newEmptyPriceRecord(1, baseTime, "tokenB", "tokenA"),
+		newEmptyPriceRecord(1, tPlusOne, "tokenB", "tokenA"),
+		newEmptyPriceRecord(2, baseTime, "tokenA", "tokenC"),
+		newEmptyPriceRecord(3, baseTime, "tokenB", "tokenC"),
+		newEmptyPriceRecord(3, tPlusOne, "tokenB", "tokenC"),
+	}
+	s.preSetRecords(expectedRecords)
+
+	for _, limit := range []int{1, 2, len(expectedRecords), len(expectedRecords) + 1} {
+		actualRecords := []types.TwapRecord{}
+		startKey := []byte(types.HistoricalTWAPPoolIndexPrefix)
+		for startKey != nil {
+			records, nextKey, err := s.twapkeeper.GetHistoricalPoolIndexedTWAPsFrom(s.Ctx, startKey, limit)
+			s.Require().NoError(err)
+			s.Require().LessOrEqual(len(records), limit)
+			actualRecords = append(actualRecords, records...)
+			startKey = nextKey
+		}
+		s.Require().Equal(expectedRecords, actualRecords, "limit %d", limit)
+	}
+}
+
 func (s *TestSuite) TestAccumulatorOverflow() {
 	maxSpotPrice := gammtypes.MaxSpotPrice
 	tests := map[string]struct {
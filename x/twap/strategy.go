@@ -8,7 +8,7 @@ import (
 )
 
 // twapStrategy is an interface for computing TWAPs.
-// We have two strategies implementing the interface - arithmetic and geometric.
+// We have three strategies implementing the interface - arithmetic, geometric and harmonic.
 // We expose a common TWAP API to reduce duplication and avoid complexity.
 type twapStrategy interface {
 	// computeTwap calculates the TWAP with specific startRecord and endRecord.
@@ -23,6 +23,10 @@ type geometric struct {
 	TwapKeeper Keeper
 }
 
+type harmonic struct {
+	TwapKeeper Keeper
+}
+
 // computeTwap computes and returns an arithmetic TWAP between
 // two records given the quote asset.
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
@@ -70,3 +74,26 @@ func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.Tw
 	// by the underlying spot price function.
 	return osmomath.SigFigRound(result.Dec(), gammtypes.SpotPriceSigFigs)
 }
+
+// computeTwap computes and returns a harmonic TWAP between
+// two records given the quote asset.
+// The harmonic mean of the price of the base asset in the quote asset is the reciprocal
+// of the arithmetic mean of its reciprocal, the price of the quote asset in the base asset,
+// so it is computed from the arithmetic accumulator of the other asset.
+func (s *harmonic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) osmomath.Dec {
+	var reciprocalAccumDiff osmomath.Dec
+	if quoteAsset == startRecord.Asset0Denom {
+		reciprocalAccumDiff = endRecord.P1ArithmeticTwapAccumulator.Sub(startRecord.P1ArithmeticTwapAccumulator)
+	} else {
+		reciprocalAccumDiff = endRecord.P0ArithmeticTwapAccumulator.Sub(startRecord.P0ArithmeticTwapAccumulator)
+	}
+
+	if reciprocalAccumDiff.IsZero() {
+		return osmomath.ZeroDec()
+	}
+
+	// result = timeDelta / (accumDiff of the reciprocal price), avoiding the precision loss of
+	// dividing the accumulator difference first.
+	timeDelta := types.CanonicalTimeMs(endRecord.Time) - types.CanonicalTimeMs(startRecord.Time)
+	return osmomath.NewDec(timeDelta).Quo(reciprocalAccumDiff)
+}
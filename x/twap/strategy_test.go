@@ -308,6 +308,57 @@ func (s *TestSuite) TestComputeGeometricStrategyTwap() {
 	}
 }
 
+// TestComputeHarmonicStrategyTwap tests harmonic strategy's computeTwap
+// Contrary to computeTwap function (logic.go) that handles the cases with zero delta correctly,
+// this function should panic in case of zero delta.
+func (s *TestSuite) TestComputeHarmonicStrategyTwap() {
+	// spot price of denom1 = 1 then 4, so spot price of denom0 = 1 then 0.25, for one second each.
+	twoSecStartRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime, osmomath.OneDec(), osmomath.ZeroDec(), osmomath.ZeroDec(), osmomath.ZeroDec())
+	twoSecEndRecord := newTwoAssetPoolTwapRecordWithDefaults(baseTime.Add(2*time.Second), osmomath.OneDec(), OneSec.MulInt64(5), OneSec.MulInt64(5).QuoInt64(4), osmomath.ZeroDec())
+
+	tests := map[string]computeTwapTestCase{
+		"spot price = 1 for one second, 0 init accumulator": {
+			startRecord: newOneSidedRecord(baseTime, osmomath.ZeroDec(), false),
+			endRecord:   newOneSidedRecord(tPlusOne, OneSec, false),
+			quoteAsset:  denom0,
+			expTwap:     osmomath.OneDec(),
+		},
+		"reciprocal accumulator = 10*OneSec, t=5s. 10 base accum": {
+			startRecord: newOneSidedRecord(baseTime, osmomath.NewDec(10), true),
+			endRecord:   newOneSidedRecord(baseTime.Add(5*time.Second), osmomath.NewDec(10).Add(tenSecAccum), true),
+			quoteAsset:  denom1,
+			expTwap:     pointFiveDec,
+		},
+		"spot price of denom1 = 1 then 4: harmonic mean of 1.6, below the arithmetic mean of 2.5": {
+			startRecord: twoSecStartRecord,
+			endRecord:   twoSecEndRecord,
+			quoteAsset:  denom0,
+			expTwap:     osmomath.MustNewDecFromStr("1.6"),
+		},
+		"spot price of denom0 = 1 then 0.25: harmonic mean of 0.4, below the arithmetic mean of 0.625": {
+			startRecord: twoSecStartRecord,
+			endRecord:   twoSecEndRecord,
+			quoteAsset:  denom1,
+			expTwap:     osmomath.MustNewDecFromStr("0.4"),
+		},
+		"zero reciprocal accumulator difference: zero twap": {
+			startRecord: newOneSidedRecord(baseTime, osmomath.ZeroDec(), true),
+			endRecord:   newOneSidedRecord(tPlusOne, osmomath.ZeroDec(), true),
+			quoteAsset:  denom1,
+			expTwap:     osmomath.ZeroDec(),
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			osmoassert.ConditionalPanic(s.T(), test.expPanic, func() {
+				harmonicStrategy := &twap.HarmonicTwapStrategy{TwapKeeper: *s.App.TwapKeeper}
+				actualTwap := harmonicStrategy.ComputeTwap(test.startRecord, test.endRecord, test.quoteAsset)
+				s.Require().Equal(test.expTwap, actualTwap)
+			})
+		})
+	}
+}
+
 func (s *TestSuite) TestComputeArithmeticStrategyTwap_ThreeAsset() {
 	tenSecAccum := OneSec.MulInt64(10)
 	pointOneAccum := OneSec.QuoInt64(10)
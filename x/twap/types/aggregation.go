@@ -0,0 +1,31 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the reference denoms are invalid or repeated, or if the
+// minimum liquidity or maximum deviation are negative.
+// An unset minimum liquidity or maximum deviation is valid and treated as zero.
+func (o TwapAggregationOptions) Validate() error {
+	seenDenoms := map[string]struct{}{}
+	for _, denom := range o.ReferenceDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if _, ok := seenDenoms[denom]; ok {
+			return fmt.Errorf("reference denom %s is repeated", denom)
+		}
+		seenDenoms[denom] = struct{}{}
+	}
+	if !o.MinLiquidity.IsNil() && o.MinLiquidity.IsNegative() {
+		return errors.New("min liquidity must not be negative")
+	}
+	if !o.MaxDeviation.IsNil() && o.MaxDeviation.IsNegative() {
+		return errors.New("max deviation must not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/twap/v1beta1/aggregation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapAggregationOptions configures how the TWAPs of all the pools listing a
+// denom pair are combined into a single price.
+type TwapAggregationOptions struct {
+	// Denoms through which two hop paths from the base asset to the quote asset
+	// are considered in addition to the pools listing both assets.
+	ReferenceDenoms []string `protobuf:"bytes,1,rep,name=reference_denoms,json=referenceDenoms,proto3" json:"reference_denoms,omitempty" yaml:"reference_denoms"`
+	// Minimum liquidity, valued in the quote asset, of a pool or path for its
+	// TWAP to be used.
+	MinLiquidity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_liquidity,json=minLiquidity,proto3,customtype=cosmossdk.io/math.Int" json:"min_liquidity" yaml:"min_liquidity"`
+	// Maximum relative deviation of the TWAP of a pool or path from the
+	// liquidity weighted median TWAP, above which it is rejected as an outlier.
+	// Zero disables outlier rejection.
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation" yaml:"max_deviation"`
+}
+
+func (m *TwapAggregationOptions) Reset()         { *m = TwapAggregationOptions{} }
+func (m *TwapAggregationOptions) String() string { return proto.CompactTextString(m) }
+func (*TwapAggregationOptions) ProtoMessage()    {}
+func (*TwapAggregationOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb2013d38ff90c4, []int{0}
+}
+func (m *TwapAggregationOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapAggregationOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapAggregationOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapAggregationOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapAggregationOptions.Merge(m, src)
+}
+func (m *TwapAggregationOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapAggregationOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapAggregationOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapAggregationOptions proto.InternalMessageInfo
+
+func (m *TwapAggregationOptions) GetReferenceDenoms() []string {
+	if m != nil {
+		return m.ReferenceDenoms
+	}
+	return nil
+}
+
+// TwapSource is a pool, or a two hop path of pools through a reference denom,
+// whose TWAP was considered in an aggregated TWAP.
+type TwapSource struct {
+	// Ids of the pools of the source, in swap order from the base asset.
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	// TWAP of the base asset in units of the quote asset.
+	Twap cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap"`
+	// Liquidity of the source valued in the quote asset. For paths, the
+	// liquidity of the shallowest pool.
+	Liquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity"`
+	// Whether the source was left out of the aggregated TWAP, for being below
+	// the minimum liquidity or an outlier.
+	Rejected bool `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (m *TwapSource) Reset()         { *m = TwapSource{} }
+func (m *TwapSource) String() string { return proto.CompactTextString(m) }
+func (*TwapSource) ProtoMessage()    {}
+func (*TwapSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb2013d38ff90c4, []int{1}
+}
+func (m *TwapSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapSource.Merge(m, src)
+}
+func (m *TwapSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapSource proto.InternalMessageInfo
+
+func (m *TwapSource) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+func (m *TwapSource) GetRejected() bool {
+	if m != nil {
+		return m.Rejected
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TwapAggregationOptions)(nil), "osmosis.twap.v1beta1.TwapAggregationOptions")
+	proto.RegisterType((*TwapSource)(nil), "osmosis.twap.v1beta1.TwapSource")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/twap/v1beta1/aggregation.proto", fileDescriptor_3fb2013d38ff90c4)
+}
+
+var fileDescriptor_3fb2013d38ff90c4 = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0xaa, 0xd3, 0x40,
+	0x14, 0x4e, 0x6e, 0x8b, 0xb6, 0x83, 0x97, 0x2b, 0x63, 0xd5, 0x70, 0x2f, 0x24, 0x25, 0x82, 0x74,
+	0x63, 0xc6, 0x7b, 0x45, 0x04, 0x5d, 0x35, 0x14, 0xa1, 0x52, 0x10, 0xa2, 0xab, 0x6e, 0xe2, 0x24,
+	0x19, 0xd3, 0xd1, 0x4c, 0x26, 0x66, 0xa6, 0x3f, 0x79, 0x0b, 0x9f, 0xc0, 0xe7, 0xe9, 0xb2, 0x0b,
+	0x17, 0xe2, 0x22, 0x48, 0xfb, 0x06, 0x7d, 0x02, 0xc9, 0x4f, 0x7f, 0xf4, 0x6e, 0xba, 0x19, 0xce,
+	0x77, 0xe6, 0xfb, 0xbe, 0x73, 0xe6, 0xcc, 0x01, 0x4f, 0xb9, 0x60, 0x5c, 0x50, 0x81, 0xe4, 0x1c,
+	0x27, 0x68, 0x76, 0xed, 0x11, 0x89, 0xaf, 0x11, 0x0e, 0xc3, 0x94, 0x84, 0x58, 0x52, 0x1e, 0x5b,
+	0x49, 0xca, 0x25, 0x87, 0x9d, 0x9a, 0x67, 0x15, 0x3c, 0xab, 0xe6, 0x5d, 0x76, 0x42, 0x1e, 0xf2,
+	0x92, 0x80, 0x8a, 0xa8, 0xe2, 0x9a, 0x3f, 0xce, 0xc0, 0xa3, 0x8f, 0x73, 0x9c, 0xf4, 0x0f, 0x2e,
+	0xef, 0x93, 0xe2, 0x14, 0xf0, 0x2d, 0xb8, 0x9f, 0x92, 0xcf, 0x24, 0x25, 0xb1, 0x4f, 0xdc, 0x80,
+	0xc4, 0x9c, 0x09, 0x4d, 0xed, 0x36, 0x7a, 0x6d, 0xfb, 0x6a, 0x9b, 0x1b, 0x8f, 0x33, 0xcc, 0xa2,
+	0xd7, 0xe6, 0xff, 0x0c, 0xd3, 0xb9, 0xd8, 0xa7, 0x06, 0x65, 0x06, 0x8e, 0xc1, 0x39, 0xa3, 0xb1,
+	0x1b, 0xd1, 0x6f, 0x53, 0x1a, 0x50, 0x99, 0x69, 0x67, 0x5d, 0xb5, 0xd7, 0xb6, 0x5f, 0x2e, 0x73,
+	0x43, 0xf9, 0x9d, 0x1b, 0x0f, 0xfd, 0xb2, 0x5d, 0x11, 0x7c, 0xb5, 0x28, 0x47, 0x0c, 0xcb, 0x89,
+	0x35, 0x8c, 0xe5, 0x36, 0x37, 0x3a, 0x55, 0x85, 0x7f, 0xb4, 0xa6, 0x73, 0x8f, 0xd1, 0x78, 0xb4,
+	0x83, 0xf0, 0x13, 0x38, 0x67, 0x78, 0xe1, 0x06, 0x64, 0x46, 0xcb, 0xde, 0xb5, 0x46, 0xe9, 0xfd,
+	0xa6, 0xf6, 0xbe, 0xba, 0xed, 0x3d, 0x22, 0x21, 0xf6, 0xb3, 0x01, 0xf1, 0x8f, 0x2a, 0x1c, 0x3b,
+	0x14, 0x15, 0xf0, 0x62, 0xb0, 0x87, 0x3f, 0x55, 0x00, 0x8a, 0x01, 0x7d, 0xe0, 0xd3, 0xd4, 0x27,
+	0xd0, 0x02, 0xad, 0x84, 0xf3, 0xc8, 0xa5, 0x41, 0x35, 0x8c, 0xa6, 0xfd, 0x60, 0x9b, 0x1b, 0x17,
+	0x95, 0xd1, 0xee, 0xc6, 0x74, 0xee, 0x16, 0xe1, 0x30, 0x10, 0xf0, 0x15, 0x68, 0x16, 0xbf, 0x50,
+	0xbf, 0xf9, 0xc9, 0x09, 0x7d, 0x39, 0xa5, 0x00, 0xf6, 0x41, 0xfb, 0x30, 0xb1, 0xc6, 0xe9, 0xea,
+	0x83, 0x0a, 0x5e, 0x82, 0x56, 0x4a, 0xbe, 0x10, 0x5f, 0x92, 0x40, 0x6b, 0x76, 0xd5, 0x5e, 0xcb,
+	0xd9, 0x63, 0xfb, 0xdd, 0x72, 0xad, 0xab, 0xab, 0xb5, 0xae, 0xfe, 0x59, 0xeb, 0xea, 0xf7, 0x8d,
+	0xae, 0xac, 0x36, 0xba, 0xf2, 0x6b, 0xa3, 0x2b, 0xe3, 0xe7, 0x21, 0x95, 0x93, 0xa9, 0x67, 0xf9,
+	0x9c, 0xa1, 0x7a, 0x91, 0x9e, 0x45, 0xd8, 0x13, 0x3b, 0x80, 0x66, 0x37, 0x37, 0x68, 0x51, 0xed,
+	0xa0, 0xcc, 0x12, 0x22, 0xbc, 0x3b, 0xe5, 0x2a, 0xbd, 0xf8, 0x3b, 0x00, 0x45, 0xf0, 0xbb, 0xff,
+	0xa0, 0x02, 0x00, 0x00,
+}
+
+func (m *TwapAggregationOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapAggregationOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapAggregationOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAggregation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinLiquidity.Size()
+		i -= size
+		if _, err := m.MinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAggregation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ReferenceDenoms) > 0 {
+		for iNdEx := len(m.ReferenceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReferenceDenoms[iNdEx])
+			copy(dAtA[i:], m.ReferenceDenoms[iNdEx])
+			i = encodeVarintAggregation(dAtA, i, uint64(len(m.ReferenceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rejected {
+		i--
+		if m.Rejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAggregation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAggregation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAggregation(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAggregation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAggregation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapAggregationOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReferenceDenoms) > 0 {
+		for _, s := range m.ReferenceDenoms {
+			l = len(s)
+			n += 1 + l + sovAggregation(uint64(l))
+		}
+	}
+	l = m.MinLiquidity.Size()
+	n += 1 + l + sovAggregation(uint64(l))
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovAggregation(uint64(l))
+	return n
+}
+
+func (m *TwapSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovAggregation(uint64(e))
+		}
+		n += 1 + sovAggregation(uint64(l)) + l
+	}
+	l = m.Twap.Size()
+	n += 1 + l + sovAggregation(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovAggregation(uint64(l))
+	if m.Rejected {
+		n += 2
+	}
+	return n
+}
+
+func sovAggregation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAggregation(x uint64) (n int) {
+	return sovAggregation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapAggregationOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAggregation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapAggregationOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapAggregationOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenoms = append(m.ReferenceDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAggregation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAggregation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAggregation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAggregation
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAggregation
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAggregation
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAggregation
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rejected = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAggregation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAggregation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAggregation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAggregation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAggregation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAggregation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAggregation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAggregation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAggregation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAggregation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAggregation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -73,3 +73,29 @@ type InvalidUpdateRecordError struct {
 func (e InvalidUpdateRecordError) Error() string {
 	return fmt.Sprintf("failed to update the record, the context time must be greater than record time; record: block %d at %s, actual: block %d at %s", e.RecordBlockHeight, e.RecordTime, e.ActualBlockHeight, e.ActualTime)
 }
+
+type NoTwapSourcesError struct {
+	BaseAssetDenom  string
+	QuoteAssetDenom string
+}
+
+func (e NoTwapSourcesError) Error() string {
+	return fmt.Sprintf("no pool or path with enough liquidity and a twap over the time range for base asset %s and quote asset %s", e.BaseAssetDenom, e.QuoteAssetDenom)
+}
+
+type LogPriceSquaredAccumulatorNotTrackedError struct {
+	PoolId    uint64
+	StartTime time.Time
+}
+
+func (e LogPriceSquaredAccumulatorNotTrackedError) Error() string {
+	return fmt.Sprintf("the squared log price accumulator of pool %d was not tracked at %s, realized volatility can't be computed", e.PoolId, e.StartTime)
+}
+
+type TooManyRecordSubscribersError struct {
+	MaxSubscribers int
+}
+
+func (e TooManyRecordSubscribersError) Error() string {
+	return fmt.Sprintf("the twap record updates already have the max number of subscribers (%d)", e.MaxSubscribers)
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	TypeEvtTwapRecordUpdated = "twap_record_updated"
+
+	AttributeKeyPoolId                      = "pool_id"
+	AttributeKeyAsset0Denom                 = "asset0_denom"
+	AttributeKeyAsset1Denom                 = "asset1_denom"
+	AttributeKeyHeight                      = "height"
+	AttributeKeyTime                        = "time"
+	AttributeKeyP0LastSpotPrice             = "p0_last_spot_price"
+	AttributeKeyP1LastSpotPrice             = "p1_last_spot_price"
+	AttributeKeyP0ArithmeticTwapAccumulator = "p0_arithmetic_twap_accumulator"
+	AttributeKeyP1ArithmeticTwapAccumulator = "p1_arithmetic_twap_accumulator"
+	AttributeKeyGeometricTwapAccumulator    = "geometric_twap_accumulator"
+	AttributeKeyLogPriceSquaredAccumulator  = "log_price_squared_accumulator"
+	AttributeKeyLastErrorTime               = "last_error_time"
+)
+
+// NewTwapRecordUpdatedEvent returns the event emitted when the record is stored at the end of a block.
+// The event carries the whole record, so that it can be rebuilt with ParseTwapRecordUpdatedEvent.
+// The squared log price accumulator is empty when it is not tracked.
+func NewTwapRecordUpdatedEvent(record TwapRecord) sdk.Event {
+	logPriceSquaredAccumulator := ""
+	if record.LogPriceSquaredAccumulator != nil {
+		logPriceSquaredAccumulator = record.LogPriceSquaredAccumulator.String()
+	}
+
+	return sdk.NewEvent(
+		TypeEvtTwapRecordUpdated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(record.PoolId, 10)),
+		sdk.NewAttribute(AttributeKeyAsset0Denom, record.Asset0Denom),
+		sdk.NewAttribute(AttributeKeyAsset1Denom, record.Asset1Denom),
+		sdk.NewAttribute(AttributeKeyHeight, strconv.FormatInt(record.Height, 10)),
+		sdk.NewAttribute(AttributeKeyTime, record.Time.UTC().Format(time.RFC3339Nano)),
+		sdk.NewAttribute(AttributeKeyP0LastSpotPrice, record.P0LastSpotPrice.String()),
+		sdk.NewAttribute(AttributeKeyP1LastSpotPrice, record.P1LastSpotPrice.String()),
+		sdk.NewAttribute(AttributeKeyP0ArithmeticTwapAccumulator, record.P0ArithmeticTwapAccumulator.String()),
+		sdk.NewAttribute(AttributeKeyP1ArithmeticTwapAccumulator, record.P1ArithmeticTwapAccumulator.String()),
+		sdk.NewAttribute(AttributeKeyGeometricTwapAccumulator, record.GeometricTwapAccumulator.String()),
+		sdk.NewAttribute(AttributeKeyLogPriceSquaredAccumulator, logPriceSquaredAccumulator),
+		sdk.NewAttribute(AttributeKeyLastErrorTime, record.LastErrorTime.UTC().Format(time.RFC3339Nano)),
+	)
+}
+
+// ParseTwapRecordUpdatedEvent returns the record carried by an event created with NewTwapRecordUpdatedEvent.
+// Returns error if the event is of another type or if any of the record attributes is missing or malformed.
+func ParseTwapRecordUpdatedEvent(event abci.Event) (TwapRecord, error) {
+	if event.Type != TypeEvtTwapRecordUpdated {
+		return TwapRecord{}, fmt.Errorf("unexpected event type %s, expected %s", event.Type, TypeEvtTwapRecordUpdated)
+	}
+
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	getAttribute := func(key string) (string, error) {
+		value, ok := attributes[key]
+		if !ok {
+			return "", fmt.Errorf("missing attribute %s in event %s", key, event.Type)
+		}
+		return value, nil
+	}
+
+	var (
+		record TwapRecord
+		value  string
+		err    error
+	)
+
+	if value, err = getAttribute(AttributeKeyPoolId); err != nil {
+		return TwapRecord{}, err
+	}
+	if record.PoolId, err = strconv.ParseUint(value, 10, 64); err != nil {
+		return TwapRecord{}, fmt.Errorf("invalid %s: %w", AttributeKeyPoolId, err)
+	}
+
+	if record.Asset0Denom, err = getAttribute(AttributeKeyAsset0Denom); err != nil {
+		return TwapRecord{}, err
+	}
+	if record.Asset1Denom, err = getAttribute(AttributeKeyAsset1Denom); err != nil {
+		return TwapRecord{}, err
+	}
+
+	if value, err = getAttribute(AttributeKeyHeight); err != nil {
+		return TwapRecord{}, err
+	}
+	if record.Height, err = strconv.ParseInt(value, 10, 64); err != nil {
+		return TwapRecord{}, fmt.Errorf("invalid %s: %w", AttributeKeyHeight, err)
+	}
+
+	for key, dst := range map[string]*time.Time{
+		AttributeKeyTime:          &record.Time,
+		AttributeKeyLastErrorTime: &record.LastErrorTime,
+	} {
+		if value, err = getAttribute(key); err != nil {
+			return TwapRecord{}, err
+		}
+		if *dst, err = time.Parse(time.RFC3339Nano, value); err != nil {
+			return TwapRecord{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	for key, dst := range map[string]*osmomath.Dec{
+		AttributeKeyP0LastSpotPrice:             &record.P0LastSpotPrice,
+		AttributeKeyP1LastSpotPrice:             &record.P1LastSpotPrice,
+		AttributeKeyP0ArithmeticTwapAccumulator: &record.P0ArithmeticTwapAccumulator,
+		AttributeKeyP1ArithmeticTwapAccumulator: &record.P1ArithmeticTwapAccumulator,
+		AttributeKeyGeometricTwapAccumulator:    &record.GeometricTwapAccumulator,
+	} {
+		if value, err = getAttribute(key); err != nil {
+			return TwapRecord{}, err
+		}
+		if *dst, err = osmomath.NewDecFromStr(value); err != nil {
+			return TwapRecord{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	if value, err = getAttribute(AttributeKeyLogPriceSquaredAccumulator); err != nil {
+		return TwapRecord{}, err
+	}
+	if value != "" {
+		logPriceSquaredAccumulator, err := osmomath.NewDecFromStr(value)
+		if err != nil {
+			return TwapRecord{}, fmt.Errorf("invalid %s: %w", AttributeKeyLogPriceSquaredAccumulator, err)
+		}
+		record.LogPriceSquaredAccumulator = &logPriceSquaredAccumulator
+	}
+
+	return record, nil
+}
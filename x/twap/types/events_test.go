@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestParseTwapRecordUpdatedEvent(t *testing.T) {
+	logPriceSquaredAccumulator := osmomath.MustNewDecFromStr("0.123456789012345678")
+	baseRecord := TwapRecord{
+		PoolId:                      2,
+		Asset0Denom:                 "uatom",
+		Asset1Denom:                 "uosmo",
+		Height:                      10,
+		Time:                        time.Unix(1257894000, 123456789).UTC(),
+		P0LastSpotPrice:             osmomath.MustNewDecFromStr("1.5"),
+		P1LastSpotPrice:             osmomath.MustNewDecFromStr("0.666666666666666667"),
+		P0ArithmeticTwapAccumulator: osmomath.NewDec(100),
+		P1ArithmeticTwapAccumulator: osmomath.NewDec(50),
+		GeometricTwapAccumulator:    osmomath.NewDec(-20),
+		LogPriceSquaredAccumulator:  &logPriceSquaredAccumulator,
+		LastErrorTime:               time.Unix(1257893000, 0).UTC(),
+	}
+
+	withoutLogPriceSquaredAccumulator := baseRecord
+	withoutLogPriceSquaredAccumulator.LogPriceSquaredAccumulator = nil
+
+	withoutLastErrorTime := baseRecord
+	withoutLastErrorTime.LastErrorTime = time.Time{}
+
+	withoutAttribute := func(key string) abci.Event {
+		event := abci.Event(NewTwapRecordUpdatedEvent(baseRecord))
+		attributes := []abci.EventAttribute{}
+		for _, attribute := range event.Attributes {
+			if attribute.Key != key {
+				attributes = append(attributes, attribute)
+			}
+		}
+		event.Attributes = attributes
+		return event
+	}
+
+	tests := map[string]struct {
+		event       abci.Event
+		expRecord   TwapRecord
+		expectedErr bool
+	}{
+		"record": {
+			event:     abci.Event(NewTwapRecordUpdatedEvent(baseRecord)),
+			expRecord: baseRecord,
+		},
+		"record without squared log price accumulator": {
+			event:     abci.Event(NewTwapRecordUpdatedEvent(withoutLogPriceSquaredAccumulator)),
+			expRecord: withoutLogPriceSquaredAccumulator,
+		},
+		"record without last error time": {
+			event:     abci.Event(NewTwapRecordUpdatedEvent(withoutLastErrorTime)),
+			expRecord: withoutLastErrorTime,
+		},
+		"error: other event type": {
+			event:       abci.Event{Type: "swap"},
+			expectedErr: true,
+		},
+		"error: missing attribute": {
+			event:       withoutAttribute(AttributeKeyGeometricTwapAccumulator),
+			expectedErr: true,
+		},
+		"error: malformed attribute": {
+			event: abci.Event{
+				Type:       TypeEvtTwapRecordUpdated,
+				Attributes: []abci.EventAttribute{{Key: AttributeKeyPoolId, Value: "one"}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			record, err := ParseTwapRecordUpdatedEvent(tc.event)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRecord, record)
+		})
+	}
+}
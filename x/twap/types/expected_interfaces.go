@@ -19,4 +19,5 @@ type PoolManagerInterface interface {
 		quoteAssetDenom string,
 		baseAssetDenom string,
 	) (price osmomath.BigDec, err error)
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
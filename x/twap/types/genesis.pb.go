@@ -33,6 +33,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// pool_record_history_keep_periods overrides record_history_keep_period for
+	// the given pools, e.g. to keep the records of pools oracles depend on for
+	// longer.
+	PoolRecordHistoryKeepPeriods []PoolRecordHistoryKeepPeriod `protobuf:"bytes,3,rep,name=pool_record_history_keep_periods,json=poolRecordHistoryKeepPeriods,proto3" json:"pool_record_history_keep_periods" yaml:"pool_record_history_keep_periods"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +86,67 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPoolRecordHistoryKeepPeriods() []PoolRecordHistoryKeepPeriod {
+	if m != nil {
+		return m.PoolRecordHistoryKeepPeriods
+	}
+	return nil
+}
+
+// PoolRecordHistoryKeepPeriod is the period for which the twap records of a
+// pool are kept, overriding the record_history_keep_period param.
+type PoolRecordHistoryKeepPeriod struct {
+	PoolId                  uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Reset()         { *m = PoolRecordHistoryKeepPeriod{} }
+func (m *PoolRecordHistoryKeepPeriod) String() string { return proto.CompactTextString(m) }
+func (*PoolRecordHistoryKeepPeriod) ProtoMessage()    {}
+func (*PoolRecordHistoryKeepPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecordHistoryKeepPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.Merge(m, src)
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecordHistoryKeepPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecordHistoryKeepPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecordHistoryKeepPeriod proto.InternalMessageInfo
+
+func (m *PoolRecordHistoryKeepPeriod) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecordHistoryKeepPeriod) GetRecordHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.RecordHistoryKeepPeriod
+	}
+	return 0
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
@@ -94,7 +159,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3f4bdf49b69bd63c, []int{1}
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,6 +204,7 @@ func (m *GenesisState) GetParams() Params {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
+	proto.RegisterType((*PoolRecordHistoryKeepPeriod)(nil), "osmosis.twap.v1beta1.PoolRecordHistoryKeepPeriod")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
 }
 
@@ -147,32 +213,37 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0xee, 0xd3, 0x30,
-	0x14, 0xc5, 0x63, 0x3e, 0x2a, 0x91, 0x3f, 0x53, 0x54, 0x41, 0xff, 0x15, 0x4a, 0x43, 0x06, 0xd4,
-	0xa5, 0x36, 0x0d, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0x6b, 0xa9, 0x02, 0x13, 0x4b, 0xe4, 0x24, 0xb7,
-	0xa9, 0x45, 0x13, 0x5b, 0xb6, 0xd3, 0x92, 0x07, 0x40, 0x62, 0x64, 0xe4, 0x89, 0x50, 0xc7, 0x8e,
-	0x4c, 0x05, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0xc4, 0x2e, 0x03, 0x2a, 0x5b, 0xae, 0x7e, 0xe7, 0x1c,
-	0x9d, 0xdc, 0x6b, 0x37, 0xe4, 0xaa, 0xe4, 0x8a, 0x29, 0xa2, 0xb7, 0x54, 0x90, 0xcd, 0x34, 0x05,
-	0x4d, 0xa7, 0xa4, 0x80, 0x0a, 0x14, 0x53, 0x58, 0x48, 0xae, 0xb9, 0xd7, 0xb7, 0x1a, 0xdc, 0x6a,
-	0xb0, 0xd5, 0x0c, 0xfb, 0x05, 0x2f, 0x78, 0x27, 0x20, 0xed, 0x97, 0xd1, 0x0e, 0x1f, 0x5d, 0xcc,
-	0x6b, 0x87, 0x44, 0x42, 0xc6, 0x65, 0x6e, 0x75, 0xd7, 0x05, 0xe7, 0xc5, 0x1a, 0x48, 0x37, 0xa5,
-	0xf5, 0x92, 0xd0, 0xaa, 0x39, 0xa3, 0xac, 0xcb, 0x48, 0x4c, 0xb6, 0x19, 0x2c, 0xf2, 0xff, 0x75,
-	0xe5, 0xb5, 0xa4, 0x9a, 0xf1, 0xca, 0xf0, 0xf0, 0x3b, 0x72, 0x7b, 0x0b, 0x2a, 0x69, 0xa9, 0xbc,
-	0xa7, 0xee, 0x3d, 0x21, 0xeb, 0x0a, 0x12, 0x10, 0x3c, 0x5b, 0x25, 0x2c, 0x87, 0x4a, 0xb3, 0x25,
-	0x03, 0x39, 0x40, 0x01, 0x1a, 0xdf, 0x89, 0xfb, 0x1d, 0x7d, 0xd1, 0xc2, 0xd7, 0x7f, 0x99, 0xf7,
-	0x19, 0xb9, 0x43, 0xd3, 0x33, 0x59, 0x31, 0xa5, 0xb9, 0x6c, 0x92, 0x8f, 0x00, 0x22, 0x11, 0x20,
-	0x19, 0xcf, 0x07, 0x37, 0x02, 0x34, 0xbe, 0x8a, 0xae, 0xb1, 0xa9, 0x81, 0xcf, 0x35, 0xf0, 0x73,
-	0x5b, 0x63, 0x3e, 0xd9, 0x1d, 0x46, 0xce, 0xef, 0xc3, 0xe8, 0x61, 0x43, 0xcb, 0xf5, 0x2c, 0xfc,
-	0x7f, 0x54, 0xf8, 0xed, 0xe7, 0x08, 0xc5, 0xf7, 0x8d, 0xe0, 0x95, 0xe1, 0x6f, 0x01, 0xc4, 0xc2,
-	0xd0, 0x2f, 0xc8, 0xbd, 0xfb, 0xd2, 0x1c, 0xe1, 0x9d, 0xa6, 0x1a, 0xbc, 0x67, 0xee, 0xed, 0x76,
-	0x89, 0x6a, 0x80, 0x82, 0x9b, 0xe3, 0xab, 0x28, 0xc0, 0x97, 0x6e, 0x82, 0xdf, 0x6f, 0xa9, 0x88,
-	0xbb, 0xc8, 0xf9, 0xad, 0xb6, 0x49, 0x6c, 0x4c, 0xde, 0xcc, 0xed, 0x89, 0x6e, 0x2d, 0xf6, 0x0f,
-	0x1e, 0x5c, 0xb6, 0x9b, 0xd5, 0x59, 0xab, 0x75, 0xcc, 0xdf, 0xec, 0x8e, 0x3e, 0xda, 0x1f, 0x7d,
-	0xf4, 0xeb, 0xe8, 0xa3, 0xaf, 0x27, 0xdf, 0xd9, 0x9f, 0x7c, 0xe7, 0xc7, 0xc9, 0x77, 0x3e, 0x3c,
-	0x2e, 0x98, 0x5e, 0xd5, 0x29, 0xce, 0x78, 0x49, 0x6c, 0xde, 0x64, 0x4d, 0x53, 0x75, 0x1e, 0xc8,
-	0x26, 0x8a, 0xc8, 0x27, 0xf3, 0x12, 0x74, 0x23, 0x40, 0xa5, 0xbd, 0x6e, 0x63, 0x4f, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x09, 0xe7, 0x14, 0x43, 0x76, 0x02, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0xcd, 0xde, 0x1d, 0x41, 0xec, 0x21, 0x0a, 0x2b, 0x82, 0x5c, 0x38, 0x39, 0xc6, 0x05, 0x44,
+	0x42, 0xe7, 0x25, 0x81, 0xea, 0x44, 0x65, 0x81, 0xe0, 0xa0, 0x89, 0x0c, 0x15, 0x8d, 0xb5, 0x8e,
+	0xf7, 0x9c, 0x15, 0x8e, 0x67, 0xb5, 0xbb, 0xb9, 0x23, 0x1f, 0x80, 0x44, 0x49, 0xc9, 0x37, 0xf0,
+	0x25, 0x57, 0x9e, 0xa8, 0xa8, 0x02, 0x4a, 0xfe, 0x20, 0xfc, 0x00, 0xf2, 0xee, 0x06, 0x21, 0x94,
+	0x40, 0x4b, 0xb7, 0xa3, 0xf7, 0xe6, 0xcd, 0xf3, 0x1b, 0x0f, 0x0e, 0x41, 0x4d, 0x40, 0x71, 0x45,
+	0xf4, 0x39, 0x15, 0xe4, 0xac, 0x9f, 0x31, 0x4d, 0xfb, 0xa4, 0x60, 0x15, 0x53, 0x5c, 0x45, 0x42,
+	0x82, 0x06, 0xaf, 0xe5, 0x38, 0x51, 0xcd, 0x89, 0x1c, 0xa7, 0xd3, 0x2a, 0xa0, 0x00, 0x43, 0x20,
+	0xf5, 0xcb, 0x72, 0x3b, 0x77, 0x37, 0xea, 0xd5, 0x45, 0x2a, 0xd9, 0x08, 0x64, 0xee, 0x78, 0x07,
+	0x05, 0x40, 0x51, 0x32, 0x62, 0xaa, 0x6c, 0x7a, 0x4a, 0x68, 0x35, 0x5b, 0x43, 0x23, 0xa3, 0x91,
+	0x5a, 0x6d, 0x5b, 0x38, 0xc8, 0xff, 0xb3, 0x2b, 0x9f, 0x4a, 0xaa, 0x39, 0x54, 0x16, 0x0f, 0x7f,
+	0xec, 0xe0, 0xe6, 0x90, 0x4a, 0x3a, 0x51, 0xde, 0x23, 0x7c, 0x53, 0xc8, 0x69, 0xc5, 0x52, 0x26,
+	0x60, 0x34, 0x4e, 0x79, 0xce, 0x2a, 0xcd, 0x4f, 0x39, 0x93, 0x6d, 0x14, 0xa0, 0xde, 0xb5, 0xa4,
+	0x65, 0xd0, 0xa7, 0x35, 0x78, 0xf2, 0x0b, 0xf3, 0xde, 0x23, 0xdc, 0xb1, 0x3e, 0xd3, 0x31, 0x57,
+	0x1a, 0xe4, 0x2c, 0x7d, 0xcb, 0x98, 0x48, 0x05, 0x93, 0x1c, 0xf2, 0xf6, 0x4e, 0x80, 0x7a, 0xfb,
+	0x83, 0x83, 0xc8, 0xda, 0x88, 0xd6, 0x36, 0xa2, 0x27, 0xce, 0x46, 0x7c, 0x74, 0x31, 0xef, 0x36,
+	0x56, 0xf3, 0xee, 0x9d, 0x19, 0x9d, 0x94, 0xc7, 0xe1, 0x76, 0xa9, 0xf0, 0xd3, 0xb7, 0x2e, 0x4a,
+	0x6e, 0x59, 0xc2, 0x73, 0x8b, 0xbf, 0x64, 0x4c, 0x0c, 0x0d, 0xea, 0x7d, 0x46, 0x38, 0x10, 0x00,
+	0x65, 0xba, 0x5d, 0x41, 0xb5, 0x77, 0x83, 0xdd, 0xde, 0xfe, 0xa0, 0x1f, 0x6d, 0x5a, 0x4f, 0x34,
+	0x04, 0x28, 0x93, 0xcd, 0xea, 0x31, 0x71, 0x2e, 0xef, 0x59, 0x97, 0xff, 0x1a, 0x14, 0x26, 0x87,
+	0x62, 0xbb, 0x9a, 0x0a, 0xbf, 0x20, 0x7c, 0xfb, 0x2f, 0xe3, 0xbc, 0xfb, 0xf8, 0xaa, 0x19, 0xc1,
+	0x73, 0x93, 0xfd, 0x5e, 0xec, 0xad, 0xe6, 0xdd, 0x1b, 0xbf, 0xcd, 0xe6, 0x79, 0x98, 0x34, 0xeb,
+	0xd7, 0x49, 0xfe, 0xbf, 0x6c, 0x20, 0xfc, 0x80, 0xf0, 0xf5, 0x67, 0xf6, 0x0c, 0x5e, 0x69, 0xaa,
+	0x99, 0xf7, 0x18, 0x5f, 0xa9, 0x03, 0x56, 0x6d, 0x64, 0x62, 0x0f, 0x36, 0xc7, 0xfe, 0xfa, 0x9c,
+	0x0a, 0x9b, 0x43, 0xbc, 0x57, 0x3b, 0x49, 0x6c, 0x93, 0x77, 0x8c, 0x9b, 0xc2, 0xfc, 0x98, 0xee,
+	0x0b, 0x0e, 0xb7, 0x6c, 0xcd, 0x70, 0x5c, 0xab, 0xeb, 0x88, 0x5f, 0x5c, 0x2c, 0x7c, 0x74, 0xb9,
+	0xf0, 0xd1, 0xf7, 0x85, 0x8f, 0x3e, 0x2e, 0xfd, 0xc6, 0xe5, 0xd2, 0x6f, 0x7c, 0x5d, 0xfa, 0x8d,
+	0x37, 0x0f, 0x0a, 0xae, 0xc7, 0xd3, 0x2c, 0x1a, 0xc1, 0x84, 0x38, 0xbd, 0xa3, 0x92, 0x66, 0x6a,
+	0x5d, 0x90, 0xb3, 0xc1, 0x80, 0xbc, 0xb3, 0xb7, 0xa8, 0x67, 0x82, 0xa9, 0xac, 0x69, 0x12, 0x7b,
+	0xf8, 0x73, 0x00, 0xb2, 0x7a, 0xfd, 0xeb, 0xf8, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +266,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for iNdEx := len(m.PoolRecordHistoryKeepPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRecordHistoryKeepPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -213,6 +298,42 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolRecordHistoryKeepPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecordHistoryKeepPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -283,6 +404,26 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRecordHistoryKeepPeriods) > 0 {
+		for _, e := range m.PoolRecordHistoryKeepPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolRecordHistoryKeepPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -403,6 +544,142 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecordHistoryKeepPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecordHistoryKeepPeriods = append(m.PoolRecordHistoryKeepPeriods, PoolRecordHistoryKeepPeriod{})
+			if err := m.PoolRecordHistoryKeepPeriods[len(m.PoolRecordHistoryKeepPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecordHistoryKeepPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecordHistoryKeepPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -140,6 +140,32 @@ func TestGenesisState_Validate(t *testing.T) {
 
 			expectedErr: true,
 		},
+		"valid pool record history keep periods": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 7 * 24 * time.Hour}, PoolRecordHistoryKeepPeriod{PoolId: 2, RecordHistoryKeepPeriod: time.Hour}),
+				[]TwapRecord{baseRecord}),
+		},
+		"invalid pool record history keep periods: zero pool id": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 0, RecordHistoryKeepPeriod: time.Hour}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid pool record history keep periods: duplicate pool id": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: time.Hour}, PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: 2 * time.Hour}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
+		"invalid pool record history keep periods: negative duration": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordHistoryKeepPeriods(basicParams, PoolRecordHistoryKeepPeriod{PoolId: 1, RecordHistoryKeepPeriod: -time.Hour}),
+				[]TwapRecord{baseRecord}),
+
+			expectedErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -160,6 +186,11 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 }
 
+func withPoolRecordHistoryKeepPeriods(params Params, poolKeepPeriods ...PoolRecordHistoryKeepPeriod) Params {
+	params.PoolRecordHistoryKeepPeriods = poolKeepPeriods
+	return params
+}
+
 func TestTWAPRecord_Validate(t *testing.T) {
 	type testcase struct {
 		twapRecord  TwapRecord
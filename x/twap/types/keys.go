@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	time "time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +31,7 @@ var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	poolLiquidityNoSeparator           = "pool_liquidity"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -44,6 +47,9 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id | denom
+	// made for getting the liquidity of a pool at the end of the last block it changed in
+	poolLiquidityPrefix = poolLiquidityNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -52,6 +58,12 @@ func FormatKeyPoolTwapRecords(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", HistoricalTWAPPoolIndexPrefix, poolId))
 }
 
+// FormatPoolLiquidityPrefix returns the prefix of the liquidity of the pool stored at the end of
+// the last block it changed in, followed by the denom of each coin.
+func FormatPoolLiquidityPrefix(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d%s", poolLiquidityPrefix, poolId, KeySeparator))
+}
+
 func FormatMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	poolIdS := osmoutils.FormatFixedLengthU64(poolId)
 	return []byte(fmt.Sprintf("%s%s%s%s%s%s", mostRecentTWAPsPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
@@ -92,6 +104,36 @@ func GetAllMostRecentTwapsForPool(store sdk.KVStore, poolId uint64) ([]TwapRecor
 	return osmoutils.GatherValuesFromStore(store, []byte(startPrefix), []byte(endPrefix), ParseTwapFromBz)
 }
 
+// GetAllMostRecentTwaps returns the most recent twap records of every pool.
+func GetAllMostRecentTwaps(store sdk.KVStore) ([]TwapRecord, error) {
+	return osmoutils.GatherValuesFromStorePrefix(store, []byte(mostRecentTWAPsPrefix), ParseTwapFromBz)
+}
+
+// GetPoolIdsWithMostRecentTwapsForDenomPair returns the ids of all the pools with a most recent twap record
+// for the lexicographically ordered denom pair, in increasing order.
+func GetPoolIdsWithMostRecentTwapsForDenomPair(store sdk.KVStore, denom0, denom1 string) ([]uint64, error) {
+	iter := sdk.KVStorePrefixIterator(store, []byte(mostRecentTWAPsPrefix))
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		// format is pool id | denom1 | denom2
+		keyParts := strings.Split(string(iter.Key()[len(mostRecentTWAPsPrefix):]), KeySeparator)
+		if len(keyParts) != 3 {
+			return nil, fmt.Errorf("invalid most recent twap key %s", iter.Key())
+		}
+		if keyParts[1] != denom0 || keyParts[2] != denom1 {
+			continue
+		}
+		poolId, err := strconv.ParseUint(keyParts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	return poolIds, nil
+}
+
 func ParseTwapFromBz(bz []byte) (twap TwapRecord, err error) {
 	if len(bz) == 0 {
 		return TwapRecord{}, errors.New("twap not found")
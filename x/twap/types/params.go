@@ -11,8 +11,9 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
-	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPruneEpochIdentifier         = []byte("PruneEpochIdentifier")
+	KeyRecordHistoryKeepPeriod      = []byte("RecordHistoryKeepPeriod")
+	KeyPoolRecordHistoryKeepPeriods = []byte("PoolRecordHistoryKeepPeriods")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -37,8 +38,9 @@ func NewParams(pruneEpochIdentifier string, recordHistoryKeepPeriod time.Duratio
 // default twap module parameters.
 func DefaultParams() Params {
 	return Params{
-		PruneEpochIdentifier:    defaultPruneEpochIdentifier,
-		RecordHistoryKeepPeriod: defaultRecordHistoryKeepPeriod,
+		PruneEpochIdentifier:         defaultPruneEpochIdentifier,
+		RecordHistoryKeepPeriod:      defaultRecordHistoryKeepPeriod,
+		PoolRecordHistoryKeepPeriods: []PoolRecordHistoryKeepPeriod{},
 	}
 }
 
@@ -52,6 +54,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePoolRecordHistoryKeepPeriods(p.PoolRecordHistoryKeepPeriods); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +66,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyPoolRecordHistoryKeepPeriods, &p.PoolRecordHistoryKeepPeriods, validatePoolRecordHistoryKeepPeriods),
 	}
 }
 
@@ -75,3 +82,27 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validatePoolRecordHistoryKeepPeriods(i interface{}) error {
+	v, ok := i.([]PoolRecordHistoryKeepPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenPoolIds := map[uint64]struct{}{}
+	for _, poolKeepPeriod := range v {
+		if poolKeepPeriod.PoolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if _, seen := seenPoolIds[poolKeepPeriod.PoolId]; seen {
+			return fmt.Errorf("duplicate record history keep period for pool %d", poolKeepPeriod.PoolId)
+		}
+		seenPoolIds[poolKeepPeriod.PoolId] = struct{}{}
+
+		if err := validatePeriod(poolKeepPeriod.RecordHistoryKeepPeriod); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
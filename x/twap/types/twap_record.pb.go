@@ -60,6 +60,11 @@ type TwapRecord struct {
 	// It is used to alert the caller if they are getting a potentially erroneous
 	// TWAP, due to an unforeseen underlying error.
 	LastErrorTime time.Time `protobuf:"bytes,11,opt,name=last_error_time,json=lastErrorTime,proto3,stdtime" json:"last_error_time" yaml:"last_error_time"`
+	// Accumulator of the squared base 2 logarithm of p0, alongside the
+	// geometric twap accumulator of its logarithm, from which the variance of
+	// the logarithm of the price over a time range is computed.
+	// Unset for records stored before it was tracked.
+	LogPriceSquaredAccumulator *cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=log_price_squared_accumulator,json=logPriceSquaredAccumulator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"log_price_squared_accumulator,omitempty"`
 }
 
 func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
@@ -146,41 +151,43 @@ func init() {
 }
 
 var fileDescriptor_dbf5c78678e601aa = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
+	// 574 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0x5a, 0x52, 0xba, 0xa1, 0xaa, 0x64, 0x45, 0x60, 0x52, 0xc9, 0x0e, 0x41, 0x42,
-	0xe1, 0x80, 0x3f, 0xc2, 0x8d, 0x5b, 0xa2, 0x72, 0x00, 0x55, 0xa8, 0x32, 0x3d, 0x71, 0xb1, 0xd6,
-	0xce, 0xd6, 0xb6, 0xb0, 0x33, 0xab, 0xdd, 0x4d, 0x4b, 0xde, 0xa2, 0xaf, 0xc4, 0xad, 0xc7, 0x1e,
-	0x11, 0x07, 0x83, 0x92, 0x1b, 0xc7, 0x3e, 0x01, 0xda, 0x5d, 0x27, 0x90, 0xf0, 0xd5, 0xde, 0x3c,
-	0x33, 0xff, 0xf9, 0xfd, 0x77, 0xbc, 0xa3, 0x45, 0x4f, 0x81, 0x97, 0xc0, 0x73, 0xee, 0x89, 0x73,
-	0x4c, 0xbd, 0xb3, 0x20, 0x26, 0x02, 0x07, 0x2a, 0x88, 0x18, 0x49, 0x80, 0x8d, 0x5d, 0xca, 0x40,
-	0x80, 0xd9, 0xae, 0x75, 0xae, 0x2c, 0xb9, 0xb5, 0xae, 0xd3, 0x4e, 0x21, 0x05, 0x25, 0xf0, 0xe4,
-	0x97, 0xd6, 0x76, 0x1e, 0xa5, 0x00, 0x69, 0x41, 0x3c, 0x15, 0xc5, 0xd3, 0x53, 0x0f, 0x4f, 0x66,
-	0xcb, 0x52, 0xa2, 0x38, 0x91, 0xee, 0xd1, 0x41, 0x5d, 0xb2, 0x75, 0xe4, 0xc5, 0x98, 0x93, 0xd5,
-	0x41, 0x12, 0xc8, 0x27, 0x75, 0xdd, 0xd9, 0xa4, 0x8a, 0xbc, 0x24, 0x5c, 0xe0, 0x92, 0x6a, 0x41,
-	0xef, 0x53, 0x13, 0xa1, 0x93, 0x73, 0x4c, 0x43, 0x75, 0x6e, 0xf3, 0x21, 0xda, 0xa1, 0x00, 0x45,
-	0x94, 0x8f, 0x2d, 0xa3, 0x6b, 0xf4, 0xb7, 0xc3, 0xa6, 0x0c, 0x5f, 0x8f, 0xcd, 0xc7, 0xe8, 0x3e,
-	0xe6, 0x9c, 0x08, 0x3f, 0x1a, 0x93, 0x09, 0x94, 0xd6, 0x9d, 0xae, 0xd1, 0xdf, 0x0d, 0x5b, 0x3a,
-	0x77, 0x28, 0x53, 0x2b, 0x49, 0x50, 0x4b, 0xb6, 0x7e, 0x91, 0x04, 0x5a, 0x32, 0x44, 0xcd, 0x8c,
-	0xe4, 0x69, 0x26, 0xac, 0xed, 0xae, 0xd1, 0xdf, 0x1a, 0x3d, 0xfb, 0x5e, 0x39, 0x7b, 0xfa, 0x97,
-	0x45, 0xba, 0x70, 0x5d, 0x39, 0xed, 0x19, 0x2e, 0x8b, 0x97, 0xbd, 0xb5, 0x74, 0x2f, 0xac, 0x1b,
-	0xcd, 0xb7, 0x68, 0x5b, 0xce, 0x60, 0xdd, 0xed, 0x1a, 0xfd, 0xd6, 0xa0, 0xe3, 0xea, 0x01, 0xdd,
-	0xe5, 0x80, 0xee, 0xc9, 0x72, 0xc0, 0x91, 0x7d, 0x59, 0x39, 0x8d, 0xeb, 0xca, 0x31, 0xd7, 0x78,
-	0xb2, 0xb9, 0x77, 0xf1, 0xd5, 0x31, 0x42, 0xc5, 0x31, 0x8f, 0x91, 0x49, 0xfd, 0xa8, 0xc0, 0x5c,
-	0x44, 0x9c, 0x82, 0x88, 0x28, 0xcb, 0x13, 0x62, 0x35, 0xe5, 0xd9, 0x47, 0x4f, 0x24, 0xe1, 0x4b,
-	0xe5, 0x1c, 0xe8, 0xbf, 0xcc, 0xc7, 0x1f, 0xdc, 0x1c, 0xbc, 0x12, 0x8b, 0xcc, 0x3d, 0x22, 0x29,
-	0x4e, 0x66, 0x87, 0x24, 0x09, 0xf7, 0xa9, 0x7f, 0x84, 0xb9, 0x78, 0x47, 0x41, 0x1c, 0xcb, 0x5e,
-	0x45, 0x0c, 0x7e, 0x23, 0xee, 0xdc, 0x86, 0x18, 0xac, 0x13, 0x33, 0x64, 0x53, 0x3f, 0xc2, 0x2c,
-	0x17, 0x59, 0x49, 0x44, 0x9e, 0x44, 0x6a, 0xd5, 0x70, 0x92, 0x4c, 0xcb, 0x69, 0x81, 0x05, 0x30,
-	0xeb, 0xde, 0xcd, 0xe9, 0x07, 0xd4, 0x1f, 0xae, 0x48, 0xf2, 0xea, 0x87, 0x3f, 0x39, 0xca, 0x29,
-	0xf8, 0xa7, 0xd3, 0xee, 0x6d, 0x9c, 0x82, 0xbf, 0x3b, 0x61, 0xd4, 0x49, 0x09, 0x94, 0x44, 0xb0,
-	0x3f, 0xb9, 0xa0, 0x9b, 0xbb, 0x58, 0x2b, 0xcc, 0xa6, 0xc5, 0x29, 0xda, 0x57, 0xb7, 0x40, 0x18,
-	0x03, 0xa6, 0x2e, 0xde, 0x6a, 0xfd, 0x77, 0x6b, 0x7a, 0xf5, 0xd6, 0x3c, 0xd0, 0x5b, 0xb3, 0x01,
-	0xd0, 0x9b, 0xb3, 0x27, 0xb3, 0xaf, 0x64, 0x52, 0xf6, 0x8d, 0xde, 0x5c, 0xce, 0x6d, 0xe3, 0x6a,
-	0x6e, 0x1b, 0xdf, 0xe6, 0xb6, 0x71, 0xb1, 0xb0, 0x1b, 0x57, 0x0b, 0xbb, 0xf1, 0x79, 0x61, 0x37,
-	0xde, 0xfb, 0x69, 0x2e, 0xb2, 0x69, 0xec, 0x26, 0x50, 0x7a, 0xf5, 0x5b, 0xf0, 0xbc, 0xc0, 0x31,
-	0x5f, 0x06, 0xde, 0xd9, 0x60, 0xe0, 0x7d, 0xd4, 0xcf, 0x88, 0x98, 0x51, 0xc2, 0xe3, 0xa6, 0x3a,
-	0xd2, 0x8b, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0x76, 0x09, 0x8f, 0x63, 0x04, 0x00, 0x00,
+	0x10, 0xc7, 0x63, 0x5a, 0x52, 0xba, 0x69, 0x55, 0xc9, 0x8a, 0xc0, 0xa4, 0xc2, 0x0e, 0x41, 0x42,
+	0xe1, 0x80, 0x3f, 0xc2, 0x8d, 0x5b, 0xa2, 0x72, 0x00, 0x55, 0xa8, 0x72, 0x7b, 0xe2, 0x62, 0xad,
+	0xed, 0x8d, 0x6d, 0x61, 0x67, 0x16, 0xef, 0xa6, 0x25, 0x6f, 0xd1, 0xd7, 0xe0, 0x4d, 0x7a, 0xec,
+	0x11, 0x71, 0x08, 0x28, 0xb9, 0x71, 0xec, 0x13, 0xa0, 0xdd, 0x75, 0x42, 0x13, 0x3e, 0xda, 0xde,
+	0x32, 0x33, 0xff, 0xf9, 0xcd, 0xfe, 0x33, 0x23, 0xa3, 0xe7, 0xc0, 0x0a, 0x60, 0x19, 0x73, 0xf8,
+	0x19, 0xa6, 0xce, 0xa9, 0x17, 0x12, 0x8e, 0x3d, 0x19, 0x04, 0x25, 0x89, 0xa0, 0x8c, 0x6d, 0x5a,
+	0x02, 0x07, 0xbd, 0x59, 0xe9, 0x6c, 0x51, 0xb2, 0x2b, 0x5d, 0xab, 0x99, 0x40, 0x02, 0x52, 0xe0,
+	0x88, 0x5f, 0x4a, 0xdb, 0x7a, 0x9c, 0x00, 0x24, 0x39, 0x71, 0x64, 0x14, 0x8e, 0x87, 0x0e, 0x1e,
+	0x4d, 0x16, 0xa5, 0x48, 0x72, 0x02, 0xd5, 0xa3, 0x82, 0xaa, 0x64, 0xaa, 0xc8, 0x09, 0x31, 0x23,
+	0xcb, 0x87, 0x44, 0x90, 0x8d, 0xaa, 0xba, 0xb5, 0x4e, 0xe5, 0x59, 0x41, 0x18, 0xc7, 0x05, 0x55,
+	0x82, 0xce, 0x97, 0x2d, 0x84, 0x4e, 0xce, 0x30, 0xf5, 0xe5, 0xbb, 0xf5, 0x47, 0x68, 0x8b, 0x02,
+	0xe4, 0x41, 0x16, 0x1b, 0x5a, 0x5b, 0xeb, 0x6e, 0xfa, 0x75, 0x11, 0xbe, 0x8d, 0xf5, 0xa7, 0x68,
+	0x07, 0x33, 0x46, 0xb8, 0x1b, 0xc4, 0x64, 0x04, 0x85, 0x71, 0xaf, 0xad, 0x75, 0xb7, 0xfd, 0x86,
+	0xca, 0x1d, 0x88, 0xd4, 0x52, 0xe2, 0x55, 0x92, 0x8d, 0x6b, 0x12, 0x4f, 0x49, 0xfa, 0xa8, 0x9e,
+	0x92, 0x2c, 0x49, 0xb9, 0xb1, 0xd9, 0xd6, 0xba, 0x1b, 0x83, 0x17, 0x3f, 0xa7, 0xd6, 0xae, 0xfa,
+	0xcb, 0x02, 0x55, 0xb8, 0x9a, 0x5a, 0xcd, 0x09, 0x2e, 0xf2, 0xd7, 0x9d, 0x95, 0x74, 0xc7, 0xaf,
+	0x1a, 0xf5, 0xf7, 0x68, 0x53, 0x78, 0x30, 0xee, 0xb7, 0xb5, 0x6e, 0xa3, 0xd7, 0xb2, 0x95, 0x41,
+	0x7b, 0x61, 0xd0, 0x3e, 0x59, 0x18, 0x1c, 0x98, 0x17, 0x53, 0xab, 0x76, 0x35, 0xb5, 0xf4, 0x15,
+	0x9e, 0x68, 0xee, 0x9c, 0x7f, 0xb7, 0x34, 0x5f, 0x72, 0xf4, 0x23, 0xa4, 0x53, 0x37, 0xc8, 0x31,
+	0xe3, 0x01, 0xa3, 0xc0, 0x03, 0x5a, 0x66, 0x11, 0x31, 0xea, 0xe2, 0xed, 0x83, 0x67, 0x82, 0xf0,
+	0x6d, 0x6a, 0xed, 0xab, 0x7f, 0x99, 0xc5, 0x1f, 0xed, 0x0c, 0x9c, 0x02, 0xf3, 0xd4, 0x3e, 0x24,
+	0x09, 0x8e, 0x26, 0x07, 0x24, 0xf2, 0xf7, 0xa8, 0x7b, 0x88, 0x19, 0x3f, 0xa6, 0xc0, 0x8f, 0x44,
+	0xaf, 0x24, 0x7a, 0x7f, 0x10, 0xb7, 0xee, 0x42, 0xf4, 0x56, 0x89, 0x29, 0x32, 0xa9, 0x1b, 0xe0,
+	0x32, 0xe3, 0x69, 0x41, 0x78, 0x16, 0x05, 0xf2, 0xd4, 0x70, 0x14, 0x8d, 0x8b, 0x71, 0x8e, 0x39,
+	0x94, 0xc6, 0x83, 0xdb, 0xd3, 0xf7, 0xa9, 0xdb, 0x5f, 0x92, 0xc4, 0xea, 0xfb, 0xbf, 0x39, 0x72,
+	0x92, 0xf7, 0xdf, 0x49, 0xdb, 0x77, 0x99, 0xe4, 0xfd, 0x7b, 0x12, 0x46, 0xad, 0x84, 0x40, 0x41,
+	0x78, 0xf9, 0xb7, 0x29, 0xe8, 0xf6, 0x53, 0x8c, 0x25, 0x66, 0x7d, 0xc4, 0x10, 0xed, 0xc9, 0x2d,
+	0x90, 0xb2, 0x84, 0x52, 0x2e, 0xde, 0x68, 0xdc, 0x78, 0x35, 0x9d, 0xea, 0x6a, 0x1e, 0xaa, 0xab,
+	0x59, 0x03, 0xa8, 0xcb, 0xd9, 0x15, 0xd9, 0x37, 0x22, 0x29, 0xfa, 0xf4, 0x21, 0x7a, 0x92, 0x43,
+	0xa2, 0xf6, 0x1c, 0xb0, 0x4f, 0x63, 0x5c, 0x92, 0x78, 0xc5, 0xcd, 0xce, 0xd2, 0x8d, 0x76, 0x93,
+	0x9b, 0x56, 0x0e, 0x89, 0x5c, 0xfa, 0xb1, 0xe2, 0x5c, 0xf3, 0x33, 0x78, 0x77, 0x31, 0x33, 0xb5,
+	0xcb, 0x99, 0xa9, 0xfd, 0x98, 0x99, 0xda, 0xf9, 0xdc, 0xac, 0x5d, 0xce, 0xcd, 0xda, 0xd7, 0xb9,
+	0x59, 0xfb, 0xe0, 0x26, 0x19, 0x4f, 0xc7, 0xa1, 0x1d, 0x41, 0xe1, 0x54, 0xdf, 0x9c, 0x97, 0x39,
+	0x0e, 0xd9, 0x22, 0x70, 0x4e, 0x7b, 0x3d, 0xe7, 0xb3, 0xfa, 0x5c, 0xf1, 0x09, 0x25, 0x2c, 0xac,
+	0x4b, 0xeb, 0xaf, 0x7e, 0x0d, 0x00, 0xba, 0xb7, 0x3e, 0x48, 0xcb, 0x04, 0x00, 0x00,
 }
 
 func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
@@ -203,6 +210,18 @@ func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LogPriceSquaredAccumulator != nil {
+		{
+			size := m.LogPriceSquaredAccumulator.Size()
+			i -= size
+			if _, err := m.LogPriceSquaredAccumulator.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastErrorTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime):])
 	if err1 != nil {
 		return 0, err1
@@ -341,6 +360,10 @@ func (m *TwapRecord) Size() (n int) {
 	n += 1 + l + sovTwapRecord(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastErrorTime)
 	n += 1 + l + sovTwapRecord(uint64(l))
+	if m.LogPriceSquaredAccumulator != nil {
+		l = m.LogPriceSquaredAccumulator.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
 	return n
 }
 
@@ -717,6 +740,42 @@ func (m *TwapRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogPriceSquaredAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LogPriceSquaredAccumulator = &v
+			if err := m.LogPriceSquaredAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTwapRecord(dAtA[iNdEx:])
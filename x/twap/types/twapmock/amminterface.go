@@ -85,3 +85,7 @@ func (p *ProgrammedPoolManagerInterface) RouteCalculateSpotPrice(ctx sdk.Context
 	}
 	return p.underlyingKeeper.RouteCalculateSpotPrice(ctx, poolId, quoteDenom, baseDenom)
 }
+
+func (p *ProgrammedPoolManagerInterface) GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	return p.underlyingKeeper.GetTotalPoolLiquidity(ctx, poolId)
+}
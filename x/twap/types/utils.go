@@ -11,6 +11,8 @@ import (
 var (
 	MaxSpotPrice       = osmomath.NewDec(2).Power(128).Sub(osmomath.OneDec())
 	MaxSpotPriceBigDec = osmomath.BigDecFromDec(MaxSpotPrice)
+	// LnTwo converts base 2 logarithms to natural logarithms.
+	LnTwo = osmomath.NewBigDec(2).Ln().Dec()
 )
 
 // GetAllUniqueDenomPairs returns all unique pairs of denoms, where for every pair
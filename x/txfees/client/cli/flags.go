@@ -0,0 +1,17 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// FlagPercentiles is the comma separated percentiles of the base fees to compute.
+	FlagPercentiles = "percentiles"
+)
+
+// FlagSetPercentiles returns the flag set for the base fee history percentiles.
+func FlagSetPercentiles() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPercentiles, "", "Comma separated percentiles between 0 and 100 of the base fees to compute, e.g. 10,50,90")
+	return fs
+}
@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v22/x/txfees/types"
@@ -18,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 	)
 
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryBaseFee)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryBaseFeeHistory)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, GetCmdQueryEipParams)
 
 	return cmd
 }
@@ -64,3 +70,46 @@ func GetCmdQueryBaseFee() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeReque
 		QueryFnName: "GetEipBaseFee",
 	}, &types.QueryEipBaseFeeRequest{}
 }
+
+func GetCmdQueryBaseFeeHistory() (*osmocli.QueryDescriptor, *types.QueryEipBaseFeeHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "base-fee-history [block-count]",
+		Short: "Query the eip base fee history of the latest blocks. Zero block count returns all blocks kept by the node.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} base-fee-history 20 --percentiles 10,50,90`,
+		QueryFnName: "GetEipBaseFeeHistory",
+		Flags:       osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetPercentiles()}},
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
+			"Percentiles": osmocli.FlagOnlyParser(parsePercentiles),
+		},
+	}, &types.QueryEipBaseFeeHistoryRequest{}
+}
+
+func GetCmdQueryEipParams() (*osmocli.QueryDescriptor, *types.QueryEipParamsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "eip-params",
+		Short: "Query the eip fee market parameters in effect on the node.",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} eip-params`,
+		QueryFnName: "GetEipParams",
+	}, &types.QueryEipParamsRequest{}
+}
+
+// parsePercentiles parses the comma separated percentiles flag.
+func parsePercentiles(fs *flag.FlagSet) ([]uint32, error) {
+	percentilesStr, err := fs.GetString(FlagPercentiles)
+	if err != nil || percentilesStr == "" {
+		return []uint32{}, err
+	}
+
+	percentiles := []uint32{}
+	for _, percentileStr := range strings.Split(percentilesStr, ",") {
+		percentile, err := strconv.ParseUint(strings.TrimSpace(percentileStr), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		percentiles = append(percentiles, uint32(percentile))
+	}
+
+	return percentiles, nil
+}
@@ -76,6 +76,18 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryFeeTokensRequest{},
 			&types.QueryFeeTokensResponse{},
 		},
+		{
+			"Query eip base fee history",
+			"/osmosis.txfees.v1beta1.Query/GetEipBaseFeeHistory",
+			&types.QueryEipBaseFeeHistoryRequest{BlockCount: 10, Percentiles: []uint32{50}},
+			&types.QueryEipBaseFeeHistoryResponse{},
+		},
+		{
+			"Query eip params",
+			"/osmosis.txfees.v1beta1.Query/GetEipParams",
+			&types.QueryEipParamsRequest{},
+			&types.QueryEipParamsResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
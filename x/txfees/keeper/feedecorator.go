@@ -27,9 +27,14 @@ type MempoolFeeDecorator struct {
 	Opts         types.MempoolFeeOptions
 }
 
-func NewMempoolFeeDecorator(txFeesKeeper Keeper, opts types.MempoolFeeOptions) MempoolFeeDecorator {
+// NewMempoolFeeDecorator returns a new MempoolFeeDecorator.
+// If the EIP-1559 fee market is enabled, the given parameters are applied to it.
+func NewMempoolFeeDecorator(txFeesKeeper Keeper, opts types.MempoolFeeOptions, eipParams mempool1559.Params) MempoolFeeDecorator {
 	if opts.Mempool1559Enabled {
 		mempool1559.CurEipState.BackupFilePath = filepath.Join(txFeesKeeper.dataDir, mempool1559.BackupFilename)
+		if err := mempool1559.SetParams(eipParams); err != nil {
+			panic(err)
+		}
 	}
 
 	return MempoolFeeDecorator{
@@ -90,3 +90,48 @@ func (q Querier) GetEipBaseFee(_ context.Context, _ *types.QueryEipBaseFeeReques
 	response := mempool1559.CurEipState.GetCurBaseFee()
 	return &types.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
+
+func (q Querier) GetEipBaseFeeHistory(_ context.Context, req *types.QueryEipBaseFeeHistoryRequest) (*types.QueryEipBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	for _, percentile := range req.Percentiles {
+		if percentile > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "percentile %d must be between 0 and 100", percentile)
+		}
+	}
+
+	history := mempool1559.CurEipState.GetBaseFeeHistory(int(req.BlockCount))
+
+	entries := make([]types.EipBaseFeeHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = types.EipBaseFeeHistoryEntry{
+			Height:     entry.Height,
+			BaseFee:    entry.BaseFee,
+			GasWanted:  entry.GasWanted,
+			ChangeRate: entry.ChangeRate,
+		}
+	}
+
+	return &types.QueryEipBaseFeeHistoryResponse{
+		Entries:            entries,
+		PercentileBaseFees: mempool1559.GetBaseFeePercentiles(history, req.Percentiles),
+	}, nil
+}
+
+func (q Querier) GetEipParams(_ context.Context, _ *types.QueryEipParamsRequest) (*types.QueryEipParamsResponse, error) {
+	params := mempool1559.GetParams()
+	return &types.QueryEipParamsResponse{
+		Enabled:                    types.GlobalMempool1559Enabled,
+		DefaultBaseFee:             params.DefaultBaseFee,
+		MinBaseFee:                 params.MinBaseFee,
+		MaxBaseFee:                 params.MaxBaseFee,
+		ResetInterval:              params.ResetInterval,
+		MaxBlockChangeRate:         params.MaxBlockChangeRate,
+		TargetBlockSpacePercent:    params.TargetBlockSpacePercent,
+		TargetGas:                  mempool1559.TargetGas,
+		RecheckFeeLowBaseFee:       params.RecheckFeeLowBaseFee,
+		RecheckFeeHighBaseFee:      params.RecheckFeeHighBaseFee,
+		RecheckFeeBaseFeeThreshold: params.RecheckFeeBaseFeeThreshold,
+	}, nil
+}
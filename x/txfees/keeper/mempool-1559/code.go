@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,10 +35,18 @@ import (
    - MaxBlockChangeRate: The maximum block change rate, initialized to 1/10.
 
    Global constants:
-   - TargetGas: Gas wanted per block, initialized to .625 * block_gas_limt = 187.5 million.
+   - TargetGas: Gas wanted per block, initialized to .625 * block_gas_limt = 187.5 million,
+     and derived from the block gas limit whenever it changes unless configured.
    - ResetInterval: The interval at which eipState is reset, initialized to 6000 blocks.
    - BackupFile: File for backup, set to "eip1559state.json".
    - RecheckFeeConstant: A constant value for rechecking fees, initialized to 2.25.
+
+   The global variables and the recheck fee constants are the defaults of Params, which can be overridden
+   in the osmosis-mempool section of app.toml and are applied on startup with SetParams.
+
+   The base fee, gas wanted and change rate of the latest BaseFeeHistorySize blocks are kept
+   in a ring buffer that is exposed over gRPC for fee estimation. Every block appends its entry to
+   the history backup file, "eip1559history.jsonl", next to the backup file.
 */
 
 var (
@@ -88,13 +97,14 @@ const (
 	BackupFilename = "eip1559state.json"
 )
 
-// EipState tracks the current base fee and totalGasWantedThisBlock
+// EipState tracks the current base fee, totalGasWantedThisBlock and the base fee history
 // this structure is never written to state
 type EipState struct {
 	lastBlockHeight         int64
 	totalGasWantedThisBlock int64
 	BackupFilePath          string
-	CurBaseFee              osmomath.Dec `json:"cur_base_fee"`
+	CurBaseFee              osmomath.Dec    `json:"cur_base_fee"`
+	BaseFeeHistory          *BaseFeeHistory `json:"-"`
 }
 
 // CurEipState is a global variable used in the BeginBlock, EndBlock and
@@ -105,6 +115,7 @@ var CurEipState = EipState{
 	totalGasWantedThisBlock: 0,
 	BackupFilePath:          "",
 	CurBaseFee:              sdk.NewDec(0),
+	BaseFeeHistory:          NewBaseFeeHistory(BaseFeeHistorySize),
 }
 
 // startBlock is executed at the start of each block and is responsible for resetting the state
@@ -113,11 +124,7 @@ func (e *EipState) startBlock(height int64) {
 	e.lastBlockHeight = height
 	e.totalGasWantedThisBlock = 0
 
-	if e.CurBaseFee.Equal(sdk.NewDec(0)) {
-		// CurBaseFee has not been initialized yet. This only happens when the node has just started.
-		// Try to read the previous value from the backup file and if not available, set it to the default.
-		e.CurBaseFee = e.tryLoad()
-	}
+	e.LoadBackup()
 
 	// we reset the CurBaseFee every ResetInterval
 	if height%ResetInterval == 0 {
@@ -125,6 +132,21 @@ func (e *EipState) startBlock(height int64) {
 	}
 }
 
+// LoadBackup initializes the CurBaseFee and the base fee history from the backup file
+// if CurBaseFee has not been initialized yet. This only happens when the node has just started
+// or when the state is read outside of block execution, e.g. when replaying ingested blocks.
+// If the backup file is not available, CurBaseFee is set to the default.
+func (e *EipState) LoadBackup() {
+	if !e.CurBaseFee.Equal(sdk.NewDec(0)) {
+		return
+	}
+
+	var history []BaseFeeHistoryEntry
+	e.CurBaseFee, history = e.tryLoad()
+	e.getOrInitHistory().reset(history)
+}
+
+// Clone returns a copy of the state. The base fee history is shared since it is safe for concurrent use.
 func (e EipState) Clone() EipState {
 	e.CurBaseFee = e.CurBaseFee.Clone()
 	return e
@@ -151,6 +173,7 @@ func (e *EipState) updateBaseFee(height int64) {
 	}
 	e.lastBlockHeight = height
 
+	baseFeeBeforeUpdate := e.CurBaseFee.Clone()
 	gasUsed := e.totalGasWantedThisBlock
 	gasDiff := gasUsed - TargetGas
 	//  (gasUsed - targetGas) / targetGas * maxChangeRate
@@ -168,7 +191,9 @@ func (e *EipState) updateBaseFee(height int64) {
 		e.CurBaseFee = MaxBaseFee.Clone()
 	}
 
-	go e.Clone().tryPersist()
+	entry := e.recordHistory(height, baseFeeBeforeUpdate, gasUsed)
+
+	go e.Clone().tryPersist(entry)
 }
 
 // GetCurBaseFee returns a clone of the CurBaseFee to avoid overwriting the initial value in
@@ -177,6 +202,50 @@ func (e *EipState) GetCurBaseFee() osmomath.Dec {
 	return e.CurBaseFee.Clone()
 }
 
+// recordHistory adds the fee market state of the block at the given height to the base fee history
+// and returns it
+func (e *EipState) recordHistory(height int64, baseFee osmomath.Dec, gasWanted int64) BaseFeeHistoryEntry {
+	changeRate := sdk.ZeroDec()
+	if !baseFee.IsZero() {
+		changeRate = e.CurBaseFee.Sub(baseFee).Quo(baseFee)
+	}
+
+	entry := BaseFeeHistoryEntry{
+		Height:     height,
+		BaseFee:    baseFee,
+		GasWanted:  gasWanted,
+		ChangeRate: changeRate,
+	}
+	e.getOrInitHistory().add(entry)
+	return entry
+}
+
+// getOrInitHistory returns the base fee history, initializing it if it was not set
+func (e *EipState) getOrInitHistory() *BaseFeeHistory {
+	if e.BaseFeeHistory == nil {
+		e.BaseFeeHistory = NewBaseFeeHistory(BaseFeeHistorySize)
+	}
+	return e.BaseFeeHistory
+}
+
+// GetBaseFeeHistory returns the fee market state of the latest count blocks sorted by height,
+// or of all blocks in the history if count is zero
+func (e *EipState) GetBaseFeeHistory(count int) []BaseFeeHistoryEntry {
+	return e.BaseFeeHistory.GetEntries(count)
+}
+
+// GetBaseFeeHistoryEntry returns the fee market state of the block at the given height.
+// Returns false if the block is not in the base fee history.
+func (e *EipState) GetBaseFeeHistoryEntry(height int64) (BaseFeeHistoryEntry, bool) {
+	return e.BaseFeeHistory.GetEntry(height)
+}
+
+// GetTotalGasWantedThisBlock returns the sum of the gas wanted by the transactions
+// delivered so far in the current block
+func (e *EipState) GetTotalGasWantedThisBlock() int64 {
+	return e.totalGasWantedThisBlock
+}
+
 // GetCurRecheckBaseFee returns a clone of the CurBaseFee / RecheckFeeConstant to account for
 // rechecked transactions in the feedecorator ante handler
 func (e *EipState) GetCurRecheckBaseFee() osmomath.Dec {
@@ -198,8 +267,9 @@ func (e *EipState) GetCurRecheckBaseFee() osmomath.Dec {
 var rwMtx = sync.Mutex{}
 
 // tryPersist persists the eip1559 state to disk in the form of a json file
+// and appends the base fee history entry of the block to the history backup file
 // we do this in case a node stops and it can continue functioning as normal
-func (e EipState) tryPersist() {
+func (e EipState) tryPersist(entry BaseFeeHistoryEntry) {
 	bz, err := json.Marshal(e)
 	if err != nil {
 		fmt.Println("Error marshalling eip1559 state", err)
@@ -213,18 +283,37 @@ func (e EipState) tryPersist() {
 		fmt.Println("Error writing eip1559 state", err)
 		return
 	}
+
+	err = appendHistoryBackup(e.historyBackupFilePath(), e.BaseFeeHistory, entry)
+	if err != nil {
+		fmt.Println("Error writing eip1559 base fee history", err)
+	}
+}
+
+// historyBackupFilePath returns the path of the history backup file, next to the backup file
+func (e EipState) historyBackupFilePath() string {
+	if e.BackupFilePath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(e.BackupFilePath), HistoryBackupFilename)
 }
 
-// tryLoad reads eip1559 state from disk and initializes the CurEipState to
+// tryLoad reads eip1559 state from disk and initializes the CurEipState and the base fee history to
 // the previous state when a node is restarted
-func (e *EipState) tryLoad() osmomath.Dec {
+func (e *EipState) tryLoad() (osmomath.Dec, []BaseFeeHistoryEntry) {
 	rwMtx.Lock()
 	defer rwMtx.Unlock()
+
+	history, err := readHistoryBackup(e.historyBackupFilePath())
+	if err != nil {
+		fmt.Println("Error reading eip1559 base fee history", err)
+	}
+
 	bz, err := os.ReadFile(e.BackupFilePath)
 	if err != nil {
 		fmt.Println("Error reading eip1559 state", err)
 		fmt.Println("Setting eip1559 state to default value", MinBaseFee)
-		return MinBaseFee.Clone()
+		return MinBaseFee.Clone(), history
 	}
 
 	var loaded EipState
@@ -232,9 +321,9 @@ func (e *EipState) tryLoad() osmomath.Dec {
 	if err != nil {
 		fmt.Println("Error unmarshalling eip1559 state", err)
 		fmt.Println("Setting eip1559 state to default value", MinBaseFee)
-		return MinBaseFee.Clone()
+		return MinBaseFee.Clone(), history
 	}
 
-	fmt.Println("Loaded eip1559 state. CurBaseFee=", loaded.CurBaseFee)
-	return loaded.CurBaseFee.Clone()
+	fmt.Println("Loaded eip1559 state. CurBaseFee=", loaded.CurBaseFee, "BaseFeeHistoryLength=", len(history))
+	return loaded.CurBaseFee.Clone(), history
 }
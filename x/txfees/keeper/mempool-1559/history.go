@@ -0,0 +1,245 @@
+package mempool1559
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	osmomath "github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// BaseFeeHistorySize is the number of latest blocks that the base fee history is kept for.
+// 600 blocks is approximately 50 minutes.
+var BaseFeeHistorySize = 600
+
+// HistoryBackupFilename is the file that the base fee history is persisted in, next to the backup file.
+// Every block appends its entry as a JSON line so that the whole history is not rewritten at every block.
+const HistoryBackupFilename = "eip1559history.jsonl"
+
+// historyBackupLines is the number of lines of the history backup file, guarded by rwMtx.
+var historyBackupLines int
+
+// BaseFeeHistoryEntry is the fee market state of a block.
+type BaseFeeHistoryEntry struct {
+	Height int64 `json:"height"`
+	// BaseFee is the base fee that was in effect for the block.
+	BaseFee osmomath.Dec `json:"base_fee"`
+	// GasWanted is the sum of the gas wanted by the transactions of the block.
+	GasWanted int64 `json:"gas_wanted"`
+	// ChangeRate is the relative change of the base fee at the end of the block,
+	// after the minimum and maximum base fees are enforced.
+	ChangeRate osmomath.Dec `json:"change_rate"`
+}
+
+// BaseFeeHistory is a ring buffer of the fee market state of the latest blocks.
+// It is safe for concurrent use so that it can be queried while blocks are executed.
+type BaseFeeHistory struct {
+	mu      sync.RWMutex
+	size    int
+	entries []BaseFeeHistoryEntry
+	// next is the index of the entry that is overwritten once the buffer is full.
+	next int
+}
+
+// NewBaseFeeHistory returns an empty base fee history that keeps the given number of latest blocks.
+func NewBaseFeeHistory(size int) *BaseFeeHistory {
+	return &BaseFeeHistory{
+		size:    size,
+		entries: make([]BaseFeeHistoryEntry, 0, size),
+	}
+}
+
+// add adds the entry of the latest block, overwriting the entry of the oldest block if full.
+// If the latest block is at the same height, e.g. because it was executed again, its entry is replaced.
+func (h *BaseFeeHistory) add(entry BaseFeeHistoryEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.size <= 0 {
+		return
+	}
+
+	if len(h.entries) > 0 {
+		latest := (h.next + len(h.entries) - 1) % len(h.entries)
+		if h.entries[latest].Height == entry.Height {
+			h.entries[latest] = entry
+			return
+		}
+	}
+
+	if len(h.entries) < h.size {
+		h.entries = append(h.entries, entry)
+		return
+	}
+
+	h.entries[h.next] = entry
+	h.next = (h.next + 1) % h.size
+}
+
+// reset replaces the entries with the given entries sorted by height,
+// keeping only the latest entries if there are more than the history size.
+func (h *BaseFeeHistory) reset(entries []BaseFeeHistoryEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(entries) > h.size {
+		entries = entries[len(entries)-h.size:]
+	}
+
+	h.entries = make([]BaseFeeHistoryEntry, 0, h.size)
+	h.entries = append(h.entries, entries...)
+	h.next = 0
+}
+
+// GetEntries returns the entries of the latest count blocks sorted by height.
+// Returns all entries if count is zero or greater than the number of entries.
+func (h *BaseFeeHistory) GetEntries(count int) []BaseFeeHistoryEntry {
+	if h == nil {
+		return []BaseFeeHistoryEntry{}
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ordered := make([]BaseFeeHistoryEntry, 0, len(h.entries))
+	ordered = append(ordered, h.entries[h.next:]...)
+	ordered = append(ordered, h.entries[:h.next]...)
+
+	if count > 0 && count < len(ordered) {
+		ordered = ordered[len(ordered)-count:]
+	}
+
+	return ordered
+}
+
+// GetEntry returns the entry of the block at the given height.
+// Returns false if the block is not in the history.
+func (h *BaseFeeHistory) GetEntry(height int64) (BaseFeeHistoryEntry, bool) {
+	if h == nil {
+		return BaseFeeHistoryEntry{}, false
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, entry := range h.entries {
+		if entry.Height == height {
+			return entry, true
+		}
+	}
+
+	return BaseFeeHistoryEntry{}, false
+}
+
+// appendHistoryBackup appends the entry to the history backup file at the given path.
+// Once the file has twice as many lines as the history size, it is rewritten with the entries
+// of the history instead so that it does not grow indefinitely.
+// Must be called with rwMtx held.
+func appendHistoryBackup(path string, history *BaseFeeHistory, entry BaseFeeHistoryEntry) error {
+	if historyBackupLines >= 2*BaseFeeHistorySize {
+		entries := history.GetEntries(0)
+		if err := writeHistoryBackup(path, entries, os.O_CREATE|os.O_WRONLY|os.O_TRUNC); err != nil {
+			return err
+		}
+		historyBackupLines = len(entries)
+		return nil
+	}
+
+	if err := writeHistoryBackup(path, []BaseFeeHistoryEntry{entry}, os.O_CREATE|os.O_WRONLY|os.O_APPEND); err != nil {
+		return err
+	}
+	historyBackupLines++
+	return nil
+}
+
+// writeHistoryBackup writes the entries as JSON lines into the file at the given path opened with the given flags.
+func writeHistoryBackup(path string, entries []BaseFeeHistoryEntry, flag int) error {
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		bz, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		buf.Write(bz)
+		buf.WriteByte('\n')
+	}
+
+	f, err := os.OpenFile(path, flag, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readHistoryBackup reads the entries of the history backup file at the given path sorted by height.
+// Since the entries are appended concurrently and the file can be rewritten while entries are appended,
+// the last entry of every height is kept. Lines that cannot be parsed, e.g. a line partially written
+// before the node stopped, are skipped.
+// Must be called with rwMtx held.
+func readHistoryBackup(path string) ([]BaseFeeHistoryEntry, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	historyBackupLines = 0
+	entriesByHeight := map[int64]BaseFeeHistoryEntry{}
+	for _, line := range bytes.Split(bz, []byte{'\n'}) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		historyBackupLines++
+
+		var entry BaseFeeHistoryEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		entriesByHeight[entry.Height] = entry
+	}
+
+	entries := make([]BaseFeeHistoryEntry, 0, len(entriesByHeight))
+	for _, entry := range entriesByHeight {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Height < entries[j].Height
+	})
+
+	return entries, nil
+}
+
+// GetBaseFeePercentiles returns the base fees at the given percentiles of the base fees of the entries
+// using the nearest-rank method. Percentiles must be between 0 and 100.
+// Returns an empty slice if there are no entries.
+func GetBaseFeePercentiles(entries []BaseFeeHistoryEntry, percentiles []uint32) []osmomath.Dec {
+	if len(entries) == 0 {
+		return []osmomath.Dec{}
+	}
+
+	baseFees := make([]osmomath.Dec, len(entries))
+	for i, entry := range entries {
+		baseFees[i] = entry.BaseFee
+	}
+	sort.Slice(baseFees, func(i, j int) bool {
+		return baseFees[i].LT(baseFees[j])
+	})
+
+	result := make([]osmomath.Dec, len(percentiles))
+	for i, percentile := range percentiles {
+		// rank = ceil(percentile / 100 * n), with a minimum of 1.
+		rank := (int(percentile)*len(baseFees) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		result[i] = baseFees[rank-1].Clone()
+	}
+
+	return result
+}
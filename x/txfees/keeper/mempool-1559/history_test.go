@@ -0,0 +1,171 @@
+package mempool1559
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func historyEntry(height int64, baseFee string) BaseFeeHistoryEntry {
+	return BaseFeeHistoryEntry{
+		Height:     height,
+		BaseFee:    osmomath.MustNewDecFromStr(baseFee),
+		GasWanted:  height * 1000,
+		ChangeRate: osmomath.ZeroDec(),
+	}
+}
+
+func heights(entries []BaseFeeHistoryEntry) []int64 {
+	result := make([]int64, len(entries))
+	for i, entry := range entries {
+		result[i] = entry.Height
+	}
+	return result
+}
+
+// TestBaseFeeHistoryRingBuffer tests that the history keeps the entries of the latest blocks
+// sorted by height once the ring buffer wraps around.
+func TestBaseFeeHistoryRingBuffer(t *testing.T) {
+	history := NewBaseFeeHistory(3)
+	require.Empty(t, history.GetEntries(0))
+
+	for height := int64(1); height <= 2; height++ {
+		history.add(historyEntry(height, "0.01"))
+	}
+	require.Equal(t, []int64{1, 2}, heights(history.GetEntries(0)))
+
+	for height := int64(3); height <= 7; height++ {
+		history.add(historyEntry(height, "0.01"))
+	}
+	require.Equal(t, []int64{5, 6, 7}, heights(history.GetEntries(0)))
+	require.Equal(t, []int64{6, 7}, heights(history.GetEntries(2)))
+	require.Equal(t, []int64{5, 6, 7}, heights(history.GetEntries(10)))
+
+	entry, found := history.GetEntry(6)
+	require.True(t, found)
+	require.Equal(t, historyEntry(6, "0.01"), entry)
+	_, found = history.GetEntry(4)
+	require.False(t, found)
+
+	// reset keeps only the latest entries.
+	history.reset([]BaseFeeHistoryEntry{historyEntry(10, "0.01"), historyEntry(11, "0.01"), historyEntry(12, "0.01"), historyEntry(13, "0.01")})
+	require.Equal(t, []int64{11, 12, 13}, heights(history.GetEntries(0)))
+	history.add(historyEntry(14, "0.01"))
+	require.Equal(t, []int64{12, 13, 14}, heights(history.GetEntries(0)))
+
+	// Adding the latest height again replaces its entry.
+	history.add(historyEntry(14, "0.02"))
+	require.Equal(t, []BaseFeeHistoryEntry{historyEntry(12, "0.01"), historyEntry(13, "0.01"), historyEntry(14, "0.02")}, history.GetEntries(0))
+
+	// A nil history has no entries.
+	var nilHistory *BaseFeeHistory
+	require.Empty(t, nilHistory.GetEntries(0))
+	_, found = nilHistory.GetEntry(1)
+	require.False(t, found)
+}
+
+// TestHistoryBackup tests that the entries are appended to the history backup file, which is rewritten
+// with the history once it has twice as many lines as the history size, and that the entries are loaded
+// by height keeping the last entry of every height and skipping partially written lines.
+func TestHistoryBackup(t *testing.T) {
+	defer func(size int) { BaseFeeHistorySize = size }(BaseFeeHistorySize)
+	BaseFeeHistorySize = 2
+
+	rwMtx.Lock()
+	historyBackupLines = 0
+	rwMtx.Unlock()
+
+	state := EipState{
+		BackupFilePath: filepath.Join(t.TempDir(), BackupFilename),
+		CurBaseFee:     osmomath.MustNewDecFromStr("0.02"),
+		BaseFeeHistory: NewBaseFeeHistory(BaseFeeHistorySize),
+	}
+	for height := int64(1); height <= 5; height++ {
+		entry := historyEntry(height, "0.01")
+		state.BaseFeeHistory.add(entry)
+		state.tryPersist(entry)
+	}
+
+	// The fifth entry rewrites the file with the two entries of the history.
+	rwMtx.Lock()
+	require.Equal(t, 2, historyBackupLines)
+
+	// Entries can be appended out of order and again for the same height.
+	require.NoError(t, appendHistoryBackup(state.historyBackupFilePath(), state.BaseFeeHistory, historyEntry(6, "0.01")))
+	require.NoError(t, appendHistoryBackup(state.historyBackupFilePath(), state.BaseFeeHistory, historyEntry(5, "0.03")))
+	rwMtx.Unlock()
+
+	// The last line was partially written before the node stopped.
+	f, err := os.OpenFile(state.historyBackupFilePath(), os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"height":7,"base_fee"`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	loaded := EipState{BackupFilePath: state.BackupFilePath}
+	curBaseFee, history := loaded.tryLoad()
+	require.Equal(t, state.CurBaseFee, curBaseFee)
+	require.Equal(t, []BaseFeeHistoryEntry{historyEntry(4, "0.01"), historyEntry(5, "0.03"), historyEntry(6, "0.01")}, history)
+
+	rwMtx.Lock()
+	require.Equal(t, 5, historyBackupLines)
+	rwMtx.Unlock()
+
+	// The backup file only has the current base fee.
+	bz, err := os.ReadFile(state.BackupFilePath)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), "height")
+}
+
+// TestUpdateBaseFeeRecordsHistory tests that every base fee update records the base fee
+// that was in effect for the block, its gas wanted and the relative change.
+func TestUpdateBaseFeeRecordsHistory(t *testing.T) {
+	eip := &EipState{
+		CurBaseFee:     DefaultBaseFee.Clone(),
+		BaseFeeHistory: NewBaseFeeHistory(BaseFeeHistorySize),
+	}
+
+	eip.startBlock(1)
+	eip.totalGasWantedThisBlock = TargetGas * 2
+	baseFeeBeforeUpdate := eip.GetCurBaseFee()
+	eip.updateBaseFee(1)
+
+	history := eip.GetBaseFeeHistory(0)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(1), history[0].Height)
+	require.Equal(t, baseFeeBeforeUpdate, history[0].BaseFee)
+	require.Equal(t, TargetGas*2, history[0].GasWanted)
+	require.Equal(t, eip.CurBaseFee.Sub(baseFeeBeforeUpdate).Quo(baseFeeBeforeUpdate), history[0].ChangeRate)
+	require.True(t, history[0].ChangeRate.IsPositive())
+
+	// Updating the same height again replaces its entry.
+	baseFeeBeforeUpdate = eip.GetCurBaseFee()
+	eip.updateBaseFee(1)
+	history = eip.GetBaseFeeHistory(0)
+	require.Len(t, history, 1)
+	require.Equal(t, baseFeeBeforeUpdate, history[0].BaseFee)
+}
+
+func TestGetBaseFeePercentiles(t *testing.T) {
+	entries := []BaseFeeHistoryEntry{
+		historyEntry(1, "0.05"),
+		historyEntry(2, "0.01"),
+		historyEntry(3, "0.04"),
+		historyEntry(4, "0.02"),
+		historyEntry(5, "0.03"),
+	}
+
+	require.Empty(t, GetBaseFeePercentiles(nil, []uint32{50}))
+	require.Equal(t, []sdk.Dec{
+		osmomath.MustNewDecFromStr("0.01"),
+		osmomath.MustNewDecFromStr("0.01"),
+		osmomath.MustNewDecFromStr("0.03"),
+		osmomath.MustNewDecFromStr("0.05"),
+		osmomath.MustNewDecFromStr("0.05"),
+	}, GetBaseFeePercentiles(entries, []uint32{0, 20, 50, 90, 100}))
+}
@@ -0,0 +1,62 @@
+package mempool1559
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	osmomath "github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// ParseParams parses the EIP-1559 fee market parameters from the osmosis-mempool section of app.toml,
+// falling back to the defaults for the ones that are not set. Panics if the resulting parameters are invalid.
+func ParseParams(opts servertypes.AppOptions) Params {
+	defaultParams := DefaultParams()
+	params := Params{
+		DefaultBaseFee:             parseDecFromConfig(opts, "eip1559-default-base-fee", defaultParams.DefaultBaseFee),
+		MinBaseFee:                 parseDecFromConfig(opts, "eip1559-min-base-fee", defaultParams.MinBaseFee),
+		MaxBaseFee:                 parseDecFromConfig(opts, "eip1559-max-base-fee", defaultParams.MaxBaseFee),
+		ResetInterval:              parseInt64FromConfig(opts, "eip1559-reset-interval", defaultParams.ResetInterval),
+		MaxBlockChangeRate:         parseDecFromConfig(opts, "eip1559-max-block-change-rate", defaultParams.MaxBlockChangeRate),
+		TargetGas:                  parseInt64FromConfig(opts, "eip1559-target-gas", defaultParams.TargetGas),
+		TargetBlockSpacePercent:    parseDecFromConfig(opts, "eip1559-target-block-space-percent", defaultParams.TargetBlockSpacePercent),
+		RecheckFeeLowBaseFee:       parseDecFromConfig(opts, "eip1559-recheck-fee-low-base-fee", defaultParams.RecheckFeeLowBaseFee),
+		RecheckFeeHighBaseFee:      parseDecFromConfig(opts, "eip1559-recheck-fee-high-base-fee", defaultParams.RecheckFeeHighBaseFee),
+		RecheckFeeBaseFeeThreshold: parseDecFromConfig(opts, "eip1559-recheck-fee-base-fee-threshold", defaultParams.RecheckFeeBaseFeeThreshold),
+	}
+	if err := params.Validate(); err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool eip1559 parameters, err= %v", err))
+	}
+	return params
+}
+
+func parseInt64FromConfig(opts servertypes.AppOptions, optName string, defaultValue int64) int64 {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToInt64E(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
+func parseDecFromConfig(opts servertypes.AppOptions, optName string, defaultValue osmomath.Dec) osmomath.Dec {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	valueStr, ok := valueInterface.(string)
+	if !ok {
+		panic("invalidly configured osmosis-mempool." + optName)
+	}
+	// prepend 0 to allow the config to start with a decimal, e.g. ".01"
+	value, err := osmomath.NewDecFromStr("0" + valueStr)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
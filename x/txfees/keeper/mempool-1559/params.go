@@ -0,0 +1,157 @@
+package mempool1559
+
+import (
+	"fmt"
+
+	osmomath "github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// Params are the node local parameters of the EIP-1559 fee market.
+// They are configured in the osmosis-mempool section of app.toml and applied
+// to the package globals when the mempool fee decorator is created.
+// If TargetGas is zero, the target gas is derived from the block max gas
+// and TargetBlockSpacePercent whenever the block max gas changes.
+type Params struct {
+	DefaultBaseFee             osmomath.Dec
+	MinBaseFee                 osmomath.Dec
+	MaxBaseFee                 osmomath.Dec
+	ResetInterval              int64
+	MaxBlockChangeRate         osmomath.Dec
+	TargetGas                  int64
+	TargetBlockSpacePercent    osmomath.Dec
+	RecheckFeeLowBaseFee       osmomath.Dec
+	RecheckFeeHighBaseFee      osmomath.Dec
+	RecheckFeeBaseFeeThreshold osmomath.Dec
+}
+
+// configuredTargetGas is the configured target gas, zero if it is derived from the block max gas.
+var configuredTargetGas = int64(0)
+
+// defaultParams are the values of the package globals at initialization.
+var defaultParams = GetParams()
+
+// DefaultParams returns the parameters that the fee market uses when none are configured.
+func DefaultParams() Params {
+	return defaultParams.Clone()
+}
+
+// GetParams returns the parameters in effect.
+func GetParams() Params {
+	return Params{
+		DefaultBaseFee:             DefaultBaseFee.Clone(),
+		MinBaseFee:                 MinBaseFee.Clone(),
+		MaxBaseFee:                 MaxBaseFee.Clone(),
+		ResetInterval:              ResetInterval,
+		MaxBlockChangeRate:         MaxBlockChangeRate.Clone(),
+		TargetGas:                  configuredTargetGas,
+		TargetBlockSpacePercent:    TargetBlockSpacePercent.Clone(),
+		RecheckFeeLowBaseFee:       RecheckFeeLowBaseFeeDec.Clone(),
+		RecheckFeeHighBaseFee:      RecheckFeeHighBaseFeeDec.Clone(),
+		RecheckFeeBaseFeeThreshold: RecheckFeeBaseFeeThreshold.Clone(),
+	}
+}
+
+// SetParams validates the parameters and sets them as the parameters in effect.
+// It must only be called on startup, before any block is processed.
+func SetParams(p Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	p = p.Clone()
+	DefaultBaseFee = p.DefaultBaseFee
+	MinBaseFee = p.MinBaseFee
+	MaxBaseFee = p.MaxBaseFee
+	ResetInterval = p.ResetInterval
+	MaxBlockChangeRate = p.MaxBlockChangeRate
+	configuredTargetGas = p.TargetGas
+	if configuredTargetGas > 0 {
+		TargetGas = configuredTargetGas
+	}
+	TargetBlockSpacePercent = p.TargetBlockSpacePercent
+	RecheckFeeLowBaseFeeDec = p.RecheckFeeLowBaseFee
+	RecheckFeeHighBaseFeeDec = p.RecheckFeeHighBaseFee
+	RecheckFeeBaseFeeThreshold = p.RecheckFeeBaseFeeThreshold
+	return nil
+}
+
+// SetTargetGasFromBlockMaxGas sets the target gas to TargetBlockSpacePercent of the block max gas,
+// unless the target gas is configured.
+func SetTargetGasFromBlockMaxGas(blockMaxGas int64) {
+	if configuredTargetGas > 0 {
+		return
+	}
+	TargetGas = TargetBlockSpacePercent.Mul(osmomath.NewDec(blockMaxGas)).TruncateInt().Int64()
+}
+
+// Validate returns an error if the parameters would make the fee market misbehave.
+func (p Params) Validate() error {
+	for _, dec := range []struct {
+		name  string
+		value osmomath.Dec
+	}{
+		{"default base fee", p.DefaultBaseFee},
+		{"min base fee", p.MinBaseFee},
+		{"max base fee", p.MaxBaseFee},
+		{"max block change rate", p.MaxBlockChangeRate},
+		{"target block space percent", p.TargetBlockSpacePercent},
+		{"recheck fee low base fee", p.RecheckFeeLowBaseFee},
+		{"recheck fee high base fee", p.RecheckFeeHighBaseFee},
+		{"recheck fee base fee threshold", p.RecheckFeeBaseFeeThreshold},
+	} {
+		if dec.value.IsNil() {
+			return fmt.Errorf("eip1559 %s must be set", dec.name)
+		}
+	}
+
+	if !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("eip1559 min base fee must be positive, got %s", p.MinBaseFee)
+	}
+	if p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("eip1559 max base fee (%s) must not be lower than the min base fee (%s)", p.MaxBaseFee, p.MinBaseFee)
+	}
+	if p.DefaultBaseFee.LT(p.MinBaseFee) || p.DefaultBaseFee.GT(p.MaxBaseFee) {
+		return fmt.Errorf("eip1559 default base fee (%s) must be between the min (%s) and max (%s) base fees", p.DefaultBaseFee, p.MinBaseFee, p.MaxBaseFee)
+	}
+	if p.ResetInterval <= 0 {
+		return fmt.Errorf("eip1559 reset interval must be positive, got %d", p.ResetInterval)
+	}
+	// A change rate of 1 or more would let a block with no gas wanted drop the base fee to zero or below.
+	if !p.MaxBlockChangeRate.IsPositive() || p.MaxBlockChangeRate.GTE(osmomath.OneDec()) {
+		return fmt.Errorf("eip1559 max block change rate must be in (0, 1), got %s", p.MaxBlockChangeRate)
+	}
+	if p.TargetGas < 0 {
+		return fmt.Errorf("eip1559 target gas must not be negative, got %d", p.TargetGas)
+	}
+	if !p.TargetBlockSpacePercent.IsPositive() || p.TargetBlockSpacePercent.GT(osmomath.OneDec()) {
+		return fmt.Errorf("eip1559 target block space percent must be in (0, 1], got %s", p.TargetBlockSpacePercent)
+	}
+	if p.RecheckFeeLowBaseFee.LT(osmomath.OneDec()) || p.RecheckFeeHighBaseFee.LT(osmomath.OneDec()) {
+		return fmt.Errorf("eip1559 recheck fee constants must be at least 1, got %s and %s", p.RecheckFeeLowBaseFee, p.RecheckFeeHighBaseFee)
+	}
+	if p.RecheckFeeBaseFeeThreshold.IsNegative() {
+		return fmt.Errorf("eip1559 recheck fee base fee threshold must not be negative, got %s", p.RecheckFeeBaseFeeThreshold)
+	}
+
+	return nil
+}
+
+// Clone returns a deep copy of the parameters.
+func (p Params) Clone() Params {
+	p.DefaultBaseFee = cloneDec(p.DefaultBaseFee)
+	p.MinBaseFee = cloneDec(p.MinBaseFee)
+	p.MaxBaseFee = cloneDec(p.MaxBaseFee)
+	p.MaxBlockChangeRate = cloneDec(p.MaxBlockChangeRate)
+	p.TargetBlockSpacePercent = cloneDec(p.TargetBlockSpacePercent)
+	p.RecheckFeeLowBaseFee = cloneDec(p.RecheckFeeLowBaseFee)
+	p.RecheckFeeHighBaseFee = cloneDec(p.RecheckFeeHighBaseFee)
+	p.RecheckFeeBaseFeeThreshold = cloneDec(p.RecheckFeeBaseFeeThreshold)
+	return p
+}
+
+func cloneDec(d osmomath.Dec) osmomath.Dec {
+	if d.IsNil() {
+		return d
+	}
+	return d.Clone()
+}
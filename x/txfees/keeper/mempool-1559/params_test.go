@@ -0,0 +1,116 @@
+package mempool1559
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify    func(p *Params)
+		expectErr bool
+	}{
+		"default params": {
+			modify: func(p *Params) {},
+		},
+		"nil dec": {
+			modify:    func(p *Params) { p.RecheckFeeBaseFeeThreshold = osmomath.Dec{} },
+			expectErr: true,
+		},
+		"zero min base fee": {
+			modify:    func(p *Params) { p.MinBaseFee = osmomath.ZeroDec() },
+			expectErr: true,
+		},
+		"max base fee lower than min base fee": {
+			modify:    func(p *Params) { p.MaxBaseFee = osmomath.MustNewDecFromStr("0.001") },
+			expectErr: true,
+		},
+		"default base fee above max base fee": {
+			modify:    func(p *Params) { p.DefaultBaseFee = osmomath.NewDec(6) },
+			expectErr: true,
+		},
+		"zero reset interval": {
+			modify:    func(p *Params) { p.ResetInterval = 0 },
+			expectErr: true,
+		},
+		"max block change rate of one": {
+			modify:    func(p *Params) { p.MaxBlockChangeRate = osmomath.OneDec() },
+			expectErr: true,
+		},
+		"negative target gas": {
+			modify:    func(p *Params) { p.TargetGas = -1 },
+			expectErr: true,
+		},
+		"target block space percent above one": {
+			modify:    func(p *Params) { p.TargetBlockSpacePercent = osmomath.MustNewDecFromStr("1.1") },
+			expectErr: true,
+		},
+		"full target block space": {
+			modify: func(p *Params) { p.TargetBlockSpacePercent = osmomath.OneDec() },
+		},
+		"recheck fee below one": {
+			modify:    func(p *Params) { p.RecheckFeeHighBaseFee = osmomath.MustNewDecFromStr("0.5") },
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.modify(&params)
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestSetParams tests that the parameters are applied to the fee market
+// and that invalid parameters are rejected without being applied.
+func TestSetParams(t *testing.T) {
+	defer func(targetGas int64) {
+		require.NoError(t, SetParams(DefaultParams()))
+		TargetGas = targetGas
+	}(TargetGas)
+
+	params := DefaultParams()
+	params.MinBaseFee = osmomath.MustNewDecFromStr("0.001")
+	params.ResetInterval = 100
+	params.RecheckFeeLowBaseFee = osmomath.NewDec(4)
+	require.NoError(t, SetParams(params))
+
+	require.Equal(t, params, GetParams())
+	require.Equal(t, params.MinBaseFee, MinBaseFee)
+	require.Equal(t, int64(100), ResetInterval)
+
+	// The parameters are copied, so modifying them does not change the parameters in effect.
+	params.MinBaseFee.MulMut(osmomath.NewDec(2))
+	require.Equal(t, osmomath.MustNewDecFromStr("0.001"), MinBaseFee)
+
+	invalidParams := DefaultParams()
+	invalidParams.ResetInterval = -1
+	require.Error(t, SetParams(invalidParams))
+	require.Equal(t, int64(100), ResetInterval)
+
+	// The target gas is derived from the block max gas unless it is configured.
+	SetTargetGasFromBlockMaxGas(100_000_000)
+	require.Equal(t, int64(62_500_000), TargetGas)
+
+	params.TargetGas = 50_000_000
+	require.NoError(t, SetParams(params))
+	require.Equal(t, int64(50_000_000), TargetGas)
+	SetTargetGasFromBlockMaxGas(300_000_000)
+	require.Equal(t, int64(50_000_000), TargetGas)
+	require.Equal(t, params, GetParams())
+
+	// Rechecked transactions are charged the base fee divided by the configured factor.
+	eip := &EipState{CurBaseFee: osmomath.MustNewDecFromStr("0.008")}
+	require.Equal(t, osmomath.MustNewDecFromStr("0.002"), eip.GetCurRecheckBaseFee())
+}
@@ -196,8 +196,7 @@ func (am AppModule) CheckAndSetTargetGas(ctx sdk.Context) {
 			return
 		}
 
-		newBlockMaxGas := mempool1559.TargetBlockSpacePercent.Mul(sdk.NewDec(newConsensusParams.Block.MaxGas)).TruncateInt().Int64()
-		mempool1559.TargetGas = newBlockMaxGas
+		mempool1559.SetTargetGasFromBlockMaxGas(newConsensusParams.Block.MaxGas)
 		return
 	}
 
@@ -214,8 +213,7 @@ func (am AppModule) CheckAndSetTargetGas(ctx sdk.Context) {
 
 		// Sure, its possible that the thing that changes in consensus params was something other than the block gas limit,
 		// but just double setting it here is fine instead of doing more logic to see what actually changed.
-		newBlockMaxGas := mempool1559.TargetBlockSpacePercent.Mul(sdk.NewDec(newConsensusParams.Block.MaxGas)).TruncateInt().Int64()
-		mempool1559.TargetGas = newBlockMaxGas
+		mempool1559.SetTargetGasFromBlockMaxGas(newConsensusParams.Block.MaxGas)
 		cachedConsParamBytes = consParamsBytes
 	}
 }
@@ -445,6 +445,301 @@ func (m *QueryEipBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEipBaseFeeResponse proto.InternalMessageInfo
 
+// QueryEipBaseFeeHistoryRequest defines grpc request structure for querying the
+// EIP-1559 base fee history
+type QueryEipBaseFeeHistoryRequest struct {
+	// block_count is the number of latest blocks to return. Zero returns all the
+	// blocks kept by the node.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty" yaml:"block_count"`
+	// percentiles are the percentiles between 0 and 100 of the base fees of the
+	// returned blocks to compute.
+	Percentiles []uint32 `protobuf:"varint,2,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty" yaml:"percentiles"`
+}
+
+func (m *QueryEipBaseFeeHistoryRequest) Reset()         { *m = QueryEipBaseFeeHistoryRequest{} }
+func (m *QueryEipBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEipBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryEipBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEipBaseFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryEipBaseFeeHistoryRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// QueryEipBaseFeeHistoryResponse defines grpc response structure for querying
+// the EIP-1559 base fee history
+type QueryEipBaseFeeHistoryResponse struct {
+	// entries are sorted by height.
+	Entries []EipBaseFeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
+	// percentile_base_fees are the base fees at the requested percentiles in the
+	// order of the request.
+	PercentileBaseFees []cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,rep,name=percentile_base_fees,json=percentileBaseFees,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentile_base_fees" yaml:"percentile_base_fees"`
+}
+
+func (m *QueryEipBaseFeeHistoryResponse) Reset()         { *m = QueryEipBaseFeeHistoryResponse{} }
+func (m *QueryEipBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEipBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryEipBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEipBaseFeeHistoryResponse) GetEntries() []EipBaseFeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// EipBaseFeeHistoryEntry is the EIP-1559 fee market state of a block
+type EipBaseFeeHistoryEntry struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// base_fee is the base fee that was in effect for the block.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee" yaml:"base_fee"`
+	// gas_wanted is the sum of the gas wanted by the transactions of the block.
+	GasWanted int64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty" yaml:"gas_wanted"`
+	// change_rate is the relative change of the base fee at the end of the
+	// block.
+	ChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=change_rate,json=changeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"change_rate" yaml:"change_rate"`
+}
+
+func (m *EipBaseFeeHistoryEntry) Reset()         { *m = EipBaseFeeHistoryEntry{} }
+func (m *EipBaseFeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*EipBaseFeeHistoryEntry) ProtoMessage()    {}
+func (*EipBaseFeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{12}
+}
+func (m *EipBaseFeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EipBaseFeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EipBaseFeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EipBaseFeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EipBaseFeeHistoryEntry.Merge(m, src)
+}
+func (m *EipBaseFeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EipBaseFeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EipBaseFeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EipBaseFeeHistoryEntry proto.InternalMessageInfo
+
+func (m *EipBaseFeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EipBaseFeeHistoryEntry) GetGasWanted() int64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+// QueryEipParamsRequest defines grpc request structure for querying the
+// EIP-1559 fee market parameters
+type QueryEipParamsRequest struct {
+}
+
+func (m *QueryEipParamsRequest) Reset()         { *m = QueryEipParamsRequest{} }
+func (m *QueryEipParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEipParamsRequest) ProtoMessage()    {}
+func (*QueryEipParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{13}
+}
+func (m *QueryEipParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipParamsRequest.Merge(m, src)
+}
+func (m *QueryEipParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipParamsRequest proto.InternalMessageInfo
+
+// QueryEipParamsResponse defines grpc response structure for querying the
+// EIP-1559 fee market parameters
+type QueryEipParamsResponse struct {
+	// enabled is whether the EIP-1559 fee market is enabled on the node. The
+	// parameters are not applied when it is disabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// default_base_fee is the base fee set every reset_interval blocks.
+	DefaultBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=default_base_fee,json=defaultBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"default_base_fee" yaml:"default_base_fee"`
+	// min_base_fee is the lowest base fee.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the highest base fee.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee" yaml:"max_base_fee"`
+	// reset_interval is the number of blocks after which the base fee is reset
+	// to default_base_fee.
+	ResetInterval int64 `protobuf:"varint,5,opt,name=reset_interval,json=resetInterval,proto3" json:"reset_interval,omitempty" yaml:"reset_interval"`
+	// max_block_change_rate is the maximum relative change of the base fee per
+	// block.
+	MaxBlockChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_block_change_rate,json=maxBlockChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_block_change_rate" yaml:"max_block_change_rate"`
+	// target_block_space_percent is the share of the block max gas targeted by
+	// the fee market.
+	TargetBlockSpacePercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=target_block_space_percent,json=targetBlockSpacePercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_block_space_percent" yaml:"target_block_space_percent"`
+	// target_gas is the gas wanted per block targeted by the fee market.
+	TargetGas int64 `protobuf:"varint,8,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty" yaml:"target_gas"`
+	// recheck_fee_low_base_fee is the factor the base fee is divided by when
+	// rechecking transactions while the base fee is at most
+	// recheck_fee_base_fee_threshold.
+	RecheckFeeLowBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=recheck_fee_low_base_fee,json=recheckFeeLowBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_low_base_fee" yaml:"recheck_fee_low_base_fee"`
+	// recheck_fee_high_base_fee is the factor the base fee is divided by when
+	// rechecking transactions while the base fee is above
+	// recheck_fee_base_fee_threshold.
+	RecheckFeeHighBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=recheck_fee_high_base_fee,json=recheckFeeHighBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_high_base_fee" yaml:"recheck_fee_high_base_fee"`
+	// recheck_fee_base_fee_threshold is the base fee above which
+	// recheck_fee_high_base_fee is applied.
+	RecheckFeeBaseFeeThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=recheck_fee_base_fee_threshold,json=recheckFeeBaseFeeThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_base_fee_threshold" yaml:"recheck_fee_base_fee_threshold"`
+}
+
+func (m *QueryEipParamsResponse) Reset()         { *m = QueryEipParamsResponse{} }
+func (m *QueryEipParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEipParamsResponse) ProtoMessage()    {}
+func (*QueryEipParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{14}
+}
+func (m *QueryEipParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEipParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEipParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEipParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEipParamsResponse.Merge(m, src)
+}
+func (m *QueryEipParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEipParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEipParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEipParamsResponse proto.InternalMessageInfo
+
+func (m *QueryEipParamsResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryEipParamsResponse) GetResetInterval() int64 {
+	if m != nil {
+		return m.ResetInterval
+	}
+	return 0
+}
+
+func (m *QueryEipParamsResponse) GetTargetGas() int64 {
+	if m != nil {
+		return m.TargetGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -456,6 +751,11 @@ func init() {
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryEipBaseFeeHistoryRequest)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryEipBaseFeeHistoryResponse)(nil), "osmosis.txfees.v1beta1.QueryEipBaseFeeHistoryResponse")
+	proto.RegisterType((*EipBaseFeeHistoryEntry)(nil), "osmosis.txfees.v1beta1.EipBaseFeeHistoryEntry")
+	proto.RegisterType((*QueryEipParamsRequest)(nil), "osmosis.txfees.v1beta1.QueryEipParamsRequest")
+	proto.RegisterType((*QueryEipParamsResponse)(nil), "osmosis.txfees.v1beta1.QueryEipParamsResponse")
 }
 
 func init() {
@@ -463,51 +763,90 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x4e, 0x13, 0x51,
-	0x14, 0xed, 0xa0, 0xa0, 0x7d, 0x28, 0xea, 0x8b, 0x40, 0x1d, 0xcc, 0x94, 0xbc, 0xa8, 0x21, 0x98,
-	0xce, 0x83, 0xa2, 0xc6, 0xb8, 0xb3, 0xa9, 0x18, 0x13, 0x62, 0x60, 0x34, 0x31, 0x61, 0x33, 0x99,
-	0x69, 0xef, 0x94, 0x09, 0x6d, 0xdf, 0xd0, 0xf7, 0x4a, 0x68, 0x8c, 0x1b, 0xbf, 0xc0, 0xc4, 0xc4,
-	0x0f, 0x60, 0xe3, 0xce, 0xbd, 0x7f, 0xc0, 0x92, 0xc4, 0x8d, 0x71, 0xd1, 0x18, 0xf0, 0x0b, 0xf8,
-	0x02, 0x33, 0x6f, 0xde, 0x74, 0x0a, 0x74, 0x68, 0xd9, 0x75, 0xe6, 0xde, 0x7b, 0xce, 0xb9, 0x73,
-	0xcf, 0x49, 0x11, 0x61, 0xbc, 0xc1, 0xb8, 0xcf, 0xa9, 0xd8, 0xf3, 0x00, 0x38, 0xdd, 0x5d, 0x76,
-	0x41, 0x38, 0xcb, 0x74, 0xa7, 0x0d, 0xad, 0x8e, 0x19, 0xb4, 0x98, 0x60, 0x78, 0x46, 0xf5, 0x98,
-	0x51, 0x8f, 0xa9, 0x7a, 0xf4, 0xbb, 0x35, 0x56, 0x63, 0xb2, 0x85, 0x86, 0xbf, 0xa2, 0x6e, 0xfd,
-	0x7e, 0x8d, 0xb1, 0x5a, 0x1d, 0xa8, 0x13, 0xf8, 0xd4, 0x69, 0x36, 0x99, 0x70, 0x84, 0xcf, 0x9a,
-	0x5c, 0x55, 0x0d, 0x55, 0x95, 0x4f, 0x6e, 0xdb, 0xa3, 0xd5, 0x76, 0x4b, 0x36, 0xa8, 0xfa, 0xc3,
-	0x14, 0x3d, 0x1e, 0x80, 0x60, 0xdb, 0xa0, 0xda, 0xc8, 0x2c, 0x9a, 0xde, 0x08, 0x15, 0xae, 0x02,
-	0xbc, 0x0f, 0x5f, 0x73, 0x0b, 0x76, 0xda, 0xc0, 0x05, 0x11, 0x68, 0xe6, 0x6c, 0x81, 0x07, 0xac,
-	0xc9, 0x01, 0x6f, 0x22, 0xe4, 0x01, 0xd8, 0x12, 0x85, 0xe7, 0xb4, 0xf9, 0x2b, 0x0b, 0x93, 0xc5,
-	0x79, 0x73, 0xf0, 0x6a, 0x66, 0x3c, 0x5e, 0xba, 0x77, 0xd0, 0xcd, 0x67, 0x4e, 0xba, 0xf9, 0x3b,
-	0x1d, 0xa7, 0x51, 0x7f, 0x41, 0x12, 0x04, 0x62, 0x65, 0xbd, 0x98, 0x83, 0x94, 0x91, 0x2e, 0x59,
-	0xcb, 0xd0, 0x64, 0x8d, 0x77, 0x01, 0x13, 0xeb, 0x2d, 0xbf, 0x02, 0x4a, 0x13, 0x7e, 0x84, 0xc6,
-	0xab, 0x61, 0x21, 0xa7, 0xcd, 0x6b, 0x0b, 0xd9, 0xd2, 0xed, 0x93, 0x6e, 0xfe, 0x46, 0x04, 0x27,
-	0x5f, 0x13, 0x2b, 0x2a, 0x93, 0x7d, 0x0d, 0xcd, 0x0d, 0x84, 0x51, 0x1b, 0x2c, 0xa2, 0x89, 0x80,
-	0xb1, 0xfa, 0x9b, 0xb2, 0x04, 0xba, 0x5a, 0xc2, 0x27, 0xdd, 0xfc, 0x54, 0x04, 0x14, 0xbe, 0xb7,
-	0xfd, 0x2a, 0xb1, 0x54, 0x07, 0xfe, 0x80, 0x10, 0x0f, 0x98, 0xb0, 0x83, 0x10, 0x21, 0x37, 0x26,
-	0x89, 0x9f, 0x87, 0xbb, 0xfc, 0xe9, 0xe6, 0xe7, 0x2a, 0x72, 0x6b, 0x5e, 0xdd, 0x36, 0x7d, 0x46,
-	0x1b, 0x8e, 0xd8, 0x32, 0xd7, 0xa0, 0xe6, 0x54, 0x3a, 0x65, 0xa8, 0x24, 0xab, 0x26, 0xe3, 0xc4,
-	0xca, 0xf2, 0x58, 0x0c, 0x79, 0x89, 0x66, 0x13, 0x8d, 0xeb, 0x21, 0x59, 0xf5, 0xb2, 0x7b, 0xae,
-	0xa2, 0xdc, 0x79, 0x88, 0xcb, 0xef, 0xd8, 0x33, 0x41, 0xc9, 0xe1, 0x20, 0xb1, 0x62, 0x13, 0xbc,
-	0x55, 0x26, 0xe8, 0x2b, 0x28, 0xf8, 0x27, 0x08, 0xb9, 0x0e, 0x07, 0xbb, 0x5f, 0xe7, 0x74, 0xb2,
-	0x73, 0x52, 0x23, 0x56, 0xd6, 0x8d, 0xa7, 0x49, 0x4e, 0xe1, 0xbd, 0xf2, 0x83, 0x10, 0x72, 0x15,
-	0xe2, 0xd3, 0x92, 0xba, 0xfa, 0x1a, 0xfd, 0x15, 0x45, 0xb5, 0x81, 0xae, 0x4b, 0x38, 0x0f, 0x40,
-	0x11, 0x3d, 0x1b, 0xed, 0xfb, 0xdf, 0xea, 0xd3, 0xe2, 0x01, 0x10, 0xeb, 0x9a, 0x1b, 0x41, 0x17,
-	0x7f, 0x4e, 0xa0, 0x71, 0x49, 0x87, 0xbf, 0x69, 0x28, 0xdb, 0xb3, 0x38, 0x2e, 0xa4, 0xd9, 0x78,
-	0x60, 0x46, 0x74, 0x73, 0xd4, 0xf6, 0x68, 0x13, 0xb2, 0xf8, 0xf9, 0xd7, 0xbf, 0xaf, 0x63, 0x0f,
-	0x30, 0xa1, 0xe9, 0xe1, 0x54, 0xa9, 0xc0, 0x3f, 0x34, 0x34, 0x75, 0xda, 0xbe, 0xb8, 0x78, 0x21,
-	0xdd, 0xc0, 0xc8, 0xe8, 0x2b, 0x97, 0x9a, 0x51, 0x3a, 0x57, 0xa4, 0xce, 0x02, 0x7e, 0x9c, 0xa6,
-	0x33, 0xb1, 0xb4, 0xed, 0x76, 0xa2, 0x3b, 0xe3, 0xef, 0x1a, 0x9a, 0xec, 0x33, 0x22, 0xa6, 0xc3,
-	0x99, 0x4f, 0xb9, 0x5e, 0x5f, 0x1a, 0x7d, 0x40, 0xe9, 0x7c, 0x2a, 0x75, 0x52, 0x5c, 0x48, 0xd3,
-	0x29, 0x95, 0xd9, 0xca, 0xef, 0xf4, 0xa3, 0x7c, 0xfc, 0x24, 0x6f, 0xde, 0x73, 0xf4, 0x90, 0x9b,
-	0x9f, 0x8d, 0xc4, 0x90, 0x9b, 0x9f, 0x0b, 0xca, 0xf0, 0x9b, 0x27, 0x51, 0xc1, 0xfb, 0x1a, 0xba,
-	0xf9, 0x1a, 0x44, 0x92, 0x01, 0x7c, 0x31, 0xdb, 0xb9, 0x18, 0xe9, 0x74, 0xe4, 0x7e, 0x25, 0x6f,
-	0x49, 0xca, 0x5b, 0xc4, 0x0b, 0x69, 0xf2, 0x2a, 0xed, 0x96, 0x0d, 0x7e, 0x60, 0xc7, 0x29, 0x2a,
-	0xad, 0x1d, 0x1c, 0x19, 0xda, 0xe1, 0x91, 0xa1, 0xfd, 0x3d, 0x32, 0xb4, 0x2f, 0xc7, 0x46, 0xe6,
-	0xf0, 0xd8, 0xc8, 0xfc, 0x3e, 0x36, 0x32, 0x9b, 0xc5, 0x9a, 0x2f, 0xb6, 0xda, 0xae, 0x59, 0x61,
-	0x8d, 0x18, 0xad, 0x50, 0x77, 0x5c, 0xde, 0x83, 0xde, 0x2d, 0x16, 0xe9, 0x5e, 0x4c, 0x20, 0x3a,
-	0x01, 0x70, 0x77, 0x42, 0xfe, 0x0d, 0xad, 0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x43, 0xc5, 0x5c,
-	0x2d, 0x3f, 0x07, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x5d, 0x6f, 0xd4, 0x46,
+	0x17, 0xc7, 0xe3, 0x04, 0x42, 0x76, 0x42, 0xf2, 0xc0, 0x3c, 0x79, 0x31, 0x0b, 0xdd, 0x4d, 0x47,
+	0xa5, 0x4a, 0x29, 0xb1, 0x21, 0xbc, 0x94, 0x72, 0x51, 0xb5, 0x4b, 0x08, 0x20, 0x45, 0x55, 0x30,
+	0x48, 0x48, 0xa8, 0x92, 0x3b, 0xeb, 0x3d, 0x6b, 0x5b, 0xd9, 0xf5, 0x18, 0xcf, 0x6c, 0xc8, 0xaa,
+	0x6a, 0xa5, 0x56, 0xea, 0x3d, 0x2a, 0x52, 0xa5, 0xde, 0x72, 0xd3, 0x5e, 0xf5, 0x0b, 0xf4, 0x0b,
+	0x70, 0x89, 0xd4, 0x9b, 0xaa, 0x17, 0xab, 0x0a, 0xfa, 0x09, 0x72, 0x55, 0xa9, 0x37, 0x95, 0xc7,
+	0xe3, 0xb5, 0x77, 0x37, 0x0b, 0x4e, 0xd5, 0xbb, 0x1d, 0x9f, 0x73, 0xfe, 0xe7, 0x37, 0x73, 0xce,
+	0x4c, 0x4e, 0x10, 0x61, 0xbc, 0xcd, 0xb8, 0xcf, 0x4d, 0xb1, 0xd7, 0x04, 0xe0, 0xe6, 0xee, 0xc5,
+	0x3a, 0x08, 0x7a, 0xd1, 0x7c, 0xd4, 0x81, 0xa8, 0x6b, 0x84, 0x11, 0x13, 0x0c, 0x2f, 0x29, 0x1f,
+	0x23, 0xf1, 0x31, 0x94, 0x4f, 0x79, 0xc1, 0x65, 0x2e, 0x93, 0x2e, 0x66, 0xfc, 0x2b, 0xf1, 0x2e,
+	0x9f, 0x71, 0x19, 0x73, 0x5b, 0x60, 0xd2, 0xd0, 0x37, 0x69, 0x10, 0x30, 0x41, 0x85, 0xcf, 0x02,
+	0xae, 0xac, 0x15, 0x65, 0x95, 0xab, 0x7a, 0xa7, 0x69, 0x36, 0x3a, 0x91, 0x74, 0x50, 0xf6, 0xb3,
+	0x63, 0x78, 0x9a, 0x00, 0x82, 0xed, 0x80, 0x72, 0x23, 0xcb, 0x68, 0xf1, 0x6e, 0x4c, 0xb8, 0x09,
+	0x70, 0x3f, 0xfe, 0xcc, 0x2d, 0x78, 0xd4, 0x01, 0x2e, 0x88, 0x40, 0x4b, 0xc3, 0x06, 0x1e, 0xb2,
+	0x80, 0x03, 0x7e, 0x88, 0x50, 0x13, 0xc0, 0x96, 0x2a, 0x5c, 0xd7, 0x56, 0xa6, 0x56, 0x67, 0xd7,
+	0x57, 0x8c, 0x83, 0xb7, 0x66, 0xa4, 0xe1, 0xb5, 0x53, 0xcf, 0x7b, 0xd5, 0x89, 0xfd, 0x5e, 0xf5,
+	0x64, 0x97, 0xb6, 0x5b, 0xd7, 0x49, 0xa6, 0x40, 0xac, 0x52, 0x33, 0xcd, 0x41, 0x36, 0x50, 0x59,
+	0x66, 0xdd, 0x80, 0x80, 0xb5, 0xef, 0x85, 0x4c, 0x6c, 0x47, 0xbe, 0x03, 0x8a, 0x09, 0xbf, 0x8b,
+	0x8e, 0x36, 0x62, 0x83, 0xae, 0xad, 0x68, 0xab, 0xa5, 0xda, 0x89, 0xfd, 0x5e, 0xf5, 0x78, 0x22,
+	0x27, 0x3f, 0x13, 0x2b, 0x31, 0x93, 0x67, 0x1a, 0x3a, 0x7d, 0xa0, 0x8c, 0xda, 0xc1, 0x39, 0x34,
+	0x1d, 0x32, 0xd6, 0xba, 0xb3, 0x21, 0x85, 0x8e, 0xd4, 0xf0, 0x7e, 0xaf, 0x3a, 0x9f, 0x08, 0xc5,
+	0xdf, 0x6d, 0xbf, 0x41, 0x2c, 0xe5, 0x81, 0x1f, 0x20, 0xc4, 0x43, 0x26, 0xec, 0x30, 0x56, 0xd0,
+	0x27, 0x65, 0xe2, 0x6b, 0xf1, 0x5e, 0x7e, 0xef, 0x55, 0x4f, 0x3b, 0x72, 0xd7, 0xbc, 0xb1, 0x63,
+	0xf8, 0xcc, 0x6c, 0x53, 0xe1, 0x19, 0x5b, 0xe0, 0x52, 0xa7, 0xbb, 0x01, 0x4e, 0xb6, 0xd5, 0x2c,
+	0x9c, 0x58, 0x25, 0x9e, 0xc2, 0x90, 0x4f, 0xd0, 0x72, 0xc6, 0xb8, 0x1d, 0x27, 0x6b, 0x1c, 0x76,
+	0x9f, 0x9b, 0x48, 0x1f, 0x95, 0x38, 0xfc, 0x1e, 0xfb, 0x4d, 0x50, 0xa3, 0x1c, 0xa4, 0x56, 0xda,
+	0x04, 0x9f, 0xa2, 0xa5, 0x61, 0x83, 0x92, 0xbf, 0x8c, 0x50, 0x9d, 0x72, 0xb0, 0xf3, 0x9c, 0x8b,
+	0xd9, 0x9e, 0x33, 0x1b, 0xb1, 0x4a, 0xf5, 0x34, 0x9a, 0xe8, 0x4a, 0xef, 0xa6, 0x1f, 0xc6, 0x92,
+	0x9b, 0x90, 0x96, 0x96, 0xb4, 0xd0, 0xf2, 0x88, 0x45, 0xa5, 0xba, 0x8b, 0x66, 0xa4, 0x5c, 0x13,
+	0x40, 0x25, 0xba, 0x5a, 0xec, 0xfc, 0xff, 0x97, 0x63, 0x69, 0x02, 0x10, 0xeb, 0x58, 0x3d, 0x91,
+	0x26, 0xdf, 0x69, 0xe8, 0xad, 0xa1, 0x74, 0xb7, 0x7d, 0x2e, 0x58, 0xd4, 0x4d, 0x4b, 0xf0, 0x01,
+	0x9a, 0xad, 0xb7, 0x98, 0xb3, 0x63, 0x3b, 0xac, 0x13, 0x08, 0x75, 0x86, 0x4b, 0xfb, 0xbd, 0x2a,
+	0x56, 0xa2, 0x99, 0x91, 0x58, 0x48, 0xae, 0x6e, 0xc4, 0x0b, 0x7c, 0x0d, 0xcd, 0x86, 0x10, 0x39,
+	0x10, 0x08, 0xbf, 0x05, 0x5c, 0x9f, 0x5c, 0x99, 0x5a, 0x9d, 0xcb, 0x07, 0xe6, 0x8c, 0xc4, 0xca,
+	0xbb, 0x92, 0xbf, 0x34, 0x54, 0x19, 0x07, 0xa5, 0x8e, 0xe2, 0x73, 0x74, 0x0c, 0x02, 0x11, 0xf9,
+	0x90, 0xde, 0x3b, 0x63, 0xdc, 0xbd, 0x1b, 0xd1, 0xb8, 0x19, 0x88, 0xa8, 0x5b, 0x5b, 0x52, 0xb7,
+	0x50, 0x75, 0x82, 0x12, 0x23, 0x56, 0x2a, 0x8b, 0x05, 0x5a, 0xc8, 0x98, 0xec, 0xf4, 0xe8, 0x92,
+	0x7d, 0x94, 0x6a, 0xb5, 0x62, 0x07, 0x7f, 0x7a, 0x78, 0xab, 0x99, 0x10, 0xb1, 0x70, 0xf6, 0x59,
+	0x11, 0x72, 0xf2, 0xd3, 0x24, 0x5a, 0x3a, 0x98, 0x18, 0xbf, 0x87, 0xa6, 0x3d, 0xf0, 0x5d, 0x2f,
+	0xa9, 0xc1, 0x54, 0xed, 0xe4, 0x7e, 0xaf, 0x3a, 0x97, 0xe8, 0x27, 0xdf, 0x89, 0xa5, 0x1c, 0x06,
+	0x1a, 0x65, 0xf2, 0x3f, 0x69, 0x94, 0xb8, 0xcd, 0x5d, 0xca, 0xed, 0xc7, 0x34, 0x10, 0xd0, 0xd0,
+	0xa7, 0x24, 0x41, 0xae, 0xcd, 0x33, 0x1b, 0xb1, 0x4a, 0x2e, 0xe5, 0x0f, 0xe4, 0x6f, 0xfc, 0x10,
+	0xcd, 0x3a, 0x1e, 0x0d, 0x5c, 0xb0, 0x23, 0x2a, 0x40, 0x3f, 0x22, 0x59, 0x3e, 0x2c, 0xc6, 0xa2,
+	0xda, 0x24, 0x17, 0x4f, 0x2c, 0x94, 0xac, 0xac, 0x78, 0x91, 0xde, 0xd5, 0x9b, 0x7e, 0xb8, 0x4d,
+	0x23, 0xda, 0xee, 0x3f, 0xd8, 0x7f, 0xcf, 0xa0, 0xa5, 0x61, 0x8b, 0x6a, 0x9b, 0xf3, 0x71, 0xdb,
+	0xd0, 0x7a, 0x0b, 0x1a, 0xf2, 0x10, 0x67, 0xf2, 0x8f, 0x81, 0x32, 0xc8, 0x16, 0x90, 0xbf, 0xb0,
+	0x87, 0x4e, 0x34, 0xa0, 0x49, 0x3b, 0x2d, 0x61, 0x0f, 0x1d, 0xe7, 0x47, 0xc5, 0xb6, 0xb0, 0x9c,
+	0xbe, 0x55, 0x83, 0x22, 0xc4, 0x9a, 0x57, 0x9f, 0x54, 0x9d, 0xf1, 0x67, 0xe8, 0x78, 0xdb, 0x0f,
+	0xb2, 0x2c, 0x53, 0x32, 0xcb, 0xf5, 0x62, 0x59, 0xfe, 0x9f, 0x64, 0xc9, 0x0b, 0x10, 0x0b, 0xb5,
+	0xfd, 0x20, 0xaf, 0x4e, 0xf7, 0x32, 0xf5, 0x23, 0xff, 0x46, 0x9d, 0xee, 0x0d, 0xa8, 0xd3, 0xbd,
+	0x54, 0xfd, 0x63, 0x34, 0x1f, 0x01, 0x07, 0x61, 0xfb, 0x81, 0x80, 0x68, 0x97, 0xb6, 0xf4, 0xa3,
+	0xb2, 0x3b, 0x4e, 0xed, 0xf7, 0xaa, 0x8b, 0x49, 0xf0, 0xa0, 0x9d, 0x58, 0x73, 0xf2, 0xc3, 0x1d,
+	0xb5, 0xc6, 0xbb, 0x68, 0x51, 0xca, 0x27, 0x2f, 0x49, 0xae, 0x5f, 0xa6, 0x25, 0xe8, 0x8d, 0x62,
+	0xa0, 0x67, 0x72, 0xa0, 0xc3, 0x4a, 0xc4, 0xc2, 0x31, 0xb1, 0x7c, 0x9c, 0xfa, 0x1d, 0x84, 0xbf,
+	0xd5, 0x50, 0x59, 0xd0, 0xc8, 0x05, 0xa1, 0x22, 0x78, 0x48, 0x1d, 0xb0, 0xd5, 0xb5, 0xd4, 0x8f,
+	0xc9, 0xec, 0xb7, 0x8b, 0x65, 0x7f, 0x3b, 0xc9, 0x3e, 0x5e, 0x8e, 0x58, 0xcb, 0x89, 0x51, 0x52,
+	0xdc, 0x8b, 0x4d, 0xdb, 0x89, 0x25, 0xbe, 0x5b, 0x2a, 0xce, 0xa5, 0x5c, 0x9f, 0x19, 0xbe, 0x5b,
+	0x99, 0x8d, 0x58, 0xa5, 0x64, 0x71, 0x8b, 0x72, 0xfc, 0x15, 0xd2, 0x23, 0x70, 0x3c, 0x70, 0x76,
+	0xe2, 0x9a, 0xd8, 0x2d, 0xf6, 0x38, 0xab, 0x70, 0x49, 0xa2, 0x6f, 0x16, 0x43, 0xaf, 0xa6, 0x45,
+	0x3a, 0x58, 0x8c, 0x58, 0x0b, 0xca, 0xb4, 0x09, 0xb0, 0xc5, 0x1e, 0xa7, 0x75, 0xff, 0x5a, 0x43,
+	0xa7, 0xf2, 0x31, 0x9e, 0xef, 0x7a, 0x19, 0x01, 0x92, 0x04, 0xb7, 0x8a, 0x11, 0xac, 0x8c, 0x12,
+	0x0c, 0xa8, 0x11, 0x6b, 0x31, 0x43, 0xb8, 0xed, 0xbb, 0x5e, 0xca, 0xf0, 0x44, 0x43, 0x95, 0x7c,
+	0x54, 0x1a, 0x60, 0x0b, 0x2f, 0x02, 0xee, 0xb1, 0x56, 0x43, 0x9f, 0x95, 0x20, 0x5b, 0xc5, 0x40,
+	0xce, 0x8e, 0x82, 0x8c, 0x4a, 0x12, 0xab, 0x9c, 0xd1, 0x28, 0x92, 0xfb, 0xa9, 0x71, 0xfd, 0x69,
+	0x09, 0x1d, 0x95, 0xaf, 0x0f, 0xfe, 0x5e, 0x43, 0xa5, 0xfe, 0xd0, 0x88, 0xd7, 0xc6, 0xfd, 0x81,
+	0x3a, 0x70, 0xea, 0x2c, 0x1b, 0x45, 0xdd, 0x93, 0x97, 0x8d, 0x9c, 0xfb, 0xe6, 0xd7, 0x3f, 0x9f,
+	0x4e, 0xbe, 0x83, 0x89, 0x39, 0x7e, 0xdc, 0x55, 0x73, 0x26, 0xfe, 0x59, 0x43, 0xf3, 0x83, 0x03,
+	0x21, 0x5e, 0x7f, 0x6d, 0xba, 0x03, 0x87, 0xd0, 0xf2, 0xa5, 0x43, 0xc5, 0x28, 0xce, 0x4b, 0x92,
+	0x73, 0x0d, 0xbf, 0x3f, 0x8e, 0x33, 0x1b, 0x12, 0xed, 0x7a, 0x37, 0x99, 0x9c, 0xf0, 0x8f, 0x1a,
+	0x9a, 0xcd, 0x8d, 0x76, 0xd8, 0x7c, 0x73, 0xe6, 0x81, 0x39, 0xb2, 0x7c, 0xa1, 0x78, 0x80, 0xe2,
+	0xbc, 0x22, 0x39, 0x4d, 0xbc, 0x36, 0x8e, 0x53, 0x92, 0xd9, 0x6a, 0x82, 0x34, 0xbf, 0x90, 0xcb,
+	0x2f, 0x65, 0xcd, 0xfb, 0x33, 0xe2, 0x1b, 0x6a, 0x3e, 0x3c, 0x64, 0x96, 0x8d, 0xa2, 0xee, 0x45,
+	0x6b, 0x9e, 0x0d, 0x9f, 0xf8, 0x99, 0x86, 0xe6, 0x6e, 0x81, 0xc8, 0x66, 0x0b, 0xfc, 0xfa, 0x6c,
+	0x23, 0x83, 0x69, 0xd9, 0x2c, 0xec, 0xaf, 0xf0, 0x2e, 0x48, 0xbc, 0x73, 0x78, 0x75, 0x1c, 0x9e,
+	0xd3, 0x89, 0x6c, 0xf0, 0xc3, 0xfe, 0x15, 0xc3, 0xbf, 0x68, 0x68, 0x61, 0x00, 0x52, 0x0d, 0x40,
+	0xf8, 0x4a, 0xc1, 0xdc, 0x83, 0xb3, 0x6b, 0xf9, 0xea, 0x61, 0xc3, 0x14, 0xf9, 0x65, 0x49, 0x6e,
+	0xe0, 0xf3, 0xe3, 0xc8, 0xf3, 0xd4, 0xb6, 0xa7, 0x20, 0x7f, 0xd0, 0xd0, 0xf1, 0x84, 0x3e, 0x99,
+	0x3a, 0xde, 0x50, 0xfe, 0xe1, 0xb9, 0xa5, 0x6c, 0x14, 0x75, 0x2f, 0x5a, 0xfe, 0x98, 0x32, 0x94,
+	0x31, 0xb5, 0xad, 0xe7, 0x2f, 0x2b, 0xda, 0x8b, 0x97, 0x15, 0xed, 0x8f, 0x97, 0x15, 0xed, 0xc9,
+	0xab, 0xca, 0xc4, 0x8b, 0x57, 0x95, 0x89, 0xdf, 0x5e, 0x55, 0x26, 0x1e, 0xae, 0xbb, 0xbe, 0xf0,
+	0x3a, 0x75, 0xc3, 0x61, 0xed, 0x54, 0x67, 0xad, 0x45, 0xeb, 0xbc, 0x2f, 0xba, 0xbb, 0xbe, 0x6e,
+	0xee, 0xa5, 0xd2, 0xa2, 0x1b, 0x02, 0xaf, 0x4f, 0xcb, 0x7f, 0x99, 0x2f, 0xfd, 0x33, 0x00, 0x60,
+	0x03, 0x98, 0x49, 0xeb, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -534,6 +873,13 @@ type QueryClient interface {
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// GetEipBaseFeeHistory returns the base fee, gas wanted and base fee change
+	// rate of the latest blocks kept in memory by the node, along with the base
+	// fees at the requested percentiles of these blocks.
+	GetEipBaseFeeHistory(ctx context.Context, in *QueryEipBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeHistoryResponse, error)
+	// GetEipParams returns the EIP-1559 fee market parameters in effect on the
+	// node, as configured in the osmosis-mempool section of its app.toml.
+	GetEipParams(ctx context.Context, in *QueryEipParamsRequest, opts ...grpc.CallOption) (*QueryEipParamsResponse, error)
 }
 
 type queryClient struct {
@@ -589,6 +935,24 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) GetEipBaseFeeHistory(ctx context.Context, in *QueryEipBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeHistoryResponse, error) {
+	out := new(QueryEipBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/GetEipBaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetEipParams(ctx context.Context, in *QueryEipParamsRequest, opts ...grpc.CallOption) (*QueryEipParamsResponse, error) {
+	out := new(QueryEipParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/GetEipParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -603,6 +967,13 @@ type QueryServer interface {
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
 	// Returns a list of all base denom tokens and their corresponding pools.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// GetEipBaseFeeHistory returns the base fee, gas wanted and base fee change
+	// rate of the latest blocks kept in memory by the node, along with the base
+	// fees at the requested percentiles of these blocks.
+	GetEipBaseFeeHistory(context.Context, *QueryEipBaseFeeHistoryRequest) (*QueryEipBaseFeeHistoryResponse, error)
+	// GetEipParams returns the EIP-1559 fee market parameters in effect on the
+	// node, as configured in the osmosis-mempool section of its app.toml.
+	GetEipParams(context.Context, *QueryEipParamsRequest) (*QueryEipParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -624,6 +995,12 @@ func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDe
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) GetEipBaseFeeHistory(ctx context.Context, req *QueryEipBaseFeeHistoryRequest) (*QueryEipBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetEipParams(ctx context.Context, req *QueryEipParamsRequest) (*QueryEipParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEipParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -719,6 +1096,42 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetEipBaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEipBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEipBaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/GetEipBaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEipBaseFeeHistory(ctx, req.(*QueryEipBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetEipParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEipParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEipParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/GetEipParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEipParams(ctx, req.(*QueryEipParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -743,6 +1156,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "GetEipBaseFeeHistory",
+			Handler:    _Query_GetEipBaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "GetEipParams",
+			Handler:    _Query_GetEipParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -1043,142 +1464,1276 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEipBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEipBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEipBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FeeTokens) > 0 {
-		for _, e := range m.FeeTokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Percentiles) > 0 {
+		dAtA2 := make([]byte, len(m.Percentiles)*10)
+		var j1 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
 		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomSpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEipBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomSpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
-	}
-	l = m.SpotPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEipBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEipBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PercentileBaseFees) > 0 {
+		for iNdEx := len(m.PercentileBaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PercentileBaseFees[iNdEx].Size()
+				i -= size
+				if _, err := m.PercentileBaseFees[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EipBaseFeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EipBaseFeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EipBaseFeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChangeRate.Size()
+		i -= size
+		if _, err := m.ChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GasWanted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEipParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEipParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEipParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEipParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEipParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEipParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RecheckFeeBaseFeeThreshold.Size()
+		i -= size
+		if _, err := m.RecheckFeeBaseFeeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RecheckFeeHighBaseFee.Size()
+		i -= size
+		if _, err := m.RecheckFeeHighBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RecheckFeeLowBaseFee.Size()
+		i -= size
+		if _, err := m.RecheckFeeLowBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.TargetGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.TargetBlockSpacePercent.Size()
+		i -= size
+		if _, err := m.TargetBlockSpacePercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxBlockChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBlockChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ResetInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DefaultBaseFee.Size()
+		i -= size
+		if _, err := m.DefaultBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryDenomPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovQuery(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *QueryBaseDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEipBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEipBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEipBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryEipBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PercentileBaseFees) > 0 {
+		for _, e := range m.PercentileBaseFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EipBaseFeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovQuery(uint64(m.GasWanted))
+	}
+	l = m.ChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEipParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEipParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.DefaultBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ResetInterval != 0 {
+		n += 1 + sovQuery(uint64(m.ResetInterval))
+	}
+	l = m.MaxBlockChangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TargetBlockSpacePercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TargetGas != 0 {
+		n += 1 + sovQuery(uint64(m.TargetGas))
+	}
+	l = m.RecheckFeeLowBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecheckFeeHighBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RecheckFeeBaseFeeThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryDenomPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovQuery(uint64(m.PoolID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBaseDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEipBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEipBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBaseDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryEipBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEipBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEipBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryEipBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.BaseFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEipBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,12 +2756,107 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1228,7 +2878,7 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEipBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1251,17 +2901,51 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEipBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, EipBaseFeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentileBaseFees", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1271,23 +2955,25 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeTokens = append(m.FeeTokens, FeeToken{})
-			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.PercentileBaseFees = append(m.PercentileBaseFees, v)
+			if err := m.PercentileBaseFees[len(m.PercentileBaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1312,7 +2998,7 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *EipBaseFeeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,15 +3021,34 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EipBaseFeeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EipBaseFeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,63 +3076,15 @@ func (m *QueryDenomSpotPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomSpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			m.PoolID = 0
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1437,14 +3094,14 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				m.GasWanted |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,7 +3129,7 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1497,7 +3154,7 @@ func (m *QueryDenomSpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEipParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1520,44 +3177,12 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEipParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEipParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1579,7 +3204,7 @@ func (m *QueryDenomPoolIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEipParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,17 +3227,158 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEipParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEipParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetInterval", wireType)
+			}
+			m.ResetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PoolID = 0
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockChangeRate", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1622,114 +3388,82 @@ func (m *QueryDenomPoolIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBaseDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.MaxBlockChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockSpacePercent", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TargetBlockSpacePercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckFeeLowBaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1757,111 +3491,47 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.RecheckFeeLowBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckFeeHighBaseFee", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEipBaseFeeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEipBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RecheckFeeHighBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEipBaseFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEipBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckFeeBaseFeeThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,7 +3559,7 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecheckFeeBaseFeeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex